@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -23,6 +24,7 @@ import (
 	"github.com/lightningnetwork/lnd/labels"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 var (
@@ -34,9 +36,10 @@ var (
 	// procedure, we can recover and continue from the persisted state.
 	retributionBucket = []byte("retribution")
 
-	// justiceTxnBucket holds the finalized justice transactions for all
-	// breached contracts. Entries are added to the justice txn bucket just
-	// before broadcasting the sweep txn.
+	// justiceTxnBucket held the finalized justice transactions for all
+	// breached contracts. As justice transactions are now recreated on
+	// every attempt, entries are no longer added, but existing ones are
+	// still removed along with their retribution.
 	justiceTxnBucket = []byte("justice-txn")
 
 	// errBrarShuttingDown is an error returned if the breacharbiter has
@@ -44,6 +47,19 @@ var (
 	errBrarShuttingDown = errors.New("breacharbiter shutting down")
 )
 
+const (
+	// blocksPassedSplitPublish is the number of blocks after the breach
+	// confirmed without our justice transaction confirming, after which
+	// we'll publish separate justice transactions for the commitment and
+	// HTLC outputs instead of the one sweeping all outputs.
+	blocksPassedSplitPublish = 4
+
+	// defaultJusticeCsvDelay is the CSV delay of the breaching party's
+	// commitment output assumed for retributions that were persisted
+	// before the delay was recorded.
+	defaultJusticeCsvDelay = 144
+)
+
 // ContractBreachEvent is an event the breachArbiter will receive in case a
 // contract breach is observed on-chain. It contains the necessary information
 // to handle the breach, and a ProcessACK channel we will use to ACK the event
@@ -316,24 +332,22 @@ func convertToSecondLevelRevoke(bo *breachedOutput, breachInfo *retributionInfo,
 		bo.outpoint)
 }
 
-// waitForSpendEvent waits for any of the breached outputs to get spent, and
-// mutates the breachInfo to be able to sweep it. This method should be used
-// when we fail to publish the justice tx because of a double spend, indicating
-// that the counter party has taken one of the breached outputs to the second
-// level. The spendNtfns map is a cache used to store registered spend
-// subscriptions, in case we must call this method multiple times.
+// spend is used to wrap the index of the output that gets spent together with
+// the spend details.
+type spend struct {
+	index  int
+	detail *chainntnfs.SpendDetail
+}
+
+// waitForSpendEvent waits for any of the breached outputs to get spent, either
+// by one of our justice transactions or by the counter party, and returns the
+// detected spends. The spendNtfns map is a cache used to store registered
+// spend subscriptions, in case we must call this method multiple times.
 func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
-	spendNtfns map[wire.OutPoint]*chainntnfs.SpendEvent) error {
+	spendNtfns map[wire.OutPoint]*chainntnfs.SpendEvent) ([]spend, error) {
 
 	inputs := breachInfo.breachedOutputs
 
-	// spend is used to wrap the index of the output that gets spent
-	// together with the spend details.
-	type spend struct {
-		index  int
-		detail *chainntnfs.SpendDetail
-	}
-
 	// We create a channel the first goroutine that gets a spend event can
 	// signal. We make it buffered in case multiple spend events come in at
 	// the same time.
@@ -376,7 +390,7 @@ func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
 				// to avoid entering an infinite loop.
 				select {
 				case <-b.quit:
-					return errBrarShuttingDown
+					return nil, errBrarShuttingDown
 				default:
 					continue
 				}
@@ -436,62 +450,165 @@ func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
 		// channel before ranging over its content.
 		close(allSpends)
 
-		doneOutputs := make(map[int]struct{})
+		var spends []spend
 		for s := range allSpends {
-			breachedOutput := &inputs[s.index]
-			delete(spendNtfns, breachedOutput.outpoint)
-
-			switch breachedOutput.witnessType {
-			case input.HtlcAcceptedRevoke:
-				fallthrough
-			case input.HtlcOfferedRevoke:
-				brarLog.Infof("Spend on second-level"+
-					"%s(%v) for ChannelPoint(%v) "+
-					"transitions to second-level output",
-					breachedOutput.witnessType,
-					breachedOutput.outpoint,
-					breachInfo.chanPoint)
+			delete(spendNtfns, inputs[s.index].outpoint)
+			spends = append(spends, s)
+		}
 
-				// In this case we'll morph our initial revoke
-				// spend to instead point to the second level
-				// output, and update the sign descriptor in the
-				// process.
-				convertToSecondLevelRevoke(
-					breachedOutput, breachInfo, s.detail,
-				)
+		return spends, nil
+
+	case <-b.quit:
+		return nil, errBrarShuttingDown
+	}
+}
+
+// isJusticeSpend returns true if the breached output was spent by one of our
+// justice transactions, which is determined from the witness of the spending
+// input.
+func isJusticeSpend(bo *breachedOutput, detail *chainntnfs.SpendDetail) bool {
+	txIns := detail.SpendingTx.TxIn
+	if int(detail.SpenderInputIndex) >= len(txIns) {
+		return false
+	}
+	txIn := txIns[detail.SpenderInputIndex]
+
+	switch bo.witnessType {
+	// Our own commitment output can only be spent by us.
+	case input.CommitmentNoDelay, input.CommitSpendNoDelayTweakless,
+		input.CommitmentToRemoteConfirmed:
+
+		return true
+
+	// The revocation clause of the breaching party's commitment output
+	// and of second-level HTLC outputs is selected by placing a one as
+	// the second element of the witness stack.
+	case input.CommitmentRevoke, input.HtlcSecondLevelRevoke:
+		return len(txIn.Witness) == 3 &&
+			bytes.Equal(txIn.Witness[1], []byte{1})
+
+	// For HTLC outputs on the commitment, the revocation key is revealed
+	// in the witness instead.
+	case input.HtlcAcceptedRevoke, input.HtlcOfferedRevoke:
+		revoke, err := input.IsHtlcSpendRevoke(txIn, &bo.signDesc)
+		if err != nil {
+			brarLog.Errorf("Unable to determine if spend of %v "+
+				"is a revocation spend: %v", bo.outpoint, err)
+			return false
+		}
+
+		return revoke
+	}
 
-				continue
+	return false
+}
+
+// updateBreachInfo mutates the breachInfo according to the detected spends of
+// its outputs. HTLC outputs taken to the second level by the counter party are
+// converted to be able to sweep the second level output, while outputs that
+// reached a terminal state are removed. The funds claimed by our justice
+// transactions are returned, along with the part of them that was revoked
+// from the counter party.
+func (b *breachArbiter) updateBreachInfo(breachInfo *retributionInfo,
+	spends []spend) (btcutil.Amount, btcutil.Amount) {
+
+	inputs := breachInfo.breachedOutputs
+	doneOutputs := make(map[int]struct{})
+
+	var totalFunds, revokedFunds btcutil.Amount
+	for _, s := range spends {
+		breachedOutput := &inputs[s.index]
+		justiceSpend := isJusticeSpend(breachedOutput, s.detail)
+
+		switch breachedOutput.witnessType {
+		case input.HtlcAcceptedRevoke, input.HtlcOfferedRevoke:
+			if justiceSpend {
+				break
 			}
 
+			brarLog.Infof("Spend on second-level"+
+				"%s(%v) for ChannelPoint(%v) "+
+				"transitions to second-level output",
+				breachedOutput.witnessType,
+				breachedOutput.outpoint, breachInfo.chanPoint)
+
+			// Record that the HTLC output itself was taken to the
+			// second level, before we move on to the second level
+			// output.
+			b.putBreachReport(
+				breachInfo, breachedOutput,
+				channeldb.ResolverOutcomeFirstStage,
+				s.detail.SpenderTxHash,
+			)
+
+			// In this case we'll morph our initial revoke spend to
+			// instead point to the second level output, and update
+			// the sign descriptor in the process.
+			convertToSecondLevelRevoke(
+				breachedOutput, breachInfo, s.detail,
+			)
+
+			continue
+		}
+
+		doneOutputs[s.index] = struct{}{}
+
+		if !justiceSpend {
 			brarLog.Infof("Spend on %s(%v) for ChannelPoint(%v) "+
 				"transitions output to terminal state, "+
 				"removing input from justice transaction",
 				breachedOutput.witnessType,
 				breachedOutput.outpoint, breachInfo.chanPoint)
 
-			doneOutputs[s.index] = struct{}{}
+			b.putBreachReport(
+				breachInfo, breachedOutput,
+				channeldb.ResolverOutcomeUnclaimed,
+				s.detail.SpenderTxHash,
+			)
+
+			continue
 		}
 
-		// Filter the inputs for which we can no longer proceed.
-		var nextIndex int
-		for i := range inputs {
-			if _, ok := doneOutputs[i]; ok {
-				continue
-			}
+		brarLog.Infof("Justice tx %v claimed %s(%v) for "+
+			"ChannelPoint(%v)", s.detail.SpenderTxHash,
+			breachedOutput.witnessType, breachedOutput.outpoint,
+			breachInfo.chanPoint)
+
+		// Record the claimed output, so that it shows up in the close
+		// report of the channel.
+		b.putBreachReport(
+			breachInfo, breachedOutput,
+			channeldb.ResolverOutcomeClaimed,
+			s.detail.SpenderTxHash,
+		)
 
-			inputs[nextIndex] = inputs[i]
-			nextIndex++
+		totalFunds += breachedOutput.Amount()
+
+		// If the output being revoked is the remote commitment output
+		// or an offered HTLC output, it's amount contributes to the
+		// value of funds being revoked from the counter party.
+		switch breachedOutput.witnessType {
+		case input.CommitmentRevoke, input.HtlcOfferedRevoke:
+			revokedFunds += breachedOutput.Amount()
 		}
+	}
 
-		// Update our remaining set of outputs before continuing with
-		// another attempt at publication.
-		breachInfo.breachedOutputs = inputs[:nextIndex]
+	// Filter the inputs for which we can no longer proceed.
+	var nextIndex int
+	for i := range inputs {
+		if _, ok := doneOutputs[i]; ok {
+			continue
+		}
 
-	case <-b.quit:
-		return errBrarShuttingDown
+		inputs[nextIndex] = inputs[i]
+		nextIndex++
 	}
 
-	return nil
+	// Update our remaining set of outputs before continuing with another
+	// attempt at publication.
+	breachInfo.breachedOutputs = inputs[:nextIndex]
+
+	return totalFunds, revokedFunds
 }
 
 // exactRetribution is a goroutine which is executed once a contract breach has
@@ -526,134 +643,151 @@ func (b *breachArbiter) exactRetribution(confChan *chainntnfs.ConfirmationEvent,
 	brarLog.Debugf("Breach transaction %v has been confirmed, sweeping "+
 		"revoked funds", breachInfo.commitHash)
 
-	// We may have to wait for some of the HTLC outputs to be spent to the
-	// second level before broadcasting the justice tx. We'll store the
-	// SpendEvents between each attempt to not re-register uneccessarily.
-	spendNtfns := make(map[wire.OutPoint]*chainntnfs.SpendEvent)
+	// We'll bump the fee of our justice transactions as new blocks arrive,
+	// so we subscribe to block notifications before making our first
+	// attempt.
+	blockEpochs, err := b.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		brarLog.Errorf("Unable to register for block notifications: %v",
+			err)
+		return
+	}
+	defer blockEpochs.Cancel()
 
-	finalTx, err := b.cfg.Store.GetFinalizedTxn(&breachInfo.chanPoint)
+	// All justice transactions pay out to the same script, which we
+	// generate once up front.
+	pkScript, err := b.cfg.GenSweepScript()
 	if err != nil {
-		brarLog.Errorf("Unable to get finalized txn for"+
-			"chanid=%v: %v", &breachInfo.chanPoint, err)
+		brarLog.Errorf("Unable to generate sweep script: %v", err)
 		return
 	}
 
-	// If this retribution has not been finalized before, we will first
-	// construct a sweep transaction and write it to disk. This will allow
-	// the breach arbiter to re-register for notifications for the justice
-	// txid.
-justiceTxBroadcast:
-	if finalTx == nil {
-		// With the breach transaction confirmed, we now create the
-		// justice tx which will claim ALL the funds within the
-		// channel.
-		finalTx, err = b.createJusticeTx(breachInfo)
-		if err != nil {
-			brarLog.Errorf("Unable to create justice tx: %v", err)
-			return
-		}
+	// We'll store the SpendEvents between each attempt to not re-register
+	// uneccessarily.
+	spendNtfns := make(map[wire.OutPoint]*chainntnfs.SpendEvent)
+
+	var (
+		totalFunds, revokedFunds btcutil.Amount
+		height                   = int32(breachConfHeight)
 
-		// Persist our finalized justice transaction before making an
-		// attempt to broadcast.
-		err := b.cfg.Store.Finalize(&breachInfo.chanPoint, finalTx)
+		// published holds the fees of the justice transactions that
+		// we published last, which their replacements must exceed.
+		published justiceFees
+	)
+	for {
+		// Determine the fee rate for this attempt, and whether enough
+		// blocks passed since the breach to publish the split justice
+		// transactions.
+		feeRate := b.justiceFeeRate(breachInfo, height)
+		split := height >= int32(breachInfo.breachHeight)+
+			blocksPassedSplitPublish
+
+		err := b.publishJusticeTxs(
+			breachInfo, pkScript, feeRate, split, &published,
+		)
 		if err != nil {
-			brarLog.Errorf("Unable to finalize justice tx for "+
-				"chanid=%v: %v", &breachInfo.chanPoint, err)
+			brarLog.Errorf("Unable to create justice txs for "+
+				"ChannelPoint(%v): %v", breachInfo.chanPoint,
+				err)
 			return
 		}
-	}
 
-	brarLog.Debugf("Broadcasting justice tx: %v", newLogClosure(func() string {
-		return spew.Sdump(finalTx)
-	}))
+		// Now we'll wait for any of the breached outputs to be spent,
+		// either by one of our justice transactions or by the counter
+		// party. The wait is done in a goroutine, such that we can
+		// bump the fee of our justice transactions in the meantime.
+		spendChan := make(chan []spend, 1)
+		errChan := make(chan error, 1)
 
-	// We'll now attempt to broadcast the transaction which finalized the
-	// channel's retribution against the cheating counter party.
-	label := labels.MakeLabel(labels.LabelTypeJusticeTransaction, nil)
-	err = b.cfg.PublishTransaction(finalTx, label)
-	if err != nil {
-		brarLog.Errorf("Unable to broadcast justice tx: %v", err)
-
-		if err == lnwallet.ErrDoubleSpend {
-			// Broadcasting the transaction failed because of a
-			// conflict either in the mempool or in chain. We'll
-			// now create spend subscriptions for all HTLC outputs
-			// on the commitment transaction that could possibly
-			// have been spent, and wait for any of them to
-			// trigger.
-			brarLog.Infof("Waiting for a spend event before " +
-				"attempting to craft new justice tx.")
-			finalTx = nil
-
-			err := b.waitForSpendEvent(breachInfo, spendNtfns)
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+
+			spends, err := b.waitForSpendEvent(
+				breachInfo, spendNtfns,
+			)
 			if err != nil {
-				if err != errBrarShuttingDown {
-					brarLog.Errorf("error waiting for "+
-						"spend event: %v", err)
-				}
+				errChan <- err
 				return
 			}
+			spendChan <- spends
+		}()
 
-			if len(breachInfo.breachedOutputs) == 0 {
-				brarLog.Debugf("No more outputs to sweep for "+
-					"breach, marking ChannelPoint(%v) "+
-					"fully resolved", breachInfo.chanPoint)
+		var spends []spend
+	waitForSpends:
+		for {
+			select {
+			case spends = <-spendChan:
+				break waitForSpends
 
-				err = b.cleanupBreach(&breachInfo.chanPoint)
+			case epoch, ok := <-blockEpochs.Epochs:
+				if !ok {
+					return
+				}
+				height = epoch.Height
+
+				// Republish our justice transactions if the
+				// fee rate increased enough to replace the
+				// previous ones, or if it is time to start
+				// publishing the split transactions.
+				newFeeRate := b.justiceFeeRate(
+					breachInfo, height,
+				)
+				newSplit := height >= int32(
+					breachInfo.breachHeight,
+				)+blocksPassedSplitPublish
+
+				relayFee := b.cfg.Estimator.RelayFeePerKW()
+				bumped := newFeeRate >= feeRate+relayFee
+				if !bumped && newSplit == split {
+					continue
+				}
+				feeRate, split = newFeeRate, newSplit
+
+				brarLog.Infof("Republishing justice txs for "+
+					"ChannelPoint(%v) at height=%v with "+
+					"fee rate %v", breachInfo.chanPoint,
+					height, feeRate)
+
+				err := b.publishJusticeTxs(
+					breachInfo, pkScript, feeRate, split,
+					&published,
+				)
 				if err != nil {
-					brarLog.Errorf("Failed to cleanup "+
-						"breached ChannelPoint(%v): %v",
+					brarLog.Errorf("Unable to create "+
+						"justice txs for "+
+						"ChannelPoint(%v): %v",
 						breachInfo.chanPoint, err)
 				}
+
+			case err := <-errChan:
+				if err != errBrarShuttingDown {
+					brarLog.Errorf("error waiting for "+
+						"spend event: %v", err)
+				}
+				return
+
+			case <-b.quit:
 				return
 			}
+		}
 
+		claimed, revoked := b.updateBreachInfo(breachInfo, spends)
+		totalFunds += claimed
+		revokedFunds += revoked
+
+		// The justice transaction sweeping all outputs can't remain in
+		// the mempool once one of its inputs is spent, so there is
+		// nothing left to replace. The split transactions may still be
+		// unconfirmed though.
+		published.spendAll = 0
+
+		if len(breachInfo.breachedOutputs) > 0 {
 			brarLog.Infof("Attempting another justice tx "+
 				"with %d inputs",
 				len(breachInfo.breachedOutputs))
 
-			goto justiceTxBroadcast
-		}
-	}
-
-	// As a conclusionary step, we register for a notification to be
-	// dispatched once the justice tx is confirmed. After confirmation we
-	// notify the caller that initiated the retribution workflow that the
-	// deed has been done.
-	justiceTXID := finalTx.TxHash()
-	justiceScript := finalTx.TxOut[0].PkScript
-	confChan, err = b.cfg.Notifier.RegisterConfirmationsNtfn(
-		&justiceTXID, justiceScript, 1, breachConfHeight,
-	)
-	if err != nil {
-		brarLog.Errorf("Unable to register for conf for txid(%v): %v",
-			justiceTXID, err)
-		return
-	}
-
-	select {
-	case _, ok := <-confChan.Confirmed:
-		if !ok {
-			return
-		}
-
-		// Compute both the total value of funds being swept and the
-		// amount of funds that were revoked from the counter party.
-		var totalFunds, revokedFunds btcutil.Amount
-		for _, inp := range breachInfo.breachedOutputs {
-			totalFunds += inp.Amount()
-
-			// If the output being revoked is the remote commitment
-			// output or an offered HTLC output, it's amount
-			// contributes to the value of funds being revoked from
-			// the counter party.
-			switch inp.WitnessType() {
-			case input.CommitmentRevoke:
-				revokedFunds += inp.Amount()
-			case input.HtlcOfferedRevoke:
-				revokedFunds += inp.Amount()
-			default:
-			}
+			continue
 		}
 
 		brarLog.Infof("Justice for ChannelPoint(%v) has "+
@@ -674,8 +808,122 @@ justiceTxBroadcast:
 		// peer
 
 		return
-	case <-b.quit:
-		return
+	}
+}
+
+// justiceFeeRate returns the fee rate to use for the justice transactions at
+// the given height. Starting from the estimated fee rate, it increases towards
+// the maximum fee rate as the CSV delay of the breaching party's commitment
+// output expires, after which they could sweep their output themselves.
+func (b *breachArbiter) justiceFeeRate(breachInfo *retributionInfo,
+	height int32) chainfee.SatPerKWeight {
+
+	feeRate, err := b.cfg.Estimator.EstimateFeePerKW(2)
+	if err != nil {
+		brarLog.Warnf("Unable to estimate justice fee rate, using "+
+			"fee floor: %v", err)
+		feeRate = chainfee.FeePerKwFloor
+	}
+
+	return sweep.DeadlineFeeRate(
+		feeRate, sweep.DefaultMaxFeeRate,
+		int32(breachInfo.breachHeight), breachInfo.justiceDeadline(),
+		height,
+	)
+}
+
+// publishJusticeTxs creates and broadcasts the justice transaction sweeping
+// all remaining breached outputs at the given fee rate. If split is true, the
+// separate justice transactions for the commitment and HTLC outputs are
+// published instead, such that the counter party can't hold up the
+// commitment outputs by repeatedly taking HTLCs to the second level. The
+// transactions replace those that were published before, whose fees are
+// passed in and updated. Failures to broadcast are only logged, as we'll
+// retry once an output gets spent or the fee rate increases.
+func (b *breachArbiter) publishJusticeTxs(breachInfo *retributionInfo,
+	pkScript []byte, feeRate chainfee.SatPerKWeight, split bool,
+	published *justiceFees) error {
+
+	justiceTxs, err := b.createJusticeTx(
+		breachInfo.breachedOutputs, pkScript, feeRate, published,
+	)
+	if err != nil {
+		return err
+	}
+
+	label := labels.MakeLabel(labels.LabelTypeJusticeTransaction, nil)
+	publish := func(tx *wire.MsgTx, fee btcutil.Amount,
+		publishedFee *btcutil.Amount) {
+
+		if tx == nil {
+			return
+		}
+
+		brarLog.Debugf("Broadcasting justice tx: %v",
+			newLogClosure(func() string {
+				return spew.Sdump(tx)
+			}))
+
+		err := b.cfg.PublishTransaction(tx, label)
+		if err != nil {
+			brarLog.Warnf("Unable to broadcast justice tx %v: %v",
+				tx.TxHash(), err)
+			return
+		}
+
+		*publishedFee = fee
+	}
+
+	if !split {
+		publish(
+			justiceTxs.spendAll, justiceTxs.fees.spendAll,
+			&published.spendAll,
+		)
+
+		return nil
+	}
+
+	brarLog.Debugf("Justice tx for ChannelPoint(%v) not confirmed after "+
+		"%d blocks, publishing split justice txs",
+		breachInfo.chanPoint, blocksPassedSplitPublish)
+
+	// The first of the split transactions replaces the one sweeping all
+	// outputs, so we publish them in the order in which they were priced.
+	publish(
+		justiceTxs.spendCommitOuts, justiceTxs.fees.spendCommitOuts,
+		&published.spendCommitOuts,
+	)
+	publish(
+		justiceTxs.spendHTLCs, justiceTxs.fees.spendHTLCs,
+		&published.spendHTLCs,
+	)
+
+	return nil
+}
+
+// putBreachReport records the resolution of an output of a breached channel. A
+// failure to do so is logged, but doesn't interrupt the retribution, as the
+// report is informational only.
+func (b *breachArbiter) putBreachReport(breachInfo *retributionInfo,
+	bo *breachedOutput, outcome channeldb.ResolverOutcome,
+	spendTxID *chainhash.Hash) {
+
+	report := &channeldb.ResolverReport{
+		OutPoint:        bo.outpoint,
+		Amount:          bo.amt,
+		ResolverType:    channeldb.ResolverTypeBreach,
+		ResolverOutcome: outcome,
+		SpendTxID:       spendTxID,
+		Timestamp:       time.Now(),
+	}
+
+	err := b.cfg.DB.PutResolverReport(
+		nil, breachInfo.chainHash, &breachInfo.chanPoint, report,
+	)
+	if err != nil {
+		brarLog.Errorf("Unable to write resolver report for %v of "+
+			"ChannelPoint(%v): %v", report.OutPoint,
+			breachInfo.chanPoint, err)
 	}
 }
 
@@ -949,9 +1197,26 @@ type retributionInfo struct {
 	chainHash    chainhash.Hash
 	breachHeight uint32
 
+	// csvDelay is the CSV delay of the breaching party's commitment
+	// output. Our justice transactions must confirm before it expires, as
+	// the counter party can sweep the output themselves afterwards.
+	csvDelay uint32
+
 	breachedOutputs []breachedOutput
 }
 
+// justiceDeadline returns the height by which the justice transactions should
+// be confirmed, as the breaching party's commitment output becomes spendable
+// by them afterwards.
+func (ret *retributionInfo) justiceDeadline() int32 {
+	csvDelay := ret.csvDelay
+	if csvDelay == 0 {
+		csvDelay = defaultJusticeCsvDelay
+	}
+
+	return int32(ret.breachHeight + csvDelay)
+}
+
 // newRetributionInfo constructs a retributionInfo containing all the
 // information required by the breach arbiter to recover funds from breached
 // channels.  The information is primarily populated using the BreachRetribution
@@ -1050,41 +1315,152 @@ func newRetributionInfo(chanPoint *wire.OutPoint,
 		chanPoint:       *chanPoint,
 		breachedOutputs: breachedOutputs,
 		breachHeight:    breachInfo.BreachHeight,
+		csvDelay:        breachInfo.RemoteDelay,
 	}
 }
 
-// createJusticeTx creates a transaction which exacts "justice" by sweeping ALL
+// justiceTxVariants is a set of justice transactions sweeping the breached
+// outputs of a channel.
+type justiceTxVariants struct {
+	// spendAll sweeps all remaining breached outputs.
+	spendAll *wire.MsgTx
+
+	// spendCommitOuts sweeps only the commitment outputs, which the
+	// counter party can't take to the second level.
+	spendCommitOuts *wire.MsgTx
+
+	// spendHTLCs sweeps only the HTLC outputs, including those taken to
+	// the second level.
+	spendHTLCs *wire.MsgTx
+
+	// fees holds the absolute fees that the transactions pay.
+	fees justiceFees
+}
+
+// justiceFees holds the absolute fees of the justice transaction variants.
+type justiceFees struct {
+	spendAll        btcutil.Amount
+	spendCommitOuts btcutil.Amount
+	spendHTLCs      btcutil.Amount
+}
+
+// createJusticeTx creates transactions which exact "justice" by sweeping ALL
 // the funds within the channel which we are now entitled to due to a breach of
-// the channel's contract by the counterparty. This function returns a *fully*
-// signed transaction with the witness for each input fully in place.
-func (b *breachArbiter) createJusticeTx(
-	r *retributionInfo) (*wire.MsgTx, error) {
+// the channel's contract by the counterparty. Besides the transaction
+// sweeping all outputs, separate transactions for the commitment and HTLC
+// outputs are created, which can't be held up by the counter party spending
+// one of the outputs of the other kind. The returned transactions are *fully*
+// signed with the witness for each input fully in place.
+//
+// The transactions may replace previously published ones, whose fees are
+// given by replaced. As required by BIP 125, a replacement pays at least the
+// fee of the transactions it conflicts with plus the relay fee for its own
+// weight. The split transactions conflict with the one sweeping all outputs,
+// which is replaced by the first of them.
+func (b *breachArbiter) createJusticeTx(breachedOutputs []breachedOutput,
+	pkScript []byte, feeRate chainfee.SatPerKWeight,
+	replaced *justiceFees) (*justiceTxVariants, error) {
+
+	var (
+		allInputs    []input.Input
+		commitInputs []input.Input
+		htlcInputs   []input.Input
+	)
+
+	for i := range breachedOutputs {
+		// Grab locally scoped reference to breached output.
+		inp := &breachedOutputs[i]
+		allInputs = append(allInputs, inp)
+
+		switch inp.WitnessType() {
+		case input.HtlcAcceptedRevoke, input.HtlcOfferedRevoke,
+			input.HtlcSecondLevelRevoke:
+
+			htlcInputs = append(htlcInputs, inp)
+
+		default:
+			commitInputs = append(commitInputs, inp)
+		}
+	}
+
+	var (
+		txs = &justiceTxVariants{}
+		err error
+	)
+
+	txs.spendAll, txs.fees.spendAll, err = b.createSweepTx(
+		pkScript, feeRate, replaced.spendAll, allInputs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The split transactions are only a fallback for the transaction
+	// spending all outputs, so we don't fail if one of them can't be
+	// created, e.g. because its outputs don't cover the fee.
+	replacedFee := replaced.spendCommitOuts
+	if replaced.spendAll > replacedFee {
+		replacedFee = replaced.spendAll
+	}
+	txs.spendCommitOuts, txs.fees.spendCommitOuts, err = b.createSweepTx(
+		pkScript, feeRate, replacedFee, commitInputs...,
+	)
+	if err != nil {
+		brarLog.Warnf("Could not create justice tx for commitment "+
+			"outputs: %v", err)
+	}
+
+	// Without a transaction for the commitment outputs, it is up to the
+	// one for the HTLC outputs to replace the one sweeping all outputs.
+	replacedFee = replaced.spendHTLCs
+	if txs.spendCommitOuts == nil && replaced.spendAll > replacedFee {
+		replacedFee = replaced.spendAll
+	}
+	txs.spendHTLCs, txs.fees.spendHTLCs, err = b.createSweepTx(
+		pkScript, feeRate, replacedFee, htlcInputs...,
+	)
+	if err != nil {
+		brarLog.Warnf("Could not create justice tx for HTLC "+
+			"outputs: %v", err)
+	}
+
+	return txs, nil
+}
+
+// createSweepTx creates a signed transaction sweeping the given inputs to the
+// pkScript at the given fee rate. If the transaction replaces one with the
+// given non-zero fee, the fee is raised as far as needed to do so. The
+// transaction is returned together with the fee that it pays. If no inputs
+// are given, nil is returned.
+func (b *breachArbiter) createSweepTx(pkScript []byte,
+	feeRate chainfee.SatPerKWeight, replacedFee btcutil.Amount,
+	inputs ...input.Input) (*wire.MsgTx, btcutil.Amount, error) {
 
-	// We will assemble the breached outputs into a slice of spendable
-	// outputs, while simultaneously computing the estimated weight of the
+	if len(inputs) == 0 {
+		return nil, 0, nil
+	}
+
+	// We will assemble the inputs into a slice of spendable outputs,
+	// while simultaneously computing the estimated weight of the
 	// transaction.
 	var (
 		spendableOutputs []input.Input
 		weightEstimate   input.TxWeightEstimator
 	)
 
-	// Allocate enough space to potentially hold each of the breached
-	// outputs in the retribution info.
-	spendableOutputs = make([]input.Input, 0, len(r.breachedOutputs))
+	// Allocate enough space to potentially hold each of the inputs.
+	spendableOutputs = make([]input.Input, 0, len(inputs))
 
 	// The justice transaction we construct will be a segwit transaction
 	// that pays to a p2wkh output. Components such as the version,
 	// nLockTime, and output are already included in the TxWeightEstimator.
 	weightEstimate.AddP2WKHOutput()
 
-	// Next, we iterate over the breached outputs contained in the
-	// retribution info.  For each, we switch over the witness type such
-	// that we contribute the appropriate weight for each input and witness,
-	// finally adding to our list of spendable outputs.
-	for i := range r.breachedOutputs {
-		// Grab locally scoped reference to breached output.
-		inp := &r.breachedOutputs[i]
-
+	// Next, we iterate over the inputs. For each, we switch over the
+	// witness type such that we contribute the appropriate weight for
+	// each input and witness, finally adding to our list of spendable
+	// outputs.
+	for _, inp := range inputs {
 		// First, determine the appropriate estimated witness weight for
 		// the give witness type of this breached output. If the witness
 		// weight cannot be estimated, we will omit it from the
@@ -1103,22 +1479,18 @@ func (b *breachArbiter) createJusticeTx(
 	}
 
 	txWeight := int64(weightEstimate.Weight())
-	return b.sweepSpendableOutputsTxn(txWeight, spendableOutputs...)
+	return b.sweepSpendableOutputsTxn(
+		txWeight, pkScript, feeRate, replacedFee, spendableOutputs...,
+	)
 }
 
 // sweepSpendableOutputsTxn creates a signed transaction from a sequence of
-// spendable outputs by sweeping the funds into a single p2wkh output.
+// spendable outputs by sweeping the funds into a single p2wkh output. The
+// transaction is returned together with the fee that it pays.
 func (b *breachArbiter) sweepSpendableOutputsTxn(txWeight int64,
-	inputs ...input.Input) (*wire.MsgTx, error) {
-
-	// First, we obtain a new public key script from the wallet which we'll
-	// sweep the funds to.
-	// TODO(roasbeef): possibly create many outputs to minimize change in
-	// the future?
-	pkScript, err := b.cfg.GenSweepScript()
-	if err != nil {
-		return nil, err
-	}
+	pkScript []byte, feePerKw chainfee.SatPerKWeight,
+	replacedFee btcutil.Amount, inputs ...input.Input) (*wire.MsgTx,
+	btcutil.Amount, error) {
 
 	// Compute the total amount contained in the inputs.
 	var totalAmt btcutil.Amount
@@ -1126,15 +1498,32 @@ func (b *breachArbiter) sweepSpendableOutputsTxn(txWeight int64,
 		totalAmt += btcutil.Amount(input.SignDesc().Output.Value)
 	}
 
-	// We'll actually attempt to target inclusion within the next two
-	// blocks as we'd like to sweep these funds back into our wallet ASAP.
-	feePerKw, err := b.cfg.Estimator.EstimateFeePerKW(2)
-	if err != nil {
-		return nil, err
+	dustLimit := lnwallet.DefaultDustLimit()
+	if totalAmt <= dustLimit {
+		return nil, 0, fmt.Errorf("total amount %v of justice tx "+
+			"inputs doesn't exceed dust limit %v", totalAmt,
+			dustLimit)
 	}
+
 	txFee := feePerKw.FeeForWeight(txWeight)
 
-	// TODO(roasbeef): already start to siphon their funds into fees
+	// A replacement must pay more than the transaction it replaces, by
+	// at least the relay fee for its own weight.
+	if replacedFee > 0 {
+		relayFee := b.cfg.Estimator.RelayFeePerKW()
+		minFee := replacedFee + relayFee.FeeForWeight(txWeight)
+		if txFee < minFee {
+			txFee = minFee
+		}
+	}
+
+	// As the fee rate increases towards the deadline, we'll rather spend
+	// the funds on fees than let the counter party sweep them, but the
+	// output must remain above the dust limit.
+	if txFee > totalAmt-dustLimit {
+		txFee = totalAmt - dustLimit
+	}
+
 	sweepAmt := int64(totalAmt - txFee)
 
 	// With the fee calculated, we can now create the transaction using the
@@ -1160,7 +1549,7 @@ func (b *breachArbiter) sweepSpendableOutputsTxn(txWeight int64,
 	// basic validity requirements.
 	btx := btcutil.NewTx(txn)
 	if err := blockchain.CheckTransactionSanity(btx); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Create a sighash cache to improve the performance of hashing and
@@ -1194,11 +1583,11 @@ func (b *breachArbiter) sweepSpendableOutputsTxn(txWeight int64,
 	// transaction.
 	for i, input := range inputs {
 		if err := addWitness(i, input); err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 	}
 
-	return txn, nil
+	return txn, txFee, nil
 }
 
 // RetributionStore provides an interface for managing a persistent map from
@@ -1222,15 +1611,6 @@ type RetributionStore interface {
 	// is aware of any breaches for the provided channel point.
 	IsBreached(chanPoint *wire.OutPoint) (bool, error)
 
-	// Finalize persists the finalized justice transaction for a particular
-	// channel.
-	Finalize(chanPoint *wire.OutPoint, finalTx *wire.MsgTx) error
-
-	// GetFinalizedTxn loads the finalized justice transaction, if any, from
-	// the retribution store. The finalized transaction will be nil if
-	// Finalize has not yet been called for this channel point.
-	GetFinalizedTxn(chanPoint *wire.OutPoint) (*wire.MsgTx, error)
-
 	// Remove deletes the retributionInfo from disk, if any exists, under
 	// the given key. An error should be re raised if the removal fails.
 	Remove(key *wire.OutPoint) error
@@ -1268,7 +1648,8 @@ func (rs *retributionStore) Add(ret *retributionInfo) error {
 		}
 
 		var outBuf bytes.Buffer
-		if err := writeOutpoint(&outBuf, &ret.chanPoint); err != nil {
+		err = channeldb.WriteVarOutpoint(&outBuf, &ret.chanPoint)
+		if err != nil {
 			return err
 		}
 
@@ -1281,68 +1662,6 @@ func (rs *retributionStore) Add(ret *retributionInfo) error {
 	}, func() {})
 }
 
-// Finalize writes a signed justice transaction to the retribution store. This
-// is done before publishing the transaction, so that we can recover the txid on
-// startup and re-register for confirmation notifications.
-func (rs *retributionStore) Finalize(chanPoint *wire.OutPoint,
-	finalTx *wire.MsgTx) error {
-	return kvdb.Update(rs.db, func(tx kvdb.RwTx) error {
-		justiceBkt, err := tx.CreateTopLevelBucket(justiceTxnBucket)
-		if err != nil {
-			return err
-		}
-
-		var chanBuf bytes.Buffer
-		if err := writeOutpoint(&chanBuf, chanPoint); err != nil {
-			return err
-		}
-
-		var txBuf bytes.Buffer
-		if err := finalTx.Serialize(&txBuf); err != nil {
-			return err
-		}
-
-		return justiceBkt.Put(chanBuf.Bytes(), txBuf.Bytes())
-	}, func() {})
-}
-
-// GetFinalizedTxn loads the finalized justice transaction for the provided
-// channel point. The finalized transaction will be nil if Finalize has yet to
-// be called for this channel point.
-func (rs *retributionStore) GetFinalizedTxn(
-	chanPoint *wire.OutPoint) (*wire.MsgTx, error) {
-
-	var finalTxBytes []byte
-	if err := kvdb.View(rs.db, func(tx kvdb.RTx) error {
-		justiceBkt := tx.ReadBucket(justiceTxnBucket)
-		if justiceBkt == nil {
-			return nil
-		}
-
-		var chanBuf bytes.Buffer
-		if err := writeOutpoint(&chanBuf, chanPoint); err != nil {
-			return err
-		}
-
-		finalTxBytes = justiceBkt.Get(chanBuf.Bytes())
-
-		return nil
-	}, func() {
-		finalTxBytes = nil
-	}); err != nil {
-		return nil, err
-	}
-
-	if finalTxBytes == nil {
-		return nil, nil
-	}
-
-	finalTx := &wire.MsgTx{}
-	err := finalTx.Deserialize(bytes.NewReader(finalTxBytes))
-
-	return finalTx, err
-}
-
 // IsBreached queries the retribution store to discern if this channel was
 // previously breached. This is used when connecting to a peer to determine if
 // it is safe to add a link to the htlcswitch, as we should never add a channel
@@ -1356,7 +1675,8 @@ func (rs *retributionStore) IsBreached(chanPoint *wire.OutPoint) (bool, error) {
 		}
 
 		var chanBuf bytes.Buffer
-		if err := writeOutpoint(&chanBuf, chanPoint); err != nil {
+		err := channeldb.WriteVarOutpoint(&chanBuf, chanPoint)
+		if err != nil {
 			return err
 		}
 
@@ -1390,7 +1710,8 @@ func (rs *retributionStore) Remove(chanPoint *wire.OutPoint) error {
 
 		// Serialize the channel point we are intending to remove.
 		var chanBuf bytes.Buffer
-		if err := writeOutpoint(&chanBuf, chanPoint); err != nil {
+		err := channeldb.WriteVarOutpoint(&chanBuf, chanPoint)
+		if err != nil {
 			return err
 		}
 		chanBytes := chanBuf.Bytes()
@@ -1448,7 +1769,7 @@ func (ret *retributionInfo) Encode(w io.Writer) error {
 		return err
 	}
 
-	if err := writeOutpoint(w, &ret.chanPoint); err != nil {
+	if err := channeldb.WriteVarOutpoint(w, &ret.chanPoint); err != nil {
 		return err
 	}
 
@@ -1472,6 +1793,11 @@ func (ret *retributionInfo) Encode(w io.Writer) error {
 		}
 	}
 
+	binary.BigEndian.PutUint32(scratch[:], ret.csvDelay)
+	if _, err := w.Write(scratch[:]); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1488,7 +1814,7 @@ func (ret *retributionInfo) Decode(r io.Reader) error {
 	}
 	ret.commitHash = *hash
 
-	if err := readOutpoint(r, &ret.chanPoint); err != nil {
+	if err := channeldb.ReadVarOutpoint(r, &ret.chanPoint); err != nil {
 		return err
 	}
 
@@ -1519,6 +1845,17 @@ func (ret *retributionInfo) Decode(r io.Reader) error {
 		}
 	}
 
+	// Retributions persisted before the CSV delay was recorded end after
+	// the outputs, in which case we'll fall back to the default delay.
+	_, err = io.ReadFull(r, scratch[:4])
+	switch {
+	case err == io.EOF:
+		return nil
+	case err != nil:
+		return err
+	}
+	ret.csvDelay = binary.BigEndian.Uint32(scratch[:4])
+
 	return nil
 }
 
@@ -1531,7 +1868,7 @@ func (bo *breachedOutput) Encode(w io.Writer) error {
 		return err
 	}
 
-	if err := writeOutpoint(w, &bo.outpoint); err != nil {
+	if err := channeldb.WriteVarOutpoint(w, &bo.outpoint); err != nil {
 		return err
 	}
 
@@ -1562,7 +1899,7 @@ func (bo *breachedOutput) Decode(r io.Reader) error {
 	}
 	bo.amt = btcutil.Amount(binary.BigEndian.Uint64(scratch[:8]))
 
-	if err := readOutpoint(r, &bo.outpoint); err != nil {
+	if err := channeldb.ReadVarOutpoint(r, &bo.outpoint); err != nil {
 		return err
 	}
 
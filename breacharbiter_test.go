@@ -18,6 +18,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
@@ -407,24 +408,6 @@ func (frs *failingRetributionStore) IsBreached(chanPoint *wire.OutPoint) (bool,
 	return frs.rs.IsBreached(chanPoint)
 }
 
-func (frs *failingRetributionStore) Finalize(chanPoint *wire.OutPoint,
-	finalTx *wire.MsgTx) error {
-
-	frs.mu.Lock()
-	defer frs.mu.Unlock()
-
-	return frs.rs.Finalize(chanPoint, finalTx)
-}
-
-func (frs *failingRetributionStore) GetFinalizedTxn(
-	chanPoint *wire.OutPoint) (*wire.MsgTx, error) {
-
-	frs.mu.Lock()
-	defer frs.mu.Unlock()
-
-	return frs.rs.GetFinalizedTxn(chanPoint)
-}
-
 func (frs *failingRetributionStore) Remove(key *wire.OutPoint) error {
 	frs.mu.Lock()
 	defer frs.mu.Unlock()
@@ -537,16 +520,14 @@ func copyRetInfo(retInfo *retributionInfo) *retributionInfo {
 // by an in-memory map. Access to the internal state is provided by a mutex.
 // TODO(cfromknecht) extend to support and test controlled failures.
 type mockRetributionStore struct {
-	mu       sync.Mutex
-	state    map[wire.OutPoint]*retributionInfo
-	finalTxs map[wire.OutPoint]*wire.MsgTx
+	mu    sync.Mutex
+	state map[wire.OutPoint]*retributionInfo
 }
 
 func newMockRetributionStore() *mockRetributionStore {
 	return &mockRetributionStore{
-		mu:       sync.Mutex{},
-		state:    make(map[wire.OutPoint]*retributionInfo),
-		finalTxs: make(map[wire.OutPoint]*wire.MsgTx),
+		mu:    sync.Mutex{},
+		state: make(map[wire.OutPoint]*retributionInfo),
 	}
 }
 
@@ -566,30 +547,9 @@ func (rs *mockRetributionStore) IsBreached(chanPoint *wire.OutPoint) (bool, erro
 	return ok, nil
 }
 
-func (rs *mockRetributionStore) Finalize(chanPoint *wire.OutPoint,
-	finalTx *wire.MsgTx) error {
-
-	rs.mu.Lock()
-	rs.finalTxs[*chanPoint] = finalTx
-	rs.mu.Unlock()
-
-	return nil
-}
-
-func (rs *mockRetributionStore) GetFinalizedTxn(
-	chanPoint *wire.OutPoint) (*wire.MsgTx, error) {
-
-	rs.mu.Lock()
-	finalTx := rs.finalTxs[*chanPoint]
-	rs.mu.Unlock()
-
-	return finalTx, nil
-}
-
 func (rs *mockRetributionStore) Remove(key *wire.OutPoint) error {
 	rs.mu.Lock()
 	delete(rs.state, *key)
-	delete(rs.finalTxs, *key)
 	rs.mu.Unlock()
 
 	return nil
@@ -1210,8 +1170,10 @@ func TestBreachHandoffFail(t *testing.T) {
 	assertArbiterBreach(t, brar, chanPoint)
 }
 
+// publAssertion asserts the publication of justice transactions, returning
+// the published transaction, if any.
 type publAssertion func(*testing.T, map[wire.OutPoint]*wire.MsgTx,
-	chan *wire.MsgTx)
+	chan *wire.MsgTx) *wire.MsgTx
 
 type breachTest struct {
 	name string
@@ -1221,10 +1183,6 @@ type breachTest struct {
 	// htlc is in effect "readded" to the set of inputs.
 	spend2ndLevel bool
 
-	// sendFinalConf informs the test to send a confirmation for the justice
-	// transaction before asserting the arbiter is cleaned up.
-	sendFinalConf bool
-
 	// whenNonZeroInputs is called after spending an input but there are
 	// further inputs to spend in the test.
 	whenNonZeroInputs publAssertion
@@ -1262,7 +1220,7 @@ var breachTests = []breachTest{
 		spend2ndLevel: true,
 		whenNonZeroInputs: func(t *testing.T,
 			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
+			publTx chan *wire.MsgTx) *wire.MsgTx {
 
 			var tx *wire.MsgTx
 			select {
@@ -1285,10 +1243,11 @@ var breachTests = []breachTest{
 				findInputIndex(t, in, tx)
 			}
 
+			return tx
 		},
 		whenZeroInputs: func(t *testing.T,
 			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
+			publTx chan *wire.MsgTx) *wire.MsgTx {
 
 			// Sanity check to ensure the brar doesn't try to
 			// broadcast another sweep, since all outputs have been
@@ -1298,25 +1257,29 @@ var breachTests = []breachTest{
 				t.Fatalf("tx published unexpectedly")
 			case <-time.After(50 * time.Millisecond):
 			}
+
+			return nil
 		},
 	},
 	{
 		name:          "commit spends, second level sweep",
 		spend2ndLevel: false,
-		sendFinalConf: true,
 		whenNonZeroInputs: func(t *testing.T,
 			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
+			publTx chan *wire.MsgTx) *wire.MsgTx {
 
+			var tx *wire.MsgTx
 			select {
-			case <-publTx:
+			case tx = <-publTx:
 			case <-time.After(5 * time.Second):
 				t.Fatalf("tx was not published")
 			}
+
+			return tx
 		},
 		whenZeroInputs: func(t *testing.T,
 			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
+			publTx chan *wire.MsgTx) *wire.MsgTx {
 
 			// Now a transaction attempting to spend from the second
 			// level tx should be published instead. Let this
@@ -1349,6 +1312,8 @@ var breachTests = []breachTest{
 				t.Fatalf("tx not attempting to spend second "+
 					"level tx, %v", tx.TxIn[0])
 			}
+
+			return tx
 		},
 	},
 }
@@ -1481,6 +1446,7 @@ func testBreachSpends(t *testing.T, test breachTest) {
 
 	// Until no more inputs to spend remain, deliver the spend events and
 	// process the assertions prescribed by the test case.
+	var finalTx *wire.MsgTx
 	for len(inputs) > 0 {
 		var (
 			op      wire.OutPoint
@@ -1515,19 +1481,121 @@ func testBreachSpends(t *testing.T, test breachTest) {
 			publMtx.Lock()
 			publErr = nil
 			publMtx.Unlock()
-			test.whenZeroInputs(t, inputs, publTx)
+			finalTx = test.whenZeroInputs(t, inputs, publTx)
 		}
 	}
 
-	// Deliver confirmation of sweep if the test expects it.
-	if test.sendFinalConf {
-		notifier.ConfChan <- &chainntnfs.TxConfirmation{}
+	// If a final justice transaction was published, deliver its spend of
+	// the remaining output.
+	if finalTx != nil {
+		notifier.Spend(&finalTx.TxIn[0].PreviousOutPoint, 3, finalTx)
 	}
 
 	// Assert that the channel is fully resolved.
 	assertBrarCleanup(t, brar, alice.ChanPoint, alice.State().Db)
 }
 
+// TestCreateJusticeTxVariants asserts that besides the justice transaction
+// sweeping all breached outputs, separate justice transactions sweeping the
+// commitment and HTLC outputs are created.
+func TestCreateJusticeTxVariants(t *testing.T) {
+	brar, alice, _, bobClose, _, cleanUpChans,
+		cleanUpArb := initBreachedState(t)
+	defer cleanUpChans()
+	defer cleanUpArb()
+
+	retribution, err := lnwallet.NewBreachRetribution(
+		alice.State(), bobClose.ChanSnapshot.CommitHeight, 1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create breach retribution: %v", err)
+	}
+	retInfo := newRetributionInfo(alice.ChanPoint, retribution)
+
+	if retInfo.justiceDeadline() != int32(1+retribution.RemoteDelay) {
+		t.Fatalf("unexpected justice deadline %v",
+			retInfo.justiceDeadline())
+	}
+
+	pkScript, err := brar.cfg.GenSweepScript()
+	if err != nil {
+		t.Fatalf("unable to generate sweep script: %v", err)
+	}
+
+	txs, err := brar.createJusticeTx(
+		retInfo.breachedOutputs, pkScript, chainfee.FeePerKwFloor,
+		&justiceFees{},
+	)
+	if err != nil {
+		t.Fatalf("unable to create justice txs: %v", err)
+	}
+
+	localOutpoint := retribution.LocalOutpoint
+	remoteOutpoint := retribution.RemoteOutpoint
+	htlcOutpoint := retribution.HtlcRetributions[0].OutPoint
+
+	assertInputs := func(tx *wire.MsgTx, ops ...wire.OutPoint) {
+		t.Helper()
+
+		if tx == nil {
+			t.Fatalf("justice tx not created")
+		}
+		if len(tx.TxIn) != len(ops) {
+			t.Fatalf("expected %d inputs, found %d", len(ops),
+				len(tx.TxIn))
+		}
+		for _, op := range ops {
+			findInputIndex(t, op, tx)
+		}
+	}
+
+	assertInputs(txs.spendAll, localOutpoint, remoteOutpoint, htlcOutpoint)
+	assertInputs(txs.spendCommitOuts, localOutpoint, remoteOutpoint)
+	assertInputs(txs.spendHTLCs, htlcOutpoint)
+
+	// Once the justice tx sweeping all outputs was published, the one for
+	// the commitment outputs must pay a higher absolute fee to replace it,
+	// while the one for the HTLC outputs doesn't conflict with it anymore.
+	relayFee := brar.cfg.Estimator.RelayFeePerKW()
+	replaced := &justiceFees{spendAll: txs.fees.spendAll}
+	split, err := brar.createJusticeTx(
+		retInfo.breachedOutputs, pkScript, chainfee.FeePerKwFloor,
+		replaced,
+	)
+	if err != nil {
+		t.Fatalf("unable to create justice txs: %v", err)
+	}
+
+	commitWeight := blockchain.GetTransactionWeight(
+		btcutil.NewTx(split.spendCommitOuts),
+	)
+	minFee := txs.fees.spendAll + relayFee.FeeForWeight(commitWeight)
+	if split.fees.spendCommitOuts < minFee {
+		t.Fatalf("commitment justice tx fee %v doesn't replace fee %v",
+			split.fees.spendCommitOuts, txs.fees.spendAll)
+	}
+	if split.fees.spendHTLCs != txs.fees.spendHTLCs {
+		t.Fatalf("expected HTLC justice tx fee %v, got %v",
+			txs.fees.spendHTLCs, split.fees.spendHTLCs)
+	}
+
+	// Once the HTLC output is gone, only the commitment outputs are left
+	// and no HTLC justice tx should be created.
+	txs, err = brar.createJusticeTx(
+		retInfo.breachedOutputs[:2], pkScript, chainfee.FeePerKwFloor,
+		&justiceFees{},
+	)
+	if err != nil {
+		t.Fatalf("unable to create justice txs: %v", err)
+	}
+
+	assertInputs(txs.spendAll, localOutpoint, remoteOutpoint)
+	assertInputs(txs.spendCommitOuts, localOutpoint, remoteOutpoint)
+	if txs.spendHTLCs != nil {
+		t.Fatalf("unexpected HTLC justice tx")
+	}
+}
+
 // findInputIndex returns the index of the input that spends from the given
 // outpoint. This method fails if the outpoint is not found.
 func findInputIndex(t *testing.T, op wire.OutPoint, tx *wire.MsgTx) int {
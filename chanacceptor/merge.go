@@ -18,6 +18,7 @@ const (
 	fieldMinIn           = "min htlc in"
 	fieldInFlightTotal   = "in flight total"
 	fieldUpfrontShutdown = "upfront shutdown"
+	fieldZeroConf        = "zero conf"
 )
 
 // fieldMismatchError returns a merge error for a named field when we get two
@@ -148,5 +149,14 @@ func mergeResponse(current, new ChannelAcceptResponse) (ChannelAcceptResponse,
 		return current, err
 	}
 
+	// Consent to a zero-conf channel from any acceptor is sufficient, but
+	// it can't be combined with a min depth set by another acceptor.
+	current.ZeroConf = current.ZeroConf || new.ZeroConf
+	if current.ZeroConf && current.MinAcceptDepth != 0 {
+		return current, fieldMismatchError(
+			fieldZeroConf, current.ZeroConf, current.MinAcceptDepth,
+		)
+	}
+
 	return current, nil
 }
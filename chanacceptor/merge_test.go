@@ -167,6 +167,27 @@ func TestMergeResponse(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name: "zero conf consent",
+			current: ChannelAcceptResponse{
+				ZeroConf: true,
+			},
+			new: ChannelAcceptResponse{},
+			merged: ChannelAcceptResponse{
+				ZeroConf: true,
+			},
+			err: nil,
+		},
+		{
+			name: "zero conf and depth",
+			current: ChannelAcceptResponse{
+				ZeroConf: true,
+			},
+			new: ChannelAcceptResponse{
+				MinAcceptDepth: 3,
+			},
+			err: fieldMismatchError(fieldZeroConf, true, uint16(3)),
+		},
 	}
 
 	for _, test := range tests {
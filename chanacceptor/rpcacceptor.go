@@ -43,6 +43,11 @@ var (
 	errMaxHtlcTooHigh = fmt.Errorf("htlc limit exceeds spec limit of: %v",
 		input.MaxHTLCNumber/2)
 
+	// errZeroConfMinDepth is returned when a response consents to a
+	// zero-conf channel, but also requires confirmations.
+	errZeroConfMinDepth = errors.New("zero-conf channel can't have a " +
+		"min accept depth")
+
 	// maxErrorLength is the maximum error length we allow the error we
 	// send to our peer to be.
 	maxErrorLength = 500
@@ -107,7 +112,7 @@ func (r *RPCAcceptor) Accept(req *ChannelAcceptRequest) *ChannelAcceptResponse {
 	// Create a rejection response which we can use for the cases where we
 	// reject the channel.
 	rejectChannel := NewChannelAcceptResponse(
-		false, errChannelRejected, nil, 0, 0, 0, 0, 0, 0,
+		false, errChannelRejected, nil, 0, 0, 0, 0, 0, 0, false,
 	)
 
 	// Send the request to the newRequests channel.
@@ -216,6 +221,7 @@ func (r *RPCAcceptor) receiveResponses(errChan chan error,
 			MaxHtlcCount:    resp.MaxHtlcCount,
 			MinHtlcIn:       resp.MinHtlcIn,
 			MinAcceptDepth:  resp.MinAcceptDepth,
+			ZeroConf:        resp.ZeroConf,
 		}
 
 		// We have received a decision for one of our channel
@@ -273,6 +279,7 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 				CsvDelay:         uint32(req.OpenChanMsg.CsvDelay),
 				MaxAcceptedHtlcs: uint32(req.OpenChanMsg.MaxAcceptedHTLCs),
 				ChannelFlags:     uint32(req.OpenChanMsg.ChannelFlags),
+				WantsZeroConf:    req.OpenChanMsg.ChannelType.IsZeroConf(),
 			}
 
 			if err := r.send(chanAcceptReq); err != nil {
@@ -311,6 +318,7 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 				btcutil.Amount(resp.ReserveSat),
 				lnwire.MilliSatoshi(resp.InFlightMaxMsat),
 				lnwire.MilliSatoshi(resp.MinHtlcIn),
+				resp.ZeroConf,
 			)
 
 			// Delete the channel from the acceptRequests map.
@@ -360,6 +368,15 @@ func (r *RPCAcceptor) validateAcceptorResponse(dustLimit btcutil.Amount,
 		return false, errChannelRejected, nil, errInsufficientReserve
 	}
 
+	// A zero-conf channel is usable right away, so requiring any
+	// confirmations contradicts the consent.
+	if req.ZeroConf && req.MinAcceptDepth != 0 {
+		log.Errorf("Zero-conf channel: %v can't require %v "+
+			"confirmations", channelStr, req.MinAcceptDepth)
+
+		return false, errChannelRejected, nil, errZeroConfMinDepth
+	}
+
 	// Attempt to parse the upfront shutdown address provided.
 	upfront, err := chancloser.ParseUpfrontShutdownAddress(
 		req.UpfrontShutdown, r.params,
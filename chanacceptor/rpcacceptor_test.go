@@ -115,6 +115,27 @@ func TestValidateAcceptorResponse(t *testing.T) {
 			acceptorErr: errChannelRejected,
 			error:       errMaxHtlcTooHigh,
 		},
+		{
+			name: "zero-conf with min depth",
+			response: lnrpc.ChannelAcceptResponse{
+				Accept:         true,
+				ZeroConf:       true,
+				MinAcceptDepth: 1,
+			},
+			accept:      false,
+			acceptorErr: errChannelRejected,
+			error:       errZeroConfMinDepth,
+		},
+		{
+			name: "accepted zero-conf",
+			response: lnrpc.ChannelAcceptResponse{
+				Accept:   true,
+				ZeroConf: true,
+			},
+			accept:      true,
+			acceptorErr: nil,
+			error:       nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -257,6 +257,11 @@ const (
 	// ZeroHtlcTxFeeBit indicates that the channel should use zero-fee
 	// second-level HTLC transactions.
 	ZeroHtlcTxFeeBit ChannelType = 1 << 5
+
+	// ZeroConfBit indicates that the channel is a zero-conf channel, one
+	// that was marked open under an alias short channel ID before its
+	// funding transaction confirmed.
+	ZeroConfBit ChannelType = 1 << 6
 )
 
 // IsSingleFunder returns true if the channel type if one of the known single
@@ -294,6 +299,12 @@ func (c ChannelType) ZeroHtlcTxFee() bool {
 	return c&ZeroHtlcTxFeeBit == ZeroHtlcTxFeeBit
 }
 
+// IsZeroConf returns true if the channel is a zero-conf channel that was
+// usable before its funding transaction confirmed.
+func (c ChannelType) IsZeroConf() bool {
+	return c&ZeroConfBit == ZeroConfBit
+}
+
 // IsFrozen returns true if the channel is considered to be "frozen". A frozen
 // channel means that only the responder can initiate a cooperative channel
 // closure.
@@ -992,6 +1003,47 @@ func (c *OpenChannel) MarkAsOpen(openLoc lnwire.ShortChannelID) error {
 	return nil
 }
 
+// UpdateFundingTx stores the final funding transaction of a pending channel
+// that was funded without it, such as a channel of a batch that is signed and
+// published by the batch funder. From now on the channel is treated as if we
+// had the funding transaction from the start, so it is rebroadcast on startup.
+func (c *OpenChannel) UpdateFundingTx(fundingTx *wire.MsgTx) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if fundingTx.TxHash() != c.FundingOutpoint.Hash {
+		return fmt.Errorf("funding tx %v doesn't match "+
+			"ChannelPoint(%v)", fundingTx.TxHash(),
+			c.FundingOutpoint)
+	}
+
+	if err := kvdb.Update(c.Db, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		channel.ChanType &^= NoFundingTxBit
+		channel.FundingTxn = fundingTx
+
+		return putOpenChannel(chanBucket.(kvdb.RwBucket), channel)
+	}, func() {}); err != nil {
+		return err
+	}
+
+	c.ChanType &^= NoFundingTxBit
+	c.FundingTxn = fundingTx
+
+	return nil
+}
+
 // MarkDataLoss marks sets the channel status to LocalDataLoss and stores the
 // passed commitPoint for use to retrieve funds in case the remote force closes
 // the channel.
@@ -1064,11 +1116,11 @@ func (c *OpenChannel) MarkBorked() error {
 // commitment chains in the case of a last or only partially processed message.
 // When the remote party receiver this message one of three things may happen:
 //
-//   1. We're fully synced and no messages need to be sent.
-//   2. We didn't get the last CommitSig message they sent, to they'll re-send
-//      it.
-//   3. We didn't get the last RevokeAndAck message they sent, so they'll
-//      re-send it.
+//  1. We're fully synced and no messages need to be sent.
+//  2. We didn't get the last CommitSig message they sent, to they'll re-send
+//     it.
+//  3. We didn't get the last RevokeAndAck message they sent, so they'll
+//     re-send it.
 //
 // If this is a restored channel, having status ChanStatusRestored, then we'll
 // modify our typical chan sync message to ensure they force close even if
@@ -2725,6 +2777,11 @@ const (
 	// any further actions. This is intended to clean up unusable
 	// channels during development.
 	Abandoned ClosureType = 5
+
+	// FundingDoubleSpent indicates that the channel never was fully
+	// opened, as one of the inputs of its funding transaction was spent by
+	// a conflicting transaction that confirmed.
+	FundingDoubleSpent ClosureType = 6
 )
 
 // ChannelCloseSummary contains the final state of a channel at the point it
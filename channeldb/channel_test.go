@@ -1230,6 +1230,37 @@ func TestRefreshShortChanID(t *testing.T) {
 	}
 }
 
+// TestUpdateFundingTx asserts that the funding transaction of a channel that
+// was funded without it is stored by UpdateFundingTx.
+func TestUpdateFundingTx(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := MakeTestDB()
+	require.NoError(t, err)
+	defer cleanUp()
+
+	fundingTx := channels.TestFundingTx
+	state := createTestChannel(t, cdb, func(params *testChannelParams) {
+		params.channel.ChanType |= NoFundingTxBit
+		params.channel.FundingOutpoint.Hash = fundingTx.TxHash()
+	})
+
+	// A transaction that doesn't create the funding output is rejected.
+	err = state.UpdateFundingTx(wire.NewMsgTx(2))
+	require.Error(t, err)
+
+	require.NoError(t, state.UpdateFundingTx(fundingTx))
+
+	// The channel now has the funding transaction, both in memory and on
+	// disk.
+	require.True(t, state.ChanType.HasFundingTx())
+
+	channel, err := cdb.FetchChannel(state.FundingOutpoint)
+	require.NoError(t, err)
+	require.True(t, channel.ChanType.HasFundingTx())
+	require.Equal(t, fundingTx.TxHash(), channel.FundingTxn.TxHash())
+}
+
 // TestCloseInitiator tests the setting of close initiator statuses for
 // cooperative closes and local force closes.
 func TestCloseInitiator(t *testing.T) {
@@ -42,6 +42,29 @@ func readOutpoint(r io.Reader, o *wire.OutPoint) error {
 	return nil
 }
 
+// WriteVarOutpoint writes an outpoint to the passed writer, prefixing its hash
+// with a var int length. This is not the same as writeOutpoint, but is the
+// encoding used by the retribution store and the legacy utxo nursery.
+func WriteVarOutpoint(w io.Writer, o *wire.OutPoint) error {
+	if err := wire.WriteVarBytes(w, 0, o.Hash[:]); err != nil {
+		return err
+	}
+
+	return binary.Write(w, byteOrder, o.Index)
+}
+
+// ReadVarOutpoint reads an outpoint from the passed reader that was previously
+// written using WriteVarOutpoint.
+func ReadVarOutpoint(r io.Reader, o *wire.OutPoint) error {
+	txid, err := wire.ReadVarBytes(r, 0, chainhash.HashSize, "prevout")
+	if err != nil {
+		return err
+	}
+	copy(o.Hash[:], txid)
+
+	return binary.Read(r, byteOrder, &o.Index)
+}
+
 // UnknownElementType is an error returned when the codec is unable to encode or
 // decode a particular type.
 type UnknownElementType struct {
@@ -19,6 +19,8 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb/migration16"
 	"github.com/lightningnetwork/lnd/channeldb/migration20"
 	"github.com/lightningnetwork/lnd/channeldb/migration21"
+	"github.com/lightningnetwork/lnd/channeldb/migration23"
+	"github.com/lightningnetwork/lnd/channeldb/migration24"
 	"github.com/lightningnetwork/lnd/channeldb/migration_01_to_11"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -195,6 +197,20 @@ var (
 			number:    22,
 			migration: mig.CreateTLB(setIDIndexBucket),
 		},
+		{
+			// Index the existing invoices by their creation date,
+			// settle date and state, so that searches don't need
+			// to read all invoices.
+			number:    23,
+			migration: migration23.MigrateInvoiceSearchIndexes,
+		},
+		{
+			// Hand the outputs of the legacy utxo nursery over to
+			// the sweeper and the contract resolvers, and remove
+			// the nursery store.
+			number:    24,
+			migration: migration24.MigrateNurseryStore,
+		},
 	}
 
 	// Big endian is the preferred byte order, due to cursor scans over
@@ -322,6 +338,7 @@ var topLevelBuckets = [][]byte{
 	openChannelBucket,
 	closedChannelBucket,
 	forwardingLogBucket,
+	htlcEventLogBucket,
 	fwdPackagesKey,
 	invoiceBucket,
 	payAddrIndexBucket,
@@ -309,6 +309,195 @@ func (f *ForwardingLog) Query(q ForwardingEventQuery) (ForwardingLogTimeSlice, e
 	return resp, nil
 }
 
+// ForwardingStatsQuery represents a query for aggregated statistics of the
+// forwarding log payment circuit time series database.
+type ForwardingStatsQuery struct {
+	// StartTime is the start time of the time slice.
+	StartTime time.Time
+
+	// EndTime is the end time of the time slice.
+	EndTime time.Time
+
+	// Interval is the duration of the time buckets that the statistics
+	// are grouped into. Buckets are aligned to multiples of the interval
+	// since the zero time, so daily buckets start at midnight UTC and
+	// weekly buckets start on Monday. If the interval is zero, a single
+	// bucket covering the whole time slice is returned.
+	Interval time.Duration
+}
+
+// ForwardingStats holds the forwarding totals of a single channel in both
+// directions.
+type ForwardingStats struct {
+	// NumIncoming is the number of forwards that entered through the
+	// channel.
+	NumIncoming uint64
+
+	// AmtIn is the total amount of the incoming htlcs of the forwards that
+	// entered through the channel.
+	AmtIn lnwire.MilliSatoshi
+
+	// FeeIn is the total fee earned by the forwards that entered through
+	// the channel.
+	FeeIn lnwire.MilliSatoshi
+
+	// NumOutgoing is the number of forwards that left through the channel.
+	NumOutgoing uint64
+
+	// AmtOut is the total amount of the outgoing htlcs of the forwards
+	// that left through the channel.
+	AmtOut lnwire.MilliSatoshi
+
+	// FeeOut is the total fee earned by the forwards that left through the
+	// channel.
+	FeeOut lnwire.MilliSatoshi
+}
+
+// ForwardingStatsBucket holds the aggregated forwarding statistics of a time
+// bucket.
+type ForwardingStatsBucket struct {
+	// StartTime is the start time of the bucket.
+	StartTime time.Time
+
+	// EndTime is the end time of the bucket. Events at exactly this time
+	// belong to the next bucket.
+	EndTime time.Time
+
+	// NumForwards is the total number of forwards within the bucket.
+	NumForwards uint64
+
+	// AmtIn is the total amount of the incoming htlcs within the bucket.
+	AmtIn lnwire.MilliSatoshi
+
+	// AmtOut is the total amount of the outgoing htlcs within the bucket.
+	// Subtracting this from AmtIn gives the total fees earned.
+	AmtOut lnwire.MilliSatoshi
+
+	// Channels holds the forwarding totals of every channel that was
+	// used by a forward within the bucket.
+	Channels map[lnwire.ShortChannelID]*ForwardingStats
+}
+
+// add adds a forwarding event to the totals of the bucket.
+func (b *ForwardingStatsBucket) add(event *ForwardingEvent) {
+	fee := event.AmtIn - event.AmtOut
+
+	b.NumForwards++
+	b.AmtIn += event.AmtIn
+	b.AmtOut += event.AmtOut
+
+	incoming := b.channel(event.IncomingChanID)
+	incoming.NumIncoming++
+	incoming.AmtIn += event.AmtIn
+	incoming.FeeIn += fee
+
+	outgoing := b.channel(event.OutgoingChanID)
+	outgoing.NumOutgoing++
+	outgoing.AmtOut += event.AmtOut
+	outgoing.FeeOut += fee
+}
+
+// channel returns the forwarding totals of the given channel, creating them
+// if needed.
+func (b *ForwardingStatsBucket) channel(
+	chanID lnwire.ShortChannelID) *ForwardingStats {
+
+	stats, ok := b.Channels[chanID]
+	if !ok {
+		stats = &ForwardingStats{}
+		b.Channels[chanID] = stats
+	}
+
+	return stats
+}
+
+// Stats aggregates the forwarding events of a particular time slice into
+// per-channel totals, grouped into time buckets. As opposed to Query, the
+// events are never held in memory all at once, which makes it suitable for
+// large time slices. Only buckets that contain at least one event are
+// returned, ordered by their start time, unless the query has no interval.
+func (f *ForwardingLog) Stats(
+	q ForwardingStatsQuery) ([]*ForwardingStatsBucket, error) {
+
+	var buckets []*ForwardingStatsBucket
+
+	err := kvdb.View(f.db, func(tx kvdb.RTx) error {
+		logBucket := tx.ReadBucket(forwardingLogBucket)
+		if logBucket == nil {
+			return ErrNoForwardingEvents
+		}
+
+		var startTime, endTime [8]byte
+		byteOrder.PutUint64(startTime[:], uint64(q.StartTime.UnixNano()))
+		byteOrder.PutUint64(endTime[:], uint64(q.EndTime.UnixNano()))
+
+		var current *ForwardingStatsBucket
+
+		logCursor := logBucket.ReadCursor()
+		timestamp, events := logCursor.Seek(startTime[:])
+		for ; timestamp != nil && bytes.Compare(timestamp, endTime[:]) <= 0; timestamp, events = logCursor.Next() {
+			eventTime := time.Unix(
+				0, int64(byteOrder.Uint64(timestamp)),
+			)
+
+			// The events are ordered by time, so we only need to
+			// start a new bucket once we've moved past the end of
+			// the current one.
+			if current == nil || (q.Interval != 0 &&
+				!eventTime.Before(current.EndTime)) {
+
+				current = newStatsBucket(q, eventTime)
+				buckets = append(buckets, current)
+			}
+
+			readBuf := bytes.NewReader(events)
+			for readBuf.Len() != 0 {
+				var event ForwardingEvent
+				err := decodeForwardingEvent(readBuf, &event)
+				if err != nil {
+					return err
+				}
+
+				current.add(&event)
+			}
+		}
+
+		return nil
+	}, func() {
+		buckets = nil
+	})
+	if err != nil && err != ErrNoForwardingEvents {
+		return nil, err
+	}
+
+	// Without an interval, the totals of the whole time slice are
+	// returned, even if there were no events at all.
+	if q.Interval == 0 && len(buckets) == 0 {
+		buckets = append(buckets, newStatsBucket(q, q.StartTime))
+	}
+
+	return buckets, nil
+}
+
+// newStatsBucket creates the empty statistics bucket of the query that the
+// given time falls into.
+func newStatsBucket(q ForwardingStatsQuery,
+	t time.Time) *ForwardingStatsBucket {
+
+	bucket := &ForwardingStatsBucket{
+		StartTime: q.StartTime,
+		EndTime:   q.EndTime,
+		Channels:  make(map[lnwire.ShortChannelID]*ForwardingStats),
+	}
+
+	if q.Interval != 0 {
+		bucket.StartTime = t.Truncate(q.Interval)
+		bucket.EndTime = bucket.StartTime.Add(q.Interval)
+	}
+
+	return bucket
+}
+
 // makeUniqueTimestamps takes a slice of forwarding events, sorts it by the
 // event timestamps and then makes sure there are no duplicates in the
 // timestamps. If duplicates are found, some of the timestamps are increased on
@@ -381,3 +381,118 @@ func TestForwardingLogStoreEvent(t *testing.T) {
 		}
 	}
 }
+
+// TestForwardingLogStats tests that forwarding events are aggregated into
+// per-channel totals and grouped into time buckets.
+func TestForwardingLogStats(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := MakeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+	defer cleanUp()
+
+	log := ForwardingLog{
+		db: db,
+	}
+
+	chanA := lnwire.NewShortChanIDFromInt(1)
+	chanB := lnwire.NewShortChanIDFromInt(2)
+	chanC := lnwire.NewShortChanIDFromInt(3)
+
+	// Two forwards from A to B happen in the first hour, a forward from B
+	// to C happens in the third hour.
+	startTime := time.Unix(0, 0).Add(time.Hour * 24 * 1000)
+	events := []ForwardingEvent{
+		{
+			Timestamp:      startTime.Add(time.Minute),
+			IncomingChanID: chanA,
+			OutgoingChanID: chanB,
+			AmtIn:          1100,
+			AmtOut:         1000,
+		},
+		{
+			Timestamp:      startTime.Add(time.Minute * 30),
+			IncomingChanID: chanA,
+			OutgoingChanID: chanB,
+			AmtIn:          2200,
+			AmtOut:         2000,
+		},
+		{
+			Timestamp:      startTime.Add(time.Hour * 2),
+			IncomingChanID: chanB,
+			OutgoingChanID: chanC,
+			AmtIn:          5050,
+			AmtOut:         5000,
+		},
+	}
+	if err := log.AddForwardingEvents(events); err != nil {
+		t.Fatalf("unable to add events: %v", err)
+	}
+
+	// Without an interval, all events are aggregated into a single bucket.
+	endTime := startTime.Add(time.Hour * 3)
+	buckets, err := log.Stats(ForwardingStatsQuery{
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		t.Fatalf("unable to query stats: %v", err)
+	}
+	assert.Len(t, buckets, 1)
+	assert.Equal(t, startTime, buckets[0].StartTime)
+	assert.Equal(t, endTime, buckets[0].EndTime)
+	assert.Equal(t, uint64(3), buckets[0].NumForwards)
+	assert.Equal(t, lnwire.MilliSatoshi(8350), buckets[0].AmtIn)
+	assert.Equal(t, lnwire.MilliSatoshi(8000), buckets[0].AmtOut)
+	assert.Equal(t, &ForwardingStats{
+		NumIncoming: 1,
+		AmtIn:       5050,
+		FeeIn:       50,
+		NumOutgoing: 2,
+		AmtOut:      3000,
+		FeeOut:      300,
+	}, buckets[0].Channels[chanB])
+
+	// With an hourly interval, the empty second hour is skipped.
+	buckets, err = log.Stats(ForwardingStatsQuery{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Interval:  time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("unable to query stats: %v", err)
+	}
+	assert.Len(t, buckets, 2)
+
+	assert.Equal(t, startTime, buckets[0].StartTime)
+	assert.Equal(t, startTime.Add(time.Hour), buckets[0].EndTime)
+	assert.Equal(t, uint64(2), buckets[0].NumForwards)
+	assert.Equal(t, &ForwardingStats{
+		NumIncoming: 2,
+		AmtIn:       3300,
+		FeeIn:       300,
+	}, buckets[0].Channels[chanA])
+	assert.NotContains(t, buckets[0].Channels, chanC)
+
+	assert.Equal(t, startTime.Add(time.Hour*2), buckets[1].StartTime)
+	assert.Equal(t, uint64(1), buckets[1].NumForwards)
+	assert.Equal(t, &ForwardingStats{
+		NumOutgoing: 1,
+		AmtOut:      5000,
+		FeeOut:      50,
+	}, buckets[1].Channels[chanC])
+
+	// A time slice without events results in a single empty bucket if
+	// there's no interval.
+	buckets, err = log.Stats(ForwardingStatsQuery{
+		StartTime: endTime,
+		EndTime:   endTime.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("unable to query stats: %v", err)
+	}
+	assert.Len(t, buckets, 1)
+	assert.Zero(t, buckets[0].NumForwards)
+}
@@ -0,0 +1,289 @@
+package channeldb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// htlcEventLogBucket is the bucket that we'll use to store the htlc
+	// event log. The htlc event log contains the htlc events that the
+	// switch reports. Each key within the bucket is the 8-byte big endian
+	// sequence number of an event, and the value the serialized event.
+	htlcEventLogBucket = []byte("htlc-event-log")
+)
+
+// HtlcEventKind describes the kind of an htlc event in the htlc event log.
+type HtlcEventKind uint8
+
+const (
+	// HtlcEventForward is an htlc that was forwarded onwards from our
+	// node.
+	HtlcEventForward HtlcEventKind = iota
+
+	// HtlcEventForwardFail is an htlc that failed down the line after we
+	// forwarded it.
+	HtlcEventForwardFail
+
+	// HtlcEventLinkFail is an htlc that failed on our incoming or outgoing
+	// link.
+	HtlcEventLinkFail
+
+	// HtlcEventSettle is an htlc that was settled.
+	HtlcEventSettle
+)
+
+// HtlcEvent is an entry of the htlc event log. The fields that are not
+// relevant for the kind of the event are left zero.
+type HtlcEvent struct {
+	// Index is the sequence number of the event. It is assigned when the
+	// event is added to the log, and strictly increases.
+	Index uint64
+
+	// Kind is the kind of the event.
+	Kind HtlcEventKind
+
+	// Timestamp is the time when the event occurred.
+	Timestamp time.Time
+
+	// HtlcType classifies the htlc as part of a send, receive or forward.
+	// The values are defined by the htlcswitch.
+	HtlcType uint8
+
+	// IncomingCircuit is the incoming channel and htlc id of the htlc.
+	IncomingCircuit CircuitKey
+
+	// OutgoingCircuit is the outgoing channel and htlc id of the htlc.
+	OutgoingCircuit CircuitKey
+
+	// IncomingTimeLock is the time lock of the htlc on the incoming
+	// channel.
+	IncomingTimeLock uint32
+
+	// OutgoingTimeLock is the time lock of the htlc on the outgoing
+	// channel.
+	OutgoingTimeLock uint32
+
+	// IncomingAmt is the amount of the htlc on the incoming channel.
+	IncomingAmt lnwire.MilliSatoshi
+
+	// OutgoingAmt is the amount of the htlc on the outgoing channel.
+	OutgoingAmt lnwire.MilliSatoshi
+
+	// Incoming is true for link failures that occurred on the incoming
+	// link.
+	Incoming bool
+
+	// FailureMessage is the serialized wire failure of a link failure.
+	FailureMessage []byte
+
+	// FailureDetailType identifies the type of the failure detail of a
+	// link failure. The values are defined by the htlcswitch.
+	FailureDetailType uint8
+
+	// FailureDetail is the failure detail of a link failure.
+	FailureDetail uint16
+}
+
+// encodeHtlcEvent writes out the target htlc event to the passed io.Writer.
+// Note that the index isn't serialized as this will be the key within the
+// bucket.
+func encodeHtlcEvent(w io.Writer, e *HtlcEvent) error {
+	return WriteElements(
+		w, uint8(e.Kind), uint64(e.Timestamp.UnixNano()), e.HtlcType,
+		e.IncomingCircuit.ChanID, e.IncomingCircuit.HtlcID,
+		e.OutgoingCircuit.ChanID, e.OutgoingCircuit.HtlcID,
+		e.IncomingTimeLock, e.OutgoingTimeLock, e.IncomingAmt,
+		e.OutgoingAmt, e.Incoming, e.FailureMessage,
+		e.FailureDetailType, e.FailureDetail,
+	)
+}
+
+// decodeHtlcEvent decodes a serialized htlc event. Note that the index won't
+// be decoded, as the caller is expected to set it from the key.
+func decodeHtlcEvent(r io.Reader) (*HtlcEvent, error) {
+	var (
+		e         HtlcEvent
+		kind      uint8
+		timestamp uint64
+	)
+	err := ReadElements(
+		r, &kind, &timestamp, &e.HtlcType,
+		&e.IncomingCircuit.ChanID, &e.IncomingCircuit.HtlcID,
+		&e.OutgoingCircuit.ChanID, &e.OutgoingCircuit.HtlcID,
+		&e.IncomingTimeLock, &e.OutgoingTimeLock, &e.IncomingAmt,
+		&e.OutgoingAmt, &e.Incoming, &e.FailureMessage,
+		&e.FailureDetailType, &e.FailureDetail,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	e.Kind = HtlcEventKind(kind)
+	e.Timestamp = time.Unix(0, int64(timestamp))
+
+	return &e, nil
+}
+
+// HtlcEventLog is a bounded log of the htlc events that the switch reports.
+// Every event is assigned a sequence number, so that clients can catch up on
+// the events that they missed. Once the log holds more than the maximum
+// number of events, the oldest events are pruned.
+type HtlcEventLog struct {
+	db *DB
+
+	// maxEvents is the maximum number of events that are kept in the log.
+	// A value of zero doesn't limit the size of the log.
+	maxEvents uint64
+}
+
+// HtlcEventLog returns an instance of the HtlcEventLog object backed by the
+// target database instance. The log keeps at most maxEvents events.
+func (d *DB) HtlcEventLog(maxEvents uint64) *HtlcEventLog {
+	return &HtlcEventLog{
+		db:        d,
+		maxEvents: maxEvents,
+	}
+}
+
+// AddEvents adds a series of htlc events to the log and assigns them their
+// sequence numbers. If the log grows beyond its maximum size, the oldest
+// events are pruned.
+func (h *HtlcEventLog) AddEvents(events []*HtlcEvent) error {
+	return kvdb.Update(h.db, func(tx kvdb.RwTx) error {
+		logBucket, err := tx.CreateTopLevelBucket(htlcEventLogBucket)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			index, err := logBucket.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			// The key and value must remain valid for the lifetime
+			// of the transaction, so we don't reuse them.
+			var b bytes.Buffer
+			if err := encodeHtlcEvent(&b, event); err != nil {
+				return err
+			}
+
+			var key [8]byte
+			binary.BigEndian.PutUint64(key[:], index)
+			if err := logBucket.Put(key[:], b.Bytes()); err != nil {
+				return err
+			}
+
+			event.Index = index
+		}
+
+		return h.prune(logBucket)
+	}, func() {
+		for _, event := range events {
+			event.Index = 0
+		}
+	})
+}
+
+// prune removes the oldest events from the log until it holds no more than
+// the maximum number of events.
+func (h *HtlcEventLog) prune(logBucket kvdb.RwBucket) error {
+	if h.maxEvents == 0 {
+		return nil
+	}
+
+	// As sequence numbers are assigned without gaps and only the oldest
+	// events are ever removed, the number of events in the log follows
+	// from the first and the last sequence number.
+	lastIndex := logBucket.Sequence()
+
+	var staleKeys [][]byte
+	cursor := logBucket.ReadCursor()
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+		index := binary.BigEndian.Uint64(k)
+		if lastIndex-index < h.maxEvents {
+			break
+		}
+
+		staleKeys = append(staleKeys, k)
+	}
+
+	for _, k := range staleKeys {
+		if err := logBucket.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FetchEvents returns up to maxEvents events with a sequence number larger
+// than afterIndex, ordered by their sequence number. Events that were already
+// pruned from the log are skipped.
+func (h *HtlcEventLog) FetchEvents(afterIndex uint64,
+	maxEvents uint32) ([]*HtlcEvent, error) {
+
+	var events []*HtlcEvent
+	err := kvdb.View(h.db, func(tx kvdb.RTx) error {
+		logBucket := tx.ReadBucket(htlcEventLogBucket)
+		if logBucket == nil {
+			return nil
+		}
+
+		var startKey [8]byte
+		binary.BigEndian.PutUint64(startKey[:], afterIndex+1)
+
+		cursor := logBucket.ReadCursor()
+		for k, v := cursor.Seek(startKey[:]); k != nil; k, v = cursor.Next() {
+			if uint32(len(events)) >= maxEvents {
+				break
+			}
+
+			event, err := decodeHtlcEvent(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+			event.Index = binary.BigEndian.Uint64(k)
+
+			events = append(events, event)
+		}
+
+		return nil
+	}, func() {
+		events = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// LastIndex returns the sequence number of the last event that was added to
+// the log, or zero if no events were added yet.
+func (h *HtlcEventLog) LastIndex() (uint64, error) {
+	var lastIndex uint64
+	err := kvdb.View(h.db, func(tx kvdb.RTx) error {
+		logBucket := tx.ReadBucket(htlcEventLogBucket)
+		if logBucket == nil {
+			return nil
+		}
+
+		k, _ := logBucket.ReadCursor().Last()
+		if k != nil {
+			lastIndex = binary.BigEndian.Uint64(k)
+		}
+
+		return nil
+	}, func() {
+		lastIndex = 0
+	})
+
+	return lastIndex, err
+}
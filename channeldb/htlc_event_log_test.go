@@ -0,0 +1,94 @@
+package channeldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHtlcEventLog tests that htlc events are assigned increasing sequence
+// numbers, can be fetched after a given index and are pruned once the log
+// exceeds its maximum size.
+func TestHtlcEventLog(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := MakeTestDB()
+	require.NoError(t, err)
+	defer cleanUp()
+
+	log := db.HtlcEventLog(5)
+
+	// An empty log has no last index and no events.
+	lastIndex, err := log.LastIndex()
+	require.NoError(t, err)
+	require.Zero(t, lastIndex)
+
+	events, err := log.FetchEvents(0, 10)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	newEvent := func(i int) *HtlcEvent {
+		return &HtlcEvent{
+			Kind:      HtlcEventLinkFail,
+			Timestamp: time.Unix(0, int64(i)),
+			HtlcType:  2,
+			IncomingCircuit: CircuitKey{
+				ChanID: lnwire.NewShortChanIDFromInt(uint64(i)),
+				HtlcID: uint64(i),
+			},
+			OutgoingCircuit: CircuitKey{
+				ChanID: lnwire.NewShortChanIDFromInt(100),
+				HtlcID: 7,
+			},
+			IncomingTimeLock:  200,
+			OutgoingTimeLock:  160,
+			IncomingAmt:       1000,
+			OutgoingAmt:       900,
+			Incoming:          true,
+			FailureMessage:    []byte{0x10, 0x0f},
+			FailureDetailType: 1,
+			FailureDetail:     3,
+		}
+	}
+
+	// Add three events in one batch and one more on its own.
+	added := []*HtlcEvent{newEvent(1), newEvent(2), newEvent(3)}
+	require.NoError(t, log.AddEvents(added))
+	require.NoError(t, log.AddEvents([]*HtlcEvent{newEvent(4)}))
+
+	for i, event := range added {
+		require.Equal(t, uint64(i+1), event.Index)
+	}
+
+	events, err = log.FetchEvents(0, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.Equal(t, added, events[:3])
+	require.Equal(t, uint64(4), events[3].Index)
+
+	// Only the events after the start index are returned, limited to the
+	// maximum number of events.
+	events, err = log.FetchEvents(1, 2)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, uint64(2), events[0].Index)
+	require.Equal(t, uint64(3), events[1].Index)
+
+	// Adding three more events exceeds the size of the log, so the two
+	// oldest events are pruned.
+	require.NoError(t, log.AddEvents(
+		[]*HtlcEvent{newEvent(5), newEvent(6), newEvent(7)},
+	))
+
+	events, err = log.FetchEvents(0, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 5)
+	require.Equal(t, uint64(3), events[0].Index)
+	require.Equal(t, uint64(7), events[4].Index)
+
+	lastIndex, err = log.LastIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), lastIndex)
+}
@@ -0,0 +1,114 @@
+package channeldb
+
+import (
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// InboundFee describes the fee that a node charges for HTLCs that arrive over
+// a particular channel, on top of the outbound fee of the channel the HTLC
+// leaves through. Both components may be negative, in which case the inbound
+// fee acts as a discount that can be used to attract traffic through channels
+// that hold too much inbound liquidity.
+type InboundFee struct {
+	// Base is the fixed inbound fee in msat.
+	Base int32
+
+	// Rate is the proportional inbound fee in parts per million.
+	Rate int32
+}
+
+// NewInboundFeeFromWire constructs an InboundFee from its wire
+// representation.
+func NewInboundFeeFromWire(fee lnwire.Fee) InboundFee {
+	return InboundFee{
+		Base: fee.BaseFee,
+		Rate: fee.FeeRate,
+	}
+}
+
+// ToWire converts the inbound fee to its wire representation.
+func (i InboundFee) ToWire() lnwire.Fee {
+	return lnwire.Fee{
+		BaseFee: i.Base,
+		FeeRate: i.Rate,
+	}
+}
+
+// IsZero returns true if the inbound fee neither charges nor discounts
+// anything.
+func (i InboundFee) IsZero() bool {
+	return i.Base == 0 && i.Rate == 0
+}
+
+// CalcFee calculates the inbound fee for the given amount. The amount is
+// expected to be the total amount that leaves the node, i.e. the outgoing
+// htlc amount plus the outbound fee. The result may be negative.
+func (i InboundFee) CalcFee(amt lnwire.MilliSatoshi) int64 {
+	return int64(i.Base) + int64(i.Rate)*int64(amt)/1000000
+}
+
+// InboundFee returns the inbound fee that the node that created this policy
+// charges for HTLCs arriving over the channel. The fee is carried in the
+// extra opaque data of the policy. If no inbound fee is present, or it can't
+// be parsed, false is returned.
+func (c *ChannelEdgePolicy) InboundFee() (InboundFee, bool) {
+	extraData := lnwire.ExtraOpaqueData(c.ExtraOpaqueData)
+
+	var fee lnwire.Fee
+	tlvs, err := extraData.ExtractRecords(fee.Record())
+	if err != nil {
+		log.Debugf("Unable to parse extra data of policy for "+
+			"channel %v: %v", c.ChannelID, err)
+
+		return InboundFee{}, false
+	}
+
+	if _, ok := tlvs[lnwire.FeeRecordType]; !ok {
+		return InboundFee{}, false
+	}
+
+	return NewInboundFeeFromWire(fee), true
+}
+
+// SetInboundFee stores the given inbound fee in the extra opaque data of the
+// policy. Any other records in the extra data are preserved. A zero inbound
+// fee removes the record altogether.
+func (c *ChannelEdgePolicy) SetInboundFee(inboundFee InboundFee) error {
+	extraData := lnwire.ExtraOpaqueData(c.ExtraOpaqueData)
+
+	// Parse the existing stream, so that we can carry over any records
+	// that we don't know of ourselves.
+	var oldFee lnwire.Fee
+	tlvs, err := extraData.ExtractRecords(oldFee.Record())
+	if err != nil {
+		return err
+	}
+
+	unknownRecords := make(map[uint64][]byte)
+	for typ, value := range tlvs {
+		// Known types are reported with a nil value by the decoder.
+		if value == nil {
+			continue
+		}
+
+		unknownRecords[uint64(typ)] = value
+	}
+
+	records := tlv.MapToRecords(unknownRecords)
+
+	newFee := inboundFee.ToWire()
+	if !inboundFee.IsZero() {
+		records = append(records, newFee.Record())
+	}
+	tlv.SortRecords(records)
+
+	var newExtraData lnwire.ExtraOpaqueData
+	if err := newExtraData.PackRecords(records...); err != nil {
+		return err
+	}
+
+	c.ExtraOpaqueData = newExtraData
+
+	return nil
+}
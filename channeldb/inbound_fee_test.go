@@ -0,0 +1,73 @@
+package channeldb
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInboundFeeCalcFee tests the calculation of (possibly negative) inbound
+// fees.
+func TestInboundFeeCalcFee(t *testing.T) {
+	t.Parallel()
+
+	fee := InboundFee{Base: 5, Rate: 500}
+	require.EqualValues(t, 505, fee.CalcFee(1000000))
+
+	discount := InboundFee{Base: -5, Rate: -500}
+	require.EqualValues(t, -505, discount.CalcFee(1000000))
+
+	require.Zero(t, InboundFee{}.CalcFee(1000000))
+}
+
+// TestChannelEdgePolicyInboundFee tests that an inbound fee can be stored in
+// and retrieved from the extra opaque data of a policy, without disturbing
+// any other records that are present.
+func TestChannelEdgePolicyInboundFee(t *testing.T) {
+	t.Parallel()
+
+	// Start out with a policy that carries an unknown odd record.
+	unknownValue := []byte{1, 2, 3}
+	var extraData lnwire.ExtraOpaqueData
+	err := extraData.PackRecords(tlv.MapToRecords(map[uint64][]byte{
+		65537: unknownValue,
+	})...)
+	require.NoError(t, err)
+
+	policy := &ChannelEdgePolicy{
+		ChannelID:       1,
+		ExtraOpaqueData: extraData,
+	}
+
+	// Initially, no inbound fee should be reported.
+	_, ok := policy.InboundFee()
+	require.False(t, ok)
+
+	// Set an inbound discount and read it back.
+	inboundFee := InboundFee{Base: -1000, Rate: -100}
+	require.NoError(t, policy.SetInboundFee(inboundFee))
+
+	fee, ok := policy.InboundFee()
+	require.True(t, ok)
+	require.Equal(t, inboundFee, fee)
+
+	// The unknown record must have been carried over.
+	storedData := lnwire.ExtraOpaqueData(policy.ExtraOpaqueData)
+	tlvs, err := storedData.ExtractRecords()
+	require.NoError(t, err)
+	require.Equal(t, unknownValue, tlvs[65537])
+
+	// Setting a zero inbound fee removes the record, but still keeps the
+	// unknown one.
+	require.NoError(t, policy.SetInboundFee(InboundFee{}))
+
+	_, ok = policy.InboundFee()
+	require.False(t, ok)
+
+	storedData = lnwire.ExtraOpaqueData(policy.ExtraOpaqueData)
+	tlvs, err = storedData.ExtractRecords()
+	require.NoError(t, err)
+	require.Equal(t, unknownValue, tlvs[65537])
+}
@@ -0,0 +1,429 @@
+package channeldb
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+)
+
+var (
+	// creationDateIndexBucket is the name of the sub-bucket within the
+	// invoiceBucket which indexes all invoices by their creation date. It
+	// allows invoices that were created within a date range to be queried
+	// without deserializing all invoices.
+	//
+	// maps: creationNanos || addIndex => invoiceKey
+	creationDateIndexBucket = []byte("invoice-creation-date-index")
+
+	// settleDateIndexBucket is the name of the sub-bucket within the
+	// invoiceBucket which indexes all settled invoices by their settle
+	// date.
+	//
+	// maps: settleNanos || addIndex => invoiceKey
+	settleDateIndexBucket = []byte("invoice-settle-date-index")
+
+	// invoiceStateIndexBucket is the name of the sub-bucket within the
+	// invoiceBucket which indexes all invoices by their state. It allows
+	// invoices in a given state to be queried without deserializing all
+	// invoices.
+	//
+	// maps: state || addIndex => invoiceKey
+	invoiceStateIndexBucket = []byte("invoice-state-index")
+
+	// invoiceHashIndexBucket is the name of the sub-bucket within the
+	// invoiceBucket which maps all invoices to their payment hash. The
+	// invoices don't store their payment hash, which is needed to delete
+	// the invoices that are found through the other search indexes.
+	//
+	// maps: invoiceKey => payHash
+	invoiceHashIndexBucket = []byte("invoice-hash-index")
+)
+
+// dateIndexKey returns the key of an invoice within one of the date indexes.
+// The date comes first, so that a cursor iterates the invoices in date order.
+// The add index makes the key unique for invoices with the same date.
+func dateIndexKey(date time.Time, addIndex uint64) []byte {
+	var key [16]byte
+	byteOrder.PutUint64(key[:8], putNanoTime(date))
+	byteOrder.PutUint64(key[8:], addIndex)
+
+	return key[:]
+}
+
+// putDateIndex adds an invoice to a date index.
+func putDateIndex(dateIndex kvdb.RwBucket, date time.Time, addIndex uint64,
+	invoiceKey []byte) error {
+
+	return dateIndex.Put(
+		dateIndexKey(date, addIndex), copySlice(invoiceKey),
+	)
+}
+
+// stateIndexKey returns the key of an invoice within the state index. The
+// state comes first, so that all invoices in a state share a key prefix.
+func stateIndexKey(state ContractState, addIndex uint64) []byte {
+	var key [9]byte
+	key[0] = byte(state)
+	byteOrder.PutUint64(key[1:], addIndex)
+
+	return key[:]
+}
+
+// putStateIndex adds an invoice to the state index, creating the index if it
+// doesn't exist yet.
+func putStateIndex(invoices kvdb.RwBucket, state ContractState,
+	addIndex uint64, invoiceKey []byte) error {
+
+	stateIndex, err := invoices.CreateBucketIfNotExists(
+		invoiceStateIndexBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	return stateIndex.Put(
+		stateIndexKey(state, addIndex), copySlice(invoiceKey),
+	)
+}
+
+// moveStateIndex moves an invoice within the state index after its state
+// changed.
+func moveStateIndex(invoices kvdb.RwBucket, oldState ContractState,
+	invoice *Invoice, invoiceKey []byte) error {
+
+	stateIndex := invoices.NestedReadWriteBucket(invoiceStateIndexBucket)
+	if stateIndex != nil {
+		err := stateIndex.Delete(
+			stateIndexKey(oldState, invoice.AddIndex),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return putStateIndex(
+		invoices, invoice.State, invoice.AddIndex, invoiceKey,
+	)
+}
+
+// deleteSearchIndexes removes an invoice from the date and state indexes. The
+// indexes may be nil, as they are created lazily.
+func deleteSearchIndexes(creationIndex, settleIndex,
+	stateIndex kvdb.RwBucket, invoice *Invoice) error {
+
+	if creationIndex != nil {
+		err := creationIndex.Delete(
+			dateIndexKey(invoice.CreationDate, invoice.AddIndex),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if settleIndex != nil && invoice.SettleIndex > 0 {
+		err := settleIndex.Delete(
+			dateIndexKey(invoice.SettleDate, invoice.AddIndex),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if stateIndex != nil {
+		err := stateIndex.Delete(
+			stateIndexKey(invoice.State, invoice.AddIndex),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasDateRange returns true if the query restricts the creation or settle
+// date of the returned invoices.
+func (q *InvoiceQuery) hasDateRange() bool {
+	return !q.CreationDateStart.IsZero() || !q.CreationDateEnd.IsZero() ||
+		!q.SettleDateStart.IsZero() || !q.SettleDateEnd.IsZero()
+}
+
+// indexedStates returns the states that the query restricts the returned
+// invoices to, or nil if invoices in any state are returned.
+func (q *InvoiceQuery) indexedStates() []ContractState {
+	switch {
+	case len(q.States) > 0:
+		return q.States
+
+	case q.PendingOnly:
+		return []ContractState{ContractOpen, ContractAccepted}
+
+	default:
+		return nil
+	}
+}
+
+// inDateRange returns true if the date lies within the range. A zero start or
+// end leaves the range open at that side.
+func inDateRange(date, start, end time.Time) bool {
+	if !start.IsZero() && date.Before(start) {
+		return false
+	}
+
+	if !end.IsZero() && date.After(end) {
+		return false
+	}
+
+	return true
+}
+
+// matches returns true if the invoice satisfies all filters of the query.
+func (q *InvoiceQuery) matches(invoice *Invoice) bool {
+	if q.PendingOnly && !invoice.IsPending() {
+		return false
+	}
+
+	if len(q.States) > 0 {
+		var stateMatch bool
+		for _, state := range q.States {
+			if invoice.State == state {
+				stateMatch = true
+				break
+			}
+		}
+
+		if !stateMatch {
+			return false
+		}
+	}
+
+	if !inDateRange(invoice.CreationDate, q.CreationDateStart,
+		q.CreationDateEnd) {
+
+		return false
+	}
+
+	// Unsettled invoices have no settle date, so they never match a settle
+	// date range.
+	if !q.SettleDateStart.IsZero() || !q.SettleDateEnd.IsZero() {
+		if invoice.SettleIndex == 0 || !inDateRange(
+			invoice.SettleDate, q.SettleDateStart, q.SettleDateEnd,
+		) {
+
+			return false
+		}
+	}
+
+	if q.MemoContains != "" && !strings.Contains(
+		strings.ToLower(string(invoice.Memo)),
+		strings.ToLower(q.MemoContains),
+	) {
+
+		return false
+	}
+
+	if invoice.Terms.Value < q.MinAmt {
+		return false
+	}
+
+	if q.MaxAmt != 0 && invoice.Terms.Value > q.MaxAmt {
+		return false
+	}
+
+	if q.HtlcCustomRecordType != 0 {
+		var recordMatch bool
+		for _, htlc := range invoice.Htlcs {
+			_, ok := htlc.CustomRecords[q.HtlcCustomRecordType]
+			if ok {
+				recordMatch = true
+				break
+			}
+		}
+
+		if !recordMatch {
+			return false
+		}
+	}
+
+	return true
+}
+
+// indexedInvoice is an invoice reference that was found in a date or state
+// index.
+type indexedInvoice struct {
+	addIndex   uint64
+	invoiceKey []byte
+}
+
+// fetchByDateRange returns the invoices of a date index that lie within the
+// date range, ordered by their add index.
+func fetchByDateRange(dateIndex kvdb.RBucket, start,
+	end time.Time) []indexedInvoice {
+
+	var (
+		candidates []indexedInvoice
+		startKey   = dateIndexKey(start, 0)
+		cursor     = dateIndex.ReadCursor()
+	)
+
+	// Zero dates map to a zero key, so an open start begins with the
+	// first entry and an open end continues to the last entry.
+	var endNanos uint64
+	if !end.IsZero() {
+		endNanos = putNanoTime(end)
+	}
+
+	for k, v := cursor.Seek(startKey); k != nil; k, v = cursor.Next() {
+		if endNanos != 0 && byteOrder.Uint64(k[:8]) > endNanos {
+			break
+		}
+
+		candidates = append(candidates, indexedInvoice{
+			addIndex:   byteOrder.Uint64(k[8:]),
+			invoiceKey: copySlice(v),
+		})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].addIndex < candidates[j].addIndex
+	})
+
+	return candidates
+}
+
+// fetchByStates returns the invoices of the state index that are in one of the
+// given states, ordered by their add index.
+func fetchByStates(stateIndex kvdb.RBucket,
+	states []ContractState) []indexedInvoice {
+
+	var (
+		candidates []indexedInvoice
+		seen       = make(map[ContractState]struct{})
+		cursor     = stateIndex.ReadCursor()
+	)
+	for _, state := range states {
+		if _, ok := seen[state]; ok {
+			continue
+		}
+		seen[state] = struct{}{}
+
+		prefix := []byte{byte(state)}
+		for k, v := cursor.Seek(prefix); k != nil &&
+			bytes.HasPrefix(k, prefix); k, v = cursor.Next() {
+
+			candidates = append(candidates, indexedInvoice{
+				addIndex:   byteOrder.Uint64(k[1:]),
+				invoiceKey: copySlice(v),
+			})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].addIndex < candidates[j].addIndex
+	})
+
+	return candidates
+}
+
+// queryByState queries the invoices using the state index. Only the invoices
+// in the requested states are deserialized, which allows queries for open or
+// pending invoices to be served efficiently on databases with many resolved
+// invoices. Pagination follows the add index, like for regular queries.
+func queryByState(invoices kvdb.RBucket, q *InvoiceQuery) ([]Invoice,
+	error) {
+
+	stateIndex := invoices.NestedReadBucket(invoiceStateIndexBucket)
+	if stateIndex == nil {
+		return nil, nil
+	}
+
+	candidates := fetchByStates(stateIndex, q.indexedStates())
+
+	return paginateIndexed(invoices, candidates, q)
+}
+
+// queryByDateRange queries the invoices using the creation or settle date
+// index. Only the invoices that lie within the date range are deserialized,
+// which allows date range queries to be served efficiently on databases with
+// many invoices. Pagination follows the add index, like for regular queries.
+func queryByDateRange(invoices kvdb.RBucket, q *InvoiceQuery) ([]Invoice,
+	error) {
+
+	// Prefer the creation date index, and fall back to the settle date
+	// index if only a settle date range is given. The other date range is
+	// checked on the deserialized invoices.
+	var candidates []indexedInvoice
+	switch {
+	case !q.CreationDateStart.IsZero() || !q.CreationDateEnd.IsZero():
+		dateIndex := invoices.NestedReadBucket(creationDateIndexBucket)
+		if dateIndex == nil {
+			return nil, nil
+		}
+
+		candidates = fetchByDateRange(
+			dateIndex, q.CreationDateStart, q.CreationDateEnd,
+		)
+
+	default:
+		dateIndex := invoices.NestedReadBucket(settleDateIndexBucket)
+		if dateIndex == nil {
+			return nil, nil
+		}
+
+		candidates = fetchByDateRange(
+			dateIndex, q.SettleDateStart, q.SettleDateEnd,
+		)
+	}
+
+	return paginateIndexed(invoices, candidates, q)
+}
+
+// paginateIndexed returns the page of the indexed invoices that is selected
+// by the query. The candidates must be ordered by their add index. Candidates
+// that don't match all filters of the query are skipped.
+func paginateIndexed(invoices kvdb.RBucket, candidates []indexedInvoice,
+	q *InvoiceQuery) ([]Invoice, error) {
+
+	// Determine the position to start at. The index offset is exclusive,
+	// so we start with the first candidate after it, or the last one
+	// before it when querying in reverse.
+	next := sort.Search(len(candidates), func(i int) bool {
+		return candidates[i].addIndex > q.IndexOffset
+	})
+
+	step := 1
+	if q.Reversed {
+		step = -1
+
+		next = len(candidates) - 1
+		if q.IndexOffset != 0 {
+			next = sort.Search(len(candidates), func(i int) bool {
+				return candidates[i].addIndex >= q.IndexOffset
+			}) - 1
+		}
+	}
+
+	var result []Invoice
+	for ; next >= 0 && next < len(candidates); next += step {
+		if uint64(len(result)) >= q.NumMaxInvoices {
+			break
+		}
+
+		invoiceKey := candidates[next].invoiceKey
+		invoice, err := fetchInvoice(invoiceKey, invoices)
+		if err != nil {
+			return nil, err
+		}
+
+		if !q.matches(&invoice) {
+			continue
+		}
+
+		result = append(result, invoice)
+	}
+
+	return result, nil
+}
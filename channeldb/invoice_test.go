@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -1134,6 +1135,62 @@ func TestCustomRecords(t *testing.T) {
 	)
 }
 
+// TestInvoiceAcceptPolicy tests that the acceptance policy of a hodl invoice
+// is persisted, and that policies are rejected for regular invoices.
+func TestInvoiceAcceptPolicy(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := MakeTestDB()
+	require.NoError(t, err, "unable to make test db")
+	defer cleanUp()
+
+	policy := &InvoiceAcceptPolicy{
+		MinCltvDelta: 40,
+		RequiredCustomRecords: record.CustomSet{
+			100002: []byte{},
+			100001: []byte{1, 2},
+		},
+		MinAcceptAmt: 6000,
+	}
+
+	// A regular invoice can't have an acceptance policy.
+	invoice, err := randInvoice(lnwire.NewMSatFromSatoshis(10))
+	require.NoError(t, err)
+	invoice.AcceptPolicy = policy
+
+	_, err = db.AddInvoice(invoice, invoice.Terms.PaymentPreimage.Hash())
+	require.Error(t, err)
+
+	// Neither can the minimum accept amount exceed the invoice value.
+	invoice.HodlInvoice = true
+	invoice.AcceptPolicy = &InvoiceAcceptPolicy{MinAcceptAmt: 20000}
+
+	_, err = db.AddInvoice(invoice, invoice.Terms.PaymentPreimage.Hash())
+	require.Error(t, err)
+
+	// A valid policy on a hodl invoice is stored with the invoice.
+	invoice.AcceptPolicy = policy
+	paymentHash := invoice.Terms.PaymentPreimage.Hash()
+	_, err = db.AddInvoice(invoice, paymentHash)
+	require.NoError(t, err)
+
+	dbInvoice, err := db.LookupInvoice(InvoiceRefByHash(paymentHash))
+	require.NoError(t, err)
+	require.Equal(t, policy, dbInvoice.AcceptPolicy)
+
+	// Invoices without a policy are read back without one.
+	invoice, err = randInvoice(lnwire.NewMSatFromSatoshis(10))
+	require.NoError(t, err)
+
+	paymentHash = invoice.Terms.PaymentPreimage.Hash()
+	_, err = db.AddInvoice(invoice, paymentHash)
+	require.NoError(t, err)
+
+	dbInvoice, err = db.LookupInvoice(InvoiceRefByHash(paymentHash))
+	require.NoError(t, err)
+	require.Nil(t, dbInvoice.AcceptPolicy)
+}
+
 // TestInvoiceHtlcAMPFields asserts that the set id and preimage fields are
 // properly recorded when updating an invoice.
 func TestInvoiceHtlcAMPFields(t *testing.T) {
@@ -1619,3 +1676,355 @@ func TestDeleteInvoices(t *testing.T) {
 	assertInvoiceCount(0)
 
 }
+
+// TestQueryInvoicesFilters tests that invoices can be filtered by their
+// creation and settle dates, state, memo, amount and htlc custom records, and
+// that the date indexes are kept up to date when invoices are deleted.
+func TestQueryInvoicesFilters(t *testing.T) {
+	t.Parallel()
+
+	base := time.Unix(10000, 0)
+	testClock := clock.NewTestClock(base)
+
+	db, cleanUp, err := MakeTestDB(OptionClock(testClock))
+	require.NoError(t, err, "unable to make test db")
+	defer cleanUp()
+
+	const recordType = 65537
+
+	// settle returns an update callback that settles an invoice with a
+	// single htlc carrying the given custom records.
+	settle := func(records record.CustomSet) InvoiceUpdateCallback {
+		return func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+			return &InvoiceUpdateDesc{
+				State: &InvoiceStateUpdateDesc{
+					Preimage: invoice.Terms.PaymentPreimage,
+					NewState: ContractSettled,
+				},
+				AddHtlcs: map[CircuitKey]*HtlcAcceptDesc{
+					{}: {
+						Amt:           invoice.Terms.Value,
+						CustomRecords: records,
+					},
+				},
+			}, nil
+		}
+	}
+
+	cancel := func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+		return &InvoiceUpdateDesc{
+			State: &InvoiceStateUpdateDesc{
+				NewState: ContractCanceled,
+			},
+		}, nil
+	}
+
+	// Add six invoices, created 100 seconds apart. The even invoices have
+	// a matching memo, the second and fourth invoices are settled and the
+	// fifth invoice is canceled.
+	var deleteRefs []InvoiceDeleteRef
+	for i := 1; i <= 6; i++ {
+		invoice, err := randInvoice(lnwire.MilliSatoshi(i * 1000))
+		require.NoError(t, err)
+
+		invoice.CreationDate = base.Add(time.Duration(i) * 100 *
+			time.Second)
+		if i%2 == 0 {
+			invoice.Memo = []byte("Coffee order")
+		}
+
+		paymentHash := invoice.Terms.PaymentPreimage.Hash()
+		addIndex, err := db.AddInvoice(invoice, paymentHash)
+		require.NoError(t, err)
+
+		ref := InvoiceRefByHash(paymentHash)
+		switch i {
+		case 2:
+			testClock.SetTime(base.Add(1000 * time.Second))
+			invoice, err = db.UpdateInvoice(
+				ref, settle(record.CustomSet{}),
+			)
+			require.NoError(t, err)
+
+		case 4:
+			testClock.SetTime(base.Add(2000 * time.Second))
+			invoice, err = db.UpdateInvoice(
+				ref, settle(record.CustomSet{
+					recordType: []byte{1},
+				}),
+			)
+			require.NoError(t, err)
+
+		case 5:
+			invoice, err = db.UpdateInvoice(ref, cancel)
+			require.NoError(t, err)
+		}
+
+		deleteRefs = append(deleteRefs, InvoiceDeleteRef{
+			PayHash:     paymentHash,
+			PayAddr:     &invoice.Terms.PaymentAddr,
+			AddIndex:    addIndex,
+			SettleIndex: invoice.SettleIndex,
+		})
+	}
+
+	// assertQuery asserts that the query returns the invoices with the
+	// expected add indexes, in order.
+	assertQuery := func(query InvoiceQuery, expected ...uint64) {
+		t.Helper()
+
+		if query.NumMaxInvoices == 0 {
+			query.NumMaxInvoices = math.MaxUint64
+		}
+
+		resp, err := db.QueryInvoices(query)
+		require.NoError(t, err)
+
+		addIndexes := make([]uint64, 0, len(resp.Invoices))
+		for _, invoice := range resp.Invoices {
+			addIndexes = append(addIndexes, invoice.AddIndex)
+		}
+
+		if len(expected) == 0 {
+			expected = []uint64{}
+		}
+		require.Equal(t, expected, addIndexes)
+	}
+
+	creationStart := base.Add(200 * time.Second)
+	creationEnd := base.Add(400 * time.Second)
+
+	assertQuery(InvoiceQuery{
+		CreationDateStart: creationStart,
+		CreationDateEnd:   creationEnd,
+	}, 2, 3, 4)
+
+	// Pagination follows the add index, also for date range queries.
+	// Reversed queries still return the invoices in forward order.
+	assertQuery(InvoiceQuery{
+		CreationDateStart: creationStart,
+		CreationDateEnd:   creationEnd,
+		Reversed:          true,
+		NumMaxInvoices:    2,
+	}, 3, 4)
+	assertQuery(InvoiceQuery{
+		CreationDateStart: creationStart,
+		CreationDateEnd:   creationEnd,
+		IndexOffset:       2,
+	}, 3, 4)
+	assertQuery(InvoiceQuery{
+		CreationDateStart: creationStart,
+		CreationDateEnd:   creationEnd,
+		IndexOffset:       4,
+		Reversed:          true,
+	}, 2, 3)
+
+	// Only settled invoices match a settle date range.
+	assertQuery(InvoiceQuery{
+		SettleDateStart: base.Add(1500 * time.Second),
+	}, 4)
+	assertQuery(InvoiceQuery{
+		SettleDateEnd: base.Add(1500 * time.Second),
+	}, 2)
+	assertQuery(InvoiceQuery{
+		SettleDateStart: base,
+	}, 2, 4)
+
+	// State queries are served from the state index, which follows the
+	// state changes of the invoices.
+	assertQuery(InvoiceQuery{
+		States: []ContractState{ContractSettled, ContractCanceled},
+	}, 2, 4, 5)
+	assertQuery(InvoiceQuery{
+		States: []ContractState{ContractOpen},
+	}, 1, 3, 6)
+	assertQuery(InvoiceQuery{
+		PendingOnly: true,
+	}, 1, 3, 6)
+	assertQuery(InvoiceQuery{
+		States:         []ContractState{ContractCanceled, ContractOpen},
+		IndexOffset:    5,
+		Reversed:       true,
+		NumMaxInvoices: 2,
+	}, 1, 3)
+
+	assertQuery(InvoiceQuery{
+		MemoContains: "coffee",
+	}, 2, 4, 6)
+
+	assertQuery(InvoiceQuery{
+		MinAmt: 3000,
+		MaxAmt: 5000,
+	}, 3, 4, 5)
+
+	assertQuery(InvoiceQuery{
+		HtlcCustomRecordType: recordType,
+	}, 4)
+
+	// Filters are combined with the date range.
+	assertQuery(InvoiceQuery{
+		CreationDateStart: creationStart,
+		CreationDateEnd:   creationEnd,
+		MemoContains:      "coffee",
+		PendingOnly:       true,
+	})
+	assertQuery(InvoiceQuery{
+		CreationDateStart: creationStart,
+		SettleDateStart:   base,
+		Reversed:          true,
+	}, 2, 4)
+
+	// Deleting an invoice removes it from the date and state indexes.
+	require.NoError(t, db.DeleteInvoice(deleteRefs[3:4]))
+
+	assertQuery(InvoiceQuery{
+		CreationDateStart: creationStart,
+		CreationDateEnd:   creationEnd,
+	}, 2, 3)
+	assertQuery(InvoiceQuery{
+		SettleDateStart: base,
+	}, 2)
+	assertQuery(InvoiceQuery{
+		States: []ContractState{ContractSettled},
+	}, 2)
+}
+
+// TestDeleteResolvedInvoices tests that only settled and canceled invoices
+// that were resolved before the given time are deleted, and that new invoices
+// keep receiving increasing add and settle indexes.
+func TestDeleteResolvedInvoices(t *testing.T) {
+	t.Parallel()
+
+	base := time.Unix(10000, 0)
+	cutoff := base.Add(time.Hour)
+	testClock := clock.NewTestClock(base)
+
+	db, cleanUp, err := MakeTestDB(OptionClock(testClock))
+	require.NoError(t, err, "unable to make test db")
+	defer cleanUp()
+
+	cancel := func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+		return &InvoiceUpdateDesc{
+			State: &InvoiceStateUpdateDesc{
+				NewState: ContractCanceled,
+			},
+		}, nil
+	}
+
+	accept := func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+		return &InvoiceUpdateDesc{
+			State: &InvoiceStateUpdateDesc{
+				NewState: ContractAccepted,
+			},
+			AddHtlcs: map[CircuitKey]*HtlcAcceptDesc{
+				{}: {
+					Amt:           invoice.Terms.Value,
+					CustomRecords: record.CustomSet{},
+				},
+			},
+		}, nil
+	}
+
+	// addInvoice adds an invoice that is created at the given time, and
+	// applies the update to it at the current time of the test clock. Hodl
+	// invoices are added without a preimage.
+	addInvoice := func(created time.Time,
+		update InvoiceUpdateCallback, hodl bool) lntypes.Hash {
+
+		invoice, err := randInvoice(1000)
+		require.NoError(t, err)
+
+		invoice.CreationDate = created
+		invoice.HodlInvoice = hodl
+
+		paymentHash := invoice.Terms.PaymentPreimage.Hash()
+		if hodl {
+			invoice.Terms.PaymentPreimage = nil
+		}
+		_, err = db.AddInvoice(invoice, paymentHash)
+		require.NoError(t, err)
+
+		if update != nil {
+			_, err = db.UpdateInvoice(
+				InvoiceRefByHash(paymentHash), update,
+			)
+			require.NoError(t, err)
+		}
+
+		return paymentHash
+	}
+
+	// The first invoice is settled before the cutoff and the second
+	// invoice is canceled. Both are deleted, even though the canceled
+	// invoice has no preimage to derive its payment hash from.
+	oldSettled := addInvoice(base, getUpdateInvoice(1000), false)
+	oldCanceled := addInvoice(base, cancel, true)
+
+	// An open invoice and a hodl invoice with an accepted htlc are kept.
+	open := addInvoice(base, nil, false)
+	accepted := addInvoice(base, accept, true)
+
+	// An invoice that was created before the cutoff but settled after it
+	// is kept too.
+	testClock.SetTime(cutoff.Add(time.Minute))
+	recentSettled := addInvoice(base, getUpdateInvoice(1000), false)
+
+	// Delete the invoices in batches of a single invoice, to check that
+	// the deletion continues with the next batch.
+	numDeleted, err := db.deleteResolvedInvoices(cutoff, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, numDeleted)
+
+	for _, hash := range []lntypes.Hash{oldSettled, oldCanceled} {
+		_, err := db.LookupInvoice(InvoiceRefByHash(hash))
+		require.Equal(t, ErrInvoiceNotFound, err)
+	}
+
+	for _, hash := range []lntypes.Hash{open, accepted, recentSettled} {
+		_, err := db.LookupInvoice(InvoiceRefByHash(hash))
+		require.NoError(t, err)
+	}
+
+	// Only the remaining invoices are left in the hash index.
+	var numHashes int
+	err = kvdb.View(db, func(tx kvdb.RTx) error {
+		hashIndex := tx.ReadBucket(invoiceBucket).NestedReadBucket(
+			invoiceHashIndexBucket,
+		)
+
+		return hashIndex.ForEach(func(_, _ []byte) error {
+			numHashes++
+			return nil
+		})
+	}, func() {
+		numHashes = 0
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, numHashes)
+
+	// Pruning again doesn't delete anything.
+	numDeleted, err = db.DeleteResolvedInvoices(cutoff)
+	require.NoError(t, err)
+	require.Zero(t, numDeleted)
+
+	// Subscribers that resume from one of the deleted invoices only see
+	// the remaining invoices.
+	added, err := db.InvoicesAddedSince(1)
+	require.NoError(t, err)
+	require.Len(t, added, 3)
+	require.EqualValues(t, 3, added[0].AddIndex)
+
+	settled, err := db.InvoicesSettledSince(1)
+	require.NoError(t, err)
+	require.Len(t, settled, 1)
+	require.EqualValues(t, 2, settled[0].SettleIndex)
+
+	// New invoices receive higher indexes than the deleted ones.
+	newInvoice := addInvoice(
+		cutoff.Add(time.Minute), getUpdateInvoice(1000), false,
+	)
+	invoice, err := db.LookupInvoice(InvoiceRefByHash(newInvoice))
+	require.NoError(t, err)
+	require.EqualValues(t, 6, invoice.AddIndex)
+	require.EqualValues(t, 3, invoice.SettleIndex)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"time"
 
 	"github.com/lightningnetwork/lnd/channeldb/kvdb"
@@ -187,6 +188,7 @@ const (
 	invStateType    tlv.Type = 12
 	amtPaidType     tlv.Type = 13
 	hodlInvoiceType tlv.Type = 14
+	invPolicyType   tlv.Type = 15
 )
 
 // InvoiceRef is a composite identifier for invoices. Invoices can be referenced
@@ -415,6 +417,120 @@ type Invoice struct {
 	// HodlInvoice indicates whether the invoice should be held in the
 	// Accepted state or be settled right away.
 	HodlInvoice bool
+
+	// AcceptPolicy holds the conditions that htlcs paying to this invoice
+	// must satisfy to be accepted. It is nil if the invoice has no
+	// acceptance policy.
+	AcceptPolicy *InvoiceAcceptPolicy
+}
+
+// InvoiceAcceptPolicy describes the conditions that the htlcs paying to an
+// invoice must satisfy to be accepted, in addition to the invoice terms.
+type InvoiceAcceptPolicy struct {
+	// MinCltvDelta is the minimum number of blocks that an htlc must have
+	// left until its expiry to be accepted.
+	MinCltvDelta uint32
+
+	// RequiredCustomRecords are the custom records that every htlc must
+	// carry. If a record has a non-empty value, the htlc's record must
+	// have this exact value.
+	RequiredCustomRecords record.CustomSet
+
+	// MinAcceptAmt allows partial acceptance of the invoice. If it is
+	// non-zero, an htlc set that pays at least this amount is accepted,
+	// even if it pays less than the invoice value.
+	MinAcceptAmt lnwire.MilliSatoshi
+}
+
+// Copy returns a deep copy of the acceptance policy.
+func (p *InvoiceAcceptPolicy) Copy() *InvoiceAcceptPolicy {
+	if p == nil {
+		return nil
+	}
+
+	policy := *p
+	if p.RequiredCustomRecords != nil {
+		policy.RequiredCustomRecords = make(record.CustomSet)
+		for k, v := range p.RequiredCustomRecords {
+			policy.RequiredCustomRecords[k] = copySlice(v)
+		}
+	}
+
+	return &policy
+}
+
+// serializeAcceptPolicy serializes an acceptance policy. A nil policy is
+// serialized as an empty byte slice.
+func serializeAcceptPolicy(p *InvoiceAcceptPolicy) ([]byte, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	var b bytes.Buffer
+	err := WriteElements(
+		&b, p.MinCltvDelta, p.MinAcceptAmt,
+		uint32(len(p.RequiredCustomRecords)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Write the records in a deterministic order.
+	recordTypes := make([]uint64, 0, len(p.RequiredCustomRecords))
+	for recordType := range p.RequiredCustomRecords {
+		recordTypes = append(recordTypes, recordType)
+	}
+	sort.Slice(recordTypes, func(i, j int) bool {
+		return recordTypes[i] < recordTypes[j]
+	})
+
+	for _, recordType := range recordTypes {
+		err := WriteElements(
+			&b, recordType, p.RequiredCustomRecords[recordType],
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// deserializeAcceptPolicy deserializes an acceptance policy. An empty byte
+// slice results in a nil policy.
+func deserializeAcceptPolicy(policyBytes []byte) (*InvoiceAcceptPolicy,
+	error) {
+
+	if len(policyBytes) == 0 {
+		return nil, nil
+	}
+
+	var (
+		p          InvoiceAcceptPolicy
+		numRecords uint32
+	)
+	r := bytes.NewReader(policyBytes)
+	err := ReadElements(r, &p.MinCltvDelta, &p.MinAcceptAmt, &numRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	if numRecords > 0 {
+		p.RequiredCustomRecords = make(record.CustomSet, numRecords)
+	}
+	for j := uint32(0); j < numRecords; j++ {
+		var (
+			recordType uint64
+			value      []byte
+		)
+		if err := ReadElements(r, &recordType, &value); err != nil {
+			return nil, err
+		}
+
+		p.RequiredCustomRecords[recordType] = value
+	}
+
+	return &p, nil
 }
 
 // HTLCSet returns the set of accepted HTLCs belonging to an invoice. Passing a
@@ -653,6 +769,21 @@ func validateInvoice(i *Invoice, paymentHash lntypes.Hash) error {
 		return ErrInvoiceHasHtlcs
 	}
 
+	if i.AcceptPolicy != nil {
+		if !i.HodlInvoice {
+			return errors.New("only hodl invoices can have an " +
+				"acceptance policy")
+		}
+
+		if i.Terms.Value != 0 &&
+			i.AcceptPolicy.MinAcceptAmt > i.Terms.Value {
+
+			return fmt.Errorf("minimum accept amount %v exceeds "+
+				"invoice value %v", i.AcceptPolicy.MinAcceptAmt,
+				i.Terms.Value)
+		}
+	}
+
 	return nil
 }
 
@@ -763,8 +894,11 @@ func (d *DB) InvoicesAddedSince(sinceAddIndex uint64) ([]Invoice, error) {
 		return newInvoices, nil
 	}
 
+	// We start at the first index after the since index. The since index
+	// itself may no longer exist if its invoice was deleted, so we can't
+	// seek to it and skip it.
 	var startIndex [8]byte
-	byteOrder.PutUint64(startIndex[:], sinceAddIndex)
+	byteOrder.PutUint64(startIndex[:], sinceAddIndex+1)
 
 	err := kvdb.View(d, func(tx kvdb.RTx) error {
 		invoices := tx.ReadBucket(invoiceBucket)
@@ -782,12 +916,8 @@ func (d *DB) InvoicesAddedSince(sinceAddIndex uint64) ([]Invoice, error) {
 		// very end of the current key space.
 		invoiceCursor := addIndex.ReadCursor()
 
-		// We'll seek to the starting index, then manually advance the
-		// cursor in order to skip the entry with the since add index.
-		invoiceCursor.Seek(startIndex[:])
-		addSeqNo, invoiceKey := invoiceCursor.Next()
-
-		for ; addSeqNo != nil && bytes.Compare(addSeqNo, startIndex[:]) > 0; addSeqNo, invoiceKey = invoiceCursor.Next() {
+		addSeqNo, invoiceKey := invoiceCursor.Seek(startIndex[:])
+		for ; addSeqNo != nil; addSeqNo, invoiceKey = invoiceCursor.Next() {
 
 			// For each key found, we'll look up the actual
 			// invoice, then accumulate it into our return value.
@@ -963,7 +1093,10 @@ func (d *DB) ScanInvoices(
 
 // InvoiceQuery represents a query to the invoice database. The query allows a
 // caller to retrieve all invoices starting from a particular add index and
-// limit the number of results returned.
+// limit the number of results returned. The invoices can be further filtered
+// by their creation and settle date, state, memo, amount and the custom
+// records of their htlcs. Date ranges are resolved using the date indexes, so
+// that only the invoices within the range are read.
 type InvoiceQuery struct {
 	// IndexOffset is the offset within the add indices to start at. This
 	// can be used to start the response at a particular invoice.
@@ -980,6 +1113,40 @@ type InvoiceQuery struct {
 	// Reversed, if set, indicates that the invoices returned should start
 	// from the IndexOffset and go backwards.
 	Reversed bool
+
+	// CreationDateStart, if set, excludes invoices that were created
+	// before this time.
+	CreationDateStart time.Time
+
+	// CreationDateEnd, if set, excludes invoices that were created after
+	// this time.
+	CreationDateEnd time.Time
+
+	// SettleDateStart, if set, excludes invoices that weren't settled or
+	// were settled before this time.
+	SettleDateStart time.Time
+
+	// SettleDateEnd, if set, excludes invoices that weren't settled or
+	// were settled after this time.
+	SettleDateEnd time.Time
+
+	// States, if set, only returns invoices in one of the given states.
+	States []ContractState
+
+	// MemoContains, if set, only returns invoices with a memo that
+	// contains this string, ignoring case.
+	MemoContains string
+
+	// MinAmt excludes invoices with a value below this amount.
+	MinAmt lnwire.MilliSatoshi
+
+	// MaxAmt, if non-zero, excludes invoices with a value above this
+	// amount.
+	MaxAmt lnwire.MilliSatoshi
+
+	// HtlcCustomRecordType, if non-zero, only returns invoices that have
+	// at least one htlc that carries a custom record of this type.
+	HtlcCustomRecordType uint64
 }
 
 // InvoiceSlice is the response to a invoice query. It includes the original
@@ -1020,6 +1187,28 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 			return ErrNoInvoicesCreated
 		}
 
+		// If a date range or states are given, we only read the
+		// matching invoices from the date or state indexes.
+		if q.hasDateRange() || len(q.indexedStates()) > 0 {
+			var err error
+			if q.hasDateRange() {
+				resp.Invoices, err = queryByDateRange(
+					invoices, &q,
+				)
+			} else {
+				resp.Invoices, err = queryByState(invoices, &q)
+			}
+			if err != nil {
+				return err
+			}
+
+			if q.Reversed {
+				reverseInvoices(resp.Invoices)
+			}
+
+			return nil
+		}
+
 		// Get the add index bucket which we will use to iterate through
 		// our indexed invoices.
 		invoiceAddIndex := invoices.NestedReadBucket(addIndexBucket)
@@ -1044,9 +1233,10 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 				return false, err
 			}
 
-			// Skip any settled or canceled invoices if the caller
-			// is only interested in pending ones.
-			if q.PendingOnly && !invoice.IsPending() {
+			// Skip any invoices that don't match the filters of the
+			// query, such as settled or canceled invoices if the
+			// caller is only interested in pending ones.
+			if !q.matches(&invoice) {
 				return false, nil
 			}
 
@@ -1066,12 +1256,7 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 		// we'll need to reverse the slice of invoices to return them in
 		// forward order.
 		if q.Reversed {
-			numInvoices := len(resp.Invoices)
-			for i := 0; i < numInvoices/2; i++ {
-				opposite := numInvoices - i - 1
-				resp.Invoices[i], resp.Invoices[opposite] =
-					resp.Invoices[opposite], resp.Invoices[i]
-			}
+			reverseInvoices(resp.Invoices)
 		}
 
 		return nil
@@ -1094,6 +1279,15 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 	return resp, nil
 }
 
+// reverseInvoices reverses the order of a slice of invoices in place.
+func reverseInvoices(invoices []Invoice) {
+	numInvoices := len(invoices)
+	for i := 0; i < numInvoices/2; i++ {
+		opposite := numInvoices - i - 1
+		invoices[i], invoices[opposite] = invoices[opposite], invoices[i]
+	}
+}
+
 // UpdateInvoice attempts to update an invoice corresponding to the passed
 // payment hash. If an invoice matching the passed payment hash doesn't exist
 // within the database, then the action will fail with a "not found" error.
@@ -1164,8 +1358,11 @@ func (d *DB) InvoicesSettledSince(sinceSettleIndex uint64) ([]Invoice, error) {
 		return settledInvoices, nil
 	}
 
+	// We start at the first index after the since index. The since index
+	// itself may no longer exist if its invoice was deleted, so we can't
+	// seek to it and skip it.
 	var startIndex [8]byte
-	byteOrder.PutUint64(startIndex[:], sinceSettleIndex)
+	byteOrder.PutUint64(startIndex[:], sinceSettleIndex+1)
 
 	err := kvdb.View(d, func(tx kvdb.RTx) error {
 		invoices := tx.ReadBucket(invoiceBucket)
@@ -1183,12 +1380,8 @@ func (d *DB) InvoicesSettledSince(sinceSettleIndex uint64) ([]Invoice, error) {
 		// very end of the current key space.
 		invoiceCursor := settleIndex.ReadCursor()
 
-		// We'll seek to the starting index, then manually advance the
-		// cursor in order to skip the entry with the since add index.
-		invoiceCursor.Seek(startIndex[:])
-		seqNo, invoiceKey := invoiceCursor.Next()
-
-		for ; seqNo != nil && bytes.Compare(seqNo, startIndex[:]) > 0; seqNo, invoiceKey = invoiceCursor.Next() {
+		seqNo, invoiceKey := invoiceCursor.Seek(startIndex[:])
+		for ; seqNo != nil; seqNo, invoiceKey = invoiceCursor.Next() {
 
 			// For each key found, we'll look up the actual
 			// invoice, then accumulate it into our return value.
@@ -1266,6 +1459,42 @@ func putInvoice(invoices, invoiceIndex, payAddrIndex, addIndex kvdb.RwBucket,
 
 	i.AddIndex = nextAddSeqNo
 
+	// Add the invoice to the creation date index, so that it can be found
+	// by date range queries.
+	creationIndex, err := invoices.CreateBucketIfNotExists(
+		creationDateIndexBucket,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	err = putDateIndex(
+		creationIndex, i.CreationDate, nextAddSeqNo, invoiceKey[:],
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// Also add it to the state index, so that it can be found by state
+	// queries.
+	err = putStateIndex(invoices, i.State, nextAddSeqNo, invoiceKey[:])
+	if err != nil {
+		return 0, err
+	}
+
+	// Map the invoice to its payment hash, so that it can be deleted once
+	// it's found through one of the search indexes.
+	hashIndex, err := invoices.CreateBucketIfNotExists(
+		invoiceHashIndexBucket,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := hashIndex.Put(invoiceKey[:], paymentHash[:]); err != nil {
+		return 0, err
+	}
+
 	// Finally, serialize the invoice itself to be written to the disk.
 	var buf bytes.Buffer
 	if err := serializeInvoice(&buf, i); err != nil {
@@ -1321,6 +1550,11 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 		hodlInvoice = 1
 	}
 
+	acceptPolicy, err := serializeAcceptPolicy(i.AcceptPolicy)
+	if err != nil {
+		return err
+	}
+
 	tlvStream, err := tlv.NewStream(
 		// Memo and payreq.
 		tlv.MakePrimitiveRecord(memoType, &i.Memo),
@@ -1345,6 +1579,7 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 		tlv.MakePrimitiveRecord(amtPaidType, &amtPaid),
 
 		tlv.MakePrimitiveRecord(hodlInvoiceType, &hodlInvoice),
+		tlv.MakePrimitiveRecord(invPolicyType, &acceptPolicy),
 	)
 	if err != nil {
 		return err
@@ -1493,6 +1728,7 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 		creationDateBytes []byte
 		settleDateBytes   []byte
 		featureBytes      []byte
+		acceptPolicy      []byte
 	)
 
 	var i Invoice
@@ -1520,6 +1756,7 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 		tlv.MakePrimitiveRecord(amtPaidType, &amtPaid),
 
 		tlv.MakePrimitiveRecord(hodlInvoiceType, &hodlInvoice),
+		tlv.MakePrimitiveRecord(invPolicyType, &acceptPolicy),
 	)
 	if err != nil {
 		return i, err
@@ -1551,6 +1788,11 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 		i.HodlInvoice = true
 	}
 
+	i.AcceptPolicy, err = deserializeAcceptPolicy(acceptPolicy)
+	if err != nil {
+		return i, err
+	}
+
 	err = i.CreationDate.UnmarshalBinary(creationDateBytes)
 	if err != nil {
 		return i, err
@@ -1714,7 +1956,8 @@ func copyInvoice(src *Invoice) *Invoice {
 		Htlcs: make(
 			map[CircuitKey]*InvoiceHTLC, len(src.Htlcs),
 		),
-		HodlInvoice: src.HodlInvoice,
+		HodlInvoice:  src.HodlInvoice,
+		AcceptPolicy: src.AcceptPolicy.Copy(),
 	}
 
 	dest.Terms.Features = src.Terms.Features.Clone()
@@ -1848,11 +2091,21 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices,
 	// change, which depends on having an accurate view of the accepted
 	// HTLCs.
 	if update.State != nil {
+		oldState := invoice.State
 		err := updateInvoiceState(&invoice, hash, *update.State)
 		if err != nil {
 			return nil, err
 		}
 
+		if invoice.State != oldState {
+			err := moveStateIndex(
+				invoices, oldState, &invoice, invoiceNum,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		if update.State.NewState == ContractSettled {
 			err := setSettleMetaFields(
 				settleIndex, invoiceNum, &invoice, now,
@@ -1860,6 +2113,21 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices,
 			if err != nil {
 				return nil, err
 			}
+
+			dateIndex, err := invoices.CreateBucketIfNotExists(
+				settleDateIndexBucket,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			err = putDateIndex(
+				dateIndex, invoice.SettleDate,
+				invoice.AddIndex, invoiceNum,
+			)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -2135,114 +2403,311 @@ type InvoiceDeleteRef struct {
 // one transaction. The passed delete references hold all keys required to
 // delete the invoices without also needing to deserialze them.
 func (d *DB) DeleteInvoice(invoicesToDelete []InvoiceDeleteRef) error {
-	err := kvdb.Update(d, func(tx kvdb.RwTx) error {
-		invoices := tx.ReadWriteBucket(invoiceBucket)
-		if invoices == nil {
-			return ErrNoInvoicesCreated
-		}
+	return kvdb.Update(d, func(tx kvdb.RwTx) error {
+		return deleteInvoices(tx, invoicesToDelete)
+	}, func() {})
+}
 
-		invoiceIndex := invoices.NestedReadWriteBucket(
-			invoiceIndexBucket,
+// resolvedDeleteBatchSize is the maximum number of index entries that are
+// processed within a single database transaction when deleting resolved
+// invoices or payments. Deleting in batches keeps the transactions small, so
+// that other writers aren't blocked for long on databases with many entries.
+const resolvedDeleteBatchSize = 1000
+
+// DeleteResolvedInvoices deletes all settled invoices that were settled before
+// the given time and all canceled invoices that were created before it, and
+// returns the number of deleted invoices. Invoices that still have accepted
+// htlcs are never deleted. The add and settle indexes of the remaining
+// invoices are left untouched, so subscribers can keep resuming from the
+// indexes they know. The invoices are deleted in batches, each within its own
+// transaction, so the deletion may stop part way through on error.
+func (d *DB) DeleteResolvedInvoices(before time.Time) (int, error) {
+	return d.deleteResolvedInvoices(before, resolvedDeleteBatchSize)
+}
+
+// deleteResolvedInvoices deletes the resolved invoices that were created
+// before the given time, processing at most batchSize invoices per
+// transaction.
+func (d *DB) deleteResolvedInvoices(before time.Time, batchSize int) (int,
+	error) {
+
+	var (
+		numDeleted int
+		startKey   = dateIndexKey(time.Time{}, 0)
+	)
+	for startKey != nil {
+		var (
+			batchDeleted int
+			nextKey      []byte
 		)
-		if invoiceIndex == nil {
-			return ErrNoInvoicesCreated
+		err := kvdb.Update(d, func(tx kvdb.RwTx) error {
+			var err error
+			batchDeleted, nextKey, err = deleteResolvedInvoiceBatch(
+				tx, startKey, before, batchSize,
+			)
+			return err
+		}, func() {
+			batchDeleted = 0
+			nextKey = nil
+		})
+		if err != nil {
+			return numDeleted, err
 		}
 
-		invoiceAddIndex := invoices.NestedReadWriteBucket(
-			addIndexBucket,
-		)
-		if invoiceAddIndex == nil {
-			return ErrNoInvoicesCreated
+		numDeleted += batchDeleted
+		startKey = nextKey
+	}
+
+	return numDeleted, nil
+}
+
+// deleteResolvedInvoiceBatch deletes the resolved invoices among the next
+// batchSize entries of the creation date index, starting at the given key. It
+// returns the number of deleted invoices, and the key to continue at or nil
+// if there are no more invoices that were created before the given time.
+func deleteResolvedInvoiceBatch(tx kvdb.RwTx, startKey []byte,
+	before time.Time, batchSize int) (int, []byte, error) {
+
+	invoices := tx.ReadWriteBucket(invoiceBucket)
+	if invoices == nil {
+		return 0, nil, nil
+	}
+
+	// Invoices are always created before they are resolved, so we only
+	// need to look at the invoices that were created before the given
+	// time. The creation date index returns them in creation order.
+	creationDateIndex := invoices.NestedReadBucket(creationDateIndexBucket)
+	if creationDateIndex == nil {
+		return 0, nil, nil
+	}
+
+	var (
+		candidates  [][]byte
+		nextKey     []byte
+		beforeNanos = putNanoTime(before)
+		cursor      = creationDateIndex.ReadCursor()
+	)
+	for k, v := cursor.Seek(startKey); k != nil; k, v = cursor.Next() {
+		if byteOrder.Uint64(k[:8]) >= beforeNanos {
+			break
 		}
-		// settleIndex can be nil, as the bucket is created lazily
-		// when the first invoice is settled.
-		settleIndex := invoices.NestedReadWriteBucket(settleIndexBucket)
 
-		payAddrIndex := tx.ReadWriteBucket(payAddrIndexBucket)
+		if len(candidates) == batchSize {
+			nextKey = copySlice(k)
+			break
+		}
+
+		candidates = append(candidates, copySlice(v))
+	}
+
+	// The invoices don't store their payment hash, which is needed to
+	// remove them from the invoice index, so we look it up in the hash
+	// index.
+	hashIndex := invoices.NestedReadBucket(invoiceHashIndexBucket)
+	if hashIndex == nil {
+		return 0, nil, ErrNoInvoicesCreated
+	}
+
+	var refsToDelete []InvoiceDeleteRef
+	for _, invoiceKey := range candidates {
+		invoice, err := fetchInvoice(invoiceKey, invoices)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if !isResolvedBefore(&invoice, before) {
+			continue
+		}
+
+		ref := InvoiceDeleteRef{
+			AddIndex:    invoice.AddIndex,
+			SettleIndex: invoice.SettleIndex,
+		}
+		if invoice.Terms.PaymentAddr != BlankPayAddr {
+			payAddr := invoice.Terms.PaymentAddr
+			ref.PayAddr = &payAddr
+		}
+
+		payHash := hashIndex.Get(invoiceKey)
+		if payHash == nil {
+			return 0, nil, fmt.Errorf("unable to find payment "+
+				"hash of invoice %x", invoiceKey)
+		}
+		copy(ref.PayHash[:], payHash)
+
+		refsToDelete = append(refsToDelete, ref)
+	}
+
+	if err := deleteInvoices(tx, refsToDelete); err != nil {
+		return 0, nil, err
+	}
+
+	return len(refsToDelete), nextKey, nil
+}
+
+// isResolvedBefore returns true if the invoice was settled before the given
+// time, or if it was canceled and created before it. Invoices with accepted
+// htlcs aren't considered resolved, as those htlcs may still need the invoice
+// to be resolved.
+func isResolvedBefore(invoice *Invoice, before time.Time) bool {
+	for _, htlc := range invoice.Htlcs {
+		if htlc.State == HtlcStateAccepted {
+			return false
+		}
+	}
+
+	switch invoice.State {
+	case ContractSettled:
+		return invoice.SettleDate.Before(before)
+
+	case ContractCanceled:
+		return invoice.CreationDate.Before(before)
+
+	default:
+		return false
+	}
+}
+
+// deleteInvoices deletes the passed invoices and removes them from all
+// indexes. The add and settle index sequences are left untouched, so new
+// invoices keep receiving higher indexes than the deleted ones.
+func deleteInvoices(tx kvdb.RwTx, invoicesToDelete []InvoiceDeleteRef) error {
+	invoices := tx.ReadWriteBucket(invoiceBucket)
+	if invoices == nil {
+		return ErrNoInvoicesCreated
+	}
+
+	invoiceIndex := invoices.NestedReadWriteBucket(
+		invoiceIndexBucket,
+	)
+	if invoiceIndex == nil {
+		return ErrNoInvoicesCreated
+	}
 
-		for _, ref := range invoicesToDelete {
-			// Fetch the invoice key for using it to check for
-			// consistency and also to delete from the invoice index.
-			invoiceKey := invoiceIndex.Get(ref.PayHash[:])
-			if invoiceKey == nil {
-				return ErrInvoiceNotFound
+	invoiceAddIndex := invoices.NestedReadWriteBucket(
+		addIndexBucket,
+	)
+	if invoiceAddIndex == nil {
+		return ErrNoInvoicesCreated
+	}
+	// settleIndex can be nil, as the bucket is created lazily
+	// when the first invoice is settled.
+	settleIndex := invoices.NestedReadWriteBucket(settleIndexBucket)
+
+	// The date indexes can be nil too, as they are created lazily.
+	creationDateIndex := invoices.NestedReadWriteBucket(
+		creationDateIndexBucket,
+	)
+	settleDateIndex := invoices.NestedReadWriteBucket(
+		settleDateIndexBucket,
+	)
+	stateIndex := invoices.NestedReadWriteBucket(invoiceStateIndexBucket)
+	hashIndex := invoices.NestedReadWriteBucket(invoiceHashIndexBucket)
+
+	payAddrIndex := tx.ReadWriteBucket(payAddrIndexBucket)
+
+	for _, ref := range invoicesToDelete {
+		// Fetch the invoice key for using it to check for
+		// consistency and also to delete from the invoice index.
+		invoiceKey := invoiceIndex.Get(ref.PayHash[:])
+		if invoiceKey == nil {
+			return ErrInvoiceNotFound
+		}
+
+		err := invoiceIndex.Delete(ref.PayHash[:])
+		if err != nil {
+			return err
+		}
+
+		// Delete payment address index reference if there's a
+		// valid payment address passed.
+		if ref.PayAddr != nil {
+			// To ensure consistency check that the already
+			// fetched invoice key matches the one in the
+			// payment address index.
+			key := payAddrIndex.Get(ref.PayAddr[:])
+			if !bytes.Equal(key, invoiceKey) {
+				return fmt.Errorf("unknown invoice " +
+					"in pay addr index")
 			}
 
-			err := invoiceIndex.Delete(ref.PayHash[:])
+			// Delete from the payment address index.
+			err := payAddrIndex.Delete(ref.PayAddr[:])
 			if err != nil {
 				return err
 			}
+		}
 
-			// Delete payment address index reference if there's a
-			// valid payment address passed.
-			if ref.PayAddr != nil {
-				// To ensure consistency check that the already
-				// fetched invoice key matches the one in the
-				// payment address index.
-				key := payAddrIndex.Get(ref.PayAddr[:])
-				if !bytes.Equal(key, invoiceKey) {
-					return fmt.Errorf("unknown invoice " +
-						"in pay addr index")
-				}
+		var addIndexKey [8]byte
+		byteOrder.PutUint64(addIndexKey[:], ref.AddIndex)
 
-				// Delete from the payment address index.
-				err := payAddrIndex.Delete(ref.PayAddr[:])
-				if err != nil {
-					return err
-				}
-			}
+		// To ensure consistency check that the key stored in
+		// the add index also matches the previously fetched
+		// invoice key.
+		key := invoiceAddIndex.Get(addIndexKey[:])
+		if !bytes.Equal(key, invoiceKey) {
+			return fmt.Errorf("unknown invoice in " +
+				"add index")
+		}
+
+		// Remove from the add index.
+		err = invoiceAddIndex.Delete(addIndexKey[:])
+		if err != nil {
+			return err
+		}
 
-			var addIndexKey [8]byte
-			byteOrder.PutUint64(addIndexKey[:], ref.AddIndex)
+		// Remove from the settle index if available and
+		// if the invoice is settled.
+		if settleIndex != nil && ref.SettleIndex > 0 {
+			var settleIndexKey [8]byte
+			byteOrder.PutUint64(
+				settleIndexKey[:], ref.SettleIndex,
+			)
 
-			// To ensure consistency check that the key stored in
-			// the add index also matches the previously fetched
-			// invoice key.
-			key := invoiceAddIndex.Get(addIndexKey[:])
+			// To ensure consistency check that the already
+			// fetched invoice key matches the one in the
+			// settle index
+			key := settleIndex.Get(settleIndexKey[:])
 			if !bytes.Equal(key, invoiceKey) {
-				return fmt.Errorf("unknown invoice in " +
-					"add index")
+				return fmt.Errorf("unknown invoice " +
+					"in settle index")
 			}
 
-			// Remove from the add index.
-			err = invoiceAddIndex.Delete(addIndexKey[:])
+			err = settleIndex.Delete(settleIndexKey[:])
 			if err != nil {
 				return err
 			}
+		}
 
-			// Remove from the settle index if available and
-			// if the invoice is settled.
-			if settleIndex != nil && ref.SettleIndex > 0 {
-				var settleIndexKey [8]byte
-				byteOrder.PutUint64(
-					settleIndexKey[:], ref.SettleIndex,
-				)
-
-				// To ensure consistency check that the already
-				// fetched invoice key matches the one in the
-				// settle index
-				key := settleIndex.Get(settleIndexKey[:])
-				if !bytes.Equal(key, invoiceKey) {
-					return fmt.Errorf("unknown invoice " +
-						"in settle index")
-				}
+		// Remove the invoice from the date and state indexes.
+		// The keys of these indexes contain the dates and state
+		// of the invoice, so we need to read it first.
+		invoice, err := fetchInvoice(invoiceKey, invoices)
+		if err != nil {
+			return err
+		}
 
-				err = settleIndex.Delete(settleIndexKey[:])
-				if err != nil {
-					return err
-				}
-			}
+		err = deleteSearchIndexes(
+			creationDateIndex, settleDateIndex, stateIndex,
+			&invoice,
+		)
+		if err != nil {
+			return err
+		}
 
-			// Finally remove the serialized invoice from the
-			// invoice bucket.
-			err = invoices.Delete(invoiceKey)
-			if err != nil {
+		if hashIndex != nil {
+			if err := hashIndex.Delete(invoiceKey); err != nil {
 				return err
 			}
 		}
 
-		return nil
-	}, func() {})
+		// Finally remove the serialized invoice from the
+		// invoice bucket.
+		err = invoices.Delete(invoiceKey)
+		if err != nil {
+			return err
+		}
+	}
 
-	return err
+	return nil
 }
@@ -0,0 +1,275 @@
+package migration23
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// byteOrder is the byte order used for all keys and values.
+	byteOrder = binary.BigEndian
+
+	// invoiceBucket is the name of the bucket within the database that
+	// stores all data related to invoices no matter their final state.
+	invoiceBucket = []byte("invoices")
+
+	// creationDateIndexBucket is the name of the sub-bucket within the
+	// invoiceBucket which indexes all invoices by their creation date.
+	//
+	// maps: creationNanos || addIndex => invoiceKey
+	creationDateIndexBucket = []byte("invoice-creation-date-index")
+
+	// settleDateIndexBucket is the name of the sub-bucket within the
+	// invoiceBucket which indexes all settled invoices by their settle
+	// date.
+	//
+	// maps: settleNanos || addIndex => invoiceKey
+	settleDateIndexBucket = []byte("invoice-settle-date-index")
+
+	// invoiceStateIndexBucket is the name of the sub-bucket within the
+	// invoiceBucket which indexes all invoices by their state.
+	//
+	// maps: state || addIndex => invoiceKey
+	invoiceStateIndexBucket = []byte("invoice-state-index")
+
+	// invoiceHashIndexBucket is the name of the sub-bucket within the
+	// invoiceBucket which maps all invoices to their payment hash.
+	//
+	// maps: invoiceKey => payHash
+	invoiceHashIndexBucket = []byte("invoice-hash-index")
+
+	// invoiceIndexBucket is the name of the sub-bucket within the
+	// invoiceBucket which indexes all invoices by their payment hash.
+	//
+	// maps: payHash => invoiceKey
+	invoiceIndexBucket = []byte("paymenthashes")
+
+	// numInvoicesKey is the key within the invoiceIndexBucket which
+	// stores the number of invoices.
+	numInvoicesKey = []byte("nik")
+)
+
+const (
+	// The tlv types of the invoice fields that make up the search
+	// indexes.
+	createTimeType  tlv.Type = 2
+	settleTimeType  tlv.Type = 3
+	addIndexType    tlv.Type = 4
+	settleIndexType tlv.Type = 5
+	invStateType    tlv.Type = 12
+)
+
+// invoiceIndexFields holds the invoice fields that make up the search
+// indexes.
+type invoiceIndexFields struct {
+	invoiceKey   []byte
+	creationDate time.Time
+	settleDate   time.Time
+	addIndex     uint64
+	settleIndex  uint64
+	state        uint8
+}
+
+// MigrateInvoiceSearchIndexes populates the creation date, settle date, state
+// and hash indexes with the invoices that the node already has.
+func MigrateInvoiceSearchIndexes(tx kvdb.RwTx) error {
+	log.Info("Migrating invoices to the search indexes")
+
+	invoices := tx.ReadWriteBucket(invoiceBucket)
+	if invoices == nil {
+		return nil
+	}
+
+	// First collect the index fields of all invoices. We don't add to the
+	// indexes while iterating the invoice bucket, as the indexes are
+	// nested within it.
+	var allFields []*invoiceIndexFields
+	err := invoices.ForEach(func(k, v []byte) error {
+		// Skip the nested index buckets.
+		if v == nil {
+			return nil
+		}
+
+		fields, err := deserializeIndexFields(bytes.NewReader(v))
+		if err != nil {
+			return err
+		}
+
+		fields.invoiceKey = append([]byte(nil), k...)
+		allFields = append(allFields, fields)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	creationIndex, err := invoices.CreateBucketIfNotExists(
+		creationDateIndexBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	settleIndex, err := invoices.CreateBucketIfNotExists(
+		settleDateIndexBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	stateIndex, err := invoices.CreateBucketIfNotExists(
+		invoiceStateIndexBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := migrateHashIndex(invoices); err != nil {
+		return err
+	}
+
+	for _, fields := range allFields {
+		err := creationIndex.Put(
+			dateIndexKey(fields.creationDate, fields.addIndex),
+			fields.invoiceKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = stateIndex.Put(
+			stateIndexKey(fields.state, fields.addIndex),
+			fields.invoiceKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		if fields.settleIndex == 0 {
+			continue
+		}
+
+		err = settleIndex.Put(
+			dateIndexKey(fields.settleDate, fields.addIndex),
+			fields.invoiceKey,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Added %d invoices to the search indexes", len(allFields))
+
+	return nil
+}
+
+// migrateHashIndex maps all invoices in the payment hash index to their
+// payment hash.
+func migrateHashIndex(invoices kvdb.RwBucket) error {
+	invoiceIndex := invoices.NestedReadBucket(invoiceIndexBucket)
+	if invoiceIndex == nil {
+		return nil
+	}
+
+	hashes := make(map[string][]byte)
+	err := invoiceIndex.ForEach(func(k, v []byte) error {
+		if bytes.Equal(k, numInvoicesKey) {
+			return nil
+		}
+
+		hashes[string(v)] = append([]byte(nil), k...)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	hashIndex, err := invoices.CreateBucketIfNotExists(
+		invoiceHashIndexBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	for invoiceKey, payHash := range hashes {
+		err := hashIndex.Put([]byte(invoiceKey), payHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dateIndexKey returns the key of an invoice within one of the date indexes.
+func dateIndexKey(date time.Time, addIndex uint64) []byte {
+	var nanos uint64
+	if !date.IsZero() {
+		nanos = uint64(date.UnixNano())
+	}
+
+	var key [16]byte
+	byteOrder.PutUint64(key[:8], nanos)
+	byteOrder.PutUint64(key[8:], addIndex)
+
+	return key[:]
+}
+
+// stateIndexKey returns the key of an invoice within the state index.
+func stateIndexKey(state uint8, addIndex uint64) []byte {
+	var key [9]byte
+	key[0] = state
+	byteOrder.PutUint64(key[1:], addIndex)
+
+	return key[:]
+}
+
+// deserializeIndexFields reads the dates, indexes and state of a serialized
+// invoice. All other fields of the invoice are skipped.
+func deserializeIndexFields(r io.Reader) (*invoiceIndexFields, error) {
+	var (
+		fields            invoiceIndexFields
+		creationDateBytes []byte
+		settleDateBytes   []byte
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(createTimeType, &creationDateBytes),
+		tlv.MakePrimitiveRecord(settleTimeType, &settleDateBytes),
+		tlv.MakePrimitiveRecord(addIndexType, &fields.addIndex),
+		tlv.MakePrimitiveRecord(settleIndexType, &fields.settleIndex),
+		tlv.MakePrimitiveRecord(invStateType, &fields.state),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyLen int64
+	if err := binary.Read(r, byteOrder, &bodyLen); err != nil {
+		return nil, err
+	}
+
+	lr := io.LimitReader(r, bodyLen)
+	if err := tlvStream.Decode(lr); err != nil {
+		return nil, err
+	}
+
+	err = fields.creationDate.UnmarshalBinary(creationDateBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fields.settleDate.UnmarshalBinary(settleDateBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fields, nil
+}
@@ -0,0 +1,160 @@
+package migration23
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+	"github.com/lightningnetwork/lnd/channeldb/migtest"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// serializeTestInvoice serializes an invoice that only holds the fields that
+// make up the search indexes, plus a memo to check that unknown fields are
+// skipped.
+func serializeTestInvoice(t *testing.T, creationDate, settleDate time.Time,
+	addIndex, settleIndex uint64, state uint8) string {
+
+	creationDateBytes, err := creationDate.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	settleDateBytes, err := settleDate.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	memo := []byte("memo")
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(0, &memo),
+		tlv.MakePrimitiveRecord(createTimeType, &creationDateBytes),
+		tlv.MakePrimitiveRecord(settleTimeType, &settleDateBytes),
+		tlv.MakePrimitiveRecord(addIndexType, &addIndex),
+		tlv.MakePrimitiveRecord(settleIndexType, &settleIndex),
+		tlv.MakePrimitiveRecord(invStateType, &state),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var body bytes.Buffer
+	if err := tlvStream.Encode(&body); err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	err = binary.Write(&b, byteOrder, int64(body.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Write(body.Bytes())
+
+	return b.String()
+}
+
+// TestMigrateInvoiceSearchIndexes asserts that existing invoices are added to
+// the creation date, state and hash indexes, and settled invoices also to the
+// settle date index.
+func TestMigrateInvoiceSearchIndexes(t *testing.T) {
+	created1 := time.Unix(1000, 0)
+	created2 := time.Unix(2000, 0)
+	settled2 := time.Unix(3000, 0)
+
+	invoiceKey1 := string([]byte{0, 0, 0, 0})
+	invoiceKey2 := string([]byte{0, 0, 0, 1})
+
+	payHash1 := string(bytes.Repeat([]byte{1}, 32))
+	payHash2 := string(bytes.Repeat([]byte{2}, 32))
+	numInvoices := string([]byte{0, 0, 0, 2})
+
+	before := func(tx kvdb.RwTx) error {
+		return migtest.RestoreDB(tx, invoiceBucket, map[string]interface{}{
+			invoiceKey1: serializeTestInvoice(
+				t, created1, time.Time{}, 1, 0, 0,
+			),
+			invoiceKey2: serializeTestInvoice(
+				t, created2, settled2, 2, 1, 1,
+			),
+			"invoice-add-index": map[string]interface{}{},
+			"paymenthashes": map[string]interface{}{
+				payHash1:               invoiceKey1,
+				payHash2:               invoiceKey2,
+				string(numInvoicesKey): numInvoices,
+			},
+		})
+	}
+
+	after := func(tx kvdb.RwTx) error {
+		invoices := tx.ReadBucket(invoiceBucket)
+
+		creationIndex := invoices.NestedReadBucket(
+			creationDateIndexBucket,
+		)
+		if creationIndex == nil {
+			t.Fatal("creation date index not created")
+		}
+
+		settleIndex := invoices.NestedReadBucket(settleDateIndexBucket)
+		if settleIndex == nil {
+			t.Fatal("settle date index not created")
+		}
+
+		stateIndex := invoices.NestedReadBucket(
+			invoiceStateIndexBucket,
+		)
+		if stateIndex == nil {
+			t.Fatal("state index not created")
+		}
+
+		hashIndex := invoices.NestedReadBucket(invoiceHashIndexBucket)
+		if hashIndex == nil {
+			t.Fatal("hash index not created")
+		}
+
+		checkEntries := func(index kvdb.RBucket,
+			expected map[string]string) {
+
+			var numEntries int
+			err := index.ForEach(func(k, v []byte) error {
+				numEntries++
+				if expected[string(k)] != string(v) {
+					t.Fatalf("unexpected index entry %x", k)
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if numEntries != len(expected) {
+				t.Fatalf("expected %v entries, got %v",
+					len(expected), numEntries)
+			}
+		}
+
+		checkEntries(creationIndex, map[string]string{
+			string(dateIndexKey(created1, 1)): invoiceKey1,
+			string(dateIndexKey(created2, 2)): invoiceKey2,
+		})
+		checkEntries(settleIndex, map[string]string{
+			string(dateIndexKey(settled2, 2)): invoiceKey2,
+		})
+		checkEntries(stateIndex, map[string]string{
+			string(stateIndexKey(0, 1)): invoiceKey1,
+			string(stateIndexKey(1, 2)): invoiceKey2,
+		})
+		checkEntries(hashIndex, map[string]string{
+			invoiceKey1: payHash1,
+			invoiceKey2: payHash2,
+		})
+
+		return nil
+	}
+
+	migtest.ApplyMigration(
+		t, before, after, MigrateInvoiceSearchIndexes, false,
+	)
+}
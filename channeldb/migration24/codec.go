@@ -0,0 +1,222 @@
+package migration24
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// kidOutput holds the fields of an output incubated by the legacy utxo
+// nursery that are needed to hand it over to the sweeper.
+type kidOutput struct {
+	outpoint         wire.OutPoint
+	originChanPoint  wire.OutPoint
+	blocksToMaturity uint32
+	absoluteMaturity uint32
+	confHeight       uint32
+	witnessType      uint16
+
+	// signDesc is the serialized sign descriptor of the output, which the
+	// sweeper stores in the same encoding.
+	signDesc []byte
+
+	// output and hashType are parsed from the sign descriptor.
+	output   wire.TxOut
+	hashType txscript.SigHashType
+}
+
+// decodeKidOutput decodes a kid output as it was stored by the legacy utxo
+// nursery. The sign descriptor makes up the remainder of the value.
+func decodeKidOutput(v []byte) (*kidOutput, error) {
+	var (
+		kid    kidOutput
+		amount uint64
+		isHtlc bool
+	)
+
+	r := bytes.NewReader(v)
+	if err := binary.Read(r, byteOrder, &amount); err != nil {
+		return nil, err
+	}
+	if err := readOutpoint(r, &kid.outpoint); err != nil {
+		return nil, err
+	}
+	if err := readOutpoint(r, &kid.originChanPoint); err != nil {
+		return nil, err
+	}
+
+	elements := []interface{}{
+		&isHtlc, &kid.blocksToMaturity, &kid.absoluteMaturity,
+		&kid.confHeight, &kid.witnessType,
+	}
+	for _, element := range elements {
+		if err := binary.Read(r, byteOrder, element); err != nil {
+			return nil, err
+		}
+	}
+
+	kid.signDesc = v[len(v)-r.Len():]
+
+	err := readSignDescOutput(
+		bytes.NewReader(kid.signDesc), &kid.output, &kid.hashType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kid, nil
+}
+
+// decodeBabyTimeoutTx decodes the timeout transaction of a baby output as it
+// was stored by the legacy utxo nursery.
+func decodeBabyTimeoutTx(v []byte) (*wire.MsgTx, error) {
+	r := bytes.NewReader(v)
+
+	var expiry uint32
+	if err := binary.Read(r, byteOrder, &expiry); err != nil {
+		return nil, err
+	}
+
+	timeoutTx := &wire.MsgTx{}
+	if err := timeoutTx.Deserialize(r); err != nil {
+		return nil, err
+	}
+
+	return timeoutTx, nil
+}
+
+// readSignDescOutput reads the output and sighash type from a serialized sign
+// descriptor, skipping all other fields.
+func readSignDescOutput(r io.Reader, output *wire.TxOut,
+	hashType *txscript.SigHashType) error {
+
+	var (
+		family, index uint32
+		hasKey        bool
+	)
+	for _, element := range []interface{}{&family, &index, &hasKey} {
+		if err := binary.Read(r, byteOrder, element); err != nil {
+			return err
+		}
+	}
+
+	if hasKey {
+		if _, err := wire.ReadVarBytes(r, 0, 34, "pubkey"); err != nil {
+			return err
+		}
+	}
+
+	_, err := wire.ReadVarBytes(r, 0, 32, "singleTweak")
+	if err != nil {
+		return err
+	}
+	_, err = wire.ReadVarBytes(r, 0, 32, "doubleTweak")
+	if err != nil {
+		return err
+	}
+	_, err = wire.ReadVarBytes(r, 0, 500, "witnessScript")
+	if err != nil {
+		return err
+	}
+
+	var value uint64
+	if err := binary.Read(r, byteOrder, &value); err != nil {
+		return err
+	}
+	output.Value = int64(value)
+
+	output.PkScript, err = wire.ReadVarBytes(r, 0, 80, "pkScript")
+	if err != nil {
+		return err
+	}
+
+	var sigHashType uint32
+	if err := binary.Read(r, byteOrder, &sigHashType); err != nil {
+		return err
+	}
+	*hashType = txscript.SigHashType(sigHashType)
+
+	return nil
+}
+
+// readOutpoint reads an outpoint in the encoding of the legacy utxo nursery,
+// which prefixes the hash with a var int length.
+func readOutpoint(r io.Reader, o *wire.OutPoint) error {
+	txid, err := wire.ReadVarBytes(r, 0, chainhash.HashSize, "prevout")
+	if err != nil {
+		return err
+	}
+	copy(o.Hash[:], txid)
+
+	return binary.Read(r, byteOrder, &o.Index)
+}
+
+// outpointKey returns the key of an outpoint in the sweeper store and the
+// arbitrator log: txid || index.
+func outpointKey(o *wire.OutPoint) []byte {
+	var key [resolverIDLen]byte
+	copy(key[:], o.Hash[:])
+	byteOrder.PutUint32(key[chainhash.HashSize:], o.Index)
+
+	return key[:]
+}
+
+// serializeSweeperInput serializes a kid output as the stored state of a
+// sweeper input, which carries the full sign descriptor so that the sweeper
+// sweeps it without waiting for an owner to offer it.
+func serializeSweeperInput(w io.Writer, kid *kidOutput) error {
+	err := binary.Write(w, byteOrder, kid.witnessType)
+	if err != nil {
+		return err
+	}
+
+	err = binary.Write(w, byteOrder, kid.output.Value)
+	if err != nil {
+		return err
+	}
+	err = wire.WriteVarBytes(w, 0, kid.output.PkScript)
+	if err != nil {
+		return err
+	}
+
+	// The output may only be published once its relative lock expires.
+	minPublishHeight := kid.confHeight
+	if kid.blocksToMaturity > 0 {
+		minPublishHeight += kid.blocksToMaturity - 1
+	}
+
+	var (
+		feeRate, budget, lastFeeRate int64
+		force, hasGroup, updated     bool
+		group                        uint64
+		deadlineHeight               int32
+		publishAttempts              uint32
+	)
+	elements := []interface{}{
+		kid.confHeight,
+		uint32(kndrConfTarget), feeRate, force,
+		hasGroup, group, deadlineHeight, budget,
+		updated, int32(kid.confHeight),
+		int32(minPublishHeight), publishAttempts,
+		lastFeeRate, uint32(kid.hashType), true,
+	}
+	for _, element := range elements {
+		if err := binary.Write(w, byteOrder, element); err != nil {
+			return err
+		}
+	}
+
+	if _, err := w.Write(kid.signDesc); err != nil {
+		return err
+	}
+
+	return binary.Write(
+		w, byteOrder, []uint32{
+			kid.blocksToMaturity, kid.absoluteMaturity,
+		},
+	)
+}
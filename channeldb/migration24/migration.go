@@ -0,0 +1,400 @@
+package migration24
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+)
+
+// The utxo nursery used to incubate time-locked commitment and HTLC outputs
+// in its own store, before sweeping them into the wallet. This is now done by
+// the contract resolvers and the sweeper. The layout of the legacy store is:
+//
+//   utxn<chain-hash>/
+//   |
+//   ├── channel-index/
+//   │   └── <chan-point>/
+//   |       └── <state-prefix><outpoint>: <spendable-output>
+//   |
+//   └── height-index/
+//       └── <height>/
+//           ├── finalized-kndr-txn: <tx>
+//           └── <chan-point>/
+//                └── <state-prefix><outpoint>: ""
+
+var (
+	// byteOrder is the byte order used for all keys and values.
+	byteOrder = binary.BigEndian
+
+	// closedChannelBucket is the bucket that stores the summaries of all
+	// closed channels, keyed by their channel point.
+	closedChannelBucket = []byte("closed-chan-bucket")
+
+	// utxnChainPrefix is used to prefix a particular chain hash and create
+	// the root-level, chain-segmented bucket of the legacy nursery store.
+	utxnChainPrefix = []byte("utxn")
+
+	// channelIndexKey is the key of the bucket containing all of the
+	// legacy nursery's active channels.
+	channelIndexKey = []byte("channel-index")
+
+	// heightIndexKey is the key of the bucket containing all heights for
+	// which the legacy nursery needed to take action.
+	heightIndexKey = []byte("height-index")
+
+	// finalizedKndrTxnKey is the key under which the legacy nursery stored
+	// the kindergarten sweep transaction of a height.
+	finalizedKndrTxnKey = []byte("finalized-kndr-txn")
+
+	// The state prefixes of the outputs in the legacy nursery store.
+	psclPrefix = []byte("pscl")
+	crbtPrefix = []byte("crbt")
+	kndrPrefix = []byte("kndr")
+	gradPrefix = []byte("grad")
+
+	// contractsBucketKey is the bucket within the arbitrator log scope of
+	// a channel that stores its unresolved contracts, keyed by resolver
+	// id.
+	contractsBucketKey = []byte("contractkey")
+
+	// pendingInputsBucketKey is the sweeper bucket that stores the inputs
+	// it is attempting to sweep.
+	//
+	// maps: outpoint -> serialized_stored_input
+	pendingInputsBucketKey = []byte("sweeper-pending-inputs")
+
+	// txHashesBucketKey is the sweeper bucket that stores the hashes of
+	// all sweep txes that were published.
+	//
+	// maps: txHash -> empty slice
+	txHashesBucketKey = []byte("sweeper-tx-hashes")
+)
+
+const (
+	// closeSummaryChainHashOffset is the offset of the chain hash in a
+	// serialized close summary, which starts with the channel point and
+	// the short channel id.
+	closeSummaryChainHashOffset = 36 + 8
+
+	// resolverIDLen is the length of the key of a resolver in the
+	// arbitrator log: txid || index.
+	resolverIDLen = 36
+
+	// kndrConfTarget is the confirmation target the legacy nursery used
+	// to sweep kindergarten outputs.
+	kndrConfTarget = 6
+)
+
+// MigrateNurseryStore hands the outputs of the legacy utxo nursery over and
+// removes its store. Kindergarten outputs, whose parent transaction confirmed,
+// are stored as sweeper inputs with their full sign descriptor, so that the
+// sweeper sweeps them once their locks expire. Preschool and crib outputs are
+// still awaiting the confirmation of their parent, and are taken over by the
+// resolvers of their contracts, which republish the parent and then sweep the
+// output. The migration fails without removing anything if such an output has
+// no resolver in the arbitrator log of its channel.
+func MigrateNurseryStore(tx kvdb.RwTx) error {
+	log.Info("Migrating utxo nursery outputs to the sweeper")
+
+	chainHashes, err := fetchChainHashes(tx)
+	if err != nil {
+		return err
+	}
+
+	for _, chainHash := range chainHashes {
+		if err := migrateChain(tx, chainHash); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fetchChainHashes returns the chain hashes of all closed channels. The
+// nursery only incubated outputs of closed channels, so these are the only
+// chains that can have a nursery store.
+func fetchChainHashes(tx kvdb.RwTx) ([]chainhash.Hash, error) {
+	closedBucket := tx.ReadBucket(closedChannelBucket)
+	if closedBucket == nil {
+		return nil, nil
+	}
+
+	var (
+		chainHashes []chainhash.Hash
+		seen        = make(map[chainhash.Hash]struct{})
+	)
+	err := closedBucket.ForEach(func(_, v []byte) error {
+		offset := closeSummaryChainHashOffset
+		if len(v) < offset+chainhash.HashSize {
+			return nil
+		}
+
+		var chainHash chainhash.Hash
+		copy(chainHash[:], v[offset:offset+chainhash.HashSize])
+
+		if _, ok := seen[chainHash]; ok {
+			return nil
+		}
+		seen[chainHash] = struct{}{}
+		chainHashes = append(chainHashes, chainHash)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return chainHashes, nil
+}
+
+// migrateChain migrates the nursery store of a single chain.
+func migrateChain(tx kvdb.RwTx, chainHash chainhash.Hash) error {
+	chainKey := make([]byte, 0, len(utxnChainPrefix)+chainhash.HashSize)
+	chainKey = append(chainKey, utxnChainPrefix...)
+	chainKey = append(chainKey, chainHash[:]...)
+
+	chainBucket := tx.ReadBucket(chainKey)
+	if chainBucket == nil {
+		return nil
+	}
+
+	// First, we'll collect the outputs to hand over to the sweeper and
+	// check that all other outputs are taken over by a resolver. We don't
+	// write while iterating the nursery store.
+	var kids []*kidOutput
+	chanIndex := chainBucket.NestedReadBucket(channelIndexKey)
+	if chanIndex != nil {
+		err := chanIndex.ForEach(func(chanBytes, v []byte) error {
+			// Only the nested channel buckets are of interest.
+			if v != nil {
+				return nil
+			}
+
+			var chanPoint wire.OutPoint
+			r := bytes.NewReader(chanBytes)
+			if err := readOutpoint(r, &chanPoint); err != nil {
+				return err
+			}
+
+			chanKids, err := migrateChannel(
+				tx, chainHash, chanPoint,
+				chanIndex.NestedReadBucket(chanBytes),
+			)
+			if err != nil {
+				return err
+			}
+			kids = append(kids, chanKids...)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	sweepTxids, err := fetchFinalizedTxids(chainBucket)
+	if err != nil {
+		return err
+	}
+
+	// Now we can store the kindergarten outputs as sweeper inputs.
+	inputsBucket, err := tx.CreateTopLevelBucket(pendingInputsBucketKey)
+	if err != nil {
+		return err
+	}
+
+	for _, kid := range kids {
+		// The sweeper may already know about the output.
+		key := outpointKey(&kid.outpoint)
+		if inputsBucket.Get(key) != nil {
+			continue
+		}
+
+		var b bytes.Buffer
+		if err := serializeSweeperInput(&b, kid); err != nil {
+			return err
+		}
+
+		if err := inputsBucket.Put(key, b.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	// The nursery's own sweep transactions are added to the sweeper's
+	// transactions, so that their outputs are recognized as sweeps.
+	txHashesBucket, err := tx.CreateTopLevelBucket(txHashesBucketKey)
+	if err != nil {
+		return err
+	}
+
+	for _, txid := range sweepTxids {
+		if err := txHashesBucket.Put(txid[:], []byte{}); err != nil {
+			return err
+		}
+	}
+
+	return tx.DeleteTopLevelBucket(chainKey)
+}
+
+// migrateChannel returns the kindergarten outputs of a channel in the nursery
+// store. An error is returned if any preschool or crib output of the channel
+// isn't taken over by a resolver in the channel's arbitrator log.
+func migrateChannel(tx kvdb.RwTx, chainHash chainhash.Hash,
+	chanPoint wire.OutPoint, chanBucket kvdb.RBucket) ([]*kidOutput,
+	error) {
+
+	contracts := fetchContracts(tx, chainHash, chanPoint)
+
+	var kids []*kidOutput
+	err := chanBucket.ForEach(func(k, v []byte) error {
+		switch {
+		// Graduated outputs have been swept already.
+		case bytes.HasPrefix(k, gradPrefix):
+			return nil
+
+		case bytes.HasPrefix(k, kndrPrefix):
+			kid, err := decodeKidOutput(v)
+			if err != nil {
+				return err
+			}
+
+			log.Infof("Handing nursery output %v of "+
+				"ChannelPoint(%v) over to the sweeper",
+				kid.outpoint, chanPoint)
+
+			kids = append(kids, kid)
+
+			return nil
+
+		// A crib output is the output of an HTLC timeout transaction
+		// that hasn't confirmed yet. The timeout resolver of the HTLC
+		// is keyed by the HTLC output that the transaction spends.
+		case bytes.HasPrefix(k, crbtPrefix):
+			timeoutTx, err := decodeBabyTimeoutTx(v)
+			if err != nil {
+				return err
+			}
+			if len(timeoutTx.TxIn) == 0 {
+				return fmt.Errorf("nursery timeout tx %v "+
+					"has no inputs", timeoutTx.TxHash())
+			}
+
+			htlcOutpoint := timeoutTx.TxIn[0].PreviousOutPoint
+			if !hasResolver(contracts, htlcOutpoint) {
+				return fmt.Errorf("no resolver for nursery "+
+					"htlc %v of ChannelPoint(%v)",
+					htlcOutpoint, chanPoint)
+			}
+
+			return nil
+
+		// A preschool output is waiting for its parent transaction to
+		// confirm, which the resolvers of the channel take care of.
+		case bytes.HasPrefix(k, psclPrefix):
+			if !hasUnresolvedContracts(contracts) {
+				return fmt.Errorf("no resolver for nursery "+
+					"output %x of ChannelPoint(%v)", k,
+					chanPoint)
+			}
+
+			return nil
+
+		default:
+			return fmt.Errorf("unknown nursery output key %x", k)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return kids, nil
+}
+
+// fetchContracts returns the bucket holding the unresolved contracts in the
+// arbitrator log of a channel, or nil if there are none.
+func fetchContracts(tx kvdb.RwTx, chainHash chainhash.Hash,
+	chanPoint wire.OutPoint) kvdb.RBucket {
+
+	scope := make([]byte, 0, chainhash.HashSize+resolverIDLen)
+	scope = append(scope, chainHash[:]...)
+	scope = append(scope, outpointKey(&chanPoint)...)
+
+	scopeBucket := tx.ReadBucket(scope)
+	if scopeBucket == nil {
+		return nil
+	}
+
+	return scopeBucket.NestedReadBucket(contractsBucketKey)
+}
+
+// hasResolver returns true if the contracts bucket holds a resolver that is
+// keyed by the given outpoint.
+func hasResolver(contracts kvdb.RBucket, op wire.OutPoint) bool {
+	if contracts == nil {
+		return false
+	}
+
+	return contracts.Get(outpointKey(&op)) != nil
+}
+
+// hasUnresolvedContracts returns true if the contracts bucket holds any
+// resolver.
+func hasUnresolvedContracts(contracts kvdb.RBucket) bool {
+	if contracts == nil {
+		return false
+	}
+
+	var found bool
+	_ = contracts.ForEach(func(k, _ []byte) error {
+		if len(k) == resolverIDLen {
+			found = true
+		}
+		return nil
+	})
+
+	return found
+}
+
+// fetchFinalizedTxids returns the hashes of the kindergarten sweep
+// transactions that the legacy nursery finalized.
+func fetchFinalizedTxids(chainBucket kvdb.RBucket) ([]chainhash.Hash, error) {
+	hghtIndex := chainBucket.NestedReadBucket(heightIndexKey)
+	if hghtIndex == nil {
+		return nil, nil
+	}
+
+	var txids []chainhash.Hash
+	err := hghtIndex.ForEach(func(k, v []byte) error {
+		hghtBucket := hghtIndex.NestedReadBucket(k)
+		if hghtBucket == nil {
+			return nil
+		}
+
+		txBytes := hghtBucket.Get(finalizedKndrTxnKey)
+		if txBytes == nil {
+			return nil
+		}
+
+		// Skip the tx if it cannot be deserialized.
+		sweepTx := &wire.MsgTx{}
+		err := sweepTx.Deserialize(bytes.NewReader(txBytes))
+		if err != nil {
+			log.Warnf("Cannot deserialize nursery tx at height "+
+				"%x: %v", k, err)
+			return nil
+		}
+
+		txids = append(txids, sweepTx.TxHash())
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txids, nil
+}
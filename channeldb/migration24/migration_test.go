@@ -0,0 +1,279 @@
+package migration24
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+	"github.com/lightningnetwork/lnd/channeldb/migtest"
+)
+
+var (
+	testChainHash = chainhash.Hash{1}
+
+	testChanPoint = wire.OutPoint{Hash: chainhash.Hash{2}, Index: 1}
+
+	// testKidOutpoint is the outpoint of a kindergarten output.
+	testKidOutpoint = wire.OutPoint{Hash: chainhash.Hash{3}, Index: 2}
+
+	// testHtlcOutpoint is the htlc output that the timeout transaction of
+	// a crib output spends.
+	testHtlcOutpoint = wire.OutPoint{Hash: chainhash.Hash{4}, Index: 3}
+
+	testSweepTx = &wire.MsgTx{
+		Version: 2,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{
+				Hash: chainhash.Hash{5},
+			},
+		}},
+		TxOut: []*wire.TxOut{{Value: 1000}},
+	}
+)
+
+// writeTestElements writes the elements in the big endian byte order.
+func writeTestElements(t *testing.T, w *bytes.Buffer,
+	elements ...interface{}) {
+
+	for _, element := range elements {
+		if err := binary.Write(w, byteOrder, element); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// varOutpoint returns the legacy nursery encoding of an outpoint.
+func varOutpoint(t *testing.T, op *wire.OutPoint) []byte {
+	var b bytes.Buffer
+	if err := wire.WriteVarBytes(&b, 0, op.Hash[:]); err != nil {
+		t.Fatal(err)
+	}
+	writeTestElements(t, &b, op.Index)
+
+	return b.Bytes()
+}
+
+// testSignDesc returns a serialized sign descriptor without a public key.
+func testSignDesc(t *testing.T) []byte {
+	var b bytes.Buffer
+	writeTestElements(t, &b, uint32(4), uint32(7), false)
+
+	for _, varBytes := range [][]byte{nil, nil, {0x51}} {
+		if err := wire.WriteVarBytes(&b, 0, varBytes); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeTestElements(t, &b, uint64(50000))
+	if err := wire.WriteVarBytes(&b, 0, []byte{0, 32, 9}); err != nil {
+		t.Fatal(err)
+	}
+	writeTestElements(t, &b, uint32(txscript.SigHashAll))
+
+	return b.Bytes()
+}
+
+// kidOutputBytes returns the legacy nursery encoding of a kid output with the
+// given locks and confirmation height.
+func kidOutputBytes(t *testing.T, op *wire.OutPoint, csv, cltv,
+	confHeight uint32) []byte {
+
+	var b bytes.Buffer
+	writeTestElements(t, &b, uint64(50000))
+	b.Write(varOutpoint(t, op))
+	b.Write(varOutpoint(t, &testChanPoint))
+	writeTestElements(
+		t, &b, true, csv, cltv, confHeight, uint16(7),
+	)
+	b.Write(testSignDesc(t))
+
+	return b.Bytes()
+}
+
+// babyOutputBytes returns the legacy nursery encoding of a baby output whose
+// timeout transaction spends the given htlc output.
+func babyOutputBytes(t *testing.T, htlcOutpoint wire.OutPoint) []byte {
+	timeoutTx := &wire.MsgTx{
+		Version: 2,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: htlcOutpoint,
+		}},
+		TxOut: []*wire.TxOut{{Value: 40000}},
+	}
+
+	var b bytes.Buffer
+	writeTestElements(t, &b, uint32(500))
+	if err := timeoutTx.Serialize(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	op := wire.OutPoint{Hash: timeoutTx.TxHash()}
+	b.Write(kidOutputBytes(t, &op, 144, 0, 0))
+
+	return b.Bytes()
+}
+
+// expectedSweeperInput returns the sweeper encoding of the kindergarten output
+// written by kidOutputBytes.
+func expectedSweeperInput(t *testing.T, csv, cltv, confHeight uint32) string {
+	var b bytes.Buffer
+	writeTestElements(t, &b, uint16(7), int64(50000))
+	if err := wire.WriteVarBytes(&b, 0, []byte{0, 32, 9}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The input is swept with the nursery's conf target and without any
+	// other sweep parameters or attempts yet.
+	writeTestElements(
+		t, &b, confHeight, uint32(6), int64(0), false, false,
+		uint64(0), int32(0), int64(0), false, int32(confHeight),
+		int32(confHeight+csv-1), uint32(0), int64(0),
+		uint32(txscript.SigHashAll), true,
+	)
+	b.Write(testSignDesc(t))
+	writeTestElements(t, &b, csv, cltv)
+
+	return b.String()
+}
+
+// closeSummaryBytes returns the start of a serialized close summary of the
+// test channel.
+func closeSummaryBytes() string {
+	var b bytes.Buffer
+	b.Write(outpointKey(&testChanPoint))
+	b.Write(make([]byte, 8))
+	b.Write(testChainHash[:])
+	b.Write(make([]byte, 40))
+
+	return b.String()
+}
+
+// nurseryStore returns the legacy nursery store holding the given outputs of
+// the test channel.
+func nurseryStore(t *testing.T,
+	outputs map[string]interface{}) map[string]interface{} {
+
+	var sweepTx bytes.Buffer
+	if err := testSweepTx.Serialize(&sweepTx); err != nil {
+		t.Fatal(err)
+	}
+
+	chanKey := string(varOutpoint(t, &testChanPoint))
+	return map[string]interface{}{
+		string(channelIndexKey): map[string]interface{}{
+			chanKey: outputs,
+		},
+		string(heightIndexKey): map[string]interface{}{
+			string([]byte{0, 0, 1, 0}): map[string]interface{}{
+				string(finalizedKndrTxnKey): sweepTx.String(),
+				chanKey:                     map[string]interface{}{},
+			},
+		},
+	}
+}
+
+// TestMigrateNurseryStore asserts that kindergarten outputs are handed over to
+// the sweeper, the finalized sweep txes are added to the sweeper's txes and
+// the nursery store is removed.
+func TestMigrateNurseryStore(t *testing.T) {
+	chainKey := append(append([]byte(nil), utxnChainPrefix...),
+		testChainHash[:]...)
+	scope := append(append([]byte(nil), testChainHash[:]...),
+		outpointKey(&testChanPoint)...)
+
+	kidKey := string(kndrPrefix) + string(varOutpoint(t, &testKidOutpoint))
+	babyKey := string(crbtPrefix) + string(varOutpoint(t, &testChanPoint))
+	gradKey := string(gradPrefix) + string(varOutpoint(t, &testChanPoint))
+
+	before := func(tx kvdb.RwTx) error {
+		err := migtest.RestoreDB(tx, closedChannelBucket,
+			map[string]interface{}{
+				"chan": closeSummaryBytes(),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		err = migtest.RestoreDB(tx, chainKey, nurseryStore(
+			t, map[string]interface{}{
+				kidKey: string(kidOutputBytes(
+					t, &testKidOutpoint, 144, 0, 300,
+				)),
+				babyKey: string(babyOutputBytes(
+					t, testHtlcOutpoint,
+				)),
+				gradKey: "",
+			},
+		))
+		if err != nil {
+			return err
+		}
+
+		return migtest.RestoreDB(tx, scope, map[string]interface{}{
+			string(contractsBucketKey): map[string]interface{}{
+				string(outpointKey(&testHtlcOutpoint)): "",
+			},
+		})
+	}
+
+	after := func(tx kvdb.RwTx) error {
+		if tx.ReadBucket(chainKey) != nil {
+			t.Fatal("nursery store not removed")
+		}
+
+		sweepTxid := testSweepTx.TxHash()
+		err := migtest.VerifyDB(tx, txHashesBucketKey,
+			map[string]interface{}{
+				string(sweepTxid[:]): "",
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		kidInput := expectedSweeperInput(t, 144, 0, 300)
+		return migtest.VerifyDB(tx, pendingInputsBucketKey,
+			map[string]interface{}{
+				string(outpointKey(&testKidOutpoint)): kidInput,
+			},
+		)
+	}
+
+	migtest.ApplyMigration(t, before, after, MigrateNurseryStore, false)
+}
+
+// TestMigrateNurseryStoreNoResolver asserts that the migration fails, leaving
+// the nursery store in place, if a crib output isn't taken over by a resolver.
+func TestMigrateNurseryStoreNoResolver(t *testing.T) {
+	chainKey := append(append([]byte(nil), utxnChainPrefix...),
+		testChainHash[:]...)
+
+	babyKey := string(crbtPrefix) + string(varOutpoint(t, &testChanPoint))
+	store := nurseryStore(t, map[string]interface{}{
+		babyKey: string(babyOutputBytes(t, testHtlcOutpoint)),
+	})
+
+	before := func(tx kvdb.RwTx) error {
+		err := migtest.RestoreDB(tx, closedChannelBucket,
+			map[string]interface{}{
+				"chan": closeSummaryBytes(),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		return migtest.RestoreDB(tx, chainKey, store)
+	}
+
+	after := func(tx kvdb.RwTx) error {
+		return migtest.VerifyDB(tx, chainKey, store)
+	}
+
+	migtest.ApplyMigration(t, before, after, MigrateNurseryStore, true)
+}
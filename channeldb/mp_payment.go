@@ -29,6 +29,12 @@ type HTLCAttemptInfo struct {
 
 	// AttemptTime is the time at which this HTLC was attempted.
 	AttemptTime time.Time
+
+	// TimePref is the time preference that path finding used to find the
+	// route of this attempt. It ranges from -1 (cheapest) to 1 (most
+	// reliable). Attempts that were made before the time preference was
+	// introduced report 0.
+	TimePref float64
 }
 
 // HTLCAttempt contains information about a specific HTLC attempt for a given
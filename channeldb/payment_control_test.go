@@ -770,6 +770,173 @@ func TestPaymentControlDeletePayments(t *testing.T) {
 	})
 }
 
+// createTestPayment creates a payment with the given creation time and final
+// status. Succeeded payments have one failed and one settled attempt, failed
+// payments a single failed attempt and in-flight payments a single in-flight
+// attempt.
+func createTestPayment(t *testing.T, pControl *PaymentControl,
+	creationTime time.Time, status PaymentStatus,
+	attemptID *uint64) lntypes.Hash {
+
+	t.Helper()
+
+	info, attempt, preimg, err := genInfo()
+	require.NoError(t, err)
+	info.CreationTime = creationTime
+
+	require.NoError(t, pControl.InitPayment(info.PaymentHash, info))
+
+	registerAttempt := func() uint64 {
+		attempt.AttemptID = *attemptID
+		*attemptID++
+
+		_, err := pControl.RegisterAttempt(info.PaymentHash, attempt)
+		require.NoError(t, err)
+
+		return attempt.AttemptID
+	}
+
+	failAttempt := func(id uint64) {
+		_, err := pControl.FailAttempt(
+			info.PaymentHash, id, &HTLCFailInfo{
+				Reason: HTLCFailUnreadable,
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	switch status {
+	case StatusSucceeded:
+		failAttempt(registerAttempt())
+
+		_, err := pControl.SettleAttempt(
+			info.PaymentHash, registerAttempt(),
+			&HTLCSettleInfo{
+				Preimage: preimg,
+			},
+		)
+		require.NoError(t, err)
+
+	case StatusFailed:
+		failAttempt(registerAttempt())
+
+		_, err := pControl.Fail(info.PaymentHash, FailureReasonNoRoute)
+		require.NoError(t, err)
+
+	case StatusInFlight:
+		registerAttempt()
+	}
+
+	return info.PaymentHash
+}
+
+// TestPaymentControlDeleteSinglePayment tests that single payments and their
+// failed attempts can be deleted, but that in-flight payments are kept.
+func TestPaymentControlDeleteSinglePayment(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	pControl := NewPaymentControl(db)
+
+	var attemptID uint64
+	now := time.Unix(time.Now().Unix(), 0)
+	succeeded := createTestPayment(
+		t, pControl, now, StatusSucceeded, &attemptID,
+	)
+	failed := createTestPayment(t, pControl, now, StatusFailed, &attemptID)
+	inFlight := createTestPayment(
+		t, pControl, now, StatusInFlight, &attemptID,
+	)
+
+	// In-flight payments can't be deleted.
+	require.Error(t, db.DeletePayment(inFlight, false))
+	require.Error(t, db.DeletePayment(inFlight, true))
+
+	// Deleting the failed attempts of the succeeded payment keeps the
+	// payment itself.
+	require.NoError(t, db.DeletePayment(succeeded, true))
+
+	payment, err := pControl.FetchPayment(succeeded)
+	require.NoError(t, err)
+	require.Len(t, payment.HTLCs, 1)
+	require.Nil(t, payment.HTLCs[0].Failure)
+
+	// Delete the failed payment entirely. Deleting it again fails, as it
+	// no longer exists.
+	require.NoError(t, db.DeletePayment(failed, false))
+	require.Equal(
+		t, ErrPaymentNotInitiated, db.DeletePayment(failed, false),
+	)
+
+	// The payment must also be removed from the sequence number index, so
+	// that queries only return the remaining payments.
+	resp, err := db.QueryPayments(PaymentsQuery{
+		MaxPayments:       10,
+		IncludeIncomplete: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Payments, 2)
+	require.Equal(t, succeeded, resp.Payments[0].Info.PaymentHash)
+	require.Equal(t, inFlight, resp.Payments[1].Info.PaymentHash)
+}
+
+// TestDeleteResolvedPayments tests that only succeeded and failed payments
+// that were created before the given time are deleted, and that new payments
+// keep receiving increasing sequence numbers.
+func TestDeleteResolvedPayments(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	pControl := NewPaymentControl(db)
+
+	var attemptID uint64
+	old := time.Unix(1000, 0)
+	cutoff := time.Unix(2000, 0)
+	recent := time.Unix(3000, 0)
+
+	createTestPayment(t, pControl, old, StatusSucceeded, &attemptID)
+	createTestPayment(t, pControl, old, StatusFailed, &attemptID)
+	oldInFlight := createTestPayment(
+		t, pControl, old, StatusInFlight, &attemptID,
+	)
+	recentSucceeded := createTestPayment(
+		t, pControl, recent, StatusSucceeded, &attemptID,
+	)
+
+	// Delete the payments in batches of a single payment, to check that
+	// the deletion continues with the next batch.
+	numDeleted, err := db.deleteResolvedPayments(cutoff, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, numDeleted)
+
+	payments, err := db.FetchPayments()
+	require.NoError(t, err)
+	require.Len(t, payments, 2)
+	require.Equal(t, oldInFlight, payments[0].Info.PaymentHash)
+	require.Equal(t, recentSucceeded, payments[1].Info.PaymentHash)
+	lastSeqNr := payments[1].SequenceNum
+
+	// Pruning again doesn't delete anything.
+	numDeleted, err = db.DeleteResolvedPayments(cutoff)
+	require.NoError(t, err)
+	require.Zero(t, numDeleted)
+
+	// A new payment receives a higher sequence number than all payments
+	// that were created before.
+	newPayment := createTestPayment(
+		t, pControl, recent, StatusInFlight, &attemptID,
+	)
+	payment, err := pControl.FetchPayment(newPayment)
+	require.NoError(t, err)
+	require.Greater(t, payment.SequenceNum, lastSeqNr)
+}
+
 // TestPaymentControlMultiShard checks the ability of payment control to
 // have multiple in-flight HTLCs for a single payment.
 func TestPaymentControlMultiShard(t *testing.T) {
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"sort"
 	"time"
 
@@ -730,34 +731,9 @@ func (db *DB) DeletePayments(failedOnly, failedHtlcsOnly bool) error {
 
 			// If we are only deleting failed HTLCs, fetch them.
 			if failedHtlcsOnly {
-				htlcsBucket := bucket.NestedReadBucket(
-					paymentHtlcsBucket,
-				)
-
-				var htlcs []HTLCAttempt
-				if htlcsBucket != nil {
-					htlcs, err = fetchHtlcAttempts(
-						htlcsBucket,
-					)
-					if err != nil {
-						return err
-					}
-				}
-
-				// Now iterate though them and save the bucket
-				// keys for the failed HTLCs.
-				var toDelete [][]byte
-				for _, h := range htlcs {
-					if h.Failure == nil {
-						continue
-					}
-
-					htlcIDBytes := make([]byte, 8)
-					binary.BigEndian.PutUint64(
-						htlcIDBytes, h.AttemptID,
-					)
-
-					toDelete = append(toDelete, htlcIDBytes)
+				toDelete, err := fetchFailedHtlcKeys(bucket)
+				if err != nil {
+					return err
 				}
 
 				hash, err := lntypes.MakeHash(k)
@@ -822,6 +798,268 @@ func (db *DB) DeletePayments(failedOnly, failedHtlcsOnly bool) error {
 	}, func() {})
 }
 
+// DeletePayment deletes a single completed or failed payment from the DB. If
+// failedHtlcsOnly is set, the payment itself won't be deleted, only its failed
+// HTLC attempts. In-flight payments can't be deleted.
+func (db *DB) DeletePayment(paymentHash lntypes.Hash,
+	failedHtlcsOnly bool) error {
+
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
+		payments := tx.ReadWriteBucket(paymentsRootBucket)
+		if payments == nil {
+			return ErrPaymentNotInitiated
+		}
+
+		bucket := payments.NestedReadWriteBucket(paymentHash[:])
+		if bucket == nil {
+			return ErrPaymentNotInitiated
+		}
+
+		paymentStatus, err := fetchPaymentStatus(bucket)
+		if err != nil {
+			return err
+		}
+
+		// If the status is InFlight, we cannot safely delete the
+		// payment information.
+		if paymentStatus == StatusInFlight {
+			return fmt.Errorf("payment %v is in flight and cannot "+
+				"be deleted", paymentHash)
+		}
+
+		if failedHtlcsOnly {
+			toDelete, err := fetchFailedHtlcKeys(bucket)
+			if err != nil {
+				return err
+			}
+
+			htlcsBucket := bucket.NestedReadWriteBucket(
+				paymentHtlcsBucket,
+			)
+			for _, aid := range toDelete {
+				err := htlcsBucket.DeleteNestedBucket(aid)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+
+		return deletePaymentBucket(tx, payments, paymentHash[:])
+	}, func() {})
+}
+
+// DeleteResolvedPayments deletes all succeeded and failed payments that were
+// created before the given time, and returns the number of deleted payments.
+// In-flight payments are never deleted. The sequence numbers of the remaining
+// payments are left untouched, and new payments keep receiving higher
+// sequence numbers, so callers that paginate by sequence number are not
+// affected. The payments are deleted in batches, each within its own
+// transaction, so the deletion may stop part way through on error.
+func (db *DB) DeleteResolvedPayments(before time.Time) (int, error) {
+	return db.deleteResolvedPayments(before, resolvedDeleteBatchSize)
+}
+
+// deleteResolvedPayments deletes the resolved payments that were created
+// before the given time, processing at most batchSize payment index entries
+// per transaction.
+func (db *DB) deleteResolvedPayments(before time.Time, batchSize int) (int,
+	error) {
+
+	var (
+		numDeleted int
+		startKey   = make([]byte, 8)
+	)
+	for startKey != nil {
+		var (
+			batchDeleted int
+			nextKey      []byte
+		)
+		err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+			var err error
+			batchDeleted, nextKey, err = deleteResolvedPaymentBatch(
+				tx, startKey, before, batchSize,
+			)
+			return err
+		}, func() {
+			batchDeleted = 0
+			nextKey = nil
+		})
+		if err != nil {
+			return numDeleted, err
+		}
+
+		numDeleted += batchDeleted
+		startKey = nextKey
+	}
+
+	return numDeleted, nil
+}
+
+// deleteResolvedPaymentBatch deletes the resolved payments among the next
+// batchSize entries of the payment index, starting at the given sequence
+// number. It returns the number of deleted payments, and the sequence number
+// to continue at or nil if there are no more payments that were created before
+// the given time.
+func deleteResolvedPaymentBatch(tx kvdb.RwTx, startKey []byte,
+	before time.Time, batchSize int) (int, []byte, error) {
+
+	payments := tx.ReadWriteBucket(paymentsRootBucket)
+	if payments == nil {
+		return 0, nil, nil
+	}
+
+	indexes := tx.ReadBucket(paymentsIndexBucket)
+	if indexes == nil {
+		return 0, nil, fmt.Errorf("index bucket does not exist")
+	}
+
+	// Sequence numbers are assigned in creation order, so the payment
+	// index returns the payments in the order they were created. Collect
+	// the payments to delete first, as we can't delete from the index
+	// while iterating over it.
+	var (
+		deleteHashes [][]byte
+		nextKey      []byte
+		numScanned   int
+		cursor       = indexes.ReadCursor()
+	)
+	for k, v := cursor.Seek(startKey); k != nil; k, v = cursor.Next() {
+		if numScanned == batchSize {
+			nextKey = copySlice(k)
+			break
+		}
+		numScanned++
+
+		paymentHash, err := deserializePaymentIndex(
+			bytes.NewReader(v),
+		)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		bucket := payments.NestedReadBucket(paymentHash[:])
+		if bucket == nil {
+			return 0, nil, ErrPaymentNotInitiated
+		}
+
+		creationInfo, err := fetchCreationInfo(bucket)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		// Once we reach a payment that was created after the given
+		// time, all remaining payments were created after it too.
+		// Entries of duplicate payments point to the payment that
+		// replaced them, so we only stop at the payment's own entry.
+		if !creationInfo.CreationTime.Before(before) {
+			if bytes.Equal(bucket.Get(paymentSequenceKey), k) {
+				break
+			}
+
+			continue
+		}
+
+		paymentStatus, err := fetchPaymentStatus(bucket)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if paymentStatus != StatusSucceeded &&
+			paymentStatus != StatusFailed {
+
+			continue
+		}
+
+		deleteHashes = append(deleteHashes, copySlice(paymentHash[:]))
+	}
+
+	// Duplicate payments have multiple index entries, but are deleted
+	// only once.
+	var numDeleted int
+	for _, k := range deleteHashes {
+		if payments.NestedReadBucket(k) == nil {
+			continue
+		}
+
+		if err := deletePaymentBucket(tx, payments, k); err != nil {
+			return 0, nil, err
+		}
+		numDeleted++
+	}
+
+	return numDeleted, nextKey, nil
+}
+
+// deletePaymentBucket deletes the payment with the given hash, including any
+// duplicate payments, and removes all indexes that point to it.
+func deletePaymentBucket(tx kvdb.RwTx, payments kvdb.RwBucket,
+	paymentHash []byte) error {
+
+	bucket := payments.NestedReadBucket(paymentHash)
+	if bucket == nil {
+		return ErrPaymentNotInitiated
+	}
+
+	seqNrs, err := fetchSequenceNumbers(bucket)
+	if err != nil {
+		return err
+	}
+
+	// Copy the sequence numbers, as they are no longer valid once the
+	// payment bucket is deleted.
+	for i := range seqNrs {
+		seqNrs[i] = copySlice(seqNrs[i])
+	}
+
+	if err := payments.DeleteNestedBucket(paymentHash); err != nil {
+		return err
+	}
+
+	indexBucket := tx.ReadWriteBucket(paymentsIndexBucket)
+	for _, k := range seqNrs {
+		if err := indexBucket.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fetchFailedHtlcKeys returns the bucket keys of the failed HTLC attempts of a
+// payment.
+func fetchFailedHtlcKeys(bucket kvdb.RBucket) ([][]byte, error) {
+	htlcsBucket := bucket.NestedReadBucket(paymentHtlcsBucket)
+
+	var (
+		htlcs []HTLCAttempt
+		err   error
+	)
+	if htlcsBucket != nil {
+		htlcs, err = fetchHtlcAttempts(htlcsBucket)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Now iterate though them and save the bucket keys for the failed
+	// HTLCs.
+	var toDelete [][]byte
+	for _, h := range htlcs {
+		if h.Failure == nil {
+			continue
+		}
+
+		htlcIDBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(htlcIDBytes, h.AttemptID)
+
+		toDelete = append(toDelete, htlcIDBytes)
+	}
+
+	return toDelete, nil
+}
+
 // fetchSequenceNumbers fetches all the sequence numbers associated with a
 // payment, including those belonging to any duplicate payments.
 func fetchSequenceNumbers(paymentBucket kvdb.RBucket) ([][]byte, error) {
@@ -926,7 +1164,13 @@ func serializeHTLCAttemptInfo(w io.Writer, a *HTLCAttemptInfo) error {
 		return err
 	}
 
-	return serializeTime(w, a.AttemptTime)
+	if err := serializeTime(w, a.AttemptTime); err != nil {
+		return err
+	}
+
+	// The time preference is appended as an optional trailing field, so
+	// that attempts that were stored by older versions can still be read.
+	return WriteElements(w, math.Float64bits(a.TimePref))
 }
 
 func deserializeHTLCAttemptInfo(r io.Reader) (*HTLCAttemptInfo, error) {
@@ -945,6 +1189,19 @@ func deserializeHTLCAttemptInfo(r io.Reader) (*HTLCAttemptInfo, error) {
 		return nil, err
 	}
 
+	// Attempts stored by older versions don't have a time preference, in
+	// which case we leave it at the neutral default.
+	var timePref uint64
+	err = ReadElements(r, &timePref)
+	switch {
+	case err == io.EOF:
+		return a, nil
+
+	case err != nil:
+		return nil, err
+	}
+	a.TimePref = math.Float64frombits(timePref)
+
 	return a, nil
 }
 
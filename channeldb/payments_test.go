@@ -71,6 +71,7 @@ func makeFakeInfo() (*PaymentCreationInfo, *HTLCAttemptInfo) {
 		SessionKey:  priv,
 		Route:       testRoute,
 		AttemptTime: time.Unix(100, 0),
+		TimePref:    0.5,
 	}
 	return c, a
 }
@@ -130,6 +131,34 @@ func TestSentPaymentSerialization(t *testing.T) {
 	}
 }
 
+// TestHTLCAttemptInfoLegacySerialization asserts that attempt info that was
+// stored without a time preference can still be deserialized.
+func TestHTLCAttemptInfoLegacySerialization(t *testing.T) {
+	t.Parallel()
+
+	_, s := makeFakeInfo()
+
+	var b bytes.Buffer
+	if err := serializeHTLCAttemptInfo(&b, s); err != nil {
+		t.Fatalf("unable to serialize info: %v", err)
+	}
+
+	// Strip the trailing time preference to obtain the legacy encoding.
+	legacy := bytes.NewReader(b.Bytes()[:b.Len()-8])
+	info, err := deserializeHTLCAttemptInfo(legacy)
+	if err != nil {
+		t.Fatalf("unable to deserialize legacy info: %v", err)
+	}
+
+	if info.TimePref != 0 {
+		t.Fatalf("expected zero time pref, got %v", info.TimePref)
+	}
+	if !info.AttemptTime.Equal(s.AttemptTime) {
+		t.Fatalf("expected attempt time %v, got %v", s.AttemptTime,
+			info.AttemptTime)
+	}
+}
+
 // assertRouteEquals compares to routes for equality and returns an error if
 // they are not equal.
 func assertRouteEqual(a, b *route.Route) error {
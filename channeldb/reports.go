@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
@@ -41,6 +42,7 @@ var (
 	resolverType  tlv.Type = 2
 	outcomeType   tlv.Type = 3
 	spendTxIDType tlv.Type = 4
+	timestampType tlv.Type = 5
 )
 
 // ResolverType indicates the type of resolver that was resolved on chain.
@@ -59,6 +61,11 @@ const (
 	// ResolverTypeCommit represents resolution of our time locked commit
 	// when we force close.
 	ResolverTypeCommit ResolverType = 3
+
+	// ResolverTypeBreach represents resolution of an output of a revoked
+	// commitment that was broadcast by the remote party. These outputs
+	// are claimed by the breach arbiter's justice transaction.
+	ResolverTypeBreach ResolverType = 4
 )
 
 // ResolverOutcome indicates the outcome for the resolver that that the contract
@@ -117,6 +124,11 @@ type ResolverReport struct {
 	// claimed the outpoint. This may be a sweep transaction, or a first
 	// stage success/timeout transaction.
 	SpendTxID *chainhash.Hash
+
+	// Timestamp is the time at which the resolution was recorded. This is
+	// the zero time for reports that were written before timestamps were
+	// recorded.
+	Timestamp time.Time
 }
 
 // PutResolverReport creates and commits a transaction that is used to write a
@@ -199,6 +211,14 @@ func serializeReport(w io.Writer, report *ResolverReport) error {
 		))
 	}
 
+	// If our timestamp is set, we add a tlv entry for it as well.
+	if !report.Timestamp.IsZero() {
+		timestamp := uint64(report.Timestamp.Unix())
+		records = append(records, tlv.MakePrimitiveRecord(
+			timestampType, &timestamp,
+		))
+	}
+
 	// Create our stream and encode it.
 	tlvStream, err := tlv.NewStream(records...)
 	if err != nil {
@@ -265,7 +285,7 @@ func (d DB) FetchChannelReports(chainHash chainhash.Hash,
 func deserializeReport(r io.Reader) (*ResolverReport, error) {
 	var (
 		resolver, outcome uint8
-		amt               uint64
+		amt, timestamp    uint64
 		spentTx           []byte
 	)
 
@@ -274,6 +294,7 @@ func deserializeReport(r io.Reader) (*ResolverReport, error) {
 		tlv.MakePrimitiveRecord(resolverType, &resolver),
 		tlv.MakePrimitiveRecord(outcomeType, &outcome),
 		tlv.MakePrimitiveRecord(spendTxIDType, &spentTx),
+		tlv.MakePrimitiveRecord(timestampType, &timestamp),
 	)
 	if err != nil {
 		return nil, err
@@ -298,6 +319,11 @@ func deserializeReport(r io.Reader) (*ResolverReport, error) {
 		report.SpendTxID = spendTx
 	}
 
+	// If our timestamp is set, we set it on our report.
+	if timestamp != 0 {
+		report.Timestamp = time.Unix(int64(timestamp), 0)
+	}
+
 	return report, nil
 }
 
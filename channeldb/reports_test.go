@@ -3,6 +3,7 @@ package channeldb
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
@@ -28,11 +29,12 @@ var (
 )
 
 // TestPersistReport tests the writing and retrieval of a report on disk with
-// and without a spend txid.
+// and without a spend txid and timestamp.
 func TestPersistReport(t *testing.T) {
 	tests := []struct {
 		name      string
 		spendTxID *chainhash.Hash
+		timestamp time.Time
 	}{
 		{
 			name:      "Non-nil spend txid",
@@ -42,6 +44,11 @@ func TestPersistReport(t *testing.T) {
 			name:      "Nil spend txid",
 			spendTxID: nil,
 		},
+		{
+			name:      "Timestamp",
+			spendTxID: &testChanPoint1.Hash,
+			timestamp: time.Unix(1600000000, 0),
+		},
 	}
 
 	for _, test := range tests {
@@ -63,6 +70,7 @@ func TestPersistReport(t *testing.T) {
 				ResolverType:    1,
 				ResolverOutcome: 2,
 				SpendTxID:       test.spendTxID,
+				Timestamp:       test.timestamp,
 			}
 
 			// Write report to disk, and ensure it is identical when
@@ -0,0 +1,321 @@
+package lnd
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// closeOutputReport describes an output of a closing transaction along with
+// the resolver reports of the resolutions that handled it.
+type closeOutputReport struct {
+	// index is the index of the output within the closing transaction.
+	index uint32
+
+	// amount is the value of the output.
+	amount btcutil.Amount
+
+	// pkScript is the script of the output. This is nil if the closing
+	// transaction couldn't be retrieved.
+	pkScript []byte
+
+	// resolutions are the reports of the resolutions that handled the
+	// output, with the first stage preceding the second stage.
+	resolutions []*channeldb.ResolverReport
+}
+
+// sweepReport describes a transaction that resolved outputs of a closed
+// channel on our behalf.
+type sweepReport struct {
+	// txid is the hash of the sweep transaction.
+	txid chainhash.Hash
+
+	// fee is the share of the transaction's fee that is attributed to
+	// the channel. If the transaction also spent outputs of other
+	// channels, the fee is split by the weight of their inputs. This is
+	// only valid if feeKnown is true.
+	fee btcutil.Amount
+
+	// feeKnown is true if the values of all inputs of the transaction are
+	// known, so the fee could be determined.
+	feeKnown bool
+
+	// recovered is the net amount the transaction paid to our wallet for
+	// the channel's inputs.
+	recovered btcutil.Amount
+
+	// blockHeight is the height the transaction confirmed at, or zero if
+	// it is unconfirmed or unknown to the wallet.
+	blockHeight int32
+
+	// timestamp is the unix timestamp of the transaction as recorded by
+	// the wallet.
+	timestamp int64
+}
+
+// closeReport is a unified account of how the funds of a closed channel were
+// resolved on chain.
+type closeReport struct {
+	// outputs are the outputs of the closing transaction.
+	outputs []*closeOutputReport
+
+	// unmatched are the reports of the resolutions that couldn't be
+	// attributed to an output of the closing transaction.
+	unmatched []*channeldb.ResolverReport
+
+	// closingFee is the fee paid by the closing transaction. This is zero
+	// if the closing transaction couldn't be retrieved.
+	closingFee btcutil.Amount
+
+	// sweeps are the transactions that resolved outputs of the channel on
+	// our behalf.
+	sweeps []*sweepReport
+
+	// totalRecovered is the total amount that the closing transaction and
+	// the sweeps paid to our wallet.
+	totalRecovered btcutil.Amount
+
+	// totalSweepFees is the total fee of the sweeps with a known fee.
+	totalSweepFees btcutil.Amount
+}
+
+// isLocalSpend returns true if the spending transaction of the given report
+// was published by us rather than by the remote party.
+func isLocalSpend(report *channeldb.ResolverReport) bool {
+	if report.SpendTxID == nil {
+		return false
+	}
+
+	outcome := report.ResolverOutcome
+	switch report.ResolverType {
+	// An outgoing HTLC that was claimed was swept by the remote party with
+	// the preimage, while we swept it if it timed out.
+	case channeldb.ResolverTypeOutgoingHtlc:
+		return outcome == channeldb.ResolverOutcomeTimeout ||
+			outcome == channeldb.ResolverOutcomeFirstStage
+
+	// Outputs of a revoked commitment are only swept by us through the
+	// justice transaction. All other spends are the remote party's.
+	case channeldb.ResolverTypeBreach:
+		return outcome == channeldb.ResolverOutcomeClaimed
+
+	default:
+		return outcome == channeldb.ResolverOutcomeClaimed ||
+			outcome == channeldb.ResolverOutcomeFirstStage
+	}
+}
+
+// inputWeight returns the weight that the given input adds to a transaction.
+func inputWeight(txIn *wire.TxIn) int64 {
+	return int64(txIn.SerializeSize())*blockchain.WitnessScaleFactor +
+		int64(txIn.Witness.SerializeSize())
+}
+
+// newCloseReport assembles the close report of a channel from its close
+// summary, its closing transaction, the resolver reports stored for it and
+// the wallet's transactions since the close. The closing transaction may be
+// nil if it couldn't be retrieved, in which case the outputs are derived from
+// the resolver reports. The lookupValue closure is used to look up the
+// value of sweep inputs that belong to neither the channel nor the reports,
+// such as wallet inputs that were added to pay for fees or outputs of other
+// channels that were swept in the same transaction. It also returns whether
+// the input belongs to the wallet.
+func newCloseReport(summary *channeldb.ChannelCloseSummary,
+	closingTx *wire.MsgTx, reports []*channeldb.ResolverReport,
+	walletTxns map[chainhash.Hash]*lnwallet.TransactionDetail,
+	lookupValue func(wire.OutPoint) (btcutil.Amount, bool,
+		bool)) *closeReport {
+
+	closeReport := &closeReport{}
+
+	// We'll index the reports by the outpoint they resolved, and keep
+	// track of the values of all outputs we know of, so we can determine
+	// the fees of the sweeps.
+	reportsByOutPoint := make(map[wire.OutPoint]*channeldb.ResolverReport)
+	reportsByTxid := make(map[chainhash.Hash][]*channeldb.ResolverReport)
+	knownValues := make(map[wire.OutPoint]btcutil.Amount)
+	for _, report := range reports {
+		op := report.OutPoint
+		reportsByOutPoint[op] = report
+		reportsByTxid[op.Hash] = append(reportsByTxid[op.Hash], report)
+		knownValues[op] = report.Amount
+	}
+
+	// Next, we'll list the outputs of the closing transaction. If we don't
+	// have it, we'll fall back to the outputs that were resolved.
+	closingTxid := summary.ClosingTXID
+	if closingTx != nil {
+		var totalOut btcutil.Amount
+		for i, txOut := range closingTx.TxOut {
+			amt := btcutil.Amount(txOut.Value)
+			closeReport.outputs = append(
+				closeReport.outputs, &closeOutputReport{
+					index:    uint32(i),
+					amount:   amt,
+					pkScript: txOut.PkScript,
+				},
+			)
+
+			op := wire.OutPoint{Hash: closingTxid, Index: uint32(i)}
+			knownValues[op] = amt
+			totalOut += amt
+		}
+
+		closeReport.closingFee = summary.Capacity - totalOut
+	} else {
+		for _, report := range reports {
+			if report.OutPoint.Hash != closingTxid {
+				continue
+			}
+
+			closeReport.outputs = append(
+				closeReport.outputs, &closeOutputReport{
+					index:  report.OutPoint.Index,
+					amount: report.Amount,
+				},
+			)
+		}
+	}
+
+	// Now we'll attribute the reports to the outputs. Outputs that were
+	// resolved in two stages have a first stage report on the output
+	// itself, and a report on the output of the spending transaction.
+	matched := make(map[wire.OutPoint]struct{})
+	for _, output := range closeReport.outputs {
+		op := wire.OutPoint{Hash: closingTxid, Index: output.index}
+		report, ok := reportsByOutPoint[op]
+		for ok {
+			output.resolutions = append(output.resolutions, report)
+			matched[report.OutPoint] = struct{}{}
+
+			if report.ResolverOutcome !=
+				channeldb.ResolverOutcomeFirstStage ||
+				report.SpendTxID == nil {
+
+				break
+			}
+
+			// The second level report is the one on the output
+			// of the spending transaction. As second level
+			// transactions may be batched, we can only attribute
+			// it if there's a single such report.
+			secondLevel := reportsByTxid[*report.SpendTxID]
+			if len(secondLevel) != 1 {
+				break
+			}
+			report = secondLevel[0]
+		}
+	}
+
+	for _, report := range reports {
+		if _, ok := matched[report.OutPoint]; !ok {
+			closeReport.unmatched = append(
+				closeReport.unmatched, report,
+			)
+		}
+	}
+
+	// The closing transaction may have paid to our wallet directly, such
+	// as for a cooperative close.
+	if detail, ok := walletTxns[closingTxid]; ok {
+		closeReport.totalRecovered += detail.Value
+	}
+
+	// Finally, we'll report on every transaction we used to sweep outputs
+	// of the channel.
+	sweeps := make(map[chainhash.Hash]struct{})
+	for _, report := range reports {
+		if !isLocalSpend(report) {
+			continue
+		}
+
+		txid := *report.SpendTxID
+		if _, ok := sweeps[txid]; ok {
+			continue
+		}
+		sweeps[txid] = struct{}{}
+
+		sweep := &sweepReport{txid: txid}
+		closeReport.sweeps = append(closeReport.sweeps, sweep)
+
+		// Without the wallet's record of the transaction, we can't
+		// tell what it paid or recovered.
+		detail, ok := walletTxns[txid]
+		if !ok {
+			continue
+		}
+
+		sweep.recovered = detail.Value
+		sweep.blockHeight = detail.BlockHeight
+		sweep.timestamp = detail.Timestamp
+
+		var tx wire.MsgTx
+		err := tx.Deserialize(bytes.NewReader(detail.RawTx))
+		if err != nil {
+			rpcsLog.Warnf("Unable to decode sweep tx %v: %v", txid,
+				err)
+			closeReport.totalRecovered += sweep.recovered
+			continue
+		}
+
+		// The sweeper may batch the outputs of several channels in a
+		// single transaction, and add wallet inputs to pay for the
+		// fee. We'll split the fee between the inputs that aren't the
+		// wallet's by their weight, and only attribute the share of
+		// this channel's inputs to it.
+		var (
+			totalIn, totalOut, chanIn btcutil.Amount
+			chanWeight, sweptWeight   int64
+		)
+		sweep.feeKnown = true
+		for _, txIn := range tx.TxIn {
+			op := txIn.PreviousOutPoint
+			value, ok := knownValues[op]
+			if ok {
+				chanIn += value
+				chanWeight += inputWeight(txIn)
+			}
+
+			wallet := false
+			if !ok {
+				value, wallet, ok = lookupValue(op)
+			}
+			if !ok {
+				sweep.feeKnown = false
+			}
+			if !wallet {
+				sweptWeight += inputWeight(txIn)
+			}
+
+			totalIn += value
+		}
+		for _, txOut := range tx.TxOut {
+			totalOut += btcutil.Amount(txOut.Value)
+		}
+
+		if sweep.feeKnown && sweptWeight > 0 {
+			sweep.fee = (totalIn - totalOut) *
+				btcutil.Amount(chanWeight) /
+				btcutil.Amount(sweptWeight)
+			closeReport.totalSweepFees += sweep.fee
+		}
+
+		// If other channels' outputs were swept too, the wallet's gain
+		// can't all be attributed to ours, but at most our inputs
+		// minus our share of the fee.
+		if chanWeight < sweptWeight &&
+			chanIn-sweep.fee < sweep.recovered {
+
+			sweep.recovered = chanIn - sweep.fee
+		}
+
+		closeReport.totalRecovered += sweep.recovered
+	}
+
+	return closeReport
+}
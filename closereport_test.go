@@ -0,0 +1,332 @@
+package lnd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/stretchr/testify/require"
+)
+
+// serializeTx returns the raw bytes of the given transaction.
+func serializeTx(t *testing.T, tx *wire.MsgTx) []byte {
+	var b bytes.Buffer
+	require.NoError(t, tx.Serialize(&b))
+	return b.Bytes()
+}
+
+// TestCloseReportForceClose tests that the close report of a local force close
+// attributes single and two stage resolutions to the outputs of the closing
+// transaction, and determines the fees and recovered amounts of the sweeps.
+func TestCloseReportForceClose(t *testing.T) {
+	t.Parallel()
+
+	// The closing transaction has a to_local output, an outgoing HTLC, an
+	// anchor and a to_remote output.
+	closingTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{
+			{Value: 500000, PkScript: []byte{0x00}},
+			{Value: 100000, PkScript: []byte{0x01}},
+			{Value: 330, PkScript: []byte{0x02}},
+			{Value: 390000, PkScript: []byte{0x03}},
+		},
+	}
+	closingTxid := closingTx.TxHash()
+
+	summary := &channeldb.ChannelCloseSummary{
+		ClosingTXID: closingTxid,
+		Capacity:    1000000,
+		CloseHeight: 100,
+	}
+
+	// The HTLC is timed out with a second level transaction, which isn't
+	// known to our wallet.
+	timeoutTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{
+				Hash: closingTxid, Index: 1,
+			},
+		}},
+		TxOut: []*wire.TxOut{{Value: 99000}},
+	}
+	timeoutTxid := timeoutTx.TxHash()
+
+	// The to_local output and the second level output are swept in a
+	// single transaction, with an additional wallet input.
+	walletInput := wire.OutPoint{Hash: chainhash.Hash{0x01}, Index: 3}
+	sweepTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Hash: closingTxid}},
+			{PreviousOutPoint: wire.OutPoint{Hash: timeoutTxid}},
+			{PreviousOutPoint: walletInput},
+		},
+		TxOut: []*wire.TxOut{{Value: 648000}},
+	}
+	sweepTxid := sweepTx.TxHash()
+
+	reports := []*channeldb.ResolverReport{
+		{
+			OutPoint:        wire.OutPoint{Hash: closingTxid},
+			Amount:          500000,
+			ResolverType:    channeldb.ResolverTypeCommit,
+			ResolverOutcome: channeldb.ResolverOutcomeClaimed,
+			SpendTxID:       &sweepTxid,
+		},
+		{
+			OutPoint: wire.OutPoint{
+				Hash: closingTxid, Index: 1,
+			},
+			Amount:          100000,
+			ResolverType:    channeldb.ResolverTypeOutgoingHtlc,
+			ResolverOutcome: channeldb.ResolverOutcomeFirstStage,
+			SpendTxID:       &timeoutTxid,
+		},
+		{
+			OutPoint:        wire.OutPoint{Hash: timeoutTxid},
+			Amount:          99000,
+			ResolverType:    channeldb.ResolverTypeOutgoingHtlc,
+			ResolverOutcome: channeldb.ResolverOutcomeTimeout,
+			SpendTxID:       &sweepTxid,
+		},
+		{
+			OutPoint: wire.OutPoint{
+				Hash: closingTxid, Index: 2,
+			},
+			Amount:          330,
+			ResolverType:    channeldb.ResolverTypeAnchor,
+			ResolverOutcome: channeldb.ResolverOutcomeUnclaimed,
+		},
+	}
+
+	walletTxns := map[chainhash.Hash]*lnwallet.TransactionDetail{
+		sweepTxid: {
+			Hash:        sweepTxid,
+			Value:       598000,
+			BlockHeight: 250,
+			Timestamp:   1600000000,
+			RawTx:       serializeTx(t, sweepTx),
+		},
+	}
+
+	lookupValue := func(op wire.OutPoint) (btcutil.Amount, bool, bool) {
+		if op == walletInput {
+			return 50000, true, true
+		}
+
+		return 0, false, false
+	}
+
+	report := newCloseReport(
+		summary, closingTx, reports, walletTxns, lookupValue,
+	)
+
+	require.Len(t, report.outputs, 4)
+	require.Equal(t, btcutil.Amount(9670), report.closingFee)
+	require.Empty(t, report.unmatched)
+
+	// The to_local output was swept directly, the HTLC in two stages, and
+	// the anchor wasn't swept. The to_remote output isn't ours to
+	// resolve.
+	require.Equal(
+		t, []*channeldb.ResolverReport{reports[0]},
+		report.outputs[0].resolutions,
+	)
+	require.Equal(
+		t, []*channeldb.ResolverReport{reports[1], reports[2]},
+		report.outputs[1].resolutions,
+	)
+	require.Equal(
+		t, []*channeldb.ResolverReport{reports[3]},
+		report.outputs[2].resolutions,
+	)
+	require.Empty(t, report.outputs[3].resolutions)
+	require.Equal(t, []byte{0x03}, report.outputs[3].pkScript)
+
+	// The second level transaction isn't known to the wallet, so we can't
+	// tell its fee. The sweep's inputs are all known.
+	require.Len(t, report.sweeps, 2)
+	sweeps := make(map[chainhash.Hash]*sweepReport)
+	for _, sweep := range report.sweeps {
+		sweeps[sweep.txid] = sweep
+	}
+
+	require.False(t, sweeps[timeoutTxid].feeKnown)
+	require.Equal(t, &sweepReport{
+		txid:        sweepTxid,
+		fee:         1000,
+		feeKnown:    true,
+		recovered:   598000,
+		blockHeight: 250,
+		timestamp:   1600000000,
+	}, sweeps[sweepTxid])
+
+	require.Equal(t, btcutil.Amount(598000), report.totalRecovered)
+	require.Equal(t, btcutil.Amount(1000), report.totalSweepFees)
+}
+
+// TestCloseReportBreach tests that the close report of a breach is derived
+// from the resolver reports if the closing transaction isn't available, and
+// that only the justice transaction is reported as our sweep.
+func TestCloseReportBreach(t *testing.T) {
+	t.Parallel()
+
+	closingTxid := chainhash.Hash{0x02}
+	secondLevelTxid := chainhash.Hash{0x03}
+	justiceTxid := chainhash.Hash{0x04}
+
+	summary := &channeldb.ChannelCloseSummary{
+		ClosingTXID: closingTxid,
+		Capacity:    1000000,
+		CloseType:   channeldb.BreachClose,
+	}
+
+	reports := []*channeldb.ResolverReport{
+		{
+			OutPoint:        wire.OutPoint{Hash: closingTxid},
+			Amount:          600000,
+			ResolverType:    channeldb.ResolverTypeBreach,
+			ResolverOutcome: channeldb.ResolverOutcomeClaimed,
+			SpendTxID:       &justiceTxid,
+		},
+		{
+			OutPoint: wire.OutPoint{
+				Hash: closingTxid, Index: 1,
+			},
+			Amount:          100000,
+			ResolverType:    channeldb.ResolverTypeBreach,
+			ResolverOutcome: channeldb.ResolverOutcomeFirstStage,
+			SpendTxID:       &secondLevelTxid,
+		},
+		{
+			OutPoint:        wire.OutPoint{Hash: secondLevelTxid},
+			Amount:          99000,
+			ResolverType:    channeldb.ResolverTypeBreach,
+			ResolverOutcome: channeldb.ResolverOutcomeClaimed,
+			SpendTxID:       &justiceTxid,
+		},
+	}
+
+	walletTxns := map[chainhash.Hash]*lnwallet.TransactionDetail{
+		justiceTxid: {
+			Hash:  justiceTxid,
+			Value: 695000,
+		},
+	}
+
+	report := newCloseReport(
+		summary, nil, reports, walletTxns,
+		func(wire.OutPoint) (btcutil.Amount, bool, bool) {
+			return 0, false, false
+		},
+	)
+
+	require.Len(t, report.outputs, 2)
+	require.Zero(t, report.closingFee)
+	require.Empty(t, report.unmatched)
+	require.Equal(t, btcutil.Amount(600000), report.outputs[0].amount)
+	require.Equal(
+		t, []*channeldb.ResolverReport{reports[1], reports[2]},
+		report.outputs[1].resolutions,
+	)
+
+	// The second level transaction was published by the remote party, so
+	// the justice transaction is our only sweep.
+	require.Len(t, report.sweeps, 1)
+	require.Equal(t, justiceTxid, report.sweeps[0].txid)
+	require.Equal(t, btcutil.Amount(695000), report.totalRecovered)
+}
+
+// TestCloseReportBatchedSweep tests that only the channel's own inputs of a
+// sweep that also spent outputs of another channel are attributed to it, and
+// that the fee is split by the weight of the inputs.
+func TestCloseReportBatchedSweep(t *testing.T) {
+	t.Parallel()
+
+	closingTxid := chainhash.Hash{0x05}
+	otherOutput := wire.OutPoint{Hash: chainhash.Hash{0x06}}
+	walletInput := wire.OutPoint{Hash: chainhash.Hash{0x07}}
+
+	summary := &channeldb.ChannelCloseSummary{
+		ClosingTXID: closingTxid,
+		Capacity:    1000000,
+	}
+
+	// The sweep spends our to_local output, the output of another channel
+	// with a larger witness, and a wallet input to pay for the fee.
+	sweepTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{
+				PreviousOutPoint: wire.OutPoint{
+					Hash: closingTxid,
+				},
+				Witness: wire.TxWitness{
+					bytes.Repeat([]byte{0x01}, 100),
+				},
+			},
+			{
+				PreviousOutPoint: otherOutput,
+				Witness: wire.TxWitness{
+					bytes.Repeat([]byte{0x01}, 100),
+					bytes.Repeat([]byte{0x01}, 100),
+					bytes.Repeat([]byte{0x01}, 166),
+				},
+			},
+			{PreviousOutPoint: walletInput},
+		},
+		TxOut: []*wire.TxOut{{Value: 842000}},
+	}
+	sweepTxid := sweepTx.TxHash()
+
+	// The weights of the swept inputs only differ by their witnesses.
+	require.Equal(t, int64(266), inputWeight(sweepTx.TxIn[0]))
+	require.Equal(t, int64(534), inputWeight(sweepTx.TxIn[1]))
+
+	reports := []*channeldb.ResolverReport{
+		{
+			OutPoint:        wire.OutPoint{Hash: closingTxid},
+			Amount:          500000,
+			ResolverType:    channeldb.ResolverTypeCommit,
+			ResolverOutcome: channeldb.ResolverOutcomeClaimed,
+			SpendTxID:       &sweepTxid,
+		},
+	}
+
+	walletTxns := map[chainhash.Hash]*lnwallet.TransactionDetail{
+		sweepTxid: {
+			Hash:  sweepTxid,
+			Value: 792000,
+			RawTx: serializeTx(t, sweepTx),
+		},
+	}
+
+	lookupValue := func(op wire.OutPoint) (btcutil.Amount, bool, bool) {
+		switch op {
+		case walletInput:
+			return 50000, true, true
+
+		case otherOutput:
+			return 300000, false, true
+		}
+
+		return 0, false, false
+	}
+
+	report := newCloseReport(
+		summary, nil, reports, walletTxns, lookupValue,
+	)
+
+	// The total fee of 8000 sat is split between the swept inputs by
+	// their weight, so our input pays 8000 * 266 / 800 sat, which is
+	// subtracted from the amount it recovered.
+	require.Len(t, report.sweeps, 1)
+	require.True(t, report.sweeps[0].feeKnown)
+	require.Equal(t, btcutil.Amount(2660), report.sweeps[0].fee)
+	require.Equal(t, btcutil.Amount(497340), report.sweeps[0].recovered)
+	require.Equal(t, btcutil.Amount(497340), report.totalRecovered)
+	require.Equal(t, btcutil.Amount(2660), report.totalSweepFees)
+}
@@ -4,8 +4,10 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/urfave/cli"
 )
 
@@ -183,6 +185,60 @@ func lookupInvoice(ctx *cli.Context) error {
 	return nil
 }
 
+var deleteInvoiceCommand = cli.Command{
+	Name:     "deleteinvoice",
+	Category: "Invoices",
+	Usage:    "Delete a settled or canceled invoice.",
+	Description: `
+	Delete a settled or canceled invoice from the database. Open and
+	accepted invoices can't be deleted, they need to be canceled first.`,
+	ArgsUsage: "rhash",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "rhash",
+			Usage: "the 32 byte payment hash of the invoice to " +
+				"delete, the hash should be a hex-encoded " +
+				"string",
+		},
+	},
+	Action: actionDecorator(deleteInvoice),
+}
+
+func deleteInvoice(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		rHash []byte
+		err   error
+	)
+
+	switch {
+	case ctx.IsSet("rhash"):
+		rHash, err = hex.DecodeString(ctx.String("rhash"))
+	case ctx.Args().Present():
+		rHash, err = hex.DecodeString(ctx.Args().First())
+	default:
+		return fmt.Errorf("rhash argument missing")
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to decode rhash argument: %v", err)
+	}
+
+	resp, err := client.DeleteInvoice(ctxc, &lnrpc.DeleteInvoiceRequest{
+		RHash: rHash,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var listInvoicesCommand = cli.Command{
 	Name:     "listinvoices",
 	Category: "Invoices",
@@ -202,7 +258,12 @@ var listInvoicesCommand = cli.Command{
 	For example: if you have 200 invoices, "lncli listinvoices" will return
 	the last 100 created. If you wish to retrieve the previous 100, the
 	first_offset_index of the response can be used as the index_offset of
-	the next listinvoices request.`,
+	the next listinvoices request.
+
+	The invoices can be filtered by their creation and settle dates, state,
+	memo, amount and the custom records of the htlcs that paid them. All
+	filters must match for an invoice to be returned. Filtering requires
+	the invoices rpc sub-server to be active on the node.`,
 	Flags: []cli.Flag{
 		cli.BoolFlag{
 			Name: "pending_only",
@@ -225,11 +286,71 @@ var listInvoicesCommand = cli.Command{
 			Usage: "if set, invoices succeeding the " +
 				"index_offset will be returned",
 		},
+		cli.Int64Flag{
+			Name: "creation_date_start",
+			Usage: "(optional) only return invoices created at " +
+				"or after this unix timestamp",
+		},
+		cli.Int64Flag{
+			Name: "creation_date_end",
+			Usage: "(optional) only return invoices created at " +
+				"or before this unix timestamp",
+		},
+		cli.Int64Flag{
+			Name: "settle_date_start",
+			Usage: "(optional) only return invoices settled at " +
+				"or after this unix timestamp",
+		},
+		cli.Int64Flag{
+			Name: "settle_date_end",
+			Usage: "(optional) only return invoices settled at " +
+				"or before this unix timestamp",
+		},
+		cli.StringSliceFlag{
+			Name: "state",
+			Usage: "(optional) only return invoices in this " +
+				"state, one of open, settled, canceled or " +
+				"accepted; can be specified multiple times",
+		},
+		cli.StringFlag{
+			Name: "memo_contains",
+			Usage: "(optional) only return invoices whose memo " +
+				"contains this string, ignoring case",
+		},
+		cli.Int64Flag{
+			Name: "min_amt_msat",
+			Usage: "(optional) only return invoices with a value " +
+				"of at least this amount",
+		},
+		cli.Int64Flag{
+			Name: "max_amt_msat",
+			Usage: "(optional) only return invoices with a value " +
+				"of at most this amount",
+		},
+		cli.Uint64Flag{
+			Name: "htlc_custom_record",
+			Usage: "(optional) only return invoices paid with an " +
+				"htlc that carries a custom record of this type",
+		},
 	},
 	Action: actionDecorator(listInvoices),
 }
 
+// invoiceFilterFlags are the listinvoices flags that require the invoices to
+// be searched through the invoices rpc sub-server.
+var invoiceFilterFlags = []string{
+	"creation_date_start", "creation_date_end", "settle_date_start",
+	"settle_date_end", "state", "memo_contains", "min_amt_msat",
+	"max_amt_msat", "htlc_custom_record",
+}
+
 func listInvoices(ctx *cli.Context) error {
+	for _, flag := range invoiceFilterFlags {
+		if ctx.IsSet(flag) {
+			return searchInvoices(ctx)
+		}
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
@@ -251,6 +372,61 @@ func listInvoices(ctx *cli.Context) error {
 	return nil
 }
 
+// searchInvoices lists the invoices that match the filters given to the
+// listinvoices command.
+func searchInvoices(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	req := &invoicesrpc.SearchInvoicesRequest{
+		PendingOnly:          ctx.Bool("pending_only"),
+		IndexOffset:          ctx.Uint64("index_offset"),
+		NumMaxInvoices:       ctx.Uint64("max_invoices"),
+		Reversed:             !ctx.Bool("paginate-forwards"),
+		CreationDateStart:    ctx.Int64("creation_date_start"),
+		CreationDateEnd:      ctx.Int64("creation_date_end"),
+		SettleDateStart:      ctx.Int64("settle_date_start"),
+		SettleDateEnd:        ctx.Int64("settle_date_end"),
+		MemoContains:         ctx.String("memo_contains"),
+		MinAmtMsat:           ctx.Int64("min_amt_msat"),
+		MaxAmtMsat:           ctx.Int64("max_amt_msat"),
+		HtlcCustomRecordType: ctx.Uint64("htlc_custom_record"),
+	}
+
+	for _, state := range ctx.StringSlice("state") {
+		stateName := strings.ToUpper(state)
+		rpcState, ok := lnrpc.Invoice_InvoiceState_value[stateName]
+		if !ok {
+			return fmt.Errorf("unknown invoice state: %v", state)
+		}
+
+		req.States = append(
+			req.States, lnrpc.Invoice_InvoiceState(rpcState),
+		)
+	}
+
+	client, cleanUp := getInvoicesClient(ctx)
+	defer cleanUp()
+
+	invoices, err := client.SearchInvoices(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(invoices)
+
+	return nil
+}
+
+func getInvoicesClient(ctx *cli.Context) (invoicesrpc.InvoicesClient, func()) {
+	conn := getClientConn(ctx, false)
+
+	cleanUp := func() {
+		conn.Close()
+	}
+
+	return invoicesrpc.NewInvoicesClient(conn), cleanUp
+}
+
 var decodePayReqCommand = cli.Command{
 	Name:        "decodepayreq",
 	Category:    "Invoices",
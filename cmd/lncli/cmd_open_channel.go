@@ -6,6 +6,7 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -137,6 +138,14 @@ var openChannelCommand = cli.Command{
 				"must be explicitly told about it to be able " +
 				"to route through it",
 		},
+		cli.BoolFlag{
+			Name: "zero_conf",
+			Usage: "(optional) open a private channel that is " +
+				"usable before its funding transaction " +
+				"confirms. The peer's channel acceptor must " +
+				"explicitly consent to it, so this should " +
+				"only be used with trusted peers",
+		},
 		cli.Int64Flag{
 			Name: "min_htlc_msat",
 			Usage: "(optional) the minimum value we will require " +
@@ -306,6 +315,7 @@ func openChannel(ctx *cli.Context) error {
 	}
 
 	req.Private = ctx.Bool("private")
+	req.ZeroConf = ctx.Bool("zero_conf")
 
 	// PSBT funding is a more involved, interactive process that is too
 	// large to also fit into this already long function.
@@ -802,3 +812,135 @@ func finalizeMsgFromString(tx string,
 		},
 	}, nil
 }
+
+// batchChannelJSON is the JSON representation of a single channel in the
+// batchopenchannel command.
+type batchChannelJSON struct {
+	NodePubkey         string `json:"node_pubkey,omitempty"`
+	LocalFundingAmount int64  `json:"local_funding_amount,omitempty"`
+	PushSat            int64  `json:"push_sat,omitempty"`
+	Private            bool   `json:"private,omitempty"`
+	MinHtlcMsat        int64  `json:"min_htlc_msat,omitempty"`
+	RemoteCsvDelay     uint32 `json:"remote_csv_delay,omitempty"`
+	CloseAddress       string `json:"close_address,omitempty"`
+	PendingChanID      string `json:"pending_chan_id,omitempty"`
+}
+
+var batchOpenChannelCommand = cli.Command{
+	Name:     "batchopenchannel",
+	Category: "Channels",
+	Usage: "Open multiple channels to existing peers in a single " +
+		"transaction.",
+	Description: `
+	Attempt to open one or more new channels to existing peers with the
+	given node-keys.
+
+	Example:
+	lncli batchopenchannel --sat_per_vbyte=5 '[{
+		"node_pubkey": "02abcdef...",
+		"local_funding_amount": 500000,
+		"private": true,
+		"close_address": "bc1qxxx..."
+	}, {
+		"node_pubkey": "03fedcba...",
+		"local_funding_amount": 200000,
+		"remote_csv_delay": 288
+	}]'
+
+	All channels are negotiated in parallel and funded by a single
+	transaction that is only published once every peer has signed. If any
+	of the channels fails, all others are rolled back and no transaction
+	is published.`,
+	ArgsUsage: "channels-json",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/vByte that should be used when crafting " +
+				"the transaction",
+		},
+		txLabelFlag,
+	},
+	Action: actionDecorator(batchOpenChannel),
+}
+
+func batchOpenChannel(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	args := ctx.Args()
+
+	// Show command help if no arguments provided
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "batchopenchannel")
+		return nil
+	}
+
+	var jsonChannels []*batchChannelJSON
+	err := json.Unmarshal([]byte(args.First()), &jsonChannels)
+	if err != nil {
+		return fmt.Errorf("error parsing channels JSON: %v", err)
+	}
+
+	req := &lnrpc.BatchOpenChannelRequest{
+		TargetConf:  int32(ctx.Int64("conf_target")),
+		SatPerVbyte: ctx.Int64("sat_per_vbyte"),
+		Label:       ctx.String(txLabelFlag.Name),
+	}
+	for _, jsonChannel := range jsonChannels {
+		pubKeyBytes, err := hex.DecodeString(jsonChannel.NodePubkey)
+		if err != nil {
+			return fmt.Errorf("error parsing node pubkey hex: %v",
+				err)
+		}
+		pendingChanBytes, err := hex.DecodeString(
+			jsonChannel.PendingChanID,
+		)
+		if err != nil {
+			return fmt.Errorf("error parsing pending chan ID: %v",
+				err)
+		}
+
+		req.Channels = append(req.Channels, &lnrpc.BatchOpenChannel{
+			NodePubkey:         pubKeyBytes,
+			LocalFundingAmount: jsonChannel.LocalFundingAmount,
+			PushSat:            jsonChannel.PushSat,
+			Private:            jsonChannel.Private,
+			MinHtlcMsat:        jsonChannel.MinHtlcMsat,
+			RemoteCsvDelay:     jsonChannel.RemoteCsvDelay,
+			CloseAddress:       jsonChannel.CloseAddress,
+			PendingChanId:      pendingChanBytes,
+		})
+	}
+
+	resp, err := client.BatchOpenChannel(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	channelPoints := make([]string, len(resp.PendingChannels))
+	for idx, pending := range resp.PendingChannels {
+		txid, err := chainhash.NewHash(pending.Txid)
+		if err != nil {
+			return err
+		}
+
+		channelPoints[idx] = fmt.Sprintf("%v:%d", txid,
+			pending.OutputIndex)
+	}
+
+	printJSON(struct {
+		PendingChannels []string `json:"pending_channels"`
+	}{
+		PendingChannels: channelPoints,
+	})
+
+	return nil
+}
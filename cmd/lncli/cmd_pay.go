@@ -87,6 +87,35 @@ var (
 			"payment splitting is required to attempt a payment, " +
 			"specified in milli-satoshis",
 	}
+
+	trampolineNodeFlag = cli.StringSliceFlag{
+		Name: "trampoline_node",
+		Usage: "the hex-encoded pubkey of a trampoline node that " +
+			"should find the route to the destination on our " +
+			"behalf, can be repeated to route via multiple " +
+			"trampoline nodes in the given order",
+	}
+
+	trampolineFeeMsatFlag = cli.Int64Flag{
+		Name: "trampoline_fee_msat",
+		Usage: "the fee in milli-satoshis that the trampoline nodes " +
+			"may spend on their own fees and the routes to the " +
+			"destination",
+	}
+
+	trampolineCltvDeltaFlag = cli.IntFlag{
+		Name: "trampoline_cltv_delta",
+		Usage: "the number of blocks that the trampoline nodes may " +
+			"use for their own cltv deltas and the routes to the " +
+			"destination",
+	}
+
+	timePrefFlag = cli.Float64Flag{
+		Name: "time_pref",
+		Usage: "(optional) expresses time preference (range -1 to 1), " +
+			"where -1 optimizes for the lowest fees and 1 for the " +
+			"highest reliability",
+	}
 )
 
 // paymentFlags returns common flags for sendpayment and payinvoice.
@@ -131,7 +160,8 @@ func paymentFlags() []cli.Flag {
 			Usage: "allow sending a circular payment to self",
 		},
 		dataFlag, inflightUpdatesFlag, maxPartsFlag, jsonFlag,
-		maxShardSizeSatFlag, maxShardSizeMsatFlag,
+		maxShardSizeSatFlag, maxShardSizeMsatFlag, trampolineNodeFlag,
+		trampolineFeeMsatFlag, trampolineCltvDeltaFlag, timePrefFlag,
 	}
 }
 
@@ -398,6 +428,24 @@ func sendPaymentRequest(ctx *cli.Context,
 
 	req.MaxParts = uint32(ctx.Uint(maxPartsFlag.Name))
 
+	req.TimePref = ctx.Float64(timePrefFlag.Name)
+
+	if ctx.IsSet(trampolineNodeFlag.Name) {
+		for _, node := range ctx.StringSlice(trampolineNodeFlag.Name) {
+			trampolineNode, err := route.NewVertexFromStr(node)
+			if err != nil {
+				return err
+			}
+			req.TrampolineNodes = append(
+				req.TrampolineNodes, trampolineNode[:],
+			)
+		}
+		req.TrampolineFeeMsat = ctx.Int64(trampolineFeeMsatFlag.Name)
+		req.TrampolineCltvDelta = int32(
+			ctx.Int(trampolineCltvDeltaFlag.Name),
+		)
+	}
+
 	switch {
 	// If the max shard size is specified, then it should either be in sat
 	// or msat, but not both.
@@ -701,6 +701,11 @@ var closeChannelCommand = cli.Command{
 	--sat_per_vbyte arguments. This will be the starting value used during
 	fee negotiation. This is optional.
 
+	The fees proposed or accepted during negotiation can be bounded via the
+	--max_fee_per_vbyte and --min_fee_per_vbyte arguments. If set, the
+	resulting fee range is sent to the remote party and the close fails if
+	it doesn't overlap with theirs. This is optional.
+
 	In the case of a cooperative closure, one can manually set the address
 	to deliver funds to upon closure. This is optional, and may only be used
 	if an upfront shutdown address has not already been set. If neither are
@@ -747,6 +752,18 @@ var closeChannelCommand = cli.Command{
 				"sat/vbyte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.Uint64Flag{
+			Name: "max_fee_per_vbyte",
+			Usage: "(optional) the maximum fee rate expressed in " +
+				"sat/vbyte that may be proposed or accepted " +
+				"for a cooperative close",
+		},
+		cli.Uint64Flag{
+			Name: "min_fee_per_vbyte",
+			Usage: "(optional) the minimum fee rate expressed in " +
+				"sat/vbyte that may be proposed or accepted " +
+				"for a cooperative close",
+		},
 		cli.StringFlag{
 			Name: "delivery_addr",
 			Usage: "(optional) an address to deliver funds " +
@@ -790,6 +807,8 @@ func closeChannel(ctx *cli.Context) error {
 		TargetConf:      int32(ctx.Int64("conf_target")),
 		SatPerVbyte:     ctx.Uint64(feeRateFlag),
 		DeliveryAddress: ctx.String("delivery_addr"),
+		MaxFeePerVbyte:  ctx.Uint64("max_fee_per_vbyte"),
+		MinFeePerVbyte:  ctx.Uint64("min_fee_per_vbyte"),
 	}
 
 	// After parsing the request, we'll spin up a goroutine that will
@@ -1448,6 +1467,12 @@ var closedChannelsCommand = cli.Command{
 			Usage: "list channels that were abandoned by " +
 				"the local node",
 		},
+		cli.BoolFlag{
+			Name: "funding_double_spent",
+			Usage: "list channels that were never opened " +
+				"because their funding transaction was " +
+				"double spent",
+		},
 	},
 	Action: actionDecorator(closedChannels),
 }
@@ -1458,12 +1483,13 @@ func closedChannels(ctx *cli.Context) error {
 	defer cleanUp()
 
 	req := &lnrpc.ClosedChannelsRequest{
-		Cooperative:     ctx.Bool("cooperative"),
-		LocalForce:      ctx.Bool("local_force"),
-		RemoteForce:     ctx.Bool("remote_force"),
-		Breach:          ctx.Bool("breach"),
-		FundingCanceled: ctx.Bool("funding_canceled"),
-		Abandoned:       ctx.Bool("abandoned"),
+		Cooperative:        ctx.Bool("cooperative"),
+		LocalForce:         ctx.Bool("local_force"),
+		RemoteForce:        ctx.Bool("remote_force"),
+		Breach:             ctx.Bool("breach"),
+		FundingCanceled:    ctx.Bool("funding_canceled"),
+		Abandoned:          ctx.Bool("abandoned"),
+		FundingDoubleSpent: ctx.Bool("funding_double_spent"),
 	}
 
 	resp, err := client.ClosedChannels(ctxc, req)
@@ -1476,6 +1502,64 @@ func closedChannels(ctx *cli.Context) error {
 	return nil
 }
 
+var closeReportCommand = cli.Command{
+	Name:     "closereport",
+	Category: "Channels",
+	Usage:    "Show how the funds of a closed channel were resolved.",
+	Description: `
+	Show a detailed report of how the funds of a closed channel were
+	resolved on chain. The report lists every output of the closing
+	transaction along with the resolutions that handled it, and the
+	transactions that swept the funds together with their fees and the
+	amounts they recovered.
+
+	To view which funding_txids/output_indexes can be used for this command,
+	see the channel_point values within the closedchannels command output.
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the funding " +
+				"transaction",
+		},
+	},
+	Action: actionDecorator(closeReport),
+}
+
+func closeReport(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "closereport")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.ChannelCloseReportRequest{
+		ChannelPoint: channelPoint,
+	}
+
+	resp, err := client.ChannelCloseReport(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var describeGraphCommand = cli.Command{
 	Name:     "describegraph",
 	Category: "Graph",
@@ -1603,6 +1687,63 @@ func listPayments(ctx *cli.Context) error {
 	return nil
 }
 
+var deletePaymentCommand = cli.Command{
+	Name:     "deletepayment",
+	Category: "Payments",
+	Usage:    "Delete a single outgoing payment.",
+	Description: "Delete a succeeded or failed payment from the " +
+		"database. In-flight payments can't be deleted.",
+	ArgsUsage: "payment_hash",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "payment_hash",
+			Usage: "the hex-encoded payment hash of the payment " +
+				"to delete",
+		},
+		cli.BoolFlag{
+			Name: "failed_htlcs_only",
+			Usage: "if set, only the failed htlcs of the " +
+				"payment are deleted, not the payment itself",
+		},
+	},
+	Action: actionDecorator(deletePayment),
+}
+
+func deletePayment(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		paymentHash []byte
+		err         error
+	)
+
+	switch {
+	case ctx.IsSet("payment_hash"):
+		paymentHash, err = hex.DecodeString(ctx.String("payment_hash"))
+	case ctx.Args().Present():
+		paymentHash, err = hex.DecodeString(ctx.Args().First())
+	default:
+		return fmt.Errorf("payment_hash argument missing")
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to decode payment_hash: %v", err)
+	}
+
+	resp, err := client.DeletePayment(ctxc, &lnrpc.DeletePaymentRequest{
+		PaymentHash:     paymentHash,
+		FailedHtlcsOnly: ctx.Bool("failed_htlcs_only"),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var getChanInfoCommand = cli.Command{
 	Name:     "getchaninfo",
 	Category: "Graph",
@@ -1747,6 +1888,7 @@ var queryRoutesCommand = cli.Command{
 				"that must be taken to the first hop",
 		},
 		cltvLimitFlag,
+		timePrefFlag,
 	},
 	Action: actionDecorator(queryRoutes),
 }
@@ -1799,6 +1941,7 @@ func queryRoutes(ctx *cli.Context) error {
 		UseMissionControl: ctx.Bool("use_mc"),
 		CltvLimit:         uint32(ctx.Uint64(cltvLimitFlag.Name)),
 		OutgoingChanId:    ctx.Uint64("outgoing_chanid"),
+		TimePref:          ctx.Float64(timePrefFlag.Name),
 	}
 
 	route, err := client.QueryRoutes(ctxc, req)
@@ -2162,6 +2305,20 @@ var updateChannelPolicyCommand = cli.Command{
 				"to all forwarded HTLCs. If unset, the max HTLC " +
 				"is left unchanged.",
 		},
+		cli.Int64Flag{
+			Name: "inbound_fee_base_msat",
+			Usage: "if set, the base fee in milli-satoshis that " +
+				"will be charged for each HTLC arriving over " +
+				"the channel. A negative value acts as a " +
+				"discount.",
+		},
+		cli.Int64Flag{
+			Name: "inbound_fee_rate_milli_msat",
+			Usage: "if set, the fee rate in parts per million that " +
+				"will be charged proportionally based on the " +
+				"value of each HTLC arriving over the channel. " +
+				"A negative value acts as a discount.",
+		},
 		cli.StringFlag{
 			Name: "chan_point",
 			Usage: "The channel whose fee policy should be " +
@@ -2283,6 +2440,30 @@ func updateChannelPolicy(ctx *cli.Context) error {
 		req.MinHtlcMsatSpecified = true
 	}
 
+	if ctx.IsSet("inbound_fee_base_msat") ||
+		ctx.IsSet("inbound_fee_rate_milli_msat") {
+
+		inboundBaseFee := ctx.Int64("inbound_fee_base_msat")
+		if inboundBaseFee < math.MinInt32 ||
+			inboundBaseFee > math.MaxInt32 {
+
+			return errors.New("inbound_fee_base_msat out of range")
+		}
+
+		inboundFeeRate := ctx.Int64("inbound_fee_rate_milli_msat")
+		if inboundFeeRate < math.MinInt32 ||
+			inboundFeeRate > math.MaxInt32 {
+
+			return errors.New("inbound_fee_rate_milli_msat out " +
+				"of range")
+		}
+
+		req.InboundFee = &lnrpc.InboundFee{
+			FeeBaseMsat:      int32(inboundBaseFee),
+			FeeRateMilliMsat: int32(inboundFeeRate),
+		}
+	}
+
 	if chanPoint != nil {
 		req.Scope = &lnrpc.PolicyUpdateRequest_ChanPoint{
 			ChanPoint: chanPoint,
@@ -2427,6 +2608,110 @@ func forwardingHistory(ctx *cli.Context) error {
 	return nil
 }
 
+var forwardingStatsCommand = cli.Command{
+	Name:      "fwdingstats",
+	Category:  "Payments",
+	Usage:     "Query aggregated statistics of the forwarding history.",
+	ArgsUsage: "[start_time] [end_time]",
+	Description: `
+	Query the totals of the volume and fees of all forwards over a
+	particular time range (--start_time and --end_time), per channel and
+	per peer and in both directions. The start and end times are expressed
+	in the same way as for fwdinghistory. If --start_time isn't provided,
+	then 24 hours ago is used. If --end_time isn't provided, then the
+	current time is used.
+
+	The statistics can be grouped into time buckets using the --interval
+	param, which is one of "hour", "day" or "week". Daily and weekly
+	buckets start at midnight UTC.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "start_time",
+			Usage: "the starting time for the query " +
+				`as unix timestamp or relative e.g. "-1w"`,
+		},
+		cli.StringFlag{
+			Name: "end_time",
+			Usage: "the end time for the query " +
+				`as unix timestamp or relative e.g. "-1w"`,
+		},
+		cli.StringFlag{
+			Name: "interval",
+			Usage: "the interval of the time buckets, one of " +
+				`"hour", "day" or "week"; if not set, the ` +
+				"totals of the whole time range are returned",
+		},
+	},
+	Action: actionDecorator(forwardingStats),
+}
+
+func forwardingStats(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		startTime, endTime uint64
+		err                error
+	)
+	args := ctx.Args()
+	now := time.Now()
+
+	switch {
+	case ctx.IsSet("start_time"):
+		startTime, err = parseTime(ctx.String("start_time"), now)
+	case args.Present():
+		startTime, err = parseTime(args.First(), now)
+		args = args.Tail()
+	default:
+		startTime = uint64(now.Add(-time.Hour * 24).Unix())
+	}
+	if err != nil {
+		return fmt.Errorf("unable to decode start_time: %v", err)
+	}
+
+	switch {
+	case ctx.IsSet("end_time"):
+		endTime, err = parseTime(ctx.String("end_time"), now)
+	case args.Present():
+		endTime, err = parseTime(args.First(), now)
+	default:
+		endTime = uint64(now.Unix())
+	}
+	if err != nil {
+		return fmt.Errorf("unable to decode end_time: %v", err)
+	}
+
+	var interval lnrpc.ForwardingHistoryStatsRequest_Interval
+	switch ctx.String("interval") {
+	case "":
+		interval = lnrpc.ForwardingHistoryStatsRequest_TOTAL
+	case "hour":
+		interval = lnrpc.ForwardingHistoryStatsRequest_HOUR
+	case "day":
+		interval = lnrpc.ForwardingHistoryStatsRequest_DAY
+	case "week":
+		interval = lnrpc.ForwardingHistoryStatsRequest_WEEK
+	default:
+		return fmt.Errorf("unknown interval: %v",
+			ctx.String("interval"))
+	}
+
+	req := &lnrpc.ForwardingHistoryStatsRequest{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Interval:  interval,
+	}
+	resp, err := client.ForwardingHistoryStats(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var exportChanBackupCommand = cli.Command{
 	Name:     "exportchanbackup",
 	Category: "Channels",
@@ -4,9 +4,11 @@ package main
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 
 	"strconv"
+	"strings"
 
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/urfave/cli"
@@ -21,16 +23,6 @@ func invoicesCommands() []cli.Command {
 	}
 }
 
-func getInvoicesClient(ctx *cli.Context) (invoicesrpc.InvoicesClient, func()) {
-	conn := getClientConn(ctx, false)
-
-	cleanUp := func() {
-		conn.Close()
-	}
-
-	return invoicesrpc.NewInvoicesClient(conn), cleanUp
-}
-
 var settleInvoiceCommand = cli.Command{
 	Name:     "settleinvoice",
 	Category: "Invoices",
@@ -190,6 +182,25 @@ var addHoldInvoiceCommand = cli.Command{
 				"private channels in order to assist the " +
 				"payer in reaching you",
 		},
+		cli.Uint64Flag{
+			Name: "min_cltv_delta",
+			Usage: "the minimum number of blocks that htlcs paying " +
+				"to this invoice must remain locked for. " +
+				"Htlcs that expire sooner are rejected",
+		},
+		cli.StringFlag{
+			Name: "required_records",
+			Usage: "custom records that htlcs paying to this " +
+				"invoice must carry. The required format is: " +
+				"<record_id>=<hex_value>,<record_id>=,.. An " +
+				"empty value only requires the record to be " +
+				"present",
+		},
+		cli.Int64Flag{
+			Name: "min_accept_amt_msat",
+			Usage: "the amount in millisatoshis that accepts the " +
+				"invoice, if less than the invoice amount",
+		},
 	},
 	Action: actionDecorator(addHoldInvoice),
 }
@@ -247,6 +258,26 @@ func addHoldInvoice(ctx *cli.Context) error {
 		Private:         ctx.Bool("private"),
 	}
 
+	if ctx.IsSet("min_cltv_delta") || ctx.IsSet("required_records") ||
+		ctx.IsSet("min_accept_amt_msat") {
+
+		records, err := parseRequiredRecords(
+			ctx.String("required_records"),
+		)
+		if err != nil {
+			return err
+		}
+
+		minCltvDelta := ctx.Uint64("min_cltv_delta")
+		minAcceptAmt := ctx.Int64("min_accept_amt_msat")
+
+		invoice.AcceptPolicy = &invoicesrpc.InvoiceAcceptPolicy{
+			MinCltvDelta:          uint32(minCltvDelta),
+			RequiredCustomRecords: records,
+			MinAcceptAmtMsat:      uint64(minAcceptAmt),
+		}
+	}
+
 	resp, err := client.AddHoldInvoice(ctxc, invoice)
 	if err != nil {
 		return err
@@ -260,3 +291,36 @@ func addHoldInvoice(ctx *cli.Context) error {
 
 	return nil
 }
+
+// parseRequiredRecords parses the required custom records of an invoice
+// acceptance policy.
+func parseRequiredRecords(data string) (map[uint64][]byte, error) {
+	records := make(map[uint64][]byte)
+	if data == "" {
+		return records, nil
+	}
+
+	for _, r := range strings.Split(data, ",") {
+		kv := strings.Split(r, "=")
+		if len(kv) != 2 {
+			return nil, errors.New("invalid required records " +
+				"format: multiple equal signs in record")
+		}
+
+		recordID, err := strconv.ParseUint(kv[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid required records "+
+				"format: %v", err)
+		}
+
+		value, err := hex.DecodeString(kv[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid required records "+
+				"format: %v", err)
+		}
+
+		records[recordID] = value
+	}
+
+	return records, nil
+}
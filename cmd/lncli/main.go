@@ -334,6 +334,7 @@ func main() {
 		connectCommand,
 		disconnectCommand,
 		openChannelCommand,
+		batchOpenChannelCommand,
 		closeChannelCommand,
 		closeAllChannelsCommand,
 		abandonChannelCommand,
@@ -348,10 +349,13 @@ func main() {
 		sendToRouteCommand,
 		addInvoiceCommand,
 		lookupInvoiceCommand,
+		deleteInvoiceCommand,
 		listInvoicesCommand,
 		listChannelsCommand,
 		closedChannelsCommand,
+		closeReportCommand,
 		listPaymentsCommand,
+		deletePaymentCommand,
 		describeGraphCommand,
 		getNodeMetricsCommand,
 		getChanInfoCommand,
@@ -367,6 +371,7 @@ func main() {
 		feeReportCommand,
 		updateChannelPolicyCommand,
 		forwardingHistoryCommand,
+		forwardingStatsCommand,
 		exportChanBackupCommand,
 		verifyChanBackupCommand,
 		restoreChanBackupCommand,
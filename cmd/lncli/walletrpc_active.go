@@ -12,7 +12,6 @@ import (
 	"sort"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
-	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/urfave/cli"
 )
@@ -58,6 +57,7 @@ func walletCommands() []cli.Command {
 				bumpCloseFeeCommand,
 				listSweepsCommand,
 				labelTxCommand,
+				consolidateCommand,
 				releaseOutputCommand,
 				listLeasesCommand,
 				psbtCommand,
@@ -249,8 +249,11 @@ var bumpCloseFeeCommand = cli.Command{
 	This command allows the fee of a channel closing transaction to be
 	increased by using the child-pays-for-parent mechanism. It will instruct
 	the sweeper to sweep the anchor outputs of transactions in the set
-	of valid commitments for the specified channel at the requested fee
-	rate or confirmation target.
+	of valid commitments for the specified channel, such that the package
+	of the commitment and the sweep reaches the requested fee rate or
+	confirmation target. Running the command again replaces the earlier
+	sweep. The effective fee rate of the package is reported by
+	pendingchannels once the sweep is published.
 	`,
 	Flags: []cli.Flag{
 		cli.Uint64Flag{
@@ -290,99 +293,28 @@ func bumpCloseFee(ctx *cli.Context) error {
 		return err
 	}
 
-	// Validate the channel point.
-	channelPoint := ctx.Args().Get(0)
-	_, err = NewProtoOutPoint(channelPoint)
+	chanPoint, err := parseChanPoint(ctx.Args().Get(0))
 	if err != nil {
 		return err
 	}
 
-	// Fetch all waiting close channels.
-	client, cleanUp := getClient(ctx)
-	defer cleanUp()
-
-	// Fetch waiting close channel commitments.
-	commitments, err := getWaitingCloseCommitments(client, channelPoint)
-	if err != nil {
-		return err
-	}
-
-	// Retrieve pending sweeps.
 	walletClient, cleanUp := getWalletClient(ctx)
 	defer cleanUp()
 
-	sweeps, err := walletClient.PendingSweeps(
-		ctxc, &walletrpc.PendingSweepsRequest{},
-	)
+	// Let lnd sweep the anchors of the commitments of the channel, so the
+	// commitment that confirms is bumped to the requested fee rate.
+	_, err = walletClient.BumpFee(ctxc, &walletrpc.BumpFeeRequest{
+		ChanPoint:   chanPoint,
+		TargetConf:  uint32(ctx.Uint64("conf_target")),
+		SatPerVbyte: ctx.Uint64(feeRateFlag),
+	})
 	if err != nil {
 		return err
 	}
 
-	// Match pending sweeps with commitments of the channel for which a bump
-	// is requested and bump their fees.
-	commitSet := map[string]struct{}{
-		commitments.LocalTxid:  {},
-		commitments.RemoteTxid: {},
-	}
-	if commitments.RemotePendingTxid != "" {
-		commitSet[commitments.RemotePendingTxid] = struct{}{}
-	}
-
-	for _, sweep := range sweeps.PendingSweeps {
-		// Only bump anchor sweeps.
-		if sweep.WitnessType != walletrpc.WitnessType_COMMITMENT_ANCHOR {
-			continue
-		}
-
-		// Skip unrelated sweeps.
-		sweepTxID, err := chainhash.NewHash(sweep.Outpoint.TxidBytes)
-		if err != nil {
-			return err
-		}
-		if _, match := commitSet[sweepTxID.String()]; !match {
-			continue
-		}
-
-		// Bump fee of the anchor sweep.
-		fmt.Printf("Bumping fee of %v:%v\n",
-			sweepTxID, sweep.Outpoint.OutputIndex)
-
-		_, err = walletClient.BumpFee(ctxc, &walletrpc.BumpFeeRequest{
-			Outpoint:    sweep.Outpoint,
-			TargetConf:  uint32(ctx.Uint64("conf_target")),
-			SatPerVbyte: ctx.Uint64(feeRateFlag),
-			Force:       true,
-		})
-		if err != nil {
-			return err
-		}
-	}
-
 	return nil
 }
 
-func getWaitingCloseCommitments(client lnrpc.LightningClient,
-	channelPoint string) (*lnrpc.PendingChannelsResponse_Commitments,
-	error) {
-
-	ctxc := getContext()
-
-	req := &lnrpc.PendingChannelsRequest{}
-	resp, err := client.PendingChannels(ctxc, req)
-	if err != nil {
-		return nil, err
-	}
-
-	// Lookup the channel commit tx hashes.
-	for _, channel := range resp.WaitingCloseChannels {
-		if channel.Channel.ChannelPoint == channelPoint {
-			return channel.Commitments, nil
-		}
-	}
-
-	return nil, errors.New("channel not found")
-}
-
 var listSweepsCommand = cli.Command{
 	Name:  "listsweeps",
 	Usage: "Lists all sweeps that have been published by our node.",
@@ -422,6 +354,69 @@ func listSweeps(ctx *cli.Context) error {
 	return nil
 }
 
+var consolidateCommand = cli.Command{
+	Name:  "consolidate",
+	Usage: "Consolidate small wallet utxos into cold storage.",
+	Description: `
+	Merge the small confirmed utxos of the wallet into a single output
+	paying to the cold storage address or xpub configured in the
+	consolidation section of lnd's config. Only utxos up to the configured
+	maximum value are consolidated, and the configured reserve, or the
+	value required to fee bump anchor channels if larger, is left in the
+	wallet.
+
+	If neither conf_target nor sat_per_vbyte is provided, the fee rate is
+	estimated for the configured consolidation confirmation target.
+
+	With the dry_run flag set, the consolidation is only previewed and not
+	published.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "conf_target",
+			Usage: "the number of blocks that the consolidation " +
+				"should confirm within",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "a manual fee expressed in sat/vbyte that " +
+				"should be used for the consolidation",
+		},
+		cli.BoolFlag{
+			Name:  "dry_run",
+			Usage: "preview the consolidation without publishing",
+		},
+	},
+	Action: actionDecorator(consolidate),
+}
+
+func consolidate(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	_, err := checkNotBothSet(ctx, "conf_target", "sat_per_vbyte")
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ConsolidateUtxos(
+		ctxc, &walletrpc.ConsolidateUtxosRequest{
+			TargetConf:  uint32(ctx.Uint64("conf_target")),
+			SatPerVbyte: ctx.Uint64("sat_per_vbyte"),
+			DryRun:      ctx.Bool("dry_run"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var labelTxCommand = cli.Command{
 	Name:      "labeltx",
 	Usage:     "adds a label to a transaction",
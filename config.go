@@ -305,12 +305,18 @@ type Config struct {
 
 	RejectHTLC bool `long:"rejecthtlc" description:"If true, lnd will not forward any HTLCs that are meant as onward payments. This option will still allow lnd to send HTLCs and receive HTLCs but lnd won't be used as a hop."`
 
+	RequireInterceptor bool `long:"requireinterceptor" description:"If true, forwarded HTLCs are held while no HTLC interceptor is connected, including HTLCs that are replayed after a restart. Held HTLCs are presented to the next interceptor that connects, or failed back shortly before they expire."`
+
+	HtlcEventLogSize uint64 `long:"htlc-event-log-size" description:"The maximum number of htlc events that are persisted, so that they can be replayed to clients of the SubscribeHtlcEvents RPC. Once the limit is reached, the oldest events are pruned. Set to 0 to disable persisting htlc events."`
+
 	StaggerInitialReconnect bool `long:"stagger-initial-reconnect" description:"If true, will apply a randomized staggering between 0s and 30s when reconnecting to persistent peers on startup. The first 10 reconnections will be attempted instantly, regardless of the flag's value"`
 
 	MaxOutgoingCltvExpiry uint32 `long:"max-cltv-expiry" description:"The maximum number of blocks funds could be locked up for when forwarding payments."`
 
 	MaxChannelFeeAllocation float64 `long:"max-channel-fee-allocation" description:"The maximum percentage of total funds that can be allocated to a channel's commitment fee. This only applies for the initiator of the channel. Valid values are within [0.1, 1]."`
 
+	MaxDustExposure uint64 `long:"max-dust-exposure" description:"The maximum total value in satoshis of dust htlcs that a channel may carry on either of its commitments. Dust htlcs are lost to the miners when the channel is force closed. Htlcs and fee updates that would exceed this value are rejected. Set to 0 to disable the limit."`
+
 	MaxCommitFeeRateAnchors uint64 `long:"max-commit-fee-rate-anchors" description:"The maximum fee rate in sat/vbyte that will be used for commitments of channels of the anchors type. Must be large enough to ensure transaction propagation"`
 
 	DryRunMigration bool `long:"dry-run-migration" description:"If true, lnd will abort committing a migration if it would otherwise have been successful. This leaves the database unmodified, and still compatible with the previously active version of lnd."`
@@ -327,14 +333,24 @@ type Config struct {
 
 	GcCanceledInvoicesOnTheFly bool `long:"gc-canceled-invoices-on-the-fly" description:"If true, we'll delete newly canceled invoices on the fly."`
 
+	HoldExpiryDelta uint32 `long:"hold-expiry-delta" description:"The number of blocks before the expiry of the first htlc paying to an accepted hold invoice at which the invoice is canceled automatically. This prevents the incoming channel from being force closed if the invoice is neither settled nor canceled in time. Must be greater than the incoming broadcast deltas of the force close policies. By default, 2 blocks more than the largest of them are used. Set to 0 to disable automatic cancellation."`
+
 	Routing *lncfg.Routing `group:"routing" namespace:"routing"`
 
 	Gossip *lncfg.Gossip `group:"gossip" namespace:"gossip"`
 
+	Trampoline *lncfg.Trampoline `group:"trampoline" namespace:"trampoline"`
+
 	Workers *lncfg.Workers `group:"workers" namespace:"workers"`
 
 	Caches *lncfg.Caches `group:"caches" namespace:"caches"`
 
+	Prune *lncfg.Prune `group:"prune" namespace:"prune"`
+
+	ForceClose *lncfg.ForceClose `group:"forceclose" namespace:"forceclose"`
+
+	Consolidation *lncfg.Consolidation `group:"consolidation" namespace:"consolidation"`
+
 	Prometheus lncfg.Prometheus `group:"prometheus" namespace:"prometheus"`
 
 	WtClient *lncfg.WtClient `group:"wtclient" namespace:"wtclient"`
@@ -494,7 +510,12 @@ func DefaultConfig() Config {
 			RejectCacheSize:  channeldb.DefaultRejectCacheSize,
 			ChannelCacheSize: channeldb.DefaultChannelCacheSize,
 		},
-		Prometheus: lncfg.DefaultPrometheus(),
+		Prune: &lncfg.Prune{
+			Interval: lncfg.DefaultPruneInterval,
+		},
+		ForceClose:    lncfg.DefaultForceClose(),
+		Consolidation: lncfg.DefaultConsolidation(),
+		Prometheus:    lncfg.DefaultPrometheus(),
 		Watchtower: &lncfg.Watchtower{
 			TowerDir: defaultTowerDir,
 		},
@@ -525,8 +546,18 @@ func DefaultConfig() Config {
 			MaxChannelUpdateBurst: discovery.DefaultMaxChannelUpdateBurst,
 			ChannelUpdateInterval: discovery.DefaultChannelUpdateInterval,
 		},
+		Trampoline: &lncfg.Trampoline{
+			BaseFeeMsat:    lncfg.DefaultTrampolineBaseFeeMsat,
+			FeeRatePPM:     lncfg.DefaultTrampolineFeeRatePPM,
+			CltvDelta:      lncfg.DefaultTrampolineCltvDelta,
+			MaxParts:       lncfg.DefaultTrampolineMaxParts,
+			PaymentTimeout: lncfg.DefaultTrampolinePaymentTimeout,
+		},
 		MaxOutgoingCltvExpiry:   htlcswitch.DefaultMaxOutgoingCltvExpiry,
 		MaxChannelFeeAllocation: htlcswitch.DefaultMaxLinkFeeAllocation,
+		MaxDustExposure:         uint64(htlcswitch.DefaultMaxDustExposure.ToSatoshis()),
+		HtlcEventLogSize:        htlcswitch.DefaultHtlcEventLogSize,
+		HoldExpiryDelta:         lncfg.DefaultHoldInvoiceExpiryDelta,
 		MaxCommitFeeRateAnchors: lnwallet.DefaultAnchorsCommitMaxFeeRateSatPerVByte,
 		LogWriter:               build.NewRotatingLogWriter(),
 		DB:                      lncfg.DefaultDB(),
@@ -1530,6 +1561,31 @@ func ValidateConfig(cfg Config, usageMessage string,
 			maxRemoteHtlcs)
 	}
 
+	if err := cfg.ForceClose.Parse(); err != nil {
+		return nil, err
+	}
+
+	// Accepted hold invoices must be canceled before the incoming channel
+	// is force closed to claim the htlc on chain. If the default delta
+	// doesn't leave room for that, we'll derive it from the largest
+	// incoming broadcast delta instead, leaving the same number of blocks
+	// for the cancellation to reach our peer.
+	maxIncomingDelta := cfg.ForceClose.MaxIncomingBroadcastDelta()
+	if cfg.HoldExpiryDelta == lncfg.DefaultHoldInvoiceExpiryDelta &&
+		cfg.HoldExpiryDelta <= maxIncomingDelta {
+
+		cfg.HoldExpiryDelta = maxIncomingDelta +
+			lncfg.DefaultHoldInvoiceExpiryDelta -
+			lncfg.DefaultIncomingBroadcastDelta
+	}
+	if cfg.HoldExpiryDelta != 0 &&
+		cfg.HoldExpiryDelta <= maxIncomingDelta {
+
+		return nil, fmt.Errorf("hold-expiry-delta (%v) must be "+
+			"greater than %v", cfg.HoldExpiryDelta,
+			maxIncomingDelta)
+	}
+
 	if err := cfg.Gossip.Parse(); err != nil {
 		return nil, err
 	}
@@ -1538,9 +1594,12 @@ func ValidateConfig(cfg Config, usageMessage string,
 	err = lncfg.Validate(
 		cfg.Workers,
 		cfg.Caches,
+		cfg.Prune,
+		cfg.Consolidation,
 		cfg.WtClient,
 		cfg.DB,
 		cfg.HealthChecks,
+		cfg.Trampoline,
 	)
 	if err != nil {
 		return nil, err
@@ -20,6 +20,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 // ErrChainArbExiting signals that the chain arbitrator is shutting down.
@@ -50,6 +51,23 @@ type ResolutionMsg struct {
 	PreImage *[32]byte
 }
 
+// BroadcastPolicy determines when the arbitrator of a channel goes on-chain
+// to resolve the htlcs of the channel.
+type BroadcastPolicy struct {
+	// IncomingBroadcastDelta is the delta that we'll use to decide when to
+	// broadcast our commitment transaction if we have incoming htlcs.
+	IncomingBroadcastDelta uint32
+
+	// OutgoingBroadcastDelta is the delta that we'll use to decide when to
+	// broadcast our commitment transaction if there are active outgoing
+	// htlcs.
+	OutgoingBroadcastDelta uint32
+
+	// MinHtlcValue is the value below which htlcs don't make us go
+	// on-chain.
+	MinHtlcValue btcutil.Amount
+}
+
 // ChainArbitratorConfig is a configuration struct that contains all the
 // function closures and interface that required to arbitrate on-chain
 // contracts for a particular chain.
@@ -72,6 +90,16 @@ type ChainArbitratorConfig struct {
 	// htlcs. This value can be lower than the incoming broadcast delta.
 	OutgoingBroadcastDelta uint32
 
+	// MinBroadcastHtlcValue is the value below which htlcs that reach
+	// their broadcast delta don't make us go on-chain, as the fees of the
+	// force close would exceed their value. These htlcs are kept in a
+	// dust-at-risk state instead. A zero value disables this.
+	MinBroadcastHtlcValue btcutil.Amount
+
+	// BroadcastPolicies holds the broadcast deltas and minimum htlc values
+	// of channels that override the values above.
+	BroadcastPolicies map[wire.OutPoint]BroadcastPolicy
+
 	// NewSweepAddr is a function that returns a new address under control
 	// by the wallet. We'll use this to sweep any no-delay outputs as a
 	// result of unilateral channel closes.
@@ -111,14 +139,10 @@ type ChainArbitratorConfig struct {
 	// returned.
 	IsOurAddress func(btcutil.Address) bool
 
-	// IncubateOutput sends either an incoming HTLC, an outgoing HTLC, or
-	// both to the utxo nursery. Once this function returns, the nursery
-	// should have safely persisted the outputs to disk, and should start
-	// the process of incubation. This is used when a resolver wishes to
-	// pass off the output to the nursery as we're only waiting on an
-	// absolute/relative item block.
-	IncubateOutputs func(wire.OutPoint, *lnwallet.OutgoingHtlcResolution,
-		*lnwallet.IncomingHtlcResolution, uint32) error
+	// FetchInputInfo returns the output of the wallet that is spent by
+	// the given outpoint. It's used to find the scripts of the inputs of
+	// unsigned funding transactions.
+	FetchInputInfo func(*wire.OutPoint) (*lnwallet.Utxo, error)
 
 	// PreimageDB is a global store of all known pre-images. We'll use this
 	// to decide if we should broadcast a commitment transaction to claim
@@ -168,6 +192,13 @@ type ChainArbitratorConfig struct {
 	// htlcIndex, if it is a forwarded one.
 	IsForwardedHTLC func(chanID lnwire.ShortChannelID, htlcIndex uint64) bool
 
+	// IncomingHTLCExpiry returns for a given outgoing htlc, identified by
+	// channel id and htlcIndex, the expiry of the incoming htlc it was
+	// forwarded for. False is returned if it isn't a forwarded htlc, or if
+	// the incoming htlc can't be found.
+	IncomingHTLCExpiry func(chanID lnwire.ShortChannelID,
+		htlcIndex uint64) (uint32, bool)
+
 	// Clock is the clock implementation that ChannelArbitrator uses.
 	// It is useful for testing.
 	Clock clock.Clock
@@ -342,6 +373,7 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 		PutResolverReport: func(tx kvdb.RwTx,
 			report *channeldb.ResolverReport) error {
 
+			report.Timestamp = c.cfg.Clock.Now()
 			return c.chanSource.PutResolverReport(
 				tx, c.cfg.ChainHash, &channel.FundingOutpoint,
 				report,
@@ -349,6 +381,14 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 		},
 	}
 
+	// If the channel has its own broadcast policy, it replaces the default
+	// broadcast deltas and minimum htlc value.
+	if policy, ok := c.cfg.BroadcastPolicies[chanPoint]; ok {
+		arbCfg.IncomingBroadcastDelta = policy.IncomingBroadcastDelta
+		arbCfg.OutgoingBroadcastDelta = policy.OutgoingBroadcastDelta
+		arbCfg.MinBroadcastHtlcValue = policy.MinHtlcValue
+	}
+
 	// The final component needed is an arbitrator log that the arbitrator
 	// will use to keep track of its internal state using a backed
 	// persistent log.
@@ -492,6 +532,7 @@ func (c *ChainArbitrator) Start() error {
 					return c.cfg.ContractBreach(chanPoint, retInfo)
 				},
 				extractStateNumHint: lnwallet.GetStateNumHint,
+				fetchInputInfo:      c.cfg.FetchInputInfo,
 			},
 		)
 		if err != nil {
@@ -547,6 +588,7 @@ func (c *ChainArbitrator) Start() error {
 			PutResolverReport: func(tx kvdb.RwTx,
 				report *channeldb.ResolverReport) error {
 
+				report.Timestamp = c.cfg.Clock.Now()
 				return c.chanSource.PutResolverReport(
 					tx, c.cfg.ChainHash, &chanPoint, report,
 				)
@@ -978,6 +1020,62 @@ func (c *ChainArbitrator) GetChannelArbitrator(chanPoint wire.OutPoint) (
 	return arbitrator, nil
 }
 
+// DustAtRisk returns the htlcs that the arbitrators of the active channels
+// keep monitoring instead of going on-chain for them because of their low
+// value, keyed by channel point. Channels without such htlcs are omitted.
+func (c *ChainArbitrator) DustAtRisk() map[wire.OutPoint][]channeldb.HTLC {
+	c.Lock()
+	defer c.Unlock()
+
+	dustAtRisk := make(map[wire.OutPoint][]channeldb.HTLC)
+	for chanPoint, arbitrator := range c.activeChannels {
+		atRisk := arbitrator.DustAtRisk()
+		if len(atRisk) == 0 {
+			continue
+		}
+
+		dustAtRisk[chanPoint] = atRisk
+	}
+
+	return dustAtRisk
+}
+
+// BumpAnchorFee raises the fee rate of the unconfirmed force close of the
+// channel identified by the passed channel point, by sweeping the anchors of
+// its commitments with the given fee preference. The fee of the sweep is
+// chosen such that the package made up of the commitment and the sweep reaches
+// the requested fee rate, replacing any earlier sweep of the anchors.
+func (c *ChainArbitrator) BumpAnchorFee(chanPoint wire.OutPoint,
+	feePref sweep.FeePreference) error {
+
+	c.Lock()
+	arbitrator, ok := c.activeChannels[chanPoint]
+	c.Unlock()
+	if !ok {
+		return fmt.Errorf("unable to find arbitrator")
+	}
+
+	log.Infof("Attempting to bump anchor fee of ChannelPoint(%v)",
+		chanPoint)
+
+	errChan := make(chan error, 1)
+	select {
+	case arbitrator.bumpAnchorReqs <- &bumpAnchorReq{
+		fee:     feePref,
+		errResp: errChan,
+	}:
+	case <-c.quit:
+		return ErrChainArbExiting
+	}
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-c.quit:
+		return ErrChainArbExiting
+	}
+}
+
 // forceCloseReq is a request sent from an outside sub-system to the arbitrator
 // that watches a particular channel to broadcast the commitment transaction,
 // and enter the resolution phase of the channel.
@@ -993,6 +1091,38 @@ type forceCloseReq struct {
 	closeTx chan *wire.MsgTx
 }
 
+// FailUnconfirmedChannel marks a zero-conf channel, whose funding transaction
+// didn't confirm in time, as closed with the given close summary. As the
+// channel may already be in use under its alias, its link is removed and all
+// HTLCs forwarded over it are failed back first.
+func (c *ChainArbitrator) FailUnconfirmedChannel(
+	closeSummary *channeldb.ChannelCloseSummary) error {
+
+	c.Lock()
+	arbitrator, ok := c.activeChannels[closeSummary.ChanPoint]
+	c.Unlock()
+	if !ok {
+		return fmt.Errorf("unable to find arbitrator")
+	}
+
+	errChan := make(chan error, 1)
+	select {
+	case arbitrator.fundingFailReqs <- &fundingFailReq{
+		closeSummary: closeSummary,
+		errResp:      errChan,
+	}:
+	case <-c.quit:
+		return ErrChainArbExiting
+	}
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-c.quit:
+		return ErrChainArbExiting
+	}
+}
+
 // ForceCloseContract attempts to force close the channel infield by the passed
 // channel point. A force close will immediately terminate the contract,
 // causing it to enter the resolution phase. If the force close was successful,
@@ -1082,6 +1212,7 @@ func (c *ChainArbitrator) WatchNewChannel(newChan *channeldb.OpenChannel) error
 				return c.cfg.ContractBreach(chanPoint, retInfo)
 			},
 			extractStateNumHint: lnwallet.GetStateNumHint,
+			fetchInputInfo:      c.cfg.FetchInputInfo,
 		},
 	)
 	if err != nil {
@@ -47,6 +47,21 @@ type CooperativeCloseInfo struct {
 	*channeldb.ChannelCloseSummary
 }
 
+// FundingDoubleSpendInfo encapsulates all the information we need to act on
+// an input of a pending channel's funding transaction being spent by a
+// conflicting transaction that gets confirmed.
+type FundingDoubleSpendInfo struct {
+	*channeldb.ChannelCloseSummary
+
+	// DoubleSpentInput is the input of the funding transaction that was
+	// spent by the conflicting transaction.
+	DoubleSpentInput wire.OutPoint
+
+	// ZeroConf is true if the channel is a zero-conf channel that may
+	// already be in use, in which case its link needs to be torn down.
+	ZeroConf bool
+}
+
 // RemoteUnilateralCloseInfo wraps the normal UnilateralCloseSummary to couple
 // the CommitSet at the time of channel closure.
 type RemoteUnilateralCloseInfo struct {
@@ -126,6 +141,11 @@ type ChainEventSubscription struct {
 	// material required to bring the cheating channel peer to justice.
 	ContractBreach chan *lnwallet.BreachRetribution
 
+	// FundingDoubleSpend is a channel that will be sent upon if one of the
+	// inputs of the funding transaction of a pending channel is spent by
+	// a conflicting transaction, meaning the channel can never be opened.
+	FundingDoubleSpend chan *FundingDoubleSpendInfo
+
 	// Cancel cancels the subscription to the event stream for a particular
 	// channel. This method should be called once the caller no longer needs to
 	// be notified of any on-chain events for a particular channel.
@@ -163,6 +183,11 @@ type chainWatcherConfig struct {
 	// obfuscater. This is used by the chain watcher to identify which
 	// state was broadcast and confirmed on-chain.
 	extractStateNumHint func(*wire.MsgTx, [lnwallet.StateHintSize]byte) uint64
+
+	// fetchInputInfo returns the wallet output that is spent by the given
+	// outpoint. It's used to find the scripts of the inputs of an
+	// unsigned funding transaction.
+	fetchInputInfo func(*wire.OutPoint) (*lnwallet.Utxo, error)
 }
 
 // chainWatcher is a system that's assigned to every active channel. The duty
@@ -241,10 +266,11 @@ func (c *chainWatcher) Start() error {
 	fundingOut := &chanState.FundingOutpoint
 
 	// As a height hint, we'll try to use the opening height, but if the
-	// channel isn't yet open, then we'll use the height it was broadcast
-	// at.
-	heightHint := c.cfg.chanState.ShortChanID().BlockHeight
-	if heightHint == 0 {
+	// channel isn't yet open, or is a zero-conf channel that is still
+	// known by its alias, then we'll use the height it was broadcast at.
+	shortChanID := c.cfg.chanState.ShortChanID()
+	heightHint := shortChanID.BlockHeight
+	if heightHint == 0 || shortChanID.IsAlias() {
 		heightHint = chanState.FundingBroadcastHeight
 	}
 
@@ -273,6 +299,172 @@ func (c *chainWatcher) Start() error {
 	c.wg.Add(1)
 	go c.closeObserver(spendNtfn)
 
+	// If the channel is still pending and we know its funding
+	// transaction, we'll also watch the inputs of the funding transaction
+	// for conflicting spends, as the channel can never confirm if any of
+	// them is double spent. The same goes for a zero-conf channel that is
+	// already open under its alias.
+	unconfirmed := chanState.IsPending ||
+		(chanState.ChanType.IsZeroConf() && shortChanID.IsAlias())
+	if unconfirmed && chanState.FundingTxn != nil {
+		c.wg.Add(1)
+		go c.fundingInputsObserver(heightHint)
+	}
+
+	return nil
+}
+
+// fundingInputsObserver watches the inputs of the funding transaction of a
+// pending channel, and dispatches a FundingDoubleSpendInfo to all subscribers
+// if any of them is spent by a transaction other than the funding
+// transaction. Once the funding transaction itself confirms, the observer
+// exits.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *chainWatcher) fundingInputsObserver(heightHint uint32) {
+	defer c.wg.Done()
+
+	fundingTx := c.cfg.chanState.FundingTxn
+	fundingTxID := fundingTx.TxHash()
+
+	// spend couples a spend notification with the funding transaction
+	// input that was spent.
+	type spend struct {
+		input  wire.OutPoint
+		detail *chainntnfs.SpendDetail
+	}
+
+	spends := make(chan spend, len(fundingTx.TxIn))
+	exit := make(chan struct{})
+	defer close(exit)
+
+	for _, txIn := range fundingTx.TxIn {
+		op := txIn.PreviousOutPoint
+
+		pkScript, err := c.fundingInputScript(txIn)
+		if err != nil {
+			log.Warnf("ChannelPoint(%v): unable to watch funding "+
+				"input %v for double spends: %v",
+				c.cfg.chanState.FundingOutpoint, op, err)
+			continue
+		}
+
+		spendNtfn, err := c.cfg.notifier.RegisterSpendNtfn(
+			&op, pkScript, heightHint,
+		)
+		if err != nil {
+			log.Errorf("ChannelPoint(%v): unable to register "+
+				"spend ntfn for funding input %v: %v",
+				c.cfg.chanState.FundingOutpoint, op, err)
+			continue
+		}
+		defer spendNtfn.Cancel()
+
+		go func() {
+			select {
+			case detail, ok := <-spendNtfn.Spend:
+				if !ok {
+					return
+				}
+				spends <- spend{input: op, detail: detail}
+
+			case <-exit:
+			}
+		}()
+	}
+
+	select {
+	case s := <-spends:
+		// If the input was spent by the funding transaction itself,
+		// the funding transaction confirmed and none of its inputs can
+		// be double spent anymore.
+		if *s.detail.SpenderTxHash == fundingTxID {
+			return
+		}
+
+		log.Warnf("ChannelPoint(%v): funding input %v double spent "+
+			"by tx %v", c.cfg.chanState.FundingOutpoint, s.input,
+			s.detail.SpenderTxHash)
+
+		err := c.dispatchFundingDoubleSpend(s.input, s.detail)
+		if err != nil {
+			log.Errorf("unable to handle funding double spend for "+
+				"ChannelPoint(%v): %v",
+				c.cfg.chanState.FundingOutpoint, err)
+		}
+
+	case <-c.quit:
+	}
+}
+
+// fundingInputScript returns the script of the output that is spent by the
+// given input of the funding transaction. The script isn't stored with the
+// funding transaction, so we'll derive it from the input. The funding
+// transaction of a batch, or of a PSBT flow that skipped finalization, is
+// unsigned though, in which case the output is looked up in the wallet.
+func (c *chainWatcher) fundingInputScript(txIn *wire.TxIn) ([]byte, error) {
+	pkScript, err := txscript.ComputePkScript(
+		txIn.SignatureScript, txIn.Witness,
+	)
+	if err == nil {
+		return pkScript.Script(), nil
+	}
+
+	if c.cfg.fetchInputInfo == nil {
+		return nil, err
+	}
+
+	utxo, err := c.cfg.fetchInputInfo(&txIn.PreviousOutPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return utxo.PkScript, nil
+}
+
+// dispatchFundingDoubleSpend notifies all subscribers that an input of the
+// funding transaction of the pending channel was spent by a conflicting
+// transaction.
+func (c *chainWatcher) dispatchFundingDoubleSpend(
+	doubleSpentInput wire.OutPoint, spend *chainntnfs.SpendDetail) error {
+
+	// The funding output never made it on chain, so there's nothing to
+	// settle, even if this is a zero-conf channel that was already in use.
+	// The conflicting transaction is recorded as the closing transaction.
+	closeSummary := &channeldb.ChannelCloseSummary{
+		ChanPoint:               c.cfg.chanState.FundingOutpoint,
+		ChainHash:               c.cfg.chanState.ChainHash,
+		ClosingTXID:             *spend.SpenderTxHash,
+		RemotePub:               c.cfg.chanState.IdentityPub,
+		Capacity:                c.cfg.chanState.Capacity,
+		CloseHeight:             uint32(spend.SpendingHeight),
+		CloseType:               channeldb.FundingDoubleSpent,
+		ShortChanID:             c.cfg.chanState.ShortChanID(),
+		IsPending:               true,
+		RemoteCurrentRevocation: c.cfg.chanState.RemoteCurrentRevocation,
+		RemoteNextRevocation:    c.cfg.chanState.RemoteNextRevocation,
+		LocalChanConfig:         c.cfg.chanState.LocalChanCfg,
+	}
+
+	doubleSpendInfo := &FundingDoubleSpendInfo{
+		ChannelCloseSummary: closeSummary,
+		DoubleSpentInput:    doubleSpentInput,
+		ZeroConf:            c.cfg.chanState.ChanType.IsZeroConf(),
+	}
+
+	// With the event processed, we'll now notify all subscribers of the
+	// event.
+	c.Lock()
+	for _, sub := range c.clientSubscriptions {
+		select {
+		case sub.FundingDoubleSpend <- doubleSpendInfo:
+		case <-c.quit:
+			c.Unlock()
+			return fmt.Errorf("exiting")
+		}
+	}
+	c.Unlock()
+
 	return nil
 }
 
@@ -309,6 +501,7 @@ func (c *chainWatcher) SubscribeChannelEvents() *ChainEventSubscription {
 		LocalUnilateralClosure:  make(chan *LocalUnilateralCloseInfo, 1),
 		CooperativeClosure:      make(chan *CooperativeCloseInfo, 1),
 		ContractBreach:          make(chan *lnwallet.BreachRetribution, 1),
+		FundingDoubleSpend:      make(chan *FundingDoubleSpendInfo, 1),
 		Cancel: func() {
 			c.Lock()
 			delete(c.clientSubscriptions, clientID)
@@ -84,6 +84,167 @@ func TestChainWatcherRemoteUnilateralClose(t *testing.T) {
 	}
 }
 
+// TestChainWatcherFundingDoubleSpend tests that the chain watcher of a pending
+// channel detects an input of the funding transaction being spent by a
+// conflicting transaction, while a spend by the funding transaction itself
+// isn't reported.
+func TestChainWatcherFundingDoubleSpend(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		doubleSpend bool
+		zeroConf    bool
+		unsigned    bool
+	}{
+		{
+			name:        "funding tx confirmed",
+			doubleSpend: false,
+		},
+		{
+			name:        "funding input double spent",
+			doubleSpend: true,
+		},
+		{
+			name:        "zero-conf funding input double spent",
+			doubleSpend: true,
+			zeroConf:    true,
+		},
+		{
+			name:        "unsigned funding tx input double spent",
+			doubleSpend: true,
+			unsigned:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testChainWatcherFundingDoubleSpend(
+				t, tc.doubleSpend, tc.zeroConf, tc.unsigned,
+			)
+		})
+	}
+}
+
+func testChainWatcherFundingDoubleSpend(t *testing.T, doubleSpend,
+	zeroConf, unsigned bool) {
+
+	chanType := channeldb.SingleFunderTweaklessBit
+	if zeroConf {
+		chanType |= channeldb.ZeroConfBit
+	}
+	aliceChannel, _, cleanUp, err := lnwallet.CreateTestChannels(chanType)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll mark Alice's channel as pending, and give it a funding
+	// transaction spending a single p2wkh input.
+	fundingInput := wire.OutPoint{Hash: [32]byte{1}, Index: 1}
+	fundingTx := wire.NewMsgTx(2)
+	fundingTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: fundingInput,
+		Witness: wire.TxWitness{
+			bytes.Repeat([]byte{1}, 72),
+			aliceChannel.State().LocalChanCfg.MultiSigKey.PubKey.
+				SerializeCompressed(),
+		},
+	})
+
+	// The script of the input of an unsigned funding transaction can only
+	// be found in the wallet.
+	fundingInputScript := append([]byte{0x00, 0x14}, make([]byte, 20)...)
+	fetchInputInfo := func(op *wire.OutPoint) (*lnwallet.Utxo, error) {
+		if *op != fundingInput {
+			return nil, fmt.Errorf("unknown output %v", op)
+		}
+
+		return &lnwallet.Utxo{PkScript: fundingInputScript}, nil
+	}
+	if unsigned {
+		fundingTx.TxIn[0].Witness = nil
+	}
+
+	// A zero-conf channel is already open under its alias while the
+	// funding transaction is unconfirmed.
+	chanState := aliceChannel.State()
+	chanState.IsPending = !zeroConf
+	chanState.FundingTxn = fundingTx
+	if zeroConf {
+		chanID := lnwire.NewChanIDFromOutPoint(
+			&chanState.FundingOutpoint,
+		)
+		chanState.ShortChannelID = lnwire.NewAliasShortChanID(chanID)
+	}
+
+	aliceNotifier := mock.MakeMockSpendNotifier()
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState:           chanState,
+		notifier:            aliceNotifier,
+		signer:              aliceChannel.Signer,
+		extractStateNumHint: lnwallet.GetStateNumHint,
+		fetchInputInfo:      fetchInputInfo,
+	})
+	if err != nil {
+		t.Fatalf("unable to create chain watcher: %v", err)
+	}
+	if err := aliceChainWatcher.Start(); err != nil {
+		t.Fatalf("unable to start chain watcher: %v", err)
+	}
+	defer aliceChainWatcher.Stop()
+
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	// The funding input is either spent by the funding transaction, or by
+	// a conflicting transaction.
+	spendTx := fundingTx
+	if doubleSpend {
+		spendTx = wire.NewMsgTx(2)
+		spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: fundingInput})
+		spendTx.AddTxOut(&wire.TxOut{Value: 1000})
+	}
+	aliceNotifier.Spend(&fundingInput, 100, spendTx)
+
+	if !doubleSpend {
+		select {
+		case <-chanEvents.FundingDoubleSpend:
+			t.Fatalf("unexpected funding double spend event")
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		return
+	}
+
+	var doubleSpendInfo *FundingDoubleSpendInfo
+	select {
+	case doubleSpendInfo = <-chanEvents.FundingDoubleSpend:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("didn't receive funding double spend event")
+	}
+
+	if doubleSpendInfo.DoubleSpentInput != fundingInput {
+		t.Fatalf("expected double spent input %v, got %v",
+			fundingInput, doubleSpendInfo.DoubleSpentInput)
+	}
+	if doubleSpendInfo.CloseType != channeldb.FundingDoubleSpent {
+		t.Fatalf("expected close type %v, got %v",
+			channeldb.FundingDoubleSpent, doubleSpendInfo.CloseType)
+	}
+	if doubleSpendInfo.ClosingTXID != spendTx.TxHash() {
+		t.Fatalf("expected closing txid %v, got %v",
+			spendTx.TxHash(), doubleSpendInfo.ClosingTXID)
+	}
+	if doubleSpendInfo.ZeroConf != zeroConf {
+		t.Fatalf("expected zero-conf %v, got %v", zeroConf,
+			doubleSpendInfo.ZeroConf)
+	}
+}
+
 func addFakeHTLC(t *testing.T, htlcAmount lnwire.MilliSatoshi, id uint64,
 	aliceChannel, bobChannel *lnwallet.LightningChannel) {
 
@@ -27,8 +27,47 @@ var (
 	// close a channel that's already in the process of doing so.
 	errAlreadyForceClosed = errors.New("channel is already in the " +
 		"process of being force closed")
+
+	// errNoUnconfirmedCommitment is an error returned when we attempt to
+	// bump the fee of the commitment of a channel that we didn't force
+	// close, or whose commitment already confirmed.
+	errNoUnconfirmedCommitment = errors.New("channel has no unconfirmed " +
+		"force close commitment")
+
+	// errNoAnchors is an error returned when we attempt to bump the fee
+	// of a commitment that has no anchor outputs.
+	errNoAnchors = errors.New("commitment has no anchor outputs")
 )
 
+// bumpAnchorReq is a request sent from an outside sub-system to the arbitrator
+// to raise the fee rate at which the anchors of the broadcast commitments are
+// swept, in order to get the commitment confirmed through CPFP.
+type bumpAnchorReq struct {
+	// fee is the new fee preference for the package made up of the
+	// commitment and the transaction sweeping its anchor.
+	fee sweep.FeePreference
+
+	// errResp is a channel that will be sent upon with the result of the
+	// request.
+	//
+	// NOTE; This channel MUST be buffered.
+	errResp chan error
+}
+
+// fundingFailReq is a request sent from an outside sub-system to the
+// arbitrator to mark a zero-conf channel as closed, as its funding transaction
+// didn't confirm in time.
+type fundingFailReq struct {
+	// closeSummary is the summary the channel is marked closed with.
+	closeSummary *channeldb.ChannelCloseSummary
+
+	// errResp is a channel that will be sent upon with the result of the
+	// request.
+	//
+	// NOTE; This channel MUST be buffered.
+	errResp chan error
+}
+
 const (
 	// anchorSweepConfTarget is the conf target used when sweeping
 	// commitment anchors.
@@ -353,10 +392,31 @@ type ChannelArbitrator struct {
 	// contract will be sent over.
 	forceCloseReqs chan *forceCloseReq
 
+	// bumpAnchorReqs is a channel that requests to bump the fee of the
+	// broadcast commitments through their anchors will be sent over.
+	bumpAnchorReqs chan *bumpAnchorReq
+
+	// fundingFailReqs is a channel that requests to mark a zero-conf
+	// channel, whose funding transaction never confirmed, as closed will
+	// be sent over.
+	fundingFailReqs chan *fundingFailReq
+
 	// state is the current state of the arbitrator. This state is examined
 	// upon start up to decide which actions to take.
 	state ArbitratorState
 
+	// dustAtRisk is the set of htlcs that reached their broadcast delta,
+	// but are worth less than the minimum htlc value that makes us go
+	// on-chain. Instead of going on-chain, we keep monitoring them with
+	// each new block. The set isn't persisted, as it is derived from the
+	// active htlcs. It is recomputed at the best height when the
+	// arbitrator starts, as the initial chain trigger examines the htlcs
+	// of the channel's commitments.
+	dustAtRisk []channeldb.HTLC
+
+	// dustAtRiskMtx guards dustAtRisk.
+	dustAtRiskMtx sync.RWMutex
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -373,6 +433,8 @@ func NewChannelArbitrator(cfg ChannelArbitratorConfig,
 		htlcUpdates:      make(<-chan *ContractUpdate),
 		resolutionSignal: make(chan struct{}),
 		forceCloseReqs:   make(chan *forceCloseReq),
+		bumpAnchorReqs:   make(chan *bumpAnchorReq),
+		fundingFailReqs:  make(chan *fundingFailReq),
 		activeHTLCs:      htlcSets,
 		cfg:              cfg,
 		quit:             make(chan struct{}),
@@ -468,6 +530,11 @@ func (c *ChannelArbitrator) Start(state *chanArbStartState) error {
 			case channeldb.BreachClose:
 				trigger = breachCloseTrigger
 
+			case channeldb.FundingDoubleSpent,
+				channeldb.FundingCanceled:
+
+				trigger = fundingFailedTrigger
+
 			case channeldb.LocalForceClose:
 				trigger = localCloseTrigger
 
@@ -488,7 +555,8 @@ func (c *ChannelArbitrator) Start(state *chanArbStartState) error {
 		triggerHeight)
 
 	// We'll now attempt to advance our state forward based on the current
-	// on-chain state, and our set of active contracts.
+	// on-chain state, and our set of active contracts. For open channels,
+	// this also recomputes the htlcs that are kept as dust at risk.
 	startingState := c.state
 	nextState, _, err := c.advanceState(
 		triggerHeight, trigger, state.commitSet,
@@ -719,6 +787,12 @@ const (
 	// being confirmed. In this case the channel arbitrator won't have to
 	// do anything, so we'll just clean up and exit gracefully.
 	breachCloseTrigger
+
+	// fundingFailedTrigger is a transition trigger driven by the funding
+	// transaction of a channel never confirming, either because one of
+	// its inputs was spent by a conflicting transaction, or because a
+	// zero-conf channel timed out waiting for it.
+	fundingFailedTrigger
 )
 
 // String returns a human readable string describing the passed
@@ -743,6 +817,9 @@ func (t transitionTrigger) String() string {
 	case breachCloseTrigger:
 		return "breachCloseTrigger"
 
+	case fundingFailedTrigger:
+		return "fundingFailedTrigger"
+
 	default:
 		return "unknown trigger"
 	}
@@ -795,9 +872,20 @@ func (c *ChannelArbitrator) stateStep(
 			log.Tracef("ChannelArbitrator(%v): no actions for "+
 				"chain trigger, terminating", c.cfg.ChanPoint)
 
+			// Update the htlcs that we keep monitoring instead of
+			// going on-chain because of their value.
+			err := c.updateDustAtRisk(triggerHeight, htlcs)
+			if err != nil {
+				return StateDefault, nil, err
+			}
+
 			return StateDefault, closeTx, nil
 		}
 
+		// We're going on-chain, so all htlcs will be resolved there and
+		// none of them remain at risk.
+		c.setDustAtRisk(nil)
+
 		// Otherwise, we'll log that we checked the HTLC actions as the
 		// commitment transaction has already been broadcast.
 		log.Tracef("ChannelArbitrator(%v): logging chain_actions=%v",
@@ -822,8 +910,10 @@ func (c *ChannelArbitrator) stateStep(
 		// If the trigger is a cooperative close being confirmed, then
 		// we can go straight to StateFullyResolved, as there won't be
 		// any contracts to resolve. The same is true in the case of a
-		// breach.
-		case coopCloseTrigger, breachCloseTrigger:
+		// breach, or if the funding transaction was double spent.
+		case coopCloseTrigger, breachCloseTrigger,
+			fundingFailedTrigger:
+
 			nextState = StateFullyResolved
 
 		// Otherwise, if this state advance was triggered by a
@@ -859,7 +949,9 @@ func (c *ChannelArbitrator) stateStep(
 				c.cfg.ChanPoint, trigger, StateContractClosed)
 			return StateContractClosed, closeTx, nil
 
-		case coopCloseTrigger, breachCloseTrigger:
+		case coopCloseTrigger, breachCloseTrigger,
+			fundingFailedTrigger:
+
 			log.Infof("ChannelArbitrator(%v): detected %s "+
 				"close after closing channel, fast-forwarding "+
 				"to %s to resolve contract",
@@ -965,7 +1057,9 @@ func (c *ChannelArbitrator) stateStep(
 
 		// If a coop close or breach was confirmed, jump straight to
 		// the fully resolved state.
-		case coopCloseTrigger, breachCloseTrigger:
+		case coopCloseTrigger, breachCloseTrigger,
+			fundingFailedTrigger:
+
 			nextState = StateFullyResolved
 		}
 
@@ -1090,45 +1184,102 @@ func (c *ChannelArbitrator) stateStep(
 func (c *ChannelArbitrator) sweepAnchors(anchors []*lnwallet.AnchorResolution,
 	heightHint uint32) error {
 
+	// Sweep anchor outputs with a confirmation target fee preference.
+	// Because this is a cpfp-operation, the anchor will only be attempted
+	// to sweep when the current fee estimate for the confirmation target
+	// exceeds the commit fee rate.
+	feePref := sweep.FeePreference{
+		ConfTarget: anchorSweepConfTarget,
+	}
+
+	for _, anchor := range anchors {
+		err := c.sweepAnchor(anchor, heightHint, feePref)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sweepAnchor offers the given anchor resolution to the sweeper with the given
+// fee preference.
+func (c *ChannelArbitrator) sweepAnchor(anchor *lnwallet.AnchorResolution,
+	heightHint uint32, feePref sweep.FeePreference) error {
+
 	// Use the chan id as the exclusive group. This prevents any of the
 	// anchors from being batched together.
 	exclusiveGroup := c.cfg.ShortChanID.ToUint64()
 
+	log.Debugf("ChannelArbitrator(%v): pre-confirmation sweep of "+
+		"anchor of tx %v", c.cfg.ChanPoint, anchor.CommitAnchor)
+
+	// Prepare anchor output for sweeping.
+	anchorInput := input.MakeBaseInput(
+		&anchor.CommitAnchor,
+		input.CommitmentAnchor,
+		&anchor.AnchorSignDescriptor,
+		heightHint,
+		&input.TxInfo{
+			Fee:    anchor.CommitFee,
+			Weight: anchor.CommitWeight,
+		},
+	)
+
+	// Signal that this is a force sweep, so that the anchor will be swept
+	// even if it isn't economical purely based on the anchor value.
+	_, err := c.cfg.Sweeper.SweepInput(
+		&anchorInput,
+		sweep.Params{
+			Fee:            feePref,
+			Force:          true,
+			ExclusiveGroup: &exclusiveGroup,
+		},
+	)
+
+	return err
+}
+
+// bumpAnchors updates the fee preference of the sweeps of all anchors of our
+// unconfirmed commitments. The sweeper computes the fee of the sweep
+// transaction such that the package made up of the commitment and the sweep
+// reaches the fee rate of the preference, and replaces any earlier sweep
+// transaction. Anchors that the sweeper doesn't know of are offered to it.
+func (c *ChannelArbitrator) bumpAnchors(feePref sweep.FeePreference,
+	heightHint uint32) error {
+
+	anchors, err := c.cfg.Channel.NewAnchorResolutions()
+	if err != nil {
+		return err
+	}
+
+	if len(anchors) == 0 {
+		return errNoAnchors
+	}
+
 	for _, anchor := range anchors {
-		log.Debugf("ChannelArbitrator(%v): pre-confirmation sweep of "+
-			"anchor of tx %v", c.cfg.ChanPoint, anchor.CommitAnchor)
-
-		// Prepare anchor output for sweeping.
-		anchorInput := input.MakeBaseInput(
-			&anchor.CommitAnchor,
-			input.CommitmentAnchor,
-			&anchor.AnchorSignDescriptor,
-			heightHint,
-			&input.TxInfo{
-				Fee:    anchor.CommitFee,
-				Weight: anchor.CommitWeight,
+		log.Infof("ChannelArbitrator(%v): bumping fee of tx %v "+
+			"through anchor %v to %v", c.cfg.ChanPoint,
+			anchor.CommitAnchor.Hash, anchor.CommitAnchor, feePref)
+
+		_, err := c.cfg.Sweeper.UpdateParams(
+			anchor.CommitAnchor, sweep.ParamsUpdate{
+				Fee:   feePref,
+				Force: true,
 			},
 		)
+		switch err {
+		case nil:
 
-		// Sweep anchor output with a confirmation target fee
-		// preference. Because this is a cpfp-operation, the anchor will
-		// only be attempted to sweep when the current fee estimate for
-		// the confirmation target exceeds the commit fee rate.
-		//
-		// Also signal that this is a force sweep, so that the anchor
-		// will be swept even if it isn't economical purely based on the
-		// anchor value.
-		_, err := c.cfg.Sweeper.SweepInput(
-			&anchorInput,
-			sweep.Params{
-				Fee: sweep.FeePreference{
-					ConfTarget: anchorSweepConfTarget,
-				},
-				Force:          true,
-				ExclusiveGroup: &exclusiveGroup,
-			},
-		)
-		if err != nil {
+		// The anchor isn't being swept yet, so we'll offer it with the
+		// requested fee preference.
+		case lnwallet.ErrNotMine:
+			err := c.sweepAnchor(anchor, heightHint, feePref)
+			if err != nil {
+				return err
+			}
+
+		default:
 			return err
 		}
 	}
@@ -1329,6 +1480,116 @@ func (c *ChannelArbitrator) shouldGoOnChain(htlc channeldb.HTLC,
 	return isForwarded || upTime > c.cfg.PaymentsExpirationGracePeriod
 }
 
+// belowBroadcastValue returns true if the value of the htlc is too low to go
+// on-chain for it once it reaches its broadcast delta.
+func (c *ChannelArbitrator) belowBroadcastValue(htlc channeldb.HTLC) bool {
+	return htlc.Amt.ToSatoshis() < c.cfg.MinBroadcastHtlcValue
+}
+
+// updateDustAtRisk determines the htlcs that reached their broadcast delta at
+// the given height, but that don't make us go on-chain because their value is
+// below the minimum htlc value, and stores them as the set of htlcs at risk.
+func (c *ChannelArbitrator) updateDustAtRisk(height uint32,
+	activeHTLCs map[HtlcSetKey]htlcSet) error {
+
+	var (
+		atRisk   []channeldb.HTLC
+		outgoing = make(map[uint64]struct{})
+	)
+
+	// Outgoing htlcs are at risk if they are on any of the commitments,
+	// so we'll examine all sets but report each htlc once.
+	for _, htlcs := range activeHTLCs {
+		for _, htlc := range htlcs.outgoingHTLCs {
+			if _, ok := outgoing[htlc.HtlcIndex]; ok {
+				continue
+			}
+
+			if !c.belowBroadcastValue(htlc) ||
+				!c.shouldGoOnChain(
+					htlc, c.cfg.OutgoingBroadcastDelta,
+					height,
+				) {
+
+				continue
+			}
+
+			outgoing[htlc.HtlcIndex] = struct{}{}
+			atRisk = append(atRisk, htlc)
+		}
+	}
+
+	// Incoming htlcs are only at risk if we know the preimage, as we'd
+	// otherwise not go on-chain to claim them anyway.
+	for _, htlc := range activeHTLCs[LocalHtlcSet].incomingHTLCs {
+		if !c.belowBroadcastValue(htlc) ||
+			!c.shouldGoOnChain(
+				htlc, c.cfg.IncomingBroadcastDelta, height,
+			) {
+
+			continue
+		}
+
+		preimageAvailable, err := c.isPreimageAvailable(htlc.RHash)
+		if err != nil {
+			return err
+		}
+		if !preimageAvailable {
+			continue
+		}
+
+		atRisk = append(atRisk, htlc)
+	}
+
+	c.setDustAtRisk(atRisk)
+
+	return nil
+}
+
+// setDustAtRisk replaces the set of htlcs at risk, logging the htlcs that
+// weren't at risk before.
+func (c *ChannelArbitrator) setDustAtRisk(atRisk []channeldb.HTLC) {
+	c.dustAtRiskMtx.Lock()
+	defer c.dustAtRiskMtx.Unlock()
+
+	type htlcKey struct {
+		incoming bool
+		index    uint64
+	}
+	known := make(map[htlcKey]struct{}, len(c.dustAtRisk))
+	for _, htlc := range c.dustAtRisk {
+		known[htlcKey{htlc.Incoming, htlc.HtlcIndex}] = struct{}{}
+	}
+
+	for _, htlc := range atRisk {
+		key := htlcKey{htlc.Incoming, htlc.HtlcIndex}
+		if _, ok := known[key]; ok {
+			continue
+		}
+
+		log.Warnf("ChannelArbitrator(%v): not going to chain for "+
+			"htlc %x (incoming=%v, amt=%v, expiry=%v) below min "+
+			"htlc value %v, keeping it as dust at risk",
+			c.cfg.ChanPoint, htlc.RHash[:], htlc.Incoming,
+			htlc.Amt.ToSatoshis(), htlc.RefundTimeout,
+			c.cfg.MinBroadcastHtlcValue)
+	}
+
+	c.dustAtRisk = atRisk
+}
+
+// DustAtRisk returns the htlcs that reached their broadcast delta, but that
+// we're not going on-chain for because of their low value.
+func (c *ChannelArbitrator) DustAtRisk() []channeldb.HTLC {
+	c.dustAtRiskMtx.RLock()
+	defer c.dustAtRiskMtx.RUnlock()
+
+	atRisk := make([]channeldb.HTLC, len(c.dustAtRisk))
+	copy(atRisk, c.dustAtRisk)
+
+	return atRisk
+}
+
 // checkCommitChainActions is called for each new block connected to the end of
 // the main chain. Given the new block height, this new method will examine all
 // active HTLC's, and determine if we need to go on-chain to claim any of them.
@@ -1358,7 +1619,7 @@ func (c *ChannelArbitrator) checkCommitChainActions(height uint32,
 		// never resolved downstream, and it's "close" to timing out.
 		toChain := c.shouldGoOnChain(htlc, c.cfg.OutgoingBroadcastDelta,
 			height,
-		)
+		) && !c.belowBroadcastValue(htlc)
 
 		if toChain {
 			log.Debugf("ChannelArbitrator(%v): go to chain for "+
@@ -1389,7 +1650,7 @@ func (c *ChannelArbitrator) checkCommitChainActions(height uint32,
 
 		toChain := c.shouldGoOnChain(htlc, c.cfg.IncomingBroadcastDelta,
 			height,
-		)
+		) && !c.belowBroadcastValue(htlc)
 
 		if toChain {
 			log.Debugf("ChannelArbitrator(%v): go to chain for "+
@@ -1607,7 +1868,7 @@ func (c *ChannelArbitrator) checkRemoteDanglingActions(
 		// the incoming HTLC.
 		goToChain := c.shouldGoOnChain(htlc, c.cfg.OutgoingBroadcastDelta,
 			height,
-		)
+		) && !c.belowBroadcastValue(htlc)
 
 		// If we don't need to go to chain, and no commitments have
 		// been confirmed, then we can move on. Otherwise, if
@@ -2131,8 +2392,8 @@ func (c *ChannelArbitrator) UpdateContractSignals(newSignals *ContractSignals) {
 // outgoing HTLC is about to timeout, and when we know the pre-image for an
 // incoming HTLC, but it hasn't yet been settled off-chain. In these cases,
 // we'll: broadcast our commitment, cancel/settle any HTLC's backwards after
-// sufficient confirmation, and finally send our set of outputs to the UTXO
-// Nursery for incubation, and ultimate sweeping.
+// sufficient confirmation, and finally let our resolvers sweep the outputs
+// once their time locks have expired.
 //
 // NOTE: This MUST be run as a goroutine.
 func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
@@ -2238,6 +2499,23 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 				return
 			}
 
+		// An input of the funding transaction was double spent, so
+		// the channel will never be opened.
+		case doubleSpendInfo := <-c.cfg.ChainEvents.FundingDoubleSpend:
+			log.Infof("ChannelArbitrator(%v) marking channel "+
+				"closed, funding input %v double spent",
+				c.cfg.ChanPoint,
+				doubleSpendInfo.DoubleSpentInput)
+
+			err := c.failFunding(
+				doubleSpendInfo.ChannelCloseSummary,
+				doubleSpendInfo.ZeroConf,
+			)
+			if err != nil {
+				log.Errorf("Unable to fail funding: %v", err)
+				return
+			}
+
 		// We have broadcasted our commitment, and it is now confirmed
 		// on-chain.
 		case closeInfo := <-c.cfg.ChainEvents.LocalUnilateralClosure:
@@ -2419,6 +2697,27 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 				return
 			}
 
+		// The funding transaction of a zero-conf channel didn't
+		// confirm in time, so the channel will never be opened.
+		case failReq := <-c.fundingFailReqs:
+			if c.state != StateDefault {
+				failReq.errResp <- fmt.Errorf("channel "+
+					"already closing in state %v", c.state)
+
+				continue
+			}
+
+			log.Infof("ChannelArbitrator(%v) marking zero-conf "+
+				"channel closed, funding tx didn't confirm",
+				c.cfg.ChanPoint)
+
+			err := c.failFunding(failReq.closeSummary, true)
+			failReq.errResp <- err
+			if err != nil {
+				log.Errorf("Unable to fail funding: %v", err)
+				return
+			}
+
 		// We've just received a request to forcibly close out the
 		// channel. We'll
 		case closeReq := <-c.forceCloseReqs:
@@ -2464,8 +2763,89 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 				return
 			}
 
+		// A new request to bump the fee of our unconfirmed commitments
+		// has arrived.
+		case bumpReq := <-c.bumpAnchorReqs:
+			err := errNoUnconfirmedCommitment
+			if c.state == StateCommitmentBroadcasted {
+				err = c.bumpAnchors(
+					bumpReq.fee, uint32(bestHeight),
+				)
+			}
+
+			select {
+			case bumpReq.errResp <- err:
+			case <-c.quit:
+				return
+			}
+
 		case <-c.quit:
 			return
 		}
 	}
 }
+
+// failFunding marks the channel, whose funding transaction will never
+// confirm, as closed. A zero-conf channel may already be in use under its
+// alias, so we'll remove its link from the switch and fail back all HTLCs that
+// were forwarded over it first, as they can never be resolved on chain.
+func (c *ChannelArbitrator) failFunding(
+	closeSummary *channeldb.ChannelCloseSummary, zeroConf bool) error {
+
+	if zeroConf {
+		err := c.cfg.MarkLinkInactive(c.cfg.ChanPoint)
+		if err != nil {
+			log.Errorf("Unable to mark link inactive: %v", err)
+		}
+
+		if err := c.failOutgoingHTLCs(); err != nil {
+			return err
+		}
+	}
+
+	if err := c.cfg.MarkChannelClosed(closeSummary); err != nil {
+		return fmt.Errorf("unable to mark channel closed: %v", err)
+	}
+
+	// There are no contracts to resolve, so the state machine will
+	// advance straight to its terminal state.
+	_, _, err := c.advanceState(
+		closeSummary.CloseHeight, fundingFailedTrigger, nil,
+	)
+
+	return err
+}
+
+// failOutgoingHTLCs fails back all HTLCs we offered on any of the commitments
+// of the channel, without going on chain.
+func (c *ChannelArbitrator) failOutgoingHTLCs() error {
+	failureMsg := &lnwire.FailPermanentChannelFailure{}
+
+	var (
+		msgsToSend []ResolutionMsg
+		seen       = make(map[uint64]struct{})
+	)
+	for _, htlcs := range c.activeHTLCs {
+		for htlcIndex := range htlcs.outgoingHTLCs {
+			if _, ok := seen[htlcIndex]; ok {
+				continue
+			}
+			seen[htlcIndex] = struct{}{}
+
+			msgsToSend = append(msgsToSend, ResolutionMsg{
+				SourceChan: c.cfg.ShortChanID,
+				HtlcIndex:  htlcIndex,
+				Failure:    failureMsg,
+			})
+		}
+	}
+
+	if len(msgsToSend) == 0 {
+		return nil
+	}
+
+	log.Infof("ChannelArbitrator(%v): failing back %d outgoing htlcs",
+		c.cfg.ChanPoint, len(msgsToSend))
+
+	return c.cfg.DeliverResolutionMsg(msgsToSend...)
+}
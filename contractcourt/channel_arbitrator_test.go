@@ -22,6 +22,8 @@ import (
 	"github.com/lightningnetwork/lnd/lntest/mock"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/sweep"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -197,8 +199,6 @@ type chanArbTestCtx struct {
 
 	resolvedChan chan struct{}
 
-	incubationRequests chan struct{}
-
 	resolutions chan []ResolutionMsg
 
 	log ArbitratorLog
@@ -309,10 +309,10 @@ func createTestChannelArbitrator(t *testing.T, log ArbitratorLog,
 		LocalUnilateralClosure:  make(chan *LocalUnilateralCloseInfo, 1),
 		CooperativeClosure:      make(chan *CooperativeCloseInfo, 1),
 		ContractBreach:          make(chan *lnwallet.BreachRetribution, 1),
+		FundingDoubleSpend:      make(chan *FundingDoubleSpendInfo, 1),
 	}
 
 	resolutionChan := make(chan []ResolutionMsg, 1)
-	incubateChan := make(chan struct{})
 
 	chainIO := &mockChainIO{}
 	mockSweeper := newMockSweeper()
@@ -332,13 +332,6 @@ func createTestChannelArbitrator(t *testing.T, log ArbitratorLog,
 			SpendChan: make(chan *chainntnfs.SpendDetail),
 			ConfChan:  make(chan *chainntnfs.TxConfirmation),
 		},
-		IncubateOutputs: func(wire.OutPoint,
-			*lnwallet.OutgoingHtlcResolution,
-			*lnwallet.IncomingHtlcResolution, uint32) error {
-
-			incubateChan <- struct{}{}
-			return nil
-		},
 		OnionProcessor: &mockOnionProcessor{},
 		IsForwardedHTLC: func(chanID lnwire.ShortChannelID,
 			htlcIndex uint64) bool {
@@ -422,14 +415,13 @@ func createTestChannelArbitrator(t *testing.T, log ArbitratorLog,
 	chanArb := NewChannelArbitrator(*arbCfg, htlcSets, log)
 
 	return &chanArbTestCtx{
-		t:                  t,
-		chanArb:            chanArb,
-		cleanUp:            cleanUp,
-		resolvedChan:       resolvedChan,
-		resolutions:        resolutionChan,
-		log:                log,
-		incubationRequests: incubateChan,
-		sweeper:            mockSweeper,
+		t:            t,
+		chanArb:      chanArb,
+		cleanUp:      cleanUp,
+		resolvedChan: resolvedChan,
+		resolutions:  resolutionChan,
+		log:          log,
+		sweeper:      mockSweeper,
 	}, nil
 }
 
@@ -494,6 +486,204 @@ func TestChannelArbitratorCooperativeClose(t *testing.T) {
 	}
 }
 
+// TestChannelArbitratorFundingDoubleSpend checks that the ChannelArbitrator
+// marks the channel closed and resolved if an input of the funding transaction
+// was double spent.
+func TestChannelArbitratorFundingDoubleSpend(t *testing.T) {
+	log := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+	}
+
+	chanArbCtx, err := createTestChannelArbitrator(t, log)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+
+	if err := chanArbCtx.chanArb.Start(nil); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer func() {
+		if err := chanArbCtx.chanArb.Stop(); err != nil {
+			t.Fatalf("unable to stop chan arb: %v", err)
+		}
+	}()
+
+	// It should start out in the default state.
+	chanArbCtx.AssertState(StateDefault)
+
+	// We set up a channel to detect when MarkChannelClosed is called.
+	closeInfos := make(chan *channeldb.ChannelCloseSummary)
+	chanArbCtx.chanArb.cfg.MarkChannelClosed = func(
+		closeInfo *channeldb.ChannelCloseSummary,
+		statuses ...channeldb.ChannelStatus) error {
+
+		closeInfos <- closeInfo
+		return nil
+	}
+
+	// The double spend should trigger a MarkChannelClosed +
+	// MarkChannelResolved.
+	doubleSpendInfo := &FundingDoubleSpendInfo{
+		ChannelCloseSummary: &channeldb.ChannelCloseSummary{
+			CloseType: channeldb.FundingDoubleSpent,
+		},
+	}
+	chanArbCtx.chanArb.cfg.ChainEvents.FundingDoubleSpend <- doubleSpendInfo
+
+	select {
+	case c := <-closeInfos:
+		if c.CloseType != channeldb.FundingDoubleSpent {
+			t.Fatalf("expected funding double spent close, got %v",
+				c.CloseType)
+		}
+	case <-time.After(defaultTimeout):
+		t.Fatalf("timeout waiting for channel close")
+	}
+
+	// It should advance straight to the fully resolved state, and mark the
+	// channel as resolved.
+	chanArbCtx.AssertStateTransitions(StateFullyResolved)
+
+	select {
+	case <-chanArbCtx.resolvedChan:
+		// Expected.
+	case <-time.After(defaultTimeout):
+		t.Fatalf("contract was not resolved")
+	}
+}
+
+// TestChannelArbitratorFailUnconfirmedZeroConf checks that failing an
+// unconfirmed zero-conf channel fails back the HTLCs we offered over it and
+// marks the channel closed and resolved.
+func TestChannelArbitratorFailUnconfirmedZeroConf(t *testing.T) {
+	log := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+	}
+
+	chanArbCtx, err := createTestChannelArbitrator(t, log)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+	chanArb := chanArbCtx.chanArb
+	chanArb.cfg.PreimageDB = newMockWitnessBeacon()
+	chanArb.cfg.Registry = &mockRegistry{}
+
+	inactiveLinks := make(chan wire.OutPoint, 1)
+	chanArb.cfg.MarkLinkInactive = func(chanPoint wire.OutPoint) error {
+		inactiveLinks <- chanPoint
+		return nil
+	}
+
+	closeInfos := make(chan *channeldb.ChannelCloseSummary, 1)
+	chanArb.cfg.MarkChannelClosed = func(
+		closeInfo *channeldb.ChannelCloseSummary,
+		statuses ...channeldb.ChannelStatus) error {
+
+		closeInfos <- closeInfo
+		return nil
+	}
+
+	if err := chanArb.Start(nil); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer func() {
+		if err := chanArb.Stop(); err != nil {
+			t.Fatalf("unable to stop chan arb: %v", err)
+		}
+	}()
+
+	// The channel is in use under its alias.
+	alias := lnwire.NewAliasShortChanID(lnwire.ChannelID{1})
+	htlcUpdates := make(chan *ContractUpdate)
+	chanArb.UpdateContractSignals(&ContractSignals{
+		HtlcUpdates: htlcUpdates,
+		ShortChanID: alias,
+	})
+
+	// We offered an HTLC that is on both commitments, and received
+	// another one.
+	outgoingHtlc := channeldb.HTLC{
+		Incoming:  false,
+		Amt:       10000,
+		HtlcIndex: 5,
+	}
+	incomingHtlc := channeldb.HTLC{
+		Incoming:  true,
+		Amt:       10000,
+		HtlcIndex: 6,
+	}
+	for _, htlcKey := range []HtlcSetKey{LocalHtlcSet, RemoteHtlcSet} {
+		htlcUpdates <- &ContractUpdate{
+			HtlcKey: htlcKey,
+			Htlcs:   []channeldb.HTLC{outgoingHtlc, incomingHtlc},
+		}
+	}
+
+	errChan := make(chan error, 1)
+	chanArb.fundingFailReqs <- &fundingFailReq{
+		closeSummary: &channeldb.ChannelCloseSummary{
+			CloseType: channeldb.FundingCanceled,
+		},
+		errResp: errChan,
+	}
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatalf("unable to fail channel: %v", err)
+		}
+	case <-time.After(defaultTimeout):
+		t.Fatalf("channel not failed")
+	}
+
+	select {
+	case <-inactiveLinks:
+	case <-time.After(defaultTimeout):
+		t.Fatalf("link not marked inactive")
+	}
+
+	// Only the outgoing HTLC should be failed back, once.
+	select {
+	case msgs := <-chanArbCtx.resolutions:
+		if len(msgs) != 1 {
+			t.Fatalf("expected 1 message, instead got %v", len(msgs))
+		}
+		if msgs[0].SourceChan != alias {
+			t.Fatalf("wrong source chan: expected %v, got %v",
+				alias, msgs[0].SourceChan)
+		}
+		if msgs[0].HtlcIndex != outgoingHtlc.HtlcIndex {
+			t.Fatalf("wrong htlc index: expected %v, got %v",
+				outgoingHtlc.HtlcIndex, msgs[0].HtlcIndex)
+		}
+		if msgs[0].Failure == nil {
+			t.Fatalf("expected failure")
+		}
+	case <-time.After(defaultTimeout):
+		t.Fatalf("resolution msgs not sent")
+	}
+
+	select {
+	case c := <-closeInfos:
+		if c.CloseType != channeldb.FundingCanceled {
+			t.Fatalf("expected funding canceled close, got %v",
+				c.CloseType)
+		}
+	case <-time.After(defaultTimeout):
+		t.Fatalf("timeout waiting for channel close")
+	}
+
+	chanArbCtx.AssertStateTransitions(StateFullyResolved)
+
+	select {
+	case <-chanArbCtx.resolvedChan:
+	case <-time.After(defaultTimeout):
+		t.Fatalf("contract was not resolved")
+	}
+}
+
 // TestChannelArbitratorRemoteForceClose checks that the ChannelArbitrator goes
 // through the expected states if a remote force close is observed in the
 // chain.
@@ -812,7 +1002,8 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 	// Set up the outgoing resolution. Populate SignedTimeoutTx because our
 	// commitment transaction got confirmed.
 	outgoingRes := lnwallet.OutgoingHtlcResolution{
-		Expiry: 10,
+		Expiry:   10,
+		CsvDelay: 1,
 		SweepSignDesc: input.SignDescriptor{
 			Output: &wire.TxOut{},
 		},
@@ -913,27 +1104,25 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 	}
 
 	// htlcOutgoingContestResolver is now active and waiting for the HTLC to
-	// expire. It should not yet have passed it on for incubation.
-	select {
-	case <-chanArbCtx.incubationRequests:
-		t.Fatalf("contract should not be incubated yet")
-	default:
-	}
-
-	// Send a notification that the expiry height has been reached.
+	// expire. Send a notification that the expiry height has been reached.
 	oldNotifier.EpochChan <- &chainntnfs.BlockEpoch{Height: 10}
 
 	// htlcOutgoingContestResolver is now transforming into a
-	// htlcTimeoutResolver and should send the contract off for incubation.
+	// htlcTimeoutResolver, which waits for the locktime of the timeout
+	// transaction to be reached before publishing it.
 	select {
-	case <-chanArbCtx.incubationRequests:
+	case oldNotifier.EpochChan <- &chainntnfs.BlockEpoch{Height: 10}:
 	case <-time.After(defaultTimeout):
 		t.Fatalf("no response received")
 	}
 
 	// Notify resolver that the HTLC output of the commitment has been
 	// spent.
-	oldNotifier.SpendChan <- &chainntnfs.SpendDetail{SpendingTx: closeTx}
+	closeTxid := closeTx.TxHash()
+	oldNotifier.SpendChan <- &chainntnfs.SpendDetail{
+		SpendingTx:    closeTx,
+		SpenderTxHash: &closeTxid,
+	}
 
 	// Finally, we should also receive a resolution message instructing the
 	// switch to cancel back the HTLC.
@@ -960,8 +1149,25 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 	default:
 	}
 
+	// Once the CSV lock of the second level transaction has expired, its
+	// output should be offered to the sweeper.
+	select {
+	case oldNotifier.EpochChan <- &chainntnfs.BlockEpoch{Height: 11}:
+	case <-time.After(defaultTimeout):
+		t.Fatalf("no response received")
+	}
+
+	select {
+	case <-chanArbCtx.sweeper.sweptInputs:
+	case <-time.After(defaultTimeout):
+		t.Fatalf("second level output not offered to sweeper")
+	}
+
 	// Notify resolver that the second level transaction is spent.
-	oldNotifier.SpendChan <- &chainntnfs.SpendDetail{SpendingTx: closeTx}
+	oldNotifier.SpendChan <- &chainntnfs.SpendDetail{
+		SpendingTx:    closeTx,
+		SpenderTxHash: &closeTxid,
+	}
 
 	// At this point channel should be marked as resolved.
 	chanArbCtxNew.AssertStateTransitions(StateFullyResolved)
@@ -1946,6 +2152,116 @@ func TestChannelArbitratorPendingExpiredHTLC(t *testing.T) {
 	)
 }
 
+// TestChannelArbitratorDustAtRisk asserts that htlcs below the minimum htlc
+// value don't make the arbitrator go on-chain when they reach their broadcast
+// delta, but are kept as dust at risk instead.
+func TestChannelArbitratorDustAtRisk(t *testing.T) {
+	t.Parallel()
+
+	log := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+		resolvers: make(map[ContractResolver]struct{}),
+	}
+	chanArbCtx, err := createTestChannelArbitrator(t, log)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+	chanArb := chanArbCtx.chanArb
+	chanArb.cfg.MinBroadcastHtlcValue = 20000
+
+	// We'll start with an outgoing htlc below the minimum value that
+	// already reached its broadcast delta, as is the case when the node
+	// restarts with such an htlc on its commitment.
+	restartHTLC := channeldb.HTLC{
+		Incoming:      false,
+		Amt:           lnwire.NewMSatFromSatoshis(5000),
+		HtlcIndex:     0,
+		RefundTimeout: 5,
+	}
+	chanArb.activeHTLCs[LocalHtlcSet] = newHtlcSet(
+		[]channeldb.HTLC{restartHTLC},
+	)
+
+	if err := chanArb.Start(nil); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer func() {
+		if err := chanArb.Stop(); err != nil {
+			t.Fatalf("unable to stop chan arb: %v", err)
+		}
+	}()
+
+	// The htlc should be reported as dust at risk right after start up,
+	// without waiting for the next block.
+	atRisk := chanArb.DustAtRisk()
+	if len(atRisk) != 1 || atRisk[0].HtlcIndex != restartHTLC.HtlcIndex {
+		t.Fatalf("unexpected dust at risk after start: %v", atRisk)
+	}
+
+	htlcUpdates := make(chan *ContractUpdate)
+	chanArb.UpdateContractSignals(&ContractSignals{
+		HtlcUpdates: htlcUpdates,
+		ShortChanID: lnwire.ShortChannelID{},
+	})
+
+	// We'll add an outgoing htlc below the minimum value that expires at
+	// height 10.
+	smallHTLC := channeldb.HTLC{
+		Incoming:      false,
+		Amt:           lnwire.NewMSatFromSatoshis(5000),
+		HtlcIndex:     1,
+		RefundTimeout: 10,
+	}
+	htlcUpdates <- &ContractUpdate{
+		HtlcKey: LocalHtlcSet,
+		Htlcs:   []channeldb.HTLC{smallHTLC},
+	}
+
+	// The htlc reaches its broadcast delta at height 5, but we shouldn't
+	// go on-chain for it. Instead, it should be reported as dust at risk.
+	chanArb.blocks <- 6
+
+	timeout := time.After(defaultTimeout)
+	for len(atRisk) == 0 || atRisk[0].HtlcIndex != smallHTLC.HtlcIndex {
+		select {
+		case <-timeout:
+			t.Fatalf("htlc not reported as dust at risk")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		atRisk = chanArb.DustAtRisk()
+	}
+	chanArbCtx.AssertState(StateDefault)
+
+	if len(atRisk) != 1 || atRisk[0].HtlcIndex != smallHTLC.HtlcIndex {
+		t.Fatalf("unexpected dust at risk: %v", atRisk)
+	}
+
+	// Once an htlc above the minimum value reaches its broadcast delta, we
+	// should go on-chain and no htlc remains at risk.
+	largeHTLC := channeldb.HTLC{
+		Incoming:      false,
+		Amt:           lnwire.NewMSatFromSatoshis(30000),
+		HtlcIndex:     2,
+		RefundTimeout: 10,
+	}
+	htlcUpdates <- &ContractUpdate{
+		HtlcKey: LocalHtlcSet,
+		Htlcs:   []channeldb.HTLC{smallHTLC, largeHTLC},
+	}
+	chanArb.blocks <- 7
+
+	chanArbCtx.AssertStateTransitions(
+		StateBroadcastCommit,
+		StateCommitmentBroadcasted,
+	)
+
+	if atRisk := chanArb.DustAtRisk(); len(atRisk) != 0 {
+		t.Fatalf("expected no dust at risk, got: %v", atRisk)
+	}
+}
+
 // TestRemoteCloseInitiator tests the setting of close initiator statuses
 // for remote force closes and breaches.
 func TestRemoteCloseInitiator(t *testing.T) {
@@ -2258,6 +2574,121 @@ func TestChannelArbitratorAnchors(t *testing.T) {
 	assertResolverReport(t, reports, expectedReport)
 }
 
+// TestChannelArbitratorBumpAnchors asserts that requests to bump the fee of a
+// force close through its anchors are only served while the commitment is
+// unconfirmed, and that anchors that aren't swept yet are offered to the
+// sweeper.
+func TestChannelArbitratorBumpAnchors(t *testing.T) {
+	log := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+	}
+
+	chanArbCtx, err := createTestChannelArbitrator(t, log)
+	require.NoError(t, err)
+
+	chanArb := chanArbCtx.chanArb
+	sweeper := chanArbCtx.sweeper
+
+	anchors := []*lnwallet.AnchorResolution{
+		{CommitAnchor: wire.OutPoint{Index: 1}},
+		{CommitAnchor: wire.OutPoint{Index: 2}},
+	}
+	chanArb.cfg.Channel.(*mockChannel).anchorResolutions = anchors
+
+	require.NoError(t, chanArb.Start(nil))
+	defer func() {
+		require.NoError(t, chanArb.Stop())
+	}()
+
+	// Requests are routed to the arbitrator of the channel.
+	chainArb := &ChainArbitrator{
+		activeChannels: map[wire.OutPoint]*ChannelArbitrator{
+			chanArb.cfg.ChanPoint: chanArb,
+		},
+		quit: make(chan struct{}),
+	}
+	feePref := sweep.FeePreference{ConfTarget: 2}
+
+	// bumpAnchors requests a fee bump in the background, as the mock
+	// sweeper blocks until its calls are consumed.
+	bumpAnchors := func() chan error {
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- chainArb.BumpAnchorFee(
+				chanArb.cfg.ChanPoint, feePref,
+			)
+		}()
+
+		return errChan
+	}
+	receiveErr := func(errChan chan error) error {
+		select {
+		case err := <-errChan:
+			return err
+
+		case <-time.After(defaultTimeout):
+			t.Fatal("no response received")
+			return nil
+		}
+	}
+
+	// A channel that we don't watch can't be bumped.
+	err = chainArb.BumpAnchorFee(wire.OutPoint{Index: 99}, feePref)
+	require.Error(t, err)
+
+	// Before the commitment is broadcast, there's nothing to bump.
+	err = receiveErr(bumpAnchors())
+	require.Equal(t, errNoUnconfirmedCommitment, err)
+
+	// Force close the channel. The anchors are offered to the sweeper
+	// right away.
+	errChan := make(chan error, 1)
+	respChan := make(chan *wire.MsgTx, 1)
+	chanArb.forceCloseReqs <- &forceCloseReq{
+		errResp: errChan,
+		closeTx: respChan,
+	}
+	chanArbCtx.AssertStateTransitions(
+		StateBroadcastCommit, StateCommitmentBroadcasted,
+	)
+	for range anchors {
+		<-sweeper.sweptInputs
+	}
+	<-respChan
+	require.NoError(t, <-errChan)
+
+	// Now the fee preference of the anchor sweeps is updated.
+	errChan = bumpAnchors()
+	for _, anchor := range anchors {
+		require.Equal(t, anchor.CommitAnchor, <-sweeper.updatedInputs)
+	}
+	require.NoError(t, receiveErr(errChan))
+
+	// Anchors that the sweeper doesn't know of are offered to it with the
+	// requested fee preference.
+	sweeper.updateErr = lnwallet.ErrNotMine
+	errChan = bumpAnchors()
+	for _, anchor := range anchors {
+		require.Equal(t, anchor.CommitAnchor, <-sweeper.updatedInputs)
+
+		swept := <-sweeper.sweptInputs
+		require.Equal(t, anchor.CommitAnchor, *swept.OutPoint())
+	}
+	require.NoError(t, receiveErr(errChan))
+
+	// Other errors of the sweeper are returned.
+	sweeper.updateErr = errors.New("sweeper error")
+	errChan = bumpAnchors()
+	<-sweeper.updatedInputs
+	require.Equal(t, sweeper.updateErr, receiveErr(errChan))
+
+	// A commitment without anchors can't be bumped.
+	chanArb.cfg.Channel.(*mockChannel).anchorResolutions = nil
+	err = receiveErr(bumpAnchors())
+	require.Equal(t, errNoAnchors, err)
+}
+
 // putResolverReportInChannel returns a put report function which will pipe
 // reports into the channel provided.
 func putResolverReportInChannel(reports chan *channeldb.ResolverReport) func(
@@ -107,6 +107,7 @@ type mockSweeper struct {
 	updatedInputs     chan wire.OutPoint
 	sweepTx           *wire.MsgTx
 	sweepErr          error
+	updateErr         error
 	createSweepTxChan chan *wire.MsgTx
 }
 
@@ -149,6 +150,10 @@ func (s *mockSweeper) UpdateParams(input wire.OutPoint,
 
 	s.updatedInputs <- input
 
+	if s.updateErr != nil {
+		return nil, s.updateErr
+	}
+
 	result := make(chan sweep.Result, 1)
 	result <- sweep.Result{
 		Tx: s.sweepTx,
@@ -8,8 +8,10 @@ import (
 
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btclog"
+	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 var (
@@ -24,8 +26,37 @@ const (
 	// secondLevelConfTarget is the confirmation target we'll use when
 	// adding fees to our second-level HTLC transactions.
 	secondLevelConfTarget = 6
+
+	// htlcSweepBudgetRatio is the fraction of the value of an HTLC that
+	// we're willing to spend on fees to confirm its second-level
+	// transaction before the deadline.
+	htlcSweepBudgetRatio = 0.5
+
+	// htlcTimeoutDeadlineDelta is the number of blocks after the expiry
+	// of an outgoing HTLC by which its second-level timeout transaction
+	// must confirm if the expiry of the incoming HTLC isn't known, such
+	// as for our own payments. This is the smallest time lock delta a
+	// node may advertise.
+	htlcTimeoutDeadlineDelta = 18
 )
 
+// htlcSweepParams returns the sweep parameters for the second-level
+// transaction of the given HTLC, which must confirm before the given deadline
+// height. The fee budget is derived from the value of the HTLC.
+func htlcSweepParams(htlc channeldb.HTLC, deadline uint32) sweep.Params {
+	budget := btcutil.Amount(
+		float64(htlc.Amt.ToSatoshis()) * htlcSweepBudgetRatio,
+	)
+
+	return sweep.Params{
+		Fee: sweep.FeePreference{
+			ConfTarget: secondLevelConfTarget,
+		},
+		DeadlineHeight: int32(deadline),
+		Budget:         budget,
+	}
+}
+
 // ContractResolver is an interface which packages a state machine which is
 // able to carry out the necessary steps required to fully resolve a Bitcoin
 // contract on-chain. Resolvers are fully encodable to ensure callers are able
@@ -119,8 +119,9 @@ func (h *htlcOutgoingContestResolver) Resolve() (ContractResolver, error) {
 			// TODO(joostjager): Statement above may not be valid.
 			// For CLTV locks, the expiry value is the last
 			// _invalid_ block. The likely reason that this does not
-			// create a problem, is that utxonursery is checking the
-			// expiry again (in the proper way).
+			// create a problem, is that the timeout resolver and
+			// the sweeper are checking the expiry again (in the
+			// proper way).
 			//
 			// Source:
 			// https://github.com/btcsuite/btcd/blob/991d32e72fe84d5fbf9c47cd604d793a0cd3a072/blockchain/validate.go#L154
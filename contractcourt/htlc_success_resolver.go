@@ -20,9 +20,9 @@ import (
 // htlcSuccessResolver is a resolver that's capable of sweeping an incoming
 // HTLC output on-chain. If this is the remote party's commitment, we'll sweep
 // it directly from the commitment output *immediately*. If this is our
-// commitment, we'll first broadcast the success transaction, then send it to
-// the incubator for sweeping. That's it, no need to send any clean up
-// messages.
+// commitment, we'll first broadcast the success transaction, then sweep its
+// output once the CSV lock has expired. That's it, no need to send any clean
+// up messages.
 //
 // TODO(roasbeef): don't need to broadcast?
 type htlcSuccessResolver struct {
@@ -30,13 +30,13 @@ type htlcSuccessResolver struct {
 	// contains everything we need to properly resolve this HTLC.
 	htlcResolution lnwallet.IncomingHtlcResolution
 
-	// outputIncubating returns true if we've sent the output to the output
-	// incubator (utxo nursery). In case the htlcResolution has non-nil
-	// SignDetails, it means we will let the Sweeper handle broadcasting
-	// the secondd-level transaction, and sweeping its output. In this case
-	// we let this field indicate whether we need to broadcast the
-	// second-level tx (false) or if it has confirmed and we must sweep the
-	// second-level output (true).
+	// outputIncubating returns true if we've broadcast the second-level
+	// transaction. In case the htlcResolution has non-nil SignDetails, it
+	// means we will let the Sweeper handle broadcasting the second-level
+	// transaction, and sweeping its output. In this case we let this field
+	// indicate whether we need to broadcast the second-level tx (false) or
+	// if it has confirmed and we must sweep the second-level output
+	// (true).
 	outputIncubating bool
 
 	// resolved reflects if the contract has been fully resolved or not.
@@ -58,9 +58,7 @@ type htlcSuccessResolver struct {
 	htlc channeldb.HTLC
 
 	// currentReport stores the current state of the resolver for reporting
-	// over the rpc interface. This should only be reported in case we have
-	// a non-nil SignDetails on the htlcResolution, otherwise the nursery
-	// will produce reports.
+	// over the rpc interface.
 	currentReport ContractReport
 
 	// reportLock prevents concurrent access to the resolver report.
@@ -108,10 +106,11 @@ func (h *htlcSuccessResolver) ResolverKey() []byte {
 
 // Resolve attempts to resolve an unresolved incoming HTLC that we know the
 // preimage to. If the HTLC is on the commitment of the remote party, then we'll
-// simply sweep it directly. Otherwise, we'll hand this off to the utxo nursery
-// to do its duty. There is no need to make a call to the invoice registry
-// anymore. Every HTLC has already passed through the incoming contest resolver
-// and in there the invoice was already marked as settled.
+// simply sweep it directly. Otherwise, we'll broadcast the second-level
+// success transaction and sweep its output. There is no need to make a call to
+// the invoice registry anymore. Every HTLC has already passed through the
+// incoming contest resolver and in there the invoice was already marked as
+// settled.
 //
 // TODO(roasbeef): create multi to batch
 //
@@ -175,8 +174,7 @@ func (h *htlcSuccessResolver) broadcastSuccessTx() (*wire.OutPoint, error) {
 		return h.broadcastReSignedSuccessTx()
 	}
 
-	// Otherwise we'll publish the second-level transaction directly and
-	// offer the resolution to the nursery to handle.
+	// Otherwise we'll publish the second-level transaction directly.
 	log.Infof("%T(%x): broadcasting second-layer transition tx: %v",
 		h, h.htlc.RHash[:], spew.Sdump(h.htlcResolution.SignedSuccessTx))
 
@@ -192,30 +190,36 @@ func (h *htlcSuccessResolver) broadcastSuccessTx() (*wire.OutPoint, error) {
 		return nil, err
 	}
 
-	// Otherwise, this is an output on our commitment transaction. In this
-	// case, we'll send it to the incubator, but only if we haven't already
-	// done so.
 	if !h.outputIncubating {
-		log.Infof("%T(%x): incubating incoming htlc output",
-			h, h.htlc.RHash[:])
-
-		err := h.IncubateOutputs(
-			h.ChanPoint, nil, &h.htlcResolution,
-			h.broadcastHeight,
-		)
-		if err != nil {
-			return nil, err
-		}
-
 		h.outputIncubating = true
-
 		if err := h.Checkpoint(h); err != nil {
 			log.Errorf("unable to Checkpoint: %v", err)
 			return nil, err
 		}
 	}
 
-	return &h.htlcResolution.ClaimOutpoint, nil
+	// We'll watch the HTLC output on our commitment for the confirmation
+	// of the success transaction. Its pkScript is derived from the witness
+	// script, which is the last element of the witness.
+	successTx := h.htlcResolution.SignedSuccessTx
+	witness := successTx.TxIn[0].Witness
+	htlcScript, err := input.WitnessScriptHash(witness[len(witness)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("%T(%x): waiting for second-level HTLC success "+
+		"transaction to confirm", h, h.htlc.RHash[:])
+
+	commitSpend, err := waitForSpend(
+		&successTx.TxIn[0].PreviousOutPoint, htlcScript,
+		h.broadcastHeight, h.Notifier, h.quit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.sweepSecondLevelOutput(commitSpend)
 }
 
 // broadcastReSignedSuccessTx handles the case where we have non-nil
@@ -240,13 +244,11 @@ func (h *htlcSuccessResolver) broadcastReSignedSuccessTx() (
 			h.htlcResolution.SignDetails, h.htlcResolution.Preimage,
 			h.broadcastHeight,
 		)
+		// The success transaction must confirm before the HTLC
+		// expires, as the remote party can time it out afterwards.
 		_, err := h.Sweeper.SweepInput(
 			&secondLevelInput,
-			sweep.Params{
-				Fee: sweep.FeePreference{
-					ConfTarget: secondLevelConfTarget,
-				},
-			},
+			htlcSweepParams(h.htlc, h.htlc.RefundTimeout),
 		)
 		if err != nil {
 			return nil, err
@@ -292,14 +294,23 @@ func (h *htlcSuccessResolver) broadcastReSignedSuccessTx() (
 		}
 	}
 
+	return h.sweepSecondLevelOutput(commitSpend)
+}
+
+// sweepSecondLevelOutput waits for the CSV lock of the confirmed second-level
+// success transaction to expire, then offers its output to the sweeper. It
+// returns the outpoint of the second-level output, that we must wait to be
+// spent for the resolver to be fully resolved.
+func (h *htlcSuccessResolver) sweepSecondLevelOutput(
+	commitSpend *chainntnfs.SpendDetail) (*wire.OutPoint, error) {
+
 	// The HTLC success tx has a CSV lock that we must wait for.
 	waitHeight := uint32(commitSpend.SpendingHeight) +
 		h.htlcResolution.CsvDelay - 1
 
-	// Now that the sweeper has broadcasted the second-level transaction,
-	// it has confirmed, and we have checkpointed our state, we'll sweep
-	// the second level output. We report the resolver has moved the next
-	// stage.
+	// Now that the second-level transaction has confirmed, and we have
+	// checkpointed our state, we'll sweep the second level output. We
+	// report the resolver has moved the next stage.
 	h.reportLock.Lock()
 	h.currentReport.Stage = 2
 	h.currentReport.MaturityHeight = waitHeight
@@ -507,12 +518,6 @@ func (h *htlcSuccessResolver) IsResolved() bool {
 
 // report returns a report on the resolution state of the contract.
 func (h *htlcSuccessResolver) report() *ContractReport {
-	// If the sign details are nil, the report will be created by handled
-	// by the nursery.
-	if h.htlcResolution.SignDetails == nil {
-		return nil
-	}
-
 	h.reportLock.Lock()
 	defer h.reportLock.Unlock()
 	copy := h.currentReport
@@ -520,8 +525,8 @@ func (h *htlcSuccessResolver) report() *ContractReport {
 }
 
 func (h *htlcSuccessResolver) initReport() {
-	// We create the initial report. This will only be reported for
-	// resolvers not handled by the nursery.
+	// We create the initial report, which is updated as the resolution
+	// moves to the second stage.
 	finalAmt := h.htlc.Amt.ToSatoshis()
 	if h.htlcResolution.SignedSuccessTx != nil {
 		finalAmt = btcutil.Amount(
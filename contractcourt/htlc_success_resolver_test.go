@@ -60,10 +60,6 @@ func newHtlcResolverTestContext(t *testing.T,
 				return nil
 			},
 			Sweeper: newMockSweeper(),
-			IncubateOutputs: func(wire.OutPoint, *lnwallet.OutgoingHtlcResolution,
-				*lnwallet.IncomingHtlcResolution, uint32) error {
-				return nil
-			},
 			DeliverResolutionMsg: func(msgs ...ResolutionMsg) error {
 				if len(msgs) != 1 {
 					return fmt.Errorf("expected 1 "+
@@ -193,7 +189,8 @@ func TestHtlcSuccessSingleStage(t *testing.T) {
 }
 
 // TestSecondStageResolution tests successful sweep of a second stage htlc
-// claim, going through the Nursery.
+// claim, where the fully signed success transaction is broadcast by the
+// resolver itself.
 func TestHtlcSuccessSecondStageResolution(t *testing.T) {
 	commitOutpoint := wire.OutPoint{Index: 2}
 	htlcOutpoint := wire.OutPoint{Index: 3}
@@ -208,10 +205,12 @@ func TestHtlcSuccessSecondStageResolution(t *testing.T) {
 	// which is spent from the signed success tx.
 	twoStageResolution := lnwallet.IncomingHtlcResolution{
 		Preimage: [32]byte{},
+		CsvDelay: 4,
 		SignedSuccessTx: &wire.MsgTx{
 			TxIn: []*wire.TxIn{
 				{
 					PreviousOutPoint: commitOutpoint,
+					Witness:          [][]byte{{0x01}},
 				},
 			},
 			TxOut: []*wire.TxOut{
@@ -244,15 +243,37 @@ func TestHtlcSuccessSecondStageResolution(t *testing.T) {
 
 	checkpoints := []checkpoint{
 		{
-			// The resolver will send the output to the Nursery.
+			// The resolver will broadcast the success transaction.
 			incubating: true,
 		},
 		{
-			// It will then wait for the Nursery to spend the
-			// output. We send a spend notification for our output
-			// to resolve our htlc.
+			// It will then wait for the success transaction to
+			// confirm, and for its CSV lock to expire, before
+			// offering the second-level output to the sweeper. We
+			// send a spend notification for that output to resolve
+			// our htlc.
 			preCheckpoint: func(ctx *htlcResolverTestContext,
 				_ bool) error {
+
+				ctx.notifier.SpendChan <- &chainntnfs.SpendDetail{
+					SpendingTx: twoStageResolution.
+						SignedSuccessTx,
+					SpenderTxHash:  &successTx,
+					SpendingHeight: 10,
+				}
+
+				ctx.notifier.EpochChan <- &chainntnfs.BlockEpoch{
+					Height: 13,
+				}
+
+				resolver := ctx.resolver.(*htlcSuccessResolver)
+				inp := <-resolver.Sweeper.(*mockSweeper).sweptInputs
+				exp := wire.OutPoint{Hash: successTx}
+				if *inp.OutPoint() != exp {
+					return fmt.Errorf("swept outpoint %v, "+
+						"expected %v", inp.OutPoint(), exp)
+				}
+
 				ctx.notifier.SpendChan <- &chainntnfs.SpendDetail{
 					SpendingTx:    sweepTx,
 					SpenderTxHash: &sweepHash,
@@ -12,6 +12,7 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/labels"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -30,8 +31,10 @@ type htlcTimeoutResolver struct {
 	// resolve this outgoing HTLC.
 	htlcResolution lnwallet.OutgoingHtlcResolution
 
-	// outputIncubating returns true if we've sent the output to the output
-	// incubator (utxo nursery).
+	// outputIncubating returns true if we've broadcast the second-level
+	// timeout transaction, or it has been confirmed after we offered it to
+	// the sweeper. Resolvers that were created by earlier versions also
+	// set it once they handed the output to the utxo nursery.
 	outputIncubating bool
 
 	// resolved reflects if the contract has been fully resolved or not.
@@ -48,9 +51,7 @@ type htlcTimeoutResolver struct {
 	htlc channeldb.HTLC
 
 	// currentReport stores the current state of the resolver for reporting
-	// over the rpc interface. This should only be reported in case we have
-	// a non-nil SignDetails on the htlcResolution, otherwise the nursery
-	// will produce reports.
+	// over the rpc interface.
 	currentReport ContractReport
 
 	// reportLock prevents concurrent access to the resolver report.
@@ -308,6 +309,23 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 	return h.handleCommitSpend(commitSpend)
 }
 
+// sweepDeadline returns the height by which the timeout transaction must
+// confirm. If we forwarded the HTLC, this is the expiry of the incoming HTLC,
+// as the remote party can claim it back from then on while our outgoing HTLC
+// may still be claimed with the preimage.
+func (h *htlcTimeoutResolver) sweepDeadline() uint32 {
+	if h.IncomingHTLCExpiry != nil {
+		expiry, ok := h.IncomingHTLCExpiry(
+			h.ShortChanID, h.htlc.HtlcIndex,
+		)
+		if ok {
+			return expiry
+		}
+	}
+
+	return h.htlc.RefundTimeout + htlcTimeoutDeadlineDelta
+}
+
 // spendHtlcOutput handles the initial spend of an HTLC output via the timeout
 // clause. If this is our local commitment, the second-level timeout TX will be
 // used to spend the output into the next stage. If this is the remote
@@ -330,44 +348,58 @@ func (h *htlcTimeoutResolver) spendHtlcOutput() (*chainntnfs.SpendDetail, error)
 			h.htlcResolution.SignDetails,
 			h.broadcastHeight,
 		)
+		// The timeout transaction must confirm before the incoming
+		// HTLC expires, so we don't lose its value.
 		_, err := h.Sweeper.SweepInput(
 			&inp,
-			sweep.Params{
-				Fee: sweep.FeePreference{
-					ConfTarget: secondLevelConfTarget,
-				},
-			},
+			htlcSweepParams(h.htlc, h.sweepDeadline()),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-	// If we have no SignDetails, and we haven't already sent the output to
-	// the utxo nursery, then we'll do so now.
-	case h.htlcResolution.SignDetails == nil && !h.outputIncubating:
-		log.Tracef("%T(%v): incubating htlc output", h,
-			h.htlcResolution.ClaimOutpoint)
+	// If we have no SignDetails, but this is our commitment, the
+	// second-level timeout transaction is fully signed with a fixed fee.
+	// We'll broadcast it ourselves once its locktime has been reached.
+	case h.htlcResolution.SignDetails == nil &&
+		h.htlcResolution.SignedTimeoutTx != nil:
 
-		err := h.IncubateOutputs(
-			h.ChanPoint, &h.htlcResolution, nil,
-			h.broadcastHeight,
-		)
-		if err != nil {
+		if err := h.publishTimeoutTx(); err != nil {
 			return nil, err
 		}
 
-		h.outputIncubating = true
-
-		if err := h.Checkpoint(h); err != nil {
-			log.Errorf("unable to Checkpoint: %v", err)
+	// Otherwise this is an output on the remote commitment, which we can
+	// sweep directly through the timeout clause. We hand it to the sweeper
+	// right away, as it won't sweep the input before its locktime has been
+	// reached. The sweeper restores the input after a restart, but parks
+	// it until its owner claims it again, so we re-offer it every time we
+	// are launched.
+	case h.htlcResolution.SignedTimeoutTx == nil:
+		log.Infof("%T(%v): offering htlc output to sweeper after "+
+			"expiry=%v", h, h.htlcResolution.ClaimOutpoint,
+			h.htlcResolution.Expiry)
+
+		inp := input.NewCsvInputWithCltv(
+			&h.htlcResolution.ClaimOutpoint,
+			input.HtlcOfferedRemoteTimeout,
+			&h.htlcResolution.SweepSignDesc,
+			h.broadcastHeight, h.htlcResolution.CsvDelay,
+			h.htlcResolution.Expiry,
+		)
+		_, err := h.Sweeper.SweepInput(
+			inp,
+			htlcSweepParams(h.htlc, h.sweepDeadline()),
+		)
+		if err != nil {
 			return nil, err
 		}
 	}
 
-	// Now that we've handed off the HTLC to the nursery or sweeper, we'll
-	// watch for a spend of the output, and make our next move off of that.
-	// Depending on if this is our commitment, or the remote party's
-	// commitment, we'll be watching a different outpoint and script.
+	// Now that we've published the timeout transaction or handed off the
+	// HTLC to the sweeper, we'll watch for a spend of the output, and make
+	// our next move off of that. Depending on if this is our commitment,
+	// or the remote party's commitment, we'll be watching a different
+	// outpoint and script.
 	outpointToWatch, scriptToWatch, err := h.chainDetailsToWatch()
 	if err != nil {
 		return nil, err
@@ -400,6 +432,52 @@ func (h *htlcTimeoutResolver) spendHtlcOutput() (*chainntnfs.SpendDetail, error)
 	return spend, err
 }
 
+// publishTimeoutTx waits for the locktime of the fully signed second-level
+// timeout transaction to be reached, then broadcasts it. This is only used for
+// HTLCs on our commitment that don't allow us to re-sign the timeout
+// transaction, so it can't be aggregated or fee bumped by the sweeper.
+func (h *htlcTimeoutResolver) publishTimeoutTx() error {
+	timeoutTx := h.htlcResolution.SignedTimeoutTx
+
+	// The timeout transaction can be included in the block after its
+	// locktime, so we'll wait for the locktime height to be reached before
+	// broadcasting it.
+	log.Infof("%T(%x): waiting for height %v to broadcast second-layer "+
+		"timeout tx", h, h.htlc.RHash[:], timeoutTx.LockTime)
+
+	err := waitForHeight(timeoutTx.LockTime, h.Notifier, h.quit)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("%T(%x): broadcasting second-layer timeout tx: %v", h,
+		h.htlc.RHash[:], spew.Sdump(timeoutTx))
+
+	// We broadcast the transaction again after every restart, as we might
+	// have gone down before it confirmed. A double spend means that it, or
+	// a spend of the HTLC output by the remote party, already confirmed,
+	// which we'll pick up when waiting for the spend of the output.
+	label := labels.MakeLabel(
+		labels.LabelTypeChannelClose, &h.ShortChanID,
+	)
+	err = h.PublishTx(timeoutTx, label)
+	if err != nil && err != lnwallet.ErrDoubleSpend {
+		return err
+	}
+
+	if h.outputIncubating {
+		return nil
+	}
+
+	h.outputIncubating = true
+	if err := h.Checkpoint(h); err != nil {
+		log.Errorf("unable to Checkpoint: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 // handleCommitSpend handles the spend of the HTLC output on the commitment
 // transaction. If this was our local commitment, the spend will be he
 // confirmed second-level timeout transaction, and we'll sweep that into our
@@ -428,10 +506,10 @@ func (h *htlcTimeoutResolver) handleCommitSpend(
 
 	switch {
 
-	// If the sweeper is handling the second level transaction, wait for
-	// the CSV lock to expire, before sweeping the output on the
-	// second-level.
-	case h.htlcResolution.SignDetails != nil:
+	// If this was our local commitment, the spend is the confirmed
+	// second-level timeout transaction. We'll wait for the CSV lock to
+	// expire, before sweeping the output on the second-level.
+	case h.htlcResolution.SignedTimeoutTx != nil:
 		waitHeight := uint32(commitSpend.SpendingHeight) +
 			h.htlcResolution.CsvDelay - 1
 
@@ -481,17 +559,15 @@ func (h *htlcTimeoutResolver) handleCommitSpend(
 			return nil, err
 		}
 
-		// Update the claim outpoint to point to the second-level
-		// transaction created by the sweeper.
+		// Update the claim outpoint to point to the confirmed
+		// second-level transaction, as the sweeper may have changed
+		// its txid.
 		claimOutpoint = *op
-		fallthrough
 
-	// Finally, if this was an output on our commitment transaction, we'll
-	// wait for the second-level HTLC output to be spent, and for that
-	// transaction itself to confirm.
-	case h.htlcResolution.SignedTimeoutTx != nil:
-		log.Infof("%T(%v): waiting for nursery/sweeper to spend CSV "+
-			"delayed output", h, claimOutpoint)
+		// Finally, we'll wait for the second-level HTLC output to be
+		// spent, and for that transaction itself to confirm.
+		log.Infof("%T(%v): waiting for sweeper to spend CSV delayed "+
+			"output", h, claimOutpoint)
 		sweep, err := waitForSpend(
 			&claimOutpoint,
 			h.htlcResolution.SweepSignDesc.Output.PkScript,
@@ -558,12 +634,6 @@ func (h *htlcTimeoutResolver) IsResolved() bool {
 
 // report returns a report on the resolution state of the contract.
 func (h *htlcTimeoutResolver) report() *ContractReport {
-	// If the sign details are nil, the report will be created by handled
-	// by the nursery.
-	if h.htlcResolution.SignDetails == nil {
-		return nil
-	}
-
 	h.reportLock.Lock()
 	defer h.reportLock.Unlock()
 	copy := h.currentReport
@@ -571,8 +641,8 @@ func (h *htlcTimeoutResolver) report() *ContractReport {
 }
 
 func (h *htlcTimeoutResolver) initReport() {
-	// We create the initial report. This will only be reported for
-	// resolvers not handled by the nursery.
+	// We create the initial report, which is updated as the resolution
+	// moves to the second stage.
 	finalAmt := h.htlc.Amt.ToSatoshis()
 	if h.htlcResolution.SignedTimeoutTx != nil {
 		finalAmt = btcutil.Amount(
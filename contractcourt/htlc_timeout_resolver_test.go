@@ -19,6 +19,7 @@ import (
 	"github.com/lightningnetwork/lnd/lntest/mock"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/stretchr/testify/require"
 )
 
@@ -260,20 +261,18 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 		t.Logf("Running test case: %v", testCase.name)
 
 		checkPointChan := make(chan struct{}, 1)
-		incubateChan := make(chan struct{}, 1)
+		publishChan := make(chan *wire.MsgTx, 1)
 		resolutionChan := make(chan ResolutionMsg, 1)
 		reportChan := make(chan *channeldb.ResolverReport)
+		sweeper := newMockSweeper()
 
 		chainCfg := ChannelArbitratorConfig{
 			ChainArbitratorConfig: ChainArbitratorConfig{
 				Notifier:   notifier,
 				PreimageDB: witnessBeacon,
-				IncubateOutputs: func(wire.OutPoint,
-					*lnwallet.OutgoingHtlcResolution,
-					*lnwallet.IncomingHtlcResolution,
-					uint32) error {
-
-					incubateChan <- struct{}{}
+				Sweeper:    sweeper,
+				PublishTx: func(tx *wire.MsgTx, _ string) error {
+					publishChan <- tx
 					return nil
 				},
 				DeliverResolutionMsg: func(msgs ...ResolutionMsg) error {
@@ -313,6 +312,8 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 			htlcResolution: lnwallet.OutgoingHtlcResolution{
 				ClaimOutpoint: testChanPoint2,
 				SweepSignDesc: *fakeSignDesc,
+				Expiry:        uint32(fakeTimeout),
+				CsvDelay:      1,
 			},
 			contractResolverKit: *newContractResolverKit(
 				cfg,
@@ -359,14 +360,48 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 			}
 		}()
 
-		// At the output isn't yet in the nursery, we expect that we
-		// should receive an incubation request.
-		select {
-		case <-incubateChan:
-		case err := <-resolveErr:
-			t.Fatalf("unable to resolve HTLC: %v", err)
-		case <-time.After(time.Second * 5):
-			t.Fatalf("failed to receive incubation request")
+		// If this is the remote commitment, the resolver should offer
+		// the HTLC output to the sweeper, locked to the expiry of the
+		// HTLC. Otherwise, it should broadcast the timeout transaction
+		// once its locktime has been reached, and checkpoint that it
+		// did so.
+		if testCase.remoteCommit {
+			select {
+			case inp := <-sweeper.sweptInputs:
+				lockTime, ok := inp.RequiredLockTime()
+				require.True(t, ok)
+				require.Equal(t, uint32(fakeTimeout), lockTime)
+
+			case err := <-resolveErr:
+				t.Fatalf("unable to resolve HTLC: %v", err)
+			case <-time.After(time.Second * 5):
+				t.Fatalf("htlc output not offered to sweeper")
+			}
+		} else {
+			select {
+			case notifier.EpochChan <- &chainntnfs.BlockEpoch{}:
+			case <-time.After(time.Second * 5):
+				t.Fatalf("failed to send block epoch")
+			}
+
+			select {
+			case tx := <-publishChan:
+				require.Equal(
+					t, resolver.htlcResolution.SignedTimeoutTx,
+					tx,
+				)
+
+			case err := <-resolveErr:
+				t.Fatalf("unable to resolve HTLC: %v", err)
+			case <-time.After(time.Second * 5):
+				t.Fatalf("timeout tx not published")
+			}
+
+			select {
+			case <-checkPointChan:
+			case <-time.After(time.Second * 5):
+				t.Fatalf("check point not received")
+			}
 		}
 
 		// Next, the resolver should request a spend notification for
@@ -431,11 +466,25 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 				t.Fatalf("resolution not sent")
 			}
 
-			// We should also get another request for the spend
-			// notification of the second-level transaction to
-			// indicate that it's been swept by the nursery, but
-			// only if this is a local commitment transaction.
+			// If this is a local commitment transaction, the
+			// resolver should wait for the CSV lock of the
+			// second-level transaction to expire, offer its output
+			// to the sweeper, and request the spend notification
+			// of the second-level output.
 			if !testCase.remoteCommit {
+				select {
+				case notifier.EpochChan <- &chainntnfs.BlockEpoch{}:
+				case <-time.After(time.Second * 5):
+					t.Fatalf("failed to send block epoch")
+				}
+
+				select {
+				case <-sweeper.sweptInputs:
+				case <-time.After(time.Second * 5):
+					t.Fatalf("second-level output not " +
+						"offered to sweeper")
+				}
+
 				select {
 				case notifier.SpendChan <- &chainntnfs.SpendDetail{
 					SpendingTx:    spendingTx,
@@ -462,8 +511,15 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 		spendTxID := spendingTx.TxHash()
 		amt := btcutil.Amount(fakeSignDesc.Output.Value)
 
+		// If we timed out the HTLC on our commitment, the final report
+		// is for the output of the confirmed second-level transaction.
+		claimOutpoint := testChanPoint2
+		if testCase.timeout && !testCase.remoteCommit {
+			claimOutpoint = wire.OutPoint{Hash: spendTxID}
+		}
+
 		reports = append(reports, &channeldb.ResolverReport{
-			OutPoint:        testChanPoint2,
+			OutPoint:        claimOutpoint,
 			Amount:          amt,
 			ResolverType:    channeldb.ResolverTypeOutgoingHtlc,
 			ResolverOutcome: testCase.outcome,
@@ -515,16 +571,19 @@ func TestHtlcTimeoutSingleStage(t *testing.T) {
 
 	checkpoints := []checkpoint{
 		{
-			// Output should be handed off to the nursery.
-			incubating: true,
-		},
-		{
-			// We send a confirmation the sweep tx from published
-			// by the nursery.
+			// The output should be offered to the sweeper, which
+			// will publish a sweep tx once the HTLC has expired.
 			preCheckpoint: func(ctx *htlcResolverTestContext,
 				_ bool) error {
-				// The nursery will create and publish a sweep
-				// tx.
+
+				resolver := ctx.resolver.(*htlcTimeoutResolver)
+				inp := <-resolver.Sweeper.(*mockSweeper).sweptInputs
+				if *inp.OutPoint() != commitOutpoint {
+					return fmt.Errorf("swept outpoint %v, "+
+						"expected %v", inp.OutPoint(),
+						commitOutpoint)
+				}
+
 				ctx.notifier.SpendChan <- &chainntnfs.SpendDetail{
 					SpendingTx:    sweepTx,
 					SpenderTxHash: &sweepTxid,
@@ -548,8 +607,7 @@ func TestHtlcTimeoutSingleStage(t *testing.T) {
 			// After the sweep has confirmed, we expect the
 			// checkpoint to be resolved, and with the above
 			// report.
-			incubating: true,
-			resolved:   true,
+			resolved: true,
 			reports: []*channeldb.ResolverReport{
 				claim,
 			},
@@ -603,6 +661,7 @@ func TestHtlcTimeoutSecondStage(t *testing.T) {
 		ClaimOutpoint:   htlcOutpoint,
 		SignedTimeoutTx: timeoutTx,
 		SweepSignDesc:   testSignDesc,
+		CsvDelay:        1,
 	}
 
 	firstStage := &channeldb.ResolverReport{
@@ -614,7 +673,7 @@ func TestHtlcTimeoutSecondStage(t *testing.T) {
 	}
 
 	secondState := &channeldb.ResolverReport{
-		OutPoint:        htlcOutpoint,
+		OutPoint:        wire.OutPoint{Hash: timeoutTxid},
 		Amount:          btcutil.Amount(testSignDesc.Output.Value),
 		ResolverType:    channeldb.ResolverTypeOutgoingHtlc,
 		ResolverOutcome: channeldb.ResolverOutcomeTimeout,
@@ -623,15 +682,28 @@ func TestHtlcTimeoutSecondStage(t *testing.T) {
 
 	checkpoints := []checkpoint{
 		{
-			// Output should be handed off to the nursery.
+			// The timeout tx should be published once its
+			// locktime has been reached.
+			preCheckpoint: func(ctx *htlcResolverTestContext,
+				_ bool) error {
+
+				ctx.notifier.EpochChan <- &chainntnfs.BlockEpoch{}
+				return nil
+			},
 			incubating: true,
 		},
 		{
 			// We send a confirmation for our sweep tx to indicate
 			// that our sweep succeeded.
 			preCheckpoint: func(ctx *htlcResolverTestContext,
-				_ bool) error {
-				// The nursery will publish the timeout tx.
+				resumed bool) error {
+
+				// If we resumed, the timeout tx will be
+				// published again after its locktime.
+				if resumed {
+					ctx.notifier.EpochChan <- &chainntnfs.BlockEpoch{}
+				}
+
 				ctx.notifier.SpendChan <- &chainntnfs.SpendDetail{
 					SpendingTx:    timeoutTx,
 					SpenderTxHash: &timeoutTxid,
@@ -649,6 +721,14 @@ func TestHtlcTimeoutSecondStage(t *testing.T) {
 					t.Fatalf("resolution not sent")
 				}
 
+				// Once the CSV lock has expired, the
+				// second-level output is offered to the
+				// sweeper.
+				ctx.notifier.EpochChan <- &chainntnfs.BlockEpoch{}
+
+				resolver := ctx.resolver.(*htlcTimeoutResolver)
+				<-resolver.Sweeper.(*mockSweeper).sweptInputs
+
 				// Deliver spend of timeout tx.
 				ctx.notifier.SpendChan <- &chainntnfs.SpendDetail{
 					SpendingTx:    sweepTx,
@@ -739,14 +819,27 @@ func TestHtlcTimeoutSingleStageRemoteSpend(t *testing.T) {
 
 	checkpoints := []checkpoint{
 		{
-			// Output should be handed off to the nursery.
+			// The timeout tx should be published once its
+			// locktime has been reached.
+			preCheckpoint: func(ctx *htlcResolverTestContext,
+				_ bool) error {
+
+				ctx.notifier.EpochChan <- &chainntnfs.BlockEpoch{}
+				return nil
+			},
 			incubating: true,
 		},
 		{
 			// We send a spend notification for a remote spend with
 			// the preimage.
 			preCheckpoint: func(ctx *htlcResolverTestContext,
-				_ bool) error {
+				resumed bool) error {
+
+				// If we resumed, the timeout tx will be
+				// published again after its locktime.
+				if resumed {
+					ctx.notifier.EpochChan <- &chainntnfs.BlockEpoch{}
+				}
 
 				witnessBeacon := ctx.resolver.(*htlcTimeoutResolver).PreimageDB.(*mockWitnessBeacon)
 
@@ -849,14 +942,15 @@ func TestHtlcTimeoutSecondStageRemoteSpend(t *testing.T) {
 
 	checkpoints := []checkpoint{
 		{
-			// Output should be handed off to the nursery.
-			incubating: true,
-		},
-		{
-			// We send a confirmation for the remote's second layer
-			// success transcation.
+			// The output is offered to the sweeper, and we send a
+			// confirmation for the remote's second layer success
+			// transcation.
 			preCheckpoint: func(ctx *htlcResolverTestContext,
 				_ bool) error {
+
+				resolver := ctx.resolver.(*htlcTimeoutResolver)
+				<-resolver.Sweeper.(*mockSweeper).sweptInputs
+
 				ctx.notifier.SpendChan <- &chainntnfs.SpendDetail{
 					SpendingTx:    remoteSuccessTx,
 					SpenderTxHash: &successTxid,
@@ -896,8 +990,7 @@ func TestHtlcTimeoutSecondStageRemoteSpend(t *testing.T) {
 			// After the sweep has confirmed, we expect the
 			// checkpoint to be resolved, and with the above
 			// report.
-			incubating: true,
-			resolved:   true,
+			resolved: true,
 			reports: []*channeldb.ResolverReport{
 				claim,
 			},
@@ -1313,3 +1406,50 @@ func testHtlcTimeout(t *testing.T, resolution lnwallet.OutgoingHtlcResolution,
 		_ = runFromCheckpoint(t, ctx, checkpoints[i+1:])
 	}
 }
+
+// TestHtlcTimeoutSweepDeadline tests that the timeout transaction of a
+// forwarded HTLC must confirm before the incoming HTLC expires, and that we
+// fall back to a fixed delta after the expiry of the HTLC otherwise.
+func TestHtlcTimeoutSweepDeadline(t *testing.T) {
+	t.Parallel()
+
+	const (
+		expiry         = 1000
+		incomingExpiry = 1040
+	)
+
+	shortChanID := lnwire.NewShortChanIDFromInt(5)
+	resolver := &htlcTimeoutResolver{
+		contractResolverKit: *newContractResolverKit(ResolverConfig{
+			ChannelArbitratorConfig: ChannelArbitratorConfig{
+				ShortChanID: shortChanID,
+			},
+		}),
+		htlc: channeldb.HTLC{
+			RefundTimeout: expiry,
+			HtlcIndex:     3,
+		},
+	}
+
+	require.Equal(
+		t, uint32(expiry+htlcTimeoutDeadlineDelta),
+		resolver.sweepDeadline(),
+	)
+
+	resolver.IncomingHTLCExpiry = func(chanID lnwire.ShortChannelID,
+		htlcIndex uint64) (uint32, bool) {
+
+		if chanID != shortChanID || htlcIndex != 3 {
+			return 0, false
+		}
+
+		return incomingExpiry, true
+	}
+	require.Equal(t, uint32(incomingExpiry), resolver.sweepDeadline())
+
+	resolver.htlc.HtlcIndex = 4
+	require.Equal(
+		t, uint32(expiry+htlcTimeoutDeadlineDelta),
+		resolver.sweepDeadline(),
+	)
+}
@@ -1613,6 +1613,19 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			return nil, false
 		}
 
+		// Alias short channel IDs are only used for our own zero-conf
+		// channels and are never announced, so we'll reject any remote
+		// announcement that carries one.
+		if nMsg.isRemote && msg.ShortChannelID.IsAlias() {
+			err := fmt.Errorf("ignoring remote "+
+				"ChannelAnnouncement for alias "+
+				"short_chan_id=%v", msg.ShortChannelID)
+			log.Errorf(err.Error())
+
+			nMsg.err <- err
+			return nil, false
+		}
+
 		// If the advertised inclusionary block is beyond our knowledge
 		// of the chain tip, then we'll ignore for it now.
 		d.Lock()
@@ -1836,8 +1849,12 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// If the advertised inclusionary block is beyond our knowledge
 		// of the chain tip, then we'll put the announcement in limbo
 		// to be fully verified once we advance forward in the chain.
+		// Updates for the alias of a zero-conf channel are exempt, as
+		// the alias doesn't refer to a block.
 		d.Lock()
-		if nMsg.isRemote && isPremature(msg.ShortChannelID, 0) {
+		if nMsg.isRemote && !msg.ShortChannelID.IsAlias() &&
+			isPremature(msg.ShortChannelID, 0) {
+
 			log.Infof("Update announcement for "+
 				"short_chan_id(%v), is premature: advertises "+
 				"height %v, only height %v is known",
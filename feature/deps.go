@@ -58,6 +58,12 @@ var deps = depDesc{
 	lnwire.AnchorsOptional: {
 		lnwire.StaticRemoteKeyOptional: {},
 	},
+	lnwire.TrampolineRoutingOptional: {
+		lnwire.PaymentAddrOptional: {},
+	},
+	lnwire.ZeroConfOptional: {
+		lnwire.ScidAliasOptional: {},
+	},
 }
 
 // ValidateDeps asserts that a feature vector sets all features and their
@@ -23,6 +23,14 @@ type Config struct {
 
 	// NoWumbo unsets any bits signalling support for wumbo channels.
 	NoWumbo bool
+
+	// NoTrampoline unsets any bits signalling support for forwarding
+	// trampoline payments.
+	NoTrampoline bool
+
+	// NoZeroConf unsets any bits signalling support for zero-conf
+	// channels and the alias short channel IDs they are routed with.
+	NoZeroConf bool
 }
 
 // Manager is responsible for generating feature vectors for different requested
@@ -77,6 +85,8 @@ func newManager(cfg Config, desc setDesc) (*Manager, error) {
 			raw.Unset(lnwire.PaymentAddrRequired)
 			raw.Unset(lnwire.MPPOptional)
 			raw.Unset(lnwire.MPPRequired)
+			raw.Unset(lnwire.TrampolineRoutingOptional)
+			raw.Unset(lnwire.TrampolineRoutingRequired)
 		}
 		if cfg.NoStaticRemoteKey {
 			raw.Unset(lnwire.StaticRemoteKeyOptional)
@@ -90,6 +100,16 @@ func newManager(cfg Config, desc setDesc) (*Manager, error) {
 			raw.Unset(lnwire.WumboChannelsOptional)
 			raw.Unset(lnwire.WumboChannelsRequired)
 		}
+		if cfg.NoTrampoline {
+			raw.Unset(lnwire.TrampolineRoutingOptional)
+			raw.Unset(lnwire.TrampolineRoutingRequired)
+		}
+		if cfg.NoZeroConf {
+			raw.Unset(lnwire.ScidAliasOptional)
+			raw.Unset(lnwire.ScidAliasRequired)
+			raw.Unset(lnwire.ZeroConfOptional)
+			raw.Unset(lnwire.ZeroConfRequired)
+		}
 
 		// Ensure that all of our feature sets properly set any
 		// dependent features.
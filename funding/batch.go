@@ -0,0 +1,555 @@
+package funding
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/psbt"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+)
+
+const (
+	// DefaultBatchPeerTimeout is the default time we wait for each step of
+	// the funding flow of a channel in a batch before the peer is
+	// considered unresponsive and the batch is rolled back.
+	DefaultBatchPeerTimeout = 2 * time.Minute
+)
+
+var (
+	// errShuttingDown is returned if the batch funding is interrupted by
+	// a shutdown of the daemon.
+	errShuttingDown = errors.New("batch funding interrupted by shutdown")
+)
+
+// RequestParser is a function that parses an incoming RPC open channel request
+// into the internal funding initialization message.
+type RequestParser func(*lnrpc.OpenChannelRequest) (*InitFundingMsg, error)
+
+// ChannelOpener is a function that kicks off the negotiation of a new channel
+// with a remote peer.
+type ChannelOpener func(*InitFundingMsg) (chan *lnrpc.OpenStatusUpdate,
+	chan error)
+
+// ChannelAbandoner is a function that removes a pending channel from the local
+// database, the graph and the contract court.
+type ChannelAbandoner func(*wire.OutPoint) error
+
+// FundingTxUpdater is a function that stores the signed funding transaction
+// of a pending channel.
+type FundingTxUpdater func(*wire.OutPoint, *wire.MsgTx) error
+
+// WalletKitServer is the subset of the wallet kit RPC server that is needed to
+// fund, sign and release the inputs of a batch funding transaction.
+type WalletKitServer interface {
+	// FundPsbt selects inputs for the given template and locks them.
+	FundPsbt(ctx context.Context, req *walletrpc.FundPsbtRequest) (
+		*walletrpc.FundPsbtResponse, error)
+
+	// FinalizePsbt signs all inputs of the given PSBT that belong to the
+	// wallet and extracts the final transaction.
+	FinalizePsbt(ctx context.Context,
+		req *walletrpc.FinalizePsbtRequest) (
+		*walletrpc.FinalizePsbtResponse, error)
+
+	// ReleaseOutput unlocks an input that was locked by FundPsbt.
+	ReleaseOutput(ctx context.Context,
+		req *walletrpc.ReleaseOutputRequest) (
+		*walletrpc.ReleaseOutputResponse, error)
+}
+
+// Wallet is the subset of the wallet that is needed to drive the PSBT funding
+// flow of the channels in a batch.
+type Wallet interface {
+	// PsbtFundingVerify verifies the funded PSBT of the channel with the
+	// given pending channel ID.
+	PsbtFundingVerify(pendingChanID [32]byte, packet *psbt.Packet,
+		skipFinalize bool) error
+
+	// CancelFundingIntent cancels the funding intent of the channel with
+	// the given pending channel ID.
+	CancelFundingIntent(pendingChanID [32]byte) error
+
+	// PublishTransaction broadcasts the given transaction to the network.
+	PublishTransaction(tx *wire.MsgTx, label string) error
+}
+
+// BatchConfig is the configuration of a Batcher.
+type BatchConfig struct {
+	// RequestParser parses the open channel request of each channel in the
+	// batch.
+	RequestParser RequestParser
+
+	// ChannelOpener starts the negotiation of a channel.
+	ChannelOpener ChannelOpener
+
+	// ChannelAbandoner removes the channels that already reached the
+	// pending state if the batch fails.
+	ChannelAbandoner ChannelAbandoner
+
+	// FundingTxUpdater stores the signed batch funding transaction with
+	// each channel before it is published, so that the channels don't
+	// refer to the unsigned transaction they were negotiated with.
+	FundingTxUpdater FundingTxUpdater
+
+	// WalletKitServer funds and signs the batch funding transaction.
+	WalletKitServer WalletKitServer
+
+	// Wallet drives the PSBT funding flow of each channel and publishes
+	// the batch funding transaction.
+	Wallet Wallet
+
+	// NetParams are the parameters of the network the channels are opened
+	// on.
+	NetParams *chaincfg.Params
+
+	// PeerTimeout is the time we wait for each step of the funding flow
+	// of a channel before the batch is rolled back.
+	PeerTimeout time.Duration
+
+	// Quit is closed when the daemon shuts down.
+	Quit <-chan struct{}
+}
+
+// batchChannel is the state of a single channel within a batch.
+type batchChannel struct {
+	pendingChanID [32]byte
+	fundingReq    *InitFundingMsg
+
+	updates chan *lnrpc.OpenStatusUpdate
+	errs    chan error
+
+	// fundingAddr is the address of the funding output of the channel.
+	fundingAddr string
+
+	// verified is set once the funded PSBT was handed to the funding flow
+	// of the channel, after which the channel can't be canceled anymore.
+	verified bool
+
+	// failed is set once the funding flow of the channel returned an
+	// error.
+	failed bool
+
+	// chanPoint is set once the channel reached the pending state.
+	chanPoint *wire.OutPoint
+}
+
+// Batcher opens multiple channels with a single funding transaction. The
+// channels are negotiated in parallel and the funding transaction is only
+// published once all of them reached the pending state. If any of the channels
+// fails, all others are rolled back.
+type Batcher struct {
+	cfg *BatchConfig
+
+	channels    []*batchChannel
+	lockedUtxos []*walletrpc.UtxoLease
+}
+
+// NewBatcher returns a new batch channel funder.
+func NewBatcher(cfg *BatchConfig) *Batcher {
+	return &Batcher{
+		cfg: cfg,
+	}
+}
+
+// BatchFund opens all channels of the request with a single funding
+// transaction and returns the pending updates of the channels in the order of
+// the request. Either all channels are opened or none of them.
+func (b *Batcher) BatchFund(ctx context.Context,
+	req *lnrpc.BatchOpenChannelRequest) ([]*lnrpc.PendingUpdate, error) {
+
+	if len(req.Channels) == 0 {
+		return nil, errors.New("no channels specified")
+	}
+	if req.TargetConf != 0 && req.SatPerVbyte != 0 {
+		return nil, errors.New("either target_conf or sat_per_vbyte " +
+			"can be set, not both")
+	}
+
+	label := labels.MakeLabel(labels.LabelTypeChannelOpen, nil)
+	if req.Label != "" {
+		var err error
+		label, err = labels.ValidateAPI(req.Label)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse all channel requests before any negotiation is started, so we
+	// don't need to roll back anything if one of them is invalid.
+	pendingChanIDs := make(map[[32]byte]struct{}, len(req.Channels))
+	for idx, channel := range req.Channels {
+		pendingChanID, err := batchPendingChanID(channel.PendingChanId)
+		if err != nil {
+			return nil, fmt.Errorf("channel %d: %v", idx, err)
+		}
+		if _, ok := pendingChanIDs[pendingChanID]; ok {
+			return nil, fmt.Errorf("channel %d: duplicate pending "+
+				"channel ID %x", idx, pendingChanID[:])
+		}
+		pendingChanIDs[pendingChanID] = struct{}{}
+
+		fundingReq, err := b.cfg.RequestParser(
+			&lnrpc.OpenChannelRequest{
+				NodePubkey:         channel.NodePubkey,
+				LocalFundingAmount: channel.LocalFundingAmount,
+				PushSat:            channel.PushSat,
+				Private:            channel.Private,
+				MinHtlcMsat:        channel.MinHtlcMsat,
+				RemoteCsvDelay:     channel.RemoteCsvDelay,
+				CloseAddress:       channel.CloseAddress,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("channel %d: %v", idx, err)
+		}
+
+		// Each channel is funded through the PSBT flow. The batch
+		// funding transaction is published by us once all channels are
+		// pending, so the funding manager must not publish it.
+		fundingReq.PendingChanID = pendingChanID
+		fundingReq.ChanFunder = chanfunding.NewPsbtAssembler(
+			btcutil.Amount(channel.LocalFundingAmount), nil,
+			b.cfg.NetParams, false,
+		)
+
+		b.channels = append(b.channels, &batchChannel{
+			pendingChanID: pendingChanID,
+			fundingReq:    fundingReq,
+		})
+	}
+
+	pendingUpdates, err := b.batchFund(ctx, req, label)
+	if err != nil {
+		log.Errorf("Batch funding failed, rolling back %d channels: %v",
+			len(b.channels), err)
+
+		// The request may have been canceled, but the batch still
+		// needs to be rolled back completely.
+		b.cleanup(context.Background())
+
+		return nil, err
+	}
+
+	return pendingUpdates, nil
+}
+
+// batchFund negotiates all channels of the batch, funds them with a single
+// transaction and publishes it once every channel is pending. The caller is
+// responsible for rolling back the batch if an error is returned.
+func (b *Batcher) batchFund(ctx context.Context,
+	req *lnrpc.BatchOpenChannelRequest,
+	label string) ([]*lnrpc.PendingUpdate, error) {
+
+	// Start the negotiation of all channels in parallel.
+	for _, channel := range b.channels {
+		channel.updates, channel.errs = b.cfg.ChannelOpener(
+			channel.fundingReq,
+		)
+	}
+
+	// Each channel asks for its funding output to be funded once the
+	// multisig keys are negotiated with the peer.
+	outputs := make(map[string]uint64, len(b.channels))
+	for _, channel := range b.channels {
+		update, err := b.waitForUpdate(ctx, channel)
+		if err != nil {
+			return nil, err
+		}
+
+		psbtFund := update.GetPsbtFund()
+		if psbtFund == nil {
+			return nil, fmt.Errorf("channel %x: expected PSBT "+
+				"funding request, got %T",
+				channel.pendingChanID[:], update.Update)
+		}
+
+		channel.fundingAddr = psbtFund.FundingAddress
+		outputs[channel.fundingAddr] = uint64(psbtFund.FundingAmount)
+	}
+
+	// Fund all funding outputs with a single transaction that has one
+	// change output at most.
+	fundReq := &walletrpc.FundPsbtRequest{
+		Template: &walletrpc.FundPsbtRequest_Raw{
+			Raw: &walletrpc.TxTemplate{
+				Outputs: outputs,
+			},
+		},
+	}
+	switch {
+	case req.SatPerVbyte != 0:
+		fundReq.Fees = &walletrpc.FundPsbtRequest_SatPerVbyte{
+			SatPerVbyte: uint64(req.SatPerVbyte),
+		}
+
+	case req.TargetConf != 0:
+		fundReq.Fees = &walletrpc.FundPsbtRequest_TargetConf{
+			TargetConf: uint32(req.TargetConf),
+		}
+	}
+
+	fundResp, err := b.cfg.WalletKitServer.FundPsbt(ctx, fundReq)
+	if err != nil {
+		return nil, fmt.Errorf("error funding batch transaction: %v",
+			err)
+	}
+	b.lockedUtxos = fundResp.LockedUtxos
+
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(fundResp.FundedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing funded PSBT: %v", err)
+	}
+
+	// The channels are negotiated with the txid of the unsigned
+	// transaction, so signing must not change it. This is only the case
+	// if all inputs are native SegWit inputs.
+	for idx, in := range packet.Inputs {
+		if in.WitnessUtxo == nil ||
+			!txscript.IsWitnessProgram(in.WitnessUtxo.PkScript) {
+
+			return nil, fmt.Errorf("input %d of batch transaction "+
+				"is not a native SegWit input", idx)
+		}
+	}
+
+	// Hand the funded transaction to each channel. The funding flow
+	// continues with the unsigned transaction, as we sign and publish it
+	// ourselves once all channels are pending.
+	for _, channel := range b.channels {
+		err := b.cfg.Wallet.PsbtFundingVerify(
+			channel.pendingChanID, packet, true,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("channel %x: error verifying "+
+				"PSBT: %v", channel.pendingChanID[:], err)
+		}
+		channel.verified = true
+	}
+
+	// Wait for all peers to sign their commitment transactions. Only then
+	// it's safe to publish the funding transaction.
+	pendingUpdates := make([]*lnrpc.PendingUpdate, len(b.channels))
+	for idx, channel := range b.channels {
+		pending, err := b.waitForPending(ctx, channel)
+		if err != nil {
+			return nil, err
+		}
+
+		pendingUpdates[idx] = pending
+	}
+
+	finalizeResp, err := b.cfg.WalletKitServer.FinalizePsbt(
+		ctx, &walletrpc.FinalizePsbtRequest{
+			FundedPsbt: fundResp.FundedPsbt,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error signing batch transaction: %v",
+			err)
+	}
+
+	var finalTx wire.MsgTx
+	err = finalTx.Deserialize(bytes.NewReader(finalizeResp.RawFinalTx))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing signed batch "+
+			"transaction: %v", err)
+	}
+
+	txid := finalTx.TxHash()
+	if txid != packet.UnsignedTx.TxHash() {
+		return nil, fmt.Errorf("txid of signed batch transaction %v "+
+			"doesn't match negotiated txid %v", txid,
+			packet.UnsignedTx.TxHash())
+	}
+
+	// The channels were negotiated with the unsigned transaction, which
+	// can't be rebroadcast. Now that it's signed, we store the final
+	// transaction with each of them before publishing it.
+	for _, channel := range b.channels {
+		err := b.cfg.FundingTxUpdater(channel.chanPoint, &finalTx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to store funding tx "+
+				"of ChannelPoint(%v): %v", channel.chanPoint,
+				err)
+		}
+	}
+
+	log.Infof("Publishing batch funding tx %v for %d channels", txid,
+		len(b.channels))
+
+	err = b.cfg.Wallet.PublishTransaction(&finalTx, label)
+	if err != nil {
+		return nil, fmt.Errorf("error publishing batch transaction: "+
+			"%v", err)
+	}
+
+	return pendingUpdates, nil
+}
+
+// waitForUpdate returns the next update of the channel's funding flow. An
+// error is returned if the peer doesn't advance the funding flow within the
+// peer timeout.
+func (b *Batcher) waitForUpdate(ctx context.Context,
+	channel *batchChannel) (*lnrpc.OpenStatusUpdate, error) {
+
+	timeout := b.cfg.PeerTimeout
+	if timeout == 0 {
+		timeout = DefaultBatchPeerTimeout
+	}
+
+	select {
+	case update := <-channel.updates:
+		return update, nil
+
+	case err := <-channel.errs:
+		channel.failed = true
+		return nil, fmt.Errorf("channel %x failed: %v",
+			channel.pendingChanID[:], err)
+
+	case <-time.After(timeout):
+		return nil, fmt.Errorf("channel %x timed out after %v",
+			channel.pendingChanID[:], timeout)
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-b.cfg.Quit:
+		return nil, errShuttingDown
+	}
+}
+
+// waitForPending waits for the channel to reach the pending state and records
+// its channel point.
+func (b *Batcher) waitForPending(ctx context.Context,
+	channel *batchChannel) (*lnrpc.PendingUpdate, error) {
+
+	update, err := b.waitForUpdate(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := update.GetChanPending()
+	if pending == nil {
+		return nil, fmt.Errorf("channel %x: expected pending "+
+			"update, got %T", channel.pendingChanID[:],
+			update.Update)
+	}
+
+	hash, err := chainhash.NewHash(pending.Txid)
+	if err != nil {
+		return nil, err
+	}
+	channel.chanPoint = &wire.OutPoint{
+		Hash:  *hash,
+		Index: pending.OutputIndex,
+	}
+
+	return pending, nil
+}
+
+// cleanup rolls back all channels of a failed batch and releases the inputs
+// that were locked for the batch funding transaction.
+func (b *Batcher) cleanup(ctx context.Context) {
+	for _, channel := range b.channels {
+		// Channels that never started or already failed don't need to
+		// be rolled back.
+		if channel.updates == nil || channel.failed {
+			continue
+		}
+
+		// The funding flow of a channel that isn't verified yet can
+		// simply be canceled. The funding intent is only registered
+		// once the keys are negotiated, so we need to wait for the
+		// funding request first if we haven't received it yet.
+		if !channel.verified {
+			if channel.fundingAddr == "" {
+				_, err := b.waitForUpdate(ctx, channel)
+				if err != nil {
+					log.Debugf("Channel %x failed before "+
+						"being funded: %v",
+						channel.pendingChanID[:], err)
+
+					continue
+				}
+			}
+
+			err := b.cfg.Wallet.CancelFundingIntent(
+				channel.pendingChanID,
+			)
+			if err != nil {
+				log.Debugf("Unable to cancel funding intent "+
+					"of channel %x: %v",
+					channel.pendingChanID[:], err)
+			}
+
+			continue
+		}
+
+		// A verified channel can't be canceled anymore. We wait for it
+		// to either fail or become pending, in which case we abandon
+		// it, as its funding transaction is never published.
+		if channel.chanPoint == nil {
+			_, err := b.waitForPending(ctx, channel)
+			if err != nil {
+				log.Debugf("Channel %x didn't become pending: "+
+					"%v", channel.pendingChanID[:], err)
+
+				continue
+			}
+		}
+
+		err := b.cfg.ChannelAbandoner(channel.chanPoint)
+		if err != nil {
+			log.Errorf("Unable to abandon channel %v: %v",
+				channel.chanPoint, err)
+		}
+	}
+
+	for _, lease := range b.lockedUtxos {
+		_, err := b.cfg.WalletKitServer.ReleaseOutput(
+			ctx, &walletrpc.ReleaseOutputRequest{
+				Id:       lease.Id,
+				Outpoint: lease.Outpoint,
+			},
+		)
+		if err != nil {
+			log.Errorf("Unable to release batch input %v: %v",
+				lease.Outpoint, err)
+		}
+	}
+}
+
+// batchPendingChanID returns the given pending channel ID or a random one if
+// none is set.
+func batchPendingChanID(rawID []byte) ([32]byte, error) {
+	var pendingChanID [32]byte
+	switch len(rawID) {
+	case 0:
+		if _, err := rand.Read(pendingChanID[:]); err != nil {
+			return pendingChanID, err
+		}
+
+	case 32:
+		copy(pendingChanID[:], rawID)
+
+	default:
+		return pendingChanID, errors.New("pending channel ID must be " +
+			"32 bytes")
+	}
+
+	return pendingChanID, nil
+}
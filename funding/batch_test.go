@@ -0,0 +1,408 @@
+package funding
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/psbt"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errBatchPeer = errors.New("peer failed")
+
+	// batchP2WKHScript is a native SegWit script that funds the batch.
+	batchP2WKHScript = append([]byte{0x00, 0x14}, make([]byte, 20)...)
+
+	// batchP2SHScript is a script that changes the txid when signed.
+	batchP2SHScript = append(
+		append([]byte{0xa9, 0x14}, make([]byte, 20)...), 0x87,
+	)
+)
+
+// batchFailStage is the stage of the funding flow at which a peer fails.
+type batchFailStage uint8
+
+const (
+	failNever batchFailStage = iota
+	failBeforeFunding
+	failBeforePending
+	stallBeforePending
+)
+
+// fakeBatchChannel is the funding flow of a single channel that is simulated
+// by the batch harness.
+type fakeBatchChannel struct {
+	idx     int
+	updates chan *lnrpc.OpenStatusUpdate
+	errs    chan error
+}
+
+// batchHarness simulates the funding manager, the wallet and the wallet kit
+// server for the batcher.
+type batchHarness struct {
+	t *testing.T
+
+	failStage    batchFailStage
+	failIdx      int
+	fundingInput []byte
+
+	mu        sync.Mutex
+	channels  map[[32]byte]*fakeBatchChannel
+	canceled  []int
+	abandoned []uint32
+	released  int
+	published []*wire.MsgTx
+	stored    map[uint32]*wire.MsgTx
+	funded    bool
+}
+
+func newBatchHarness(t *testing.T, failStage batchFailStage,
+	failIdx int) *batchHarness {
+
+	return &batchHarness{
+		t:            t,
+		failStage:    failStage,
+		failIdx:      failIdx,
+		fundingInput: batchP2WKHScript,
+		channels:     make(map[[32]byte]*fakeBatchChannel),
+		stored:       make(map[uint32]*wire.MsgTx),
+	}
+}
+
+func (h *batchHarness) batcher() *Batcher {
+	return NewBatcher(&BatchConfig{
+		RequestParser: func(req *lnrpc.OpenChannelRequest) (
+			*InitFundingMsg, error) {
+
+			if req.LocalFundingAmount == 0 {
+				return nil, errors.New("invalid amount")
+			}
+
+			return &InitFundingMsg{
+				LocalFundingAmt: btcutil.Amount(
+					req.LocalFundingAmount,
+				),
+			}, nil
+		},
+		ChannelOpener:    h.openChannel,
+		ChannelAbandoner: h.abandonChannel,
+		FundingTxUpdater: h.updateFundingTx,
+		WalletKitServer:  h,
+		Wallet:           h,
+		NetParams:        &chaincfg.RegressionNetParams,
+		PeerTimeout:      50 * time.Millisecond,
+		Quit:             make(chan struct{}),
+	})
+}
+
+func (h *batchHarness) openChannel(
+	req *InitFundingMsg) (chan *lnrpc.OpenStatusUpdate, chan error) {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	channel := &fakeBatchChannel{
+		idx:     len(h.channels),
+		updates: make(chan *lnrpc.OpenStatusUpdate, 2),
+		errs:    make(chan error, 1),
+	}
+	h.channels[req.PendingChanID] = channel
+
+	if h.failStage == failBeforeFunding && channel.idx == h.failIdx {
+		channel.errs <- errBatchPeer
+		return channel.updates, channel.errs
+	}
+
+	channel.updates <- &lnrpc.OpenStatusUpdate{
+		Update: &lnrpc.OpenStatusUpdate_PsbtFund{
+			PsbtFund: &lnrpc.ReadyForPsbtFunding{
+				FundingAddress: fmt.Sprintf("addr%d",
+					channel.idx),
+				FundingAmount: int64(req.LocalFundingAmt),
+			},
+		},
+	}
+
+	return channel.updates, channel.errs
+}
+
+func (h *batchHarness) abandonChannel(chanPoint *wire.OutPoint) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.abandoned = append(h.abandoned, chanPoint.Index)
+	return nil
+}
+
+func (h *batchHarness) updateFundingTx(chanPoint *wire.OutPoint,
+	tx *wire.MsgTx) error {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.stored[chanPoint.Index] = tx
+	return nil
+}
+
+func (h *batchHarness) PsbtFundingVerify(pendingChanID [32]byte,
+	packet *psbt.Packet, skipFinalize bool) error {
+
+	require.True(h.t, skipFinalize)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	channel := h.channels[pendingChanID]
+	if h.failStage == failBeforePending && channel.idx == h.failIdx {
+		channel.errs <- errBatchPeer
+		return nil
+	}
+	if h.failStage == stallBeforePending && channel.idx == h.failIdx {
+		return nil
+	}
+
+	txid := packet.UnsignedTx.TxHash()
+	channel.updates <- &lnrpc.OpenStatusUpdate{
+		Update: &lnrpc.OpenStatusUpdate_ChanPending{
+			ChanPending: &lnrpc.PendingUpdate{
+				Txid:        txid[:],
+				OutputIndex: uint32(channel.idx),
+			},
+		},
+	}
+
+	return nil
+}
+
+func (h *batchHarness) CancelFundingIntent(pendingChanID [32]byte) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.canceled = append(h.canceled, h.channels[pendingChanID].idx)
+	return nil
+}
+
+func (h *batchHarness) PublishTransaction(tx *wire.MsgTx, _ string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.published = append(h.published, tx)
+	return nil
+}
+
+func (h *batchHarness) FundPsbt(_ context.Context,
+	req *walletrpc.FundPsbtRequest) (*walletrpc.FundPsbtResponse, error) {
+
+	h.mu.Lock()
+	h.funded = true
+	h.mu.Unlock()
+
+	var txOut []*wire.TxOut
+	for _, amt := range req.GetRaw().Outputs {
+		txOut = append(txOut, &wire.TxOut{Value: int64(amt)})
+	}
+	prevOut := &wire.OutPoint{Index: 1}
+	packet, err := psbt.New(
+		[]*wire.OutPoint{prevOut}, txOut, 2, 0, []uint32{0},
+	)
+	require.NoError(h.t, err)
+	packet.Inputs[0].WitnessUtxo = &wire.TxOut{
+		Value:    1_000_000,
+		PkScript: h.fundingInput,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(h.t, packet.Serialize(&buf))
+
+	return &walletrpc.FundPsbtResponse{
+		FundedPsbt: buf.Bytes(),
+		LockedUtxos: []*walletrpc.UtxoLease{{
+			Id: make([]byte, 32),
+			Outpoint: &lnrpc.OutPoint{
+				TxidBytes:   prevOut.Hash[:],
+				OutputIndex: prevOut.Index,
+			},
+		}},
+	}, nil
+}
+
+func (h *batchHarness) FinalizePsbt(_ context.Context,
+	req *walletrpc.FinalizePsbtRequest) (*walletrpc.FinalizePsbtResponse,
+	error) {
+
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
+	)
+	require.NoError(h.t, err)
+
+	tx := packet.UnsignedTx
+	tx.TxIn[0].Witness = wire.TxWitness{{0x01}, {0x02}}
+
+	var buf bytes.Buffer
+	require.NoError(h.t, tx.Serialize(&buf))
+
+	return &walletrpc.FinalizePsbtResponse{
+		RawFinalTx: buf.Bytes(),
+	}, nil
+}
+
+func (h *batchHarness) ReleaseOutput(_ context.Context,
+	_ *walletrpc.ReleaseOutputRequest) (*walletrpc.ReleaseOutputResponse,
+	error) {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.released++
+	return &walletrpc.ReleaseOutputResponse{}, nil
+}
+
+// batchRequest returns a batch request that opens the given number of
+// channels.
+func batchRequest(numChannels int) *lnrpc.BatchOpenChannelRequest {
+	req := &lnrpc.BatchOpenChannelRequest{
+		SatPerVbyte: 5,
+	}
+	for i := 0; i < numChannels; i++ {
+		req.Channels = append(req.Channels, &lnrpc.BatchOpenChannel{
+			LocalFundingAmount: int64(100_000 * (i + 1)),
+		})
+	}
+
+	return req
+}
+
+// TestBatchFund asserts that the channels of a batch are funded by a single
+// transaction that is only published once all channels are pending.
+func TestBatchFund(t *testing.T) {
+	t.Parallel()
+
+	h := newBatchHarness(t, failNever, 0)
+	pending, err := h.batcher().BatchFund(
+		context.Background(), batchRequest(3),
+	)
+	require.NoError(t, err)
+
+	require.Len(t, h.published, 1)
+	txid := h.published[0].TxHash()
+	require.Len(t, h.published[0].TxOut, 3)
+
+	require.Len(t, pending, 3)
+	for idx, update := range pending {
+		hash, err := chainhash.NewHash(update.Txid)
+		require.NoError(t, err)
+		require.Equal(t, txid, *hash)
+		require.EqualValues(t, idx, update.OutputIndex)
+
+		// Each channel must store the signed transaction.
+		stored := h.stored[update.OutputIndex]
+		require.Equal(t, h.published[0], stored)
+		require.NotEmpty(t, stored.TxIn[0].Witness)
+	}
+
+	require.Empty(t, h.canceled)
+	require.Empty(t, h.abandoned)
+	require.Zero(t, h.released)
+}
+
+// TestBatchFundRollback asserts that all channels of a batch are rolled back
+// and no transaction is published if any of the channels fails.
+func TestBatchFundRollback(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		req          *lnrpc.BatchOpenChannelRequest
+		failStage    batchFailStage
+		failIdx      int
+		fundingInput []byte
+		funded       bool
+		canceled     []int
+		abandoned    []uint32
+		released     int
+	}{
+		{
+			name:         "invalid request",
+			req:          batchRequest(0),
+			fundingInput: batchP2WKHScript,
+		},
+		{
+			name: "invalid channel",
+			req: func() *lnrpc.BatchOpenChannelRequest {
+				req := batchRequest(2)
+				req.Channels[1].LocalFundingAmount = 0
+				return req
+			}(),
+			fundingInput: batchP2WKHScript,
+		},
+		{
+			name:         "peer fails before funding",
+			req:          batchRequest(3),
+			failStage:    failBeforeFunding,
+			failIdx:      1,
+			fundingInput: batchP2WKHScript,
+			canceled:     []int{0, 2},
+		},
+		{
+			name:         "non segwit input",
+			req:          batchRequest(3),
+			fundingInput: batchP2SHScript,
+			funded:       true,
+			canceled:     []int{0, 1, 2},
+			released:     1,
+		},
+		{
+			name:         "peer fails before pending",
+			req:          batchRequest(3),
+			failStage:    failBeforePending,
+			failIdx:      1,
+			fundingInput: batchP2WKHScript,
+			funded:       true,
+			abandoned:    []uint32{0, 2},
+			released:     1,
+		},
+		{
+			name:         "peer stalls before pending",
+			req:          batchRequest(3),
+			failStage:    stallBeforePending,
+			failIdx:      1,
+			fundingInput: batchP2WKHScript,
+			funded:       true,
+			abandoned:    []uint32{0, 2},
+			released:     1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			h := newBatchHarness(t, test.failStage, test.failIdx)
+			h.fundingInput = test.fundingInput
+
+			_, err := h.batcher().BatchFund(
+				context.Background(), test.req,
+			)
+			require.Error(t, err)
+
+			require.Empty(t, h.published)
+			require.Empty(t, h.stored)
+			require.Equal(t, test.funded, h.funded)
+			require.Equal(t, test.canceled, h.canceled)
+			require.Equal(t, test.abandoned, h.abandoned)
+			require.Equal(t, test.released, h.released)
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
@@ -103,6 +104,14 @@ const (
 	// for the funding transaction to be confirmed before forgetting
 	// channels that aren't initiated by us. 2016 blocks is ~2 weeks.
 	maxWaitNumBlocksFundingConf = 2016
+
+	// maxWaitNumBlocksZeroConf is the maximum number of blocks to wait for
+	// the funding transaction of a zero-conf channel that isn't initiated
+	// by us to be confirmed. The channel is already in use, but we can't
+	// watch the inputs of a funding transaction we don't know, so we'll
+	// learn about a double spend only by the funding transaction never
+	// confirming. 144 blocks is ~1 day.
+	maxWaitNumBlocksZeroConf = 144
 )
 
 var (
@@ -118,6 +127,32 @@ var (
 	ErrConfirmationTimeout = errors.New("timeout waiting for funding " +
 		"confirmation")
 
+	// ErrFundingDoubleSpent is an error returned when we are waiting for
+	// a funding transaction to confirm, but one of its inputs is spent by
+	// a conflicting transaction.
+	ErrFundingDoubleSpent = errors.New("funding transaction double spent")
+
+	// ErrZeroConfNotSupported is returned when a zero-conf channel is
+	// requested, but we or the peer don't signal support for it.
+	ErrZeroConfNotSupported = errors.New("zero-conf channels not " +
+		"supported by both peers")
+
+	// ErrZeroConfPublic is returned when a zero-conf channel is requested
+	// that would be announced to the network.
+	ErrZeroConfPublic = errors.New("zero-conf channels must be private")
+
+	// ErrZeroConfRejected is returned when the channel acceptor doesn't
+	// consent to a zero-conf channel the peer requested.
+	ErrZeroConfRejected = errors.New("zero-conf channel rejected")
+
+	// ErrChannelTypeMismatch is returned when the responder doesn't echo
+	// the channel type we proposed.
+	ErrChannelTypeMismatch = errors.New("channel type mismatch")
+
+	// ErrUnknownChannelType is returned when the peer proposes a channel
+	// type we don't know about.
+	ErrUnknownChannelType = errors.New("unknown channel type")
+
 	// errUpfrontShutdownScriptNotSupported is returned if an upfront shutdown
 	// script is set for a peer that does not support the feature bit.
 	errUpfrontShutdownScriptNotSupported = errors.New("peer does not support" +
@@ -150,6 +185,11 @@ type reservationWithCtx struct {
 	// maxLocalCsv is the maximum csv we will accept from the remote.
 	maxLocalCsv uint16
 
+	// channelType is the explicit channel type we proposed as the
+	// initiator, which the responder must echo. It is nil if no channel
+	// type was proposed.
+	channelType *lnwire.ChannelType
+
 	updateMtx   sync.RWMutex
 	lastUpdated time.Time
 
@@ -238,6 +278,12 @@ type InitFundingMsg struct {
 	// used.
 	ChanFunder chanfunding.Assembler
 
+	// ZeroConf requests a zero-conf channel that is usable before its
+	// funding transaction confirms. The channel must be private and the
+	// peer's channel acceptor must consent to it. The channel is
+	// identified by its alias short channel ID for its whole lifetime.
+	ZeroConf bool
+
 	// PendingChanID is not all zeroes (the default value), then this will
 	// be the pending channel ID used for the funding flow within the wire
 	// protocol.
@@ -393,11 +439,28 @@ type Config struct {
 	// node we're establishing a channel with for reconnection purposes.
 	WatchNewChannel func(*channeldb.OpenChannel, *btcec.PublicKey) error
 
+	// SubscribeChainEvents returns a subscription to the on-chain events
+	// of the channel with the given channel point. It is used to learn
+	// whether an input of the funding transaction is double spent while
+	// we wait for the funding transaction to confirm.
+	SubscribeChainEvents func(wire.OutPoint) (
+		*contractcourt.ChainEventSubscription, error)
+
 	// ReportShortChanID allows the funding manager to report the newly
 	// discovered short channel ID of a formerly pending channel to outside
 	// sub-systems.
 	ReportShortChanID func(wire.OutPoint) error
 
+	// FailUnconfirmedChannel marks a zero-conf channel whose funding
+	// transaction never confirmed as closed. The link of the channel is
+	// removed from the switch first, and all HTLCs that were forwarded
+	// over it are failed back.
+	FailUnconfirmedChannel func(*channeldb.ChannelCloseSummary) error
+
+	// DeleteAliasEdge removes the edge of a failed zero-conf channel that
+	// was added to the router graph under its alias short channel ID.
+	DeleteAliasEdge func(lnwire.ShortChannelID) error
+
 	// ZombieSweeperInterval is the periodic time interval in which the
 	// zombie sweeper is run.
 	ZombieSweeperInterval time.Duration
@@ -1014,6 +1077,18 @@ func (f *Manager) stateStep(channel *channeldb.OpenChannel,
 	// The channel was added to the Router's topology, but the channel
 	// announcement was not sent.
 	case addedToRouterGraph:
+		// A zero-conf channel is already in use under its alias, but
+		// we only complete its opening process once the funding
+		// transaction has confirmed.
+		if channel.ChanType.IsZeroConf() {
+			err := f.waitForZeroConfConfirmation(
+				channel, shortChanID,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
 		err := f.annAfterSixConfs(channel, shortChanID)
 		if err != nil {
 			return fmt.Errorf("error sending channel "+
@@ -1045,64 +1120,61 @@ func (f *Manager) stateStep(channel *channeldb.OpenChannel,
 func (f *Manager) advancePendingChannelState(
 	channel *channeldb.OpenChannel, pendingChanID [32]byte) error {
 
+	// A zero-conf channel is usable right away, so we mark it open under
+	// its alias short channel ID without waiting for the funding
+	// transaction. Its confirmation is awaited before the opening process
+	// completes. The channel keeps its alias once the funding transaction
+	// confirms, as its link, forwarding packages and router graph edge are
+	// all keyed by it.
+	if channel.ChanType.IsZeroConf() {
+		chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+		confChannel := &confirmedChannel{
+			shortChanID: lnwire.NewAliasShortChanID(chanID),
+		}
+
+		log.Infof("Opening zero-conf ChannelID(%v) with alias "+
+			"shortChanID=%v", chanID, confChannel.shortChanID)
+
+		err := f.handleFundingConfirmation(channel, confChannel)
+		if err != nil {
+			return fmt.Errorf("unable to open zero-conf "+
+				"ChannelPoint(%v): %v", channel.FundingOutpoint,
+				err)
+		}
+
+		return nil
+	}
+
 	confChannel, err := f.waitForFundingWithTimeout(channel)
-	if err == ErrConfirmationTimeout {
+	switch {
+	// An input of the funding transaction was double spent, so the
+	// channel can never be opened. The channel itself is marked closed by
+	// the chain arbitrator, which detected the double spend.
+	case err == ErrFundingDoubleSpent:
+		f.releaseFundingInputs(channel)
+
+		doubleSpendErr := fmt.Errorf("funding tx (%v) double spent",
+			channel.FundingOutpoint)
+		f.notifyFundingFailure(channel, pendingChanID, doubleSpendErr)
+
+		return doubleSpendErr
+
+	case err == ErrConfirmationTimeout:
 		// We'll get a timeout if the number of blocks mined
 		// since the channel was initiated reaches
 		// maxWaitNumBlocksFundingConf and we are not the
 		// channel initiator.
-		ch := channel
-		localBalance := ch.LocalCommitment.LocalBalance.ToSatoshis()
-		closeInfo := &channeldb.ChannelCloseSummary{
-			ChainHash:               ch.ChainHash,
-			ChanPoint:               ch.FundingOutpoint,
-			RemotePub:               ch.IdentityPub,
-			Capacity:                ch.Capacity,
-			SettledBalance:          localBalance,
-			CloseType:               channeldb.FundingCanceled,
-			RemoteCurrentRevocation: ch.RemoteCurrentRevocation,
-			RemoteNextRevocation:    ch.RemoteNextRevocation,
-			LocalChanConfig:         ch.LocalChanCfg,
-		}
-
-		// Close the channel with us as the initiator because we are
-		// timing the channel out.
-		if err := ch.CloseChannel(
-			closeInfo, channeldb.ChanStatusLocalCloseInitiator,
-		); err != nil {
-			return fmt.Errorf("failed closing channel "+
-				"%v: %v", ch.FundingOutpoint, err)
+		if err := cancelTimedOutChannel(channel); err != nil {
+			return err
 		}
 
 		timeoutErr := fmt.Errorf("timeout waiting for funding tx "+
 			"(%v) to confirm", channel.FundingOutpoint)
-
-		// When the peer comes online, we'll notify it that we
-		// are now considering the channel flow canceled.
-		f.wg.Add(1)
-		go func() {
-			defer f.wg.Done()
-
-			peerChan := make(chan lnpeer.Peer, 1)
-			var peerKey [33]byte
-			copy(peerKey[:], ch.IdentityPub.SerializeCompressed())
-
-			f.cfg.NotifyWhenOnline(peerKey, peerChan)
-
-			var peer lnpeer.Peer
-			select {
-			case peer = <-peerChan:
-			case <-f.quit:
-				return
-			}
-			// TODO(halseth): should this send be made
-			// reliable?
-			f.failFundingFlow(peer, pendingChanID, timeoutErr)
-		}()
+		f.notifyFundingFailure(channel, pendingChanID, timeoutErr)
 
 		return timeoutErr
 
-	} else if err != nil {
+	case err != nil:
 		return fmt.Errorf("error waiting for funding "+
 			"confirmation for ChannelPoint(%v): %v",
 			channel.FundingOutpoint, err)
@@ -1123,6 +1195,162 @@ func (f *Manager) advancePendingChannelState(
 	return nil
 }
 
+// fundingCanceledSummary returns the close summary of a channel whose funding
+// transaction didn't confirm in time.
+func fundingCanceledSummary(
+	ch *channeldb.OpenChannel) *channeldb.ChannelCloseSummary {
+
+	localBalance := ch.LocalCommitment.LocalBalance.ToSatoshis()
+	return &channeldb.ChannelCloseSummary{
+		ChainHash:               ch.ChainHash,
+		ChanPoint:               ch.FundingOutpoint,
+		RemotePub:               ch.IdentityPub,
+		Capacity:                ch.Capacity,
+		SettledBalance:          localBalance,
+		CloseType:               channeldb.FundingCanceled,
+		RemoteCurrentRevocation: ch.RemoteCurrentRevocation,
+		RemoteNextRevocation:    ch.RemoteNextRevocation,
+		LocalChanConfig:         ch.LocalChanCfg,
+	}
+}
+
+// cancelTimedOutChannel marks a channel whose funding transaction didn't
+// confirm in time as closed.
+func cancelTimedOutChannel(ch *channeldb.OpenChannel) error {
+	closeInfo := fundingCanceledSummary(ch)
+
+	// Close the channel with us as the initiator because we are timing
+	// the channel out.
+	if err := ch.CloseChannel(
+		closeInfo, channeldb.ChanStatusLocalCloseInitiator,
+	); err != nil {
+		return fmt.Errorf("failed closing channel %v: %v",
+			ch.FundingOutpoint, err)
+	}
+
+	return nil
+}
+
+// waitForZeroConfConfirmation waits for the funding transaction of a zero-conf
+// channel, which is already in use under its alias short channel ID, to
+// confirm. Once it does, the channel is validated against the confirmed
+// transaction. If the funding transaction is double spent or never confirms,
+// the channel is marked as failed and removed from the router graph.
+func (f *Manager) waitForZeroConfConfirmation(channel *channeldb.OpenChannel,
+	shortChanID *lnwire.ShortChannelID) error {
+
+	confChannel, err := f.waitForFundingWithTimeout(channel)
+	switch {
+	// An input of the funding transaction was double spent. The chain
+	// arbitrator, which detected the double spend, already removed the
+	// link and marked the channel closed, so we only need to clean up
+	// after it.
+	case err == ErrFundingDoubleSpent:
+		f.releaseFundingInputs(channel)
+		f.failZeroConfChannel(channel, shortChanID)
+
+		return fmt.Errorf("funding tx (%v) of zero-conf channel "+
+			"double spent", channel.FundingOutpoint)
+
+	// We are not the initiator and the funding transaction didn't confirm
+	// in time, so we stop using the channel. The chain arbitrator fails
+	// back the HTLCs that were forwarded over it and marks it closed.
+	case err == ErrConfirmationTimeout:
+		closeSummary := fundingCanceledSummary(channel)
+		closeSummary.IsPending = true
+
+		err := f.cfg.FailUnconfirmedChannel(closeSummary)
+		if err != nil {
+			return fmt.Errorf("unable to fail zero-conf "+
+				"ChannelPoint(%v): %v", channel.FundingOutpoint,
+				err)
+		}
+		f.failZeroConfChannel(channel, shortChanID)
+
+		return fmt.Errorf("timeout waiting for funding tx (%v) of "+
+			"zero-conf channel to confirm", channel.FundingOutpoint)
+
+	case err != nil:
+		return fmt.Errorf("error waiting for funding "+
+			"confirmation for ChannelPoint(%v): %v",
+			channel.FundingOutpoint, err)
+	}
+
+	// Now that the funding transaction has confirmed, we'll request that
+	// the wallet fully verify the channel. The channel keeps using its
+	// alias, as the link and its forwarding packages are keyed by it.
+	err = f.cfg.Wallet.ValidateChannel(channel, confChannel.fundingTx)
+	if err != nil {
+		return fmt.Errorf("unable to validate channel: %v", err)
+	}
+
+	f.labelFundingTx(channel, confChannel.shortChanID)
+
+	log.Infof("Funding tx of zero-conf ChannelPoint(%v) confirmed: "+
+		"shortChanID=%v, alias=%v", channel.FundingOutpoint,
+		confChannel.shortChanID, shortChanID)
+
+	return nil
+}
+
+// failZeroConfChannel removes a failed zero-conf channel from the router
+// graph and deletes its opening state, as its opening process can never
+// complete.
+func (f *Manager) failZeroConfChannel(channel *channeldb.OpenChannel,
+	shortChanID *lnwire.ShortChannelID) {
+
+	if err := f.cfg.DeleteAliasEdge(*shortChanID); err != nil {
+		log.Errorf("Unable to delete alias edge %v: %v", shortChanID,
+			err)
+	}
+
+	err := f.deleteChannelOpeningState(&channel.FundingOutpoint)
+	if err != nil {
+		log.Errorf("Unable to delete opening state of "+
+			"ChannelPoint(%v): %v", channel.FundingOutpoint, err)
+	}
+}
+
+// notifyFundingFailure notifies the peer of the given pending channel that we
+// are now considering the channel flow canceled, once it comes online.
+func (f *Manager) notifyFundingFailure(ch *channeldb.OpenChannel,
+	pendingChanID [32]byte, fundingErr error) {
+
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+
+		peerChan := make(chan lnpeer.Peer, 1)
+		var peerKey [33]byte
+		copy(peerKey[:], ch.IdentityPub.SerializeCompressed())
+
+		f.cfg.NotifyWhenOnline(peerKey, peerChan)
+
+		var peer lnpeer.Peer
+		select {
+		case peer = <-peerChan:
+		case <-f.quit:
+			return
+		}
+		// TODO(halseth): should this send be made
+		// reliable?
+		f.failFundingFlow(peer, pendingChanID, fundingErr)
+	}()
+}
+
+// releaseFundingInputs unlocks the wallet inputs of the funding transaction of
+// the given channel, such that the inputs that weren't double spent can be
+// used for coin selection again.
+func (f *Manager) releaseFundingInputs(ch *channeldb.OpenChannel) {
+	if ch.FundingTxn == nil {
+		return
+	}
+
+	for _, txIn := range ch.FundingTxn.TxIn {
+		f.cfg.Wallet.UnlockOutpoint(txIn.PreviousOutPoint)
+	}
+}
+
 // ProcessFundingMsg sends a message to the internal fundingManager goroutine,
 // allowing it to handle the lnwire.Message.
 func (f *Manager) ProcessFundingMsg(msg lnwire.Message, peer lnpeer.Peer) {
@@ -1170,6 +1398,24 @@ func commitmentType(localFeatures,
 	return lnwallet.CommitmentTypeLegacy
 }
 
+// zeroConfChannelType returns the explicit channel type that is negotiated
+// for zero-conf channels, which are routed over using an alias short channel
+// ID until their funding transaction confirms.
+func zeroConfChannelType() *lnwire.ChannelType {
+	return lnwire.NewChannelType(
+		lnwire.ScidAliasRequired, lnwire.ZeroConfRequired,
+	)
+}
+
+// zeroConfSupported returns true if both peers signal support for zero-conf
+// channels.
+func zeroConfSupported(localFeatures,
+	remoteFeatures *lnwire.FeatureVector) bool {
+
+	return localFeatures.HasFeature(lnwire.ZeroConfOptional) &&
+		remoteFeatures.HasFeature(lnwire.ZeroConfOptional)
+}
+
 // handleFundingOpen creates an initial 'ChannelReservation' within the wallet,
 // then responds to the source peer with an accept channel message progressing
 // the funding workflow.
@@ -1297,6 +1543,41 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 		return
 	}
 
+	// If the initiator proposed an explicit channel type, we'll make sure
+	// we can open a channel of that type. The only type we currently
+	// negotiate is the zero-conf one, which requires that the channel is
+	// private and that our channel acceptor explicitly trusts the peer.
+	var zeroConf bool
+	if msg.ChannelType != nil {
+		var chanTypeErr error
+		switch {
+		case !msg.ChannelType.Equal(zeroConfChannelType()):
+			chanTypeErr = ErrUnknownChannelType
+
+		case !zeroConfSupported(
+			peer.LocalFeatures(), peer.RemoteFeatures(),
+		):
+			chanTypeErr = ErrZeroConfNotSupported
+
+		case msg.ChannelFlags&lnwire.FFAnnounceChannel != 0:
+			chanTypeErr = ErrZeroConfPublic
+
+		case !acceptorResp.ZeroConf:
+			chanTypeErr = ErrZeroConfRejected
+		}
+		if chanTypeErr != nil {
+			log.Errorf("Rejecting channel type of pending "+
+				"channel %x: %v", msg.PendingChannelID,
+				chanTypeErr)
+			f.failFundingFlow(
+				peer, msg.PendingChannelID, chanTypeErr,
+			)
+			return
+		}
+
+		zeroConf = true
+	}
+
 	log.Infof("Recv'd fundingRequest(amt=%v, push=%v, delay=%v, "+
 		"pendingId=%x) from peer(%x)", amt, msg.PushAmount,
 		msg.CsvDelay, msg.PendingChannelID,
@@ -1347,6 +1628,16 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 	if acceptorResp.MinAcceptDepth != 0 {
 		numConfsReq = acceptorResp.MinAcceptDepth
 	}
+
+	// A zero-conf channel is usable right away, so we signal a minimum
+	// depth of zero to the initiator. We still wait for a single
+	// confirmation in the background to learn the real short channel ID.
+	minDepth := uint32(numConfsReq)
+	if zeroConf {
+		numConfsReq = 1
+		minDepth = 0
+		reservation.SetZeroConf()
+	}
 	reservation.SetNumConfsRequired(numConfsReq)
 
 	// We'll also validate and apply all the constraints the initiating
@@ -1504,7 +1795,7 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 		DustLimit:             ourContribution.DustLimit,
 		MaxValueInFlight:      remoteMaxValue,
 		ChannelReserve:        chanReserve,
-		MinAcceptDepth:        uint32(numConfsReq),
+		MinAcceptDepth:        minDepth,
 		HtlcMinimum:           minHtlc,
 		CsvDelay:              remoteCsvDelay,
 		MaxAcceptedHTLCs:      maxHtlcs,
@@ -1515,6 +1806,7 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
 		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
 		UpfrontShutdownScript: ourContribution.UpfrontShutdown,
+		ChannelType:           msg.ChannelType,
 	}
 
 	if err := peer.SendMessage(true, &fundingAccept); err != nil {
@@ -1558,10 +1850,37 @@ func (f *Manager) handleFundingAccept(peer lnpeer.Peer,
 		return
 	}
 
+	// The responder must echo the channel type we proposed, otherwise we
+	// can't be sure both of us have the same view of the channel.
+	if !msg.ChannelType.Equal(resCtx.channelType) {
+		log.Warnf("Peer responded with channel type that doesn't "+
+			"match our proposal for pending_id(%x)", pendingChanID)
+		f.failFundingFlow(peer, msg.PendingChannelID,
+			ErrChannelTypeMismatch)
+		return
+	}
+
+	// A zero-conf channel is usable right away, so the responder must
+	// not require any confirmations. We'll still wait for a single
+	// confirmation in the background to learn the real short channel ID.
+	// Any other channel requires at least one confirmation.
+	numConfs := uint16(msg.MinAcceptDepth)
+	switch {
+	case resCtx.channelType.IsZeroConf() && numConfs != 0:
+		err := fmt.Errorf("peer requires %d confirmations for "+
+			"zero-conf channel", numConfs)
+		log.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+
+	case numConfs == 0:
+		numConfs = 1
+	}
+
 	// We'll also specify the responder's preference for the number of
 	// required confirmations, and also the set of channel constraints
 	// they've specified for commitment states we can create.
-	resCtx.reservation.SetNumConfsRequired(uint16(msg.MinAcceptDepth))
+	resCtx.reservation.SetNumConfsRequired(numConfs)
 	channelConstraints := &channeldb.ChannelConstraints{
 		DustLimit:        msg.DustLimit,
 		ChanReserve:      msg.ChannelReserve,
@@ -2148,6 +2467,19 @@ func (f *Manager) waitForFundingWithTimeout(
 	}
 	defer close(cancelChan)
 
+	// We'll also be notified if an input of the funding transaction is
+	// double spent, as the funding transaction will never confirm then.
+	var doubleSpendChan <-chan *contractcourt.FundingDoubleSpendInfo
+	chainEvents, err := f.cfg.SubscribeChainEvents(ch.FundingOutpoint)
+	if err != nil {
+		log.Warnf("Unable to subscribe to chain events of "+
+			"ChannelPoint(%v), not watching for funding double "+
+			"spends: %v", ch.FundingOutpoint, err)
+	} else {
+		defer chainEvents.Cancel()
+		doubleSpendChan = chainEvents.FundingDoubleSpend
+	}
+
 	select {
 	case err := <-timeoutChan:
 		if err != nil {
@@ -2155,6 +2487,13 @@ func (f *Manager) waitForFundingWithTimeout(
 		}
 		return nil, ErrConfirmationTimeout
 
+	case doubleSpend := <-doubleSpendChan:
+		log.Warnf("Funding input %v of ChannelPoint(%v) double spent "+
+			"by tx %v", doubleSpend.DoubleSpentInput,
+			ch.FundingOutpoint, doubleSpend.ClosingTXID)
+
+		return nil, ErrFundingDoubleSpent
+
 	case <-f.quit:
 		// The fundingManager is shutting down, and will resume wait on
 		// startup.
@@ -2296,8 +2635,13 @@ func (f *Manager) waitForTimeout(completeChan *channeldb.OpenChannel,
 
 	defer epochClient.Cancel()
 
-	// On block maxHeight we will cancel the funding confirmation wait.
-	maxHeight := completeChan.FundingBroadcastHeight + maxWaitNumBlocksFundingConf
+	// On block maxHeight we will cancel the funding confirmation wait. A
+	// zero-conf channel is already in use, so we'll stop waiting sooner.
+	maxWait := uint32(maxWaitNumBlocksFundingConf)
+	if completeChan.ChanType.IsZeroConf() {
+		maxWait = maxWaitNumBlocksZeroConf
+	}
+	maxHeight := completeChan.FundingBroadcastHeight + maxWait
 	for {
 		select {
 		case epoch, ok := <-epochClient.Epochs:
@@ -2312,8 +2656,7 @@ func (f *Manager) waitForTimeout(completeChan *channeldb.OpenChannel,
 			if uint32(epoch.Height) >= maxHeight {
 				log.Warnf("Waited for %v blocks without "+
 					"seeing funding transaction confirmed,"+
-					" cancelling.",
-					maxWaitNumBlocksFundingConf)
+					" cancelling.", maxWait)
 
 				// Notify the caller of the timeout.
 				close(timeoutChan)
@@ -2351,11 +2694,17 @@ func (f *Manager) handleFundingConfirmation(
 
 	// Now that that the channel has been fully confirmed, we'll request
 	// that the wallet fully verify this channel to ensure that it can be
-	// used.
-	err := f.cfg.Wallet.ValidateChannel(completeChan, confChannel.fundingTx)
-	if err != nil {
-		// TODO(roasbeef): delete chan state?
-		return fmt.Errorf("unable to validate channel: %v", err)
+	// used. A zero-conf channel is opened under its alias before its
+	// funding transaction confirms, so it is only validated once it does.
+	isAlias := confChannel.shortChanID.IsAlias()
+	if !isAlias {
+		err := f.cfg.Wallet.ValidateChannel(
+			completeChan, confChannel.fundingTx,
+		)
+		if err != nil {
+			// TODO(roasbeef): delete chan state?
+			return fmt.Errorf("unable to validate channel: %v", err)
+		}
 	}
 
 	// The funding transaction now being confirmed, we add this channel to
@@ -2364,7 +2713,7 @@ func (f *Manager) handleFundingConfirmation(
 	// useful to resume the opening process in case of restarts. We set the
 	// opening state before we mark the channel opened in the database,
 	// such that we can receover from one of the db writes failing.
-	err = f.saveChannelOpeningState(
+	err := f.saveChannelOpeningState(
 		&fundingPoint, markedOpen, &confChannel.shortChanID,
 	)
 	if err != nil {
@@ -2392,23 +2741,11 @@ func (f *Manager) handleFundingConfirmation(
 		log.Errorf("unable to report short chan id: %v", err)
 	}
 
-	// If we opened the channel, and lnd's wallet published our funding tx
-	// (which is not the case for some channels) then we update our
-	// transaction label with our short channel ID, which is known now that
-	// our funding transaction has confirmed. We do not label transactions
-	// we did not publish, because our wallet has no knowledge of them.
-	if completeChan.IsInitiator && completeChan.ChanType.HasFundingTx() {
-		shortChanID := completeChan.ShortChanID()
-		label := labels.MakeLabel(
-			labels.LabelTypeChannelOpen, &shortChanID,
-		)
-
-		err = f.cfg.UpdateLabel(
-			completeChan.FundingOutpoint.Hash, label,
-		)
-		if err != nil {
-			log.Errorf("unable to update label: %v", err)
-		}
+	// The short channel ID is known now that our funding transaction has
+	// confirmed, so we update its label. The funding transaction of a
+	// zero-conf channel is labelled once it actually confirms.
+	if !isAlias {
+		f.labelFundingTx(completeChan, confChannel.shortChanID)
 	}
 
 	// Close the discoverySignal channel, indicating to a separate
@@ -2424,6 +2761,25 @@ func (f *Manager) handleFundingConfirmation(
 	return nil
 }
 
+// labelFundingTx updates the label of the funding transaction of the given
+// channel with its short channel ID, if we opened the channel and lnd's wallet
+// published our funding tx (which is not the case for some channels). We do
+// not label transactions we did not publish, because our wallet has no
+// knowledge of them.
+func (f *Manager) labelFundingTx(completeChan *channeldb.OpenChannel,
+	shortChanID lnwire.ShortChannelID) {
+
+	if !completeChan.IsInitiator || !completeChan.ChanType.HasFundingTx() {
+		return
+	}
+
+	label := labels.MakeLabel(labels.LabelTypeChannelOpen, &shortChanID)
+	err := f.cfg.UpdateLabel(completeChan.FundingOutpoint.Hash, label)
+	if err != nil {
+		log.Errorf("unable to update label: %v", err)
+	}
+}
+
 // sendFundingLocked creates and sends the fundingLocked message.
 // This should be called after the funding transaction has been confirmed,
 // and the channelState is 'markedOpen'.
@@ -3131,6 +3487,27 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		channelFlags = lnwire.FFAnnounceChannel
 	}
 
+	// A zero-conf channel is proposed through an explicit channel type,
+	// which requires that both of us know about it. As such a channel
+	// can't be validated by the rest of the network before it confirms,
+	// we only allow private ones.
+	var channelType *lnwire.ChannelType
+	if msg.ZeroConf {
+		if !msg.Private {
+			msg.Err <- ErrZeroConfPublic
+			return
+		}
+
+		if !zeroConfSupported(
+			msg.Peer.LocalFeatures(), msg.Peer.RemoteFeatures(),
+		) {
+			msg.Err <- ErrZeroConfNotSupported
+			return
+		}
+
+		channelType = zeroConfChannelType()
+	}
+
 	// If the caller specified their own channel ID, then we'll use that.
 	// Otherwise we'll generate a fresh one as normal.  This will be used
 	// to track this reservation throughout its lifetime.
@@ -3223,6 +3600,10 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 	// Set our upfront shutdown address in the existing reservation.
 	reservation.SetOurUpfrontShutdown(shutdown)
 
+	if channelType.IsZeroConf() {
+		reservation.SetZeroConf()
+	}
+
 	// Now that we have successfully reserved funds for this channel in the
 	// wallet, we can fetch the final channel capacity. This is done at
 	// this point since the final capacity might change in case of
@@ -3270,6 +3651,7 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		remoteMaxHtlcs: maxHtlcs,
 		maxLocalCsv:    maxCSV,
 		reservation:    reservation,
+		channelType:    channelType,
 		peer:           msg.Peer,
 		updates:        msg.Updates,
 		err:            msg.Err,
@@ -3312,6 +3694,7 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
 		ChannelFlags:          channelFlags,
 		UpfrontShutdownScript: shutdown,
+		ChannelType:           channelType,
 	}
 	if err := msg.Peer.SendMessage(true, &fundingOpen); err != nil {
 		e := fmt.Errorf("unable to send funding request message: %v",
@@ -26,6 +26,7 @@ import (
 	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/channelnotifier"
+	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
@@ -192,9 +193,13 @@ type testNode struct {
 	newChannels     chan *newChannelMsg
 	mockNotifier    *mockNotifier
 	mockChanEvent   *mockChanEvent
+	doubleSpends    chan *contractcourt.FundingDoubleSpendInfo
 	testDir         string
 	shutdownChannel chan struct{}
+	localFeatures   []lnwire.FeatureBit
 	remoteFeatures  []lnwire.FeatureBit
+	failedChans     chan *channeldb.ChannelCloseSummary
+	deletedAliases  chan lnwire.ShortChannelID
 
 	remotePeer  *testNode
 	sendMessage func(lnwire.Message) error
@@ -229,7 +234,9 @@ func (n *testNode) QuitSignal() <-chan struct{} {
 }
 
 func (n *testNode) LocalFeatures() *lnwire.FeatureVector {
-	return lnwire.NewFeatureVector(nil, nil)
+	return lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(n.localFeatures...), nil,
+	)
 }
 
 func (n *testNode) RemoteFeatures() *lnwire.FeatureVector {
@@ -328,6 +335,15 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		),
 	}
 
+	// Funding double spends are delivered through the chain event
+	// subscriptions of the pending channels.
+	doubleSpends := make(chan *contractcourt.FundingDoubleSpendInfo, 1)
+
+	// Zero-conf channels that fail are handed to the chain arbitrator and
+	// removed from the router graph.
+	failedChans := make(chan *channeldb.ChannelCloseSummary, 1)
+	deletedAliases := make(chan lnwire.ShortChannelID, 1)
+
 	dbDir := filepath.Join(tempTestDir, "cdb")
 	cdb, err := channeldb.Open(dbDir)
 	if err != nil {
@@ -425,9 +441,28 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		WatchNewChannel: func(*channeldb.OpenChannel, *btcec.PublicKey) error {
 			return nil
 		},
+		SubscribeChainEvents: func(chanPoint wire.OutPoint) (
+			*contractcourt.ChainEventSubscription, error) {
+
+			return &contractcourt.ChainEventSubscription{
+				ChanPoint:          chanPoint,
+				FundingDoubleSpend: doubleSpends,
+				Cancel:             func() {},
+			}, nil
+		},
 		ReportShortChanID: func(wire.OutPoint) error {
 			return nil
 		},
+		FailUnconfirmedChannel: func(
+			summary *channeldb.ChannelCloseSummary) error {
+
+			failedChans <- summary
+			return nil
+		},
+		DeleteAliasEdge: func(scid lnwire.ShortChannelID) error {
+			deletedAliases <- scid
+			return nil
+		},
 		PublishTransaction: func(txn *wire.MsgTx, _ string) error {
 			publTxChan <- txn
 			return nil
@@ -467,6 +502,9 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		fundingMgr:      f,
 		mockNotifier:    chainNotifier,
 		mockChanEvent:   evt,
+		doubleSpends:    doubleSpends,
+		failedChans:     failedChans,
+		deletedAliases:  deletedAliases,
 		testDir:         tempTestDir,
 		shutdownChannel: shutdownChan,
 		addr:            addr,
@@ -524,8 +562,9 @@ func recreateAliceFundingManager(t *testing.T, alice *testNode) {
 
 			connectedChan <- alice.remotePeer
 		},
-		TempChanIDSeed: oldCfg.TempChanIDSeed,
-		FindChannel:    oldCfg.FindChannel,
+		TempChanIDSeed:       oldCfg.TempChanIDSeed,
+		FindChannel:          oldCfg.FindChannel,
+		SubscribeChainEvents: oldCfg.SubscribeChainEvents,
 		DefaultRoutingPolicy: htlcswitch.ForwardingPolicy{
 			MinHTLCOut:    5,
 			BaseFee:       100,
@@ -637,7 +676,7 @@ func openChannel(t *testing.T, alice, bob *testNode, localFundingAmt,
 
 	publ := fundChannel(
 		t, alice, bob, localFundingAmt, pushAmt, false, numConfs,
-		updateChan, announceChan,
+		updateChan, announceChan, false,
 	)
 	fundingOutPoint := &wire.OutPoint{
 		Hash:  publ.TxHash(),
@@ -650,7 +689,8 @@ func openChannel(t *testing.T, alice, bob *testNode, localFundingAmt,
 // transaction is confirmed on-chain. Returns the funding tx.
 func fundChannel(t *testing.T, alice, bob *testNode, localFundingAmt,
 	pushAmt btcutil.Amount, subtractFees bool, numConfs uint32,
-	updateChan chan *lnrpc.OpenStatusUpdate, announceChan bool) *wire.MsgTx {
+	updateChan chan *lnrpc.OpenStatusUpdate, announceChan,
+	zeroConf bool) *wire.MsgTx {
 
 	// Create a funding request and start the workflow.
 	errChan := make(chan error, 1)
@@ -663,6 +703,7 @@ func fundChannel(t *testing.T, alice, bob *testNode, localFundingAmt,
 		PushAmt:         lnwire.NewMSatFromSatoshis(pushAmt),
 		FundingFeePerKw: 1000,
 		Private:         !announceChan,
+		ZeroConf:        zeroConf,
 		Updates:         updateChan,
 		Err:             errChan,
 	}
@@ -2045,6 +2086,260 @@ func TestFundingManagerFundingNotTimeoutInitiator(t *testing.T) {
 	assertNumPendingChannelsBecomes(t, bob, 0)
 }
 
+// TestFundingManagerFundingDoubleSpend checks that the funding flow of a
+// pending channel is canceled if an input of its funding transaction is double
+// spent.
+func TestFundingManagerFundingDoubleSpend(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// We will consume the channel updates as we go, so no buffering is needed.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+
+	// Run through the process of opening the channel, up until the funding
+	// transaction is broadcasted.
+	fundingOutPoint, fundingTx := openChannel(
+		t, alice, bob, 500000, 0, 1, updateChan, true,
+	)
+
+	// Alice is the initiator, so she won't time out waiting for the
+	// funding transaction. Instead, one of its inputs gets double spent.
+	alice.doubleSpends <- &contractcourt.FundingDoubleSpendInfo{
+		ChannelCloseSummary: &channeldb.ChannelCloseSummary{
+			ChanPoint: *fundingOutPoint,
+			CloseType: channeldb.FundingDoubleSpent,
+		},
+		DoubleSpentInput: fundingTx.TxIn[0].PreviousOutPoint,
+	}
+
+	// Alice should have sent an Error message to Bob.
+	assertErrorSent(t, alice.msgChan)
+
+	// As Alice stopped waiting for the funding transaction, its
+	// confirmation shouldn't advance the funding flow anymore.
+	alice.mockNotifier.oneConfChannel <- &chainntnfs.TxConfirmation{
+		Tx: fundingTx,
+	}
+
+	select {
+	case msg := <-alice.msgChan:
+		t.Fatalf("unexpected message sent: %T", msg)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// zeroConfAcceptor is a channel acceptor that trusts every peer with
+// zero-conf channels.
+type zeroConfAcceptor struct{}
+
+// Accept accepts every channel request as a zero-conf channel.
+func (zeroConfAcceptor) Accept(_ *chanacceptor.ChannelAcceptRequest) (
+	resp *chanacceptor.ChannelAcceptResponse) {
+
+	return chanacceptor.NewChannelAcceptResponse(
+		true, nil, nil, 0, 0, 0, 0, 0, 0, true,
+	)
+}
+
+// openZeroConfChannel opens a zero-conf channel between Alice and Bob and
+// takes it to the point where it is in use under its alias, while its funding
+// transaction is still unconfirmed. Returns the funding out point and
+// transaction.
+func openZeroConfChannel(t *testing.T, alice, bob *testNode,
+	updateChan chan *lnrpc.OpenStatusUpdate) (*wire.OutPoint,
+	*wire.MsgTx) {
+
+	t.Helper()
+
+	zeroConfFeatures := []lnwire.FeatureBit{
+		lnwire.ZeroConfOptional, lnwire.ScidAliasOptional,
+	}
+	for _, node := range []*testNode{alice, bob} {
+		node.localFeatures = zeroConfFeatures
+		node.remoteFeatures = zeroConfFeatures
+	}
+
+	localAmt := btcutil.Amount(500000)
+	fundingTx := fundChannel(
+		t, alice, bob, localAmt, 0, false, 0, updateChan, false, true,
+	)
+	fundingOutPoint := &wire.OutPoint{
+		Hash:  fundingTx.TxHash(),
+		Index: 0,
+	}
+
+	// Without any confirmation, both funding managers should mark the
+	// channel open and send fundingLocked.
+	assertMarkedOpen(t, alice, bob, fundingOutPoint)
+
+	fundingLockedAlice := assertFundingMsgSent(
+		t, alice.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+	fundingLockedBob := assertFundingMsgSent(
+		t, bob.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+
+	assertFundingLockedSent(t, alice, bob, fundingOutPoint)
+	assertChannelAnnouncements(t, alice, bob, localAmt, nil, nil)
+	assertAddedToRouterGraph(t, alice, bob, fundingOutPoint)
+
+	// Both sides should have assigned the same alias to the channel.
+	chanID := lnwire.NewChanIDFromOutPoint(fundingOutPoint)
+	alias := lnwire.NewAliasShortChanID(chanID)
+	for _, node := range []*testNode{alice, bob} {
+		_, shortChanID, err := node.fundingMgr.getChannelOpeningState(
+			fundingOutPoint,
+		)
+		require.NoError(t, err)
+		require.True(t, shortChanID.IsAlias())
+		require.Equal(t, alias, *shortChanID)
+	}
+
+	waitForOpenUpdate(t, updateChan)
+
+	alice.fundingMgr.ProcessFundingMsg(fundingLockedBob, bob)
+	bob.fundingMgr.ProcessFundingMsg(fundingLockedAlice, alice)
+	assertHandleFundingLocked(t, alice, bob)
+
+	return fundingOutPoint, fundingTx
+}
+
+// TestFundingManagerZeroConf checks that a zero-conf channel is usable under
+// its alias before its funding transaction confirms, and that its opening
+// process completes once it does.
+func TestFundingManagerZeroConf(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t, func(cfg *Config) {
+		cfg.OpenChannelPredicate = zeroConfAcceptor{}
+	})
+	defer tearDownFundingManagers(t, alice, bob)
+
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	fundingOutPoint, fundingTx := openZeroConfChannel(
+		t, alice, bob, updateChan,
+	)
+
+	// Once the funding transaction confirms, both sides send their node
+	// announcement, as the channel is private.
+	alice.mockNotifier.oneConfChannel <- &chainntnfs.TxConfirmation{
+		Tx: fundingTx,
+	}
+	bob.mockNotifier.oneConfChannel <- &chainntnfs.TxConfirmation{
+		Tx: fundingTx,
+	}
+
+	for _, node := range []*testNode{alice, bob} {
+		select {
+		case msg := <-node.msgChan:
+			_, ok := msg.(*lnwire.NodeAnnouncement)
+			require.True(t, ok, "expected node announcement, "+
+				"got %T", msg)
+		case <-time.After(time.Second * 5):
+			t.Fatalf("expected to receive node announcement")
+		}
+	}
+
+	// The opening process is now complete, and the alias edge is kept.
+	assertNoChannelState(t, alice, bob, fundingOutPoint)
+
+	select {
+	case scid := <-alice.deletedAliases:
+		t.Fatalf("alias edge %v unexpectedly deleted", scid)
+	case scid := <-bob.deletedAliases:
+		t.Fatalf("alias edge %v unexpectedly deleted", scid)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// TestFundingManagerZeroConfDoubleSpend checks that a zero-conf channel whose
+// funding transaction is double spent is removed from the router graph.
+func TestFundingManagerZeroConfDoubleSpend(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t, func(cfg *Config) {
+		cfg.OpenChannelPredicate = zeroConfAcceptor{}
+	})
+	defer tearDownFundingManagers(t, alice, bob)
+
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	fundingOutPoint, fundingTx := openZeroConfChannel(
+		t, alice, bob, updateChan,
+	)
+
+	// Alice, the initiator, learns that an input of the funding
+	// transaction was double spent.
+	alice.doubleSpends <- &contractcourt.FundingDoubleSpendInfo{
+		ChannelCloseSummary: &channeldb.ChannelCloseSummary{
+			ChanPoint: *fundingOutPoint,
+			CloseType: channeldb.FundingDoubleSpent,
+		},
+		DoubleSpentInput: fundingTx.TxIn[0].PreviousOutPoint,
+		ZeroConf:         true,
+	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(fundingOutPoint)
+	select {
+	case scid := <-alice.deletedAliases:
+		require.Equal(t, lnwire.NewAliasShortChanID(chanID), scid)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alias edge not deleted")
+	}
+
+	assertErrChannelNotFound(t, alice, fundingOutPoint)
+}
+
+// TestFundingManagerZeroConfTimeout checks that the responder of a zero-conf
+// channel stops using it if its funding transaction doesn't confirm in time.
+func TestFundingManagerZeroConfTimeout(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t, func(cfg *Config) {
+		cfg.OpenChannelPredicate = zeroConfAcceptor{}
+	})
+	defer tearDownFundingManagers(t, alice, bob)
+
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	fundingOutPoint, _ := openZeroConfChannel(t, alice, bob, updateChan)
+
+	// One block before the zero-conf timeout, Bob keeps the channel.
+	bob.mockNotifier.epochChan <- &chainntnfs.BlockEpoch{
+		Height: fundingBroadcastHeight + maxWaitNumBlocksZeroConf - 1,
+	}
+
+	select {
+	case summary := <-bob.failedChans:
+		t.Fatalf("channel %v failed too early", summary.ChanPoint)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once the timeout is reached, Bob hands the channel to the chain
+	// arbitrator to fail it and removes its alias edge.
+	bob.mockNotifier.epochChan <- &chainntnfs.BlockEpoch{
+		Height: fundingBroadcastHeight + maxWaitNumBlocksZeroConf,
+	}
+
+	select {
+	case summary := <-bob.failedChans:
+		require.Equal(t, *fundingOutPoint, summary.ChanPoint)
+		require.Equal(t, channeldb.FundingCanceled, summary.CloseType)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("channel not failed")
+	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(fundingOutPoint)
+	select {
+	case scid := <-bob.deletedAliases:
+		require.Equal(t, lnwire.NewAliasShortChanID(chanID), scid)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alias edge not deleted")
+	}
+
+	assertErrChannelNotFound(t, bob, fundingOutPoint)
+}
+
 // TestFundingManagerReceiveFundingLockedTwice checks that the fundingManager
 // continues to operate as expected in case we receive a duplicate fundingLocked
 // message.
@@ -3234,7 +3529,7 @@ func TestFundingManagerFundAll(t *testing.T) {
 		pushAmt := btcutil.Amount(0)
 		fundingTx := fundChannel(
 			t, alice, bob, test.spendAmt, pushAmt, true, 1,
-			updateChan, true,
+			updateChan, true, false,
 		)
 
 		// Check whether the expected change output is present.
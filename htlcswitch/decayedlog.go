@@ -13,18 +13,19 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb/kvdb"
 )
 
-const (
-	// defaultDbDirectory is the default directory where our decayed log
-	// will store our (sharedHash, CLTV) key-value pairs.
-	defaultDbDirectory = "sharedhashes"
-)
-
 var (
 	// sharedHashBucket is a bucket which houses the first HashPrefixSize
 	// bytes of a received HTLC's hashed shared secret as the key and the HTLC's
 	// CLTV expiry as the value.
 	sharedHashBucket = []byte("shared-hash")
 
+	// sharedHashExpiryBucket is an index of the entries in the
+	// sharedHashBucket by their CLTV expiry. The key is the big endian
+	// CLTV followed by the hash prefix, and the value is empty. As the
+	// keys are sorted by expiry, the garbage collector only needs to visit
+	// the entries that actually expired.
+	sharedHashExpiryBucket = []byte("shared-hash-expiry-index")
+
 	// batchReplayBucket is a bucket that maps batch identifiers to
 	// serialized ReplaySets. This is used to give idempotency in the event
 	// that a batch is processed more than once.
@@ -45,14 +46,13 @@ var (
 // HashPrefixSize bytes of a sha256-hashed shared secret along with a node's
 // CLTV value. It is a decaying log meaning there will be a garbage collector
 // to collect entries which are expired according to their stored CLTV value
-// and the current block height. DecayedLog wraps boltdb for simplicity and
-// batches writes to the database to decrease write contention.
+// and the current block height. DecayedLog is stored in the given kvdb
+// backend, which is usually shared with the channel database, and batches
+// writes to the database to decrease write contention.
 type DecayedLog struct {
 	started int32 // To be used atomically.
 	stopped int32 // To be used atomically.
 
-	cfg *kvdb.BoltBackendConfig
-
 	db kvdb.Backend
 
 	notifier chainntnfs.ChainNotifier
@@ -63,46 +63,26 @@ type DecayedLog struct {
 
 // NewDecayedLog creates a new DecayedLog, which caches recently seen hash
 // shared secrets. Entries are evicted as their cltv expires using block epochs
-// from the given notifier.
-func NewDecayedLog(dbPath, dbFileName string, boltCfg *kvdb.BoltConfig,
+// from the given notifier. The log is stored in the given backend, which is
+// not closed when the log is stopped.
+func NewDecayedLog(db kvdb.Backend,
 	notifier chainntnfs.ChainNotifier) *DecayedLog {
 
-	cfg := &kvdb.BoltBackendConfig{
-		DBPath:            dbPath,
-		DBFileName:        dbFileName,
-		NoFreelistSync:    true,
-		AutoCompact:       boltCfg.AutoCompact,
-		AutoCompactMinAge: boltCfg.AutoCompactMinAge,
-		DBTimeout:         boltCfg.DBTimeout,
-	}
-
-	// Use default path for log database
-	if dbPath == "" {
-		cfg.DBPath = defaultDbDirectory
-	}
-
 	return &DecayedLog{
-		cfg:      cfg,
+		db:       db,
 		notifier: notifier,
 		quit:     make(chan struct{}),
 	}
 }
 
-// Start opens the database we will be using to store hashed shared secrets.
-// It also starts the garbage collector in a goroutine to remove stale
+// Start initializes the buckets we will be using to store hashed shared
+// secrets. It also starts the garbage collector in a goroutine to remove stale
 // database entries.
 func (d *DecayedLog) Start() error {
 	if !atomic.CompareAndSwapInt32(&d.started, 0, 1) {
 		return nil
 	}
 
-	// Open the boltdb for use.
-	var err error
-	d.db, err = kvdb.GetBoltBackend(d.cfg)
-	if err != nil {
-		return fmt.Errorf("could not open boltdb: %v", err)
-	}
-
 	// Initialize the primary buckets used by the decayed log.
 	if err := d.initBuckets(); err != nil {
 		return err
@@ -124,7 +104,7 @@ func (d *DecayedLog) Start() error {
 }
 
 // initBuckets initializes the primary buckets used by the decayed log, namely
-// the shared hash bucket, and batch replay
+// the shared hash bucket, its expiry index, and batch replay
 func (d *DecayedLog) initBuckets() error {
 	return kvdb.Update(d.db, func(tx kvdb.RwTx) error {
 		_, err := tx.CreateTopLevelBucket(sharedHashBucket)
@@ -132,6 +112,11 @@ func (d *DecayedLog) initBuckets() error {
 			return ErrDecayedLogInit
 		}
 
+		_, err = tx.CreateTopLevelBucket(sharedHashExpiryBucket)
+		if err != nil {
+			return ErrDecayedLogInit
+		}
+
 		_, err = tx.CreateTopLevelBucket(batchReplayBucket)
 		if err != nil {
 			return ErrDecayedLogInit
@@ -141,7 +126,8 @@ func (d *DecayedLog) initBuckets() error {
 	}, func() {})
 }
 
-// Stop halts the garbage collector and closes boltdb.
+// Stop halts the garbage collector. The backend of the log is left open, as it
+// is owned by the caller.
 func (d *DecayedLog) Stop() error {
 	if !atomic.CompareAndSwapInt32(&d.stopped, 0, 1) {
 		return nil
@@ -152,9 +138,6 @@ func (d *DecayedLog) Stop() error {
 
 	d.wg.Wait()
 
-	// Close boltdb.
-	d.db.Close()
-
 	return nil
 }
 
@@ -199,48 +182,65 @@ func (d *DecayedLog) garbageCollector(epochClient *chainntnfs.BlockEpochEvent) {
 }
 
 // gcExpiredHashes purges the decaying log of all entries whose CLTV expires
-// below the provided height.
+// below the provided height. The expired entries are found by walking the
+// expiry index from the lowest CLTV up to the given height.
 func (d *DecayedLog) gcExpiredHashes(height uint32) (uint32, error) {
 	var numExpiredHashes uint32
 
 	err := kvdb.Batch(d.db, func(tx kvdb.RwTx) error {
 		numExpiredHashes = 0
 
-		// Grab the shared hash bucket
+		// Grab the shared hash bucket and its expiry index.
 		sharedHashes := tx.ReadWriteBucket(sharedHashBucket)
 		if sharedHashes == nil {
 			return fmt.Errorf("sharedHashBucket " +
 				"is nil")
 		}
 
-		var expiredCltv [][]byte
-		if err := sharedHashes.ForEach(func(k, v []byte) error {
-			// Deserialize the CLTV value for this entry.
-			cltv := uint32(binary.BigEndian.Uint32(v))
-
-			if cltv < height {
-				// This CLTV is expired. We must add it to an
-				// array which we'll loop over and delete every
-				// hash contained from the db.
-				expiredCltv = append(expiredCltv, k)
-				numExpiredHashes++
+		expiryIndex := tx.ReadWriteBucket(sharedHashExpiryBucket)
+		if expiryIndex == nil {
+			return fmt.Errorf("sharedHashExpiryBucket " +
+				"is nil")
+		}
+
+		// Collect the index keys of all expired entries. The keys are
+		// copied, as they are only valid for the life of the cursor
+		// position.
+		var expiredKeys [][]byte
+		cursor := expiryIndex.ReadWriteCursor()
+		for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+			if len(k) != 4+sphinx.HashPrefixSize {
+				return ErrDecayedLogCorrupted
 			}
 
-			return nil
-		}); err != nil {
-			return err
+			// The index is sorted by CLTV, so we can stop at the
+			// first entry that didn't expire yet.
+			cltv := binary.BigEndian.Uint32(k[:4])
+			if cltv >= height {
+				break
+			}
+
+			key := make([]byte, len(k))
+			copy(key, k)
+			expiredKeys = append(expiredKeys, key)
 		}
 
-		// Delete every item in the array. This must
-		// be done explicitly outside of the ForEach
-		// function for safety reasons.
-		for _, hash := range expiredCltv {
-			err := sharedHashes.Delete(hash)
+		// Delete every expired entry from both the shared hash bucket
+		// and the index. This must be done after iterating for safety
+		// reasons.
+		for _, key := range expiredKeys {
+			err := sharedHashes.Delete(key[4:])
 			if err != nil {
 				return err
 			}
+
+			if err := expiryIndex.Delete(key); err != nil {
+				return err
+			}
 		}
 
+		numExpiredHashes = uint32(len(expiredKeys))
+
 		return nil
 	})
 	if err != nil {
@@ -250,6 +250,31 @@ func (d *DecayedLog) gcExpiredHashes(height uint32) (uint32, error) {
 	return numExpiredHashes, nil
 }
 
+// expiryIndexKey returns the key of an entry in the expiry index, which is the
+// big endian CLTV followed by the hash prefix.
+func expiryIndexKey(hash *sphinx.HashPrefix, cltv uint32) []byte {
+	var key [4 + sphinx.HashPrefixSize]byte
+	binary.BigEndian.PutUint32(key[:4], cltv)
+	copy(key[4:], hash[:])
+
+	return key[:]
+}
+
+// putSharedHash stores a shared secret hash with its CLTV and adds it to the
+// expiry index.
+func putSharedHash(sharedHashes, expiryIndex kvdb.RwBucket,
+	hash *sphinx.HashPrefix, cltv uint32) error {
+
+	var scratch [4]byte
+	binary.BigEndian.PutUint32(scratch[:], cltv)
+
+	if err := sharedHashes.Put(hash[:], scratch[:]); err != nil {
+		return err
+	}
+
+	return expiryIndex.Put(expiryIndexKey(hash, cltv), nil)
+}
+
 // Delete removes a <shared secret hash, CLTV> key-pair from the
 // sharedHashBucket.
 func (d *DecayedLog) Delete(hash *sphinx.HashPrefix) error {
@@ -259,6 +284,24 @@ func (d *DecayedLog) Delete(hash *sphinx.HashPrefix) error {
 			return ErrDecayedLogCorrupted
 		}
 
+		expiryIndex := tx.ReadWriteBucket(sharedHashExpiryBucket)
+		if expiryIndex == nil {
+			return ErrDecayedLogCorrupted
+		}
+
+		// Remove the index entry first, as we need the stored CLTV to
+		// locate it.
+		valueBytes := sharedHashes.Get(hash[:])
+		if valueBytes == nil {
+			return nil
+		}
+
+		cltv := binary.BigEndian.Uint32(valueBytes)
+		err := expiryIndex.Delete(expiryIndexKey(hash, cltv))
+		if err != nil {
+			return err
+		}
+
 		return sharedHashes.Delete(hash[:])
 	})
 }
@@ -299,16 +342,17 @@ func (d *DecayedLog) Get(hash *sphinx.HashPrefix) (uint32, error) {
 
 // Put stores a shared secret hash as the key and the CLTV as the value.
 func (d *DecayedLog) Put(hash *sphinx.HashPrefix, cltv uint32) error {
-	// Optimisitically serialize the cltv value into the scratch buffer.
-	var scratch [4]byte
-	binary.BigEndian.PutUint32(scratch[:], cltv)
-
 	return kvdb.Batch(d.db, func(tx kvdb.RwTx) error {
 		sharedHashes := tx.ReadWriteBucket(sharedHashBucket)
 		if sharedHashes == nil {
 			return ErrDecayedLogCorrupted
 		}
 
+		expiryIndex := tx.ReadWriteBucket(sharedHashExpiryBucket)
+		if expiryIndex == nil {
+			return ErrDecayedLogCorrupted
+		}
+
 		// Check to see if this hash prefix has been recorded before. If
 		// a value is found, this packet is being replayed.
 		valueBytes := sharedHashes.Get(hash[:])
@@ -316,7 +360,7 @@ func (d *DecayedLog) Put(hash *sphinx.HashPrefix, cltv uint32) error {
 			return sphinx.ErrReplayedPacket
 		}
 
-		return sharedHashes.Put(hash[:], scratch[:])
+		return putSharedHash(sharedHashes, expiryIndex, hash, cltv)
 	})
 }
 
@@ -342,6 +386,11 @@ func (d *DecayedLog) PutBatch(b *sphinx.Batch) (*sphinx.ReplaySet, error) {
 			return ErrDecayedLogCorrupted
 		}
 
+		expiryIndex := tx.ReadWriteBucket(sharedHashExpiryBucket)
+		if expiryIndex == nil {
+			return ErrDecayedLogCorrupted
+		}
+
 		// Load the batch replay bucket, which will be used to either
 		// retrieve the result of previously processing this batch, or
 		// to write the result of this operation.
@@ -361,10 +410,6 @@ func (d *DecayedLog) PutBatch(b *sphinx.Batch) (*sphinx.ReplaySet, error) {
 			return replays.Decode(bytes.NewReader(replayBytes))
 		}
 
-		// The CLTV will be stored into scratch and then stored into the
-		// sharedHashBucket.
-		var scratch [4]byte
-
 		replays = sphinx.NewReplaySet()
 		err := b.ForEach(func(seqNum uint16, hashPrefix *sphinx.HashPrefix, cltv uint32) error {
 			// Retrieve the bytes which represents the CLTV
@@ -374,10 +419,11 @@ func (d *DecayedLog) PutBatch(b *sphinx.Batch) (*sphinx.ReplaySet, error) {
 				return nil
 			}
 
-			// Serialize the cltv value and write an entry keyed by
-			// the hash prefix.
-			binary.BigEndian.PutUint32(scratch[:], cltv)
-			return sharedHashes.Put(hashPrefix[:], scratch[:])
+			// Write an entry keyed by the hash prefix, and index it
+			// by its cltv.
+			return putSharedHash(
+				sharedHashes, expiryIndex, hashPrefix, cltv,
+			)
 		})
 		if err != nil {
 			return err
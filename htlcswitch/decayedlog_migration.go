@@ -0,0 +1,218 @@
+package htlcswitch
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+)
+
+// decayedLogMigrationBatchSize is the maximum number of entries that are
+// copied in a single transaction when migrating a decayed log file. This keeps
+// the transactions small enough for remote backends.
+const decayedLogMigrationBatchSize = 5000
+
+// decayedLogEntry is a key-value pair copied from one of the buckets of a
+// decayed log file.
+type decayedLogEntry struct {
+	key   []byte
+	value []byte
+}
+
+// MigrateDecayedLogFile copies the entries of the decayed log stored in the
+// bolt file at the given path into the given backend, which the DecayedLog
+// uses from now on. The expiry index is built for the copied shared hashes.
+// Entries that already exist in the backend are left untouched, so the
+// migration can safely be repeated if it is interrupted. Once all entries are
+// copied, the file is removed. Nothing is done if the file doesn't exist.
+func MigrateDecayedLogFile(dbPath, dbFileName string, dbTimeout time.Duration,
+	db kvdb.Backend) error {
+
+	filePath := filepath.Join(dbPath, dbFileName)
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	log.Infof("Migrating decayed log from %v", filePath)
+
+	srcDB, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:         dbPath,
+		DBFileName:     dbFileName,
+		NoFreelistSync: true,
+		DBTimeout:      dbTimeout,
+	})
+	if err != nil {
+		return fmt.Errorf("could not open decayed log file: %v", err)
+	}
+
+	sharedHashes, batchReplays, err := readDecayedLogFile(srcDB)
+	if err != nil {
+		srcDB.Close()
+		return err
+	}
+
+	if err := srcDB.Close(); err != nil {
+		return err
+	}
+
+	// Make sure all buckets exist before copying the entries over.
+	err = NewDecayedLog(db, nil).initBuckets()
+	if err != nil {
+		return err
+	}
+
+	for len(sharedHashes) > 0 {
+		n := len(sharedHashes)
+		if n > decayedLogMigrationBatchSize {
+			n = decayedLogMigrationBatchSize
+		}
+
+		if err := copySharedHashes(db, sharedHashes[:n]); err != nil {
+			return err
+		}
+		sharedHashes = sharedHashes[n:]
+	}
+
+	for len(batchReplays) > 0 {
+		n := len(batchReplays)
+		if n > decayedLogMigrationBatchSize {
+			n = decayedLogMigrationBatchSize
+		}
+
+		if err := copyBatchReplays(db, batchReplays[:n]); err != nil {
+			return err
+		}
+		batchReplays = batchReplays[n:]
+	}
+
+	log.Infof("Decayed log migrated, removing %v", filePath)
+
+	return os.Remove(filePath)
+}
+
+// readDecayedLogFile reads all shared hashes and batch replays from the given
+// decayed log database.
+func readDecayedLogFile(db kvdb.Backend) ([]decayedLogEntry,
+	[]decayedLogEntry, error) {
+
+	var sharedHashes, batchReplays []decayedLogEntry
+
+	readBucket := func(tx kvdb.RTx, name []byte) ([]decayedLogEntry,
+		error) {
+
+		bucket := tx.ReadBucket(name)
+		if bucket == nil {
+			return nil, nil
+		}
+
+		var entries []decayedLogEntry
+		err := bucket.ForEach(func(k, v []byte) error {
+			entry := decayedLogEntry{
+				key:   make([]byte, len(k)),
+				value: make([]byte, len(v)),
+			}
+			copy(entry.key, k)
+			copy(entry.value, v)
+
+			entries = append(entries, entry)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return entries, nil
+	}
+
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		var err error
+		sharedHashes, err = readBucket(tx, sharedHashBucket)
+		if err != nil {
+			return err
+		}
+
+		batchReplays, err = readBucket(tx, batchReplayBucket)
+		return err
+	}, func() {
+		sharedHashes = nil
+		batchReplays = nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sharedHashes, batchReplays, nil
+}
+
+// copySharedHashes writes the given shared hashes to the decayed log in the
+// given backend and adds them to the expiry index.
+func copySharedHashes(db kvdb.Backend, entries []decayedLogEntry) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
+		sharedHashes := tx.ReadWriteBucket(sharedHashBucket)
+		if sharedHashes == nil {
+			return ErrDecayedLogCorrupted
+		}
+
+		expiryIndex := tx.ReadWriteBucket(sharedHashExpiryBucket)
+		if expiryIndex == nil {
+			return ErrDecayedLogCorrupted
+		}
+
+		for _, entry := range entries {
+			if len(entry.key) != sphinx.HashPrefixSize ||
+				len(entry.value) != 4 {
+
+				log.Warnf("Skipping malformed shared hash %x",
+					entry.key)
+				continue
+			}
+
+			if sharedHashes.Get(entry.key) != nil {
+				continue
+			}
+
+			var hash sphinx.HashPrefix
+			copy(hash[:], entry.key)
+			cltv := binary.BigEndian.Uint32(entry.value)
+
+			err := putSharedHash(
+				sharedHashes, expiryIndex, &hash, cltv,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+}
+
+// copyBatchReplays writes the given batch replays to the decayed log in the
+// given backend.
+func copyBatchReplays(db kvdb.Backend, entries []decayedLogEntry) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
+		batchReplays := tx.ReadWriteBucket(batchReplayBucket)
+		if batchReplays == nil {
+			return ErrDecayedLogCorrupted
+		}
+
+		for _, entry := range entries {
+			if batchReplays.Get(entry.key) != nil {
+				continue
+			}
+
+			err := batchReplays.Put(entry.key, entry.value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb/kvdb"
 	"github.com/lightningnetwork/lnd/lntest/mock"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -28,11 +30,42 @@ func tempDecayedLogPath(t *testing.T) (string, string) {
 	return dir, "sphinxreplay.db"
 }
 
+// testDecayedLog wraps a DecayedLog to also close its backend when it is
+// stopped, so the same database can be reopened by the next instance.
+type testDecayedLog struct {
+	*DecayedLog
+}
+
+// Stop stops the decayed log and closes its backend.
+func (l *testDecayedLog) Stop() error {
+	if err := l.DecayedLog.Stop(); err != nil {
+		return err
+	}
+
+	return l.db.Close()
+}
+
+// openDecayedLogDB opens the bolt database at the given path to back a
+// decayed log.
+func openDecayedLogDB(dbPath, dbFileName string) (kvdb.Backend, error) {
+	return kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:         dbPath,
+		DBFileName:     dbFileName,
+		NoFreelistSync: true,
+		DBTimeout:      kvdb.DefaultDBTimeout,
+	})
+}
+
 // startup sets up the DecayedLog and possibly the garbage collector.
 func startup(dbPath, dbFileName string, notifier bool) (sphinx.ReplayLog,
 	*mock.ChainNotifier, *sphinx.HashPrefix, error) {
 
-	var log sphinx.ReplayLog
+	db, err := openDecayedLogDB(dbPath, dbFileName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var log *DecayedLog
 	var chainNotifier *mock.ChainNotifier
 	if notifier {
 
@@ -44,17 +77,16 @@ func startup(dbPath, dbFileName string, notifier bool) (sphinx.ReplayLog,
 		}
 
 		// Initialize the DecayedLog object
-		log = NewDecayedLog(
-			dbPath, dbFileName, &kvdb.BoltConfig{}, chainNotifier,
-		)
+		log = NewDecayedLog(db, chainNotifier)
 	} else {
 		// Initialize the DecayedLog object
-		log = NewDecayedLog(dbPath, dbFileName, &kvdb.BoltConfig{}, nil)
+		log = NewDecayedLog(db, nil)
 	}
 
-	// Open the channeldb (start the garbage collector)
-	err := log.Start()
+	// Initialize the buckets and start the garbage collector.
+	err = log.Start()
 	if err != nil {
+		db.Close()
 		return nil, nil, nil, err
 	}
 
@@ -67,7 +99,7 @@ func startup(dbPath, dbFileName string, notifier bool) (sphinx.ReplayLog,
 		return nil, nil, nil, err
 	}
 
-	return log, chainNotifier, &hashedSecret, nil
+	return &testDecayedLog{log}, chainNotifier, &hashedSecret, nil
 }
 
 // shutdown deletes the temporary directory that the test database uses
@@ -330,3 +362,119 @@ func TestDecayedLogStorageAndRetrieval(t *testing.T) {
 		t.Fatalf("Value retrieved doesn't match value stored")
 	}
 }
+
+// TestDecayedLogExpiryIndex asserts that the garbage collector only removes
+// the entries whose CLTV expired, and that deleted entries are also removed
+// from the expiry index.
+func TestDecayedLogExpiryIndex(t *testing.T) {
+	t.Parallel()
+
+	dbPath, dbFileName := tempDecayedLogPath(t)
+
+	l, _, _, err := startup(dbPath, dbFileName, false)
+	require.NoError(t, err)
+	defer shutdown(dbPath, l)
+
+	d := l.(*testDecayedLog).DecayedLog
+
+	// Store entries with increasing CLTVs, and delete one of them again.
+	hashes := make([]*sphinx.HashPrefix, 4)
+	for i := range hashes {
+		hashes[i] = &sphinx.HashPrefix{byte(i)}
+		require.NoError(t, d.Put(hashes[i], cltv+uint32(i)))
+	}
+	require.NoError(t, d.Delete(hashes[1]))
+
+	// Collecting at the CLTV of the third entry removes only the first,
+	// as the second one was already deleted.
+	numExpired, err := d.gcExpiredHashes(cltv + 2)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, numExpired)
+
+	for i, hash := range hashes {
+		_, err := d.Get(hash)
+		if i < 2 {
+			require.Equal(t, sphinx.ErrLogEntryNotFound, err)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+
+	// Only the remaining entries are left in the expiry index.
+	var indexKeys [][]byte
+	err = kvdb.View(d.db, func(tx kvdb.RTx) error {
+		return tx.ReadBucket(sharedHashExpiryBucket).ForEach(
+			func(k, _ []byte) error {
+				indexKeys = append(indexKeys, k)
+				return nil
+			},
+		)
+	}, func() {
+		indexKeys = nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{
+		expiryIndexKey(hashes[2], cltv+2),
+		expiryIndexKey(hashes[3], cltv+3),
+	}, indexKeys)
+}
+
+// TestMigrateDecayedLogFile asserts that the entries of a decayed log file are
+// copied into the new backend, and that the file is removed afterwards.
+func TestMigrateDecayedLogFile(t *testing.T) {
+	t.Parallel()
+
+	dbPath, dbFileName := tempDecayedLogPath(t)
+	defer os.RemoveAll(dbPath)
+
+	// Fill the old decayed log file with a shared hash and a batch.
+	oldLog, _, hashedSecret, err := startup(dbPath, dbFileName, false)
+	require.NoError(t, err)
+	require.NoError(t, oldLog.Put(hashedSecret, cltv))
+
+	batch := sphinx.NewBatch([]byte("batch"))
+	batchHash := &sphinx.HashPrefix{1}
+	require.NoError(t, batch.Put(0, batchHash, cltv+1))
+	_, err = oldLog.PutBatch(batch)
+	require.NoError(t, err)
+	require.NoError(t, oldLog.Stop())
+
+	// Migrate into a new database that stands in for the channel
+	// database.
+	db, err := openDecayedLogDB(dbPath, "channel.db")
+	require.NoError(t, err)
+
+	err = MigrateDecayedLogFile(
+		dbPath, dbFileName, kvdb.DefaultDBTimeout, db,
+	)
+	require.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(dbPath, dbFileName))
+	require.True(t, os.IsNotExist(err))
+
+	// Migrating again is a no-op now that the file is gone.
+	err = MigrateDecayedLogFile(
+		dbPath, dbFileName, kvdb.DefaultDBTimeout, db,
+	)
+	require.NoError(t, err)
+
+	d := &testDecayedLog{NewDecayedLog(db, nil)}
+	require.NoError(t, d.Start())
+	defer d.Stop()
+
+	value, err := d.Get(hashedSecret)
+	require.NoError(t, err)
+	require.Equal(t, cltv, value)
+
+	// The replayed batch returns the result of the original batch.
+	replayedBatch := sphinx.NewBatch([]byte("batch"))
+	require.NoError(t, replayedBatch.Put(0, batchHash, cltv+1))
+	replays, err := d.PutBatch(replayedBatch)
+	require.NoError(t, err)
+	require.False(t, replays.Contains(0))
+
+	// The migrated entries are part of the expiry index.
+	numExpired, err := d.gcExpiredHashes(cltv + 2)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, numExpired)
+}
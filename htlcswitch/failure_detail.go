@@ -54,6 +54,12 @@ const (
 	// OutgoingFailureForwardsDisabled is returned when the switch is
 	// configured to disallow forwards.
 	OutgoingFailureForwardsDisabled
+
+	// FailureDustExposure is returned when an htlc would push the total
+	// value of dust htlcs on one of the channel's commitments over the
+	// maximum dust exposure. It is used for both htlcs that we receive and
+	// htlcs that we offer.
+	FailureDustExposure
 )
 
 // FailureString returns the string representation of a failure detail.
@@ -91,6 +97,9 @@ func (fd OutgoingFailure) FailureString() string {
 	case OutgoingFailureForwardsDisabled:
 		return "node configured to disallow forwards"
 
+	case FailureDustExposure:
+		return "htlc exceeds maximum dust exposure"
+
 	default:
 		return "unknown failure detail"
 	}
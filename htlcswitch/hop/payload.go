@@ -90,6 +90,11 @@ type Payload struct {
 	// a TLV onion payload.
 	AMP *record.AMP
 
+	// TrampolineOnion holds the serialized trampoline onion, which carries
+	// the instructions for forwarding the payment as a trampoline node,
+	// when parsed from a TLV onion payload.
+	TrampolineOnion []byte
+
 	// customRecords are user-defined records in the custom type range that
 	// were included in the payload.
 	customRecords record.CustomSet
@@ -115,10 +120,11 @@ func NewLegacyPayload(f *sphinx.HopData) *Payload {
 // should correspond to the bytes encapsulated in a TLV onion payload.
 func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 	var (
-		cid  uint64
-		amt  uint64
-		cltv uint32
-		mpp  = &record.MPP{}
+		cid        uint64
+		amt        uint64
+		cltv       uint32
+		mpp        = &record.MPP{}
+		trampoline []byte
 	)
 
 	tlvStream, err := tlv.NewStream(
@@ -126,6 +132,7 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		record.NewLockTimeRecord(&cltv),
 		record.NewNextHopIDRecord(&cid),
 		mpp.Record(),
+		record.NewTrampolineOnionRecord(&trampoline),
 	)
 	if err != nil {
 		return nil, err
@@ -160,6 +167,11 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		mpp = nil
 	}
 
+	// Likewise, only return a trampoline onion if one was parsed.
+	if _, ok := parsedTypes[record.TrampolineOnionType]; !ok {
+		trampoline = nil
+	}
+
 	// Filter out the custom records.
 	customRecords := NewCustomRecords(parsedTypes)
 
@@ -170,8 +182,9 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 			AmountToForward: lnwire.MilliSatoshi(amt),
 			OutgoingCTLV:    cltv,
 		},
-		MPP:           mpp,
-		customRecords: customRecords,
+		MPP:             mpp,
+		TrampolineOnion: trampoline,
+		customRecords:   customRecords,
 	}, nil
 }
 
@@ -207,6 +220,7 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 	_, hasLockTime := parsedTypes[record.LockTimeOnionType]
 	_, hasNextHop := parsedTypes[record.NextHopOnionType]
 	_, hasMPP := parsedTypes[record.MPPOnionType]
+	_, hasTrampoline := parsedTypes[record.TrampolineOnionType]
 
 	switch {
 
@@ -243,6 +257,15 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 			Violation: IncludedViolation,
 			FinalHop:  isFinalHop,
 		}
+
+	// Only the trampoline node itself, which is the final hop of the
+	// outer route, should receive trampoline instructions.
+	case !isFinalHop && hasTrampoline:
+		return ErrInvalidPayload{
+			Type:      record.TrampolineOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
 	}
 
 	return nil
@@ -260,6 +283,12 @@ func (h *Payload) AMPRecord() *record.AMP {
 	return h.AMP
 }
 
+// TrampolineOnionBlob returns the trampoline onion parsed from the onion
+// payload, or nil if the payload doesn't carry any.
+func (h *Payload) TrampolineOnionBlob() []byte {
+	return h.TrampolineOnion
+}
+
 // CustomRecords returns the custom tlv type records that were parsed from the
 // payload.
 func (h *Payload) CustomRecords() record.CustomSet {
@@ -16,6 +16,7 @@ type decodePayloadTest struct {
 	expErr           error
 	expCustomRecords map[uint64][]byte
 	shouldHaveMPP    bool
+	shouldHaveTramp  bool
 }
 
 var decodePayloadTests = []decodePayloadTest{
@@ -194,6 +195,40 @@ var decodePayloadTests = []decodePayloadTest{
 		expErr:        nil,
 		shouldHaveMPP: true,
 	},
+	{
+		name: "intermediate hop with trampoline",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// next hop id
+			0x06, 0x08,
+			0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			// trampoline onion
+			0xfe, 0x00, 0x01, 0x02, 0x34, 0x04,
+			0x00, 0x33, 0x33, 0x33,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.TrampolineOnionType,
+			Violation: hop.IncludedViolation,
+			FinalHop:  false,
+		},
+	},
+	{
+		name: "final hop with trampoline",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// trampoline onion
+			0xfe, 0x00, 0x01, 0x02, 0x34, 0x04,
+			0x00, 0x33, 0x33, 0x33,
+		},
+		expErr:          nil,
+		shouldHaveTramp: true,
+	},
 }
 
 // TestDecodeHopPayloadRecordValidation asserts that parsing the payloads in the
@@ -242,6 +277,16 @@ func testDecodeHopPayloadValidation(t *testing.T, test decodePayloadTest) {
 		t.Fatalf("unexpected MPP payload")
 	}
 
+	// Assert trampoline fields if we expect them.
+	if test.shouldHaveTramp {
+		expOnion := []byte{0x00, 0x33, 0x33, 0x33}
+		if !bytes.Equal(p.TrampolineOnion, expOnion) {
+			t.Fatalf("invalid trampoline onion")
+		}
+	} else if p.TrampolineOnion != nil {
+		t.Fatalf("unexpected trampoline onion")
+	}
+
 	// Convert expected nil map to empty map, because we always expect an
 	// initiated map from the payload.
 	expCustomRecords := make(record.CustomSet)
@@ -0,0 +1,204 @@
+package htlcswitch
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// failureDetailType identifies the type of the failure detail of a persisted
+// link failure event.
+type failureDetailType uint8
+
+const (
+	// failureDetailNone indicates that the link failure has no failure
+	// detail.
+	failureDetailNone failureDetailType = iota
+
+	// failureDetailOutgoing indicates that the failure detail is an
+	// OutgoingFailure.
+	failureDetailOutgoing
+
+	// failureDetailInvoice indicates that the failure detail is an
+	// invoices.FailResolutionResult.
+	failureDetailInvoice
+)
+
+// htlcEventToRecord converts an htlc event into its htlc event log
+// representation.
+func htlcEventToRecord(event interface{}) (*channeldb.HtlcEvent, error) {
+	var record channeldb.HtlcEvent
+
+	setKey := func(key HtlcKey, eventType HtlcEventType) {
+		record.IncomingCircuit = key.IncomingCircuit
+		record.OutgoingCircuit = key.OutgoingCircuit
+		record.HtlcType = uint8(eventType)
+	}
+
+	setInfo := func(info HtlcInfo) {
+		record.IncomingTimeLock = info.IncomingTimeLock
+		record.OutgoingTimeLock = info.OutgoingTimeLock
+		record.IncomingAmt = info.IncomingAmt
+		record.OutgoingAmt = info.OutgoingAmt
+	}
+
+	switch e := event.(type) {
+	case *ForwardingEvent:
+		record.Kind = channeldb.HtlcEventForward
+		record.Timestamp = e.Timestamp
+		setKey(e.HtlcKey, e.HtlcEventType)
+		setInfo(e.HtlcInfo)
+
+	case *ForwardingFailEvent:
+		record.Kind = channeldb.HtlcEventForwardFail
+		record.Timestamp = e.Timestamp
+		setKey(e.HtlcKey, e.HtlcEventType)
+
+	case *LinkFailEvent:
+		record.Kind = channeldb.HtlcEventLinkFail
+		record.Timestamp = e.Timestamp
+		record.Incoming = e.Incoming
+		setKey(e.HtlcKey, e.HtlcEventType)
+		setInfo(e.HtlcInfo)
+
+		var b bytes.Buffer
+		err := lnwire.EncodeFailureMessage(
+			&b, e.LinkError.WireMessage(), 0,
+		)
+		if err != nil {
+			return nil, err
+		}
+		record.FailureMessage = b.Bytes()
+
+		switch detail := e.LinkError.FailureDetail.(type) {
+		case nil:
+			record.FailureDetailType = uint8(failureDetailNone)
+
+		case OutgoingFailure:
+			record.FailureDetailType = uint8(failureDetailOutgoing)
+			record.FailureDetail = uint16(detail)
+
+		case invoices.FailResolutionResult:
+			record.FailureDetailType = uint8(failureDetailInvoice)
+			record.FailureDetail = uint16(detail)
+
+		default:
+			return nil, fmt.Errorf("unknown failure detail type: "+
+				"%T", detail)
+		}
+
+	case *SettleEvent:
+		record.Kind = channeldb.HtlcEventSettle
+		record.Timestamp = e.Timestamp
+		setKey(e.HtlcKey, e.HtlcEventType)
+
+	default:
+		return nil, fmt.Errorf("unknown htlc event: %T", event)
+	}
+
+	return &record, nil
+}
+
+// htlcEventFromRecord restores an htlc event from its htlc event log
+// representation.
+func htlcEventFromRecord(record *channeldb.HtlcEvent) (interface{}, error) {
+	key := HtlcKey{
+		IncomingCircuit: record.IncomingCircuit,
+		OutgoingCircuit: record.OutgoingCircuit,
+	}
+	info := HtlcInfo{
+		IncomingTimeLock: record.IncomingTimeLock,
+		OutgoingTimeLock: record.OutgoingTimeLock,
+		IncomingAmt:      record.IncomingAmt,
+		OutgoingAmt:      record.OutgoingAmt,
+	}
+	eventType := HtlcEventType(record.HtlcType)
+
+	switch record.Kind {
+	case channeldb.HtlcEventForward:
+		return &ForwardingEvent{
+			Index:         record.Index,
+			HtlcKey:       key,
+			HtlcInfo:      info,
+			HtlcEventType: eventType,
+			Timestamp:     record.Timestamp,
+		}, nil
+
+	case channeldb.HtlcEventForwardFail:
+		return &ForwardingFailEvent{
+			Index:         record.Index,
+			HtlcKey:       key,
+			HtlcEventType: eventType,
+			Timestamp:     record.Timestamp,
+		}, nil
+
+	case channeldb.HtlcEventLinkFail:
+		msg, err := lnwire.DecodeFailureMessage(
+			bytes.NewReader(record.FailureMessage), 0,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		linkErr := NewLinkError(msg)
+		switch failureDetailType(record.FailureDetailType) {
+		case failureDetailNone:
+
+		case failureDetailOutgoing:
+			linkErr.FailureDetail = OutgoingFailure(
+				record.FailureDetail,
+			)
+
+		case failureDetailInvoice:
+			linkErr.FailureDetail = invoices.FailResolutionResult(
+				record.FailureDetail,
+			)
+
+		default:
+			return nil, fmt.Errorf("unknown failure detail type: "+
+				"%v", record.FailureDetailType)
+		}
+
+		return &LinkFailEvent{
+			Index:         record.Index,
+			HtlcKey:       key,
+			HtlcInfo:      info,
+			HtlcEventType: eventType,
+			LinkError:     linkErr,
+			Incoming:      record.Incoming,
+			Timestamp:     record.Timestamp,
+		}, nil
+
+	case channeldb.HtlcEventSettle:
+		return &SettleEvent{
+			Index:         record.Index,
+			HtlcKey:       key,
+			HtlcEventType: eventType,
+			Timestamp:     record.Timestamp,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown htlc event kind: %v",
+			record.Kind)
+	}
+}
+
+// setEventIndex sets the sequence number of an htlc event.
+func setEventIndex(event interface{}, index uint64) {
+	switch e := event.(type) {
+	case *ForwardingEvent:
+		e.Index = index
+
+	case *ForwardingFailEvent:
+		e.Index = index
+
+	case *LinkFailEvent:
+		e.Index = index
+
+	case *SettleEvent:
+		e.Index = index
+	}
+}
@@ -1,6 +1,7 @@
 package htlcswitch
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -12,14 +13,34 @@ import (
 	"github.com/lightningnetwork/lnd/subscribe"
 )
 
+const (
+	// DefaultHtlcEventLogSize is the default maximum number of htlc events
+	// that are kept in the htlc event log.
+	DefaultHtlcEventLogSize = 100000
+
+	// maxEventBatchSize is the maximum number of htlc events that are
+	// persisted in a single database transaction.
+	maxEventBatchSize = 100
+
+	// replayBatchSize is the number of persisted htlc events that are
+	// fetched at once when events are replayed to a client.
+	replayBatchSize = 1000
+)
+
+var (
+	// ErrHtlcEventLogDisabled is returned when a client asks for a replay
+	// of htlc events, but htlc events aren't persisted.
+	ErrHtlcEventLogDisabled = errors.New("htlc event log disabled")
+)
+
 // HtlcNotifier notifies clients of htlc forwards, failures and settles for
 // htlcs that the switch handles. It takes subscriptions for its events and
 // notifies them when htlc events occur. These are served on a best-effort
-// basis; events are not persisted, delivery is not guaranteed (in the event
-// of a crash in the switch, forward events may be lost) and some events may
-// be replayed upon restart. Events consumed from this package should be
-// de-duplicated by the htlc's unique combination of incoming+outgoing circuit
-// and not relied upon for critical operations.
+// basis; delivery is not guaranteed (in the event of a crash in the switch,
+// forward events may be lost) and some events may be replayed upon restart.
+// Events consumed from this package should be de-duplicated by the htlc's
+// unique combination of incoming+outgoing circuit and not relied upon for
+// critical operations.
 //
 // The htlc notifier sends the following kinds of events:
 // Forwarding Event:
@@ -54,6 +75,13 @@ import (
 // have a zero outgoing circuit key because the htlc terminates at our
 // node, and sends from our node will have a zero incoming circuit key because
 // the send originates at our node.
+//
+// If the notifier is created with an htlc event log, every event is persisted
+// before it is sent to clients, and is assigned a sequence number. Clients can
+// then ask for a replay of the events that they missed while they were not
+// subscribed, as long as these events weren't pruned from the log yet. Events
+// that are still queued when the notifier is stopped are persisted before it
+// shuts down, and events that are notified after that are dropped.
 type HtlcNotifier struct {
 	started sync.Once
 	stopped sync.Once
@@ -63,15 +91,49 @@ type HtlcNotifier struct {
 	now func() time.Time
 
 	ntfnServer *subscribe.Server
+
+	// eventLog persists the htlc events. If it is nil, events are only
+	// sent to the clients that are currently subscribed.
+	eventLog HtlcEventLog
+
+	// pendingEvents holds the events that are yet to be persisted.
+	pendingEvents []interface{}
+
+	// eventsClosed is set once the pending events have been flushed on
+	// shutdown. No new events are accepted after that.
+	eventsClosed bool
+
+	// eventsMtx guards pendingEvents and eventsClosed.
+	eventsMtx sync.Mutex
+
+	// eventSignal is signaled when new events are pending.
+	eventSignal chan struct{}
+
+	// mu makes sure that events are persisted and sent to clients
+	// atomically with respect to new subscriptions, so that replaying
+	// clients neither miss events nor receive them twice.
+	mu sync.Mutex
+
+	// lastIndex is the sequence number of the last persisted event.
+	lastIndex uint64
+
+	quit chan struct{}
+	wg   sync.WaitGroup
 }
 
 // NewHtlcNotifier creates a new HtlcNotifier which gets htlc forwarded,
 // failed and settled events from links our node has established with peers
-// and sends notifications to subscribing clients.
-func NewHtlcNotifier(now func() time.Time) *HtlcNotifier {
+// and sends notifications to subscribing clients. If an event log is passed,
+// the events are persisted and can be replayed to clients.
+func NewHtlcNotifier(now func() time.Time,
+	eventLog HtlcEventLog) *HtlcNotifier {
+
 	return &HtlcNotifier{
-		now:        now,
-		ntfnServer: subscribe.NewServer(),
+		now:         now,
+		ntfnServer:  subscribe.NewServer(),
+		eventLog:    eventLog,
+		eventSignal: make(chan struct{}, 1),
+		quit:        make(chan struct{}),
 	}
 }
 
@@ -81,14 +143,32 @@ func (h *HtlcNotifier) Start() error {
 	var err error
 	h.started.Do(func() {
 		log.Trace("HtlcNotifier starting")
-		err = h.ntfnServer.Start()
+		if err = h.ntfnServer.Start(); err != nil {
+			return
+		}
+
+		if h.eventLog == nil {
+			return
+		}
+
+		h.lastIndex, err = h.eventLog.LastIndex()
+		if err != nil {
+			return
+		}
+
+		h.wg.Add(1)
+		go h.persistEvents()
 	})
 	return err
 }
 
-// Stop signals the notifier for a graceful shutdown.
+// Stop signals the notifier for a graceful shutdown. Pending events are
+// persisted and sent to the clients before it returns.
 func (h *HtlcNotifier) Stop() {
 	h.stopped.Do(func() {
+		close(h.quit)
+		h.wg.Wait()
+
 		if err := h.ntfnServer.Stop(); err != nil {
 			log.Warnf("error stopping htlc notifier: %v", err)
 		}
@@ -96,9 +176,185 @@ func (h *HtlcNotifier) Stop() {
 }
 
 // SubscribeHtlcEvents returns a subscribe.Client that will receive updates
-// any time the server is made aware of a new event.
-func (h *HtlcNotifier) SubscribeHtlcEvents() (*subscribe.Client, error) {
-	return h.ntfnServer.Subscribe()
+// any time the server is made aware of a new event. If a non-zero start index
+// is passed, all persisted events with a larger sequence number are passed to
+// the replay callback before the client is returned. The client receives all
+// events that occur after the replayed events, so the caller should process
+// the replayed events before the client's updates.
+func (h *HtlcNotifier) SubscribeHtlcEvents(startIndex uint64,
+	replay func(event interface{}) error) (*subscribe.Client, error) {
+
+	if startIndex == 0 {
+		return h.ntfnServer.Subscribe()
+	}
+
+	if h.eventLog == nil {
+		return nil, ErrHtlcEventLogDisabled
+	}
+
+	// We subscribe while holding the lock, so that all events up to the
+	// last index are persisted, and all later events are delivered to
+	// the client.
+	h.mu.Lock()
+	client, err := h.ntfnServer.Subscribe()
+	lastIndex := h.lastIndex
+	h.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.replayEvents(startIndex, lastIndex, replay); err != nil {
+		client.Cancel()
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// replayEvents passes all persisted events with a sequence number after
+// startIndex, up to and including lastIndex, to the replay callback.
+func (h *HtlcNotifier) replayEvents(startIndex, lastIndex uint64,
+	replay func(event interface{}) error) error {
+
+	for startIndex < lastIndex {
+		records, err := h.eventLog.FetchEvents(
+			startIndex, replayBatchSize,
+		)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+
+		for _, record := range records {
+			if record.Index > lastIndex {
+				return nil
+			}
+
+			event, err := htlcEventFromRecord(record)
+			if err != nil {
+				return err
+			}
+
+			if err := replay(event); err != nil {
+				return err
+			}
+
+			startIndex = record.Index
+		}
+	}
+
+	return nil
+}
+
+// persistEvents persists the queued events in batches and sends them to the
+// subscribed clients once they have been assigned their sequence numbers.
+//
+// NOTE: This must be run as a goroutine.
+func (h *HtlcNotifier) persistEvents() {
+	defer h.wg.Done()
+
+	for {
+		select {
+		case <-h.eventSignal:
+			h.persistPending(false)
+
+		// On shutdown, we flush the events that are still pending, so
+		// that they aren't lost.
+		case <-h.quit:
+			h.persistPending(true)
+			return
+		}
+	}
+}
+
+// persistPending persists and sends all pending events, in batches of at most
+// maxEventBatchSize events. If closing is true, no new events are accepted
+// once the pending events have been taken.
+func (h *HtlcNotifier) persistPending(closing bool) {
+	for {
+		h.eventsMtx.Lock()
+		n := len(h.pendingEvents)
+		if n > maxEventBatchSize {
+			n = maxEventBatchSize
+		}
+
+		batch := make([]interface{}, n)
+		copy(batch, h.pendingEvents)
+		h.pendingEvents = h.pendingEvents[n:]
+		if len(h.pendingEvents) == 0 {
+			h.pendingEvents = nil
+			h.eventsClosed = closing
+		}
+		h.eventsMtx.Unlock()
+
+		if len(batch) == 0 {
+			return
+		}
+
+		h.persistAndSend(batch)
+	}
+}
+
+// persistAndSend persists a batch of events and sends them to the subscribed
+// clients. If the events can't be persisted, they are still sent to the
+// clients, but without a sequence number.
+func (h *HtlcNotifier) persistAndSend(batch []interface{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	records := make([]*channeldb.HtlcEvent, 0, len(batch))
+	for _, event := range batch {
+		record, err := htlcEventToRecord(event)
+		if err != nil {
+			log.Errorf("Unable to serialize htlc event: %v", err)
+			records = nil
+			break
+		}
+
+		records = append(records, record)
+	}
+
+	if records != nil {
+		if err := h.eventLog.AddEvents(records); err != nil {
+			log.Errorf("Unable to persist htlc events: %v", err)
+		} else {
+			for i, event := range batch {
+				setEventIndex(event, records[i].Index)
+			}
+			h.lastIndex = records[len(records)-1].Index
+		}
+	}
+
+	for _, event := range batch {
+		if err := h.ntfnServer.SendUpdate(event); err != nil {
+			log.Warnf("Unable to send htlc event: %v", err)
+		}
+	}
+}
+
+// notify persists the event if we have an event log, and sends it to the
+// subscribed clients.
+func (h *HtlcNotifier) notify(event interface{}) error {
+	if h.eventLog == nil {
+		return h.ntfnServer.SendUpdate(event)
+	}
+
+	h.eventsMtx.Lock()
+	if h.eventsClosed {
+		h.eventsMtx.Unlock()
+		return errors.New("htlc notifier shutting down")
+	}
+	h.pendingEvents = append(h.pendingEvents, event)
+	h.eventsMtx.Unlock()
+
+	select {
+	case h.eventSignal <- struct{}{}:
+	default:
+	}
+
+	return nil
 }
 
 // HtlcKey uniquely identifies the htlc.
@@ -208,6 +464,10 @@ func (h HtlcEventType) String() string {
 // Sends which originate from our node will report forward events with zero
 // incoming circuits in their htlc key.
 type ForwardingEvent struct {
+	// Index is the sequence number of the event in the htlc event log. It
+	// is zero if the event wasn't persisted.
+	Index uint64
+
 	// HtlcKey uniquely identifies the htlc, and can be used to match the
 	// forwarding event with subsequent settle/fail events.
 	HtlcKey
@@ -230,6 +490,10 @@ type ForwardingEvent struct {
 // the wire failure message does not contain full information about the
 // failure.
 type LinkFailEvent struct {
+	// Index is the sequence number of the event in the htlc event log. It
+	// is zero if the event wasn't persisted.
+	Index uint64
+
 	// HtlcKey uniquely identifies the htlc.
 	HtlcKey
 
@@ -258,6 +522,10 @@ type LinkFailEvent struct {
 // should be matched with their corresponding forward event to obtain this
 // information.
 type ForwardingFailEvent struct {
+	// Index is the sequence number of the event in the htlc event log. It
+	// is zero if the event wasn't persisted.
+	Index uint64
+
 	// HtlcKey uniquely identifies the htlc, and can be used to match the
 	// htlc with its corresponding forwarding event.
 	HtlcKey
@@ -275,6 +543,10 @@ type ForwardingFailEvent struct {
 // be matched with corresponding forward events or invoices (for receives)
 // to obtain additional information about the htlc.
 type SettleEvent struct {
+	// Index is the sequence number of the event in the htlc event log. It
+	// is zero if the event wasn't persisted.
+	Index uint64
+
 	// HtlcKey uniquely identifies the htlc, and can be used to match
 	// forwards with their corresponding forwarding event.
 	HtlcKey
@@ -304,7 +576,7 @@ func (h *HtlcNotifier) NotifyForwardingEvent(key HtlcKey, info HtlcInfo,
 	log.Tracef("Notifying forward event: %v over %v, %v", eventType, key,
 		info)
 
-	if err := h.ntfnServer.SendUpdate(event); err != nil {
+	if err := h.notify(event); err != nil {
 		log.Warnf("Unable to send forwarding event: %v", err)
 	}
 }
@@ -328,7 +600,7 @@ func (h *HtlcNotifier) NotifyLinkFailEvent(key HtlcKey, info HtlcInfo,
 	log.Tracef("Notifying link failure event: %v over %v, %v", eventType,
 		key, info)
 
-	if err := h.ntfnServer.SendUpdate(event); err != nil {
+	if err := h.notify(event); err != nil {
 		log.Warnf("Unable to send link fail event: %v", err)
 	}
 }
@@ -349,7 +621,7 @@ func (h *HtlcNotifier) NotifyForwardingFailEvent(key HtlcKey,
 	log.Tracef("Notifying forwarding failure event: %v over %v", eventType,
 		key)
 
-	if err := h.ntfnServer.SendUpdate(event); err != nil {
+	if err := h.notify(event); err != nil {
 		log.Warnf("Unable to send forwarding fail event: %v", err)
 	}
 }
@@ -367,7 +639,7 @@ func (h *HtlcNotifier) NotifySettleEvent(key HtlcKey, eventType HtlcEventType) {
 
 	log.Tracef("Notifying settle event: %v over %v", eventType, key)
 
-	if err := h.ntfnServer.SendUpdate(event); err != nil {
+	if err := h.notify(event); err != nil {
 		log.Warnf("Unable to send settle event: %v", err)
 	}
 }
@@ -0,0 +1,168 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHtlcNotifierReplay tests that htlc events are persisted with sequence
+// numbers, and that clients can replay the events they missed before they
+// receive live events.
+func TestHtlcNotifierReplay(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := channeldb.MakeTestDB()
+	require.NoError(t, err)
+	defer cleanUp()
+
+	now := time.Unix(1000, 0)
+	mockTime := func() time.Time {
+		return now
+	}
+
+	notifier := NewHtlcNotifier(mockTime, db.HtlcEventLog(100))
+	require.NoError(t, notifier.Start())
+	defer notifier.Stop()
+
+	key := HtlcKey{
+		IncomingCircuit: channeldb.CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(1),
+			HtlcID: 2,
+		},
+		OutgoingCircuit: channeldb.CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(3),
+			HtlcID: 4,
+		},
+	}
+	info := HtlcInfo{
+		IncomingTimeLock: 200,
+		OutgoingTimeLock: 160,
+		IncomingAmt:      1000,
+		OutgoingAmt:      900,
+	}
+
+	// Subscribe a live client, so that we know when events have been
+	// persisted.
+	live, err := notifier.SubscribeHtlcEvents(0, nil)
+	require.NoError(t, err)
+	defer live.Cancel()
+
+	receiveEvent := func() interface{} {
+		select {
+		case event := <-live.Updates():
+			return event
+
+		case <-time.After(time.Second):
+			t.Fatal("no htlc event received")
+			return nil
+		}
+	}
+
+	notifier.NotifyForwardingEvent(key, info, HtlcEventTypeForward)
+	notifier.NotifyLinkFailEvent(
+		key, info, HtlcEventTypeForward,
+		NewDetailedLinkError(
+			&lnwire.FailTemporaryNodeFailure{},
+			OutgoingFailureInsufficientBalance,
+		), false,
+	)
+	notifier.NotifyLinkFailEvent(
+		key, info, HtlcEventTypeReceive,
+		NewDetailedLinkError(
+			lnwire.NewFailIncorrectDetails(1000, 100),
+			invoices.ResultInvoiceNotFound,
+		), true,
+	)
+	notifier.NotifySettleEvent(key, HtlcEventTypeForward)
+
+	var events []interface{}
+	for i := 0; i < 4; i++ {
+		events = append(events, receiveEvent())
+	}
+
+	require.Equal(t, &ForwardingEvent{
+		Index:         1,
+		HtlcKey:       key,
+		HtlcInfo:      info,
+		HtlcEventType: HtlcEventTypeForward,
+		Timestamp:     now,
+	}, events[0])
+	require.Equal(t, uint64(4), events[3].(*SettleEvent).Index)
+
+	// A replay from the start returns all events, restored from the event
+	// log.
+	var replayed []interface{}
+	client, err := notifier.SubscribeHtlcEvents(
+		1, func(event interface{}) error {
+			replayed = append(replayed, event)
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	defer client.Cancel()
+
+	require.Equal(t, events[1:], replayed)
+
+	// Events after the replay are delivered live.
+	notifier.NotifyForwardingFailEvent(key, HtlcEventTypeForward)
+
+	select {
+	case event := <-client.Updates():
+		require.Equal(t, &ForwardingFailEvent{
+			Index:         5,
+			HtlcKey:       key,
+			HtlcEventType: HtlcEventTypeForward,
+			Timestamp:     now,
+		}, event)
+
+	case <-time.After(time.Second):
+		t.Fatal("no htlc event received")
+	}
+
+	// Without an event log, events can't be replayed.
+	noLog := NewHtlcNotifier(mockTime, nil)
+	require.NoError(t, noLog.Start())
+	defer noLog.Stop()
+
+	_, err = noLog.SubscribeHtlcEvents(1, nil)
+	require.Equal(t, ErrHtlcEventLogDisabled, err)
+}
+
+// TestHtlcNotifierFlushOnStop tests that the events that are still pending
+// when the notifier is stopped are persisted, and that events notified after
+// that are dropped.
+func TestHtlcNotifierFlushOnStop(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := channeldb.MakeTestDB()
+	require.NoError(t, err)
+	defer cleanUp()
+
+	eventLog := db.HtlcEventLog(1000)
+	notifier := NewHtlcNotifier(time.Now, eventLog)
+	require.NoError(t, notifier.Start())
+
+	// Notify more events than fit into a single batch, and stop the
+	// notifier right away.
+	const numEvents = 2*maxEventBatchSize + 1
+	for i := 0; i < numEvents; i++ {
+		notifier.NotifySettleEvent(HtlcKey{}, HtlcEventTypeForward)
+	}
+	notifier.Stop()
+
+	lastIndex, err := eventLog.LastIndex()
+	require.NoError(t, err)
+	require.EqualValues(t, numEvents, lastIndex)
+
+	// Events notified after the notifier was stopped are dropped.
+	notifier.NotifySettleEvent(HtlcKey{}, HtlcEventTypeForward)
+
+	lastIndex, err = eventLog.LastIndex()
+	require.NoError(t, err)
+	require.EqualValues(t, numEvents, lastIndex)
+}
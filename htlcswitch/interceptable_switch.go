@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/go-errors/errors"
+	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch/hop"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -15,18 +16,52 @@ var (
 	// ErrFwdNotExists is an error returned when the caller tries to resolve
 	// a forward that doesn't exist anymore.
 	ErrFwdNotExists = errors.New("forward does not exist")
+
+	// ErrUnsupportedFailureCode is returned when the caller tries to fail
+	// a forward with a failure code that can't be constructed by the
+	// switch.
+	ErrUnsupportedFailureCode = errors.New("unsupported failure code")
 )
 
+// InterceptableSwitchConfig contains the configuration of the
+// InterceptableSwitch.
+type InterceptableSwitchConfig struct {
+	// Switch is the underlying switch that forwards the packets that are
+	// not intercepted or that are resumed by the interceptor.
+	Switch *Switch
+
+	// Notifier is used to learn about new blocks, so that held forwards
+	// can be failed back before their incoming htlc expires.
+	Notifier chainntnfs.ChainNotifier
+
+	// CltvRejectDelta is the number of blocks before the expiry of the
+	// incoming htlc at which a held forward is failed back automatically.
+	CltvRejectDelta uint32
+
+	// RequireInterceptor indicates whether forwards must be held if no
+	// interceptor is registered. If set, forwards that are held when the
+	// interceptor disconnects remain held, and forwards that are replayed
+	// after a restart are held until an interceptor connects. All of them
+	// are presented to the next interceptor that registers.
+	RequireInterceptor bool
+}
+
 // InterceptableSwitch is an implementation of ForwardingSwitch interface.
 // This implementation is used like a proxy that wraps the switch and
 // intercepts forward requests. A reference to the Switch is held in order
 // to communicate back the interception result where the options are:
 // Resume - forwards the original request to the switch as is.
+// ResumeModified - forwards the request with a modified amount or channel.
 // Settle - routes UpdateFulfillHTLC to the originating link.
 // Fail - routes UpdateFailHTLC to the originating link.
 type InterceptableSwitch struct {
 	sync.RWMutex
 
+	started sync.Once
+	stopped sync.Once
+
+	cfg *InterceptableSwitchConfig
+
 	// htlcSwitch is the underline switch
 	htlcSwitch *Switch
 
@@ -34,20 +69,109 @@ type InterceptableSwitch struct {
 	// an incoming htlc. It should return true if it is interested in handling
 	// it.
 	fwdInterceptor ForwardInterceptor
+
+	// heldForwards contains all forwards that are currently held, keyed by
+	// their incoming circuit. They outlive the interceptor that they were
+	// presented to, so that they can be presented again when a new
+	// interceptor registers.
+	heldForwards map[channeldb.CircuitKey]*interceptedForward
+
+	wg   sync.WaitGroup
+	quit chan struct{}
 }
 
 // NewInterceptableSwitch returns an instance of InterceptableSwitch.
-func NewInterceptableSwitch(s *Switch) *InterceptableSwitch {
-	return &InterceptableSwitch{htlcSwitch: s}
+func NewInterceptableSwitch(cfg *InterceptableSwitchConfig) *InterceptableSwitch {
+	return &InterceptableSwitch{
+		cfg:          cfg,
+		htlcSwitch:   cfg.Switch,
+		heldForwards: make(map[channeldb.CircuitKey]*interceptedForward),
+		quit:         make(chan struct{}),
+	}
+}
+
+// Start starts the goroutine that fails back held forwards that are close to
+// their expiry.
+func (s *InterceptableSwitch) Start() error {
+	var err error
+	s.started.Do(func() {
+		var blockEpochs *chainntnfs.BlockEpochEvent
+		blockEpochs, err = s.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+		if err != nil {
+			return
+		}
+
+		s.wg.Add(1)
+		go s.expiryWatcher(blockEpochs)
+	})
+
+	return err
+}
+
+// Stop signals all goroutines to exit and waits for them to do so.
+func (s *InterceptableSwitch) Stop() error {
+	s.stopped.Do(func() {
+		close(s.quit)
+		s.wg.Wait()
+	})
+
+	return nil
 }
 
-// SetInterceptor sets the ForwardInterceptor to be used.
+// SetInterceptor sets the ForwardInterceptor to be used. All forwards that are
+// currently held are presented to the new interceptor. If the interceptor is
+// unset and no interceptor is required, held forwards are resumed.
 func (s *InterceptableSwitch) SetInterceptor(
 	interceptor ForwardInterceptor) {
 
 	s.Lock()
-	defer s.Unlock()
 	s.fwdInterceptor = interceptor
+
+	held := make([]*interceptedForward, 0, len(s.heldForwards))
+	for _, fwd := range s.heldForwards {
+		held = append(held, fwd)
+	}
+
+	resumeHeld := interceptor == nil && !s.cfg.RequireInterceptor
+	if resumeHeld {
+		s.heldForwards = make(
+			map[channeldb.CircuitKey]*interceptedForward,
+		)
+	}
+	s.Unlock()
+
+	switch {
+	// Without an interceptor, we resume the default behavior for all held
+	// forwards.
+	case resumeHeld:
+		if len(held) > 0 {
+			log.Infof("Interceptor disconnected, resuming %d held "+
+				"forwards", len(held))
+		}
+
+		for _, fwd := range held {
+			if err := fwd.resume(nil, nil); err != nil {
+				log.Errorf("Failed to resume held forward %v: %v",
+					fwd.packet.inKey(), err)
+			}
+		}
+
+	// Present the forwards that are still held to the new interceptor. This
+	// is done in a goroutine, as the interceptor may only start processing
+	// forwards after it has been registered.
+	case interceptor != nil && len(held) > 0:
+		log.Infof("Presenting %d held forwards to new interceptor",
+			len(held))
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+
+			for _, fwd := range held {
+				interceptor(fwd)
+			}
+		}()
+	}
 }
 
 // ForwardPackets attempts to forward the batch of htlcs through the
@@ -57,19 +181,9 @@ func (s *InterceptableSwitch) SetInterceptor(
 func (s *InterceptableSwitch) ForwardPackets(linkQuit chan struct{},
 	packets ...*htlcPacket) error {
 
-	var interceptor ForwardInterceptor
-	s.Lock()
-	interceptor = s.fwdInterceptor
-	s.Unlock()
-
-	// Optimize for the case we don't have an interceptor.
-	if interceptor == nil {
-		return s.htlcSwitch.ForwardPackets(linkQuit, packets...)
-	}
-
 	var notIntercepted []*htlcPacket
 	for _, p := range packets {
-		if !s.interceptForward(p, interceptor, linkQuit) {
+		if !s.interceptForward(p, linkQuit) {
 			notIntercepted = append(notIntercepted, p)
 		}
 	}
@@ -81,26 +195,160 @@ func (s *InterceptableSwitch) ForwardPackets(linkQuit chan struct{},
 // are being checked for interception. It can be extended in the future given
 // the right use case.
 func (s *InterceptableSwitch) interceptForward(packet *htlcPacket,
-	interceptor ForwardInterceptor, linkQuit chan struct{}) bool {
+	linkQuit chan struct{}) bool {
+
+	htlc, ok := packet.htlc.(*lnwire.UpdateAddHTLC)
+	if !ok {
+		return false
+	}
+
+	// We are not interested in intercepting initated payments.
+	if packet.incomingChanID == hop.Source {
+		return false
+	}
+
+	intercepted := &interceptedForward{
+		linkQuit:      linkQuit,
+		htlc:          htlc,
+		packet:        packet,
+		htlcSwitch:    s.htlcSwitch,
+		interceptable: s,
+	}
+	inKey := packet.inKey()
+
+	s.Lock()
+
+	// If we already hold this forward, the incoming link replayed it, for
+	// example after a reconnect. We replace the held forward so that it
+	// is resolved through the new link, but don't present it again.
+	if _, ok := s.heldForwards[inKey]; ok {
+		s.heldForwards[inKey] = intercepted
+		s.Unlock()
+
+		return true
+	}
+
+	interceptor := s.fwdInterceptor
+	if interceptor == nil && !s.cfg.RequireInterceptor {
+		s.Unlock()
+		return false
+	}
+
+	// Don't hold forwards that would need to be failed back right away to
+	// protect the incoming htlc.
+	if s.isExpiring(packet, s.htlcSwitch.BestHeight()) {
+		s.Unlock()
+
+		log.Debugf("Failing forward %v that is too close to expiry "+
+			"to be intercepted", inKey)
+
+		err := intercepted.failWithCode(lnwire.CodeTemporaryChannelFailure)
+		if err != nil {
+			log.Errorf("Failed to fail forward %v: %v", inKey, err)
+		}
+
+		return true
+	}
+
+	s.heldForwards[inKey] = intercepted
+	s.Unlock()
+
+	// Without an interceptor, the forward is held until one registers.
+	if interceptor == nil {
+		log.Debugf("Holding forward %v until an interceptor registers",
+			inKey)
+
+		return true
+	}
+
+	if interceptor(intercepted) || s.cfg.RequireInterceptor {
+		return true
+	}
+
+	// The interceptor couldn't take the forward, so we execute the default
+	// behavior unless we've already lost the forward to a resolution.
+	_, err := s.release(inKey)
+	return err != nil
+}
+
+// isExpiring returns true if the incoming htlc of the given packet is close
+// enough to its expiry that a held forward must be failed back.
+func (s *InterceptableSwitch) isExpiring(packet *htlcPacket,
+	height uint32) bool {
+
+	return packet.incomingTimeout <= height+s.cfg.CltvRejectDelta
+}
+
+// release removes the forward with the given incoming circuit from the set of
+// held forwards and returns it. The returned forward may be more recent than
+// the one that the caller holds if the packet was replayed in the meantime.
+func (s *InterceptableSwitch) release(
+	inKey channeldb.CircuitKey) (*interceptedForward, error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	fwd, ok := s.heldForwards[inKey]
+	if !ok {
+		return nil, ErrFwdNotExists
+	}
+	delete(s.heldForwards, inKey)
+
+	return fwd, nil
+}
+
+// expiryWatcher fails back held forwards whose incoming htlc is about to
+// expire, so that they don't hang until the incoming channel is force closed.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (s *InterceptableSwitch) expiryWatcher(
+	blockEpochs *chainntnfs.BlockEpochEvent) {
+
+	defer s.wg.Done()
+	defer blockEpochs.Cancel()
 
-	switch htlc := packet.htlc.(type) {
-	case *lnwire.UpdateAddHTLC:
-		// We are not interested in intercepting initated payments.
-		if packet.incomingChanID == hop.Source {
-			return false
+	for {
+		select {
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return
+			}
+
+			s.failExpiring(uint32(epoch.Height))
+
+		case <-s.quit:
+			return
 		}
+	}
+}
+
+// failExpiring fails back all held forwards that are close to expiry at the
+// given height.
+func (s *InterceptableSwitch) failExpiring(height uint32) {
+	var expiring []*interceptedForward
 
-		intercepted := &interceptedForward{
-			linkQuit:   linkQuit,
-			htlc:       htlc,
-			packet:     packet,
-			htlcSwitch: s.htlcSwitch,
+	s.Lock()
+	for inKey, fwd := range s.heldForwards {
+		if !s.isExpiring(fwd.packet, height) {
+			continue
 		}
 
-		// If this htlc was intercepted, don't handle the forward.
-		return interceptor(intercepted)
-	default:
-		return false
+		delete(s.heldForwards, inKey)
+		expiring = append(expiring, fwd)
+	}
+	s.Unlock()
+
+	for _, fwd := range expiring {
+		inKey := fwd.packet.inKey()
+
+		log.Infof("Auto-failing held forward %v at height %v, incoming "+
+			"expiry %v", inKey, height, fwd.packet.incomingTimeout)
+
+		err := fwd.failWithCode(lnwire.CodeTemporaryChannelFailure)
+		if err != nil {
+			log.Errorf("Failed to fail held forward %v: %v",
+				inKey, err)
+		}
 	}
 }
 
@@ -108,10 +356,11 @@ func (s *InterceptableSwitch) interceptForward(packet *htlcPacket,
 // It is passed from the switch to external interceptors that are interested
 // in holding forwards and resolve them manually.
 type interceptedForward struct {
-	linkQuit   chan struct{}
-	htlc       *lnwire.UpdateAddHTLC
-	packet     *htlcPacket
-	htlcSwitch *Switch
+	linkQuit      chan struct{}
+	htlc          *lnwire.UpdateAddHTLC
+	packet        *htlcPacket
+	htlcSwitch    *Switch
+	interceptable *InterceptableSwitch
 }
 
 // Packet returns the intercepted htlc packet.
@@ -134,12 +383,181 @@ func (f *interceptedForward) Packet() InterceptedPacket {
 
 // Resume resumes the default behavior as if the packet was not intercepted.
 func (f *interceptedForward) Resume() error {
+	return f.ResumeModified(nil, nil)
+}
+
+// ResumeModified resumes the default behavior with a different outgoing
+// channel or amount. A nil value leaves the respective field unchanged. Only
+// channels with the same peer as the original outgoing channel can be chosen,
+// as the onion for the next hop can't be processed by any other peer.
+func (f *interceptedForward) ResumeModified(
+	outgoingChanID *lnwire.ShortChannelID,
+	outgoingAmt *lnwire.MilliSatoshi) error {
+
+	if outgoingAmt != nil && *outgoingAmt > f.packet.incomingAmount {
+		return fmt.Errorf("outgoing amount %v exceeds incoming "+
+			"amount %v", *outgoingAmt, f.packet.incomingAmount)
+	}
+
+	if outgoingChanID != nil &&
+		*outgoingChanID != f.packet.outgoingChanID {
+
+		origPeer, err := f.htlcSwitch.linkPeerByShortID(
+			f.packet.outgoingChanID,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to find peer of outgoing "+
+				"channel %v: %v", f.packet.outgoingChanID, err)
+		}
+
+		peer, err := f.htlcSwitch.linkPeerByShortID(*outgoingChanID)
+		if err != nil {
+			return fmt.Errorf("unable to find peer of outgoing "+
+				"channel %v: %v", *outgoingChanID, err)
+		}
+
+		if peer != origPeer {
+			return fmt.Errorf("outgoing channel %v is not with "+
+				"peer %x of the next hop", *outgoingChanID,
+				origPeer)
+		}
+	}
+
+	fwd, err := f.interceptable.release(f.packet.inKey())
+	if err != nil {
+		return err
+	}
+
+	return fwd.resume(outgoingChanID, outgoingAmt)
+}
+
+// resume forwards the packet to the switch, optionally modifying the outgoing
+// channel and amount.
+func (f *interceptedForward) resume(outgoingChanID *lnwire.ShortChannelID,
+	outgoingAmt *lnwire.MilliSatoshi) error {
+
+	if outgoingChanID != nil {
+		f.packet.outgoingChanID = *outgoingChanID
+	}
+	if outgoingAmt != nil {
+		f.htlc.Amount = *outgoingAmt
+		f.packet.amount = *outgoingAmt
+	}
+
 	return f.htlcSwitch.ForwardPackets(f.linkQuit, f.packet)
 }
 
-// Fail forward a failed packet to the switch.
+// Fail forward a failed packet to the switch. The htlc is failed with a
+// temporary channel failure.
 func (f *interceptedForward) Fail() error {
-	reason, err := f.packet.obfuscator.EncryptFirstHop(lnwire.NewTemporaryChannelFailure(nil))
+	return f.FailWithCode(lnwire.CodeTemporaryChannelFailure)
+}
+
+// FailWithCode fails the forward with a failure message that is constructed
+// by the switch from the given failure code.
+func (f *interceptedForward) FailWithCode(code lnwire.FailCode) error {
+	// Make sure that we are able to construct the failure before the
+	// forward is released.
+	if _, err := f.failureFromCode(code); err != nil {
+		return err
+	}
+
+	fwd, err := f.interceptable.release(f.packet.inKey())
+	if err != nil {
+		return err
+	}
+
+	return fwd.failWithCode(code)
+}
+
+// FailWithMessage fails the forward with the given failure message.
+func (f *interceptedForward) FailWithMessage(
+	failure lnwire.FailureMessage) error {
+
+	fwd, err := f.interceptable.release(f.packet.inKey())
+	if err != nil {
+		return err
+	}
+
+	return fwd.fail(failure)
+}
+
+// failWithCode fails the forward with a failure message that is constructed
+// from the given failure code.
+func (f *interceptedForward) failWithCode(code lnwire.FailCode) error {
+	failure, err := f.failureFromCode(code)
+	if err != nil {
+		return err
+	}
+
+	return f.fail(failure)
+}
+
+// failureFromCode constructs the failure message for the given failure code.
+// Failures that carry a channel update use the latest update of the outgoing
+// channel.
+func (f *interceptedForward) failureFromCode(
+	code lnwire.FailCode) (lnwire.FailureMessage, error) {
+
+	fetchUpdate := func() (*lnwire.ChannelUpdate, error) {
+		return f.htlcSwitch.cfg.FetchLastChannelUpdate(
+			f.packet.outgoingChanID,
+		)
+	}
+
+	switch code {
+	case lnwire.CodeTemporaryChannelFailure:
+		return lnwire.NewTemporaryChannelFailure(nil), nil
+
+	case lnwire.CodeTemporaryNodeFailure:
+		return &lnwire.FailTemporaryNodeFailure{}, nil
+
+	case lnwire.CodePermanentNodeFailure:
+		return &lnwire.FailPermanentNodeFailure{}, nil
+
+	case lnwire.CodePermanentChannelFailure:
+		return &lnwire.FailPermanentChannelFailure{}, nil
+
+	case lnwire.CodeUnknownNextPeer:
+		return &lnwire.FailUnknownNextPeer{}, nil
+
+	case lnwire.CodeIncorrectOrUnknownPaymentDetails:
+		return lnwire.NewFailIncorrectDetails(
+			f.packet.incomingAmount, f.htlcSwitch.BestHeight(),
+		), nil
+
+	case lnwire.CodeExpiryTooSoon:
+		update, err := fetchUpdate()
+		if err != nil {
+			return nil, err
+		}
+		return lnwire.NewExpiryTooSoon(*update), nil
+
+	case lnwire.CodeFeeInsufficient:
+		update, err := fetchUpdate()
+		if err != nil {
+			return nil, err
+		}
+		return lnwire.NewFeeInsufficient(f.htlc.Amount, *update), nil
+
+	case lnwire.CodeIncorrectCltvExpiry:
+		update, err := fetchUpdate()
+		if err != nil {
+			return nil, err
+		}
+		return lnwire.NewIncorrectCltvExpiry(f.htlc.Expiry, *update),
+			nil
+
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFailureCode,
+			code)
+	}
+}
+
+// fail encrypts the failure message for the sender and routes it back to the
+// incoming link.
+func (f *interceptedForward) fail(failure lnwire.FailureMessage) error {
+	reason, err := f.packet.obfuscator.EncryptFirstHop(failure)
 	if err != nil {
 		return fmt.Errorf("failed to encrypt failure reason %v", err)
 	}
@@ -153,7 +571,13 @@ func (f *interceptedForward) Settle(preimage lntypes.Preimage) error {
 	if !preimage.Matches(f.htlc.PaymentHash) {
 		return errors.New("preimage does not match hash")
 	}
-	return f.resolve(&lnwire.UpdateFulfillHTLC{
+
+	fwd, err := f.interceptable.release(f.packet.inKey())
+	if err != nil {
+		return err
+	}
+
+	return fwd.resolve(&lnwire.UpdateFulfillHTLC{
 		PaymentPreimage: preimage,
 	})
 }
@@ -0,0 +1,363 @@
+package htlcswitch
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lntest/mock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// interceptableSwitchTestContext contains a switch with two links that
+// forwards through an interceptable switch.
+type interceptableSwitchTestContext struct {
+	t *testing.T
+
+	s            *Switch
+	interceptor  *InterceptableSwitch
+	notifier     *mock.ChainNotifier
+	aliceLink    *mockChannelLink
+	bobLink      *mockChannelLink
+	linkQuit     chan struct{}
+	intercepted  chan InterceptedForward
+	nextHtlcID   uint64
+	nextPreimage byte
+}
+
+// newInterceptableSwitchTestContext creates a new test context. The returned
+// interceptable switch doesn't have an interceptor registered.
+func newInterceptableSwitchTestContext(t *testing.T,
+	requireInterceptor bool) *interceptableSwitchTestContext {
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	alicePeer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+	bobPeer, err := newMockServer(
+		t, "bob", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	t.Cleanup(func() {
+		require.NoError(t, s.Stop())
+	})
+
+	aliceLink := newMockChannelLink(s, chanID1, aliceChanID, alicePeer, true)
+	bobLink := newMockChannelLink(s, chanID2, bobChanID, bobPeer, true)
+	require.NoError(t, s.AddLink(aliceLink))
+	require.NoError(t, s.AddLink(bobLink))
+
+	notifier := &mock.ChainNotifier{
+		EpochChan: make(chan *chainntnfs.BlockEpoch),
+	}
+	interceptor := NewInterceptableSwitch(&InterceptableSwitchConfig{
+		Switch:             s,
+		Notifier:           notifier,
+		CltvRejectDelta:    10,
+		RequireInterceptor: requireInterceptor,
+	})
+	require.NoError(t, interceptor.Start())
+	t.Cleanup(func() {
+		require.NoError(t, interceptor.Stop())
+	})
+
+	return &interceptableSwitchTestContext{
+		t:           t,
+		s:           s,
+		interceptor: interceptor,
+		notifier:    notifier,
+		aliceLink:   aliceLink,
+		bobLink:     bobLink,
+		linkQuit:    make(chan struct{}),
+		intercepted: make(chan InterceptedForward, 10),
+	}
+}
+
+// setInterceptor registers an interceptor that delivers all intercepted
+// forwards on the intercepted channel of the test context.
+func (c *interceptableSwitchTestContext) setInterceptor() {
+	c.interceptor.SetInterceptor(func(fwd InterceptedForward) bool {
+		c.intercepted <- fwd
+		return true
+	})
+}
+
+// forward sends a new htlc from alice to bob through the interceptable
+// switch.
+func (c *interceptableSwitchTestContext) forward(
+	incomingTimeout uint32) *htlcPacket {
+
+	c.nextPreimage++
+	preimage := [sha256.Size]byte{c.nextPreimage}
+
+	packet := &htlcPacket{
+		incomingChanID:  c.aliceLink.ShortChanID(),
+		incomingHTLCID:  c.nextHtlcID,
+		outgoingChanID:  c.bobLink.ShortChanID(),
+		obfuscator:      NewMockObfuscator(),
+		incomingAmount:  1000,
+		amount:          900,
+		incomingTimeout: incomingTimeout,
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: sha256.Sum256(preimage[:]),
+			Amount:      900,
+		},
+	}
+	c.nextHtlcID++
+
+	require.NoError(c.t, c.interceptor.ForwardPackets(c.linkQuit, packet))
+
+	return packet
+}
+
+// receiveIntercepted asserts that a forward is presented to the interceptor.
+func (c *interceptableSwitchTestContext) receiveIntercepted() InterceptedForward {
+	select {
+	case fwd := <-c.intercepted:
+		return fwd
+
+	case <-time.After(time.Second):
+		c.t.Fatal("forward not intercepted")
+		return nil
+	}
+}
+
+// assertNoPacket asserts that the link doesn't receive a packet.
+func (c *interceptableSwitchTestContext) assertNoPacket(link *mockChannelLink) {
+	select {
+	case <-link.packets:
+		c.t.Fatal("unexpected packet")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// receiveFailure asserts that alice's link receives a failure and returns the
+// failure message.
+func (c *interceptableSwitchTestContext) receiveFailure() lnwire.FailureMessage {
+	select {
+	case pkt := <-c.aliceLink.packets:
+		require.IsType(c.t, &lnwire.UpdateFailHTLC{}, pkt.htlc)
+		return pkt.obfuscator.(*mockObfuscator).failure
+
+	case <-time.After(time.Second):
+		c.t.Fatal("no failure received")
+		return nil
+	}
+}
+
+// TestInterceptableSwitchFailure asserts that held forwards can be failed
+// with an explicit failure message or failure code.
+func TestInterceptableSwitchFailure(t *testing.T) {
+	t.Parallel()
+
+	c := newInterceptableSwitchTestContext(t, false)
+	c.setInterceptor()
+
+	// Fail with an explicit failure message.
+	c.forward(testStartingHeight + 100)
+	fwd := c.receiveIntercepted()
+	require.NoError(t, fwd.FailWithMessage(&lnwire.FailUnknownNextPeer{}))
+	require.Equal(t, &lnwire.FailUnknownNextPeer{}, c.receiveFailure())
+
+	// The forward is released after it has been resolved.
+	require.Equal(t, ErrFwdNotExists, fwd.Resume())
+
+	// Fail with a failure code.
+	c.forward(testStartingHeight + 100)
+	fwd = c.receiveIntercepted()
+	require.NoError(t, fwd.FailWithCode(lnwire.CodeTemporaryNodeFailure))
+	require.Equal(t, &lnwire.FailTemporaryNodeFailure{}, c.receiveFailure())
+
+	// Failure codes that can't be constructed don't release the forward.
+	c.forward(testStartingHeight + 100)
+	fwd = c.receiveIntercepted()
+	require.ErrorIs(
+		t, fwd.FailWithCode(lnwire.CodeInvalidRealm),
+		ErrUnsupportedFailureCode,
+	)
+	require.NoError(t, fwd.Fail())
+	require.Equal(
+		t, lnwire.NewTemporaryChannelFailure(nil), c.receiveFailure(),
+	)
+	c.assertNoPacket(c.bobLink)
+}
+
+// TestInterceptableSwitchResumeModified asserts that held forwards can be
+// resumed with a modified outgoing amount.
+func TestInterceptableSwitchResumeModified(t *testing.T) {
+	t.Parallel()
+
+	c := newInterceptableSwitchTestContext(t, false)
+	c.setInterceptor()
+
+	c.forward(testStartingHeight + 100)
+	fwd := c.receiveIntercepted()
+
+	// The outgoing amount can't exceed the incoming amount.
+	tooMuch := lnwire.MilliSatoshi(1001)
+	require.Error(t, fwd.ResumeModified(nil, &tooMuch))
+
+	amt := lnwire.MilliSatoshi(800)
+	require.NoError(t, fwd.ResumeModified(nil, &amt))
+
+	select {
+	case pkt := <-c.bobLink.packets:
+		require.Equal(t, amt, pkt.amount)
+		require.Equal(t, amt, pkt.htlc.(*lnwire.UpdateAddHTLC).Amount)
+
+	case <-time.After(time.Second):
+		t.Fatal("forward not resumed")
+	}
+}
+
+// TestInterceptableSwitchResumeModifiedChannel asserts that held forwards can
+// only be resumed through a different channel with the same peer.
+func TestInterceptableSwitchResumeModifiedChannel(t *testing.T) {
+	t.Parallel()
+
+	c := newInterceptableSwitchTestContext(t, false)
+	c.setInterceptor()
+
+	carolPeer, err := newMockServer(
+		t, "carol", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+
+	bobChanID2, bobShortChanID2 := genID()
+	bobLink2 := newMockChannelLink(
+		c.s, bobChanID2, bobShortChanID2, c.bobLink.Peer(), true,
+	)
+	carolChanID, carolShortChanID := genID()
+	carolLink := newMockChannelLink(
+		c.s, carolChanID, carolShortChanID, carolPeer, true,
+	)
+	require.NoError(t, c.s.AddLink(bobLink2))
+	require.NoError(t, c.s.AddLink(carolLink))
+
+	c.forward(testStartingHeight + 100)
+	fwd := c.receiveIntercepted()
+
+	// Neither a channel with a different peer nor an unknown channel can
+	// be chosen. The forward remains held.
+	require.Error(t, fwd.ResumeModified(&carolShortChanID, nil))
+
+	unknownChanID := lnwire.NewShortChanIDFromInt(12345)
+	require.Error(t, fwd.ResumeModified(&unknownChanID, nil))
+	c.assertNoPacket(carolLink)
+
+	// A different channel with the same peer can be chosen. The switch
+	// may still pick any of the peer's channels to forward over.
+	require.NoError(t, fwd.ResumeModified(&bobShortChanID2, nil))
+
+	select {
+	case <-bobLink2.packets:
+	case <-c.bobLink.packets:
+	case <-time.After(time.Second):
+		t.Fatal("forward not resumed")
+	}
+}
+
+// TestInterceptableSwitchRequireInterceptor asserts that forwards are held
+// without an interceptor if one is required, and that they are presented again
+// after the interceptor reconnects.
+func TestInterceptableSwitchRequireInterceptor(t *testing.T) {
+	t.Parallel()
+
+	c := newInterceptableSwitchTestContext(t, true)
+
+	// Without an interceptor, the forward is held.
+	c.forward(testStartingHeight + 100)
+	c.assertNoPacket(c.bobLink)
+
+	// Once an interceptor connects, the forward is presented to it.
+	c.setInterceptor()
+	first := c.receiveIntercepted()
+
+	// After a disconnect, the forward remains held and is presented to the
+	// next interceptor.
+	c.interceptor.SetInterceptor(nil)
+	c.assertNoPacket(c.bobLink)
+
+	c.setInterceptor()
+	second := c.receiveIntercepted()
+	require.Equal(t, first.Packet(), second.Packet())
+
+	require.NoError(t, second.Resume())
+	select {
+	case <-c.bobLink.packets:
+	case <-time.After(time.Second):
+		t.Fatal("forward not resumed")
+	}
+
+	// The original forward was resolved along with the presented one.
+	require.Equal(t, ErrFwdNotExists, first.Resume())
+}
+
+// TestInterceptableSwitchResumeOnDisconnect asserts that held forwards are
+// resumed when the interceptor disconnects and no interceptor is required.
+func TestInterceptableSwitchResumeOnDisconnect(t *testing.T) {
+	t.Parallel()
+
+	c := newInterceptableSwitchTestContext(t, false)
+
+	// Without an interceptor, forwards aren't held.
+	c.forward(testStartingHeight + 100)
+	select {
+	case <-c.bobLink.packets:
+	case <-time.After(time.Second):
+		t.Fatal("forward not forwarded")
+	}
+
+	c.setInterceptor()
+	c.forward(testStartingHeight + 100)
+	c.receiveIntercepted()
+	c.assertNoPacket(c.bobLink)
+
+	c.interceptor.SetInterceptor(nil)
+	select {
+	case <-c.bobLink.packets:
+	case <-time.After(time.Second):
+		t.Fatal("forward not resumed")
+	}
+}
+
+// TestInterceptableSwitchExpiry asserts that held forwards are failed back
+// before their incoming htlc expires.
+func TestInterceptableSwitchExpiry(t *testing.T) {
+	t.Parallel()
+
+	c := newInterceptableSwitchTestContext(t, false)
+	c.setInterceptor()
+
+	// A forward that is already too close to its expiry isn't held.
+	c.forward(testStartingHeight + 10)
+	require.Equal(
+		t, lnwire.NewTemporaryChannelFailure(nil), c.receiveFailure(),
+	)
+
+	c.forward(testStartingHeight + 20)
+	fwd := c.receiveIntercepted()
+
+	// One block before the reject delta is reached, nothing happens.
+	c.notifier.EpochChan <- &chainntnfs.BlockEpoch{
+		Height: testStartingHeight + 9,
+	}
+	c.assertNoPacket(c.aliceLink)
+
+	// Once the reject delta is reached, the forward is failed back.
+	c.notifier.EpochChan <- &chainntnfs.BlockEpoch{
+		Height: testStartingHeight + 10,
+	}
+	require.Equal(
+		t, lnwire.NewTemporaryChannelFailure(nil), c.receiveFailure(),
+	)
+	require.Equal(t, ErrFwdNotExists, fwd.Resume())
+}
@@ -41,6 +41,27 @@ type InvoiceDatabase interface {
 	HodlUnsubscribeAll(subscriber chan<- interface{})
 }
 
+// TrampolineForwarder is an interface that represents a sub-system which is
+// able to forward exit hop htlcs that carry a trampoline onion towards their
+// next destination by paying it on behalf of the sender.
+type TrampolineForwarder interface {
+	// ForwardTrampolineHtlc starts forwarding the htlc according to the
+	// instructions in the trampoline onion. Htlcs that carry an mpp
+	// record are collected until the set is complete. If the htlc can be
+	// resolved immediately, the resolution is returned. Otherwise nil is
+	// returned and the resolution is sent on the passed in hodlChan once
+	// the onward payment completes. This method may be called multiple
+	// times for the same htlc, for example after a restart, in which case
+	// the onward payment is not started again.
+	ForwardTrampolineHtlc(payHash lntypes.Hash, amt lnwire.MilliSatoshi,
+		expiry uint32, currentHeight uint32,
+		circuitKey channeldb.CircuitKey, onion []byte, mpp *record.MPP,
+		hodlChan chan<- interface{}) (invoices.HtlcResolution, error)
+
+	// UnsubscribeAll unsubscribes from all trampoline htlc resolutions.
+	UnsubscribeAll(subscriber chan<- interface{})
+}
+
 // ChannelLink is an interface which represents the subsystem for managing the
 // incoming htlc requests, applying the changes to the channel, and also
 // propagating/forwarding it to htlc switch.
@@ -109,11 +130,14 @@ type ChannelLink interface {
 	// satisfy the current forwarding policy fo the target link. Otherwise,
 	// a LinkError with a valid protocol failure message should be returned
 	// in order to signal to the source of the HTLC, the policy consistency
-	// issue.
+	// issue. The inbound fee is the fee charged by the incoming link, which
+	// is added to the fee required by the target link. If the inbound fee
+	// isn't paid, the failure carries the update of the incoming channel.
 	CheckHtlcForward(payHash [32]byte, incomingAmt lnwire.MilliSatoshi,
 		amtToForward lnwire.MilliSatoshi,
 		incomingTimeout, outgoingTimeout uint32,
-		heightNow uint32) *LinkError
+		incomingChanID lnwire.ShortChannelID,
+		inboundFee channeldb.InboundFee, heightNow uint32) *LinkError
 
 	// CheckHtlcTransit should return a nil error if the passed HTLC details
 	// satisfy the current channel policy.  Otherwise, a LinkError with a
@@ -241,7 +265,7 @@ type InterceptedPacket struct {
 // htlc. It contains all the information about the packet which accordingly
 // the interceptor decides if to hold or not.
 // In addition this interface allows a later resolution by calling either
-// Resume, Settle or Fail.
+// Resume, ResumeModified, Settle or one of the Fail methods.
 type InterceptedForward interface {
 	// Packet returns the intercepted packet.
 	Packet() InterceptedPacket
@@ -251,12 +275,48 @@ type InterceptedForward interface {
 	// this htlc which usually means forward it.
 	Resume() error
 
+	// ResumeModified notifies the intention to resume an existing hold
+	// forward with a different outgoing channel or amount. A nil value
+	// leaves the respective field unchanged. The outgoing amount may not
+	// exceed the incoming amount, and the outgoing channel must be with
+	// the same peer as the original outgoing channel.
+	ResumeModified(outgoingChanID *lnwire.ShortChannelID,
+		outgoingAmt *lnwire.MilliSatoshi) error
+
 	// Settle notifies the intention to settle an existing hold
 	// forward with a given preimage.
 	Settle(lntypes.Preimage) error
 
 	// Fails notifies the intention to fail an existing hold forward
+	// with a temporary channel failure.
 	Fail() error
+
+	// FailWithCode notifies the intention to fail an existing hold
+	// forward with a failure message that is constructed for the given
+	// failure code. ErrUnsupportedFailureCode is returned for failure
+	// codes that the switch can't construct a message for.
+	FailWithCode(code lnwire.FailCode) error
+
+	// FailWithMessage notifies the intention to fail an existing hold
+	// forward with the given failure message.
+	FailWithMessage(failure lnwire.FailureMessage) error
+}
+
+// HtlcEventLog is an interface which represents the persistent log of the
+// htlc events that the htlc notifier reports.
+type HtlcEventLog interface {
+	// AddEvents adds a series of events to the log and assigns them their
+	// sequence numbers.
+	AddEvents(events []*channeldb.HtlcEvent) error
+
+	// FetchEvents returns up to maxEvents events with a sequence number
+	// larger than afterIndex, ordered by their sequence number.
+	FetchEvents(afterIndex uint64,
+		maxEvents uint32) ([]*channeldb.HtlcEvent, error)
+
+	// LastIndex returns the sequence number of the last event that was
+	// added to the log.
+	LastIndex() (uint64, error)
 }
 
 // htlcNotifier is an interface which represents the input side of the
@@ -26,6 +26,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/queue"
+	"github.com/lightningnetwork/lnd/record"
 	"github.com/lightningnetwork/lnd/ticker"
 )
 
@@ -60,6 +61,12 @@ const (
 	// a channel's commitment fee to be of its balance. This only applies to
 	// the initiator of the channel.
 	DefaultMaxLinkFeeAllocation float64 = 0.5
+
+	// DefaultMaxDustExposure is the default maximum total value of dust
+	// htlcs that a channel may carry on either of its commitments. Dust
+	// htlcs are not paid out on chain, so this value is lost to the miners
+	// if the channel is force closed. It amounts to 500k sat.
+	DefaultMaxDustExposure = lnwire.MilliSatoshi(500000000)
 )
 
 // ForwardingPolicy describes the set of constraints that a given ChannelLink
@@ -97,6 +104,11 @@ type ForwardingPolicy struct {
 	//    per-hop payload of the incoming HTLC's onion packet.
 	TimeLockDelta uint32
 
+	// InboundFee is the fee that must be paid for incoming HTLCs that
+	// arrive over this channel. It is charged on top of the fee of the
+	// outgoing channel and may be negative to offer a discount.
+	InboundFee channeldb.InboundFee
+
 	// TODO(roasbeef): add fee module inside of switch
 }
 
@@ -167,6 +179,11 @@ type ChannelLinkConfig struct {
 	// in thread-safe manner.
 	Registry InvoiceDatabase
 
+	// TrampolineForwarder is the sub-system that forwards exit hop htlcs
+	// carrying trampoline instructions towards their next destination. If
+	// nil, such htlcs are rejected.
+	TrampolineForwarder TrampolineForwarder
+
 	// PreimageCache is a global witness beacon that houses any new
 	// preimages discovered by other links. We'll use this to add new
 	// witnesses that we discover which will notify any sub-systems
@@ -273,6 +290,12 @@ type ChannelLinkConfig struct {
 	// the initiator for channels of the anchor type.
 	MaxAnchorsCommitFeeRate chainfee.SatPerKWeight
 
+	// MaxDustExposure is the maximum total value of dust htlcs that the
+	// link allows on either commitment of the channel. Incoming and
+	// outgoing htlcs as well as fee updates that would exceed it are
+	// rejected. A value of zero disables the limit.
+	MaxDustExposure lnwire.MilliSatoshi
+
 	// NotifyActiveLink allows the link to tell the ChannelNotifier when a
 	// link is first started.
 	NotifyActiveLink func(wire.OutPoint)
@@ -516,6 +539,11 @@ func (l *channelLink) Stop() {
 	// resolutions coming from the invoice registry.
 	l.cfg.Registry.HodlUnsubscribeAll(l.hodlQueue.ChanIn())
 
+	// The same applies to resolutions of trampoline htlcs.
+	if l.cfg.TrampolineForwarder != nil {
+		l.cfg.TrampolineForwarder.UnsubscribeAll(l.hodlQueue.ChanIn())
+	}
+
 	if l.cfg.ChainEvents.Cancel != nil {
 		l.cfg.ChainEvents.Cancel()
 	}
@@ -627,7 +655,16 @@ func shouldAdjustCommitFee(netFee, chanFee chainfee.SatPerKWeight) bool {
 func (l *channelLink) createFailureWithUpdate(
 	cb func(update *lnwire.ChannelUpdate) lnwire.FailureMessage) lnwire.FailureMessage {
 
-	update, err := l.cfg.FetchLastChannelUpdate(l.ShortChanID())
+	return l.createFailureWithChanUpdate(l.ShortChanID(), cb)
+}
+
+// createFailureWithChanUpdate retrieves the last channel update message of the
+// given channel and passes it into the callback. It expects a fully populated
+// failure message.
+func (l *channelLink) createFailureWithChanUpdate(chanID lnwire.ShortChannelID,
+	cb func(update *lnwire.ChannelUpdate) lnwire.FailureMessage) lnwire.FailureMessage {
+
+	update, err := l.cfg.FetchLastChannelUpdate(chanID)
 	if err != nil {
 		return &lnwire.FailTemporaryNodeFailure{}
 	}
@@ -1255,6 +1292,18 @@ func (l *channelLink) processHtlcResolution(resolution invoices.HtlcResolution,
 		)
 		return nil
 
+	// For failed trampoline forwards, we pass on the failure message that
+	// the trampoline forwarder selected.
+	case *TrampolineFailResolution:
+		l.log.Debugf("received trampoline failure for %v: %v",
+			circuitKey, res.Failure)
+
+		l.sendHTLCError(
+			htlc.pd, NewLinkError(res.Failure), htlc.obfuscator,
+			true,
+		)
+		return nil
+
 	// Fail if we do not get a settle of fail resolution, since we
 	// are only expecting to handle settles and fails.
 	default:
@@ -1313,6 +1362,20 @@ func (l *channelLink) handleDownstreamUpdateAdd(pkt *htlcPacket) error {
 		return nil
 	}
 
+	// The switch already checked the dust exposure of the htlc, but
+	// other htlcs may have been added to the channel in the meantime.
+	if l.htlcExceedsDustExposure(htlc.Amount, false, false) {
+		l.log.Warnf("Unable to handle downstream add HTLC: max dust " +
+			"exposure exceeded")
+
+		l.mailBox.FailAdd(pkt)
+
+		return NewDetailedLinkError(
+			lnwire.NewTemporaryChannelFailure(nil),
+			FailureDustExposure,
+		)
+	}
+
 	// A new payment has been initiated via the downstream channel,
 	// so we add the new HTLC to our local log, then update the
 	// commitment chains.
@@ -1899,6 +1962,23 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 				"error receiving fee update: %v", err)
 			return
 		}
+
+		// A higher fee rate increases the fees of the second level
+		// transactions, which can turn existing htlcs into dust. If
+		// that would exceed our max dust exposure, we disconnect from
+		// the peer, which drops the uncommitted fee update.
+		if l.feeExceedsDustExposure(fee) {
+			l.fail(
+				LinkFailureError{
+					code:       ErrDustExposure,
+					Disconnect: true,
+				},
+				"fee update to %v would exceed max dust "+
+					"exposure", fee,
+			)
+			return
+		}
+
 	case *lnwire.Error:
 		// Error received from remote, MUST fail channel, but should
 		// only print the contents of the error message if all
@@ -2191,7 +2271,8 @@ func (l *channelLink) UpdateForwardingPolicy(newPolicy ForwardingPolicy) {
 func (l *channelLink) CheckHtlcForward(payHash [32]byte,
 	incomingHtlcAmt, amtToForward lnwire.MilliSatoshi,
 	incomingTimeout, outgoingTimeout uint32,
-	heightNow uint32) *LinkError {
+	incomingChanID lnwire.ShortChannelID,
+	inboundFee channeldb.InboundFee, heightNow uint32) *LinkError {
 
 	l.RLock()
 	policy := l.cfg.FwrdingPolicy
@@ -2208,22 +2289,44 @@ func (l *channelLink) CheckHtlcForward(payHash [32]byte,
 	// Next, using the amount of the incoming HTLC, we'll calculate the
 	// expected fee this incoming HTLC must carry in order to satisfy the
 	// constraints of the outgoing link.
-	expectedFee := ExpectedFee(policy, amtToForward)
+	outFee := ExpectedFee(policy, amtToForward)
+
+	// Then calculate the inbound fee that we charge for the incoming
+	// channel, based on the sum of the outgoing amount and outgoing fee.
+	// Both components are calculated separately, so that the rounding
+	// matches the way senders compute the fee during path finding.
+	inFee := inboundFee.CalcFee(amtToForward + outFee)
+
+	// The inbound fee may be a discount, but the total fee never goes
+	// below zero.
+	expectedFee := int64(outFee) + inFee
+	if expectedFee < 0 {
+		expectedFee = 0
+	}
 
 	// If the actual fee is less than our expected fee, then we'll reject
 	// this HTLC as it didn't provide a sufficient amount of fees, or the
 	// values have been tampered with, or the send used incorrect/dated
 	// information to construct the forwarding information for this hop. In
 	// any case, we'll cancel this HTLC.
-	actualFee := incomingHtlcAmt - amtToForward
+	actualFee := int64(incomingHtlcAmt) - int64(amtToForward)
 	if incomingHtlcAmt < amtToForward || actualFee < expectedFee {
 		l.log.Errorf("outgoing htlc(%x) has insufficient fee: "+
 			"expected %v, got %v",
-			payHash[:], int64(expectedFee), int64(actualFee))
+			payHash[:], expectedFee, actualFee)
 
 		// As part of the returned error, we'll send our latest routing
 		// policy so the sending node obtains the most up to date data.
-		failure := l.createFailureWithUpdate(
+		// If the htlc did pay the fee of the outgoing channel, it's
+		// the inbound fee that wasn't paid. We'll then send the policy
+		// of the incoming channel instead, which carries the inbound
+		// fee.
+		updateChanID := l.ShortChanID()
+		if inFee > 0 && actualFee >= int64(outFee) {
+			updateChanID = incomingChanID
+		}
+
+		failure := l.createFailureWithChanUpdate(updateChanID,
 			func(upd *lnwire.ChannelUpdate) lnwire.FailureMessage {
 				return lnwire.NewFeeInsufficient(
 					amtToForward, *upd,
@@ -2354,9 +2457,94 @@ func (l *channelLink) canSendHtlc(policy ForwardingPolicy,
 		)
 	}
 
+	// Finally, ensure that the htlc doesn't push the total value of dust
+	// htlcs on the channel over our maximum dust exposure.
+	if l.htlcExceedsDustExposure(amt, false, false) {
+		l.log.Errorf("outgoing htlc(%x) exceeds max dust exposure of "+
+			"%v", payHash[:], l.cfg.MaxDustExposure)
+
+		failure := l.createFailureWithUpdate(
+			func(upd *lnwire.ChannelUpdate) lnwire.FailureMessage {
+				return lnwire.NewTemporaryChannelFailure(upd)
+			},
+		)
+		return NewDetailedLinkError(
+			failure, FailureDustExposure,
+		)
+	}
+
 	return nil
 }
 
+// htlcExceedsDustExposure returns whether an htlc of the given amount pushes
+// the total value of dust htlcs on one of the commitments over the maximum
+// dust exposure. Only the commitments on which the htlc itself is dust are
+// considered. If added is true, the htlc is already part of the channel's
+// update logs.
+func (l *channelLink) htlcExceedsDustExposure(amt lnwire.MilliSatoshi,
+	incoming, added bool) bool {
+
+	if l.cfg.MaxDustExposure == 0 {
+		return false
+	}
+
+	feeRate := l.channel.CommitFeeRate()
+	for _, remote := range []bool{false, true} {
+		if !l.channel.HtlcIsDust(incoming, remote, feeRate, amt) {
+			continue
+		}
+
+		dustSum := l.channel.GetDustSum(remote, feeRate)
+		if !added {
+			dustSum += amt
+		}
+
+		if dustSum > l.cfg.MaxDustExposure {
+			l.log.Debugf("Dust exposure of %v on %v commitment "+
+				"exceeds maximum of %v", dustSum,
+				commitmentOwner(remote), l.cfg.MaxDustExposure)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// feeExceedsDustExposure returns whether the total value of dust htlcs on one
+// of the commitments exceeds the maximum dust exposure at the given fee rate.
+func (l *channelLink) feeExceedsDustExposure(
+	feeRate chainfee.SatPerKWeight) bool {
+
+	if l.cfg.MaxDustExposure == 0 {
+		return false
+	}
+
+	for _, remote := range []bool{false, true} {
+		dustSum := l.channel.GetDustSum(remote, feeRate)
+		if dustSum > l.cfg.MaxDustExposure {
+			l.log.Debugf("Dust exposure of %v on %v commitment at "+
+				"fee rate %v exceeds maximum of %v", dustSum,
+				commitmentOwner(remote), feeRate,
+				l.cfg.MaxDustExposure)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// commitmentOwner returns a description of the owner of a commitment for
+// logging purposes.
+func commitmentOwner(remote bool) string {
+	if remote {
+		return "remote"
+	}
+
+	return "local"
+}
+
 // Stats returns the statistics of channel link.
 //
 // NOTE: Part of the ChannelLink interface.
@@ -2435,6 +2623,14 @@ func (l *channelLink) updateChannelFee(feePerKw chainfee.SatPerKWeight) error {
 		return nil
 	}
 
+	// We also skip the fee update if the new fee rate would turn enough
+	// htlcs into dust to exceed our max dust exposure.
+	if l.feeExceedsDustExposure(feePerKw) {
+		l.log.Warnf("skipping fee update to %v sat/kw, max dust "+
+			"exposure would be exceeded", feePerKw)
+		return nil
+	}
+
 	// First, we'll update the local fee on our commitment.
 	if err := l.channel.UpdateFee(feePerKw); err != nil {
 		return err
@@ -2605,6 +2801,13 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 		return
 	}
 
+	// Obtain the inbound fee of this channel, which every HTLC that we
+	// forward from here must pay on top of the fee of the outgoing
+	// channel. The switch will check it together with the outgoing policy.
+	l.RLock()
+	inboundFee := l.cfg.FwrdingPolicy.InboundFee
+	l.RUnlock()
+
 	var switchPackets []*htlcPacket
 
 	for i, pd := range lockedInHtlcs {
@@ -2666,6 +2869,30 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			continue
 		}
 
+		// Reject htlcs that push the total value of dust htlcs on one
+		// of the commitments over our max dust exposure. We only do so
+		// when the htlc is processed for the first time, as a
+		// forwarding decision may already have been made otherwise.
+		if fwdPkg.State == channeldb.FwdStateLockedIn &&
+			l.htlcExceedsDustExposure(pd.Amount, true, true) {
+
+			l.log.Errorf("incoming htlc(%x) exceeds max dust "+
+				"exposure of %v", pd.RHash[:],
+				l.cfg.MaxDustExposure)
+
+			failure := l.createFailureWithUpdate(
+				func(upd *lnwire.ChannelUpdate) lnwire.FailureMessage {
+					return lnwire.NewTemporaryChannelFailure(upd)
+				},
+			)
+			l.sendHTLCError(
+				pd, NewDetailedLinkError(
+					failure, FailureDustExposure,
+				), obfuscator, false,
+			)
+			continue
+		}
+
 		heightNow := l.cfg.Switch.BestHeight()
 
 		pld, err := chanIterator.HopPayload()
@@ -2763,6 +2990,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					incomingTimeout: pd.Timeout,
 					outgoingTimeout: fwdInfo.OutgoingCTLV,
 					customRecords:   pld.CustomRecords(),
+					inboundFee:      inboundFee,
 				}
 				switchPackets = append(
 					switchPackets, updatePacket,
@@ -2827,6 +3055,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					incomingTimeout: pd.Timeout,
 					outgoingTimeout: fwdInfo.OutgoingCTLV,
 					customRecords:   pld.CustomRecords(),
+					inboundFee:      inboundFee,
 				}
 
 				fwdPkg.FwdFilter.Set(idx)
@@ -2865,7 +3094,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 // returns a boolean indicating whether the commitment tx needs an update.
 func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 	obfuscator hop.ErrorEncrypter, fwdInfo hop.ForwardingInfo,
-	heightNow uint32, payload invoices.Payload) error {
+	heightNow uint32, payload *hop.Payload) error {
 
 	// If hodl.ExitSettle is requested, we will not validate the final hop's
 	// ADD, nor will we settle the corresponding invoice or respond with the
@@ -2908,9 +3137,6 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 		return nil
 	}
 
-	// Notify the invoiceRegistry of the exit hop htlc. If we crash right
-	// after this, this code will be re-executed after restart. We will
-	// receive back a resolution event.
 	invoiceHash := lntypes.Hash(pd.RHash)
 
 	circuitKey := channeldb.CircuitKey{
@@ -2918,10 +3144,42 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 		HtlcID: pd.HtlcIndex,
 	}
 
-	event, err := l.cfg.Registry.NotifyExitHopHtlc(
-		invoiceHash, pd.Amount, pd.Timeout, int32(heightNow),
-		circuitKey, l.hodlQueue.ChanIn(), payload,
+	var (
+		event invoices.HtlcResolution
+		err   error
 	)
+
+	// If the sender asked us to act as a trampoline, we hand the htlc over
+	// to the trampoline forwarder instead of the invoice registry. Just
+	// like for invoices, this code will be re-executed after a restart, and
+	// we will receive back a resolution event.
+	if onion := payload.TrampolineOnionBlob(); onion != nil {
+		if l.cfg.TrampolineForwarder == nil {
+			l.log.Errorf("rejecting trampoline htlc(%x): "+
+				"trampoline forwarding not enabled", pd.RHash[:])
+
+			failure := NewLinkError(lnwire.NewInvalidOnionPayload(
+				uint64(record.TrampolineOnionType), 0,
+			))
+			l.sendHTLCError(pd, failure, obfuscator, true)
+
+			return nil
+		}
+
+		event, err = l.cfg.TrampolineForwarder.ForwardTrampolineHtlc(
+			invoiceHash, pd.Amount, pd.Timeout, heightNow,
+			circuitKey, onion, payload.MultiPath(),
+			l.hodlQueue.ChanIn(),
+		)
+	} else {
+		// Notify the invoiceRegistry of the exit hop htlc. If we crash
+		// right after this, this code will be re-executed after
+		// restart. We will receive back a resolution event.
+		event, err = l.cfg.Registry.NotifyExitHopHtlc(
+			invoiceHash, pd.Amount, pd.Timeout, int32(heightNow),
+			circuitKey, l.hodlQueue.ChanIn(), payload,
+		)
+	}
 	if err != nil {
 		return err
 	}
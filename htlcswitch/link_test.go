@@ -5376,6 +5376,69 @@ func (*mockPackager) AckSettleFails(tx kvdb.RwTx,
 	return nil
 }
 
+// TestChannelLinkDustExposure asserts that the link rejects htlcs and fee
+// updates that push the total value of dust htlcs on the channel over the max
+// dust exposure.
+func TestChannelLinkDustExposure(t *testing.T) {
+	t.Parallel()
+
+	const chanAmt = btcutil.SatoshiPerBitcoin * 5
+	aliceLink, bobChannel, _, _, cleanUp, _, err :=
+		newSingleLinkTestHarness(chanAmt, 0)
+	require.NoError(t, err)
+	defer cleanUp()
+
+	link := aliceLink.(*channelLink)
+	link.cfg.MaxDustExposure = lnwire.NewMSatFromSatoshis(10000)
+
+	// receiveHtlc adds an htlc of the given amount from Bob to Alice.
+	receiveHtlc := func(id byte, amt btcutil.Amount) {
+		htlc := &lnwire.UpdateAddHTLC{
+			PaymentHash: [32]byte{id},
+			Amount:      lnwire.NewMSatFromSatoshis(amt),
+			Expiry:      testStartingHeight + 100,
+		}
+
+		index, err := bobChannel.AddHTLC(htlc, nil)
+		require.NoError(t, err)
+		htlc.ID = index
+
+		_, err = link.channel.ReceiveHTLC(htlc)
+		require.NoError(t, err)
+	}
+
+	// At the test fee rate, htlcs of 4000 sat are dust on both
+	// commitments, while htlcs of 9000 sat are not.
+	feeRate := link.channel.CommitFeeRate()
+	receiveHtlc(1, 4000)
+	receiveHtlc(2, 4000)
+	receiveHtlc(3, 9000)
+
+	checkHtlc := func(amt btcutil.Amount) *LinkError {
+		return link.CheckHtlcTransit(
+			[32]byte{}, lnwire.NewMSatFromSatoshis(amt),
+			testStartingHeight+100, testStartingHeight,
+		)
+	}
+
+	// Another dust htlc would push the dust exposure over the maximum,
+	// while an htlc that isn't dust doesn't add to it.
+	linkErr := checkHtlc(4000)
+	require.NotNil(t, linkErr)
+	require.Equal(t, FailureDustExposure, linkErr.FailureDetail)
+	require.Nil(t, checkHtlc(100000))
+
+	// A fee rate that turns the 9000 sat htlc into dust exceeds the
+	// maximum as well.
+	require.False(t, link.feeExceedsDustExposure(feeRate))
+	require.True(t, link.feeExceedsDustExposure(feeRate*3))
+
+	// Without a maximum, the dust exposure isn't limited.
+	link.cfg.MaxDustExposure = 0
+	require.Nil(t, checkHtlc(4000))
+	require.False(t, link.feeExceedsDustExposure(feeRate*3))
+}
+
 // TestChannelLinkFail tests that we will fail the channel, and force close the
 // channel in certain situations.
 func TestChannelLinkFail(t *testing.T) {
@@ -5713,10 +5776,10 @@ func TestForwardingAsymmetricTimeLockPolicies(t *testing.T) {
 // forwarding policy.
 func TestCheckHtlcForward(t *testing.T) {
 
-	fetchLastChannelUpdate := func(lnwire.ShortChannelID) (
+	fetchLastChannelUpdate := func(chanID lnwire.ShortChannelID) (
 		*lnwire.ChannelUpdate, error) {
 
-		return &lnwire.ChannelUpdate{}, nil
+		return &lnwire.ChannelUpdate{ShortChannelID: chanID}, nil
 	}
 
 	testChannel, _, fCleanUp, err := createTestChannel(
@@ -5745,10 +5808,11 @@ func TestCheckHtlcForward(t *testing.T) {
 	}
 
 	var hash [32]byte
+	incomingChanID := lnwire.NewShortChanIDFromInt(5)
 
 	t.Run("satisfied", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1500, 1000,
-			200, 150, 0)
+			200, 150, incomingChanID, channeldb.InboundFee{}, 0)
 		if result != nil {
 			t.Fatalf("expected policy to be satisfied")
 		}
@@ -5756,7 +5820,7 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("below minhtlc", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 100, 50,
-			200, 150, 0)
+			200, 150, incomingChanID, channeldb.InboundFee{}, 0)
 		if _, ok := result.WireMessage().(*lnwire.FailAmountBelowMinimum); !ok {
 			t.Fatalf("expected FailAmountBelowMinimum failure code")
 		}
@@ -5764,7 +5828,7 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("above maxhtlc", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1500, 1200,
-			200, 150, 0)
+			200, 150, incomingChanID, channeldb.InboundFee{}, 0)
 		if _, ok := result.WireMessage().(*lnwire.FailTemporaryChannelFailure); !ok {
 			t.Fatalf("expected FailTemporaryChannelFailure failure code")
 		}
@@ -5772,15 +5836,23 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("insufficient fee", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1005, 1000,
-			200, 150, 0)
-		if _, ok := result.WireMessage().(*lnwire.FailFeeInsufficient); !ok {
+			200, 150, incomingChanID, channeldb.InboundFee{}, 0)
+		msg := result.WireMessage()
+		failure, ok := msg.(*lnwire.FailFeeInsufficient)
+		if !ok {
 			t.Fatalf("expected FailFeeInsufficient failure code")
 		}
+
+		// The outgoing fee wasn't paid, so we expect the update of the
+		// outgoing channel.
+		if failure.Update.ShortChannelID != link.ShortChanID() {
+			t.Fatalf("expected update of outgoing channel")
+		}
 	})
 
 	t.Run("expiry too soon", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1500, 1000,
-			200, 150, 190)
+			200, 150, incomingChanID, channeldb.InboundFee{}, 190)
 		if _, ok := result.WireMessage().(*lnwire.FailExpiryTooSoon); !ok {
 			t.Fatalf("expected FailExpiryTooSoon failure code")
 		}
@@ -5788,17 +5860,57 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("incorrect cltv expiry", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1500, 1000,
-			200, 190, 0)
+			200, 190, incomingChanID, channeldb.InboundFee{}, 0)
 		if _, ok := result.WireMessage().(*lnwire.FailIncorrectCltvExpiry); !ok {
 			t.Fatalf("expected FailIncorrectCltvExpiry failure code")
 		}
 
 	})
 
+	t.Run("inbound fee satisfied", func(t *testing.T) {
+		// The inbound fee of 10 msat is paid on top of the base fee of
+		// 10 msat.
+		inboundFee := channeldb.InboundFee{Base: 10}
+		result := link.CheckHtlcForward(hash, 1020, 1000,
+			200, 150, incomingChanID, inboundFee, 0)
+		if result != nil {
+			t.Fatalf("expected policy to be satisfied")
+		}
+	})
+
+	t.Run("inbound fee insufficient", func(t *testing.T) {
+		inboundFee := channeldb.InboundFee{Base: 10}
+		result := link.CheckHtlcForward(hash, 1015, 1000,
+			200, 150, incomingChanID, inboundFee, 0)
+		msg := result.WireMessage()
+		failure, ok := msg.(*lnwire.FailFeeInsufficient)
+		if !ok {
+			t.Fatalf("expected FailFeeInsufficient failure code")
+		}
+
+		// Only the inbound fee wasn't paid, so we expect the update of
+		// the incoming channel, which carries the inbound fee.
+		if failure.Update.ShortChannelID != incomingChanID {
+			t.Fatalf("expected update of incoming channel")
+		}
+	})
+
+	t.Run("inbound discount satisfied", func(t *testing.T) {
+		// A discount that exceeds the outbound fee results in a total
+		// fee of zero.
+		inboundFee := channeldb.InboundFee{Base: -20}
+		result := link.CheckHtlcForward(hash, 1000, 1000,
+			200, 150, incomingChanID, inboundFee, 0)
+		if result != nil {
+			t.Fatalf("expected policy to be satisfied")
+		}
+	})
+
 	t.Run("cltv expiry too far in the future", func(t *testing.T) {
 		// Check that expiry isn't too far in the future.
 		result := link.CheckHtlcForward(hash, 1500, 1000,
-			10200, 10100, 0)
+			10200, 10100, incomingChanID, channeldb.InboundFee{},
+			0)
 		if _, ok := result.WireMessage().(*lnwire.FailExpiryTooFar); !ok {
 			t.Fatalf("expected FailExpiryTooFar failure code")
 		}
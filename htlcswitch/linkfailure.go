@@ -43,6 +43,12 @@ const (
 	// remote party to force close the channel out on chain now as a
 	// result.
 	ErrRecoveryError
+
+	// ErrDustExposure indicates that an update of the remote peer would
+	// push the total value of dust htlcs on one of the commitments over
+	// our maximum dust exposure. The update isn't invalid, so we don't send
+	// an error to the peer, which could lead it to force close the channel.
+	ErrDustExposure
 )
 
 // LinkFailureError encapsulates an error that will make us fail the current
@@ -64,6 +70,11 @@ type LinkFailureError struct {
 	// SendData is a byte slice that will be sent to the peer. If nil a
 	// generic error will be sent.
 	SendData []byte
+
+	// Disconnect indicates whether we should disconnect from the peer
+	// because of this error. Any uncommitted updates are dropped, and the
+	// link is restored once the peer reconnects.
+	Disconnect bool
 }
 
 // A compile time check to ensure LinkFailureError implements the error
@@ -91,6 +102,8 @@ func (e LinkFailureError) Error() string {
 		return "invalid revocation"
 	case ErrRecoveryError:
 		return "unable to resume channel, recovery required"
+	case ErrDustExposure:
+		return "max dust exposure exceeded"
 	default:
 		return "unknown error"
 	}
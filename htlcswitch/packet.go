@@ -96,6 +96,11 @@ type htlcPacket struct {
 	// customRecords are user-defined records in the custom type range that
 	// were included in the payload.
 	customRecords record.CustomSet
+
+	// inboundFee is the inbound fee policy of the incoming link at the
+	// time the packet was created. It is used by the switch to verify
+	// that a forwarded HTLC pays sufficient fees.
+	inboundFee channeldb.InboundFee
 }
 
 // inKey returns the circuit key used to identify the incoming htlc.
@@ -110,6 +110,18 @@ type ChanClose struct {
 	// process for the cooperative closure transaction kicks off.
 	TargetFeePerKw chainfee.SatPerKWeight
 
+	// MaxFeePerKw is the highest fee rate the caller is willing to pay or
+	// accept for the closing transaction. A zero value lets the peer pick
+	// a default. This value is only utilized if the closure type is
+	// CloseRegular.
+	MaxFeePerKw chainfee.SatPerKWeight
+
+	// MinFeePerKw is the lowest fee rate the caller is willing to pay or
+	// accept for the closing transaction. A zero value means no lower
+	// bound. This value is only utilized if the closure type is
+	// CloseRegular.
+	MinFeePerKw chainfee.SatPerKWeight
+
 	// DeliveryScript is an optional delivery script to pay funds out to.
 	DeliveryScript lnwire.DeliveryAddress
 
@@ -553,6 +565,49 @@ func (s *Switch) IsForwardedHTLC(chanID lnwire.ShortChannelID,
 	return circuit != nil && circuit.Incoming.ChanID != hop.Source
 }
 
+// IncomingHTLCExpiry returns the expiry of the incoming htlc that the given
+// outgoing htlc was forwarded for. False is returned if the htlc wasn't
+// forwarded, or if the incoming htlc can't be found.
+func (s *Switch) IncomingHTLCExpiry(chanID lnwire.ShortChannelID,
+	htlcIndex uint64) (uint32, bool) {
+
+	circuit := s.circuits.LookupOpenCircuit(channeldb.CircuitKey{
+		ChanID: chanID,
+		HtlcID: htlcIndex,
+	})
+	if circuit == nil || circuit.Incoming.ChanID == hop.Source {
+		return 0, false
+	}
+
+	// The incoming channel may have been closed in the meantime, so we'll
+	// also consider channels that are pending close.
+	channels, err := s.cfg.DB.FetchAllChannels()
+	if err != nil {
+		log.Errorf("Unable to fetch channels: %v", err)
+		return 0, false
+	}
+
+	for _, channel := range channels {
+		if channel.ShortChanID() != circuit.Incoming.ChanID {
+			continue
+		}
+
+		incoming := circuit.Incoming.HtlcID
+		commits := []channeldb.ChannelCommitment{
+			channel.LocalCommitment, channel.RemoteCommitment,
+		}
+		for _, commit := range commits {
+			for _, htlc := range commit.Htlcs {
+				if htlc.Incoming && htlc.HtlcIndex == incoming {
+					return htlc.RefundTimeout, true
+				}
+			}
+		}
+	}
+
+	return 0, false
+}
+
 // ForwardPackets adds a list of packets to the switch for processing. Fails
 // and settles are added on a first past, simultaneously constructing circuits
 // for any adds. After persisting the circuits, another pass of the adds is
@@ -1043,7 +1098,9 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 				failure = link.CheckHtlcForward(
 					htlc.PaymentHash, packet.incomingAmount,
 					packet.amount, packet.incomingTimeout,
-					packet.outgoingTimeout, currentHeight,
+					packet.outgoingTimeout,
+					packet.incomingChanID,
+					packet.inboundFee, currentHeight,
 				)
 			}
 
@@ -1447,10 +1504,13 @@ func (s *Switch) teardownCircuit(pkt *htlcPacket) error {
 // CloseLink creates and sends the close channel command to the target link
 // directing the specified closure type. If the closure type is CloseRegular,
 // targetFeePerKw parameter should be the ideal fee-per-kw that will be used as
-// a starting point for close negotiation. The deliveryScript parameter is an
-// optional parameter which sets a user specified script to close out to.
+// a starting point for close negotiation, while maxFeePerKw and minFeePerKw
+// optionally bound the fee rates that will be proposed or accepted. The
+// deliveryScript parameter is an optional parameter which sets a user
+// specified script to close out to.
 func (s *Switch) CloseLink(chanPoint *wire.OutPoint,
-	closeType ChannelCloseType, targetFeePerKw chainfee.SatPerKWeight,
+	closeType ChannelCloseType, targetFeePerKw, maxFeePerKw,
+	minFeePerKw chainfee.SatPerKWeight,
 	deliveryScript lnwire.DeliveryAddress) (chan interface{}, chan error) {
 
 	// TODO(roasbeef) abstract out the close updates.
@@ -1462,6 +1522,8 @@ func (s *Switch) CloseLink(chanPoint *wire.OutPoint,
 		ChanPoint:      chanPoint,
 		Updates:        updateChan,
 		TargetFeePerKw: targetFeePerKw,
+		MaxFeePerKw:    maxFeePerKw,
+		MinFeePerKw:    minFeePerKw,
 		DeliveryScript: deliveryScript,
 		Err:            errChan,
 	}
@@ -2052,6 +2114,22 @@ func (s *Switch) getLinkByShortID(chanID lnwire.ShortChannelID) (ChannelLink, er
 	return link, nil
 }
 
+// linkPeerByShortID returns the public key of the peer of the link with the
+// given short channel ID.
+func (s *Switch) linkPeerByShortID(
+	chanID lnwire.ShortChannelID) ([33]byte, error) {
+
+	s.indexMtx.RLock()
+	defer s.indexMtx.RUnlock()
+
+	link, err := s.getLinkByShortID(chanID)
+	if err != nil {
+		return [33]byte{}, err
+	}
+
+	return link.Peer().PubKey(), nil
+}
+
 // HasActiveLink returns true if the given channel ID has a link in the link
 // index AND the link is eligible to forward.
 func (s *Switch) HasActiveLink(chanID lnwire.ChannelID) bool {
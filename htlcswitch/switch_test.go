@@ -2863,19 +2863,19 @@ func testHtcNotifier(t *testing.T, testOpts []serverOption, iterations int,
 
 	// Create htlc notifiers for each server in the three hop network and
 	// start them.
-	aliceNotifier := NewHtlcNotifier(mockTime)
+	aliceNotifier := NewHtlcNotifier(mockTime, nil)
 	if err := aliceNotifier.Start(); err != nil {
 		t.Fatalf("could not start alice notifier")
 	}
 	defer aliceNotifier.Stop()
 
-	bobNotifier := NewHtlcNotifier(mockTime)
+	bobNotifier := NewHtlcNotifier(mockTime, nil)
 	if err := bobNotifier.Start(); err != nil {
 		t.Fatalf("could not start bob notifier")
 	}
 	defer bobNotifier.Stop()
 
-	carolNotifier := NewHtlcNotifier(mockTime)
+	carolNotifier := NewHtlcNotifier(mockTime, nil)
 	if err := carolNotifier.Start(); err != nil {
 		t.Fatalf("could not start carol notifier")
 	}
@@ -2905,21 +2905,21 @@ func testHtcNotifier(t *testing.T, testOpts []serverOption, iterations int,
 
 	// Before we forward anything, subscribe to htlc events
 	// from each notifier.
-	aliceEvents, err := aliceNotifier.SubscribeHtlcEvents()
+	aliceEvents, err := aliceNotifier.SubscribeHtlcEvents(0, nil)
 	if err != nil {
 		t.Fatalf("could not subscribe to alice's"+
 			" events: %v", err)
 	}
 	defer aliceEvents.Cancel()
 
-	bobEvents, err := bobNotifier.SubscribeHtlcEvents()
+	bobEvents, err := bobNotifier.SubscribeHtlcEvents(0, nil)
 	if err != nil {
 		t.Fatalf("could not subscribe to bob's"+
 			" events: %v", err)
 	}
 	defer bobEvents.Cancel()
 
-	carolEvents, err := carolNotifier.SubscribeHtlcEvents()
+	carolEvents, err := carolNotifier.SubscribeHtlcEvents(0, nil)
 	if err != nil {
 		t.Fatalf("could not subscribe to carol's"+
 			" events: %v", err)
@@ -3225,10 +3225,11 @@ func TestSwitchHoldForward(t *testing.T) {
 	preimage := [sha256.Size]byte{1}
 	rhash := sha256.Sum256(preimage[:])
 	ogPacket := &htlcPacket{
-		incomingChanID: aliceChannelLink.ShortChanID(),
-		incomingHTLCID: 0,
-		outgoingChanID: bobChannelLink.ShortChanID(),
-		obfuscator:     NewMockObfuscator(),
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		obfuscator:      NewMockObfuscator(),
+		incomingTimeout: testStartingHeight + 100,
 		htlc: &lnwire.UpdateAddHTLC{
 			PaymentHash: rhash,
 			Amount:      1,
@@ -3236,7 +3237,11 @@ func TestSwitchHoldForward(t *testing.T) {
 	}
 
 	forwardInterceptor := &mockForwardInterceptor{}
-	switchForwardInterceptor := NewInterceptableSwitch(s)
+	switchForwardInterceptor := NewInterceptableSwitch(
+		&InterceptableSwitchConfig{
+			Switch: s,
+		},
+	)
 	switchForwardInterceptor.SetInterceptor(forwardInterceptor.InterceptForwardHtlc)
 	linkQuit := make(chan struct{})
 
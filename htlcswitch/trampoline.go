@@ -0,0 +1,38 @@
+package htlcswitch
+
+import (
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TrampolineFailResolution is an implementation of the HtlcResolution
+// interface which is returned when the onward payment of a trampoline htlc
+// failed. Unlike invoice failures, which are always reported as incorrect
+// payment details, it carries the exact failure that should be sent back to
+// the sender, so that the sender can learn whether it should retry with a
+// larger fee or cltv budget.
+type TrampolineFailResolution struct {
+	// circuitKey is the key of the htlc for which we have a resolution.
+	circuitKey channeldb.CircuitKey
+
+	// Failure is the failure message that the htlc should be failed with.
+	Failure lnwire.FailureMessage
+}
+
+// NewTrampolineFailResolution returns a trampoline htlc failure resolution.
+func NewTrampolineFailResolution(key channeldb.CircuitKey,
+	failure lnwire.FailureMessage) *TrampolineFailResolution {
+
+	return &TrampolineFailResolution{
+		circuitKey: key,
+		Failure:    failure,
+	}
+}
+
+// CircuitKey returns the circuit key for the htlc that we have a resolution
+// for.
+//
+// Note: it is part of the HtlcResolution interface.
+func (t *TrampolineFailResolution) CircuitKey() channeldb.CircuitKey {
+	return t.circuitKey
+}
@@ -91,6 +91,10 @@ type inputKit struct {
 	heightHint      uint32
 	blockToMaturity uint32
 
+	// cltvExpiry is the absolute height the spending transaction must be
+	// locked to. It is zero for inputs that are not CLTV locked.
+	cltvExpiry uint32
+
 	// unconfParent contains information about a potential unconfirmed
 	// parent transaction.
 	unconfParent *TxInfo
@@ -108,10 +112,10 @@ func (i *inputKit) RequiredTxOut() *wire.TxOut {
 }
 
 // RequiredLockTime returns whether this input commits to a tx locktime that
-// must be used in the transaction including it. This will be false for the
-// base input type since we can re-sign for any lock time.
+// must be used in the transaction including it. This will only be true for
+// CLTV locked inputs, for others we can re-sign for any lock time.
 func (i *inputKit) RequiredLockTime() (uint32, bool) {
-	return 0, false
+	return i.cltvExpiry, i.cltvExpiry > 0
 }
 
 // WitnessType returns the type of witness that must be generated to spend the
@@ -196,6 +200,24 @@ func NewCsvInput(outpoint *wire.OutPoint, witnessType WitnessType,
 	}
 }
 
+// NewCsvInputWithCltv assembles a new csv and cltv locked input that can be
+// used to construct a sweep transaction.
+func NewCsvInputWithCltv(outpoint *wire.OutPoint, witnessType WitnessType,
+	signDescriptor *SignDescriptor, heightHint uint32,
+	csvDelay uint32, cltvExpiry uint32) *BaseInput {
+
+	return &BaseInput{
+		inputKit{
+			outpoint:        *outpoint,
+			witnessType:     witnessType,
+			signDesc:        *signDescriptor,
+			heightHint:      heightHint,
+			blockToMaturity: csvDelay,
+			cltvExpiry:      cltvExpiry,
+		},
+	}
+}
+
 // CraftInputScript returns a valid set of input scripts allowing this output
 // to be spent. The returned input scripts should target the input at location
 // txIndex within the passed transaction. The input scripts generated by this
@@ -600,6 +600,35 @@ func ReceiverHtlcSpendRevoke(signer Signer, signDesc *SignDescriptor,
 	return ReceiverHtlcSpendRevokeWithKey(signer, signDesc, revokeKey, sweepTx)
 }
 
+// IsHtlcSpendRevoke is used to determine if the passed spend is spending a
+// HTLC output using the revocation key. The provided SignDescriptor must hold
+// the local revocation basepoint and commitment secret in the PubKey and
+// DoubleTweak fields, respectively.
+func IsHtlcSpendRevoke(txIn *wire.TxIn, signDesc *SignDescriptor) (
+	bool, error) {
+
+	if signDesc.KeyDesc.PubKey == nil || signDesc.DoubleTweak == nil {
+		return false, fmt.Errorf("cannot derive revocation key without " +
+			"KeyDesc pubkey and DoubleTweak")
+	}
+
+	revokeKey := DeriveRevocationPubkey(
+		signDesc.KeyDesc.PubKey,
+		signDesc.DoubleTweak.PubKey(),
+	)
+
+	// A revocation spend of either HTLC script reveals the revocation key
+	// in the second element of the witness stack, as created by
+	// SenderHtlcSpendRevokeWithKey and ReceiverHtlcSpendRevokeWithKey.
+	if len(txIn.Witness) == 3 &&
+		bytes.Equal(txIn.Witness[1], revokeKey.SerializeCompressed()) {
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
 // ReceiverHtlcSpendTimeout constructs a valid witness allowing the sender of
 // an HTLC to recover the pending funds after an absolute timeout in the
 // scenario that the receiver of the HTLC broadcasts their version of the
@@ -978,6 +978,76 @@ func TestHTLCReceiverSpendValidation(t *testing.T) {
 	}
 }
 
+// TestIsHtlcSpendRevoke asserts that a spend of an HTLC output through the
+// revocation clause is told apart from other spends of the output.
+func TestIsHtlcSpendRevoke(t *testing.T) {
+	t.Parallel()
+
+	commitSecret, commitPoint := btcec.PrivKeyFromBytes(btcec.S256(),
+		testHdSeed.CloneBytes())
+	_, bobKeyPub := btcec.PrivKeyFromBytes(btcec.S256(), bobsPrivKey)
+
+	revocationKey := DeriveRevocationPubkey(bobKeyPub, commitPoint)
+
+	signDesc := &SignDescriptor{
+		KeyDesc: keychain.KeyDescriptor{
+			PubKey: bobKeyPub,
+		},
+		DoubleTweak: commitSecret,
+	}
+
+	testCases := []struct {
+		name    string
+		witness wire.TxWitness
+		revoke  bool
+	}{
+		{
+			name: "revocation spend",
+			witness: wire.TxWitness{
+				{0x01}, revocationKey.SerializeCompressed(),
+				{0x02},
+			},
+			revoke: true,
+		},
+		{
+			name: "timeout spend",
+			witness: wire.TxWitness{
+				{}, {0x01}, {0x02}, {}, {0x03},
+			},
+			revoke: false,
+		},
+		{
+			name: "second-level spend",
+			witness: wire.TxWitness{
+				{0x01}, {0x01}, {0x02},
+			},
+			revoke: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		txIn := &wire.TxIn{Witness: testCase.witness}
+
+		revoke, err := IsHtlcSpendRevoke(txIn, signDesc)
+		if err != nil {
+			t.Fatalf("%v: unable to check spend: %v",
+				testCase.name, err)
+		}
+		if revoke != testCase.revoke {
+			t.Fatalf("%v: expected revoke=%v, got %v",
+				testCase.name, testCase.revoke, revoke)
+		}
+	}
+
+	// Without the commitment secret, the revocation key can't be derived.
+	_, err := IsHtlcSpendRevoke(&wire.TxIn{}, &SignDescriptor{
+		KeyDesc: signDesc.KeyDesc,
+	})
+	if err == nil {
+		t.Fatalf("expected error without commitment secret")
+	}
+}
+
 // TestSecondLevelHtlcSpends tests all the possible redemption clauses from the
 // HTLC success and timeout covenant transactions.
 func TestSecondLevelHtlcSpends(t *testing.T) {
@@ -0,0 +1,99 @@
+package invoices
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// HtlcRejection describes an htlc that was rejected because it didn't satisfy
+// the acceptance policy of the invoice it paid to, or that was canceled
+// because it came close to its expiry while the hold invoice it paid to was
+// accepted. Rejections are reported to the single invoice subscribers of the
+// invoice.
+type HtlcRejection struct {
+	// Invoice is the invoice that the htlc paid to, at the time the htlc
+	// was rejected or canceled.
+	Invoice *channeldb.Invoice
+
+	// CircuitKey is the key of the rejected htlc.
+	CircuitKey channeldb.CircuitKey
+
+	// Amt is the amount of the rejected htlc.
+	Amt lnwire.MilliSatoshi
+
+	// Expiry is the absolute block height at which the htlc expires.
+	Expiry uint32
+
+	// Outcome is the reason why the htlc was rejected.
+	Outcome FailResolutionResult
+
+	// CustomRecordType is the type of the required custom record that was
+	// missing or had an unexpected value. It is only set for custom
+	// record rejections.
+	CustomRecordType uint64
+}
+
+// checkAcceptPolicy checks the htlc against the acceptance policy of the
+// invoice. It returns nil if the htlc satisfies the policy, or if the invoice
+// has no policy.
+func (i *invoiceUpdateCtx) checkAcceptPolicy(
+	policy *channeldb.InvoiceAcceptPolicy) *HtlcRejection {
+
+	if policy == nil {
+		return nil
+	}
+
+	reject := func(outcome FailResolutionResult,
+		recordType uint64) *HtlcRejection {
+
+		return &HtlcRejection{
+			CircuitKey:       i.circuitKey,
+			Amt:              i.amtPaid,
+			Expiry:           i.expiry,
+			Outcome:          outcome,
+			CustomRecordType: recordType,
+		}
+	}
+
+	if i.expiry < uint32(i.currentHeight)+policy.MinCltvDelta {
+		return reject(ResultPolicyExpiryTooSoon, 0)
+	}
+
+	// Check the required records in a deterministic order, so that the
+	// same htlc is always rejected for the same reason.
+	recordTypes := make([]uint64, 0, len(policy.RequiredCustomRecords))
+	for recordType := range policy.RequiredCustomRecords {
+		recordTypes = append(recordTypes, recordType)
+	}
+	sort.Slice(recordTypes, func(i, j int) bool {
+		return recordTypes[i] < recordTypes[j]
+	})
+
+	for _, recordType := range recordTypes {
+		value, ok := i.customRecords[recordType]
+		if !ok {
+			return reject(ResultPolicyMissingRecord, recordType)
+		}
+
+		expected := policy.RequiredCustomRecords[recordType]
+		if len(expected) > 0 && !bytes.Equal(value, expected) {
+			return reject(ResultPolicyRecordMismatch, recordType)
+		}
+	}
+
+	return nil
+}
+
+// acceptAmt returns the amount that an htlc set must pay for the invoice to
+// be accepted. This is the invoice value, unless the acceptance policy of the
+// invoice allows partial acceptance.
+func acceptAmt(inv *channeldb.Invoice) lnwire.MilliSatoshi {
+	if inv.AcceptPolicy != nil && inv.AcceptPolicy.MinAcceptAmt != 0 {
+		return inv.AcceptPolicy.MinAcceptAmt
+	}
+
+	return inv.Terms.Value
+}
@@ -0,0 +1,228 @@
+package invoices
+
+import (
+	"errors"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+)
+
+var (
+	// ErrHtlcNotIntercepted is returned when the caller tries to resolve an
+	// htlc that isn't held for the htlc acceptor.
+	ErrHtlcNotIntercepted = errors.New("htlc not intercepted")
+
+	// ErrPreimageMismatch is returned when the caller tries to settle an
+	// intercepted htlc with a preimage that doesn't match its hash.
+	ErrPreimageMismatch = errors.New("preimage does not match hash")
+)
+
+// InterceptedHtlc describes an exit hop htlc for which no invoice exists and
+// that is presented to the htlc acceptor.
+type InterceptedHtlc struct {
+	// CircuitKey is the key of the htlc.
+	CircuitKey channeldb.CircuitKey
+
+	// Hash is the payment hash of the htlc.
+	Hash lntypes.Hash
+
+	// Amount is the amount of the htlc.
+	Amount lnwire.MilliSatoshi
+
+	// Expiry is the absolute block height at which the htlc expires.
+	Expiry uint32
+
+	// AcceptHeight is the block height at which the htlc was accepted.
+	AcceptHeight int32
+
+	// CustomRecords are the custom records that were present in the
+	// payload.
+	CustomRecords record.CustomSet
+
+	// MPP is the mpp record of the payload, if present.
+	MPP *record.MPP
+}
+
+// HtlcAcceptor is a function that is invoked by the invoice registry for
+// every exit hop htlc for which no invoice exists. The return value indicates
+// whether the acceptor takes control of the htlc. If so, the htlc is held
+// until it is resolved through SettleInterceptedHtlc or FailInterceptedHtlc,
+// or the acceptor is unset. Like the htlcs of an accepted hold invoice, an htlc
+// that is still held once it expires within the final cltv reject delta is
+// canceled.
+type HtlcAcceptor func(*InterceptedHtlc) bool
+
+// SetHtlcAcceptor sets the htlc acceptor. If the acceptor is unset, all htlcs
+// that are currently held for it are failed, because without an invoice we
+// won't be able to settle them.
+func (i *InvoiceRegistry) SetHtlcAcceptor(acceptor HtlcAcceptor) {
+	i.Lock()
+	defer i.Unlock()
+
+	i.htlcAcceptor = acceptor
+	if acceptor != nil {
+		return
+	}
+
+	for key, htlc := range i.interceptedHtlcs {
+		log.Debugf("Failing intercepted htlc %v after htlc acceptor "+
+			"disconnected", key)
+
+		delete(i.interceptedHtlcs, key)
+		i.notifyHodlSubscribers(NewFailResolution(
+			key, htlc.AcceptHeight, ResultHtlcAcceptorCanceled,
+		))
+	}
+}
+
+// interceptHtlc presents an exit hop htlc for which no invoice exists to the
+// htlc acceptor. The passed failure resolution is returned if the acceptor
+// doesn't take control of the htlc. If it does, a nil resolution is returned
+// and the final resolution is sent on the hodl channel.
+func (i *InvoiceRegistry) interceptHtlc(ctx *invoiceUpdateCtx,
+	hodlChan chan<- interface{},
+	notFound *HtlcFailResolution) (HtlcResolution, error) {
+
+	i.Lock()
+
+	acceptor := i.htlcAcceptor
+	if acceptor == nil {
+		i.Unlock()
+		return notFound, nil
+	}
+
+	// If the htlc is already held, this is a replay, for example after the
+	// link restarted. We only need to make sure that the new hodl channel
+	// receives the resolution.
+	if _, ok := i.interceptedHtlcs[ctx.circuitKey]; ok {
+		i.hodlSubscribe(hodlChan, ctx.circuitKey)
+		i.Unlock()
+
+		ctx.log("replayed htlc held for htlc acceptor")
+		return nil, nil
+	}
+
+	// Don't present htlcs that we wouldn't be able to settle in time.
+	if ctx.expiry < uint32(ctx.currentHeight+ctx.finalCltvRejectDelta) {
+		i.Unlock()
+		return ctx.failRes(ResultExpiryTooSoon), nil
+	}
+
+	htlc := &InterceptedHtlc{
+		CircuitKey:    ctx.circuitKey,
+		Hash:          ctx.hash,
+		Amount:        ctx.amtPaid,
+		Expiry:        ctx.expiry,
+		AcceptHeight:  ctx.currentHeight,
+		CustomRecords: ctx.customRecords,
+		MPP:           ctx.mpp,
+	}
+	i.interceptedHtlcs[ctx.circuitKey] = htlc
+
+	// Subscribe before presenting the htlc, so that we don't miss a
+	// resolution that arrives right away.
+	i.hodlSubscribe(hodlChan, ctx.circuitKey)
+	i.Unlock()
+
+	ctx.log("presenting htlc to htlc acceptor")
+
+	// The acceptor is called without holding the lock, as it may resolve
+	// the htlc from within.
+	if acceptor(htlc) {
+		// Watch the htlc, so that it is canceled before it expires.
+		// This happens outside the lock too, because the expiry
+		// watcher obtains the lock to cancel htlcs.
+		i.expiryWatcher.AddInterceptedHtlcs(&interceptedHtlcExpiry{
+			CircuitKey: ctx.circuitKey,
+			CancelHeight: ctx.expiry -
+				uint32(ctx.finalCltvRejectDelta),
+		})
+
+		return nil, nil
+	}
+
+	// The acceptor didn't take the htlc. If it wasn't resolved in the
+	// meantime, we fail it as if there was no acceptor.
+	i.Lock()
+	defer i.Unlock()
+
+	if _, ok := i.interceptedHtlcs[ctx.circuitKey]; !ok {
+		return nil, nil
+	}
+	delete(i.interceptedHtlcs, ctx.circuitKey)
+	i.hodlUnsubscribe(hodlChan, ctx.circuitKey)
+
+	return notFound, nil
+}
+
+// SettleInterceptedHtlc settles an htlc that is held for the htlc acceptor
+// with the given preimage.
+func (i *InvoiceRegistry) SettleInterceptedHtlc(key channeldb.CircuitKey,
+	preimage lntypes.Preimage) error {
+
+	i.Lock()
+	defer i.Unlock()
+
+	htlc, ok := i.interceptedHtlcs[key]
+	if !ok {
+		return ErrHtlcNotIntercepted
+	}
+	if !preimage.Matches(htlc.Hash) {
+		return ErrPreimageMismatch
+	}
+	delete(i.interceptedHtlcs, key)
+
+	log.Debugf("Settling intercepted htlc %v", key)
+
+	i.notifyHodlSubscribers(NewSettleResolution(
+		preimage, key, htlc.AcceptHeight, ResultSettled,
+	))
+
+	return nil
+}
+
+// cancelInterceptedHtlc fails an htlc that is held for the htlc acceptor
+// because it is about to expire.
+func (i *InvoiceRegistry) cancelInterceptedHtlc(
+	key channeldb.CircuitKey) error {
+
+	i.Lock()
+	defer i.Unlock()
+
+	htlc, ok := i.interceptedHtlcs[key]
+	if !ok {
+		return ErrHtlcNotIntercepted
+	}
+	delete(i.interceptedHtlcs, key)
+
+	log.Infof("Canceling intercepted htlc %v with expiry %v", key,
+		htlc.Expiry)
+
+	i.notifyHodlSubscribers(NewFailResolution(
+		key, htlc.AcceptHeight, ResultExpiryTooSoon,
+	))
+
+	return nil
+}
+
+// FailInterceptedHtlc fails an htlc that is held for the htlc acceptor.
+func (i *InvoiceRegistry) FailInterceptedHtlc(key channeldb.CircuitKey) error {
+	i.Lock()
+	defer i.Unlock()
+
+	htlc, ok := i.interceptedHtlcs[key]
+	if !ok {
+		return ErrHtlcNotIntercepted
+	}
+	delete(i.interceptedHtlcs, key)
+
+	log.Debugf("Failing intercepted htlc %v", key)
+
+	i.notifyHodlSubscribers(NewFailResolution(
+		key, htlc.AcceptHeight, ResultHtlcAcceptorCanceled,
+	))
+
+	return nil
+}
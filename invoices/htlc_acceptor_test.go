@@ -0,0 +1,205 @@
+package invoices
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntest/mock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// notifyUnknownHtlc notifies the registry of an exit hop htlc for which no
+// invoice exists.
+func notifyUnknownHtlc(t *testing.T, ctx *testContext, htlcID uint64,
+	hodlChan chan interface{}) HtlcResolution {
+
+	resolution, err := ctx.registry.NotifyExitHopHtlc(
+		testInvoicePaymentHash, lnwire.MilliSatoshi(100000),
+		testHtlcExpiry, testCurrentHeight, getCircuitKey(htlcID),
+		hodlChan, testPayload,
+	)
+	require.NoError(t, err)
+
+	return resolution
+}
+
+// receiveResolution asserts that a resolution is received on the hodl
+// channel.
+func receiveResolution(t *testing.T, hodlChan chan interface{}) interface{} {
+	select {
+	case resolution := <-hodlChan:
+		return resolution
+
+	case <-time.After(testTimeout):
+		t.Fatal("no resolution received")
+		return nil
+	}
+}
+
+// TestHtlcAcceptor tests that htlcs for which no invoice exists are presented
+// to the htlc acceptor, which can settle, hold and fail them.
+func TestHtlcAcceptor(t *testing.T) {
+	t.Parallel()
+
+	ctx := newTestContext(t)
+	defer ctx.cleanup()
+
+	intercepted := make(chan *InterceptedHtlc, 2)
+	ctx.registry.SetHtlcAcceptor(func(htlc *InterceptedHtlc) bool {
+		intercepted <- htlc
+		return true
+	})
+
+	// The htlc is held while the acceptor decides.
+	hodlChan := make(chan interface{}, 1)
+	require.Nil(t, notifyUnknownHtlc(t, ctx, 0, hodlChan))
+
+	htlc := <-intercepted
+	require.Equal(t, getCircuitKey(0), htlc.CircuitKey)
+	require.Equal(t, testInvoicePaymentHash, htlc.Hash)
+	require.Equal(t, lnwire.MilliSatoshi(100000), htlc.Amount)
+
+	// A replay of the held htlc isn't presented again.
+	require.Nil(t, notifyUnknownHtlc(t, ctx, 0, hodlChan))
+	require.Len(t, intercepted, 0)
+
+	// Settling requires the matching preimage.
+	require.Equal(
+		t, ErrPreimageMismatch,
+		ctx.registry.SettleInterceptedHtlc(
+			htlc.CircuitKey, lntypes.Preimage{9},
+		),
+	)
+	require.NoError(t, ctx.registry.SettleInterceptedHtlc(
+		htlc.CircuitKey, testInvoicePreimage,
+	))
+
+	settle, ok := receiveResolution(t, hodlChan).(*HtlcSettleResolution)
+	require.True(t, ok)
+	require.Equal(t, testInvoicePreimage, settle.Preimage)
+
+	// The htlc can't be resolved twice.
+	require.Equal(
+		t, ErrHtlcNotIntercepted,
+		ctx.registry.FailInterceptedHtlc(htlc.CircuitKey),
+	)
+
+	// Fail a second htlc.
+	require.Nil(t, notifyUnknownHtlc(t, ctx, 1, hodlChan))
+	htlc = <-intercepted
+	require.NoError(t, ctx.registry.FailInterceptedHtlc(htlc.CircuitKey))
+
+	fail, ok := receiveResolution(t, hodlChan).(*HtlcFailResolution)
+	require.True(t, ok)
+	require.Equal(t, ResultHtlcAcceptorCanceled, fail.Outcome)
+}
+
+// TestHtlcAcceptorDisconnect tests that held htlcs are failed when the htlc
+// acceptor disconnects, and that htlcs are failed right away if the acceptor
+// doesn't take them.
+func TestHtlcAcceptorDisconnect(t *testing.T) {
+	t.Parallel()
+
+	ctx := newTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.registry.SetHtlcAcceptor(func(*InterceptedHtlc) bool {
+		return true
+	})
+
+	hodlChan := make(chan interface{}, 1)
+	require.Nil(t, notifyUnknownHtlc(t, ctx, 0, hodlChan))
+
+	ctx.registry.SetHtlcAcceptor(nil)
+
+	fail, ok := receiveResolution(t, hodlChan).(*HtlcFailResolution)
+	require.True(t, ok)
+	require.Equal(t, ResultHtlcAcceptorCanceled, fail.Outcome)
+
+	// An acceptor that doesn't take the htlc leaves it to the default
+	// behavior.
+	ctx.registry.SetHtlcAcceptor(func(*InterceptedHtlc) bool {
+		return false
+	})
+
+	resolution := notifyUnknownHtlc(t, ctx, 1, hodlChan)
+	fail, ok = resolution.(*HtlcFailResolution)
+	require.True(t, ok)
+	require.Equal(t, ResultInvoiceNotFound, fail.Outcome)
+}
+
+// TestHtlcAcceptorExpiry tests that an htlc that is still held for the htlc
+// acceptor is failed back once it expires within the final cltv reject delta.
+func TestHtlcAcceptorExpiry(t *testing.T) {
+	defer timeout()()
+
+	cdb, cleanup, err := newTestChannelDB(clock.NewTestClock(time.Time{}))
+	require.NoError(t, err)
+	defer cleanup()
+
+	notifier := &mock.ChainNotifier{
+		EpochChan: make(chan *chainntnfs.BlockEpoch),
+	}
+
+	cfg := RegistryConfig{
+		FinalCltvRejectDelta: testFinalCltvRejectDelta,
+		Clock:                clock.NewTestClock(testTime),
+	}
+	expiryWatcher := NewInvoiceExpiryWatcher(cfg.Clock, 0, notifier)
+	registry := NewRegistry(cdb, expiryWatcher, &cfg)
+
+	require.NoError(t, registry.Start())
+	defer registry.Stop()
+
+	registry.SetHtlcAcceptor(func(*InterceptedHtlc) bool {
+		return true
+	})
+
+	// Hold an htlc that expires at height 50.
+	const expiry = 50
+	key := getCircuitKey(0)
+	hodlChan := make(chan interface{}, 1)
+	resolution, err := registry.NotifyExitHopHtlc(
+		testInvoicePaymentHash, lnwire.MilliSatoshi(100000), expiry,
+		testCurrentHeight, key, hodlChan, testPayload,
+	)
+	require.NoError(t, err)
+	require.Nil(t, resolution, "expected htlc to be held")
+
+	// sendEpoch delivers a block epoch. Because the epoch channel is
+	// unbuffered, sending the same height twice guarantees that the first
+	// epoch has been fully processed.
+	sendEpoch := func(height int32) {
+		for i := 0; i < 2; i++ {
+			notifier.EpochChan <- &chainntnfs.BlockEpoch{
+				Height: height,
+			}
+		}
+	}
+
+	// Outside of the reject delta, the htlc remains held.
+	sendEpoch(expiry - testFinalCltvRejectDelta - 1)
+
+	select {
+	case <-hodlChan:
+		t.Fatal("unexpected htlc resolution")
+	default:
+	}
+
+	// Once the htlc is within the delta, it is failed back and can't be
+	// resolved by the acceptor anymore.
+	sendEpoch(expiry - testFinalCltvRejectDelta)
+
+	fail, ok := receiveResolution(t, hodlChan).(*HtlcFailResolution)
+	require.True(t, ok)
+	require.Equal(t, ResultExpiryTooSoon, fail.Outcome)
+
+	require.Equal(
+		t, ErrHtlcNotIntercepted,
+		registry.SettleInterceptedHtlc(key, testInvoicePreimage),
+	)
+}
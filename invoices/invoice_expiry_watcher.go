@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -26,6 +27,34 @@ func (e invoiceExpiry) Less(other queue.PriorityQueueItem) bool {
 	return e.Expiry.Before(other.(*invoiceExpiry).Expiry)
 }
 
+// heldInvoiceExpiry holds the payment hash of an accepted hold invoice and the
+// height at which the first of its accepted htlcs expires. This is used to
+// order accepted hold invoices by the expiry of their htlcs for cancellation.
+type heldInvoiceExpiry struct {
+	PaymentHash lntypes.Hash
+	Expiry      uint32
+}
+
+// Less implements PriorityQueueItem.Less such that the top item in the
+// priorty queue will be the one that has the htlc that expires next.
+func (e heldInvoiceExpiry) Less(other queue.PriorityQueueItem) bool {
+	return e.Expiry < other.(*heldInvoiceExpiry).Expiry
+}
+
+// interceptedHtlcExpiry holds the circuit key of an htlc that is held for the
+// htlc acceptor and the height at which it is canceled. This is used to order
+// intercepted htlcs by their cancel height.
+type interceptedHtlcExpiry struct {
+	CircuitKey   channeldb.CircuitKey
+	CancelHeight uint32
+}
+
+// Less implements PriorityQueueItem.Less such that the top item in the
+// priorty queue will be the one that is canceled next.
+func (e interceptedHtlcExpiry) Less(other queue.PriorityQueueItem) bool {
+	return e.CancelHeight < other.(*interceptedHtlcExpiry).CancelHeight
+}
+
 // InvoiceExpiryWatcher handles automatic invoice cancellation of expried
 // invoices. Upon start InvoiceExpiryWatcher will retrieve all pending (not yet
 // settled or canceled) invoices invoices to its watcing queue. When a new
@@ -33,6 +62,10 @@ func (e invoiceExpiry) Less(other queue.PriorityQueueItem) bool {
 // InvoiceExpiryWatcher and will end up in the watching queue as well.
 // If any of the watched invoices expire, they'll be removed from the watching
 // queue and will be cancelled through InvoiceRegistry.CancelInvoice().
+// Accepted hold invoices are watched by the expiry height of their htlcs
+// instead, and are canceled once the first htlc comes within the hold expiry
+// delta of the current block height. Htlcs that are held for the htlc acceptor
+// are canceled once their cancel height is reached.
 type InvoiceExpiryWatcher struct {
 	sync.Mutex
 	started bool
@@ -41,38 +74,89 @@ type InvoiceExpiryWatcher struct {
 	// It is useful for testing.
 	clock clock.Clock
 
+	// holdExpiryDelta is the number of blocks before the expiry of the
+	// first htlc of an accepted hold invoice at which the invoice is
+	// canceled. A value of zero disables the cancellation of accepted hold
+	// invoices.
+	holdExpiryDelta uint32
+
+	// notifier is used to receive the block epochs that drive the
+	// cancellation of accepted hold invoices and intercepted htlcs.
+	notifier chainntnfs.ChainNotifier
+
 	// cancelInvoice is a template method that cancels an expired invoice.
 	cancelInvoice func(lntypes.Hash, bool) error
 
+	// cancelHeldInvoice is a template method that cancels an accepted hold
+	// invoice of which the htlcs are about to expire.
+	cancelHeldInvoice func(lntypes.Hash) error
+
+	// cancelInterceptedHtlc is a template method that cancels an htlc
+	// that is held for the htlc acceptor.
+	cancelInterceptedHtlc func(channeldb.CircuitKey) error
+
 	// expiryQueue holds invoiceExpiry items and is used to find the next
 	// invoice to expire.
 	expiryQueue queue.PriorityQueue
 
+	// heldExpiryQueue holds heldInvoiceExpiry items and is used to find
+	// the next accepted hold invoice of which the htlcs expire.
+	heldExpiryQueue queue.PriorityQueue
+
+	// interceptedExpiryQueue holds interceptedHtlcExpiry items and is used
+	// to find the next intercepted htlc to cancel.
+	interceptedExpiryQueue queue.PriorityQueue
+
+	// currentHeight is the height of the last block epoch that was
+	// received.
+	currentHeight uint32
+
 	// newInvoices channel is used to wake up the main loop when a new
 	// invoices is added.
 	newInvoices chan []*invoiceExpiry
 
+	// newHeldInvoices channel is used to wake up the main loop when a hold
+	// invoice is accepted.
+	newHeldInvoices chan []*heldInvoiceExpiry
+
+	// newInterceptedHtlcs channel is used to wake up the main loop when an
+	// htlc is held for the htlc acceptor.
+	newInterceptedHtlcs chan []*interceptedHtlcExpiry
+
 	wg sync.WaitGroup
 
 	// quit signals InvoiceExpiryWatcher to stop.
 	quit chan struct{}
 }
 
-// NewInvoiceExpiryWatcher creates a new InvoiceExpiryWatcher instance.
-func NewInvoiceExpiryWatcher(clock clock.Clock) *InvoiceExpiryWatcher {
+// NewInvoiceExpiryWatcher creates a new InvoiceExpiryWatcher instance. Accepted
+// hold invoices are canceled holdExpiryDelta blocks before their first htlc
+// expires. If holdExpiryDelta is zero or no notifier is passed, accepted hold
+// invoices aren't canceled automatically. Without a notifier, intercepted htlcs
+// aren't canceled automatically either.
+func NewInvoiceExpiryWatcher(clock clock.Clock, holdExpiryDelta uint32,
+	notifier chainntnfs.ChainNotifier) *InvoiceExpiryWatcher {
+
 	return &InvoiceExpiryWatcher{
-		clock:       clock,
-		newInvoices: make(chan []*invoiceExpiry),
-		quit:        make(chan struct{}),
+		clock:               clock,
+		holdExpiryDelta:     holdExpiryDelta,
+		notifier:            notifier,
+		newInvoices:         make(chan []*invoiceExpiry),
+		newHeldInvoices:     make(chan []*heldInvoiceExpiry),
+		newInterceptedHtlcs: make(chan []*interceptedHtlcExpiry),
+		quit:                make(chan struct{}),
 	}
 }
 
 // Start starts the the subscription handler and the main loop. Start() will
 // return with error if InvoiceExpiryWatcher is already started. Start()
 // expects a cancellation function passed that will be use to cancel expired
-// invoices by their payment hash.
+// invoices by their payment hash, one that cancels accepted hold invoices of
+// which the htlcs are about to expire and one that cancels intercepted htlcs.
 func (ew *InvoiceExpiryWatcher) Start(
-	cancelInvoice func(lntypes.Hash, bool) error) error {
+	cancelInvoice func(lntypes.Hash, bool) error,
+	cancelHeldInvoice func(lntypes.Hash) error,
+	cancelInterceptedHtlc func(channeldb.CircuitKey) error) error {
 
 	ew.Lock()
 	defer ew.Unlock()
@@ -81,10 +165,24 @@ func (ew *InvoiceExpiryWatcher) Start(
 		return fmt.Errorf("InvoiceExpiryWatcher already started")
 	}
 
+	// Without a subscription to block epochs, the main loop never
+	// receives an epoch and held invoices and intercepted htlcs stay in
+	// their queues.
+	var blockEpochs *chainntnfs.BlockEpochEvent
+	if ew.notifier != nil {
+		var err error
+		blockEpochs, err = ew.notifier.RegisterBlockEpochNtfn(nil)
+		if err != nil {
+			return err
+		}
+	}
+
 	ew.started = true
 	ew.cancelInvoice = cancelInvoice
+	ew.cancelHeldInvoice = cancelHeldInvoice
+	ew.cancelInterceptedHtlc = cancelInterceptedHtlc
 	ew.wg.Add(1)
-	go ew.mainLoop()
+	go ew.mainLoop(blockEpochs)
 
 	return nil
 }
@@ -128,6 +226,37 @@ func makeInvoiceExpiry(paymentHash lntypes.Hash,
 	}
 }
 
+// makeHeldInvoiceExpiry checks if the passed invoice is an accepted hold
+// invoice and creates a heldInvoiceExpiry object with the hash and the lowest
+// expiry height of its accepted htlcs.
+func makeHeldInvoiceExpiry(paymentHash lntypes.Hash,
+	invoice *channeldb.Invoice) *heldInvoiceExpiry {
+
+	if invoice.State != channeldb.ContractAccepted {
+		return nil
+	}
+
+	var expiry uint32
+	for _, htlc := range invoice.Htlcs {
+		if htlc.State != channeldb.HtlcStateAccepted {
+			continue
+		}
+
+		if expiry == 0 || htlc.Expiry < expiry {
+			expiry = htlc.Expiry
+		}
+	}
+
+	if expiry == 0 {
+		return nil
+	}
+
+	return &heldInvoiceExpiry{
+		PaymentHash: paymentHash,
+		Expiry:      expiry,
+	}
+}
+
 // AddInvoices adds invoices to the InvoiceExpiryWatcher.
 func (ew *InvoiceExpiryWatcher) AddInvoices(invoices ...*invoiceExpiry) {
 	if len(invoices) > 0 {
@@ -143,6 +272,41 @@ func (ew *InvoiceExpiryWatcher) AddInvoices(invoices ...*invoiceExpiry) {
 	}
 }
 
+// AddHeldInvoices adds accepted hold invoices to the InvoiceExpiryWatcher.
+func (ew *InvoiceExpiryWatcher) AddHeldInvoices(
+	invoices ...*heldInvoiceExpiry) {
+
+	if len(invoices) > 0 {
+		select {
+		case ew.newHeldInvoices <- invoices:
+			log.Debugf("Added %d held invoices to the expiry "+
+				"watcher", len(invoices))
+
+		// Select on quit too so that callers won't get blocked in case
+		// of concurrent shutdown.
+		case <-ew.quit:
+		}
+	}
+}
+
+// AddInterceptedHtlcs adds htlcs that are held for the htlc acceptor to the
+// InvoiceExpiryWatcher.
+func (ew *InvoiceExpiryWatcher) AddInterceptedHtlcs(
+	htlcs ...*interceptedHtlcExpiry) {
+
+	if len(htlcs) > 0 {
+		select {
+		case ew.newInterceptedHtlcs <- htlcs:
+			log.Debugf("Added %d intercepted htlcs to the expiry "+
+				"watcher", len(htlcs))
+
+		// Select on quit too so that callers won't get blocked in case
+		// of concurrent shutdown.
+		case <-ew.quit:
+		}
+	}
+}
+
 // nextExpiry returns a Time chan to wait on until the next invoice expires.
 // If there are no active invoices, then it'll simply wait indefinitely.
 func (ew *InvoiceExpiryWatcher) nextExpiry() <-chan time.Time {
@@ -181,14 +345,84 @@ func (ew *InvoiceExpiryWatcher) cancelNextExpiredInvoice() {
 	}
 }
 
+// cancelExpiringHeldInvoices cancels all accepted hold invoices of which the
+// first htlc expires within the hold expiry delta of the current height, and
+// removes them from the held expiry queue.
+func (ew *InvoiceExpiryWatcher) cancelExpiringHeldInvoices() {
+	// Nothing can be canceled before we know the current height, and a
+	// zero hold expiry delta disables the cancellation.
+	if ew.currentHeight == 0 || ew.holdExpiryDelta == 0 {
+		return
+	}
+
+	for !ew.heldExpiryQueue.Empty() {
+		top := ew.heldExpiryQueue.Top().(*heldInvoiceExpiry)
+		if top.Expiry > ew.currentHeight+ew.holdExpiryDelta {
+			return
+		}
+
+		log.Infof("Canceling accepted hold invoice %v at height %v, "+
+			"htlc expiry %v", top.PaymentHash, ew.currentHeight,
+			top.Expiry)
+
+		err := ew.cancelHeldInvoice(top.PaymentHash)
+		if err != nil && err != channeldb.ErrInvoiceAlreadySettled &&
+			err != channeldb.ErrInvoiceAlreadyCanceled {
+
+			log.Errorf("Unable to cancel held invoice %v: %v",
+				top.PaymentHash, err)
+		}
+
+		ew.heldExpiryQueue.Pop()
+	}
+}
+
+// cancelExpiringInterceptedHtlcs cancels all htlcs held for the htlc acceptor
+// of which the cancel height is reached, and removes them from the intercepted
+// expiry queue.
+func (ew *InvoiceExpiryWatcher) cancelExpiringInterceptedHtlcs() {
+	// Nothing can be canceled before we know the current height.
+	if ew.currentHeight == 0 {
+		return
+	}
+
+	for !ew.interceptedExpiryQueue.Empty() {
+		top := ew.interceptedExpiryQueue.Top().(*interceptedHtlcExpiry)
+		if top.CancelHeight > ew.currentHeight {
+			return
+		}
+
+		// The htlc may have been resolved by the htlc acceptor in the
+		// meantime, in which case there is nothing to cancel.
+		err := ew.cancelInterceptedHtlc(top.CircuitKey)
+		if err != nil && err != ErrHtlcNotIntercepted {
+			log.Errorf("Unable to cancel intercepted htlc %v: %v",
+				top.CircuitKey, err)
+		}
+
+		ew.interceptedExpiryQueue.Pop()
+	}
+}
+
 // mainLoop is a goroutine that receives new invoices and handles cancellation
-// of expired invoices.
-func (ew *InvoiceExpiryWatcher) mainLoop() {
+// of expired invoices, of accepted hold invoices of which the htlcs are about
+// to expire and of intercepted htlcs.
+func (ew *InvoiceExpiryWatcher) mainLoop(
+	blockEpochs *chainntnfs.BlockEpochEvent) {
+
 	defer ew.wg.Done()
 
+	var epochs <-chan *chainntnfs.BlockEpoch
+	if blockEpochs != nil {
+		defer blockEpochs.Cancel()
+		epochs = blockEpochs.Epochs
+	}
+
 	for {
 		// Cancel any invoices that may have expired.
 		ew.cancelNextExpiredInvoice()
+		ew.cancelExpiringHeldInvoices()
+		ew.cancelExpiringInterceptedHtlcs()
 
 		pushInvoices := func(invoicesWithExpiry []*invoiceExpiry) {
 			for _, invoiceWithExpiry := range invoicesWithExpiry {
@@ -199,6 +433,22 @@ func (ew *InvoiceExpiryWatcher) mainLoop() {
 			}
 		}
 
+		pushHeldInvoices := func(heldInvoices []*heldInvoiceExpiry) {
+			for _, heldInvoice := range heldInvoices {
+				if heldInvoice != nil {
+					ew.heldExpiryQueue.Push(heldInvoice)
+				}
+			}
+		}
+
+		pushInterceptedHtlcs := func(htlcs []*interceptedHtlcExpiry) {
+			for _, htlc := range htlcs {
+				if htlc != nil {
+					ew.interceptedExpiryQueue.Push(htlc)
+				}
+			}
+		}
+
 		select {
 
 		case invoicesWithExpiry := <-ew.newInvoices:
@@ -207,6 +457,14 @@ func (ew *InvoiceExpiryWatcher) mainLoop() {
 			pushInvoices(invoicesWithExpiry)
 			continue
 
+		case heldInvoices := <-ew.newHeldInvoices:
+			pushHeldInvoices(heldInvoices)
+			continue
+
+		case htlcs := <-ew.newInterceptedHtlcs:
+			pushInterceptedHtlcs(htlcs)
+			continue
+
 		default:
 			select {
 
@@ -217,6 +475,19 @@ func (ew *InvoiceExpiryWatcher) mainLoop() {
 			case invoicesWithExpiry := <-ew.newInvoices:
 				pushInvoices(invoicesWithExpiry)
 
+			case heldInvoices := <-ew.newHeldInvoices:
+				pushHeldInvoices(heldInvoices)
+
+			case htlcs := <-ew.newInterceptedHtlcs:
+				pushInterceptedHtlcs(htlcs)
+
+			case epoch, ok := <-epochs:
+				if !ok {
+					return
+				}
+
+				ew.currentHeight = uint32(epoch.Height)
+
 			case <-ew.quit:
 				return
 			}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/lntypes"
 )
@@ -25,7 +26,9 @@ func newInvoiceExpiryWatcherTest(t *testing.T, now time.Time,
 	numExpiredInvoices, numPendingInvoices int) *invoiceExpiryWatcherTest {
 
 	test := &invoiceExpiryWatcherTest{
-		watcher: NewInvoiceExpiryWatcher(clock.NewTestClock(testTime)),
+		watcher: NewInvoiceExpiryWatcher(
+			clock.NewTestClock(testTime), 0, nil,
+		),
 		testData: generateInvoiceExpiryTestData(
 			t, now, 0, numExpiredInvoices, numPendingInvoices,
 		),
@@ -41,6 +44,12 @@ func newInvoiceExpiryWatcherTest(t *testing.T, now time.Time,
 		)
 		test.wg.Done()
 		return nil
+	}, func(lntypes.Hash) error {
+		t.Fatalf("unexpected call")
+		return nil
+	}, func(channeldb.CircuitKey) error {
+		t.Fatalf("unexpected call")
+		return nil
 	})
 
 	if err != nil {
@@ -84,23 +93,36 @@ func (t *invoiceExpiryWatcherTest) checkExpectations() {
 
 // Tests that InvoiceExpiryWatcher can be started and stopped.
 func TestInvoiceExpiryWatcherStartStop(t *testing.T) {
-	watcher := NewInvoiceExpiryWatcher(clock.NewTestClock(testTime))
+	watcher := NewInvoiceExpiryWatcher(
+		clock.NewTestClock(testTime), 0, nil,
+	)
 	cancel := func(lntypes.Hash, bool) error {
 		t.Fatalf("unexpected call")
 		return nil
 	}
+	cancelHeld := func(lntypes.Hash) error {
+		t.Fatalf("unexpected call")
+		return nil
+	}
+	cancelIntercepted := func(channeldb.CircuitKey) error {
+		t.Fatalf("unexpected call")
+		return nil
+	}
 
-	if err := watcher.Start(cancel); err != nil {
+	err := watcher.Start(cancel, cancelHeld, cancelIntercepted)
+	if err != nil {
 		t.Fatalf("unexpected error upon start: %v", err)
 	}
 
-	if err := watcher.Start(cancel); err == nil {
+	err = watcher.Start(cancel, cancelHeld, cancelIntercepted)
+	if err == nil {
 		t.Fatalf("expected error upon second start")
 	}
 
 	watcher.Stop()
 
-	if err := watcher.Start(cancel); err != nil {
+	err = watcher.Start(cancel, cancelHeld, cancelIntercepted)
+	if err != nil {
 		t.Fatalf("unexpected error upon start: %v", err)
 	}
 }
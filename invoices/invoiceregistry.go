@@ -27,6 +27,11 @@ var (
 	// ErrShuttingDown is returned when an operation failed because the
 	// invoice registry is shutting down.
 	ErrShuttingDown = errors.New("invoice registry shutting down")
+
+	// ErrInvoiceNotResolved is returned when an invoice is attempted to be
+	// deleted while it is still open or accepted.
+	ErrInvoiceNotResolved = errors.New("only settled or canceled " +
+		"invoices can be deleted")
 )
 
 const (
@@ -128,6 +133,14 @@ type InvoiceRegistry struct {
 
 	expiryWatcher *InvoiceExpiryWatcher
 
+	// htlcAcceptor is invoked for exit hop htlcs for which no invoice
+	// exists. It is nil if no acceptor is registered.
+	htlcAcceptor HtlcAcceptor
+
+	// interceptedHtlcs contains the htlcs that are currently held for the
+	// htlc acceptor, keyed by their circuit key.
+	interceptedHtlcs map[channeldb.CircuitKey]*InterceptedHtlc
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -151,6 +164,7 @@ func NewRegistry(cdb *channeldb.DB, expiryWatcher *InvoiceExpiryWatcher,
 		cfg:                       cfg,
 		htlcAutoReleaseChan:       make(chan *htlcReleaseEvent),
 		expiryWatcher:             expiryWatcher,
+		interceptedHtlcs:          make(map[channeldb.CircuitKey]*InterceptedHtlc),
 		quit:                      make(chan struct{}),
 	}
 }
@@ -161,6 +175,7 @@ func NewRegistry(cdb *channeldb.DB, expiryWatcher *InvoiceExpiryWatcher,
 func (i *InvoiceRegistry) scanInvoicesOnStart() error {
 	var (
 		pending   []*invoiceExpiry
+		held      []*heldInvoiceExpiry
 		removable []channeldb.InvoiceDeleteRef
 	)
 
@@ -171,6 +186,7 @@ func (i *InvoiceRegistry) scanInvoicesOnStart() error {
 		// using the etcd driver, where all transactions are allowed
 		// to retry for serializability).
 		pending = nil
+		held = nil
 		removable = make([]channeldb.InvoiceDeleteRef, 0)
 	}
 
@@ -182,6 +198,11 @@ func (i *InvoiceRegistry) scanInvoicesOnStart() error {
 			if expiryRef != nil {
 				pending = append(pending, expiryRef)
 			}
+
+			heldRef := makeHeldInvoiceExpiry(paymentHash, invoice)
+			if heldRef != nil {
+				held = append(held, heldRef)
+			}
 		} else if i.cfg.GcCanceledInvoicesOnStartup &&
 			invoice.State == channeldb.ContractCanceled {
 
@@ -213,6 +234,10 @@ func (i *InvoiceRegistry) scanInvoicesOnStart() error {
 		len(pending))
 	i.expiryWatcher.AddInvoices(pending...)
 
+	log.Debugf("Adding %d accepted hold invoices to the expiry watcher",
+		len(held))
+	i.expiryWatcher.AddHeldInvoices(held...)
+
 	if err := i.cdb.DeleteInvoice(removable); err != nil {
 		log.Warnf("Deleting old invoices failed: %v", err)
 	}
@@ -224,7 +249,10 @@ func (i *InvoiceRegistry) scanInvoicesOnStart() error {
 func (i *InvoiceRegistry) Start() error {
 	// Start InvoiceExpiryWatcher and prepopulate it with existing active
 	// invoices.
-	err := i.expiryWatcher.Start(i.cancelInvoiceImpl)
+	err := i.expiryWatcher.Start(
+		i.cancelInvoiceImpl, i.cancelHeldInvoice,
+		i.cancelInterceptedHtlc,
+	)
 
 	if err != nil {
 		return err
@@ -259,6 +287,10 @@ func (i *InvoiceRegistry) Stop() {
 type invoiceEvent struct {
 	hash    lntypes.Hash
 	invoice *channeldb.Invoice
+
+	// rejection is set if the event reports an htlc that was rejected by
+	// the acceptance policy of the invoice.
+	rejection *HtlcRejection
 }
 
 // tickAt returns a channel that ticks at the specified time. If the time has
@@ -321,9 +353,11 @@ func (i *InvoiceRegistry) invoiceEventLoop() {
 			case *invoiceEvent:
 				// For backwards compatibility, do not notify
 				// all invoice subscribers of cancel and accept
-				// events.
+				// events. Htlc rejections are only reported to
+				// single invoice subscribers.
 				state := e.invoice.State
-				if state != channeldb.ContractCanceled &&
+				if e.rejection == nil &&
+					state != channeldb.ContractCanceled &&
 					state != channeldb.ContractAccepted {
 
 					i.dispatchToClients(e)
@@ -831,6 +865,14 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		return nil, err
 	}
 
+	// If we don't have an invoice for this htlc, the htlc acceptor may
+	// still want to resolve it.
+	if r, ok := resolution.(*HtlcFailResolution); ok &&
+		r.Outcome == ResultInvoiceNotFound {
+
+		return i.interceptHtlc(&ctx, hodlChan, r)
+	}
+
 	switch r := resolution.(type) {
 	// The htlc is held. Start a timer outside the lock if the htlc should
 	// be auto-released, because otherwise a deadlock may happen with the
@@ -845,6 +887,14 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 			}
 		}
 
+		// Watch the htlcs of an accepted hold invoice, so that the
+		// invoice is canceled before they expire. This happens outside
+		// the lock too, because the expiry watcher obtains the lock to
+		// cancel invoices.
+		if r.heldExpiry != nil {
+			i.expiryWatcher.AddHeldInvoices(r.heldExpiry)
+		}
+
 		// We return a nil resolution because htlc acceptances are
 		// represented as nil resolutions externally.
 		// TODO(carla) update calling code to handle accept resolutions.
@@ -927,6 +977,14 @@ func (i *InvoiceRegistry) notifyExitHopHtlcLocked(
 			"outcome: %v, at accept height: %v",
 			res.Outcome, res.AcceptHeight))
 
+		// Report htlcs that were rejected by the acceptance policy of
+		// the invoice to the subscribers of the invoice.
+		rejection := ctx.checkAcceptPolicy(invoice.AcceptPolicy)
+		if rejection != nil && rejection.Outcome == res.Outcome {
+			rejection.Invoice = invoice
+			i.notifyRejection(ctx.hash, rejection)
+		}
+
 	// If the htlc was settled, we will settle any previously accepted
 	// htlcs and notify our peer to settle them.
 	case *HtlcSettleResolution:
@@ -986,6 +1044,10 @@ func (i *InvoiceRegistry) notifyExitHopHtlcLocked(
 
 		}
 
+		// Otherwise the invoice is accepted and waits for the hold
+		// invoice to be settled or canceled.
+		res.heldExpiry = makeHeldInvoiceExpiry(ctx.hash, invoice)
+
 		i.hodlSubscribe(hodlChan, ctx.circuitKey)
 
 	default:
@@ -1068,6 +1130,52 @@ func (i *InvoiceRegistry) CancelInvoice(payHash lntypes.Hash) error {
 	return i.cancelInvoiceImpl(payHash, true)
 }
 
+// DeleteInvoice deletes the settled or canceled invoice corresponding to the
+// passed payment hash. Open and accepted invoices need to be canceled first,
+// as their htlcs still depend on them.
+func (i *InvoiceRegistry) DeleteInvoice(payHash lntypes.Hash) error {
+	i.Lock()
+	defer i.Unlock()
+
+	invoice, err := i.cdb.LookupInvoice(channeldb.InvoiceRefByHash(payHash))
+	if err != nil {
+		return err
+	}
+
+	if invoice.State != channeldb.ContractSettled &&
+		invoice.State != channeldb.ContractCanceled {
+
+		return ErrInvoiceNotResolved
+	}
+
+	deleteRef := channeldb.InvoiceDeleteRef{
+		PayHash:     payHash,
+		AddIndex:    invoice.AddIndex,
+		SettleIndex: invoice.SettleIndex,
+	}
+	if invoice.Terms.PaymentAddr != channeldb.BlankPayAddr {
+		deleteRef.PayAddr = &invoice.Terms.PaymentAddr
+	}
+
+	err = i.cdb.DeleteInvoice([]channeldb.InvoiceDeleteRef{deleteRef})
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("Invoice%v: deleted", payHash)
+
+	return nil
+}
+
+// cancelHeldInvoice cancels an accepted hold invoice of which the htlcs are
+// about to expire. The canceled htlcs are reported to the subscribers of the
+// invoice.
+func (i *InvoiceRegistry) cancelHeldInvoice(payHash lntypes.Hash) error {
+	return i.cancelInvoiceWithOutcome(
+		payHash, true, ResultHoldExpiryTooSoon,
+	)
+}
+
 // cancelInvoice attempts to cancel the invoice corresponding to the passed
 // payment hash. Accepted invoices will only be canceled if explicitly
 // requested to do so. It notifies subscribing links and resolvers that
@@ -1075,15 +1183,37 @@ func (i *InvoiceRegistry) CancelInvoice(payHash lntypes.Hash) error {
 func (i *InvoiceRegistry) cancelInvoiceImpl(payHash lntypes.Hash,
 	cancelAccepted bool) error {
 
+	return i.cancelInvoiceWithOutcome(
+		payHash, cancelAccepted, ResultCanceled,
+	)
+}
+
+// cancelInvoiceWithOutcome cancels an invoice and fails its htlcs back with the
+// given outcome. Htlcs that are canceled for another reason than an explicit
+// cancellation are reported to the subscribers of the invoice.
+func (i *InvoiceRegistry) cancelInvoiceWithOutcome(payHash lntypes.Hash,
+	cancelAccepted bool, outcome FailResolutionResult) error {
+
 	i.Lock()
 	defer i.Unlock()
 
 	ref := channeldb.InvoiceRefByHash(payHash)
 	log.Debugf("Invoice%v: canceling invoice", ref)
 
+	// Track the htlcs that are accepted before the cancellation, so that
+	// we know which htlcs are canceled by it.
+	var accepted map[channeldb.CircuitKey]struct{}
+
 	updateInvoice := func(invoice *channeldb.Invoice) (
 		*channeldb.InvoiceUpdateDesc, error) {
 
+		accepted = make(map[channeldb.CircuitKey]struct{})
+		for key, htlc := range invoice.Htlcs {
+			if htlc.State == channeldb.HtlcStateAccepted {
+				accepted[key] = struct{}{}
+			}
+		}
+
 		// Only cancel the invoice in ContractAccepted state if explicitly
 		// requested to do so.
 		if invoice.State == channeldb.ContractAccepted && !cancelAccepted {
@@ -1134,12 +1264,29 @@ func (i *InvoiceRegistry) cancelInvoiceImpl(payHash lntypes.Hash,
 
 		i.notifyHodlSubscribers(
 			NewFailResolution(
-				key, int32(htlc.AcceptHeight), ResultCanceled,
+				key, int32(htlc.AcceptHeight), outcome,
 			),
 		)
 	}
 	i.notifyClients(payHash, invoice, channeldb.ContractCanceled)
 
+	if outcome != ResultCanceled {
+		for key := range accepted {
+			htlc, ok := invoice.Htlcs[key]
+			if !ok || htlc.State != channeldb.HtlcStateCanceled {
+				continue
+			}
+
+			i.notifyRejection(payHash, &HtlcRejection{
+				Invoice:    invoice,
+				CircuitKey: key,
+				Amt:        htlc.Amt,
+				Expiry:     htlc.Expiry,
+				Outcome:    outcome,
+			})
+		}
+	}
+
 	// Attempt to also delete the invoice if requested through the registry
 	// config.
 	if i.cfg.GcCanceledInvoicesOnTheFly {
@@ -1186,6 +1333,23 @@ func (i *InvoiceRegistry) notifyClients(hash lntypes.Hash,
 	}
 }
 
+// notifyRejection notifies the single invoice subscribers of an htlc that was
+// rejected by the acceptance policy of the invoice.
+func (i *InvoiceRegistry) notifyRejection(hash lntypes.Hash,
+	rejection *HtlcRejection) {
+
+	event := &invoiceEvent{
+		invoice:   rejection.Invoice,
+		hash:      hash,
+		rejection: rejection,
+	}
+
+	select {
+	case i.invoiceEvents <- event:
+	case <-i.quit:
+	}
+}
+
 // invoiceSubscriptionKit defines that are common to both all invoice
 // subscribers and single invoice subscribers.
 type invoiceSubscriptionKit struct {
@@ -1240,6 +1404,10 @@ type SingleInvoiceSubscription struct {
 	// Updates is a channel that we'll use to send all invoice events for
 	// the invoice that is subscribed to.
 	Updates chan *channeldb.Invoice
+
+	// Rejections is a channel that we'll use to send the htlcs that were
+	// rejected by the acceptance policy of the invoice.
+	Rejections chan *HtlcRejection
 }
 
 // Cancel unregisters the InvoiceSubscription, freeing any previously allocated
@@ -1371,7 +1539,8 @@ func (i *InvoiceRegistry) SubscribeSingleInvoice(
 	hash lntypes.Hash) (*SingleInvoiceSubscription, error) {
 
 	client := &SingleInvoiceSubscription{
-		Updates: make(chan *channeldb.Invoice),
+		Updates:    make(chan *channeldb.Invoice),
+		Rejections: make(chan *HtlcRejection),
 		invoiceSubscriptionKit: invoiceSubscriptionKit{
 			inv:        i,
 			ntfnQueue:  queue.NewConcurrentQueue(20),
@@ -1402,6 +1571,23 @@ func (i *InvoiceRegistry) SubscribeSingleInvoice(
 			case ntfn := <-client.ntfnQueue.ChanOut():
 				invoiceEvent := ntfn.(*invoiceEvent)
 
+				// Htlc rejections are delivered separately
+				// from invoice updates.
+				rejection := invoiceEvent.rejection
+				if rejection != nil {
+					select {
+					case client.Rejections <- rejection:
+
+					case <-client.cancelChan:
+						return
+
+					case <-i.quit:
+						return
+					}
+
+					continue
+				}
+
 				select {
 				case client.Updates <- invoiceEvent.invoice:
 
@@ -1489,6 +1675,22 @@ func (i *InvoiceRegistry) hodlSubscribe(subscriber chan<- interface{},
 	reverseSubscriptions[circuitKey] = struct{}{}
 }
 
+// hodlUnsubscribe removes the subscription of the given subscriber to the
+// resolution of a single htlc.
+func (i *InvoiceRegistry) hodlUnsubscribe(subscriber chan<- interface{},
+	circuitKey channeldb.CircuitKey) {
+
+	delete(i.hodlSubscriptions[circuitKey], subscriber)
+	if len(i.hodlSubscriptions[circuitKey]) == 0 {
+		delete(i.hodlSubscriptions, circuitKey)
+	}
+
+	delete(i.hodlReverseSubscriptions[subscriber], circuitKey)
+	if len(i.hodlReverseSubscriptions[subscriber]) == 0 {
+		delete(i.hodlReverseSubscriptions, subscriber)
+	}
+}
+
 // HodlUnsubscribeAll cancels the subscription.
 func (i *InvoiceRegistry) HodlUnsubscribeAll(subscriber chan<- interface{}) {
 	i.Lock()
@@ -5,8 +5,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntest/mock"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/record"
@@ -385,7 +387,9 @@ func TestSettleHoldInvoice(t *testing.T) {
 		FinalCltvRejectDelta: testFinalCltvRejectDelta,
 		Clock:                clock.NewTestClock(testTime),
 	}
-	registry := NewRegistry(cdb, NewInvoiceExpiryWatcher(cfg.Clock), &cfg)
+	registry := NewRegistry(
+		cdb, NewInvoiceExpiryWatcher(cfg.Clock, 0, nil), &cfg,
+	)
 
 	err = registry.Start()
 	if err != nil {
@@ -573,7 +577,9 @@ func TestCancelHoldInvoice(t *testing.T) {
 		FinalCltvRejectDelta: testFinalCltvRejectDelta,
 		Clock:                clock.NewTestClock(testTime),
 	}
-	registry := NewRegistry(cdb, NewInvoiceExpiryWatcher(cfg.Clock), &cfg)
+	registry := NewRegistry(
+		cdb, NewInvoiceExpiryWatcher(cfg.Clock, 0, nil), &cfg,
+	)
 
 	err = registry.Start()
 	if err != nil {
@@ -1031,7 +1037,7 @@ func TestInvoiceExpiryWithRegistry(t *testing.T) {
 		Clock:                testClock,
 	}
 
-	expiryWatcher := NewInvoiceExpiryWatcher(cfg.Clock)
+	expiryWatcher := NewInvoiceExpiryWatcher(cfg.Clock, 0, nil)
 	registry := NewRegistry(cdb, expiryWatcher, &cfg)
 
 	// First prefill the Channel DB with some pre-existing invoices,
@@ -1132,7 +1138,7 @@ func TestOldInvoiceRemovalOnStart(t *testing.T) {
 		GcCanceledInvoicesOnStartup: true,
 	}
 
-	expiryWatcher := NewInvoiceExpiryWatcher(cfg.Clock)
+	expiryWatcher := NewInvoiceExpiryWatcher(cfg.Clock, 0, nil)
 	registry := NewRegistry(cdb, expiryWatcher, &cfg)
 
 	// First prefill the Channel DB with some pre-existing expired invoices.
@@ -1364,3 +1370,232 @@ func TestSettleInvoicePaymentAddrRequiredOptionalGrace(t *testing.T) {
 		t.Fatal("no update received")
 	}
 }
+
+// TestHoldInvoiceAcceptPolicy tests that htlcs that don't satisfy the
+// acceptance policy of a hold invoice are rejected and reported to the single
+// invoice subscribers, and that the invoice is accepted once the minimum
+// accept amount is paid.
+func TestHoldInvoiceAcceptPolicy(t *testing.T) {
+	defer timeout()()
+
+	ctx := newTestContext(t)
+	defer ctx.cleanup()
+
+	const recordType = 100001
+
+	invoice := *testHodlInvoice
+	invoice.AcceptPolicy = &channeldb.InvoiceAcceptPolicy{
+		MinCltvDelta: 10,
+		RequiredCustomRecords: record.CustomSet{
+			recordType: []byte{1, 2},
+		},
+		MinAcceptAmt: testInvoiceAmt / 2,
+	}
+
+	subscription, err := ctx.registry.SubscribeSingleInvoice(
+		testInvoicePaymentHash,
+	)
+	require.NoError(t, err)
+	defer subscription.Cancel()
+
+	_, err = ctx.registry.AddInvoice(&invoice, testInvoicePaymentHash)
+	require.NoError(t, err)
+
+	update := <-subscription.Updates
+	require.Equal(t, channeldb.ContractOpen, update.State)
+
+	amt := testInvoiceAmt / 2
+	expiry := uint32(testCurrentHeight) + 20
+
+	// notifyRejected sends an htlc to the invoice and asserts that it is
+	// rejected with the expected outcome.
+	notifyRejected := func(htlcID uint64, expiry uint32,
+		payload *mockPayload, outcome FailResolutionResult) {
+
+		key := getCircuitKey(htlcID)
+		resolution, err := ctx.registry.NotifyExitHopHtlc(
+			testInvoicePaymentHash, amt, expiry, testCurrentHeight,
+			key, make(chan interface{}, 1), payload,
+		)
+		require.NoError(t, err)
+
+		failResolution, ok := resolution.(*HtlcFailResolution)
+		require.Truef(t, ok, "expected fail resolution, got: %T",
+			resolution)
+		require.Equal(t, outcome, failResolution.Outcome)
+
+		select {
+		case rejection := <-subscription.Rejections:
+			require.Equal(t, key, rejection.CircuitKey)
+			require.Equal(t, amt, rejection.Amt)
+			require.Equal(t, expiry, rejection.Expiry)
+			require.Equal(t, outcome, rejection.Outcome)
+			require.Equal(t, channeldb.ContractOpen,
+				rejection.Invoice.State)
+
+		case <-time.After(testTimeout):
+			t.Fatal("no rejection received")
+		}
+	}
+
+	validPayload := &mockPayload{
+		customRecords: record.CustomSet{
+			recordType: []byte{1, 2},
+		},
+	}
+
+	// An htlc that expires before the minimum cltv delta of the policy is
+	// rejected.
+	notifyRejected(
+		0, testHtlcExpiry, validPayload, ResultPolicyExpiryTooSoon,
+	)
+
+	// So are htlcs without the required record or with another value.
+	notifyRejected(1, expiry, &mockPayload{}, ResultPolicyMissingRecord)
+	notifyRejected(
+		2, expiry, &mockPayload{
+			customRecords: record.CustomSet{
+				recordType: []byte{3},
+			},
+		}, ResultPolicyRecordMismatch,
+	)
+
+	// An htlc that satisfies the policy and pays the minimum accept amount
+	// is held and accepts the invoice.
+	resolution, err := ctx.registry.NotifyExitHopHtlc(
+		testInvoicePaymentHash, amt, expiry, testCurrentHeight,
+		getCircuitKey(3), make(chan interface{}, 1), validPayload,
+	)
+	require.NoError(t, err)
+	require.Nil(t, resolution, "expected htlc to be held")
+
+	update = <-subscription.Updates
+	require.Equal(t, channeldb.ContractAccepted, update.State)
+	require.Equal(t, amt, update.AmtPaid)
+}
+
+// TestHoldInvoiceExpiry tests that an accepted hold invoice is canceled once
+// its htlc comes within the hold expiry delta of its expiry, and that the
+// canceled htlc is reported to the subscribers of the invoice.
+func TestHoldInvoiceExpiry(t *testing.T) {
+	defer timeout()()
+
+	const holdExpiryDelta = 10
+
+	cdb, cleanup, err := newTestChannelDB(clock.NewTestClock(time.Time{}))
+	require.NoError(t, err)
+	defer cleanup()
+
+	notifier := &mock.ChainNotifier{
+		EpochChan: make(chan *chainntnfs.BlockEpoch),
+	}
+
+	cfg := RegistryConfig{
+		FinalCltvRejectDelta: testFinalCltvRejectDelta,
+		Clock:                clock.NewTestClock(testTime),
+	}
+	expiryWatcher := NewInvoiceExpiryWatcher(
+		cfg.Clock, holdExpiryDelta, notifier,
+	)
+	registry := NewRegistry(cdb, expiryWatcher, &cfg)
+
+	require.NoError(t, registry.Start())
+	defer registry.Stop()
+
+	subscription, err := registry.SubscribeSingleInvoice(
+		testInvoicePaymentHash,
+	)
+	require.NoError(t, err)
+	defer subscription.Cancel()
+
+	invoice := *testHodlInvoice
+	_, err = registry.AddInvoice(&invoice, testInvoicePaymentHash)
+	require.NoError(t, err)
+
+	update := <-subscription.Updates
+	require.Equal(t, channeldb.ContractOpen, update.State)
+
+	// Accept the hold invoice with an htlc that expires at height 50.
+	const expiry = 50
+	key := getCircuitKey(0)
+	hodlChan := make(chan interface{}, 1)
+	resolution, err := registry.NotifyExitHopHtlc(
+		testInvoicePaymentHash, testInvoiceAmt, expiry,
+		testCurrentHeight, key, hodlChan, testPayload,
+	)
+	require.NoError(t, err)
+	require.Nil(t, resolution, "expected htlc to be held")
+
+	update = <-subscription.Updates
+	require.Equal(t, channeldb.ContractAccepted, update.State)
+
+	// sendEpoch delivers a block epoch. Because the epoch channel is
+	// unbuffered, sending the same height twice guarantees that the first
+	// epoch has been fully processed.
+	sendEpoch := func(height int32) {
+		for i := 0; i < 2; i++ {
+			notifier.EpochChan <- &chainntnfs.BlockEpoch{
+				Height: height,
+			}
+		}
+	}
+
+	// Outside of the hold expiry delta, the invoice remains accepted.
+	sendEpoch(expiry - holdExpiryDelta - 1)
+
+	select {
+	case <-hodlChan:
+		t.Fatal("unexpected htlc resolution")
+	default:
+	}
+
+	// Once the htlc is within the delta, the invoice is canceled and the
+	// htlc is failed back.
+	sendEpoch(expiry - holdExpiryDelta)
+
+	select {
+	case res := <-hodlChan:
+		failResolution, ok := res.(*HtlcFailResolution)
+		require.Truef(t, ok, "expected fail resolution, got: %T", res)
+		require.Equal(t, ResultHoldExpiryTooSoon, failResolution.Outcome)
+
+	case <-time.After(testTimeout):
+		t.Fatal("htlc not failed back")
+	}
+
+	update = <-subscription.Updates
+	require.Equal(t, channeldb.ContractCanceled, update.State)
+
+	rejection := <-subscription.Rejections
+	require.Equal(t, key, rejection.CircuitKey)
+	require.Equal(t, uint32(expiry), rejection.Expiry)
+	require.Equal(t, ResultHoldExpiryTooSoon, rejection.Outcome)
+	require.Equal(t, channeldb.ContractCanceled, rejection.Invoice.State)
+}
+
+// TestDeleteInvoice tests that only settled and canceled invoices can be
+// deleted through the registry.
+func TestDeleteInvoice(t *testing.T) {
+	defer timeout()()
+
+	ctx := newTestContext(t)
+	defer ctx.cleanup()
+
+	// Add an open invoice, which can't be deleted.
+	_, err := ctx.registry.AddInvoice(testInvoice, testInvoicePaymentHash)
+	require.NoError(t, err)
+
+	err = ctx.registry.DeleteInvoice(testInvoicePaymentHash)
+	require.Equal(t, ErrInvoiceNotResolved, err)
+
+	// After canceling the invoice, it can be deleted.
+	require.NoError(t, ctx.registry.CancelInvoice(testInvoicePaymentHash))
+	require.NoError(t, ctx.registry.DeleteInvoice(testInvoicePaymentHash))
+
+	_, err = ctx.registry.LookupInvoice(testInvoicePaymentHash)
+	require.Equal(t, channeldb.ErrInvoiceNotFound, err)
+
+	// Deleting an unknown invoice fails.
+	err = ctx.registry.DeleteInvoice(testInvoicePaymentHash)
+	require.Equal(t, channeldb.ErrInvoiceNotFound, err)
+}
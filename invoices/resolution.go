@@ -103,6 +103,10 @@ type htlcAcceptResolution struct {
 
 	// outcome indicates the outcome of the invoice registry update.
 	outcome acceptResolutionResult
+
+	// heldExpiry is set if the htlc accepted a hold invoice. It is used to
+	// cancel the invoice before its htlcs expire.
+	heldExpiry *heldInvoiceExpiry
 }
 
 // newAcceptResolution returns a htlc resolution which is associated with a
@@ -105,6 +105,28 @@ const (
 	// ResultMppInProgress is returned when we are busy receiving a mpp
 	// payment.
 	ResultMppInProgress
+
+	// ResultHtlcAcceptorCanceled is returned when a htlc for which we have
+	// no invoice is failed by the htlc acceptor, or when the acceptor
+	// disconnects while holding it.
+	ResultHtlcAcceptorCanceled
+
+	// ResultPolicyExpiryTooSoon is returned when a htlc expires sooner
+	// than the acceptance policy of the invoice allows.
+	ResultPolicyExpiryTooSoon
+
+	// ResultPolicyMissingRecord is returned when a htlc lacks a custom
+	// record that the acceptance policy of the invoice requires.
+	ResultPolicyMissingRecord
+
+	// ResultPolicyRecordMismatch is returned when a custom record of a
+	// htlc doesn't have the value that the acceptance policy of the
+	// invoice requires.
+	ResultPolicyRecordMismatch
+
+	// ResultHoldExpiryTooSoon is returned when an accepted hold invoice is
+	// canceled automatically because its htlcs are about to expire.
+	ResultHoldExpiryTooSoon
 )
 
 // String returns a string representation of the result.
@@ -162,6 +184,21 @@ func (f FailResolutionResult) FailureString() string {
 	case ResultMppInProgress:
 		return "mpp reception in progress"
 
+	case ResultHtlcAcceptorCanceled:
+		return "canceled by htlc acceptor"
+
+	case ResultPolicyExpiryTooSoon:
+		return "expiry too soon for invoice policy"
+
+	case ResultPolicyMissingRecord:
+		return "custom record required by invoice policy missing"
+
+	case ResultPolicyRecordMismatch:
+		return "custom record doesn't match invoice policy"
+
+	case ResultHoldExpiryTooSoon:
+		return "hold invoice canceled before htlc expiry"
+
 	default:
 		return "unknown failure resolution result"
 	}
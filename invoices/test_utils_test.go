@@ -184,7 +184,7 @@ func newTestContext(t *testing.T) *testContext {
 		t.Fatal(err)
 	}
 
-	expiryWatcher := NewInvoiceExpiryWatcher(clock)
+	expiryWatcher := NewInvoiceExpiryWatcher(clock, 0, nil)
 
 	// Instantiate and start the invoice ctx.registry.
 	cfg := RegistryConfig{
@@ -149,8 +149,9 @@ func updateMpp(ctx *invoiceUpdateCtx,
 	}
 
 	// Check that the total amt of the htlc set is high enough. In case this
-	// is a zero-valued invoice, it will always be enough.
-	if ctx.mpp.TotalMsat() < inv.Terms.Value {
+	// is a zero-valued invoice, it will always be enough. The acceptance
+	// policy of the invoice may allow a lower amount.
+	if ctx.mpp.TotalMsat() < acceptAmt(inv) {
 		return nil, ctx.failRes(ResultHtlcSetTotalTooLow), nil
 	}
 
@@ -181,6 +182,12 @@ func updateMpp(ctx *invoiceUpdateCtx,
 		return nil, ctx.failRes(ResultExpiryTooSoon), nil
 	}
 
+	// Check the htlc against the acceptance policy of the invoice.
+	rejection := ctx.checkAcceptPolicy(inv.AcceptPolicy)
+	if rejection != nil {
+		return nil, ctx.failRes(rejection.Outcome), nil
+	}
+
 	// Record HTLC in the invoice database.
 	newHtlcs := map[channeldb.CircuitKey]*channeldb.HtlcAcceptDesc{
 		ctx.circuitKey: acceptDesc,
@@ -235,8 +242,9 @@ func updateLegacy(ctx *invoiceUpdateCtx,
 	// If an invoice amount is specified, check that enough is paid. Also
 	// check this for duplicate payments if the invoice is already settled
 	// or accepted. In case this is a zero-valued invoice, it will always be
-	// enough.
-	if ctx.amtPaid < inv.Terms.Value {
+	// enough. The acceptance policy of the invoice may allow a lower
+	// amount.
+	if ctx.amtPaid < acceptAmt(inv) {
 		return nil, ctx.failRes(ResultAmountTooLow), nil
 	}
 
@@ -273,6 +281,12 @@ func updateLegacy(ctx *invoiceUpdateCtx,
 		return nil, ctx.failRes(ResultExpiryTooSoon), nil
 	}
 
+	// Check the htlc against the acceptance policy of the invoice.
+	rejection := ctx.checkAcceptPolicy(inv.AcceptPolicy)
+	if rejection != nil {
+		return nil, ctx.failRes(rejection.Outcome), nil
+	}
+
 	// Record HTLC in the invoice database.
 	newHtlcs := map[channeldb.CircuitKey]*channeldb.HtlcAcceptDesc{
 		ctx.circuitKey: {
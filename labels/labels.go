@@ -68,6 +68,9 @@ const (
 
 	// LabelTypeSweepTransaction is used to label sweeps.
 	LabelTypeSweepTransaction LabelType = "sweep"
+
+	// LabelTypeConsolidation is used to label utxo consolidations.
+	LabelTypeConsolidation LabelType = "consolidation"
 )
 
 // LabelField is used to tag a value within a label.
@@ -40,6 +40,14 @@ const (
 	// push us in the broadcast window.
 	DefaultFinalCltvRejectDelta = DefaultIncomingBroadcastDelta + 3
 
+	// DefaultHoldInvoiceExpiryDelta defines the number of blocks before the
+	// expiry of an htlc paying to an accepted hold invoice at which we
+	// cancel the invoice. This prevents the channel from being force
+	// closed if the invoice is neither settled nor canceled in time. We
+	// leave a few blocks for the cancellation to reach our peer before we
+	// enter the incoming broadcast window.
+	DefaultHoldInvoiceExpiryDelta = DefaultIncomingBroadcastDelta + 2
+
 	// DefaultOutgoingBroadcastDelta defines the number of blocks before the
 	// expiry of an outgoing htlc at which we force close the channel. We
 	// are not in a hurry to force close, because there is nothing to claim
@@ -0,0 +1,113 @@
+package lncfg
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcutil/hdkeychain"
+)
+
+const (
+	// DefaultConsolidationMaxFeeRate is the default fee rate in sat/vbyte
+	// at or below which utxos are consolidated automatically.
+	DefaultConsolidationMaxFeeRate = 2
+
+	// DefaultConsolidationConfTarget is the default confirmation target
+	// used to estimate the fee rate of automatic consolidations.
+	DefaultConsolidationConfTarget = 144
+
+	// DefaultConsolidationMaxUtxoValue is the default value in satoshis
+	// of the largest utxo that is consolidated.
+	DefaultConsolidationMaxUtxoValue = 100_000
+
+	// DefaultConsolidationMinUtxos is the default minimum number of
+	// eligible utxos for which a consolidation is published.
+	DefaultConsolidationMinUtxos = 10
+
+	// DefaultConsolidationReserve is the default wallet balance in
+	// satoshis that consolidations leave untouched.
+	DefaultConsolidationReserve = 100_000
+)
+
+// Consolidation holds the configuration for merging small wallet utxos into a
+// single output paying to cold storage.
+type Consolidation struct {
+	Active bool `long:"active" description:"Automatically consolidate small confirmed wallet utxos once the fee estimate drops to max-feerate. Consolidations can always be triggered through the walletrpc sub-server if an address or xpub is configured."`
+
+	MaxFeeRate uint64 `long:"max-feerate" description:"The fee rate in sat/vbyte at or below which utxos are consolidated automatically."`
+
+	ConfTarget uint32 `long:"conftarget" description:"The confirmation target used to estimate the fee rate of automatic consolidations."`
+
+	MaxUtxoValue int64 `long:"max-utxo-value" description:"The value in satoshis of the largest utxo that is consolidated."`
+
+	MinUtxos uint32 `long:"min-utxos" description:"The minimum number of eligible utxos for which a consolidation is published automatically."`
+
+	Reserve int64 `long:"reserve" description:"The wallet balance in satoshis that consolidations leave untouched to fee bump anchor channels. The value required for the currently open anchor channels is always reserved, even if it exceeds this setting."`
+
+	Addr string `long:"addr" description:"The cold storage address that consolidations pay to."`
+
+	XPub string `long:"xpub" description:"The extended public key of a cold storage wallet. Consolidations pay to a fresh p2wkh address derived from its external branch (xpub/0/i). Can't be combined with addr."`
+}
+
+// DefaultConsolidation returns the default consolidation configuration.
+func DefaultConsolidation() *Consolidation {
+	return &Consolidation{
+		MaxFeeRate:   DefaultConsolidationMaxFeeRate,
+		ConfTarget:   DefaultConsolidationConfTarget,
+		MaxUtxoValue: DefaultConsolidationMaxUtxoValue,
+		MinUtxos:     DefaultConsolidationMinUtxos,
+		Reserve:      DefaultConsolidationReserve,
+	}
+}
+
+// Validate checks that the consolidation config is sane.
+func (c *Consolidation) Validate() error {
+	if c.Addr != "" && c.XPub != "" {
+		return fmt.Errorf("consolidation addr and xpub can't both be " +
+			"set")
+	}
+
+	if c.XPub != "" {
+		xpub, err := hdkeychain.NewKeyFromString(c.XPub)
+		if err != nil {
+			return fmt.Errorf("invalid consolidation xpub: %v", err)
+		}
+		if xpub.IsPrivate() {
+			return fmt.Errorf("consolidation xpub must be an " +
+				"extended public key")
+		}
+	}
+
+	if c.MaxUtxoValue <= 0 {
+		return fmt.Errorf("consolidation max utxo value %v must be "+
+			"positive", c.MaxUtxoValue)
+	}
+
+	if c.Reserve < 0 {
+		return fmt.Errorf("consolidation reserve %v must not be "+
+			"negative", c.Reserve)
+	}
+
+	if c.MinUtxos < 2 {
+		return fmt.Errorf("consolidation min utxos %v must be at "+
+			"least 2", c.MinUtxos)
+	}
+
+	if !c.Active {
+		return nil
+	}
+
+	if c.Addr == "" && c.XPub == "" {
+		return fmt.Errorf("automatic consolidation requires an addr " +
+			"or xpub")
+	}
+
+	if c.MaxFeeRate == 0 {
+		return fmt.Errorf("consolidation max fee rate must be positive")
+	}
+
+	if c.ConfTarget == 0 {
+		return fmt.Errorf("consolidation conf target must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package lncfg_test
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lncfg"
+)
+
+const (
+	// testXPub and testXPrv are the master keys of the first BIP 32 test
+	// vector.
+	testXPub = "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhe" +
+		"PY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+	testXPrv = "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPP" +
+		"qjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"
+
+	testAddr = "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"
+)
+
+// consolidationCfg returns the default consolidation config modified by the
+// given closure.
+func consolidationCfg(modify func(*lncfg.Consolidation)) *lncfg.Consolidation {
+	cfg := lncfg.DefaultConsolidation()
+	modify(cfg)
+
+	return cfg
+}
+
+// TestValidateConsolidation asserts that validating the consolidation config
+// only succeeds for a sane policy and a single valid destination.
+func TestValidateConsolidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   *lncfg.Consolidation
+		valid bool
+	}{
+		{
+			name:  "defaults",
+			cfg:   lncfg.DefaultConsolidation(),
+			valid: true,
+		},
+		{
+			name: "active with addr",
+			cfg: consolidationCfg(func(c *lncfg.Consolidation) {
+				c.Active = true
+				c.Addr = testAddr
+			}),
+			valid: true,
+		},
+		{
+			name: "active with xpub",
+			cfg: consolidationCfg(func(c *lncfg.Consolidation) {
+				c.Active = true
+				c.XPub = testXPub
+			}),
+			valid: true,
+		},
+		{
+			name: "active without destination",
+			cfg: consolidationCfg(func(c *lncfg.Consolidation) {
+				c.Active = true
+			}),
+		},
+		{
+			name: "addr and xpub",
+			cfg: consolidationCfg(func(c *lncfg.Consolidation) {
+				c.Addr = testAddr
+				c.XPub = testXPub
+			}),
+		},
+		{
+			name: "private xpub",
+			cfg: consolidationCfg(func(c *lncfg.Consolidation) {
+				c.XPub = testXPrv
+			}),
+		},
+		{
+			name: "invalid xpub",
+			cfg: consolidationCfg(func(c *lncfg.Consolidation) {
+				c.XPub = "xpub"
+			}),
+		},
+		{
+			name: "zero max utxo value",
+			cfg: consolidationCfg(func(c *lncfg.Consolidation) {
+				c.MaxUtxoValue = 0
+			}),
+		},
+		{
+			name: "negative reserve",
+			cfg: consolidationCfg(func(c *lncfg.Consolidation) {
+				c.Reserve = -1
+			}),
+		},
+		{
+			name: "single min utxo",
+			cfg: consolidationCfg(func(c *lncfg.Consolidation) {
+				c.MinUtxos = 1
+			}),
+		},
+		{
+			name: "active with zero max fee rate",
+			cfg: consolidationCfg(func(c *lncfg.Consolidation) {
+				c.Active = true
+				c.XPub = testXPub
+				c.MaxFeeRate = 0
+			}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Validate()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+		})
+	}
+}
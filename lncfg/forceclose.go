@@ -0,0 +1,193 @@
+package lncfg
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// ForceClosePolicy determines when a channel is force closed to resolve its
+// htlcs on-chain.
+type ForceClosePolicy struct {
+	// IncomingBroadcastDelta is the number of blocks before the expiry of
+	// an incoming htlc for which we know the preimage at which the channel
+	// is force closed.
+	IncomingBroadcastDelta uint32
+
+	// OutgoingBroadcastDelta is the number of blocks before the expiry of
+	// an outgoing htlc at which the channel is force closed.
+	OutgoingBroadcastDelta uint32
+
+	// MinHtlcValue is the value below which htlcs that are about to
+	// expire don't cause a force close. A zero value means that htlcs of
+	// any value cause a force close.
+	MinHtlcValue btcutil.Amount
+}
+
+// ForceClose holds the configuration that decides when channels are force
+// closed to resolve their htlcs on-chain.
+type ForceClose struct {
+	IncomingBroadcastDelta uint32 `long:"incoming-broadcast-delta" description:"The number of blocks before the expiry of an incoming htlc for which the preimage is known at which the channel is force closed to claim the htlc on-chain."`
+
+	OutgoingBroadcastDelta uint32 `long:"outgoing-broadcast-delta" description:"The number of blocks before the expiry of an outgoing htlc at which the channel is force closed to time out the htlc on-chain."`
+
+	MinHtlcValue int64 `long:"min-htlc-value" description:"The value in satoshis below which htlcs that are about to expire don't cause a force close, as the on-chain fees would exceed their value. These htlcs are monitored and reported as dust at risk instead. Set to 0 to force close for htlcs of any value."`
+
+	ChannelsRaw []string `long:"channel" description:"Overrides the broadcast deltas and the minimum htlc value for a single channel. The value has the form <funding txid>:<output index>=<incoming-broadcast-delta>,<outgoing-broadcast-delta>,<min-htlc-value>. Can be specified multiple times."`
+
+	// Channels holds the parsed per channel overrides, keyed by the
+	// channel point.
+	Channels map[wire.OutPoint]ForceClosePolicy
+}
+
+// DefaultForceClose returns the default force close configuration.
+func DefaultForceClose() *ForceClose {
+	return &ForceClose{
+		IncomingBroadcastDelta: DefaultIncomingBroadcastDelta,
+		OutgoingBroadcastDelta: DefaultOutgoingBroadcastDelta,
+	}
+}
+
+// Policy returns the force close policy that applies to channels without an
+// override.
+func (f *ForceClose) Policy() ForceClosePolicy {
+	return ForceClosePolicy{
+		IncomingBroadcastDelta: f.IncomingBroadcastDelta,
+		OutgoingBroadcastDelta: f.OutgoingBroadcastDelta,
+		MinHtlcValue:           btcutil.Amount(f.MinHtlcValue),
+	}
+}
+
+// MaxIncomingBroadcastDelta returns the largest incoming broadcast delta of
+// the default policy and the per channel overrides.
+func (f *ForceClose) MaxIncomingBroadcastDelta() uint32 {
+	maxDelta := f.IncomingBroadcastDelta
+	for _, policy := range f.Channels {
+		if policy.IncomingBroadcastDelta > maxDelta {
+			maxDelta = policy.IncomingBroadcastDelta
+		}
+	}
+
+	return maxDelta
+}
+
+// Parse validates the default policy and parses the per channel overrides.
+func (f *ForceClose) Parse() error {
+	if err := validateForceClosePolicy(f.Policy()); err != nil {
+		return err
+	}
+
+	channels := make(map[wire.OutPoint]ForceClosePolicy)
+	for _, raw := range f.ChannelsRaw {
+		chanPoint, policy, err := parseForceCloseOverride(raw)
+		if err != nil {
+			return fmt.Errorf("invalid force close override %q: %v",
+				raw, err)
+		}
+
+		if _, ok := channels[chanPoint]; ok {
+			return fmt.Errorf("duplicate force close override "+
+				"for channel %v", chanPoint)
+		}
+
+		channels[chanPoint] = policy
+	}
+
+	f.Channels = channels
+
+	return nil
+}
+
+// parseForceCloseOverride parses a per channel override of the form
+// <funding txid>:<output index>=<incoming delta>,<outgoing delta>,<min value>.
+func parseForceCloseOverride(raw string) (wire.OutPoint,
+	ForceClosePolicy, error) {
+
+	var (
+		chanPoint wire.OutPoint
+		policy    ForceClosePolicy
+	)
+
+	parts := strings.Split(raw, "=")
+	if len(parts) != 2 {
+		return chanPoint, policy, fmt.Errorf("expected " +
+			"<chanpoint>=<values>")
+	}
+
+	outpoint := strings.Split(parts[0], ":")
+	if len(outpoint) != 2 {
+		return chanPoint, policy, fmt.Errorf("expected channel point " +
+			"of the form <funding txid>:<output index>")
+	}
+
+	txid, err := chainhash.NewHashFromStr(outpoint[0])
+	if err != nil {
+		return chanPoint, policy, err
+	}
+	index, err := strconv.ParseUint(outpoint[1], 10, 32)
+	if err != nil {
+		return chanPoint, policy, err
+	}
+	chanPoint = wire.OutPoint{
+		Hash:  *txid,
+		Index: uint32(index),
+	}
+
+	values := strings.Split(parts[1], ",")
+	if len(values) != 3 {
+		return chanPoint, policy, fmt.Errorf("expected <incoming " +
+			"delta>,<outgoing delta>,<min htlc value>")
+	}
+
+	incomingDelta, err := strconv.ParseUint(values[0], 10, 32)
+	if err != nil {
+		return chanPoint, policy, err
+	}
+	outgoingDelta, err := strconv.ParseUint(values[1], 10, 32)
+	if err != nil {
+		return chanPoint, policy, err
+	}
+	minValue, err := strconv.ParseInt(values[2], 10, 64)
+	if err != nil {
+		return chanPoint, policy, err
+	}
+
+	policy = ForceClosePolicy{
+		IncomingBroadcastDelta: uint32(incomingDelta),
+		OutgoingBroadcastDelta: uint32(outgoingDelta),
+		MinHtlcValue:           btcutil.Amount(minValue),
+	}
+	if err := validateForceClosePolicy(policy); err != nil {
+		return chanPoint, policy, err
+	}
+
+	return chanPoint, policy, nil
+}
+
+// validateForceClosePolicy checks that the broadcast deltas of the policy
+// leave room for the cltv deltas at which htlcs are rejected, so that we
+// don't force close right after accepting an htlc.
+func validateForceClosePolicy(policy ForceClosePolicy) error {
+	if policy.IncomingBroadcastDelta >= DefaultFinalCltvRejectDelta {
+		return fmt.Errorf("incoming broadcast delta %v must be less "+
+			"than %v", policy.IncomingBroadcastDelta,
+			DefaultFinalCltvRejectDelta)
+	}
+
+	if policy.OutgoingBroadcastDelta >= DefaultOutgoingCltvRejectDelta {
+		return fmt.Errorf("outgoing broadcast delta %v must be less "+
+			"than %v", policy.OutgoingBroadcastDelta,
+			DefaultOutgoingCltvRejectDelta)
+	}
+
+	if policy.MinHtlcValue < 0 {
+		return fmt.Errorf("min htlc value %v must not be negative",
+			policy.MinHtlcValue)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package lncfg_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lncfg"
+)
+
+const testTxid = "4c7bc4a0b5c1c5e3d6e0ad09a4f7d7b3cde02bf2dbeb7f95bbd8fb16bb5e9a25"
+
+// TestParseForceClose asserts that parsing the force close config validates
+// the broadcast deltas and parses the per channel overrides.
+func TestParseForceClose(t *testing.T) {
+	txid, err := chainhash.NewHashFromStr(testTxid)
+	if err != nil {
+		t.Fatalf("unable to parse txid: %v", err)
+	}
+	chanPoint := wire.OutPoint{Hash: *txid, Index: 1}
+
+	tests := []struct {
+		name     string
+		cfg      *lncfg.ForceClose
+		valid    bool
+		channels map[wire.OutPoint]lncfg.ForceClosePolicy
+	}{
+		{
+			name:     "defaults",
+			cfg:      lncfg.DefaultForceClose(),
+			valid:    true,
+			channels: map[wire.OutPoint]lncfg.ForceClosePolicy{},
+		},
+		{
+			name: "incoming delta too large",
+			cfg: &lncfg.ForceClose{
+				IncomingBroadcastDelta: lncfg.DefaultFinalCltvRejectDelta,
+			},
+		},
+		{
+			name: "outgoing delta too large",
+			cfg: &lncfg.ForceClose{
+				OutgoingBroadcastDelta: lncfg.DefaultOutgoingCltvRejectDelta,
+			},
+		},
+		{
+			name: "negative min htlc value",
+			cfg: &lncfg.ForceClose{
+				MinHtlcValue: -1,
+			},
+		},
+		{
+			name: "valid override",
+			cfg: &lncfg.ForceClose{
+				MinHtlcValue: 1000,
+				ChannelsRaw:  []string{testTxid + ":1=6,1,50000"},
+			},
+			valid: true,
+			channels: map[wire.OutPoint]lncfg.ForceClosePolicy{
+				chanPoint: {
+					IncomingBroadcastDelta: 6,
+					OutgoingBroadcastDelta: 1,
+					MinHtlcValue:           50000,
+				},
+			},
+		},
+		{
+			name: "override without channel point index",
+			cfg: &lncfg.ForceClose{
+				ChannelsRaw: []string{testTxid + "=6,1,50000"},
+			},
+		},
+		{
+			name: "override with missing value",
+			cfg: &lncfg.ForceClose{
+				ChannelsRaw: []string{testTxid + ":1=6,1"},
+			},
+		},
+		{
+			name: "override with invalid delta",
+			cfg: &lncfg.ForceClose{
+				ChannelsRaw: []string{testTxid + ":1=20,1,50000"},
+			},
+		},
+		{
+			name: "duplicate override",
+			cfg: &lncfg.ForceClose{
+				ChannelsRaw: []string{
+					testTxid + ":1=6,1,50000",
+					testTxid + ":1=5,0,40000",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Parse()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+
+			if !test.valid {
+				return
+			}
+
+			if len(test.cfg.Channels) != len(test.channels) {
+				t.Fatalf("expected %d overrides, got %d",
+					len(test.channels), len(test.cfg.Channels))
+			}
+			for chanPoint, policy := range test.channels {
+				if test.cfg.Channels[chanPoint] != policy {
+					t.Fatalf("expected policy %v for %v, got %v",
+						policy, chanPoint,
+						test.cfg.Channels[chanPoint])
+				}
+			}
+		})
+	}
+}
@@ -20,6 +20,12 @@ type ProtocolOptions struct {
 
 	// Anchors enables anchor commitments.
 	Anchors bool `long:"anchors" description:"enable support for anchor commitments"`
+
+	// ZeroConfChans should be set if we want to signal support for
+	// zero-conf channels, which are usable before their funding
+	// transaction confirms. Each such channel still needs to be requested
+	// explicitly by the initiator and accepted by the channel acceptor.
+	ZeroConfChans bool `long:"zero-conf" description:"if set, then lnd will signal support for zero-conf channels that can be used before the funding transaction confirms; each channel still requires explicit consent from the responder's channel acceptor"`
 }
 
 // Wumbo returns true if lnd should permit the creation and acceptance of wumbo
@@ -33,3 +39,8 @@ func (l *ProtocolOptions) Wumbo() bool {
 func (l *ProtocolOptions) NoAnchorCommitments() bool {
 	return !l.Anchors
 }
+
+// ZeroConf returns true if lnd should signal support for zero-conf channels.
+func (l *ProtocolOptions) ZeroConf() bool {
+	return l.ZeroConfChans
+}
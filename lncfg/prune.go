@@ -0,0 +1,63 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultPruneInterval is the default interval at which old payments
+	// and invoices are deleted.
+	DefaultPruneInterval = time.Hour
+
+	// MinPruneInterval is the minimum interval at which old payments and
+	// invoices can be deleted.
+	MinPruneInterval = time.Minute
+
+	// MinPruneAge is the minimum age of payments and invoices before they
+	// can be deleted automatically. It leaves time for the resolution of
+	// their htlcs to be fully committed to the channels.
+	MinPruneAge = time.Hour
+)
+
+// Prune holds the configuration for the periodic deletion of old payments and
+// invoices.
+type Prune struct {
+	Interval time.Duration `long:"interval" description:"The interval at which old payments and invoices are deleted."`
+
+	PaymentAge time.Duration `long:"payment-age" description:"If non-zero, succeeded and failed payments that were created longer ago than this age are deleted periodically. In-flight payments are never deleted."`
+
+	InvoiceAge time.Duration `long:"invoice-age" description:"If non-zero, invoices that were settled longer ago than this age, and canceled invoices that were created longer ago than this age, are deleted periodically. Open and accepted invoices are never deleted."`
+}
+
+// Active returns true if payments or invoices are deleted periodically.
+func (p *Prune) Active() bool {
+	return p.PaymentAge != 0 || p.InvoiceAge != 0
+}
+
+// Validate checks that the prune ages and interval are sane.
+func (p *Prune) Validate() error {
+	if !p.Active() {
+		return nil
+	}
+
+	if p.Interval < MinPruneInterval {
+		return fmt.Errorf("prune interval %v is less than min: %v",
+			p.Interval, MinPruneInterval)
+	}
+
+	if p.PaymentAge != 0 && p.PaymentAge < MinPruneAge {
+		return fmt.Errorf("payment prune age %v is less than min: %v",
+			p.PaymentAge, MinPruneAge)
+	}
+
+	if p.InvoiceAge != 0 && p.InvoiceAge < MinPruneAge {
+		return fmt.Errorf("invoice prune age %v is less than min: %v",
+			p.InvoiceAge, MinPruneAge)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure Prune implements the Validator interface.
+var _ Validator = (*Prune)(nil)
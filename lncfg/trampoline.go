@@ -0,0 +1,71 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultTrampolineBaseFeeMsat is the default fixed fee in msat that
+	// we charge for forwarding a trampoline payment.
+	DefaultTrampolineBaseFeeMsat = 1000
+
+	// DefaultTrampolineFeeRatePPM is the default proportional fee in parts
+	// per million that we charge for forwarding a trampoline payment.
+	DefaultTrampolineFeeRatePPM = 1000
+
+	// DefaultTrampolineCltvDelta is the default number of blocks that we
+	// require between the incoming htlc of a trampoline payment and the
+	// maximum time lock of the onward payment.
+	DefaultTrampolineCltvDelta = 288
+
+	// DefaultTrampolineMaxParts is the default maximum number of partial
+	// payments that we use for the onward payment.
+	DefaultTrampolineMaxParts = 16
+
+	// DefaultTrampolinePaymentTimeout is the default time after which we
+	// give up trying to complete the onward payment.
+	DefaultTrampolinePaymentTimeout = time.Minute
+
+	// MinTrampolineCltvDelta is the minimum cltv delta that we accept for
+	// trampoline forwarding, so that we have enough time to claim the
+	// incoming htlc on chain if necessary.
+	MinTrampolineCltvDelta = 40
+)
+
+// Trampoline holds the configuration for forwarding trampoline payments on
+// behalf of lightweight senders.
+type Trampoline struct {
+	Active bool `long:"active" description:"If true, our node will signal support for trampoline routing and forward payments that carry trampoline instructions by finding a route to the next destination itself."`
+
+	BaseFeeMsat uint64 `long:"base-fee-msat" description:"The fixed fee in msat that is charged for forwarding a trampoline payment, on top of the fees of the onward route."`
+
+	FeeRatePPM uint64 `long:"fee-rate-ppm" description:"The proportional fee in parts per million that is charged for forwarding a trampoline payment, on top of the fees of the onward route."`
+
+	CltvDelta uint32 `long:"cltv-delta" description:"The number of blocks that is required between the expiry of an incoming trampoline htlc and the maximum time lock of the onward payment."`
+
+	MaxParts uint32 `long:"max-parts" description:"The maximum number of partial payments that may be used to complete the onward payment."`
+
+	PaymentTimeout time.Duration `long:"payment-timeout" description:"The time after which we give up trying to complete the onward payment."`
+}
+
+// Validate checks the Trampoline configuration for sane values.
+func (t *Trampoline) Validate() error {
+	if !t.Active {
+		return nil
+	}
+
+	if t.CltvDelta < MinTrampolineCltvDelta {
+		return fmt.Errorf("trampoline cltv delta %d is less than "+
+			"min: %d", t.CltvDelta, MinTrampolineCltvDelta)
+	}
+	if t.MaxParts == 0 {
+		return fmt.Errorf("trampoline max parts must be positive")
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure Trampoline implements the Validator
+// interface.
+var _ Validator = (*Trampoline)(nil)
@@ -102,6 +102,10 @@ type AddInvoiceData struct {
 	// RouteHints are optional route hints that can each be individually used
 	// to assist in reaching the invoice's destination.
 	RouteHints [][]zpay32.HopHint
+
+	// AcceptPolicy holds optional conditions that the htlcs paying to a
+	// hold invoice must satisfy to be accepted.
+	AcceptPolicy *channeldb.InvoiceAcceptPolicy
 }
 
 // AddInvoice attempts to add a new invoice to the invoice database. Any
@@ -352,7 +356,8 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 			PaymentAddr:     paymentAddr,
 			Features:        invoiceFeatures,
 		},
-		HodlInvoice: invoice.HodlInvoice,
+		HodlInvoice:  invoice.HodlInvoice,
+		AcceptPolicy: invoice.AcceptPolicy,
 	}
 
 	log.Tracef("[addinvoice] adding new invoice %v",
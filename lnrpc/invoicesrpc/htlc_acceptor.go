@@ -0,0 +1,183 @@
+//go:build invoicesrpc
+// +build invoicesrpc
+
+package invoicesrpc
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// htlcAcceptor is a helper struct that handles the lifecycle of an rpc htlc
+// acceptor streaming session. It is created when the stream opens and
+// disconnects when the stream closes.
+type htlcAcceptor struct {
+	// server is the Server reference.
+	server *Server
+
+	// stream is the bidirectional RPC stream.
+	stream Invoices_HtlcAcceptorServer
+
+	// quit is a channel that is closed when this htlcAcceptor is shutting
+	// down.
+	quit chan struct{}
+
+	// intercepted is where we stream all htlcs that are presented to us by
+	// the invoice registry.
+	intercepted chan *invoices.InterceptedHtlc
+
+	wg sync.WaitGroup
+}
+
+// newHtlcAcceptor creates a new htlcAcceptor.
+func newHtlcAcceptor(server *Server,
+	stream Invoices_HtlcAcceptorServer) *htlcAcceptor {
+
+	return &htlcAcceptor{
+		server:      server,
+		stream:      stream,
+		quit:        make(chan struct{}),
+		intercepted: make(chan *invoices.InterceptedHtlc),
+	}
+}
+
+// run sends the intercepted htlcs to the client and handles the corresponding
+// responses. It registers itself as the htlc acceptor of the invoice registry
+// and launches a goroutine to read from the client stream.
+func (r *htlcAcceptor) run() error {
+	defer r.onDisconnect()
+
+	// Register as the htlc acceptor. Unsetting the acceptor fails all
+	// htlcs that are still held for us.
+	registry := r.server.cfg.InvoiceRegistry
+	registry.SetHtlcAcceptor(r.onHtlc)
+	defer registry.SetHtlcAcceptor(nil)
+
+	errChan := make(chan error, 1)
+	responses := make(chan *HtlcAcceptResponse)
+	r.wg.Add(1)
+	go r.readClientResponses(responses, errChan)
+
+	for {
+		select {
+		case htlc := <-r.intercepted:
+			log.Tracef("Sending intercepted htlc %v to client",
+				htlc.CircuitKey)
+
+			if err := r.stream.Send(marshallHtlc(htlc)); err != nil {
+				return err
+			}
+
+		case resp := <-responses:
+			// A failed resolution doesn't indicate a connection
+			// problem, so we only log it.
+			if err := r.resolveFromClient(resp); err != nil {
+				log.Warnf("Client resolution of intercepted "+
+					"htlc failed: %v", err)
+			}
+
+		case err := <-errChan:
+			return err
+
+		case <-r.server.quit:
+			return nil
+		}
+	}
+}
+
+// onHtlc is called by the invoice registry for every exit hop htlc for which
+// no invoice exists. It only returns true if the htlc was delivered to the
+// main loop.
+func (r *htlcAcceptor) onHtlc(htlc *invoices.InterceptedHtlc) bool {
+	select {
+	case r.intercepted <- htlc:
+		return true
+	case <-r.quit:
+		return false
+	case <-r.server.quit:
+		return false
+	}
+}
+
+// readClientResponses reads the responses from the client stream and passes
+// them on to the main loop.
+func (r *htlcAcceptor) readClientResponses(
+	responses chan *HtlcAcceptResponse, errChan chan error) {
+
+	defer r.wg.Done()
+	for {
+		resp, err := r.stream.Recv()
+		if err != nil {
+			errChan <- err
+			return
+		}
+
+		select {
+		case responses <- resp:
+		case <-r.quit:
+			return
+		case <-r.server.quit:
+			return
+		}
+	}
+}
+
+// resolveFromClient resolves an intercepted htlc as instructed by the client.
+func (r *htlcAcceptor) resolveFromClient(in *HtlcAcceptResponse) error {
+	circuitKey := channeldb.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(in.ChanId),
+		HtlcID: in.HtlcId,
+	}
+	registry := r.server.cfg.InvoiceRegistry
+
+	switch in.Action {
+	case HtlcAcceptAction_SETTLE:
+		preimage, err := lntypes.MakePreimage(in.Preimage)
+		if err != nil {
+			return err
+		}
+
+		return registry.SettleInterceptedHtlc(circuitKey, preimage)
+
+	case HtlcAcceptAction_FAIL:
+		return registry.FailInterceptedHtlc(circuitKey)
+
+	default:
+		return fmt.Errorf("unrecognized accept action %v", in.Action)
+	}
+}
+
+// onDisconnect closes the quit channel so all goroutines will exit.
+func (r *htlcAcceptor) onDisconnect() {
+	close(r.quit)
+
+	log.Infof("RPC htlc acceptor disconnected")
+
+	r.wg.Wait()
+}
+
+// marshallHtlc converts an intercepted htlc into its rpc representation.
+func marshallHtlc(htlc *invoices.InterceptedHtlc) *HtlcAcceptRequest {
+	rpcHtlc := &HtlcAcceptRequest{
+		ChanId:        htlc.CircuitKey.ChanID.ToUint64(),
+		HtlcId:        htlc.CircuitKey.HtlcID,
+		PaymentHash:   htlc.Hash[:],
+		AmtMsat:       uint64(htlc.Amount),
+		Expiry:        htlc.Expiry,
+		AcceptHeight:  htlc.AcceptHeight,
+		CustomRecords: htlc.CustomRecords,
+	}
+
+	if htlc.MPP != nil {
+		addr := htlc.MPP.PaymentAddr()
+		rpcHtlc.MppTotalAmtMsat = uint64(htlc.MPP.TotalMsat())
+		rpcHtlc.PaymentAddr = addr[:]
+	}
+
+	return rpcHtlc
+}
@@ -25,6 +25,31 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type HtlcAcceptAction int32
+
+const (
+	HtlcAcceptAction_SETTLE HtlcAcceptAction = 0
+	HtlcAcceptAction_FAIL   HtlcAcceptAction = 1
+)
+
+var HtlcAcceptAction_name = map[int32]string{
+	0: "SETTLE",
+	1: "FAIL",
+}
+
+var HtlcAcceptAction_value = map[string]int32{
+	"SETTLE": 0,
+	"FAIL":   1,
+}
+
+func (x HtlcAcceptAction) String() string {
+	return proto.EnumName(HtlcAcceptAction_name, int32(x))
+}
+
+func (HtlcAcceptAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_090ab9c4958b987d, []int{0}
+}
+
 type CancelInvoiceMsg struct {
 	// Hash corresponding to the (hold) invoice to cancel.
 	PaymentHash          []byte   `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
@@ -131,10 +156,15 @@ type AddHoldInvoiceRequest struct {
 	//invoice's destination.
 	RouteHints []*lnrpc.RouteHint `protobuf:"bytes,8,rep,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
 	// Whether this invoice should include routing hints for private channels.
-	Private              bool     `protobuf:"varint,9,opt,name=private,proto3" json:"private,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Private bool `protobuf:"varint,9,opt,name=private,proto3" json:"private,omitempty"`
+	//
+	//Conditions that the htlcs paying to this invoice must satisfy to be
+	//accepted. Htlcs that are rejected by the policy are reported in
+	//SubscribeSingleInvoice.
+	AcceptPolicy         *InvoiceAcceptPolicy `protobuf:"bytes,11,opt,name=accept_policy,json=acceptPolicy,proto3" json:"accept_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *AddHoldInvoiceRequest) Reset()         { *m = AddHoldInvoiceRequest{} }
@@ -232,6 +262,78 @@ func (m *AddHoldInvoiceRequest) GetPrivate() bool {
 	return false
 }
 
+func (m *AddHoldInvoiceRequest) GetAcceptPolicy() *InvoiceAcceptPolicy {
+	if m != nil {
+		return m.AcceptPolicy
+	}
+	return nil
+}
+
+type InvoiceAcceptPolicy struct {
+	//
+	//The minimum number of blocks that an htlc must have left until its expiry
+	//to be accepted.
+	MinCltvDelta uint32 `protobuf:"varint,1,opt,name=min_cltv_delta,json=minCltvDelta,proto3" json:"min_cltv_delta,omitempty"`
+	//
+	//Custom records that every htlc must carry. If a record is given with a
+	//non-empty value, the htlc's record must have this exact value.
+	RequiredCustomRecords map[uint64][]byte `protobuf:"bytes,2,rep,name=required_custom_records,json=requiredCustomRecords,proto3" json:"required_custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//
+	//Allows partial acceptance of the invoice. If non-zero, an htlc set that
+	//pays at least this amount is accepted, even if it pays less than the
+	//invoice value.
+	MinAcceptAmtMsat     uint64   `protobuf:"varint,3,opt,name=min_accept_amt_msat,json=minAcceptAmtMsat,proto3" json:"min_accept_amt_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvoiceAcceptPolicy) Reset()         { *m = InvoiceAcceptPolicy{} }
+func (m *InvoiceAcceptPolicy) String() string { return proto.CompactTextString(m) }
+func (*InvoiceAcceptPolicy) ProtoMessage()    {}
+func (*InvoiceAcceptPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_090ab9c4958b987d, []int{3}
+}
+
+func (m *InvoiceAcceptPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvoiceAcceptPolicy.Unmarshal(m, b)
+}
+func (m *InvoiceAcceptPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvoiceAcceptPolicy.Marshal(b, m, deterministic)
+}
+func (m *InvoiceAcceptPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvoiceAcceptPolicy.Merge(m, src)
+}
+func (m *InvoiceAcceptPolicy) XXX_Size() int {
+	return xxx_messageInfo_InvoiceAcceptPolicy.Size(m)
+}
+func (m *InvoiceAcceptPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvoiceAcceptPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvoiceAcceptPolicy proto.InternalMessageInfo
+
+func (m *InvoiceAcceptPolicy) GetMinCltvDelta() uint32 {
+	if m != nil {
+		return m.MinCltvDelta
+	}
+	return 0
+}
+
+func (m *InvoiceAcceptPolicy) GetRequiredCustomRecords() map[uint64][]byte {
+	if m != nil {
+		return m.RequiredCustomRecords
+	}
+	return nil
+}
+
+func (m *InvoiceAcceptPolicy) GetMinAcceptAmtMsat() uint64 {
+	if m != nil {
+		return m.MinAcceptAmtMsat
+	}
+	return 0
+}
+
 type AddHoldInvoiceResp struct {
 	//
 	//A bare-bones invoice for a payment within the Lightning Network.  With the
@@ -247,7 +349,7 @@ func (m *AddHoldInvoiceResp) Reset()         { *m = AddHoldInvoiceResp{} }
 func (m *AddHoldInvoiceResp) String() string { return proto.CompactTextString(m) }
 func (*AddHoldInvoiceResp) ProtoMessage()    {}
 func (*AddHoldInvoiceResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_090ab9c4958b987d, []int{3}
+	return fileDescriptor_090ab9c4958b987d, []int{4}
 }
 
 func (m *AddHoldInvoiceResp) XXX_Unmarshal(b []byte) error {
@@ -288,7 +390,7 @@ func (m *SettleInvoiceMsg) Reset()         { *m = SettleInvoiceMsg{} }
 func (m *SettleInvoiceMsg) String() string { return proto.CompactTextString(m) }
 func (*SettleInvoiceMsg) ProtoMessage()    {}
 func (*SettleInvoiceMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_090ab9c4958b987d, []int{4}
+	return fileDescriptor_090ab9c4958b987d, []int{5}
 }
 
 func (m *SettleInvoiceMsg) XXX_Unmarshal(b []byte) error {
@@ -326,7 +428,7 @@ func (m *SettleInvoiceResp) Reset()         { *m = SettleInvoiceResp{} }
 func (m *SettleInvoiceResp) String() string { return proto.CompactTextString(m) }
 func (*SettleInvoiceResp) ProtoMessage()    {}
 func (*SettleInvoiceResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_090ab9c4958b987d, []int{5}
+	return fileDescriptor_090ab9c4958b987d, []int{6}
 }
 
 func (m *SettleInvoiceResp) XXX_Unmarshal(b []byte) error {
@@ -347,6 +449,234 @@ func (m *SettleInvoiceResp) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SettleInvoiceResp proto.InternalMessageInfo
 
+type SearchInvoicesRequest struct {
+	//
+	//The add index of an invoice that will be used as either the start or end
+	//of a query to determine which invoices should be returned in the response.
+	IndexOffset uint64 `protobuf:"varint,1,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// The max number of invoices to return in the response to this query.
+	NumMaxInvoices uint64 `protobuf:"varint,2,opt,name=num_max_invoices,json=numMaxInvoices,proto3" json:"num_max_invoices,omitempty"`
+	//
+	//If set, the invoices returned will result from seeking backwards from the
+	//specified index offset. This can be used to paginate backwards.
+	Reversed bool `protobuf:"varint,3,opt,name=reversed,proto3" json:"reversed,omitempty"`
+	// If set, only invoices that are not settled and not canceled will be
+	// returned.
+	PendingOnly bool `protobuf:"varint,4,opt,name=pending_only,json=pendingOnly,proto3" json:"pending_only,omitempty"`
+	//
+	//If non-zero, only invoices that were created at or after this unix
+	//timestamp in seconds are returned.
+	CreationDateStart int64 `protobuf:"varint,5,opt,name=creation_date_start,json=creationDateStart,proto3" json:"creation_date_start,omitempty"`
+	//
+	//If non-zero, only invoices that were created at or before this unix
+	//timestamp in seconds are returned.
+	CreationDateEnd int64 `protobuf:"varint,6,opt,name=creation_date_end,json=creationDateEnd,proto3" json:"creation_date_end,omitempty"`
+	//
+	//If non-zero, only invoices that were settled at or after this unix
+	//timestamp in seconds are returned.
+	SettleDateStart int64 `protobuf:"varint,7,opt,name=settle_date_start,json=settleDateStart,proto3" json:"settle_date_start,omitempty"`
+	//
+	//If non-zero, only invoices that were settled at or before this unix
+	//timestamp in seconds are returned.
+	SettleDateEnd int64 `protobuf:"varint,8,opt,name=settle_date_end,json=settleDateEnd,proto3" json:"settle_date_end,omitempty"`
+	// If set, only invoices in one of these states are returned.
+	States []lnrpc.Invoice_InvoiceState `protobuf:"varint,9,rep,packed,name=states,proto3,enum=lnrpc.Invoice_InvoiceState" json:"states,omitempty"`
+	// If set, only invoices whose memo contains this string are returned. The
+	// match is case insensitive.
+	MemoContains string `protobuf:"bytes,10,opt,name=memo_contains,json=memoContains,proto3" json:"memo_contains,omitempty"`
+	// If non-zero, only invoices with a value of at least this amount are
+	// returned.
+	MinAmtMsat int64 `protobuf:"varint,11,opt,name=min_amt_msat,json=minAmtMsat,proto3" json:"min_amt_msat,omitempty"`
+	// If non-zero, only invoices with a value of at most this amount are
+	// returned.
+	MaxAmtMsat int64 `protobuf:"varint,12,opt,name=max_amt_msat,json=maxAmtMsat,proto3" json:"max_amt_msat,omitempty"`
+	//
+	//If non-zero, only invoices that were paid with at least one htlc that
+	//carries a custom record of this type are returned.
+	HtlcCustomRecordType uint64   `protobuf:"varint,13,opt,name=htlc_custom_record_type,json=htlcCustomRecordType,proto3" json:"htlc_custom_record_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchInvoicesRequest) Reset()         { *m = SearchInvoicesRequest{} }
+func (m *SearchInvoicesRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchInvoicesRequest) ProtoMessage()    {}
+func (*SearchInvoicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_090ab9c4958b987d, []int{7}
+}
+
+func (m *SearchInvoicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchInvoicesRequest.Unmarshal(m, b)
+}
+func (m *SearchInvoicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchInvoicesRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchInvoicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchInvoicesRequest.Merge(m, src)
+}
+func (m *SearchInvoicesRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchInvoicesRequest.Size(m)
+}
+func (m *SearchInvoicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchInvoicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchInvoicesRequest proto.InternalMessageInfo
+
+func (m *SearchInvoicesRequest) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *SearchInvoicesRequest) GetNumMaxInvoices() uint64 {
+	if m != nil {
+		return m.NumMaxInvoices
+	}
+	return 0
+}
+
+func (m *SearchInvoicesRequest) GetReversed() bool {
+	if m != nil {
+		return m.Reversed
+	}
+	return false
+}
+
+func (m *SearchInvoicesRequest) GetPendingOnly() bool {
+	if m != nil {
+		return m.PendingOnly
+	}
+	return false
+}
+
+func (m *SearchInvoicesRequest) GetCreationDateStart() int64 {
+	if m != nil {
+		return m.CreationDateStart
+	}
+	return 0
+}
+
+func (m *SearchInvoicesRequest) GetCreationDateEnd() int64 {
+	if m != nil {
+		return m.CreationDateEnd
+	}
+	return 0
+}
+
+func (m *SearchInvoicesRequest) GetSettleDateStart() int64 {
+	if m != nil {
+		return m.SettleDateStart
+	}
+	return 0
+}
+
+func (m *SearchInvoicesRequest) GetSettleDateEnd() int64 {
+	if m != nil {
+		return m.SettleDateEnd
+	}
+	return 0
+}
+
+func (m *SearchInvoicesRequest) GetStates() []lnrpc.Invoice_InvoiceState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *SearchInvoicesRequest) GetMemoContains() string {
+	if m != nil {
+		return m.MemoContains
+	}
+	return ""
+}
+
+func (m *SearchInvoicesRequest) GetMinAmtMsat() int64 {
+	if m != nil {
+		return m.MinAmtMsat
+	}
+	return 0
+}
+
+func (m *SearchInvoicesRequest) GetMaxAmtMsat() int64 {
+	if m != nil {
+		return m.MaxAmtMsat
+	}
+	return 0
+}
+
+func (m *SearchInvoicesRequest) GetHtlcCustomRecordType() uint64 {
+	if m != nil {
+		return m.HtlcCustomRecordType
+	}
+	return 0
+}
+
+type SearchInvoicesResponse struct {
+	// The invoices that match the filters of the query.
+	Invoices []*lnrpc.Invoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	//
+	//The index of the last item in the set of returned invoices. This can be
+	//used to seek further, pagination style.
+	LastIndexOffset uint64 `protobuf:"varint,2,opt,name=last_index_offset,json=lastIndexOffset,proto3" json:"last_index_offset,omitempty"`
+	//
+	//The index of the first item in the set of returned invoices. This can be
+	//used to seek backwards, pagination style.
+	FirstIndexOffset     uint64   `protobuf:"varint,3,opt,name=first_index_offset,json=firstIndexOffset,proto3" json:"first_index_offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchInvoicesResponse) Reset()         { *m = SearchInvoicesResponse{} }
+func (m *SearchInvoicesResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchInvoicesResponse) ProtoMessage()    {}
+func (*SearchInvoicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_090ab9c4958b987d, []int{8}
+}
+
+func (m *SearchInvoicesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchInvoicesResponse.Unmarshal(m, b)
+}
+func (m *SearchInvoicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchInvoicesResponse.Marshal(b, m, deterministic)
+}
+func (m *SearchInvoicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchInvoicesResponse.Merge(m, src)
+}
+func (m *SearchInvoicesResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchInvoicesResponse.Size(m)
+}
+func (m *SearchInvoicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchInvoicesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchInvoicesResponse proto.InternalMessageInfo
+
+func (m *SearchInvoicesResponse) GetInvoices() []*lnrpc.Invoice {
+	if m != nil {
+		return m.Invoices
+	}
+	return nil
+}
+
+func (m *SearchInvoicesResponse) GetLastIndexOffset() uint64 {
+	if m != nil {
+		return m.LastIndexOffset
+	}
+	return 0
+}
+
+func (m *SearchInvoicesResponse) GetFirstIndexOffset() uint64 {
+	if m != nil {
+		return m.FirstIndexOffset
+	}
+	return 0
+}
+
 type SubscribeSingleInvoiceRequest struct {
 	// Hash corresponding to the (hold) invoice to subscribe to.
 	RHash                []byte   `protobuf:"bytes,2,opt,name=r_hash,json=rHash,proto3" json:"r_hash,omitempty"`
@@ -359,7 +689,7 @@ func (m *SubscribeSingleInvoiceRequest) Reset()         { *m = SubscribeSingleIn
 func (m *SubscribeSingleInvoiceRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeSingleInvoiceRequest) ProtoMessage()    {}
 func (*SubscribeSingleInvoiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_090ab9c4958b987d, []int{6}
+	return fileDescriptor_090ab9c4958b987d, []int{9}
 }
 
 func (m *SubscribeSingleInvoiceRequest) XXX_Unmarshal(b []byte) error {
@@ -387,53 +717,287 @@ func (m *SubscribeSingleInvoiceRequest) GetRHash() []byte {
 	return nil
 }
 
+type HtlcAcceptRequest struct {
+	// The short channel id of the channel over which the htlc was received.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	// The index of the htlc in the channel.
+	HtlcId uint64 `protobuf:"varint,2,opt,name=htlc_id,json=htlcId,proto3" json:"htlc_id,omitempty"`
+	// The payment hash of the htlc.
+	PaymentHash []byte `protobuf:"bytes,3,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// The amount of the htlc in milli-satoshis.
+	AmtMsat uint64 `protobuf:"varint,4,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	// The absolute block height at which the htlc expires.
+	Expiry uint32 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// The block height at which the htlc was accepted.
+	AcceptHeight int32 `protobuf:"varint,6,opt,name=accept_height,json=acceptHeight,proto3" json:"accept_height,omitempty"`
+	// Any custom records that were present in the payload.
+	CustomRecords map[uint64][]byte `protobuf:"bytes,7,rep,name=custom_records,json=customRecords,proto3" json:"custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//
+	//The total amount of the payment in milli-satoshis if the payload contained
+	//an mpp record.
+	MppTotalAmtMsat uint64 `protobuf:"varint,8,opt,name=mpp_total_amt_msat,json=mppTotalAmtMsat,proto3" json:"mpp_total_amt_msat,omitempty"`
+	// The payment address of the mpp record, if present.
+	PaymentAddr          []byte   `protobuf:"bytes,9,opt,name=payment_addr,json=paymentAddr,proto3" json:"payment_addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HtlcAcceptRequest) Reset()         { *m = HtlcAcceptRequest{} }
+func (m *HtlcAcceptRequest) String() string { return proto.CompactTextString(m) }
+func (*HtlcAcceptRequest) ProtoMessage()    {}
+func (*HtlcAcceptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_090ab9c4958b987d, []int{10}
+}
+
+func (m *HtlcAcceptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcAcceptRequest.Unmarshal(m, b)
+}
+func (m *HtlcAcceptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcAcceptRequest.Marshal(b, m, deterministic)
+}
+func (m *HtlcAcceptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcAcceptRequest.Merge(m, src)
+}
+func (m *HtlcAcceptRequest) XXX_Size() int {
+	return xxx_messageInfo_HtlcAcceptRequest.Size(m)
+}
+func (m *HtlcAcceptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcAcceptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcAcceptRequest proto.InternalMessageInfo
+
+func (m *HtlcAcceptRequest) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *HtlcAcceptRequest) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
+	}
+	return 0
+}
+
+func (m *HtlcAcceptRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *HtlcAcceptRequest) GetAmtMsat() uint64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
+}
+
+func (m *HtlcAcceptRequest) GetExpiry() uint32 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *HtlcAcceptRequest) GetAcceptHeight() int32 {
+	if m != nil {
+		return m.AcceptHeight
+	}
+	return 0
+}
+
+func (m *HtlcAcceptRequest) GetCustomRecords() map[uint64][]byte {
+	if m != nil {
+		return m.CustomRecords
+	}
+	return nil
+}
+
+func (m *HtlcAcceptRequest) GetMppTotalAmtMsat() uint64 {
+	if m != nil {
+		return m.MppTotalAmtMsat
+	}
+	return 0
+}
+
+func (m *HtlcAcceptRequest) GetPaymentAddr() []byte {
+	if m != nil {
+		return m.PaymentAddr
+	}
+	return nil
+}
+
+type HtlcAcceptResponse struct {
+	// The short channel id of the channel over which the htlc was received.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	// The index of the htlc in the channel.
+	HtlcId uint64 `protobuf:"varint,2,opt,name=htlc_id,json=htlcId,proto3" json:"htlc_id,omitempty"`
+	// The resolve action for this htlc.
+	Action HtlcAcceptAction `protobuf:"varint,3,opt,name=action,proto3,enum=invoicesrpc.HtlcAcceptAction" json:"action,omitempty"`
+	// The preimage in case the resolve action is Settle.
+	Preimage             []byte   `protobuf:"bytes,4,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HtlcAcceptResponse) Reset()         { *m = HtlcAcceptResponse{} }
+func (m *HtlcAcceptResponse) String() string { return proto.CompactTextString(m) }
+func (*HtlcAcceptResponse) ProtoMessage()    {}
+func (*HtlcAcceptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_090ab9c4958b987d, []int{11}
+}
+
+func (m *HtlcAcceptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcAcceptResponse.Unmarshal(m, b)
+}
+func (m *HtlcAcceptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcAcceptResponse.Marshal(b, m, deterministic)
+}
+func (m *HtlcAcceptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcAcceptResponse.Merge(m, src)
+}
+func (m *HtlcAcceptResponse) XXX_Size() int {
+	return xxx_messageInfo_HtlcAcceptResponse.Size(m)
+}
+func (m *HtlcAcceptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcAcceptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcAcceptResponse proto.InternalMessageInfo
+
+func (m *HtlcAcceptResponse) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *HtlcAcceptResponse) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
+	}
+	return 0
+}
+
+func (m *HtlcAcceptResponse) GetAction() HtlcAcceptAction {
+	if m != nil {
+		return m.Action
+	}
+	return HtlcAcceptAction_SETTLE
+}
+
+func (m *HtlcAcceptResponse) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("invoicesrpc.HtlcAcceptAction", HtlcAcceptAction_name, HtlcAcceptAction_value)
 	proto.RegisterType((*CancelInvoiceMsg)(nil), "invoicesrpc.CancelInvoiceMsg")
 	proto.RegisterType((*CancelInvoiceResp)(nil), "invoicesrpc.CancelInvoiceResp")
 	proto.RegisterType((*AddHoldInvoiceRequest)(nil), "invoicesrpc.AddHoldInvoiceRequest")
+	proto.RegisterType((*InvoiceAcceptPolicy)(nil), "invoicesrpc.InvoiceAcceptPolicy")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "invoicesrpc.InvoiceAcceptPolicy.RequiredCustomRecordsEntry")
 	proto.RegisterType((*AddHoldInvoiceResp)(nil), "invoicesrpc.AddHoldInvoiceResp")
 	proto.RegisterType((*SettleInvoiceMsg)(nil), "invoicesrpc.SettleInvoiceMsg")
 	proto.RegisterType((*SettleInvoiceResp)(nil), "invoicesrpc.SettleInvoiceResp")
+	proto.RegisterType((*SearchInvoicesRequest)(nil), "invoicesrpc.SearchInvoicesRequest")
+	proto.RegisterType((*SearchInvoicesResponse)(nil), "invoicesrpc.SearchInvoicesResponse")
 	proto.RegisterType((*SubscribeSingleInvoiceRequest)(nil), "invoicesrpc.SubscribeSingleInvoiceRequest")
+	proto.RegisterType((*HtlcAcceptRequest)(nil), "invoicesrpc.HtlcAcceptRequest")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "invoicesrpc.HtlcAcceptRequest.CustomRecordsEntry")
+	proto.RegisterType((*HtlcAcceptResponse)(nil), "invoicesrpc.HtlcAcceptResponse")
 }
 
 func init() { proto.RegisterFile("invoicesrpc/invoices.proto", fileDescriptor_090ab9c4958b987d) }
 
 var fileDescriptor_090ab9c4958b987d = []byte{
-	// 523 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x95, 0xf3, 0xd5, 0x64, 0x92, 0xa6, 0x61, 0xa1, 0x95, 0x65, 0x29, 0x34, 0x98, 0x03, 0x81,
-	0x83, 0x43, 0x5b, 0x71, 0x83, 0x43, 0x41, 0x48, 0x01, 0xa9, 0x1c, 0x1c, 0xc1, 0x81, 0x8b, 0xb5,
-	0xb1, 0x17, 0x7b, 0xd5, 0xf5, 0x7a, 0xd9, 0xdd, 0x04, 0xfa, 0x17, 0xf9, 0x07, 0xfc, 0x1b, 0xe4,
-	0xf5, 0xa6, 0xb2, 0x43, 0xdb, 0xdb, 0xcc, 0x9b, 0xd9, 0xe7, 0xa7, 0xf7, 0xc6, 0xe0, 0x51, 0xbe,
-	0x2d, 0x68, 0x4c, 0x94, 0x14, 0xf1, 0x62, 0x57, 0x07, 0x42, 0x16, 0xba, 0x40, 0xc3, 0xda, 0xcc,
-	0x1b, 0x48, 0x11, 0x57, 0xb8, 0xff, 0x06, 0x26, 0x1f, 0x30, 0x8f, 0x09, 0xfb, 0x54, 0xcd, 0xaf,
-	0x54, 0x8a, 0x9e, 0xc1, 0x48, 0xe0, 0x9b, 0x9c, 0x70, 0x1d, 0x65, 0x58, 0x65, 0xae, 0x33, 0x73,
-	0xe6, 0xa3, 0x70, 0x68, 0xb1, 0x25, 0x56, 0x99, 0xff, 0x18, 0x1e, 0x35, 0x9e, 0x85, 0x44, 0x09,
-	0xff, 0x4f, 0x0b, 0x8e, 0x2f, 0x93, 0x64, 0x59, 0xb0, 0xe4, 0x16, 0xfe, 0xb9, 0x21, 0x4a, 0x23,
-	0x04, 0x9d, 0x9c, 0xe4, 0x85, 0x61, 0x1a, 0x84, 0xa6, 0x2e, 0x31, 0xc3, 0xde, 0x32, 0xec, 0xa6,
-	0x46, 0x4f, 0xa0, 0xbb, 0xc5, 0x6c, 0x43, 0xdc, 0xf6, 0xcc, 0x99, 0xb7, 0xc3, 0xaa, 0x41, 0x53,
-	0x00, 0x53, 0x44, 0xb9, 0xc2, 0xda, 0x05, 0x33, 0x1a, 0x18, 0xe4, 0x4a, 0x61, 0x8d, 0x5e, 0xc2,
-	0x24, 0x21, 0x2a, 0x96, 0x54, 0x68, 0x5a, 0xf0, 0x4a, 0x72, 0xc7, 0x90, 0x1e, 0xd5, 0xf0, 0x52,
-	0x36, 0x3a, 0x81, 0x1e, 0xf9, 0x2d, 0xa8, 0xbc, 0x71, 0xbb, 0x86, 0xc5, 0x76, 0xe8, 0x39, 0x1c,
-	0xfe, 0xc0, 0x8c, 0xad, 0x71, 0x7c, 0x1d, 0xe1, 0x24, 0x91, 0x6e, 0xcf, 0x08, 0x1d, 0xed, 0xc0,
-	0xcb, 0x24, 0x91, 0xe8, 0x14, 0x86, 0x31, 0xd3, 0xdb, 0xc8, 0x32, 0x1c, 0xcc, 0x9c, 0x79, 0x27,
-	0x84, 0x12, 0xfa, 0x58, 0xb1, 0x9c, 0xc1, 0x50, 0x16, 0x1b, 0x4d, 0xa2, 0x8c, 0x72, 0xad, 0xdc,
-	0xfe, 0xac, 0x3d, 0x1f, 0x9e, 0x4f, 0x02, 0xc6, 0x4b, 0xbb, 0xc3, 0x72, 0xb2, 0xa4, 0x5c, 0x87,
-	0x20, 0x77, 0xa5, 0x42, 0x2e, 0x1c, 0x08, 0x49, 0xb7, 0x58, 0x13, 0x77, 0x30, 0x73, 0xe6, 0xfd,
-	0x70, 0xd7, 0xfa, 0xef, 0x00, 0xed, 0x7b, 0xa9, 0x04, 0x7a, 0x01, 0x47, 0xbb, 0x68, 0x64, 0xe5,
-	0xad, 0xf5, 0x74, 0x6c, 0x61, 0xeb, 0xb8, 0x1f, 0xc0, 0x64, 0x45, 0xb4, 0x66, 0xa4, 0x96, 0xab,
-	0x07, 0x7d, 0x21, 0x09, 0xcd, 0x71, 0x4a, 0x6c, 0xa6, 0xb7, 0x7d, 0x19, 0x68, 0x63, 0xdf, 0x04,
-	0xfa, 0x16, 0xa6, 0xab, 0xcd, 0xba, 0xb4, 0x70, 0x4d, 0x56, 0x94, 0xa7, 0xb5, 0x69, 0x95, 0xeb,
-	0x31, 0xf4, 0x64, 0x54, 0x4b, 0xb1, 0x2b, 0x4b, 0x9b, 0x3f, 0x77, 0xfa, 0xce, 0xa4, 0x75, 0xfe,
-	0xb7, 0x05, 0x7d, 0xbb, 0xaf, 0xd0, 0x37, 0x38, 0xb9, 0x9b, 0x0a, 0xbd, 0x0a, 0x6a, 0xa7, 0x19,
-	0x3c, 0xf8, 0x3d, 0x6f, 0x6c, 0xcd, 0xb4, 0xf0, 0x6b, 0x07, 0x7d, 0x81, 0xc3, 0xc6, 0x21, 0xa2,
-	0x69, 0x83, 0x6e, 0xff, 0xb6, 0xbd, 0xa7, 0xf7, 0x8f, 0x8d, 0xc1, 0x5f, 0x61, 0xdc, 0xb4, 0x1d,
-	0xf9, 0x8d, 0x17, 0x77, 0xde, 0xb7, 0x77, 0xfa, 0xe0, 0x8e, 0x12, 0xa5, 0xcc, 0x86, 0xbd, 0x7b,
-	0x32, 0xf7, 0xa3, 0xda, 0x93, 0xf9, 0x5f, 0x32, 0xef, 0x2f, 0xbe, 0x9f, 0xa5, 0x54, 0x67, 0x9b,
-	0x75, 0x10, 0x17, 0xf9, 0x82, 0xd1, 0x34, 0xd3, 0x9c, 0xf2, 0x94, 0x13, 0xfd, 0xab, 0x90, 0xd7,
-	0x0b, 0xc6, 0x93, 0x85, 0x71, 0x6a, 0x51, 0xa3, 0x59, 0xf7, 0xcc, 0x2f, 0x7f, 0xf1, 0x2f, 0x00,
-	0x00, 0xff, 0xff, 0xc3, 0xb1, 0x8c, 0x5e, 0x28, 0x04, 0x00, 0x00,
+	// 1233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6f, 0x1b, 0x45,
+	0x10, 0xe7, 0x62, 0xc7, 0xb1, 0xc7, 0x1f, 0x71, 0x36, 0x4d, 0x6b, 0x0e, 0xb5, 0x75, 0x5d, 0x04,
+	0x26, 0x80, 0xd3, 0xa6, 0xaa, 0x84, 0xf8, 0x90, 0x08, 0x69, 0x50, 0x82, 0x5a, 0x8a, 0xce, 0x01,
+	0x01, 0x2f, 0xa7, 0xcd, 0xdd, 0xc6, 0x5e, 0xf5, 0x6e, 0xef, 0xba, 0xbb, 0x0e, 0xf1, 0x9f, 0xc0,
+	0x23, 0x8f, 0xbc, 0xf0, 0x0c, 0x12, 0x7f, 0x24, 0xda, 0xb9, 0x3d, 0xe7, 0xce, 0x8d, 0x83, 0x10,
+	0x4f, 0xde, 0x9d, 0xf9, 0xed, 0x78, 0x3e, 0x7e, 0x33, 0x73, 0xe0, 0x72, 0x71, 0x91, 0xf0, 0x80,
+	0x29, 0x99, 0x06, 0x7b, 0xf9, 0x79, 0x94, 0xca, 0x44, 0x27, 0xa4, 0x59, 0xd0, 0xb9, 0x0d, 0x99,
+	0x06, 0x99, 0x7c, 0xf0, 0x14, 0xba, 0x87, 0x54, 0x04, 0x2c, 0x3a, 0xc9, 0xf4, 0x2f, 0xd4, 0x84,
+	0x3c, 0x80, 0x56, 0x4a, 0xe7, 0x31, 0x13, 0xda, 0x9f, 0x52, 0x35, 0xed, 0x39, 0x7d, 0x67, 0xd8,
+	0xf2, 0x9a, 0x56, 0x76, 0x4c, 0xd5, 0x74, 0xb0, 0x0d, 0x5b, 0xa5, 0x67, 0x1e, 0x53, 0xe9, 0xe0,
+	0xb7, 0x0a, 0xec, 0x1c, 0x84, 0xe1, 0x71, 0x12, 0x85, 0x0b, 0xf1, 0xeb, 0x19, 0x53, 0x9a, 0x10,
+	0xa8, 0xc6, 0x2c, 0x4e, 0xd0, 0x52, 0xc3, 0xc3, 0xb3, 0x91, 0xa1, 0xf5, 0x35, 0xb4, 0x8e, 0x67,
+	0x72, 0x0b, 0xd6, 0x2f, 0x68, 0x34, 0x63, 0xbd, 0x4a, 0xdf, 0x19, 0x56, 0xbc, 0xec, 0x42, 0xee,
+	0x02, 0xe0, 0xc1, 0x8f, 0x15, 0xd5, 0x3d, 0x40, 0x55, 0x03, 0x25, 0x2f, 0x14, 0xd5, 0xe4, 0x03,
+	0xe8, 0x86, 0x4c, 0x05, 0x92, 0xa7, 0x9a, 0x27, 0x22, 0x73, 0xb9, 0x8a, 0x46, 0x37, 0x0b, 0x72,
+	0xe3, 0x36, 0xb9, 0x0d, 0x35, 0x76, 0x99, 0x72, 0x39, 0xef, 0xad, 0xa3, 0x15, 0x7b, 0x23, 0x0f,
+	0xa1, 0x7d, 0x4e, 0xa3, 0xe8, 0x8c, 0x06, 0xaf, 0x7c, 0x1a, 0x86, 0xb2, 0x57, 0x43, 0x47, 0x5b,
+	0xb9, 0xf0, 0x20, 0x0c, 0x25, 0xb9, 0x0f, 0xcd, 0x20, 0xd2, 0x17, 0xbe, 0xb5, 0xb0, 0xd1, 0x77,
+	0x86, 0x55, 0x0f, 0x8c, 0xe8, 0x28, 0xb3, 0xf2, 0x18, 0x9a, 0x32, 0x99, 0x69, 0xe6, 0x4f, 0xb9,
+	0xd0, 0xaa, 0x57, 0xef, 0x57, 0x86, 0xcd, 0xfd, 0xee, 0x28, 0x12, 0x26, 0xdd, 0x9e, 0xd1, 0x1c,
+	0x73, 0xa1, 0x3d, 0x90, 0xf9, 0x51, 0x91, 0x1e, 0x6c, 0xa4, 0x92, 0x5f, 0x50, 0xcd, 0x7a, 0x8d,
+	0xbe, 0x33, 0xac, 0x7b, 0xf9, 0x95, 0x1c, 0x41, 0x9b, 0x06, 0x01, 0x4b, 0xb5, 0x9f, 0x26, 0x11,
+	0x0f, 0xe6, 0xbd, 0x66, 0xdf, 0x19, 0x36, 0xf7, 0xfb, 0xa3, 0x42, 0x21, 0x47, 0x36, 0xcd, 0x07,
+	0x08, 0xfc, 0x0e, 0x71, 0x5e, 0x8b, 0x16, 0x6e, 0x83, 0xbf, 0xd6, 0x60, 0xfb, 0x1a, 0x14, 0x79,
+	0x17, 0x3a, 0x31, 0x17, 0x3e, 0x06, 0x14, 0xb2, 0x48, 0x53, 0xac, 0x4d, 0xdb, 0x6b, 0xc5, 0x5c,
+	0x1c, 0x46, 0xfa, 0xe2, 0x99, 0x91, 0x11, 0x05, 0x77, 0x24, 0x7b, 0x3d, 0xe3, 0x92, 0x85, 0x7e,
+	0x30, 0x53, 0x3a, 0x89, 0x7d, 0xc9, 0x82, 0x44, 0x86, 0xaa, 0xb7, 0x86, 0xd1, 0x7d, 0xf6, 0x6f,
+	0xee, 0x8c, 0x3c, 0xfb, 0xfe, 0x10, 0x9f, 0x7b, 0xd9, 0xeb, 0x23, 0xa1, 0xe5, 0xdc, 0xdb, 0x91,
+	0xd7, 0xe9, 0xc8, 0xc7, 0xb0, 0x6d, 0x5c, 0xb3, 0xd1, 0xd3, 0x58, 0x67, 0x75, 0xaf, 0x60, 0xbe,
+	0xbb, 0x31, 0x17, 0x99, 0xfd, 0x83, 0x58, 0x9b, 0xf2, 0xbb, 0xc7, 0xe0, 0xae, 0xfe, 0x0f, 0xd2,
+	0x85, 0xca, 0x2b, 0x36, 0xc7, 0xe0, 0xaa, 0x9e, 0x39, 0x5e, 0x71, 0x2c, 0x23, 0x5e, 0x76, 0xf9,
+	0x74, 0xed, 0x13, 0x67, 0xf0, 0x05, 0x90, 0x65, 0xfa, 0xaa, 0x94, 0xbc, 0x0f, 0x9b, 0x79, 0x37,
+	0xc8, 0x8c, 0xce, 0x96, 0xc6, 0x1d, 0x2b, 0xb6, 0x24, 0x1f, 0x8c, 0xa0, 0x3b, 0x66, 0x5a, 0x47,
+	0xac, 0xd0, 0x4a, 0x2e, 0xd4, 0x53, 0xc9, 0x78, 0x4c, 0x27, 0xcc, 0xb6, 0xd1, 0xe2, 0x6e, 0x7a,
+	0xa8, 0x84, 0xc7, 0x1e, 0xfa, 0xb3, 0x0a, 0x3b, 0x63, 0x46, 0x65, 0x30, 0xb5, 0x52, 0x95, 0xf7,
+	0xd0, 0x03, 0x68, 0x71, 0x11, 0xb2, 0x4b, 0x3f, 0x39, 0x3f, 0x57, 0x4c, 0xdb, 0x90, 0x9a, 0x28,
+	0x7b, 0x89, 0x22, 0x32, 0x84, 0xae, 0x98, 0xc5, 0x7e, 0x4c, 0x2f, 0xfd, 0xbc, 0x2c, 0x18, 0x65,
+	0xd5, 0xeb, 0x88, 0x59, 0xfc, 0x82, 0x5e, 0xe6, 0x36, 0x8d, 0x5f, 0x92, 0x5d, 0x30, 0xa9, 0x58,
+	0x88, 0x89, 0xad, 0x7b, 0x8b, 0x3b, 0xb6, 0x3f, 0x13, 0x21, 0x17, 0x13, 0x3f, 0x11, 0xd1, 0x1c,
+	0x7b, 0xa9, 0xee, 0x35, 0xad, 0xec, 0xa5, 0x88, 0xe6, 0x64, 0x04, 0xdb, 0x81, 0x64, 0x14, 0xfb,
+	0x2d, 0xa4, 0x9a, 0xf9, 0x4a, 0x53, 0xa9, 0x6d, 0x53, 0x6d, 0xe5, 0xaa, 0x67, 0x54, 0xb3, 0xb1,
+	0x51, 0x90, 0x5d, 0xd8, 0x2a, 0xe3, 0x99, 0x08, 0xb1, 0xc7, 0x2a, 0xde, 0x66, 0x11, 0x7d, 0x24,
+	0x42, 0x83, 0x55, 0x98, 0x96, 0xa2, 0xe5, 0x8d, 0x0c, 0x9b, 0x29, 0xae, 0xec, 0xbe, 0x07, 0x9b,
+	0x45, 0xac, 0xb1, 0x5a, 0x47, 0x64, 0xfb, 0x0a, 0x69, 0x6c, 0x3e, 0x81, 0x9a, 0xd2, 0x54, 0x33,
+	0xd5, 0x6b, 0xf4, 0x2b, 0xc3, 0xce, 0xfe, 0x3b, 0xb6, 0x29, 0x6d, 0x3e, 0xf2, 0xdf, 0xb1, 0xc1,
+	0x78, 0x16, 0x6a, 0x86, 0x82, 0x19, 0x54, 0x7e, 0x90, 0x08, 0x4d, 0xb9, 0x50, 0x38, 0x79, 0x1a,
+	0x5e, 0xcb, 0x08, 0x0f, 0xad, 0x8c, 0xf4, 0xa1, 0x85, 0x64, 0xcd, 0x59, 0xda, 0xc4, 0xbf, 0x07,
+	0xc3, 0xd2, 0x8c, 0x9f, 0x88, 0xa0, 0x97, 0x57, 0x88, 0x96, 0x45, 0xd0, 0xcb, 0x1c, 0xf1, 0x14,
+	0xee, 0x4c, 0x75, 0x14, 0x94, 0x3b, 0xcc, 0xd7, 0xf3, 0x94, 0xf5, 0xda, 0x58, 0xbd, 0x5b, 0x46,
+	0x5d, 0x24, 0xf7, 0xe9, 0x3c, 0x65, 0x83, 0x3f, 0x1c, 0xb8, 0xbd, 0x4c, 0x15, 0x95, 0x26, 0x42,
+	0x31, 0xb2, 0x0b, 0xf5, 0x05, 0x01, 0x1c, 0x6c, 0xd4, 0x4e, 0x39, 0x62, 0x6f, 0xa1, 0x37, 0xf9,
+	0x8e, 0xa8, 0xd2, 0x7e, 0x89, 0x5c, 0x19, 0x6b, 0x36, 0x8d, 0xe2, 0xa4, 0x40, 0xb0, 0x8f, 0x80,
+	0x9c, 0x73, 0xb9, 0x0c, 0xb6, 0x9d, 0x89, 0x9a, 0x02, 0x7a, 0xf0, 0x39, 0xdc, 0x1d, 0xcf, 0xce,
+	0xcc, 0x04, 0x3e, 0x63, 0x63, 0x2e, 0x26, 0x05, 0xa6, 0x67, 0x94, 0xde, 0x81, 0x9a, 0xf4, 0x0b,
+	0x4b, 0x60, 0x5d, 0x9a, 0x29, 0xfd, 0x4d, 0xb5, 0xee, 0x74, 0xd7, 0x06, 0x7f, 0x57, 0x60, 0xeb,
+	0x58, 0x47, 0x41, 0xd6, 0xed, 0xf9, 0x93, 0x3b, 0xb0, 0x11, 0x4c, 0xa9, 0xf0, 0x79, 0x68, 0x1b,
+	0xa0, 0x66, 0xae, 0x27, 0xa1, 0x51, 0x60, 0x12, 0x79, 0x68, 0x9d, 0xaf, 0x99, 0xeb, 0x49, 0xf8,
+	0xc6, 0x36, 0xab, 0xbc, 0xb1, 0xcd, 0xc8, 0xdb, 0x50, 0x5f, 0x94, 0xa7, 0x8a, 0x8f, 0x37, 0xa8,
+	0xad, 0x4d, 0x79, 0x63, 0xb4, 0x8b, 0x1b, 0xc3, 0x0e, 0xa8, 0x29, 0xe3, 0x93, 0xa9, 0x46, 0x36,
+	0xaf, 0xe7, 0xc3, 0xf7, 0x18, 0x65, 0xe4, 0x47, 0xe8, 0x2c, 0x4d, 0xcd, 0x0d, 0x2c, 0xc6, 0xe3,
+	0xd2, 0xd4, 0x7c, 0x23, 0xc8, 0xd1, 0x35, 0xb3, 0xb2, 0x1d, 0x14, 0x65, 0xe4, 0x43, 0x20, 0x71,
+	0x9a, 0xfa, 0x3a, 0xd1, 0x34, 0xba, 0xa2, 0x56, 0x3d, 0xab, 0x5a, 0x9c, 0xa6, 0xa7, 0x46, 0x91,
+	0xf3, 0xab, 0x90, 0x01, 0x5c, 0x6e, 0x8d, 0x52, 0x06, 0xcc, 0x6e, 0x73, 0xbf, 0x04, 0xf2, 0x3f,
+	0x87, 0xe7, 0xef, 0x0e, 0x90, 0x62, 0x24, 0x96, 0x89, 0xff, 0xbd, 0x5e, 0x4f, 0xa1, 0x46, 0x03,
+	0x33, 0x10, 0xb0, 0x52, 0x9d, 0xfd, 0xbb, 0x2b, 0x92, 0x75, 0x80, 0x20, 0xcf, 0x82, 0x4b, 0x93,
+	0xb6, 0x5a, 0x9e, 0xb4, 0xbb, 0x43, 0xe8, 0x2e, 0xbf, 0x23, 0x00, 0xb5, 0xf1, 0xd1, 0xe9, 0xe9,
+	0xf3, 0xa3, 0xee, 0x5b, 0xa4, 0x0e, 0xd5, 0xaf, 0x0f, 0x4e, 0x9e, 0x77, 0x9d, 0xfd, 0x5f, 0xab,
+	0x50, 0x5f, 0x0c, 0xc9, 0x1f, 0xe0, 0xf6, 0xf5, 0xfc, 0x25, 0xbb, 0x25, 0x9f, 0x6e, 0x24, 0xb9,
+	0xbb, 0xd4, 0x79, 0x8f, 0x1c, 0xf2, 0x2d, 0xb4, 0x4b, 0x1f, 0x4f, 0xa4, 0x1c, 0xe2, 0xf2, 0xf7,
+	0x98, 0x7b, 0x6f, 0xb5, 0x1a, 0x37, 0xd4, 0xf7, 0xd0, 0x29, 0xef, 0x2d, 0x32, 0x28, 0xbd, 0xb8,
+	0xf6, 0x9b, 0xcc, 0xbd, 0x7f, 0x23, 0x46, 0xa5, 0xc6, 0xcd, 0xd2, 0x7e, 0x5a, 0x72, 0x73, 0x79,
+	0xd7, 0xb9, 0xf7, 0x56, 0xab, 0xd1, 0xde, 0x18, 0x5a, 0x57, 0x55, 0x48, 0x24, 0xb9, 0xbf, 0xb2,
+	0x0b, 0x32, 0xee, 0xb8, 0xf7, 0x56, 0x02, 0x30, 0x82, 0xa1, 0xf3, 0xc8, 0x21, 0x3f, 0x41, 0xa7,
+	0x3c, 0x03, 0x97, 0x62, 0xbf, 0x76, 0x97, 0xba, 0x0f, 0x6f, 0xc4, 0x64, 0x7f, 0xff, 0xd5, 0x93,
+	0x9f, 0x1f, 0x4f, 0xb8, 0x9e, 0xce, 0xce, 0x46, 0x41, 0x12, 0xef, 0x45, 0xa6, 0xa3, 0x05, 0x17,
+	0x13, 0xc1, 0xf4, 0x2f, 0x89, 0x7c, 0xb5, 0x17, 0x89, 0x70, 0x2f, 0x12, 0xc5, 0x4f, 0x6d, 0x99,
+	0x06, 0x67, 0x35, 0xfc, 0xac, 0x7e, 0xf2, 0xcf, 0x00, 0x9b, 0xec, 0xa8, 0xe2, 0x8c, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -466,6 +1030,20 @@ type InvoicesClient interface {
 	//SettleInvoice settles an accepted invoice. If the invoice is already
 	//settled, this call will succeed.
 	SettleInvoice(ctx context.Context, in *SettleInvoiceMsg, opts ...grpc.CallOption) (*SettleInvoiceResp, error)
+	//
+	//HtlcAcceptor dispatches a bi-directional streaming RPC in which exit hop
+	//htlcs for which no invoice exists are sent to the client, together with
+	//their custom records. The client can settle such an htlc with a preimage
+	//or fail it. Htlcs that the client doesn't respond to are held. When the
+	//client disconnects, all held htlcs are failed. Only one acceptor can be
+	//active at a time.
+	HtlcAcceptor(ctx context.Context, opts ...grpc.CallOption) (Invoices_HtlcAcceptorClient, error)
+	//
+	//SearchInvoices returns the invoices that match all of the given filters.
+	//Queries that restrict the creation or settle date are served from a date
+	//index, so they don't need to read all invoices of the node. The results
+	//are paginated by add index, like ListInvoices.
+	SearchInvoices(ctx context.Context, in *SearchInvoicesRequest, opts ...grpc.CallOption) (*SearchInvoicesResponse, error)
 }
 
 type invoicesClient struct {
@@ -535,6 +1113,46 @@ func (c *invoicesClient) SettleInvoice(ctx context.Context, in *SettleInvoiceMsg
 	return out, nil
 }
 
+func (c *invoicesClient) HtlcAcceptor(ctx context.Context, opts ...grpc.CallOption) (Invoices_HtlcAcceptorClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Invoices_serviceDesc.Streams[1], "/invoicesrpc.Invoices/HtlcAcceptor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &invoicesHtlcAcceptorClient{stream}
+	return x, nil
+}
+
+type Invoices_HtlcAcceptorClient interface {
+	Send(*HtlcAcceptResponse) error
+	Recv() (*HtlcAcceptRequest, error)
+	grpc.ClientStream
+}
+
+type invoicesHtlcAcceptorClient struct {
+	grpc.ClientStream
+}
+
+func (x *invoicesHtlcAcceptorClient) Send(m *HtlcAcceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *invoicesHtlcAcceptorClient) Recv() (*HtlcAcceptRequest, error) {
+	m := new(HtlcAcceptRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *invoicesClient) SearchInvoices(ctx context.Context, in *SearchInvoicesRequest, opts ...grpc.CallOption) (*SearchInvoicesResponse, error) {
+	out := new(SearchInvoicesResponse)
+	err := c.cc.Invoke(ctx, "/invoicesrpc.Invoices/SearchInvoices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InvoicesServer is the server API for Invoices service.
 type InvoicesServer interface {
 	//
@@ -555,6 +1173,20 @@ type InvoicesServer interface {
 	//SettleInvoice settles an accepted invoice. If the invoice is already
 	//settled, this call will succeed.
 	SettleInvoice(context.Context, *SettleInvoiceMsg) (*SettleInvoiceResp, error)
+	//
+	//HtlcAcceptor dispatches a bi-directional streaming RPC in which exit hop
+	//htlcs for which no invoice exists are sent to the client, together with
+	//their custom records. The client can settle such an htlc with a preimage
+	//or fail it. Htlcs that the client doesn't respond to are held. When the
+	//client disconnects, all held htlcs are failed. Only one acceptor can be
+	//active at a time.
+	HtlcAcceptor(Invoices_HtlcAcceptorServer) error
+	//
+	//SearchInvoices returns the invoices that match all of the given filters.
+	//Queries that restrict the creation or settle date are served from a date
+	//index, so they don't need to read all invoices of the node. The results
+	//are paginated by add index, like ListInvoices.
+	SearchInvoices(context.Context, *SearchInvoicesRequest) (*SearchInvoicesResponse, error)
 }
 
 // UnimplementedInvoicesServer can be embedded to have forward compatible implementations.
@@ -573,6 +1205,12 @@ func (*UnimplementedInvoicesServer) AddHoldInvoice(ctx context.Context, req *Add
 func (*UnimplementedInvoicesServer) SettleInvoice(ctx context.Context, req *SettleInvoiceMsg) (*SettleInvoiceResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SettleInvoice not implemented")
 }
+func (*UnimplementedInvoicesServer) HtlcAcceptor(srv Invoices_HtlcAcceptorServer) error {
+	return status.Errorf(codes.Unimplemented, "method HtlcAcceptor not implemented")
+}
+func (*UnimplementedInvoicesServer) SearchInvoices(ctx context.Context, req *SearchInvoicesRequest) (*SearchInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchInvoices not implemented")
+}
 
 func RegisterInvoicesServer(s *grpc.Server, srv InvoicesServer) {
 	s.RegisterService(&_Invoices_serviceDesc, srv)
@@ -653,6 +1291,50 @@ func _Invoices_SettleInvoice_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Invoices_HtlcAcceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(InvoicesServer).HtlcAcceptor(&invoicesHtlcAcceptorServer{stream})
+}
+
+type Invoices_HtlcAcceptorServer interface {
+	Send(*HtlcAcceptRequest) error
+	Recv() (*HtlcAcceptResponse, error)
+	grpc.ServerStream
+}
+
+type invoicesHtlcAcceptorServer struct {
+	grpc.ServerStream
+}
+
+func (x *invoicesHtlcAcceptorServer) Send(m *HtlcAcceptRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *invoicesHtlcAcceptorServer) Recv() (*HtlcAcceptResponse, error) {
+	m := new(HtlcAcceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Invoices_SearchInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).SearchInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicesrpc.Invoices/SearchInvoices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).SearchInvoices(ctx, req.(*SearchInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Invoices_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "invoicesrpc.Invoices",
 	HandlerType: (*InvoicesServer)(nil),
@@ -669,6 +1351,10 @@ var _Invoices_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SettleInvoice",
 			Handler:    _Invoices_SettleInvoice_Handler,
 		},
+		{
+			MethodName: "SearchInvoices",
+			Handler:    _Invoices_SearchInvoices_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -676,6 +1362,12 @@ var _Invoices_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Invoices_SubscribeSingleInvoice_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "HtlcAcceptor",
+			Handler:       _Invoices_HtlcAcceptor_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "invoicesrpc/invoices.proto",
 }
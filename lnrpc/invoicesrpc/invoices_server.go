@@ -4,9 +4,13 @@ package invoicesrpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync/atomic"
+	"time"
 
 	"google.golang.org/grpc"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -15,7 +19,9 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/record"
 )
 
 const (
@@ -58,12 +64,25 @@ var (
 			Entity: "invoices",
 			Action: "write",
 		}},
+		"/invoicesrpc.Invoices/HtlcAcceptor": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
+		"/invoicesrpc.Invoices/SearchInvoices": {{
+			Entity: "invoices",
+			Action: "read",
+		}},
 	}
 
 	// DefaultInvoicesMacFilename is the default name of the invoices
 	// macaroon that we expect to find via a file handle within the main
 	// configuration file in this package.
 	DefaultInvoicesMacFilename = "invoices.macaroon"
+
+	// ErrHtlcAcceptorAlreadyExists is an error returned when a new htlc
+	// acceptor stream is opened while there is already an active one.
+	ErrHtlcAcceptorAlreadyExists = errors.New("htlc acceptor already " +
+		"exists")
 )
 
 // ServerShell is a shell struct holding a reference to the actual sub-server.
@@ -77,6 +96,8 @@ type ServerShell struct {
 // RPC server allows external callers to access the status of the invoices
 // currently active within lnd, as well as configuring it at runtime.
 type Server struct {
+	htlcAcceptorActive int32 // To be used atomically.
+
 	quit chan struct{}
 
 	cfg *Config
@@ -247,6 +268,27 @@ func (s *Server) SubscribeSingleInvoice(req *SubscribeSingleInvoiceRequest,
 				return err
 			}
 
+		// An htlc was rejected by the acceptance policy of the invoice.
+		// We report it along with the unchanged invoice.
+		case rejection := <-invoiceClient.Rejections:
+			rpcInvoice, err := CreateRPCInvoice(
+				rejection.Invoice, s.cfg.ChainParams,
+			)
+			if err != nil {
+				return err
+			}
+
+			rpcInvoice.HtlcRejection, err = CreateRPCHtlcRejection(
+				rejection,
+			)
+			if err != nil {
+				return err
+			}
+
+			if err := updateStream.Send(rpcInvoice); err != nil {
+				return err
+			}
+
 		case <-s.quit:
 			return nil
 		}
@@ -336,6 +378,7 @@ func (s *Server) AddHoldInvoice(ctx context.Context,
 		HodlInvoice:     true,
 		Preimage:        nil,
 		RouteHints:      routeHints,
+		AcceptPolicy:    unmarshallAcceptPolicy(invoice.AcceptPolicy),
 	}
 
 	_, dbInvoice, err := AddInvoice(ctx, addInvoiceCfg, addInvoiceData)
@@ -347,3 +390,143 @@ func (s *Server) AddHoldInvoice(ctx context.Context,
 		PaymentRequest: string(dbInvoice.PaymentRequest),
 	}, nil
 }
+
+// HtlcAcceptor is a bidirectional stream that presents exit hop htlcs for
+// which no invoice exists to the client. The client can settle these htlcs
+// with a preimage or fail them. Htlcs that the client doesn't respond to are
+// held until the stream closes, after which they are failed.
+func (s *Server) HtlcAcceptor(stream Invoices_HtlcAcceptorServer) error {
+	// We ensure there is only one htlc acceptor at a time.
+	if !atomic.CompareAndSwapInt32(&s.htlcAcceptorActive, 0, 1) {
+		return ErrHtlcAcceptorAlreadyExists
+	}
+	defer atomic.CompareAndSwapInt32(&s.htlcAcceptorActive, 1, 0)
+
+	return newHtlcAcceptor(s, stream).run()
+}
+
+// SearchInvoices returns the invoices that match all of the given filters.
+// Queries that restrict the creation or settle date are served from a date
+// index, so they don't need to read all invoices of the node.
+func (s *Server) SearchInvoices(ctx context.Context,
+	req *SearchInvoicesRequest) (*SearchInvoicesResponse, error) {
+
+	// If the number of invoices was not specified, then we'll default to
+	// returning the latest 100 invoices.
+	if req.NumMaxInvoices == 0 {
+		req.NumMaxInvoices = 100
+	}
+
+	if req.MinAmtMsat < 0 || req.MaxAmtMsat < 0 {
+		return nil, errors.New("invoice amounts must not be negative")
+	}
+
+	if req.MaxAmtMsat != 0 && req.MinAmtMsat > req.MaxAmtMsat {
+		return nil, errors.New("min amount must not exceed max amount")
+	}
+
+	q := channeldb.InvoiceQuery{
+		IndexOffset:          req.IndexOffset,
+		NumMaxInvoices:       req.NumMaxInvoices,
+		PendingOnly:          req.PendingOnly,
+		Reversed:             req.Reversed,
+		CreationDateStart:    unmarshallUnixTime(req.CreationDateStart),
+		CreationDateEnd:      unmarshallUnixTime(req.CreationDateEnd),
+		SettleDateStart:      unmarshallUnixTime(req.SettleDateStart),
+		SettleDateEnd:        unmarshallUnixTime(req.SettleDateEnd),
+		MemoContains:         req.MemoContains,
+		MinAmt:               lnwire.MilliSatoshi(req.MinAmtMsat),
+		MaxAmt:               lnwire.MilliSatoshi(req.MaxAmtMsat),
+		HtlcCustomRecordType: req.HtlcCustomRecordType,
+	}
+
+	for _, rpcState := range req.States {
+		state, err := unmarshallInvoiceState(rpcState)
+		if err != nil {
+			return nil, err
+		}
+
+		q.States = append(q.States, state)
+	}
+
+	invoiceSlice, err := s.cfg.RemoteChanDB.QueryInvoices(q)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query invoices: %v", err)
+	}
+
+	resp := &SearchInvoicesResponse{
+		Invoices: make(
+			[]*lnrpc.Invoice, len(invoiceSlice.Invoices),
+		),
+		FirstIndexOffset: invoiceSlice.FirstIndexOffset,
+		LastIndexOffset:  invoiceSlice.LastIndexOffset,
+	}
+	for i, invoice := range invoiceSlice.Invoices {
+		invoice := invoice
+		resp.Invoices[i], err = CreateRPCInvoice(
+			&invoice, s.cfg.ChainParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// unmarshallUnixTime converts a unix timestamp in seconds into a time. A zero
+// timestamp is converted into the zero time, which leaves a date range open.
+func unmarshallUnixTime(timestamp int64) time.Time {
+	if timestamp == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(timestamp, 0)
+}
+
+// unmarshallInvoiceState converts an rpc invoice state into its database
+// representation.
+func unmarshallInvoiceState(
+	state lnrpc.Invoice_InvoiceState) (channeldb.ContractState, error) {
+
+	switch state {
+	case lnrpc.Invoice_OPEN:
+		return channeldb.ContractOpen, nil
+
+	case lnrpc.Invoice_SETTLED:
+		return channeldb.ContractSettled, nil
+
+	case lnrpc.Invoice_CANCELED:
+		return channeldb.ContractCanceled, nil
+
+	case lnrpc.Invoice_ACCEPTED:
+		return channeldb.ContractAccepted, nil
+
+	default:
+		return 0, fmt.Errorf("unknown invoice state: %v", state)
+	}
+}
+
+// unmarshallAcceptPolicy converts an rpc acceptance policy into its database
+// representation. A nil policy is returned if no policy is given.
+func unmarshallAcceptPolicy(
+	rpcPolicy *InvoiceAcceptPolicy) *channeldb.InvoiceAcceptPolicy {
+
+	if rpcPolicy == nil {
+		return nil
+	}
+
+	policy := &channeldb.InvoiceAcceptPolicy{
+		MinCltvDelta: rpcPolicy.MinCltvDelta,
+		MinAcceptAmt: lnwire.MilliSatoshi(rpcPolicy.MinAcceptAmtMsat),
+	}
+
+	if len(rpcPolicy.RequiredCustomRecords) > 0 {
+		policy.RequiredCustomRecords = make(record.CustomSet)
+		for recordType, value := range rpcPolicy.RequiredCustomRecords {
+			policy.RequiredCustomRecords[recordType] = value
+		}
+	}
+
+	return policy
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/zpay32"
@@ -253,3 +254,37 @@ func CreateZpay32HopHints(routeHints []*lnrpc.RouteHint) ([][]zpay32.HopHint, er
 	}
 	return res, nil
 }
+
+// CreateRPCHtlcRejection creates an *lnrpc.InvoiceHtlcRejection from an htlc
+// that was rejected by the acceptance policy of an invoice.
+func CreateRPCHtlcRejection(rejection *invoices.HtlcRejection) (
+	*lnrpc.InvoiceHtlcRejection, error) {
+
+	var reason lnrpc.InvoiceHtlcRejection_Reason
+	switch rejection.Outcome {
+	case invoices.ResultPolicyExpiryTooSoon:
+		reason = lnrpc.InvoiceHtlcRejection_EXPIRY_TOO_SOON
+
+	case invoices.ResultPolicyMissingRecord:
+		reason = lnrpc.InvoiceHtlcRejection_MISSING_CUSTOM_RECORD
+
+	case invoices.ResultPolicyRecordMismatch:
+		reason = lnrpc.InvoiceHtlcRejection_CUSTOM_RECORD_MISMATCH
+
+	case invoices.ResultHoldExpiryTooSoon:
+		reason = lnrpc.InvoiceHtlcRejection_HOLD_EXPIRY_TOO_SOON
+
+	default:
+		return nil, fmt.Errorf("unknown rejection outcome: %v",
+			rejection.Outcome)
+	}
+
+	return &lnrpc.InvoiceHtlcRejection{
+		ChanId:           rejection.CircuitKey.ChanID.ToUint64(),
+		HtlcIndex:        rejection.CircuitKey.HtlcID,
+		AmtMsat:          uint64(rejection.Amt),
+		ExpiryHeight:     int32(rejection.Expiry),
+		Reason:           reason,
+		CustomRecordType: rejection.CustomRecordType,
+	}, nil
+}
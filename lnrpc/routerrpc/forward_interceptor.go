@@ -1,12 +1,14 @@
 package routerrpc
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"sync"
 
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
@@ -19,6 +21,25 @@ var (
 	// ErrMissingPreimage is an error returned when the caller tries to settle
 	// a forward and doesn't provide a preimage.
 	ErrMissingPreimage = errors.New("missing preimage")
+
+	// ErrFailureCodeAndMessage is an error returned when the caller tries
+	// to fail a forward with both a failure code and a failure message.
+	ErrFailureCodeAndMessage = errors.New("failure code and failure " +
+		"message are mutually exclusive")
+
+	// interceptFailureCodes maps the failure codes that an interceptor can
+	// fail a forward with to their wire representation.
+	interceptFailureCodes = map[lnrpc.Failure_FailureCode]lnwire.FailCode{
+		lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS: lnwire.CodeIncorrectOrUnknownPaymentDetails,
+		lnrpc.Failure_EXPIRY_TOO_SOON:                      lnwire.CodeExpiryTooSoon,
+		lnrpc.Failure_FEE_INSUFFICIENT:                     lnwire.CodeFeeInsufficient,
+		lnrpc.Failure_INCORRECT_CLTV_EXPIRY:                lnwire.CodeIncorrectCltvExpiry,
+		lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE:            lnwire.CodeTemporaryChannelFailure,
+		lnrpc.Failure_UNKNOWN_NEXT_PEER:                    lnwire.CodeUnknownNextPeer,
+		lnrpc.Failure_TEMPORARY_NODE_FAILURE:               lnwire.CodeTemporaryNodeFailure,
+		lnrpc.Failure_PERMANENT_NODE_FAILURE:               lnwire.CodePermanentNodeFailure,
+		lnrpc.Failure_PERMANENT_CHANNEL_FAILURE:            lnwire.CodePermanentChannelFailure,
+	}
 )
 
 // forwardInterceptor is a helper struct that handles the lifecycle of an rpc
@@ -187,9 +208,28 @@ func (r *forwardInterceptor) resolveFromClient(
 
 	switch in.Action {
 	case ResolveHoldForwardAction_RESUME:
-		return interceptedForward.Resume()
+		var (
+			outgoingChanID *lnwire.ShortChannelID
+			outgoingAmt    *lnwire.MilliSatoshi
+		)
+		if in.OutgoingRequestedChanId != 0 {
+			chanID := lnwire.NewShortChanIDFromInt(
+				in.OutgoingRequestedChanId,
+			)
+			outgoingChanID = &chanID
+		}
+		if in.OutgoingAmountMsat != 0 {
+			amt := lnwire.MilliSatoshi(in.OutgoingAmountMsat)
+			outgoingAmt = &amt
+		}
+
+		return interceptedForward.ResumeModified(
+			outgoingChanID, outgoingAmt,
+		)
+
 	case ResolveHoldForwardAction_FAIL:
-		return interceptedForward.Fail()
+		return failForward(interceptedForward, in)
+
 	case ResolveHoldForwardAction_SETTLE:
 		if in.Preimage == nil {
 			return ErrMissingPreimage
@@ -204,19 +244,49 @@ func (r *forwardInterceptor) resolveFromClient(
 	}
 }
 
-// onDisconnect removes all previousely held forwards from
-// the store. Before they are removed it ensure to resume as the default
-// behavior.
-func (r *forwardInterceptor) onDisconnect() {
-	// Then close the channel so all go routine will exit.
-	close(r.quit)
+// failForward fails the intercepted forward with the failure message or code
+// that is specified in the client response.
+func failForward(forward htlcswitch.InterceptedForward,
+	in *ForwardHtlcInterceptResponse) error {
+
+	switch {
+	case len(in.FailureMessage) > 0 && in.FailureCode != 0:
+		return ErrFailureCodeAndMessage
 
-	log.Infof("RPC interceptor disconnected, resolving held packets")
-	for key, forward := range r.holdForwards {
-		if err := forward.Resume(); err != nil {
-			log.Errorf("failed to resume hold forward %v", err)
+	case len(in.FailureMessage) > 0:
+		failure, err := lnwire.DecodeFailureMessage(
+			bytes.NewReader(in.FailureMessage), 0,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode failure message: %v",
+				err)
 		}
-		delete(r.holdForwards, key)
+
+		return forward.FailWithMessage(failure)
+
+	case in.FailureCode != 0:
+		code, ok := interceptFailureCodes[in.FailureCode]
+		if !ok {
+			return fmt.Errorf("unsupported failure code %v",
+				in.FailureCode)
+		}
+
+		return forward.FailWithCode(code)
+
+	default:
+		return forward.Fail()
 	}
+}
+
+// onDisconnect closes the quit channel so all go routines will exit. The held
+// forwards are left to the switch, which either resumes them or keeps them
+// for the next interceptor.
+func (r *forwardInterceptor) onDisconnect() {
+	close(r.quit)
+
+	log.Infof("RPC interceptor disconnected, releasing %d held packets",
+		len(r.holdForwards))
+
+	r.holdForwards = nil
 	r.wg.Wait()
 }
@@ -51,6 +51,10 @@ const (
 	FailureDetail_INVALID_KEYSEND         FailureDetail = 20
 	FailureDetail_MPP_IN_PROGRESS         FailureDetail = 21
 	FailureDetail_CIRCULAR_ROUTE          FailureDetail = 22
+	FailureDetail_HTLC_ACCEPTOR_CANCELED  FailureDetail = 23
+	FailureDetail_DUST_EXPOSURE           FailureDetail = 24
+	FailureDetail_INVOICE_POLICY_REJECTED FailureDetail = 25
+	FailureDetail_HOLD_INVOICE_EXPIRY     FailureDetail = 26
 )
 
 var FailureDetail_name = map[int32]string{
@@ -77,6 +81,10 @@ var FailureDetail_name = map[int32]string{
 	20: "INVALID_KEYSEND",
 	21: "MPP_IN_PROGRESS",
 	22: "CIRCULAR_ROUTE",
+	23: "HTLC_ACCEPTOR_CANCELED",
+	24: "DUST_EXPOSURE",
+	25: "INVOICE_POLICY_REJECTED",
+	26: "HOLD_INVOICE_EXPIRY",
 }
 
 var FailureDetail_value = map[string]int32{
@@ -103,6 +111,10 @@ var FailureDetail_value = map[string]int32{
 	"INVALID_KEYSEND":         20,
 	"MPP_IN_PROGRESS":         21,
 	"CIRCULAR_ROUTE":          22,
+	"HTLC_ACCEPTOR_CANCELED":  23,
+	"DUST_EXPOSURE":           24,
+	"INVOICE_POLICY_REJECTED": 25,
+	"HOLD_INVOICE_EXPIRY":     26,
 }
 
 func (x FailureDetail) String() string {
@@ -356,7 +368,35 @@ type SendPaymentRequest struct {
 	//splitting is necessary. Setting this value will effectively cause lnd to
 	//split more aggressively, vs only when it thinks it needs to. Note that this
 	//value is in milli-satoshis.
-	MaxShardSizeMsat     uint64   `protobuf:"varint,21,opt,name=max_shard_size_msat,json=maxShardSizeMsat,proto3" json:"max_shard_size_msat,omitempty"`
+	MaxShardSizeMsat uint64 `protobuf:"varint,21,opt,name=max_shard_size_msat,json=maxShardSizeMsat,proto3" json:"max_shard_size_msat,omitempty"`
+	//
+	//The time preference for this payment. Set to -1 to optimize for fees
+	//only, to 1 to optimize for reliability only or a value inbetween for a mix.
+	//The default of 0 uses the trade-off configured through the attempt cost
+	//settings of the node.
+	TimePref float64 `protobuf:"fixed64,23,opt,name=time_pref,json=timePref,proto3" json:"time_pref,omitempty"`
+	//
+	//The optional identity pubkeys of the trampoline nodes to route the payment
+	//through, in order. If set, the payment is only routed to the first
+	//trampoline node, which then finds a route to the next trampoline node or
+	//the final destination on our behalf. The instructions for the trampoline
+	//nodes are carried in a trampoline onion, so only the last one learns the
+	//final destination. The trampoline nodes are assumed to support trampoline
+	//routing, so no graph is needed to send the payment. Custom records, route
+	//hints and a last hop restriction can't be used in combination with
+	//trampoline nodes.
+	TrampolineNodes [][]byte `protobuf:"bytes,24,rep,name=trampoline_nodes,json=trampolineNodes,proto3" json:"trampoline_nodes,omitempty"`
+	//
+	//The fee in milli-satoshis that the trampoline nodes may spend on their own
+	//fees and the fees of the routes to the final destination. The fee is split
+	//evenly between the trampoline nodes. Only used if trampoline_nodes is set.
+	TrampolineFeeMsat int64 `protobuf:"varint,25,opt,name=trampoline_fee_msat,json=trampolineFeeMsat,proto3" json:"trampoline_fee_msat,omitempty"`
+	//
+	//The number of blocks that the trampoline nodes may use for their own cltv
+	//deltas and the routes to the final destination. The blocks are split
+	//evenly between the trampoline nodes. If zero, a default value per
+	//trampoline node is used. Only used if trampoline_nodes is set.
+	TrampolineCltvDelta  int32    `protobuf:"varint,26,opt,name=trampoline_cltv_delta,json=trampolineCltvDelta,proto3" json:"trampoline_cltv_delta,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -535,6 +575,34 @@ func (m *SendPaymentRequest) GetMaxShardSizeMsat() uint64 {
 	return 0
 }
 
+func (m *SendPaymentRequest) GetTimePref() float64 {
+	if m != nil {
+		return m.TimePref
+	}
+	return 0
+}
+
+func (m *SendPaymentRequest) GetTrampolineNodes() [][]byte {
+	if m != nil {
+		return m.TrampolineNodes
+	}
+	return nil
+}
+
+func (m *SendPaymentRequest) GetTrampolineFeeMsat() int64 {
+	if m != nil {
+		return m.TrampolineFeeMsat
+	}
+	return 0
+}
+
+func (m *SendPaymentRequest) GetTrampolineCltvDelta() int32 {
+	if m != nil {
+		return m.TrampolineCltvDelta
+	}
+	return 0
+}
+
 type TrackPaymentRequest struct {
 	// The hash of the payment to look up.
 	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
@@ -1613,6 +1681,11 @@ func (m *BuildRouteResponse) GetRoute() *lnrpc.Route {
 }
 
 type SubscribeHtlcEventsRequest struct {
+	//
+	//If specified (non-zero), then we'll first start by sending out all
+	//persisted htlc events with an index greater than this value. Events that
+	//were pruned from the htlc event log can't be replayed.
+	StartIndex           uint64   `protobuf:"varint,1,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1643,13 +1716,20 @@ func (m *SubscribeHtlcEventsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SubscribeHtlcEventsRequest proto.InternalMessageInfo
 
+func (m *SubscribeHtlcEventsRequest) GetStartIndex() uint64 {
+	if m != nil {
+		return m.StartIndex
+	}
+	return 0
+}
+
 //
 //HtlcEvent contains the htlc event that was processed. These are served on a
-//best-effort basis; events are not persisted, delivery is not guaranteed
-//(in the event of a crash in the switch, forward events may be lost) and
-//some events may be replayed upon restart. Events consumed from this package
-//should be de-duplicated by the htlc's unique combination of incoming and
-//outgoing channel id and htlc id. [EXPERIMENTAL]
+//best-effort basis; delivery is not guaranteed (in the event of a crash in the
+//switch, forward events may be lost) and some events may be replayed upon
+//restart. Events consumed from this package should be de-duplicated by the
+//htlc's unique combination of incoming and outgoing channel id and htlc id.
+//[EXPERIMENTAL]
 type HtlcEvent struct {
 	//
 	//The short channel id that the incoming htlc arrived at our node on. This
@@ -1679,10 +1759,17 @@ type HtlcEvent struct {
 	//	*HtlcEvent_ForwardFailEvent
 	//	*HtlcEvent_SettleEvent
 	//	*HtlcEvent_LinkFailEvent
-	Event                isHtlcEvent_Event `protobuf_oneof:"event"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Event isHtlcEvent_Event `protobuf_oneof:"event"`
+	//
+	//The index of the event in the htlc event log. Each newly persisted event
+	//will increment this index making it monotonically increasing. Callers to
+	//SubscribeHtlcEvents can use this as the start_index to get notified of all
+	//events that occurred after this one. This value is zero if the event
+	//wasn't persisted.
+	Index                uint64   `protobuf:"varint,11,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *HtlcEvent) Reset()         { *m = HtlcEvent{} }
@@ -1815,6 +1902,13 @@ func (m *HtlcEvent) GetLinkFailEvent() *LinkFailEvent {
 	return nil
 }
 
+func (m *HtlcEvent) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*HtlcEvent) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2295,7 +2389,8 @@ func (m *ForwardHtlcInterceptRequest) GetOnionBlob() []byte {
 //*
 //ForwardHtlcInterceptResponse enables the caller to resolve a previously hold
 //forward. The caller can choose either to:
-//- `Resume`: Execute the default behavior (usually forward).
+//- `Resume`: Execute the default behavior (usually forward), optionally with a
+//modified outgoing amount or channel.
 //- `Reject`: Fail the htlc backwards.
 //- `Settle`: Settle this htlc with a given preimage.
 type ForwardHtlcInterceptResponse struct {
@@ -2306,10 +2401,29 @@ type ForwardHtlcInterceptResponse struct {
 	// The resolve action for this intercepted htlc.
 	Action ResolveHoldForwardAction `protobuf:"varint,2,opt,name=action,proto3,enum=routerrpc.ResolveHoldForwardAction" json:"action,omitempty"`
 	// The preimage in case the resolve action is Settle.
-	Preimage             []byte   `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Preimage []byte `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	//
+	//The serialized BOLT-04 failure message in case the resolve action is Fail.
+	//The message is encrypted for the sender by lnd. If failure_message is
+	//specified, the failure_code field must be set to zero.
+	FailureMessage []byte `protobuf:"bytes,4,opt,name=failure_message,json=failureMessage,proto3" json:"failure_message,omitempty"`
+	//
+	//The failure code in case the resolve action is Fail. lnd constructs the
+	//failure message for the code. If neither failure_message nor failure_code
+	//is set, a temporary channel failure is returned.
+	FailureCode lnrpc.Failure_FailureCode `protobuf:"varint,5,opt,name=failure_code,json=failureCode,proto3,enum=lnrpc.Failure_FailureCode" json:"failure_code,omitempty"`
+	//
+	//The outgoing amount in case the resolve action is Resume. If zero, the
+	//amount requested by the sender is forwarded. The amount may not exceed the
+	//incoming amount.
+	OutgoingAmountMsat uint64 `protobuf:"varint,6,opt,name=outgoing_amount_msat,json=outgoingAmountMsat,proto3" json:"outgoing_amount_msat,omitempty"`
+	//
+	//The outgoing channel in case the resolve action is Resume. If zero, the
+	//channel requested by the sender is used.
+	OutgoingRequestedChanId uint64   `protobuf:"varint,7,opt,name=outgoing_requested_chan_id,json=outgoingRequestedChanId,proto3" json:"outgoing_requested_chan_id,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
 }
 
 func (m *ForwardHtlcInterceptResponse) Reset()         { *m = ForwardHtlcInterceptResponse{} }
@@ -2358,6 +2472,34 @@ func (m *ForwardHtlcInterceptResponse) GetPreimage() []byte {
 	return nil
 }
 
+func (m *ForwardHtlcInterceptResponse) GetFailureMessage() []byte {
+	if m != nil {
+		return m.FailureMessage
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptResponse) GetFailureCode() lnrpc.Failure_FailureCode {
+	if m != nil {
+		return m.FailureCode
+	}
+	return lnrpc.Failure_RESERVED
+}
+
+func (m *ForwardHtlcInterceptResponse) GetOutgoingAmountMsat() uint64 {
+	if m != nil {
+		return m.OutgoingAmountMsat
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptResponse) GetOutgoingRequestedChanId() uint64 {
+	if m != nil {
+		return m.OutgoingRequestedChanId
+	}
+	return 0
+}
+
 type UpdateChanStatusRequest struct {
 	ChanPoint            *lnrpc.ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
 	Action               ChanStatusAction    `protobuf:"varint,2,opt,name=action,proto3,enum=routerrpc.ChanStatusAction" json:"action,omitempty"`
@@ -2485,195 +2627,207 @@ func init() {
 func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_7a0613f69d37b0a5) }
 
 var fileDescriptor_7a0613f69d37b0a5 = []byte{
-	// 2997 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4b, 0x77, 0xdb, 0xc8,
-	0xb1, 0x1e, 0x90, 0x14, 0x45, 0x16, 0x1f, 0x82, 0x5a, 0xb2, 0xc5, 0x4b, 0xf9, 0xa1, 0xc1, 0x3c,
-	0xcc, 0xeb, 0x3b, 0x23, 0x7b, 0x34, 0xf7, 0xce, 0xcc, 0xbd, 0xf3, 0xb8, 0x43, 0x91, 0x90, 0x05,
-	0x9b, 0x22, 0x35, 0x4d, 0xca, 0x63, 0x8f, 0x17, 0x08, 0x44, 0x36, 0x45, 0x8c, 0xf0, 0x60, 0x80,
-	0xa6, 0x6d, 0xcd, 0x2a, 0xc9, 0x2a, 0x27, 0x3f, 0x26, 0xbf, 0x20, 0xe7, 0x24, 0x9b, 0x6c, 0xf2,
-	0x27, 0xb2, 0xcd, 0x36, 0x9b, 0xac, 0x73, 0xfa, 0x01, 0x10, 0xa0, 0x28, 0xd9, 0x79, 0x6c, 0x24,
-	0xe0, 0xab, 0xaf, 0xab, 0xab, 0xbb, 0xab, 0xaa, 0x0b, 0x45, 0xb8, 0x19, 0xf8, 0x33, 0x4a, 0x82,
-	0x60, 0x3a, 0x7c, 0x20, 0x9e, 0x76, 0xa7, 0x81, 0x4f, 0x7d, 0x54, 0x8c, 0xf1, 0x7a, 0x31, 0x98,
-	0x0e, 0x05, 0xaa, 0xfd, 0x71, 0x15, 0x50, 0x9f, 0x78, 0xa3, 0x63, 0xeb, 0xc2, 0x25, 0x1e, 0xc5,
-	0xe4, 0xe7, 0x33, 0x12, 0x52, 0x84, 0x20, 0x37, 0x22, 0x21, 0xad, 0x29, 0x3b, 0x4a, 0xa3, 0x8c,
-	0xf9, 0x33, 0x52, 0x21, 0x6b, 0xb9, 0xb4, 0x96, 0xd9, 0x51, 0x1a, 0x59, 0xcc, 0x1e, 0xd1, 0x7f,
-	0x40, 0xc1, 0x72, 0xa9, 0xe9, 0x86, 0x16, 0xad, 0x95, 0x39, 0xbc, 0x6a, 0xb9, 0xf4, 0x28, 0xb4,
-	0x28, 0x7a, 0x17, 0xca, 0x53, 0xa1, 0xd2, 0x9c, 0x58, 0xe1, 0xa4, 0x96, 0xe5, 0x8a, 0x4a, 0x12,
-	0x3b, 0xb4, 0xc2, 0x09, 0x6a, 0x80, 0x3a, 0xb6, 0x3d, 0xcb, 0x31, 0x87, 0x0e, 0x7d, 0x69, 0x8e,
-	0x88, 0x43, 0xad, 0x5a, 0x6e, 0x47, 0x69, 0xac, 0xe0, 0x2a, 0xc7, 0x5b, 0x0e, 0x7d, 0xd9, 0x66,
-	0x68, 0x52, 0x99, 0x35, 0x1a, 0x05, 0xb5, 0xcd, 0x94, 0xb2, 0xe6, 0x68, 0x14, 0xa0, 0x7b, 0xb0,
-	0x16, 0x51, 0x02, 0xb1, 0x86, 0xda, 0xca, 0x8e, 0xd2, 0x28, 0xe2, 0xea, 0x34, 0xbd, 0xb2, 0x7b,
-	0xb0, 0x46, 0x6d, 0x97, 0xf8, 0x33, 0x6a, 0x86, 0x64, 0xe8, 0x7b, 0xa3, 0xb0, 0x96, 0x17, 0x93,
-	0x4a, 0xb8, 0x2f, 0x50, 0xa4, 0x41, 0x65, 0x4c, 0x88, 0xe9, 0xd8, 0xae, 0x4d, 0x4d, 0xb6, 0xc2,
-	0x55, 0xbe, 0xc2, 0xd2, 0x98, 0x90, 0x0e, 0xc3, 0xfa, 0x16, 0x45, 0xef, 0x43, 0x75, 0xce, 0xe1,
-	0xdb, 0x50, 0xe1, 0xa4, 0x72, 0x44, 0xe2, 0x7b, 0xb1, 0x0b, 0xaa, 0x3f, 0xa3, 0x67, 0xbe, 0xed,
-	0x9d, 0x99, 0xc3, 0x89, 0xe5, 0x99, 0xf6, 0xa8, 0x56, 0xd8, 0x51, 0x1a, 0xb9, 0xfd, 0x5c, 0x4d,
-	0x79, 0xa8, 0xe0, 0x6a, 0x24, 0x6d, 0x4d, 0x2c, 0xcf, 0x18, 0xa1, 0xfb, 0xb0, 0xbe, 0xc8, 0x0f,
-	0x6b, 0x1b, 0x3b, 0xd9, 0x46, 0x0e, 0xaf, 0xa5, 0xa9, 0x21, 0xfa, 0x10, 0xd6, 0x1c, 0x2b, 0xa4,
-	0xe6, 0xc4, 0x9f, 0x9a, 0xd3, 0xd9, 0xe9, 0x39, 0xb9, 0xa8, 0x55, 0xf9, 0xee, 0x54, 0x18, 0x7c,
-	0xe8, 0x4f, 0x8f, 0x39, 0x88, 0x6e, 0x03, 0xf0, 0x6d, 0xe6, 0xa6, 0xd6, 0x8a, 0x7c, 0xc5, 0x45,
-	0x86, 0x70, 0x33, 0xd1, 0x27, 0x50, 0xe2, 0xee, 0x61, 0x4e, 0x6c, 0x8f, 0x86, 0x35, 0xd8, 0xc9,
-	0x36, 0x4a, 0x7b, 0xea, 0xae, 0xe3, 0x31, 0x4f, 0xc1, 0x4c, 0x72, 0x68, 0x7b, 0x14, 0x43, 0x10,
-	0x3d, 0x86, 0x68, 0x04, 0x1b, 0xcc, 0x2d, 0xcc, 0xe1, 0x2c, 0xa4, 0xbe, 0x6b, 0x06, 0x64, 0xe8,
-	0x07, 0xa3, 0xb0, 0x56, 0xe2, 0x43, 0xff, 0x7b, 0x37, 0xf6, 0xb6, 0xdd, 0xcb, 0xee, 0xb5, 0xdb,
-	0x26, 0x21, 0x6d, 0xf1, 0x71, 0x58, 0x0c, 0xd3, 0x3d, 0x1a, 0x5c, 0xe0, 0xf5, 0xd1, 0x22, 0x8e,
-	0x3e, 0x02, 0x64, 0x39, 0x8e, 0xff, 0xca, 0x0c, 0x89, 0x33, 0x36, 0xe5, 0x59, 0xd6, 0xd6, 0x76,
-	0x94, 0x46, 0x01, 0xab, 0x5c, 0xd2, 0x27, 0xce, 0x58, 0xaa, 0x47, 0x9f, 0x41, 0x85, 0xdb, 0x34,
-	0x26, 0x16, 0x9d, 0x05, 0x24, 0xac, 0xa9, 0x3b, 0xd9, 0x46, 0x75, 0x6f, 0x5d, 0x2e, 0xe4, 0x40,
-	0xc0, 0xfb, 0x36, 0xc5, 0x65, 0xc6, 0x93, 0xef, 0x21, 0xda, 0x86, 0xa2, 0x6b, 0xbd, 0x36, 0xa7,
-	0x56, 0x40, 0xc3, 0xda, 0xfa, 0x8e, 0xd2, 0xa8, 0xe0, 0x82, 0x6b, 0xbd, 0x3e, 0x66, 0xef, 0x68,
-	0x17, 0x36, 0x3c, 0xdf, 0xb4, 0xbd, 0xb1, 0x63, 0x9f, 0x4d, 0xa8, 0x39, 0x9b, 0x8e, 0x2c, 0x4a,
-	0xc2, 0x1a, 0xe2, 0x36, 0xac, 0x7b, 0xbe, 0x21, 0x25, 0x27, 0x42, 0x80, 0x3e, 0x86, 0x0d, 0xa6,
-	0x2c, 0x9c, 0x58, 0xc1, 0xc8, 0x0c, 0xed, 0x9f, 0x88, 0xf0, 0x8c, 0x1b, 0xec, 0xc4, 0xb1, 0xea,
-	0x5a, 0xaf, 0xfb, 0x4c, 0xd2, 0xb7, 0x7f, 0x22, 0xcc, 0x3b, 0xea, 0x6d, 0xb8, 0xb9, 0x7c, 0x3b,
-	0x58, 0xc0, 0xb1, 0xf3, 0x54, 0xf8, 0x40, 0xf6, 0x88, 0x36, 0x61, 0xe5, 0xa5, 0xe5, 0xcc, 0x08,
-	0x0f, 0xc2, 0x32, 0x16, 0x2f, 0xff, 0x97, 0xf9, 0x42, 0xd1, 0x26, 0xb0, 0x31, 0x08, 0xac, 0xe1,
-	0xf9, 0x42, 0x1c, 0x2f, 0x86, 0xa1, 0x72, 0x39, 0x0c, 0xaf, 0x58, 0x5e, 0xe6, 0x8a, 0xe5, 0x69,
-	0xdf, 0xc0, 0x1a, 0x77, 0x88, 0x03, 0x42, 0xae, 0xcb, 0x16, 0x5b, 0xc0, 0x72, 0x01, 0x0f, 0x1c,
-	0x91, 0x31, 0xf2, 0x96, 0xcb, 0x62, 0x46, 0x1b, 0x81, 0x3a, 0x1f, 0x1f, 0x4e, 0x7d, 0x2f, 0x24,
-	0x2c, 0x15, 0x30, 0x7f, 0x61, 0x0e, 0xcf, 0xe2, 0x89, 0xef, 0x97, 0xc2, 0x47, 0x55, 0x25, 0x7e,
-	0x40, 0xf8, 0x6e, 0x31, 0x7f, 0x67, 0x71, 0x6a, 0x3a, 0xfe, 0xf0, 0x9c, 0xe5, 0x0c, 0xeb, 0x42,
-	0xaa, 0xaf, 0x30, 0xb8, 0xe3, 0x0f, 0xcf, 0xdb, 0x0c, 0xd4, 0x5e, 0x88, 0xb4, 0x36, 0xf0, 0xf9,
-	0x5c, 0xff, 0xc0, 0x76, 0x68, 0xb0, 0xc2, 0x5d, 0x97, 0xab, 0x2d, 0xed, 0x95, 0x93, 0x31, 0x80,
-	0x85, 0x48, 0x7b, 0x01, 0x1b, 0x29, 0xe5, 0x72, 0x15, 0x75, 0x28, 0x4c, 0x03, 0x62, 0xbb, 0xd6,
-	0x19, 0x91, 0x9a, 0xe3, 0x77, 0xd4, 0x80, 0xd5, 0xb1, 0x65, 0x3b, 0xb3, 0x20, 0x52, 0x5c, 0x8d,
-	0x7c, 0x52, 0xa0, 0x38, 0x12, 0x6b, 0xb7, 0xa0, 0x8e, 0x49, 0x48, 0xe8, 0x91, 0x1d, 0x86, 0xb6,
-	0xef, 0xb5, 0x7c, 0x8f, 0x06, 0xbe, 0x23, 0x57, 0xa0, 0xdd, 0x86, 0xed, 0xa5, 0x52, 0x61, 0x02,
-	0x1b, 0xfc, 0xdd, 0x8c, 0x04, 0x17, 0xcb, 0x07, 0x7f, 0x07, 0xdb, 0x4b, 0xa5, 0xd2, 0xfe, 0x8f,
-	0x60, 0x65, 0x6a, 0xd9, 0x01, 0x3b, 0x7b, 0x16, 0xc3, 0x37, 0x13, 0x31, 0x7c, 0x6c, 0xd9, 0xc1,
-	0xa1, 0x1d, 0x52, 0x3f, 0xb8, 0xc0, 0x82, 0xf4, 0x38, 0x57, 0x50, 0xd4, 0x8c, 0xd6, 0x81, 0x5b,
-	0xcf, 0x0c, 0x77, 0xea, 0x07, 0xcb, 0xed, 0x9d, 0xeb, 0x54, 0xde, 0x42, 0xa7, 0x76, 0x17, 0x6e,
-	0x5f, 0xa1, 0x4d, 0xae, 0xef, 0x37, 0x0a, 0x94, 0x12, 0xe3, 0x58, 0xe0, 0x7a, 0xfe, 0x88, 0x98,
-	0xe3, 0xc0, 0x77, 0xa3, 0x3d, 0x67, 0xc0, 0x41, 0xe0, 0xbb, 0xcc, 0x05, 0xb9, 0x90, 0xfa, 0x32,
-	0x5e, 0xf2, 0xec, 0x75, 0xe0, 0xa3, 0x8f, 0x61, 0x75, 0x22, 0x14, 0xf0, 0xa4, 0x5e, 0xda, 0xdb,
-	0x58, 0x30, 0xab, 0x6d, 0x51, 0x0b, 0x47, 0x9c, 0xc7, 0xb9, 0x42, 0x56, 0xcd, 0x3d, 0xce, 0x15,
-	0x72, 0xea, 0xca, 0xe3, 0x5c, 0x61, 0x45, 0xcd, 0x3f, 0xce, 0x15, 0xf2, 0xea, 0xaa, 0xf6, 0x17,
-	0x05, 0x0a, 0x11, 0x9b, 0x59, 0xc2, 0x4e, 0xd0, 0x64, 0x6e, 0x28, 0x7d, 0xb7, 0xc0, 0x80, 0x81,
-	0xed, 0x12, 0xb4, 0x03, 0x65, 0x2e, 0x4c, 0x47, 0x04, 0x30, 0xac, 0xc9, 0xa3, 0x82, 0xdf, 0x36,
-	0x11, 0x83, 0xbb, 0x7f, 0x4e, 0xde, 0x36, 0x82, 0x12, 0xdd, 0xa9, 0xe1, 0x6c, 0x38, 0x24, 0x61,
-	0x28, 0x66, 0x59, 0x11, 0x14, 0x89, 0xf1, 0x89, 0x3e, 0x84, 0xb5, 0x88, 0x12, 0xcd, 0x95, 0x17,
-	0xe1, 0x21, 0x61, 0x39, 0x5d, 0x03, 0xd4, 0x24, 0xcf, 0x9d, 0xdf, 0x6f, 0xd5, 0x39, 0x91, 0x4d,
-	0x2a, 0x16, 0xaf, 0xed, 0xc0, 0x9d, 0x47, 0x8b, 0x4e, 0xd7, 0xf2, 0xbd, 0xb1, 0x7d, 0x16, 0xf9,
-	0xd6, 0x0f, 0x70, 0xf7, 0x4a, 0x86, 0xf4, 0xaf, 0xcf, 0x21, 0x3f, 0xe4, 0x08, 0xdf, 0x9f, 0xd2,
-	0xde, 0xdd, 0xc4, 0xae, 0x2f, 0x1d, 0x28, 0xe9, 0xda, 0x73, 0xb8, 0xd3, 0xbf, 0x76, 0xf6, 0x7f,
-	0x5e, 0xf5, 0xbb, 0x70, 0xb7, 0x7f, 0xbd, 0xd9, 0xda, 0x2f, 0x32, 0xb0, 0xb9, 0x8c, 0xc0, 0xee,
-	0xe9, 0x89, 0xe5, 0x8c, 0x4d, 0xc7, 0x1e, 0x93, 0xb8, 0x98, 0x10, 0xd9, 0x7a, 0x8d, 0x09, 0x3a,
-	0xf6, 0x98, 0x44, 0xd5, 0xc4, 0x3d, 0x58, 0xe3, 0x57, 0x74, 0xe0, 0x9f, 0x5a, 0xa7, 0xb6, 0x63,
-	0x53, 0x91, 0xb7, 0x32, 0xb8, 0x3a, 0xf1, 0xa7, 0xc7, 0x73, 0x14, 0xdd, 0x84, 0xfc, 0x2b, 0xc2,
-	0xf2, 0x2d, 0x2f, 0x99, 0x32, 0x58, 0xbe, 0xa1, 0xcf, 0x60, 0xcb, 0xb5, 0x5e, 0xdb, 0xee, 0xcc,
-	0x35, 0xe7, 0x85, 0x4e, 0x38, 0x73, 0x68, 0xc8, 0x5d, 0xa5, 0x82, 0x6f, 0x48, 0x71, 0x7c, 0x03,
-	0x70, 0x21, 0x6a, 0xc1, 0x1d, 0xd7, 0xf6, 0xf8, 0x38, 0x99, 0x61, 0xcc, 0x80, 0x38, 0xd6, 0x6b,
-	0xd3, 0xf6, 0x28, 0x09, 0x5e, 0x5a, 0x0e, 0x77, 0xa3, 0x1c, 0xde, 0x96, 0xac, 0x28, 0x1f, 0x31,
-	0x8e, 0x21, 0x29, 0xda, 0x8f, 0xb0, 0xc5, 0x13, 0x47, 0xc2, 0xd0, 0x68, 0xe7, 0x99, 0xdf, 0x07,
-	0xbe, 0x6b, 0xb2, 0xd0, 0x8a, 0x22, 0x90, 0x01, 0x5d, 0x7f, 0x44, 0x58, 0x04, 0x52, 0x5f, 0x88,
-	0x64, 0x04, 0x52, 0x9f, 0x0b, 0x92, 0x95, 0x63, 0x36, 0x55, 0x39, 0x6a, 0xe7, 0x50, 0xbb, 0x3c,
-	0x97, 0xf4, 0xa0, 0x1d, 0x28, 0x25, 0x77, 0x90, 0x4d, 0xa7, 0xe0, 0x24, 0x94, 0x0c, 0xed, 0xcc,
-	0x9b, 0x43, 0x5b, 0xfb, 0x93, 0x02, 0xeb, 0xfb, 0x33, 0xdb, 0x19, 0xa5, 0xae, 0x89, 0xa4, 0x75,
-	0x4a, 0xba, 0xae, 0x5d, 0x56, 0xb4, 0x66, 0x96, 0x16, 0xad, 0x1f, 0x2d, 0xa9, 0xfa, 0xb2, 0xbc,
-	0xea, 0xcb, 0x2c, 0xa9, 0xf9, 0xee, 0x42, 0x69, 0x5e, 0xc2, 0xb1, 0x23, 0xcd, 0x36, 0xca, 0x18,
-	0x26, 0x51, 0xfd, 0x16, 0x5e, 0xaa, 0x81, 0x57, 0x2e, 0xd5, 0xc0, 0xda, 0x17, 0x80, 0x92, 0x6b,
-	0x91, 0x7b, 0x16, 0x5f, 0x68, 0xca, 0xd5, 0x17, 0xda, 0x2d, 0xa8, 0xf7, 0x67, 0xa7, 0xe1, 0x30,
-	0xb0, 0x4f, 0xc9, 0x21, 0x75, 0x86, 0xfa, 0x4b, 0xe2, 0xd1, 0x30, 0x0a, 0xed, 0xbf, 0xe5, 0xa0,
-	0x18, 0xa3, 0xac, 0x5e, 0xb0, 0xbd, 0xa1, 0xef, 0x46, 0xeb, 0xf2, 0x88, 0xc3, 0x96, 0x26, 0xfc,
-	0x7e, 0x3d, 0x12, 0xb5, 0x84, 0xc4, 0x18, 0x31, 0x7e, 0x6a, 0x1f, 0x24, 0x3f, 0x23, 0xf8, 0xc9,
-	0x6d, 0x10, 0xfc, 0x06, 0xa8, 0xb1, 0xfe, 0x09, 0x75, 0x86, 0xf1, 0xbe, 0xe1, 0x6a, 0x84, 0x33,
-	0x63, 0x04, 0x33, 0xd6, 0x1c, 0x31, 0x73, 0x82, 0x19, 0xe1, 0x92, 0xf9, 0x2e, 0x94, 0x59, 0xc6,
-	0x0c, 0xa9, 0xe5, 0x4e, 0x4d, 0x2f, 0x94, 0x2e, 0x5f, 0x8a, 0xb1, 0x6e, 0x88, 0xbe, 0x06, 0x20,
-	0x6c, 0x7d, 0x26, 0xbd, 0x98, 0x12, 0x9e, 0x34, 0xab, 0x7b, 0x77, 0x12, 0xbe, 0x13, 0x6f, 0xc0,
-	0x2e, 0xff, 0x3b, 0xb8, 0x98, 0x12, 0x5c, 0x24, 0xd1, 0x23, 0xfa, 0x06, 0x2a, 0x63, 0x3f, 0x78,
-	0xc5, 0x4a, 0x3e, 0x0e, 0xca, 0x8b, 0x65, 0x2b, 0xa1, 0xe1, 0x40, 0xc8, 0xf9, 0xf0, 0xc3, 0x77,
-	0x70, 0x79, 0x9c, 0x78, 0x47, 0x4f, 0x00, 0x45, 0xe3, 0xf9, 0x3d, 0x20, 0x94, 0x14, 0xb8, 0x92,
-	0xed, 0xcb, 0x4a, 0x58, 0x94, 0x46, 0x8a, 0xd4, 0xf1, 0x02, 0x86, 0xbe, 0x84, 0x72, 0x48, 0x28,
-	0x75, 0x88, 0x54, 0x53, 0xe4, 0x6a, 0x6e, 0xa6, 0x6a, 0x72, 0x26, 0x8e, 0x34, 0x94, 0xc2, 0xf9,
-	0x2b, 0xda, 0x87, 0x35, 0xc7, 0xf6, 0xce, 0x93, 0x66, 0x00, 0x1f, 0x5f, 0x4b, 0x8c, 0xef, 0xd8,
-	0xde, 0x79, 0xd2, 0x86, 0x8a, 0x93, 0x04, 0xb4, 0xaf, 0xa0, 0x18, 0xef, 0x12, 0x2a, 0xc1, 0xea,
-	0x49, 0xf7, 0x49, 0xb7, 0xf7, 0x7d, 0x57, 0x7d, 0x07, 0x15, 0x20, 0xd7, 0xd7, 0xbb, 0x6d, 0x55,
-	0x61, 0x30, 0xd6, 0x5b, 0xba, 0xf1, 0x54, 0x57, 0x33, 0xec, 0xe5, 0xa0, 0x87, 0xbf, 0x6f, 0xe2,
-	0xb6, 0x9a, 0xdd, 0x5f, 0x85, 0x15, 0x3e, 0xaf, 0xf6, 0x3b, 0x05, 0x0a, 0xfc, 0x04, 0xbd, 0xb1,
-	0x8f, 0xfe, 0x0b, 0x62, 0xe7, 0xe2, 0xd7, 0x1f, 0xab, 0x00, 0xb9, 0xd7, 0x55, 0x70, 0xec, 0x30,
-	0x03, 0x89, 0x33, 0x72, 0xec, 0x1a, 0x31, 0x39, 0x23, 0xc8, 0x91, 0x20, 0x26, 0xdf, 0x4f, 0x68,
-	0x4e, 0x65, 0xa5, 0x1c, 0x5e, 0x8b, 0x04, 0xd1, 0x1d, 0x9c, 0xfc, 0x36, 0x4b, 0xdd, 0xd5, 0x89,
-	0x6f, 0x33, 0xc9, 0xd5, 0x3e, 0x87, 0x72, 0xf2, 0xcc, 0xd1, 0x3d, 0xc8, 0xd9, 0xde, 0xd8, 0x97,
-	0x81, 0xb8, 0xb1, 0xe0, 0x5c, 0x6c, 0x91, 0x98, 0x13, 0x34, 0x04, 0xea, 0xe2, 0x39, 0x6b, 0x15,
-	0x28, 0x25, 0x0e, 0x4d, 0xfb, 0xb3, 0x02, 0x95, 0xd4, 0x21, 0xbc, 0xb5, 0x76, 0xf4, 0x35, 0x94,
-	0x5f, 0xd9, 0x01, 0x31, 0x93, 0xf5, 0x68, 0x75, 0xaf, 0x9e, 0xae, 0x47, 0xa3, 0xff, 0x2d, 0x7f,
-	0x44, 0x70, 0x89, 0xf1, 0x25, 0x80, 0xfe, 0x1f, 0xaa, 0xd1, 0x45, 0x32, 0x22, 0xd4, 0xb2, 0x1d,
-	0xbe, 0x55, 0xd5, 0x94, 0x7b, 0x48, 0x6e, 0x9b, 0xcb, 0x71, 0x65, 0x9c, 0x7c, 0x45, 0x1f, 0xcc,
-	0x15, 0x84, 0x34, 0xb0, 0xbd, 0x33, 0xbe, 0x7f, 0xc5, 0x98, 0xd6, 0xe7, 0x20, 0x2b, 0xf5, 0x2a,
-	0xf2, 0x2e, 0xeb, 0x53, 0x8b, 0xce, 0xd8, 0x87, 0xd5, 0x4a, 0x48, 0x2d, 0x99, 0xc9, 0xaa, 0xa9,
-	0xd8, 0x4a, 0x10, 0x09, 0x16, 0xac, 0x54, 0x39, 0x9e, 0xb9, 0x54, 0x8e, 0xaf, 0xb0, 0x8c, 0x21,
-	0x12, 0x6d, 0x69, 0x0f, 0xc9, 0xc5, 0x1f, 0x0e, 0x3a, 0xad, 0x26, 0xa5, 0xc4, 0x9d, 0x52, 0x2c,
-	0x08, 0xb2, 0xfe, 0xf9, 0x06, 0xa0, 0x65, 0x07, 0xc3, 0x99, 0x4d, 0x9f, 0x90, 0x0b, 0x76, 0xad,
-	0x45, 0x19, 0x5d, 0xa4, 0xbd, 0xfc, 0x50, 0x64, 0xf1, 0x2d, 0x58, 0x8d, 0x12, 0x91, 0xc8, 0x6f,
-	0xf9, 0x09, 0x4f, 0x40, 0xda, 0xef, 0x73, 0xb0, 0x2d, 0x8f, 0x54, 0x9c, 0x06, 0x25, 0xc1, 0x90,
-	0x4c, 0xe3, 0xef, 0xb4, 0x47, 0xb0, 0x39, 0x4f, 0xaa, 0x62, 0x22, 0x33, 0xfa, 0xf6, 0x2b, 0xed,
-	0xdd, 0x48, 0xac, 0x74, 0x6e, 0x06, 0x46, 0x71, 0xb2, 0x9d, 0x9b, 0xf6, 0x30, 0xa1, 0xc8, 0x72,
-	0xfd, 0x99, 0x27, 0x5d, 0x54, 0x64, 0x3c, 0x34, 0x77, 0x67, 0x26, 0xe2, 0x1e, 0x7d, 0x0f, 0x62,
-	0x27, 0x37, 0xc9, 0xeb, 0xa9, 0x1d, 0x5c, 0xf0, 0xec, 0x57, 0x99, 0xa7, 0x5b, 0x9d, 0xa3, 0x97,
-	0x3e, 0x9e, 0x32, 0x97, 0x3f, 0x9e, 0xbe, 0x84, 0x7a, 0x1c, 0x1d, 0xb2, 0x0d, 0x43, 0x46, 0xf1,
-	0xed, 0xb7, 0xca, 0x6d, 0xd8, 0x8a, 0x18, 0x38, 0x22, 0xc8, 0x2b, 0xf0, 0x21, 0x6c, 0x26, 0x42,
-	0x6b, 0x6e, 0xba, 0x88, 0x44, 0x34, 0x8f, 0xae, 0xa4, 0xe9, 0xf1, 0x08, 0x69, 0xba, 0xa8, 0x85,
-	0xe2, 0xfc, 0x2f, 0x4d, 0xff, 0x19, 0x54, 0x17, 0xda, 0x14, 0x05, 0x7e, 0xee, 0xff, 0x7b, 0x39,
-	0xb3, 0x2e, 0x3b, 0x9e, 0xdd, 0x25, 0xbd, 0x8a, 0xca, 0x30, 0xd5, 0xa7, 0xb8, 0x0d, 0xe0, 0x7b,
-	0xb6, 0xef, 0x99, 0xa7, 0x8e, 0x7f, 0xca, 0x13, 0x6e, 0x19, 0x17, 0x39, 0xb2, 0xef, 0xf8, 0xa7,
-	0xf5, 0x6f, 0x01, 0xfd, 0x8b, 0x1f, 0xf8, 0x7f, 0x50, 0xe0, 0xd6, 0x72, 0x13, 0xe5, 0x3d, 0xff,
-	0x6f, 0x73, 0xa1, 0x2f, 0x21, 0x6f, 0x0d, 0xa9, 0xed, 0x7b, 0x32, 0x33, 0xbc, 0x97, 0x18, 0x8a,
-	0x49, 0xe8, 0x3b, 0x2f, 0xc9, 0xa1, 0xef, 0x8c, 0xa4, 0x31, 0x4d, 0x4e, 0xc5, 0x72, 0x48, 0x2a,
-	0xe8, 0xb2, 0xe9, 0xa0, 0xd3, 0x7e, 0xa5, 0xc0, 0x96, 0xe8, 0x22, 0xb0, 0x13, 0x17, 0x41, 0x1d,
-	0x05, 0xc0, 0x1e, 0x00, 0x77, 0x93, 0xa9, 0x6f, 0x7b, 0x34, 0xce, 0x61, 0x22, 0x2a, 0x65, 0x6d,
-	0x70, 0xcc, 0x44, 0xb8, 0xc8, 0x68, 0xfc, 0x11, 0x7d, 0xba, 0x60, 0x68, 0xf2, 0x9e, 0x9c, 0xcf,
-	0x90, 0x36, 0x50, 0xab, 0x43, 0xed, 0xb2, 0x0d, 0x62, 0x0b, 0xef, 0xff, 0x32, 0x07, 0x95, 0x54,
-	0xea, 0x4a, 0xdf, 0x5d, 0x15, 0x28, 0x76, 0x7b, 0x66, 0x5b, 0x1f, 0x34, 0x8d, 0x8e, 0xaa, 0x20,
-	0x15, 0xca, 0xbd, 0xae, 0xd1, 0xeb, 0x9a, 0x6d, 0xbd, 0xd5, 0x6b, 0xb3, 0x5b, 0xec, 0x06, 0xac,
-	0x77, 0x8c, 0xee, 0x13, 0xb3, 0xdb, 0x1b, 0x98, 0x7a, 0xc7, 0x78, 0x64, 0xec, 0x77, 0x74, 0x35,
-	0x8b, 0x36, 0x41, 0xed, 0x75, 0xcd, 0xd6, 0x61, 0xd3, 0xe8, 0x9a, 0x03, 0xe3, 0x48, 0xef, 0x9d,
-	0x0c, 0xd4, 0x1c, 0x43, 0x59, 0xba, 0x31, 0xf5, 0x67, 0x2d, 0x5d, 0x6f, 0xf7, 0xcd, 0xa3, 0xe6,
-	0x33, 0x75, 0x05, 0xd5, 0x60, 0xd3, 0xe8, 0xf6, 0x4f, 0x0e, 0x0e, 0x8c, 0x96, 0xa1, 0x77, 0x07,
-	0xe6, 0x7e, 0xb3, 0xd3, 0xec, 0xb6, 0x74, 0x35, 0x8f, 0x6e, 0x02, 0x32, 0xba, 0xad, 0xde, 0xd1,
-	0x71, 0x47, 0x1f, 0xe8, 0x66, 0x74, 0x5b, 0xae, 0xa2, 0x0d, 0x58, 0xe3, 0x7a, 0x9a, 0xed, 0xb6,
-	0x79, 0xd0, 0x34, 0x3a, 0x7a, 0x5b, 0x2d, 0x30, 0x4b, 0x24, 0xa3, 0x6f, 0xb6, 0x8d, 0x7e, 0x73,
-	0x9f, 0xc1, 0x45, 0x36, 0xa7, 0xd1, 0x7d, 0xda, 0x33, 0x5a, 0xba, 0xd9, 0x62, 0x6a, 0x19, 0x0a,
-	0x8c, 0x1c, 0xa1, 0x27, 0xdd, 0xb6, 0x8e, 0x8f, 0x9b, 0x46, 0x5b, 0x2d, 0xa1, 0x6d, 0xd8, 0x8a,
-	0x60, 0xfd, 0xd9, 0xb1, 0x81, 0x9f, 0x9b, 0x83, 0x5e, 0xcf, 0xec, 0xf7, 0x7a, 0x5d, 0xb5, 0x9c,
-	0xd4, 0xc4, 0x56, 0xdb, 0x3b, 0xd6, 0xbb, 0x6a, 0x05, 0x6d, 0xc1, 0xc6, 0xd1, 0xf1, 0xb1, 0x19,
-	0x49, 0xa2, 0xc5, 0x56, 0x19, 0xbd, 0xd9, 0x6e, 0x63, 0xbd, 0xdf, 0x37, 0x8f, 0x8c, 0xfe, 0x51,
-	0x73, 0xd0, 0x3a, 0x54, 0xd7, 0xd8, 0x92, 0xfa, 0xfa, 0xc0, 0x1c, 0xf4, 0x06, 0xcd, 0xce, 0x1c,
-	0x57, 0x99, 0x41, 0x73, 0x9c, 0x4d, 0xda, 0xe9, 0x7d, 0xaf, 0xae, 0xb3, 0x0d, 0x67, 0x70, 0xef,
-	0xa9, 0x34, 0x11, 0xb1, 0xb5, 0xcb, 0xe3, 0x89, 0xe6, 0x54, 0x37, 0x18, 0x68, 0x74, 0x9f, 0x36,
-	0x3b, 0x46, 0xdb, 0x7c, 0xa2, 0x3f, 0xe7, 0xd5, 0xc6, 0x26, 0x03, 0x85, 0x65, 0xe6, 0x31, 0xee,
-	0x3d, 0x62, 0x86, 0xa8, 0x37, 0x10, 0x82, 0x6a, 0xcb, 0xc0, 0xad, 0x93, 0x4e, 0x13, 0x9b, 0xb8,
-	0x77, 0x32, 0xd0, 0xd5, 0x9b, 0xf7, 0x7f, 0xab, 0x40, 0x39, 0x79, 0x9b, 0xb0, 0x53, 0x37, 0xba,
-	0xe6, 0x41, 0xc7, 0x78, 0x74, 0x38, 0x10, 0x4e, 0xd0, 0x3f, 0x69, 0xb1, 0x23, 0xd3, 0x59, 0x15,
-	0x83, 0xa0, 0x2a, 0x36, 0x3d, 0x5e, 0x6c, 0x86, 0xcd, 0x25, 0xb1, 0x6e, 0x4f, 0xea, 0xcd, 0x32,
-	0xe3, 0x25, 0xa8, 0x63, 0xdc, 0xc3, 0x6a, 0x0e, 0xbd, 0x0f, 0x3b, 0x12, 0x61, 0xe7, 0x8a, 0xb1,
-	0xde, 0x1a, 0x98, 0xc7, 0xcd, 0xe7, 0x47, 0xec, 0xd8, 0x85, 0x93, 0xf5, 0xd5, 0x15, 0x74, 0x17,
-	0xb6, 0x63, 0xd6, 0x32, 0xbf, 0xb8, 0xff, 0x15, 0xd4, 0xae, 0x8a, 0x4a, 0x04, 0x90, 0xef, 0xeb,
-	0x83, 0x41, 0x47, 0x17, 0x95, 0xd7, 0x81, 0x70, 0x5c, 0x80, 0x3c, 0xd6, 0xfb, 0x27, 0x47, 0xba,
-	0x9a, 0xb9, 0xff, 0x3f, 0xa0, 0x2e, 0x86, 0x0a, 0x93, 0xeb, 0x5d, 0xe6, 0x32, 0xea, 0x3b, 0x2c,
-	0x00, 0xa4, 0xff, 0xa8, 0x0a, 0x53, 0xd1, 0x3c, 0x19, 0xf4, 0xd4, 0xcc, 0xde, 0x5f, 0x4b, 0x90,
-	0xe7, 0x5f, 0x10, 0x01, 0xfa, 0x16, 0x2a, 0x89, 0x0e, 0xef, 0xd3, 0x3d, 0x74, 0xfb, 0xda, 0xde,
-	0x6f, 0x3d, 0x6a, 0x7c, 0x49, 0xf8, 0xa1, 0x82, 0xf6, 0xa1, 0x9a, 0xec, 0x5d, 0x3e, 0xdd, 0x43,
-	0xc9, 0xc2, 0x7b, 0x49, 0x5b, 0x73, 0x89, 0x8e, 0x27, 0xa0, 0xea, 0x21, 0xb5, 0x5d, 0x76, 0xff,
-	0xcb, 0xee, 0x22, 0xaa, 0x27, 0x13, 0x57, 0xba, 0x65, 0x59, 0xdf, 0x5e, 0x2a, 0x93, 0xa9, 0xf4,
-	0x3b, 0x56, 0x6b, 0xc5, 0xfd, 0xbd, 0x4b, 0x0b, 0x4a, 0x37, 0x15, 0xeb, 0x77, 0xae, 0x12, 0xcb,
-	0xfe, 0x41, 0xf6, 0xd7, 0x19, 0xb6, 0xc6, 0x4a, 0x42, 0xb6, 0x64, 0x97, 0x16, 0x94, 0x2e, 0xa9,
-	0x48, 0xd0, 0x08, 0x36, 0x96, 0xf4, 0xfe, 0xd0, 0x07, 0xe9, 0xfc, 0x7c, 0x45, 0xe7, 0xb0, 0xfe,
-	0xe1, 0x9b, 0x68, 0x72, 0xf1, 0x23, 0xd8, 0x58, 0xd2, 0x24, 0x4c, 0xcd, 0x72, 0x75, 0x8b, 0x31,
-	0x35, 0xcb, 0x75, 0xbd, 0xc6, 0x1f, 0xe1, 0xc6, 0xd2, 0x4e, 0x1f, 0xba, 0x97, 0x50, 0x70, 0x5d,
-	0x67, 0xb1, 0xde, 0x78, 0x33, 0x51, 0xce, 0x35, 0x85, 0xad, 0x2b, 0x5a, 0x53, 0xe8, 0x3f, 0x13,
-	0x4a, 0xae, 0x6f, 0x70, 0xd5, 0xef, 0xbf, 0x0d, 0x75, 0x3e, 0x63, 0xff, 0x2d, 0x66, 0xec, 0xbf,
-	0xfd, 0x8c, 0x6f, 0x68, 0x52, 0xa1, 0x17, 0xa0, 0x2e, 0x76, 0x4d, 0x90, 0xb6, 0x78, 0x16, 0x97,
-	0xdb, 0x37, 0xf5, 0xf7, 0xae, 0xe5, 0x48, 0xe5, 0x06, 0xc0, 0xbc, 0xb1, 0x80, 0x6e, 0x25, 0x86,
-	0x5c, 0xea, 0x9d, 0xd4, 0x6f, 0x5f, 0x21, 0x95, 0xaa, 0x06, 0xb0, 0xb1, 0xa4, 0xd3, 0x90, 0xf2,
-	0xae, 0xab, 0x3b, 0x11, 0xf5, 0xcd, 0x65, 0x1f, 0xe4, 0x0f, 0x15, 0x74, 0x24, 0x02, 0x36, 0xfa,
-	0x19, 0xe8, 0x0d, 0x19, 0xa8, 0xb6, 0xfc, 0xc3, 0x61, 0x16, 0xf2, 0x50, 0x7d, 0xa8, 0xa0, 0x1e,
-	0x94, 0x93, 0x59, 0xe7, 0x8d, 0xe9, 0xe8, 0x8d, 0x0a, 0xc7, 0xb0, 0x96, 0x2a, 0xda, 0xfc, 0x20,
-	0xe5, 0xe7, 0xd7, 0xd5, 0x75, 0xa9, 0x88, 0xba, 0xa6, 0x46, 0x6d, 0xb0, 0x79, 0x5e, 0x80, 0xba,
-	0x58, 0xdc, 0xa4, 0xbc, 0xe0, 0x8a, 0xea, 0x2b, 0xe5, 0x05, 0x57, 0x55, 0x47, 0xfb, 0x9f, 0xfc,
-	0xf0, 0xe0, 0xcc, 0xa6, 0x93, 0xd9, 0xe9, 0xee, 0xd0, 0x77, 0x1f, 0xf0, 0xdf, 0x84, 0x3c, 0xdb,
-	0x3b, 0xf3, 0x08, 0x7d, 0xe5, 0x07, 0xe7, 0x0f, 0x1c, 0x6f, 0xf4, 0x80, 0xe7, 0xac, 0x07, 0xb1,
-	0xae, 0xd3, 0x3c, 0xff, 0x91, 0xf9, 0xd3, 0xbf, 0x07, 0x00, 0x00, 0xff, 0xff, 0xc3, 0x50, 0x5a,
-	0xbb, 0x94, 0x1e, 0x00, 0x00,
+	// 3189 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x5b, 0x77, 0xdb, 0xc6,
+	0x73, 0x0f, 0x78, 0x13, 0x39, 0xbc, 0x41, 0x2b, 0xd9, 0x62, 0x28, 0x5f, 0x18, 0xe6, 0x1f, 0x9b,
+	0x71, 0x13, 0xd9, 0x51, 0xda, 0x24, 0x6d, 0x2e, 0x0d, 0x45, 0x42, 0x16, 0x6c, 0x8a, 0x64, 0x96,
+	0x94, 0x63, 0xc7, 0x0f, 0x28, 0x44, 0x2e, 0x45, 0x44, 0xb8, 0xb0, 0xc0, 0xd2, 0xb6, 0xf2, 0xd4,
+	0xd3, 0xa7, 0x9e, 0x9e, 0xbe, 0xf7, 0x5b, 0xf4, 0x13, 0xf4, 0x9c, 0xbe, 0xf7, 0x4b, 0xf4, 0xad,
+	0xa7, 0xaf, 0xf9, 0x06, 0x3d, 0x7b, 0x01, 0x08, 0x52, 0x94, 0xe4, 0x36, 0xff, 0x17, 0x1b, 0xf8,
+	0xcd, 0x6f, 0x67, 0x67, 0x67, 0x67, 0x66, 0x07, 0x4b, 0xc1, 0x6d, 0xdf, 0x9b, 0x53, 0xe2, 0xfb,
+	0xb3, 0xd1, 0x63, 0xf1, 0xb4, 0x37, 0xf3, 0x3d, 0xea, 0xa1, 0x5c, 0x84, 0x57, 0x73, 0xfe, 0x6c,
+	0x24, 0xd0, 0xfa, 0xef, 0x59, 0x40, 0x03, 0xe2, 0x8e, 0xfb, 0xe6, 0x85, 0x43, 0x5c, 0x8a, 0xc9,
+	0xdf, 0xcf, 0x49, 0x40, 0x11, 0x82, 0xd4, 0x98, 0x04, 0xb4, 0xa2, 0xd4, 0x94, 0x46, 0x01, 0xf3,
+	0x67, 0xa4, 0x42, 0xd2, 0x74, 0x68, 0x25, 0x51, 0x53, 0x1a, 0x49, 0xcc, 0x1e, 0xd1, 0x87, 0x90,
+	0x35, 0x1d, 0x6a, 0x38, 0x81, 0x49, 0x2b, 0x05, 0x0e, 0x6f, 0x98, 0x0e, 0x3d, 0x0e, 0x4c, 0x8a,
+	0x3e, 0x82, 0xc2, 0x4c, 0xa8, 0x34, 0xa6, 0x66, 0x30, 0xad, 0x24, 0xb9, 0xa2, 0xbc, 0xc4, 0x8e,
+	0xcc, 0x60, 0x8a, 0x1a, 0xa0, 0x4e, 0x2c, 0xd7, 0xb4, 0x8d, 0x91, 0x4d, 0xdf, 0x18, 0x63, 0x62,
+	0x53, 0xb3, 0x92, 0xaa, 0x29, 0x8d, 0x34, 0x2e, 0x71, 0xbc, 0x65, 0xd3, 0x37, 0x6d, 0x86, 0xc6,
+	0x95, 0x99, 0xe3, 0xb1, 0x5f, 0xd9, 0x5e, 0x52, 0xd6, 0x1c, 0x8f, 0x7d, 0xf4, 0x10, 0xca, 0x21,
+	0xc5, 0x17, 0x6b, 0xa8, 0xa4, 0x6b, 0x4a, 0x23, 0x87, 0x4b, 0xb3, 0xe5, 0x95, 0x3d, 0x84, 0x32,
+	0xb5, 0x1c, 0xe2, 0xcd, 0xa9, 0x11, 0x90, 0x91, 0xe7, 0x8e, 0x83, 0x4a, 0x46, 0x4c, 0x2a, 0xe1,
+	0x81, 0x40, 0x51, 0x1d, 0x8a, 0x13, 0x42, 0x0c, 0xdb, 0x72, 0x2c, 0x6a, 0xb0, 0x15, 0x6e, 0xf0,
+	0x15, 0xe6, 0x27, 0x84, 0x74, 0x18, 0x36, 0x30, 0x29, 0xfa, 0x13, 0x94, 0x16, 0x1c, 0xee, 0x86,
+	0x22, 0x27, 0x15, 0x42, 0x12, 0xf7, 0xc5, 0x1e, 0xa8, 0xde, 0x9c, 0x9e, 0x79, 0x96, 0x7b, 0x66,
+	0x8c, 0xa6, 0xa6, 0x6b, 0x58, 0xe3, 0x4a, 0xb6, 0xa6, 0x34, 0x52, 0x07, 0xa9, 0x8a, 0xf2, 0x44,
+	0xc1, 0xa5, 0x50, 0xda, 0x9a, 0x9a, 0xae, 0x3e, 0x46, 0x8f, 0x60, 0x73, 0x95, 0x1f, 0x54, 0xb6,
+	0x6a, 0xc9, 0x46, 0x0a, 0x97, 0x97, 0xa9, 0x01, 0x7a, 0x00, 0x65, 0xdb, 0x0c, 0xa8, 0x31, 0xf5,
+	0x66, 0xc6, 0x6c, 0x7e, 0x7a, 0x4e, 0x2e, 0x2a, 0x25, 0xee, 0x9d, 0x22, 0x83, 0x8f, 0xbc, 0x59,
+	0x9f, 0x83, 0xe8, 0x2e, 0x00, 0x77, 0x33, 0x37, 0xb5, 0x92, 0xe3, 0x2b, 0xce, 0x31, 0x84, 0x9b,
+	0x89, 0xbe, 0x80, 0x3c, 0x0f, 0x0f, 0x63, 0x6a, 0xb9, 0x34, 0xa8, 0x40, 0x2d, 0xd9, 0xc8, 0xef,
+	0xab, 0x7b, 0xb6, 0xcb, 0x22, 0x05, 0x33, 0xc9, 0x91, 0xe5, 0x52, 0x0c, 0x7e, 0xf8, 0x18, 0xa0,
+	0x31, 0x6c, 0xb1, 0xb0, 0x30, 0x46, 0xf3, 0x80, 0x7a, 0x8e, 0xe1, 0x93, 0x91, 0xe7, 0x8f, 0x83,
+	0x4a, 0x9e, 0x0f, 0xfd, 0xcb, 0xbd, 0x28, 0xda, 0xf6, 0x2e, 0x87, 0xd7, 0x5e, 0x9b, 0x04, 0xb4,
+	0xc5, 0xc7, 0x61, 0x31, 0x4c, 0x73, 0xa9, 0x7f, 0x81, 0x37, 0xc7, 0xab, 0x38, 0xfa, 0x0c, 0x90,
+	0x69, 0xdb, 0xde, 0x5b, 0x23, 0x20, 0xf6, 0xc4, 0x90, 0x7b, 0x59, 0x29, 0xd7, 0x94, 0x46, 0x16,
+	0xab, 0x5c, 0x32, 0x20, 0xf6, 0x44, 0xaa, 0x47, 0x5f, 0x41, 0x91, 0xdb, 0x34, 0x21, 0x26, 0x9d,
+	0xfb, 0x24, 0xa8, 0xa8, 0xb5, 0x64, 0xa3, 0xb4, 0xbf, 0x29, 0x17, 0x72, 0x28, 0xe0, 0x03, 0x8b,
+	0xe2, 0x02, 0xe3, 0xc9, 0xf7, 0x00, 0xed, 0x42, 0xce, 0x31, 0xdf, 0x19, 0x33, 0xd3, 0xa7, 0x41,
+	0x65, 0xb3, 0xa6, 0x34, 0x8a, 0x38, 0xeb, 0x98, 0xef, 0xfa, 0xec, 0x1d, 0xed, 0xc1, 0x96, 0xeb,
+	0x19, 0x96, 0x3b, 0xb1, 0xad, 0xb3, 0x29, 0x35, 0xe6, 0xb3, 0xb1, 0x49, 0x49, 0x50, 0x41, 0xdc,
+	0x86, 0x4d, 0xd7, 0xd3, 0xa5, 0xe4, 0x44, 0x08, 0xd0, 0xe7, 0xb0, 0xc5, 0x94, 0x05, 0x53, 0xd3,
+	0x1f, 0x1b, 0x81, 0xf5, 0x1b, 0x11, 0x91, 0x71, 0x8b, 0xed, 0x38, 0x56, 0x1d, 0xf3, 0xdd, 0x80,
+	0x49, 0x06, 0xd6, 0x6f, 0x84, 0x47, 0xc7, 0x2e, 0xe4, 0x58, 0xe4, 0x19, 0x33, 0x9f, 0x4c, 0x2a,
+	0x3b, 0x35, 0xa5, 0xa1, 0xe0, 0x2c, 0x03, 0xfa, 0x3e, 0x99, 0xa0, 0x4f, 0x41, 0xa5, 0xbe, 0xe9,
+	0xcc, 0x3c, 0xdb, 0x72, 0x89, 0xe1, 0x7a, 0x63, 0x12, 0x54, 0x2a, 0xb5, 0x64, 0xa3, 0x80, 0xcb,
+	0x0b, 0xbc, 0xcb, 0x60, 0x66, 0x66, 0x8c, 0x3a, 0x21, 0x72, 0xda, 0x0f, 0x79, 0x40, 0x6e, 0x2e,
+	0x44, 0x87, 0x44, 0xcc, 0xbb, 0x0f, 0xb7, 0x62, 0xfc, 0x58, 0x0e, 0x56, 0x79, 0x70, 0xc4, 0x94,
+	0x45, 0x89, 0x58, 0x6d, 0xc3, 0xed, 0xf5, 0x5b, 0xc7, 0x8a, 0x03, 0x8b, 0x3d, 0x85, 0x2f, 0x92,
+	0x3d, 0xa2, 0x6d, 0x48, 0xbf, 0x31, 0xed, 0x39, 0xe1, 0x05, 0xa3, 0x80, 0xc5, 0xcb, 0xdf, 0x24,
+	0xbe, 0x51, 0xea, 0x53, 0xd8, 0x1a, 0xfa, 0xe6, 0xe8, 0x7c, 0xa5, 0xe6, 0xac, 0x96, 0x0c, 0xe5,
+	0x72, 0xc9, 0xb8, 0x62, 0x2b, 0x12, 0x57, 0x6c, 0x45, 0xfd, 0x07, 0x28, 0xf3, 0xe0, 0x3d, 0x24,
+	0xe4, 0xba, 0xca, 0xb6, 0x03, 0xac, 0x6e, 0xf1, 0x24, 0x17, 0xd5, 0x2d, 0x63, 0x3a, 0x2c, 0xbf,
+	0xeb, 0x63, 0x50, 0x17, 0xe3, 0x83, 0x99, 0xe7, 0x06, 0x84, 0x95, 0x2d, 0x16, 0xdb, 0x2c, 0x39,
+	0x23, 0x27, 0x2b, 0x7c, 0x54, 0x49, 0xe2, 0xa1, 0x87, 0x1f, 0x88, 0x52, 0x63, 0xd8, 0xde, 0xe8,
+	0x9c, 0xf9, 0xd6, 0xbc, 0x90, 0xea, 0x8b, 0x0c, 0xee, 0x78, 0xa3, 0xf3, 0x36, 0x03, 0xeb, 0xaf,
+	0x45, 0x09, 0x1e, 0x7a, 0x7c, 0xae, 0xff, 0x83, 0x3b, 0xea, 0x90, 0xe6, 0x69, 0xc6, 0xd5, 0xe6,
+	0xf7, 0x0b, 0xf1, 0x7c, 0xc5, 0x42, 0x54, 0x7f, 0x0d, 0x5b, 0x4b, 0xca, 0xe5, 0x2a, 0xaa, 0x90,
+	0x9d, 0xf9, 0xc4, 0x72, 0xcc, 0x33, 0x22, 0x35, 0x47, 0xef, 0xa8, 0x01, 0x1b, 0x13, 0xd3, 0xb2,
+	0xe7, 0x7e, 0xa8, 0xb8, 0x14, 0xe6, 0x8f, 0x40, 0x71, 0x28, 0xae, 0xdf, 0x81, 0x2a, 0x26, 0x01,
+	0xa1, 0xc7, 0x56, 0x10, 0x58, 0x9e, 0xdb, 0xf2, 0x5c, 0xea, 0x7b, 0xb6, 0x5c, 0x41, 0xfd, 0x2e,
+	0xec, 0xae, 0x95, 0x0a, 0x13, 0xd8, 0xe0, 0x9f, 0xe6, 0xc4, 0xbf, 0x58, 0x3f, 0xf8, 0x27, 0xd8,
+	0x5d, 0x2b, 0x95, 0xf6, 0x7f, 0x06, 0xe9, 0x99, 0x69, 0xf9, 0x6c, 0xef, 0x59, 0xbd, 0xb9, 0x1d,
+	0xab, 0x37, 0x7d, 0xd3, 0xf2, 0x8f, 0xac, 0x80, 0x7a, 0xfe, 0x05, 0x16, 0xa4, 0x67, 0xa9, 0xac,
+	0xa2, 0x26, 0xea, 0x1d, 0xb8, 0xf3, 0x52, 0x77, 0x66, 0x9e, 0xbf, 0xde, 0xde, 0x85, 0x4e, 0xe5,
+	0x3d, 0x74, 0xd6, 0xef, 0xc3, 0xdd, 0x2b, 0xb4, 0xc9, 0xf5, 0xfd, 0xb3, 0x02, 0xf9, 0xd8, 0x38,
+	0x96, 0xe8, 0x2c, 0x81, 0x8d, 0x89, 0xef, 0x39, 0xa1, 0xcf, 0x19, 0x70, 0xe8, 0x7b, 0x0e, 0x0b,
+	0x41, 0x2e, 0xa4, 0x9e, 0xcc, 0x97, 0x0c, 0x7b, 0x1d, 0x7a, 0xe8, 0x73, 0xd8, 0x98, 0x0a, 0x05,
+	0xfc, 0x00, 0xca, 0xef, 0x6f, 0xad, 0x98, 0xd5, 0x36, 0xa9, 0x89, 0x43, 0xce, 0xb3, 0x54, 0x36,
+	0xa9, 0xa6, 0x9e, 0xa5, 0xb2, 0x29, 0x35, 0xfd, 0x2c, 0x95, 0x4d, 0xab, 0x99, 0x67, 0xa9, 0x6c,
+	0x46, 0xdd, 0xa8, 0xff, 0x8f, 0x02, 0xd9, 0x90, 0xcd, 0x2c, 0x61, 0x3b, 0x68, 0xb0, 0x30, 0x94,
+	0xb1, 0x9b, 0x65, 0xc0, 0xd0, 0x72, 0x08, 0xaa, 0x41, 0x81, 0x0b, 0x97, 0x33, 0x02, 0x18, 0xd6,
+	0xe4, 0x59, 0xc1, 0x4f, 0xc6, 0x90, 0xc1, 0xc3, 0x3f, 0x25, 0x4f, 0x46, 0x41, 0x09, 0xcf, 0xff,
+	0x60, 0x3e, 0x1a, 0x91, 0x20, 0x10, 0xb3, 0xa4, 0x05, 0x45, 0x62, 0x7c, 0xa2, 0x07, 0x50, 0x0e,
+	0x29, 0xe1, 0x5c, 0x19, 0x91, 0x1e, 0x12, 0x96, 0xd3, 0x35, 0x40, 0x8d, 0xf3, 0x9c, 0xc5, 0x59,
+	0x5c, 0x5a, 0x10, 0xd9, 0xa4, 0x62, 0xf1, 0xf5, 0x1a, 0xdc, 0x7b, 0xba, 0x1a, 0x74, 0x2d, 0xcf,
+	0x9d, 0x58, 0x67, 0x61, 0x6c, 0xfd, 0x02, 0xf7, 0xaf, 0x64, 0xc8, 0xf8, 0xfa, 0x1a, 0x32, 0x23,
+	0x8e, 0x70, 0xff, 0xe4, 0xf7, 0xef, 0xc7, 0xbc, 0xbe, 0x76, 0xa0, 0xa4, 0xd7, 0x5f, 0xc1, 0xbd,
+	0xc1, 0xb5, 0xb3, 0xff, 0xff, 0x55, 0x7f, 0x04, 0xf7, 0x07, 0xd7, 0x9b, 0x5d, 0xff, 0x87, 0x04,
+	0x6c, 0xaf, 0x23, 0xb0, 0x9e, 0x62, 0x6a, 0xda, 0x13, 0xc3, 0xb6, 0x26, 0x24, 0x6a, 0x7c, 0x44,
+	0xb5, 0x2e, 0x33, 0x41, 0xc7, 0x9a, 0x90, 0xb0, 0xf3, 0x79, 0x08, 0x65, 0xde, 0x4e, 0xf8, 0xde,
+	0xa9, 0x79, 0x6a, 0xd9, 0x16, 0x15, 0x75, 0x2b, 0x81, 0x4b, 0x53, 0x6f, 0xd6, 0x5f, 0xa0, 0xe8,
+	0x36, 0x64, 0xde, 0x12, 0x56, 0x6f, 0x79, 0x7b, 0x97, 0xc0, 0xf2, 0x0d, 0x7d, 0x05, 0x3b, 0x8e,
+	0xf9, 0xce, 0x72, 0xe6, 0x8e, 0xb1, 0x68, 0xca, 0x82, 0xb9, 0x4d, 0x03, 0x1e, 0x2a, 0x45, 0x7c,
+	0x4b, 0x8a, 0xa3, 0x13, 0x80, 0x0b, 0x51, 0x0b, 0xee, 0x39, 0x96, 0xcb, 0xc7, 0xc9, 0x0a, 0x63,
+	0xf8, 0xc4, 0x36, 0xdf, 0x19, 0x96, 0x4b, 0x89, 0xff, 0xc6, 0xb4, 0x79, 0x18, 0xa5, 0xf0, 0xae,
+	0x64, 0x85, 0xf5, 0x88, 0x71, 0x74, 0x49, 0xa9, 0xff, 0x0a, 0x3b, 0xbc, 0x70, 0xc4, 0x0c, 0x0d,
+	0x3d, 0xcf, 0xe2, 0xde, 0xf7, 0x1c, 0x7e, 0x8e, 0x86, 0x19, 0xc8, 0x00, 0x76, 0x80, 0xb2, 0x0c,
+	0xa4, 0x9e, 0x10, 0xc9, 0x0c, 0xa4, 0x1e, 0x17, 0xc4, 0xbb, 0xdc, 0xe4, 0x52, 0x97, 0x5b, 0x3f,
+	0x87, 0xca, 0xe5, 0xb9, 0x64, 0x04, 0xd5, 0x20, 0x1f, 0xf7, 0xa0, 0xc2, 0x4f, 0xf6, 0x38, 0x14,
+	0x4f, 0xed, 0xc4, 0xcd, 0xa9, 0x5d, 0xff, 0x4f, 0x05, 0x36, 0x0f, 0xe6, 0x96, 0x3d, 0x5e, 0x3a,
+	0x26, 0xe2, 0xd6, 0x29, 0xcb, 0x3d, 0xf8, 0xba, 0x06, 0x3b, 0xb1, 0xb6, 0xc1, 0xfe, 0x6c, 0x4d,
+	0x87, 0x9a, 0xe4, 0x1d, 0x6a, 0x62, 0x4d, 0x7f, 0x7a, 0x1f, 0xf2, 0x8b, 0x76, 0x93, 0x6d, 0x29,
+	0xeb, 0x47, 0x60, 0x1a, 0xf6, 0x9a, 0xc1, 0xa5, 0x7e, 0x3d, 0x7d, 0xa9, 0x5f, 0xaf, 0x7f, 0x03,
+	0x28, 0xbe, 0x16, 0xe9, 0xb3, 0xe8, 0x40, 0x53, 0xae, 0x3e, 0xd0, 0xbe, 0x87, 0xea, 0x60, 0x7e,
+	0x1a, 0x8c, 0x7c, 0xeb, 0x94, 0x1c, 0x51, 0x7b, 0xa4, 0xbd, 0x21, 0x2e, 0x0d, 0x42, 0x77, 0xdc,
+	0x87, 0x7c, 0x40, 0x4d, 0x9f, 0x1a, 0x96, 0x3b, 0x26, 0xef, 0x64, 0x84, 0x03, 0x87, 0x74, 0x86,
+	0xd4, 0xff, 0x25, 0x0d, 0xb9, 0x68, 0x18, 0x6b, 0x28, 0x2c, 0x77, 0xe4, 0x39, 0xe1, 0xc2, 0x5d,
+	0x62, 0xb3, 0xb5, 0x8b, 0x61, 0x9b, 0xa1, 0xa8, 0x25, 0x24, 0xfa, 0x98, 0xf1, 0x97, 0x1c, 0x25,
+	0xf9, 0x09, 0xc1, 0x8f, 0xfb, 0x49, 0xf0, 0x1b, 0xa0, 0x46, 0xfa, 0xa7, 0xd4, 0x1e, 0x45, 0x8e,
+	0xc5, 0xa5, 0x10, 0x67, 0xc6, 0x08, 0x66, 0xa4, 0x39, 0x64, 0xa6, 0x04, 0x33, 0xc4, 0x25, 0xf3,
+	0x23, 0x28, 0xb0, 0x92, 0x1a, 0x50, 0xd3, 0x99, 0x19, 0x6e, 0x20, 0x73, 0x22, 0x1f, 0x61, 0xdd,
+	0x00, 0x7d, 0x0f, 0x40, 0xd8, 0xfa, 0x0c, 0x7a, 0x31, 0x23, 0xbc, 0xaa, 0x96, 0xf6, 0xef, 0xc5,
+	0x82, 0x2b, 0x72, 0xc0, 0x1e, 0xff, 0x77, 0x78, 0x31, 0x23, 0x38, 0x47, 0xc2, 0x47, 0xf4, 0x03,
+	0x14, 0x27, 0x9e, 0xff, 0x96, 0xf5, 0xaf, 0x1c, 0x94, 0x27, 0xcf, 0x4e, 0x4c, 0xc3, 0xa1, 0x90,
+	0xf3, 0xe1, 0x47, 0x1f, 0xe0, 0xc2, 0x24, 0xf6, 0x8e, 0x9e, 0x03, 0x0a, 0xc7, 0xf3, 0x83, 0x42,
+	0x28, 0xc9, 0x72, 0x25, 0xbb, 0x97, 0x95, 0xb0, 0x34, 0x0e, 0x15, 0xa9, 0x93, 0x15, 0x0c, 0x7d,
+	0x0b, 0x85, 0x80, 0x50, 0x6a, 0x13, 0xa9, 0x26, 0x57, 0x53, 0x56, 0x0e, 0xe7, 0x01, 0x17, 0x87,
+	0x1a, 0xf2, 0xc1, 0xe2, 0x15, 0x1d, 0x40, 0xd9, 0xb6, 0xdc, 0xf3, 0xb8, 0x19, 0xc0, 0xc7, 0x57,
+	0x62, 0xe3, 0x3b, 0x96, 0x7b, 0x1e, 0xb7, 0xa1, 0x68, 0xc7, 0x01, 0xd6, 0xc8, 0x8a, 0x60, 0xca,
+	0x73, 0x47, 0x8b, 0x97, 0xfa, 0x77, 0x90, 0x8b, 0x7c, 0x87, 0xf2, 0xb0, 0x71, 0xd2, 0x7d, 0xde,
+	0xed, 0xfd, 0xdc, 0x55, 0x3f, 0x40, 0x59, 0x48, 0x0d, 0xb4, 0x6e, 0x5b, 0x55, 0x18, 0x8c, 0xb5,
+	0x96, 0xa6, 0xbf, 0xd0, 0xd4, 0x04, 0x7b, 0x39, 0xec, 0xe1, 0x9f, 0x9b, 0xb8, 0xad, 0x26, 0x0f,
+	0x36, 0x20, 0xcd, 0xad, 0xa9, 0xff, 0xbb, 0x02, 0x59, 0xbe, 0xaf, 0xee, 0xc4, 0x43, 0x7f, 0x01,
+	0x51, 0xc8, 0xf1, 0x53, 0x93, 0x35, 0x8e, 0x3c, 0x16, 0x8b, 0x38, 0x0a, 0xa3, 0xa1, 0xc4, 0x19,
+	0x39, 0x0a, 0x98, 0x88, 0x9c, 0x10, 0xe4, 0x50, 0x10, 0x91, 0x1f, 0xc5, 0x34, 0x2f, 0x15, 0xb3,
+	0x14, 0x2e, 0x87, 0x82, 0xf0, 0xe8, 0x8e, 0x7f, 0x7e, 0x2e, 0x1d, 0xf1, 0xb1, 0xcf, 0x4f, 0xc9,
+	0xad, 0x7f, 0x0d, 0x85, 0x78, 0x24, 0xa0, 0x87, 0x90, 0xb2, 0xdc, 0x89, 0x57, 0x51, 0x2e, 0xd5,
+	0xb3, 0x70, 0x91, 0x98, 0x13, 0xea, 0x08, 0xd4, 0xd5, 0xdd, 0xaf, 0x17, 0x21, 0x1f, 0xdb, 0xca,
+	0xfa, 0x7f, 0x29, 0x50, 0x5c, 0xda, 0x9a, 0xf7, 0xd6, 0x8e, 0xbe, 0x87, 0xc2, 0x5b, 0xcb, 0x27,
+	0x46, 0xbc, 0x8d, 0x2d, 0xed, 0x57, 0x97, 0xdb, 0xd8, 0xf0, 0xff, 0x96, 0x37, 0x26, 0x38, 0xcf,
+	0xf8, 0x12, 0x40, 0x7f, 0x0b, 0xa5, 0xf0, 0xfc, 0x19, 0x13, 0x6a, 0x5a, 0x36, 0x77, 0x55, 0x69,
+	0x29, 0x68, 0x24, 0xb7, 0xcd, 0xe5, 0xb8, 0x38, 0x89, 0xbf, 0xa2, 0x4f, 0x16, 0x0a, 0x02, 0xea,
+	0x5b, 0xee, 0x19, 0xf7, 0x5f, 0x2e, 0xa2, 0x0d, 0x38, 0xc8, 0x3a, 0xc4, 0xa2, 0x3c, 0x02, 0x07,
+	0xd4, 0xa4, 0x73, 0xf6, 0xed, 0x98, 0x0e, 0xa8, 0x29, 0x0b, 0x60, 0x69, 0x29, 0xe3, 0x62, 0x44,
+	0x82, 0x05, 0x6b, 0xa9, 0x8b, 0x4f, 0x5c, 0xea, 0xe2, 0xd3, 0xac, 0x8e, 0x88, 0xfa, 0x9c, 0xdf,
+	0x47, 0x72, 0xf1, 0x47, 0xc3, 0x4e, 0xab, 0x49, 0x29, 0x71, 0x66, 0x14, 0x0b, 0x82, 0x6c, 0x9b,
+	0x7e, 0x00, 0x68, 0x59, 0xfe, 0x68, 0x6e, 0xd1, 0xe7, 0xe4, 0x82, 0x9d, 0x86, 0xe1, 0x41, 0x20,
+	0x8a, 0x61, 0x66, 0x24, 0x8a, 0xff, 0x0e, 0x6c, 0x84, 0xe5, 0x49, 0x54, 0xbd, 0xcc, 0x94, 0x97,
+	0xa5, 0xfa, 0x7f, 0xa4, 0x60, 0x57, 0x6e, 0xa9, 0xd8, 0x0d, 0x4a, 0xfc, 0x11, 0x99, 0x45, 0x9f,
+	0x77, 0x4f, 0x61, 0x7b, 0x51, 0x6a, 0xc5, 0x44, 0x46, 0xf8, 0xc9, 0x98, 0xdf, 0xbf, 0x15, 0x5b,
+	0xe9, 0xc2, 0x0c, 0x8c, 0xa2, 0x12, 0xbc, 0x30, 0xed, 0x49, 0x4c, 0x91, 0xe9, 0x78, 0x73, 0x57,
+	0x86, 0xa8, 0xa8, 0x83, 0x68, 0x11, 0xce, 0x4c, 0xc4, 0x23, 0xfa, 0x21, 0x44, 0x41, 0x6e, 0x90,
+	0x77, 0x33, 0xcb, 0xbf, 0xe0, 0x35, 0xb1, 0xb8, 0x28, 0xc2, 0x1a, 0x47, 0x2f, 0x7d, 0x73, 0x25,
+	0x2e, 0x7f, 0x73, 0x7d, 0x0b, 0xd5, 0x28, 0x3b, 0xe4, 0x4d, 0x13, 0x19, 0x47, 0x87, 0xe6, 0x06,
+	0xb7, 0x61, 0x27, 0x64, 0xe0, 0x90, 0x20, 0x4f, 0xce, 0x27, 0xb0, 0x1d, 0x4b, 0xad, 0x85, 0xe9,
+	0x22, 0x13, 0xd1, 0x22, 0xbb, 0xe2, 0xa6, 0x47, 0x23, 0xa4, 0xe9, 0xa2, 0x85, 0x8a, 0x4e, 0x05,
+	0x69, 0xfa, 0xdf, 0x41, 0x69, 0xe5, 0x26, 0x26, 0xcb, 0xf7, 0xfd, 0xaf, 0x2f, 0xd7, 0xdb, 0x75,
+	0xdb, 0xb3, 0xb7, 0xe6, 0x3a, 0xa6, 0x38, 0x8a, 0x63, 0xec, 0x0a, 0xc9, 0x73, 0x2d, 0xcf, 0x35,
+	0x4e, 0x6d, 0xef, 0x94, 0x97, 0xe1, 0x02, 0xce, 0x71, 0xe4, 0xc0, 0xf6, 0x4e, 0xab, 0x3f, 0x02,
+	0xfa, 0x83, 0xf7, 0x02, 0xff, 0x9a, 0x84, 0x3b, 0xeb, 0x4d, 0x94, 0xed, 0xc1, 0x9f, 0x2d, 0x84,
+	0xbe, 0x85, 0x8c, 0x39, 0xa2, 0x96, 0xe7, 0xca, 0xca, 0xf0, 0x71, 0x6c, 0x28, 0x26, 0x81, 0x67,
+	0xbf, 0x21, 0x47, 0x9e, 0x3d, 0x96, 0xc6, 0x34, 0x39, 0x15, 0xcb, 0x21, 0x4b, 0x49, 0x97, 0x5c,
+	0x49, 0xba, 0x87, 0x50, 0x0e, 0x13, 0xdf, 0x21, 0x41, 0xc0, 0x28, 0x29, 0x4e, 0x09, 0xeb, 0xc1,
+	0xb1, 0x40, 0x59, 0x85, 0x0a, 0x89, 0x23, 0xd6, 0x72, 0xa6, 0x6f, 0xae, 0x50, 0x93, 0xc5, 0xcb,
+	0x95, 0x81, 0x94, 0xb9, 0x32, 0x90, 0xfe, 0x48, 0xdc, 0xd6, 0xff, 0x51, 0x81, 0x1d, 0x71, 0xa7,
+	0xc2, 0x00, 0x51, 0xab, 0xc2, 0xbc, 0xde, 0x07, 0xe0, 0x5a, 0x66, 0x9e, 0xe5, 0xd2, 0xa8, 0x34,
+	0x8b, 0x75, 0xc8, 0x46, 0xa8, 0xcf, 0x44, 0x38, 0xc7, 0x68, 0xfc, 0x11, 0x7d, 0xb9, 0xe2, 0xff,
+	0x78, 0x53, 0xb0, 0x98, 0x61, 0xd9, 0xef, 0xf5, 0x2a, 0x54, 0x2e, 0xdb, 0x20, 0x22, 0xe3, 0xd1,
+	0x7f, 0xa7, 0xa0, 0xb8, 0x54, 0x91, 0x97, 0x8f, 0xe4, 0x22, 0xe4, 0xba, 0x3d, 0xa3, 0xad, 0x0d,
+	0x9b, 0x7a, 0x47, 0x55, 0x90, 0x0a, 0x85, 0x5e, 0x57, 0xef, 0x75, 0x8d, 0xb6, 0xd6, 0xea, 0xb5,
+	0xd9, 0xe1, 0x7c, 0x0b, 0x36, 0x3b, 0x7a, 0xf7, 0xb9, 0xd1, 0xed, 0x0d, 0x0d, 0xad, 0xa3, 0x3f,
+	0xd5, 0x0f, 0x3a, 0x9a, 0x9a, 0x44, 0xdb, 0xa0, 0xf6, 0xba, 0x46, 0xeb, 0xa8, 0xa9, 0x77, 0x8d,
+	0xa1, 0x7e, 0xac, 0xf5, 0x4e, 0x86, 0x6a, 0x8a, 0xa1, 0xac, 0x8a, 0x1a, 0xda, 0xcb, 0x96, 0xa6,
+	0xb5, 0x07, 0xc6, 0x71, 0xf3, 0xa5, 0x9a, 0x46, 0x15, 0xd8, 0xd6, 0xbb, 0x83, 0x93, 0xc3, 0x43,
+	0xbd, 0xa5, 0x6b, 0xdd, 0xa1, 0x71, 0xd0, 0xec, 0x34, 0xbb, 0x2d, 0x4d, 0xcd, 0xa0, 0xdb, 0x80,
+	0xf4, 0x6e, 0xab, 0x77, 0xdc, 0xef, 0x68, 0x43, 0xcd, 0x08, 0x9b, 0x80, 0x0d, 0xb4, 0x05, 0x65,
+	0xae, 0xa7, 0xd9, 0x6e, 0x1b, 0x87, 0x4d, 0xbd, 0xa3, 0xb5, 0xd5, 0x2c, 0xb3, 0x44, 0x32, 0x06,
+	0x46, 0x5b, 0x1f, 0x34, 0x0f, 0x18, 0x9c, 0x63, 0x73, 0xea, 0xdd, 0x17, 0x3d, 0xbd, 0xa5, 0x19,
+	0x2d, 0xa6, 0x96, 0xa1, 0xc0, 0xc8, 0x21, 0x7a, 0xd2, 0x6d, 0x6b, 0xb8, 0xdf, 0xd4, 0xdb, 0x6a,
+	0x1e, 0xed, 0xc2, 0x4e, 0x08, 0x6b, 0x2f, 0xfb, 0x3a, 0x7e, 0x65, 0x0c, 0x7b, 0x3d, 0x63, 0xd0,
+	0xeb, 0x75, 0xd5, 0x42, 0x5c, 0x13, 0x5b, 0x6d, 0xaf, 0xaf, 0x75, 0xd5, 0x22, 0xda, 0x81, 0xad,
+	0xe3, 0x7e, 0xdf, 0x08, 0x25, 0xe1, 0x62, 0x4b, 0x8c, 0xde, 0x6c, 0xb7, 0xb1, 0x36, 0x18, 0x18,
+	0xc7, 0xfa, 0xe0, 0xb8, 0x39, 0x6c, 0x1d, 0xa9, 0x65, 0xb6, 0xa4, 0x81, 0x36, 0x34, 0x86, 0xbd,
+	0x61, 0xb3, 0xb3, 0xc0, 0x55, 0x66, 0xd0, 0x02, 0x67, 0x93, 0x76, 0x7a, 0x3f, 0xab, 0x9b, 0xcc,
+	0xe1, 0x0c, 0xee, 0xbd, 0x90, 0x26, 0x22, 0xb6, 0x76, 0xb9, 0x3d, 0xe1, 0x9c, 0xea, 0x16, 0x03,
+	0xf5, 0xee, 0x8b, 0x66, 0x47, 0x6f, 0x1b, 0xcf, 0xb5, 0x57, 0xbc, 0x89, 0xda, 0x66, 0xa0, 0xb0,
+	0xcc, 0xe8, 0xe3, 0xde, 0x53, 0x66, 0x88, 0x7a, 0x0b, 0x21, 0x28, 0xb5, 0x74, 0xdc, 0x3a, 0xe9,
+	0x34, 0xb1, 0x81, 0x7b, 0x27, 0x43, 0x4d, 0xbd, 0x8d, 0xaa, 0x70, 0x5b, 0xb8, 0xb3, 0xd5, 0xd2,
+	0xfa, 0xc3, 0x1e, 0x5e, 0x38, 0x6a, 0x07, 0x6d, 0x42, 0xb1, 0x7d, 0x32, 0x18, 0x32, 0x77, 0xf4,
+	0x06, 0x27, 0x58, 0x53, 0x2b, 0x71, 0x27, 0xf5, 0x7b, 0x1d, 0xbd, 0xf5, 0xca, 0xc0, 0xda, 0x33,
+	0xad, 0x35, 0xd4, 0xda, 0xea, 0x87, 0xcc, 0x1d, 0x47, 0xbd, 0x4e, 0xdb, 0x58, 0x76, 0xa3, 0x5a,
+	0x7d, 0xf4, 0x6f, 0x0a, 0x14, 0xe2, 0x27, 0x31, 0x0b, 0x2d, 0xbd, 0x6b, 0x1c, 0x76, 0xf4, 0xa7,
+	0x47, 0x43, 0x11, 0x69, 0x83, 0x93, 0x16, 0x8b, 0x0b, 0x8d, 0x75, 0x80, 0x08, 0x4a, 0x62, 0x67,
+	0x23, 0x8f, 0x26, 0xd8, 0x82, 0x24, 0xd6, 0xed, 0x49, 0xe3, 0x93, 0xcc, 0x43, 0x12, 0xd4, 0x30,
+	0xee, 0x61, 0x35, 0x85, 0xfe, 0x04, 0x35, 0x89, 0xb0, 0xe0, 0xc1, 0x58, 0x6b, 0x0d, 0x8d, 0x7e,
+	0xf3, 0xd5, 0x31, 0x8b, 0x2d, 0x11, 0xc9, 0x03, 0x35, 0x8d, 0xee, 0xc3, 0x6e, 0xc4, 0x5a, 0x17,
+	0x7c, 0x8f, 0xbe, 0x83, 0xca, 0x55, 0x15, 0x0d, 0x01, 0x64, 0x06, 0xda, 0x70, 0xd8, 0xd1, 0x44,
+	0xd7, 0x7a, 0x28, 0xb2, 0x03, 0x20, 0x83, 0xb5, 0xc1, 0xc9, 0xb1, 0xa6, 0x26, 0x1e, 0xfd, 0x15,
+	0xa8, 0xab, 0xf9, 0xc8, 0xe4, 0x5a, 0x97, 0xc5, 0xa5, 0xfa, 0x01, 0xcb, 0x32, 0x19, 0xa4, 0xaa,
+	0xc2, 0x54, 0x34, 0x4f, 0x86, 0x3d, 0x35, 0xb1, 0xff, 0x7b, 0x1e, 0x32, 0xfc, 0xa3, 0xcd, 0x47,
+	0x3f, 0x42, 0x31, 0xf6, 0x03, 0xc0, 0x8b, 0x7d, 0x74, 0xf7, 0xda, 0x9f, 0x06, 0xaa, 0xe1, 0x5d,
+	0xa3, 0x84, 0x9f, 0x28, 0xe8, 0x00, 0x4a, 0xf1, 0xeb, 0xe2, 0x17, 0xfb, 0x28, 0xfe, 0x29, 0xb3,
+	0xe6, 0x26, 0x79, 0x8d, 0x8e, 0xe7, 0xa0, 0x6a, 0x01, 0xb5, 0x1c, 0xd6, 0x3b, 0xc9, 0x0b, 0x5d,
+	0x54, 0x8d, 0x17, 0xfd, 0xe5, 0x5b, 0xe2, 0xea, 0xee, 0x5a, 0x99, 0x3c, 0x86, 0x7e, 0x82, 0x7c,
+	0xec, 0x4a, 0xf5, 0xd2, 0x82, 0x96, 0xef, 0x71, 0xab, 0xf7, 0xae, 0x12, 0xcb, 0x2b, 0x9b, 0xe4,
+	0x3f, 0x25, 0xd8, 0x1a, 0x8b, 0x31, 0xd9, 0x1a, 0x2f, 0xad, 0x28, 0x5d, 0xd3, 0xcd, 0xb1, 0x1f,
+	0x64, 0xd6, 0x5c, 0xb7, 0xa2, 0x4f, 0x96, 0xcf, 0xb6, 0x2b, 0x2e, 0x6b, 0xab, 0x0f, 0x6e, 0xa2,
+	0xc9, 0xc5, 0x8f, 0x61, 0x6b, 0xcd, 0xbd, 0xec, 0xd2, 0x2c, 0x57, 0xdf, 0xea, 0x56, 0x1f, 0xdc,
+	0x44, 0x93, 0xb3, 0xfc, 0x0a, 0xb7, 0xd6, 0x5e, 0xae, 0xa2, 0x87, 0x31, 0x05, 0xd7, 0x5d, 0xe6,
+	0x56, 0x1b, 0x37, 0x13, 0xe5, 0x5c, 0x33, 0xd8, 0xb9, 0xe2, 0x36, 0x10, 0x7d, 0x1a, 0x53, 0x72,
+	0xfd, 0x9d, 0x62, 0xf5, 0xd1, 0xfb, 0x50, 0x17, 0x33, 0x0e, 0xde, 0x63, 0xc6, 0xc1, 0xfb, 0xcf,
+	0x78, 0xc3, 0xbd, 0x20, 0x7a, 0x0d, 0xea, 0xea, 0x45, 0x15, 0xaa, 0xaf, 0xee, 0xc5, 0xe5, 0x1b,
+	0xb3, 0xea, 0xc7, 0xd7, 0x72, 0xa4, 0x72, 0x1d, 0x60, 0x71, 0x97, 0x83, 0xee, 0xc4, 0x86, 0x5c,
+	0xba, 0xae, 0xaa, 0xde, 0xbd, 0x42, 0x2a, 0x55, 0x0d, 0x61, 0x6b, 0xcd, 0xe5, 0xce, 0x52, 0x74,
+	0x5d, 0x7d, 0xf9, 0x53, 0xdd, 0x5e, 0x77, 0xc5, 0xf1, 0x44, 0x41, 0xc7, 0x22, 0x61, 0xc3, 0x5f,
+	0x09, 0x6f, 0xa8, 0x40, 0x95, 0xf5, 0x1f, 0x5d, 0xf3, 0x80, 0xa7, 0xea, 0x13, 0x05, 0xf5, 0xa0,
+	0x10, 0xaf, 0x3a, 0x37, 0x96, 0xa3, 0x1b, 0x15, 0x4e, 0xa0, 0xbc, 0xd4, 0xf0, 0x7a, 0xfe, 0x52,
+	0x9c, 0x5f, 0xd7, 0x13, 0x57, 0x1f, 0xdc, 0x48, 0xe4, 0x46, 0x34, 0xd8, 0x3c, 0xaf, 0x41, 0x5d,
+	0xed, 0xa0, 0x96, 0xa2, 0xe0, 0x8a, 0x16, 0xaf, 0xfa, 0xf1, 0xb5, 0x1c, 0x61, 0xc8, 0xc1, 0x17,
+	0xbf, 0x3c, 0x3e, 0xb3, 0xe8, 0x74, 0x7e, 0xba, 0x37, 0xf2, 0x9c, 0xc7, 0xfc, 0x67, 0x38, 0xd7,
+	0x72, 0xcf, 0x5c, 0x42, 0xdf, 0x7a, 0xfe, 0xf9, 0x63, 0xdb, 0x1d, 0x3f, 0xb6, 0xdd, 0xc5, 0x5f,
+	0x25, 0xf8, 0xb3, 0xd1, 0x69, 0x86, 0xff, 0x0d, 0xc2, 0x97, 0xff, 0x3b, 0x00, 0xd5, 0xae, 0x87,
+	0x8d, 0xb3, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2746,7 +2900,9 @@ type RouterClient interface {
 	BuildRoute(ctx context.Context, in *BuildRouteRequest, opts ...grpc.CallOption) (*BuildRouteResponse, error)
 	//
 	//SubscribeHtlcEvents creates a uni-directional stream from the server to
-	//the client which delivers a stream of htlc events.
+	//the client which delivers a stream of htlc events. The caller can
+	//optionally specify a start_index, in which case we'll first send out all
+	//persisted htlc events with an index greater than the specified value.
 	SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Router_SubscribeHtlcEventsClient, error)
 	//
 	//Deprecated, use SendPaymentV2. SendPayment attempts to route a payment
@@ -3133,7 +3289,9 @@ type RouterServer interface {
 	BuildRoute(context.Context, *BuildRouteRequest) (*BuildRouteResponse, error)
 	//
 	//SubscribeHtlcEvents creates a uni-directional stream from the server to
-	//the client which delivers a stream of htlc events.
+	//the client which delivers a stream of htlc events. The caller can
+	//optionally specify a start_index, in which case we'll first send out all
+	//persisted htlc events with an index greater than the specified value.
 	SubscribeHtlcEvents(*SubscribeHtlcEventsRequest, Router_SubscribeHtlcEventsServer) error
 	//
 	//Deprecated, use SendPaymentV2. SendPayment attempts to route a payment
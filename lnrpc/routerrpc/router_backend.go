@@ -2,6 +2,7 @@ package routerrpc
 
 import (
 	"context"
+	"crypto/rand"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -31,6 +32,11 @@ const (
 	// TODO(roasbeef): make this value dynamic based on expected number of
 	// attempts for given amount
 	DefaultMaxParts = 16
+
+	// DefaultTrampolineCltvDelta is the default number of blocks that each
+	// trampoline node may use for its own cltv delta and the route towards
+	// the next trampoline node or the final destination.
+	DefaultTrampolineCltvDelta = 576
 )
 
 // RouterBackend contains the backend implementation of the router rpc sub
@@ -77,8 +83,10 @@ type RouterBackend struct {
 	DefaultFinalCltvDelta uint16
 
 	// SubscribeHtlcEvents returns a subscription client for the node's
-	// htlc events.
-	SubscribeHtlcEvents func() (*subscribe.Client, error)
+	// htlc events. If a non-zero start index is passed, the persisted
+	// events after this index are passed to the replay callback first.
+	SubscribeHtlcEvents func(startIndex uint64,
+		replay func(event interface{}) error) (*subscribe.Client, error)
 
 	// InterceptableForwarder exposes the ability to intercept forward events
 	// by letting the router register a ForwardInterceptor.
@@ -238,6 +246,10 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 	}
 	cltvLimit -= uint32(finalCLTVDelta)
 
+	if err := ValidateTimePref(in.TimePref); err != nil {
+		return nil, err
+	}
+
 	// Parse destination feature bits.
 	features, err := UnmarshalFeatures(in.DestFeatures)
 	if err != nil {
@@ -272,6 +284,7 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 		DestCustomRecords: record.CustomSet(in.DestCustomRecords),
 		CltvLimit:         cltvLimit,
 		DestFeatures:      features,
+		TimePref:          in.TimePref,
 	}
 
 	// Pass along an outgoing channel restriction if specified.
@@ -587,6 +600,13 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	}
 	payIntent.MaxParts = maxParts
 
+	// Take the time preference from the request. It only shifts the
+	// trade-off between fees and reliability in path finding.
+	if err := ValidateTimePref(rpcPayReq.TimePref); err != nil {
+		return nil, err
+	}
+	payIntent.TimePref = rpcPayReq.TimePref
+
 	// If this payment had a max shard amount specified, then we'll apply
 	// that now, which'll force us to always make payment splits smaller
 	// than this.
@@ -751,6 +771,16 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		payIntent.DestFeatures = features
 	}
 
+	// If trampoline nodes are specified, we only route the payment to the
+	// first trampoline and leave it up to the trampolines to reach the
+	// destination.
+	if len(rpcPayReq.TrampolineNodes) > 0 {
+		err := applyTrampoline(payIntent, rpcPayReq)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Check for disallowed payments to self.
 	if !rpcPayReq.AllowSelfPayment && payIntent.Target == r.SelfNode {
 		return nil, errors.New("self-payments not allowed")
@@ -759,6 +789,89 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	return payIntent, nil
 }
 
+// applyTrampoline turns the payment into a trampoline payment. The original
+// destination is moved into the trampoline instructions, while the payment
+// itself is sent to the first trampoline node with the trampoline fee and cltv
+// budget added on top.
+func applyTrampoline(payIntent *routing.LightningPayment,
+	rpcPayReq *SendPaymentRequest) error {
+
+	nodes := make([]route.Vertex, 0, len(rpcPayReq.TrampolineNodes))
+	for _, rpcNode := range rpcPayReq.TrampolineNodes {
+		node, err := route.NewVertexFromBytes(rpcNode)
+		if err != nil {
+			return err
+		}
+
+		if node == payIntent.Target {
+			return errors.New("trampoline node cannot be the " +
+				"destination")
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	switch {
+	case rpcPayReq.TrampolineFeeMsat < 0:
+		return errors.New("trampoline fee cannot be negative")
+
+	case rpcPayReq.TrampolineCltvDelta < 0:
+		return errors.New("trampoline cltv delta cannot be negative")
+
+	// Custom records would end up at the trampoline node rather than at
+	// the final destination.
+	case len(payIntent.DestCustomRecords) > 0:
+		return errors.New("custom records cannot be sent via a " +
+			"trampoline node")
+
+	// The trampoline instructions don't carry route hints, so the
+	// trampoline wouldn't be able to reach a private destination.
+	case len(payIntent.RouteHints) > 0:
+		return errors.New("route hints cannot be used with a " +
+			"trampoline node")
+
+	case payIntent.LastHop != nil:
+		return errors.New("last hop cannot be used with a " +
+			"trampoline node")
+	}
+
+	cltvDelta := uint32(rpcPayReq.TrampolineCltvDelta)
+	if cltvDelta == 0 {
+		cltvDelta = DefaultTrampolineCltvDelta * uint32(len(nodes))
+	}
+
+	finalCltvDelta := uint32(payIntent.FinalCLTVDelta) + cltvDelta
+	if finalCltvDelta > math.MaxUint16 {
+		return errors.New("trampoline cltv delta too large")
+	}
+
+	payIntent.Trampoline = &routing.TrampolineDestination{
+		Hops:           nodes[1:],
+		Target:         payIntent.Target,
+		Amount:         payIntent.Amount,
+		FinalCLTVDelta: payIntent.FinalCLTVDelta,
+		PaymentAddr:    payIntent.PaymentAddr,
+	}
+
+	payIntent.Target = nodes[0]
+	payIntent.Amount += lnwire.MilliSatoshi(rpcPayReq.TrampolineFeeMsat)
+	payIntent.FinalCLTVDelta = uint16(finalCltvDelta)
+
+	// The payment address and features of the destination are only
+	// relevant for the last trampoline node. We don't need the graph to
+	// reach the first trampoline node, because trampoline support implies
+	// the features that we need. A payment address of our own allows us
+	// to split the payment to it.
+	var paymentAddr [32]byte
+	if _, err := rand.Read(paymentAddr[:]); err != nil {
+		return err
+	}
+	payIntent.PaymentAddr = &paymentAddr
+	payIntent.DestFeatures = routing.TrampolineFeatures()
+
+	return nil
+}
+
 // unmarshallRouteHints unmarshalls a list of route hints.
 func unmarshallRouteHints(rpcRouteHints []*lnrpc.RouteHint) (
 	[][]zpay32.HopHint, error) {
@@ -853,6 +966,17 @@ func ValidateCLTVLimit(val, max uint32) (uint32, error) {
 	}
 }
 
+// ValidateTimePref returns an error if the given time preference is outside
+// of the range [-1, 1].
+func ValidateTimePref(timePref float64) error {
+	if timePref < -1 || timePref > 1 {
+		return fmt.Errorf("time preference %v out of range [-1, 1]",
+			timePref)
+	}
+
+	return nil
+}
+
 // UnmarshalMPP accepts the mpp_total_amt_msat and mpp_payment_addr fields from
 // an RPC request and converts into an record.MPP object. An error is returned
 // if the payment address is not 0 or 32 bytes. If the total amount and payment
@@ -908,6 +1032,7 @@ func (r *RouterBackend) MarshalHTLCAttempt(
 		AttemptId:     htlc.AttemptID,
 		AttemptTimeNs: MarshalTimeNano(htlc.AttemptTime),
 		Route:         route,
+		TimePref:      htlc.TimePref,
 	}
 
 	switch {
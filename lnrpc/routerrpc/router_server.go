@@ -834,7 +834,20 @@ func (s *Server) BuildRoute(ctx context.Context,
 func (s *Server) SubscribeHtlcEvents(req *SubscribeHtlcEventsRequest,
 	stream Router_SubscribeHtlcEventsServer) error {
 
-	htlcClient, err := s.cfg.RouterBackend.SubscribeHtlcEvents()
+	sendEvent := func(event interface{}) error {
+		rpcEvent, err := rpcHtlcEvent(event)
+		if err != nil {
+			return err
+		}
+
+		return stream.Send(rpcEvent)
+	}
+
+	// If a start index is specified, the events that the client missed
+	// are sent before the subscription is returned.
+	htlcClient, err := s.cfg.RouterBackend.SubscribeHtlcEvents(
+		req.StartIndex, sendEvent,
+	)
 	if err != nil {
 		return err
 	}
@@ -843,12 +856,7 @@ func (s *Server) SubscribeHtlcEvents(req *SubscribeHtlcEventsRequest,
 	for {
 		select {
 		case event := <-htlcClient.Updates():
-			rpcEvent, err := rpcHtlcEvent(event)
-			if err != nil {
-				return err
-			}
-
-			if err := stream.Send(rpcEvent); err != nil {
+			if err := sendEvent(event); err != nil {
 				return err
 			}
 
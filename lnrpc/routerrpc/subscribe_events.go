@@ -12,6 +12,7 @@ import (
 // rpcHtlcEvent returns a rpc htlc event from a htlcswitch event.
 func rpcHtlcEvent(htlcEvent interface{}) (*HtlcEvent, error) {
 	var (
+		index     uint64
 		key       htlcswitch.HtlcKey
 		timestamp time.Time
 		eventType htlcswitch.HtlcEventType
@@ -26,6 +27,7 @@ func rpcHtlcEvent(htlcEvent interface{}) (*HtlcEvent, error) {
 			},
 		}
 
+		index = e.Index
 		key = e.HtlcKey
 		eventType = e.HtlcEventType
 		timestamp = e.Timestamp
@@ -35,6 +37,7 @@ func rpcHtlcEvent(htlcEvent interface{}) (*HtlcEvent, error) {
 			ForwardFailEvent: &ForwardFailEvent{},
 		}
 
+		index = e.Index
 		key = e.HtlcKey
 		eventType = e.HtlcEventType
 		timestamp = e.Timestamp
@@ -56,6 +59,7 @@ func rpcHtlcEvent(htlcEvent interface{}) (*HtlcEvent, error) {
 			},
 		}
 
+		index = e.Index
 		key = e.HtlcKey
 		eventType = e.HtlcEventType
 		timestamp = e.Timestamp
@@ -65,6 +69,7 @@ func rpcHtlcEvent(htlcEvent interface{}) (*HtlcEvent, error) {
 			SettleEvent: &SettleEvent{},
 		}
 
+		index = e.Index
 		key = e.HtlcKey
 		eventType = e.HtlcEventType
 		timestamp = e.Timestamp
@@ -80,6 +85,7 @@ func rpcHtlcEvent(htlcEvent interface{}) (*HtlcEvent, error) {
 		OutgoingHtlcId:    key.OutgoingCircuit.HtlcID,
 		TimestampNs:       uint64(timestamp.UnixNano()),
 		Event:             event,
+		Index:             index,
 	}
 
 	// Convert the htlc event type to a rpc event.
@@ -194,6 +200,18 @@ func rpcFailureResolution(invoiceFailure invoices.FailResolutionResult) (
 	case invoices.ResultMppInProgress:
 		return FailureDetail_MPP_IN_PROGRESS, nil
 
+	case invoices.ResultHtlcAcceptorCanceled:
+		return FailureDetail_HTLC_ACCEPTOR_CANCELED, nil
+
+	case invoices.ResultPolicyExpiryTooSoon,
+		invoices.ResultPolicyMissingRecord,
+		invoices.ResultPolicyRecordMismatch:
+
+		return FailureDetail_INVOICE_POLICY_REJECTED, nil
+
+	case invoices.ResultHoldExpiryTooSoon:
+		return FailureDetail_HOLD_INVOICE_EXPIRY, nil
+
 	default:
 		return 0, fmt.Errorf("unknown fail resolution: %v",
 			invoiceFailure.FailureString())
@@ -238,6 +256,9 @@ func rpcOutgoingFailure(failureDetail htlcswitch.OutgoingFailure) (
 	case htlcswitch.OutgoingFailureForwardsDisabled:
 		return FailureDetail_FORWARDS_DISABLED, nil
 
+	case htlcswitch.FailureDustExposure:
+		return FailureDetail_DUST_EXPOSURE, nil
+
 	default:
 		return 0, fmt.Errorf("unknown outgoing failure "+
 			"detail: %v", failureDetail.FailureString())
@@ -154,6 +154,10 @@ const (
 	ResolutionType_OUTGOING_HTLC ResolutionType = 3
 	// We force closed and need to sweep our time locked commitment output.
 	ResolutionType_COMMIT ResolutionType = 4
+	//
+	//The remote party broadcast a revoked commitment, and we resolved one of
+	//its outputs with a justice transaction.
+	ResolutionType_BREACH ResolutionType = 5
 )
 
 var ResolutionType_name = map[int32]string{
@@ -162,6 +166,7 @@ var ResolutionType_name = map[int32]string{
 	2: "INCOMING_HTLC",
 	3: "OUTGOING_HTLC",
 	4: "COMMIT",
+	5: "BREACH",
 }
 
 var ResolutionType_value = map[string]int32{
@@ -170,6 +175,7 @@ var ResolutionType_value = map[string]int32{
 	"INCOMING_HTLC": 2,
 	"OUTGOING_HTLC": 3,
 	"COMMIT":        4,
+	"BREACH":        5,
 }
 
 func (x ResolutionType) String() string {
@@ -360,6 +366,8 @@ const (
 	FeatureBit_ANCHORS_OPT                 FeatureBit = 21
 	FeatureBit_ANCHORS_ZERO_FEE_HTLC_REQ   FeatureBit = 22
 	FeatureBit_ANCHORS_ZERO_FEE_HTLC_OPT   FeatureBit = 23
+	FeatureBit_TRAMPOLINE_ROUTING_REQ      FeatureBit = 56
+	FeatureBit_TRAMPOLINE_ROUTING_OPT      FeatureBit = 57
 )
 
 var FeatureBit_name = map[int32]string{
@@ -386,6 +394,8 @@ var FeatureBit_name = map[int32]string{
 	21: "ANCHORS_OPT",
 	22: "ANCHORS_ZERO_FEE_HTLC_REQ",
 	23: "ANCHORS_ZERO_FEE_HTLC_OPT",
+	56: "TRAMPOLINE_ROUTING_REQ",
+	57: "TRAMPOLINE_ROUTING_OPT",
 }
 
 var FeatureBit_value = map[string]int32{
@@ -412,6 +422,8 @@ var FeatureBit_value = map[string]int32{
 	"ANCHORS_OPT":                 21,
 	"ANCHORS_ZERO_FEE_HTLC_REQ":   22,
 	"ANCHORS_ZERO_FEE_HTLC_OPT":   23,
+	"TRAMPOLINE_ROUTING_REQ":      56,
+	"TRAMPOLINE_ROUTING_OPT":      57,
 }
 
 func (x FeatureBit) String() string {
@@ -425,12 +437,13 @@ func (FeatureBit) EnumDescriptor() ([]byte, []int) {
 type ChannelCloseSummary_ClosureType int32
 
 const (
-	ChannelCloseSummary_COOPERATIVE_CLOSE  ChannelCloseSummary_ClosureType = 0
-	ChannelCloseSummary_LOCAL_FORCE_CLOSE  ChannelCloseSummary_ClosureType = 1
-	ChannelCloseSummary_REMOTE_FORCE_CLOSE ChannelCloseSummary_ClosureType = 2
-	ChannelCloseSummary_BREACH_CLOSE       ChannelCloseSummary_ClosureType = 3
-	ChannelCloseSummary_FUNDING_CANCELED   ChannelCloseSummary_ClosureType = 4
-	ChannelCloseSummary_ABANDONED          ChannelCloseSummary_ClosureType = 5
+	ChannelCloseSummary_COOPERATIVE_CLOSE    ChannelCloseSummary_ClosureType = 0
+	ChannelCloseSummary_LOCAL_FORCE_CLOSE    ChannelCloseSummary_ClosureType = 1
+	ChannelCloseSummary_REMOTE_FORCE_CLOSE   ChannelCloseSummary_ClosureType = 2
+	ChannelCloseSummary_BREACH_CLOSE         ChannelCloseSummary_ClosureType = 3
+	ChannelCloseSummary_FUNDING_CANCELED     ChannelCloseSummary_ClosureType = 4
+	ChannelCloseSummary_ABANDONED            ChannelCloseSummary_ClosureType = 5
+	ChannelCloseSummary_FUNDING_DOUBLE_SPENT ChannelCloseSummary_ClosureType = 6
 )
 
 var ChannelCloseSummary_ClosureType_name = map[int32]string{
@@ -440,15 +453,17 @@ var ChannelCloseSummary_ClosureType_name = map[int32]string{
 	3: "BREACH_CLOSE",
 	4: "FUNDING_CANCELED",
 	5: "ABANDONED",
+	6: "FUNDING_DOUBLE_SPENT",
 }
 
 var ChannelCloseSummary_ClosureType_value = map[string]int32{
-	"COOPERATIVE_CLOSE":  0,
-	"LOCAL_FORCE_CLOSE":  1,
-	"REMOTE_FORCE_CLOSE": 2,
-	"BREACH_CLOSE":       3,
-	"FUNDING_CANCELED":   4,
-	"ABANDONED":          5,
+	"COOPERATIVE_CLOSE":    0,
+	"LOCAL_FORCE_CLOSE":    1,
+	"REMOTE_FORCE_CLOSE":   2,
+	"BREACH_CLOSE":         3,
+	"FUNDING_CANCELED":     4,
+	"ABANDONED":            5,
+	"FUNDING_DOUBLE_SPENT": 6,
 }
 
 func (x ChannelCloseSummary_ClosureType) String() string {
@@ -495,7 +510,7 @@ func (x Peer_SyncType) String() string {
 }
 
 func (Peer_SyncType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{40, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{44, 0}
 }
 
 type PeerEvent_EventType int32
@@ -520,7 +535,7 @@ func (x PeerEvent_EventType) String() string {
 }
 
 func (PeerEvent_EventType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{45, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{49, 0}
 }
 
 type PendingChannelsResponse_ForceClosedChannel_AnchorState int32
@@ -548,7 +563,7 @@ func (x PendingChannelsResponse_ForceClosedChannel_AnchorState) String() string
 }
 
 func (PendingChannelsResponse_ForceClosedChannel_AnchorState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72, 5, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{79, 5, 0}
 }
 
 type ChannelEventUpdate_UpdateType int32
@@ -582,7 +597,7 @@ func (x ChannelEventUpdate_UpdateType) String() string {
 }
 
 func (ChannelEventUpdate_UpdateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{74, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{81, 0}
 }
 
 type Invoice_InvoiceState int32
@@ -613,7 +628,48 @@ func (x Invoice_InvoiceState) String() string {
 }
 
 func (Invoice_InvoiceState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{111, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{118, 0}
+}
+
+type InvoiceHtlcRejection_Reason int32
+
+const (
+	InvoiceHtlcRejection_UNKNOWN InvoiceHtlcRejection_Reason = 0
+	// The htlc expires sooner than the policy allows.
+	InvoiceHtlcRejection_EXPIRY_TOO_SOON InvoiceHtlcRejection_Reason = 1
+	// The htlc lacks a custom record that the policy requires.
+	InvoiceHtlcRejection_MISSING_CUSTOM_RECORD InvoiceHtlcRejection_Reason = 2
+	// A custom record of the htlc doesn't have the required value.
+	InvoiceHtlcRejection_CUSTOM_RECORD_MISMATCH InvoiceHtlcRejection_Reason = 3
+	//
+	//The accepted hold invoice was neither settled nor canceled before the
+	//htlc came within the hold expiry delta of its expiry, and was canceled
+	//automatically.
+	InvoiceHtlcRejection_HOLD_EXPIRY_TOO_SOON InvoiceHtlcRejection_Reason = 4
+)
+
+var InvoiceHtlcRejection_Reason_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "EXPIRY_TOO_SOON",
+	2: "MISSING_CUSTOM_RECORD",
+	3: "CUSTOM_RECORD_MISMATCH",
+	4: "HOLD_EXPIRY_TOO_SOON",
+}
+
+var InvoiceHtlcRejection_Reason_value = map[string]int32{
+	"UNKNOWN":                0,
+	"EXPIRY_TOO_SOON":        1,
+	"MISSING_CUSTOM_RECORD":  2,
+	"CUSTOM_RECORD_MISMATCH": 3,
+	"HOLD_EXPIRY_TOO_SOON":   4,
+}
+
+func (x InvoiceHtlcRejection_Reason) String() string {
+	return proto.EnumName(InvoiceHtlcRejection_Reason_name, int32(x))
+}
+
+func (InvoiceHtlcRejection_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{121, 0}
 }
 
 type Payment_PaymentStatus int32
@@ -644,7 +700,7 @@ func (x Payment_PaymentStatus) String() string {
 }
 
 func (Payment_PaymentStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{119, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{127, 0}
 }
 
 type HTLCAttempt_HTLCStatus int32
@@ -672,7 +728,42 @@ func (x HTLCAttempt_HTLCStatus) String() string {
 }
 
 func (HTLCAttempt_HTLCStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{120, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{128, 0}
+}
+
+type ForwardingHistoryStatsRequest_Interval int32
+
+const (
+	// A single bucket covering the whole time range.
+	ForwardingHistoryStatsRequest_TOTAL ForwardingHistoryStatsRequest_Interval = 0
+	// Hourly buckets.
+	ForwardingHistoryStatsRequest_HOUR ForwardingHistoryStatsRequest_Interval = 1
+	// Daily buckets, starting at midnight UTC.
+	ForwardingHistoryStatsRequest_DAY ForwardingHistoryStatsRequest_Interval = 2
+	// Weekly buckets, starting on Monday at midnight UTC.
+	ForwardingHistoryStatsRequest_WEEK ForwardingHistoryStatsRequest_Interval = 3
+)
+
+var ForwardingHistoryStatsRequest_Interval_name = map[int32]string{
+	0: "TOTAL",
+	1: "HOUR",
+	2: "DAY",
+	3: "WEEK",
+}
+
+var ForwardingHistoryStatsRequest_Interval_value = map[string]int32{
+	"TOTAL": 0,
+	"HOUR":  1,
+	"DAY":   2,
+	"WEEK":  3,
+}
+
+func (x ForwardingHistoryStatsRequest_Interval) String() string {
+	return proto.EnumName(ForwardingHistoryStatsRequest_Interval_name, int32(x))
+}
+
+func (ForwardingHistoryStatsRequest_Interval) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{153, 0}
 }
 
 type Failure_FailureCode int32
@@ -786,7 +877,7 @@ func (x Failure_FailureCode) String() string {
 }
 
 func (Failure_FailureCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{160, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{179, 0}
 }
 
 type Utxo struct {
@@ -1571,7 +1662,11 @@ type ChannelAcceptRequest struct {
 	MaxAcceptedHtlcs uint32 `protobuf:"varint,12,opt,name=max_accepted_htlcs,json=maxAcceptedHtlcs,proto3" json:"max_accepted_htlcs,omitempty"`
 	// A bit-field which the initiator uses to specify proposed channel
 	// behavior.
-	ChannelFlags         uint32   `protobuf:"varint,13,opt,name=channel_flags,json=channelFlags,proto3" json:"channel_flags,omitempty"`
+	ChannelFlags uint32 `protobuf:"varint,13,opt,name=channel_flags,json=channelFlags,proto3" json:"channel_flags,omitempty"`
+	// Whether the initiator wants to open a zero-conf channel, which can be
+	// used before the funding transaction confirms. Such a channel is only
+	// opened if the response explicitly sets zero_conf.
+	WantsZeroConf        bool     `protobuf:"varint,14,opt,name=wants_zero_conf,json=wantsZeroConf,proto3" json:"wants_zero_conf,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1693,6 +1788,13 @@ func (m *ChannelAcceptRequest) GetChannelFlags() uint32 {
 	return 0
 }
 
+func (m *ChannelAcceptRequest) GetWantsZeroConf() bool {
+	if m != nil {
+		return m.WantsZeroConf
+	}
+	return false
+}
+
 type ChannelAcceptResponse struct {
 	// Whether or not the client accepts the channel.
 	Accept bool `protobuf:"varint,1,opt,name=accept,proto3" json:"accept,omitempty"`
@@ -1733,7 +1835,14 @@ type ChannelAcceptResponse struct {
 	MinHtlcIn uint64 `protobuf:"varint,9,opt,name=min_htlc_in,json=minHtlcIn,proto3" json:"min_htlc_in,omitempty"`
 	//
 	//The number of confirmations we require before we consider the channel open.
-	MinAcceptDepth       uint32   `protobuf:"varint,10,opt,name=min_accept_depth,json=minAcceptDepth,proto3" json:"min_accept_depth,omitempty"`
+	//Must not be set if zero_conf is set.
+	MinAcceptDepth uint32 `protobuf:"varint,10,opt,name=min_accept_depth,json=minAcceptDepth,proto3" json:"min_accept_depth,omitempty"`
+	//
+	//Whether we consent to the zero-conf channel the initiator requested. This
+	//means we trust the initiator not to double spend the funding transaction,
+	//as the channel can be used before it confirms. A zero-conf request is
+	//rejected unless this is set.
+	ZeroConf             bool     `protobuf:"varint,11,opt,name=zero_conf,json=zeroConf,proto3" json:"zero_conf,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1834,6 +1943,13 @@ func (m *ChannelAcceptResponse) GetMinAcceptDepth() uint32 {
 	return 0
 }
 
+func (m *ChannelAcceptResponse) GetZeroConf() bool {
+	if m != nil {
+		return m.ZeroConf
+	}
+	return false
+}
+
 type ChannelPoint struct {
 	// Types that are valid to be assigned to FundingTxid:
 	//	*ChannelPoint_FundingTxidBytes
@@ -3266,10 +3382,15 @@ type Channel struct {
 	// List constraints for the local node.
 	LocalConstraints *ChannelConstraints `protobuf:"bytes,29,opt,name=local_constraints,json=localConstraints,proto3" json:"local_constraints,omitempty"`
 	// List constraints for the remote node.
-	RemoteConstraints    *ChannelConstraints `protobuf:"bytes,30,opt,name=remote_constraints,json=remoteConstraints,proto3" json:"remote_constraints,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	RemoteConstraints *ChannelConstraints `protobuf:"bytes,30,opt,name=remote_constraints,json=remoteConstraints,proto3" json:"remote_constraints,omitempty"`
+	//
+	//Whether the channel is a zero-conf channel. A zero-conf channel is
+	//identified by an alias short channel ID, which it keeps after its funding
+	//transaction confirms, as the forwarding state of the channel is keyed by it.
+	ZeroConf             bool     `protobuf:"varint,31,opt,name=zero_conf,json=zeroConf,proto3" json:"zero_conf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Channel) Reset()         { *m = Channel{} }
@@ -3511,6 +3632,13 @@ func (m *Channel) GetRemoteConstraints() *ChannelConstraints {
 	return nil
 }
 
+func (m *Channel) GetZeroConf() bool {
+	if m != nil {
+		return m.ZeroConf
+	}
+	return false
+}
+
 type ListChannelsRequest struct {
 	ActiveOnly   bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
 	InactiveOnly bool `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly,proto3" json:"inactive_only,omitempty"`
@@ -3791,7 +3919,11 @@ type Resolution struct {
 	AmountSat uint64 `protobuf:"varint,4,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
 	// The hex-encoded transaction ID of the sweep transaction that spent the
 	// output.
-	SweepTxid            string   `protobuf:"bytes,5,opt,name=sweep_txid,json=sweepTxid,proto3" json:"sweep_txid,omitempty"`
+	SweepTxid string `protobuf:"bytes,5,opt,name=sweep_txid,json=sweepTxid,proto3" json:"sweep_txid,omitempty"`
+	//
+	//The unix timestamp at which the resolution was recorded. Zero for
+	//resolutions that were recorded before timestamps were tracked.
+	TimeStamp            int64    `protobuf:"varint,6,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3857,6 +3989,13 @@ func (m *Resolution) GetSweepTxid() string {
 	return ""
 }
 
+func (m *Resolution) GetTimeStamp() int64 {
+	if m != nil {
+		return m.TimeStamp
+	}
+	return 0
+}
+
 type ClosedChannelsRequest struct {
 	Cooperative          bool     `protobuf:"varint,1,opt,name=cooperative,proto3" json:"cooperative,omitempty"`
 	LocalForce           bool     `protobuf:"varint,2,opt,name=local_force,json=localForce,proto3" json:"local_force,omitempty"`
@@ -3864,6 +4003,7 @@ type ClosedChannelsRequest struct {
 	Breach               bool     `protobuf:"varint,4,opt,name=breach,proto3" json:"breach,omitempty"`
 	FundingCanceled      bool     `protobuf:"varint,5,opt,name=funding_canceled,json=fundingCanceled,proto3" json:"funding_canceled,omitempty"`
 	Abandoned            bool     `protobuf:"varint,6,opt,name=abandoned,proto3" json:"abandoned,omitempty"`
+	FundingDoubleSpent   bool     `protobuf:"varint,7,opt,name=funding_double_spent,json=fundingDoubleSpent,proto3" json:"funding_double_spent,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3936,6 +4076,13 @@ func (m *ClosedChannelsRequest) GetAbandoned() bool {
 	return false
 }
 
+func (m *ClosedChannelsRequest) GetFundingDoubleSpent() bool {
+	if m != nil {
+		return m.FundingDoubleSpent
+	}
+	return false
+}
+
 type ClosedChannelsResponse struct {
 	Channels             []*ChannelCloseSummary `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -3975,6 +4122,309 @@ func (m *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
 	return nil
 }
 
+type ChannelCloseReportRequest struct {
+	// The channel point of the closed channel.
+	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ChannelCloseReportRequest) Reset()         { *m = ChannelCloseReportRequest{} }
+func (m *ChannelCloseReportRequest) String() string { return proto.CompactTextString(m) }
+func (*ChannelCloseReportRequest) ProtoMessage()    {}
+func (*ChannelCloseReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{40}
+}
+
+func (m *ChannelCloseReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelCloseReportRequest.Unmarshal(m, b)
+}
+func (m *ChannelCloseReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelCloseReportRequest.Marshal(b, m, deterministic)
+}
+func (m *ChannelCloseReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelCloseReportRequest.Merge(m, src)
+}
+func (m *ChannelCloseReportRequest) XXX_Size() int {
+	return xxx_messageInfo_ChannelCloseReportRequest.Size(m)
+}
+func (m *ChannelCloseReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelCloseReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelCloseReportRequest proto.InternalMessageInfo
+
+func (m *ChannelCloseReportRequest) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+type CloseOutputReport struct {
+	// The index of the output within the closing transaction.
+	OutputIndex uint32 `protobuf:"varint,1,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	// The value of the output.
+	AmountSat int64 `protobuf:"varint,2,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
+	// The hex-encoded public key script of the output.
+	PkScript string `protobuf:"bytes,3,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	//
+	//The resolutions that handled the output, in the order in which they
+	//happened. Outputs that were resolved in two stages, such as HTLCs on our
+	//own commitment, list the resolution of the second level output after the
+	//first stage. Outputs we didn't have to resolve, such as those of a
+	//cooperative close, have no resolutions.
+	Resolutions          []*Resolution `protobuf:"bytes,4,rep,name=resolutions,proto3" json:"resolutions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CloseOutputReport) Reset()         { *m = CloseOutputReport{} }
+func (m *CloseOutputReport) String() string { return proto.CompactTextString(m) }
+func (*CloseOutputReport) ProtoMessage()    {}
+func (*CloseOutputReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{41}
+}
+
+func (m *CloseOutputReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseOutputReport.Unmarshal(m, b)
+}
+func (m *CloseOutputReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseOutputReport.Marshal(b, m, deterministic)
+}
+func (m *CloseOutputReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseOutputReport.Merge(m, src)
+}
+func (m *CloseOutputReport) XXX_Size() int {
+	return xxx_messageInfo_CloseOutputReport.Size(m)
+}
+func (m *CloseOutputReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseOutputReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseOutputReport proto.InternalMessageInfo
+
+func (m *CloseOutputReport) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
+}
+
+func (m *CloseOutputReport) GetAmountSat() int64 {
+	if m != nil {
+		return m.AmountSat
+	}
+	return 0
+}
+
+func (m *CloseOutputReport) GetPkScript() string {
+	if m != nil {
+		return m.PkScript
+	}
+	return ""
+}
+
+func (m *CloseOutputReport) GetResolutions() []*Resolution {
+	if m != nil {
+		return m.Resolutions
+	}
+	return nil
+}
+
+type SweepReport struct {
+	// The hex-encoded transaction ID of the sweep transaction.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	//
+	//The fee paid by the transaction. Only set if the values of all inputs of
+	//the transaction are known.
+	FeeSat int64 `protobuf:"varint,2,opt,name=fee_sat,json=feeSat,proto3" json:"fee_sat,omitempty"`
+	// Whether the fee of the transaction could be determined.
+	FeeKnown bool `protobuf:"varint,3,opt,name=fee_known,json=feeKnown,proto3" json:"fee_known,omitempty"`
+	//
+	//The net amount the transaction paid to our wallet, or zero if the
+	//transaction isn't known to the wallet.
+	RecoveredSat int64 `protobuf:"varint,4,opt,name=recovered_sat,json=recoveredSat,proto3" json:"recovered_sat,omitempty"`
+	// The height at which the transaction confirmed, or zero if unknown.
+	BlockHeight int32 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The unix timestamp of the transaction as recorded by the wallet.
+	TimeStamp            int64    `protobuf:"varint,6,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SweepReport) Reset()         { *m = SweepReport{} }
+func (m *SweepReport) String() string { return proto.CompactTextString(m) }
+func (*SweepReport) ProtoMessage()    {}
+func (*SweepReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{42}
+}
+
+func (m *SweepReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SweepReport.Unmarshal(m, b)
+}
+func (m *SweepReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SweepReport.Marshal(b, m, deterministic)
+}
+func (m *SweepReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SweepReport.Merge(m, src)
+}
+func (m *SweepReport) XXX_Size() int {
+	return xxx_messageInfo_SweepReport.Size(m)
+}
+func (m *SweepReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_SweepReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SweepReport proto.InternalMessageInfo
+
+func (m *SweepReport) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *SweepReport) GetFeeSat() int64 {
+	if m != nil {
+		return m.FeeSat
+	}
+	return 0
+}
+
+func (m *SweepReport) GetFeeKnown() bool {
+	if m != nil {
+		return m.FeeKnown
+	}
+	return false
+}
+
+func (m *SweepReport) GetRecoveredSat() int64 {
+	if m != nil {
+		return m.RecoveredSat
+	}
+	return 0
+}
+
+func (m *SweepReport) GetBlockHeight() int32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SweepReport) GetTimeStamp() int64 {
+	if m != nil {
+		return m.TimeStamp
+	}
+	return 0
+}
+
+type ChannelCloseReportResponse struct {
+	// The summary of the closed channel.
+	Summary *ChannelCloseSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	//
+	//The outputs of the closing transaction and their resolutions. This is
+	//empty if the closing transaction couldn't be retrieved.
+	Outputs []*CloseOutputReport `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	//
+	//The resolutions that couldn't be attributed to an output of the closing
+	//transaction.
+	UnmatchedResolutions []*Resolution `protobuf:"bytes,3,rep,name=unmatched_resolutions,json=unmatchedResolutions,proto3" json:"unmatched_resolutions,omitempty"`
+	// The fee paid by the closing transaction, if it could be retrieved.
+	ClosingFeeSat int64 `protobuf:"varint,4,opt,name=closing_fee_sat,json=closingFeeSat,proto3" json:"closing_fee_sat,omitempty"`
+	//
+	//The transactions that resolved outputs of the channel on our behalf,
+	//including second level HTLC transactions and justice transactions.
+	Sweeps []*SweepReport `protobuf:"bytes,5,rep,name=sweeps,proto3" json:"sweeps,omitempty"`
+	//
+	//The total amount that the closing transaction and the sweeps paid to our
+	//wallet.
+	TotalRecoveredSat int64 `protobuf:"varint,6,opt,name=total_recovered_sat,json=totalRecoveredSat,proto3" json:"total_recovered_sat,omitempty"`
+	// The total fees paid by the sweeps whose fee is known.
+	TotalSweepFeesSat    int64    `protobuf:"varint,7,opt,name=total_sweep_fees_sat,json=totalSweepFeesSat,proto3" json:"total_sweep_fees_sat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelCloseReportResponse) Reset()         { *m = ChannelCloseReportResponse{} }
+func (m *ChannelCloseReportResponse) String() string { return proto.CompactTextString(m) }
+func (*ChannelCloseReportResponse) ProtoMessage()    {}
+func (*ChannelCloseReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{43}
+}
+
+func (m *ChannelCloseReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelCloseReportResponse.Unmarshal(m, b)
+}
+func (m *ChannelCloseReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelCloseReportResponse.Marshal(b, m, deterministic)
+}
+func (m *ChannelCloseReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelCloseReportResponse.Merge(m, src)
+}
+func (m *ChannelCloseReportResponse) XXX_Size() int {
+	return xxx_messageInfo_ChannelCloseReportResponse.Size(m)
+}
+func (m *ChannelCloseReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelCloseReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelCloseReportResponse proto.InternalMessageInfo
+
+func (m *ChannelCloseReportResponse) GetSummary() *ChannelCloseSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
+func (m *ChannelCloseReportResponse) GetOutputs() []*CloseOutputReport {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *ChannelCloseReportResponse) GetUnmatchedResolutions() []*Resolution {
+	if m != nil {
+		return m.UnmatchedResolutions
+	}
+	return nil
+}
+
+func (m *ChannelCloseReportResponse) GetClosingFeeSat() int64 {
+	if m != nil {
+		return m.ClosingFeeSat
+	}
+	return 0
+}
+
+func (m *ChannelCloseReportResponse) GetSweeps() []*SweepReport {
+	if m != nil {
+		return m.Sweeps
+	}
+	return nil
+}
+
+func (m *ChannelCloseReportResponse) GetTotalRecoveredSat() int64 {
+	if m != nil {
+		return m.TotalRecoveredSat
+	}
+	return 0
+}
+
+func (m *ChannelCloseReportResponse) GetTotalSweepFeesSat() int64 {
+	if m != nil {
+		return m.TotalSweepFeesSat
+	}
+	return 0
+}
+
 type Peer struct {
 	// The identity pubkey of the peer
 	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
@@ -4022,7 +4472,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{40}
+	return fileDescriptor_77a6da22d6a3feb1, []int{44}
 }
 
 func (m *Peer) XXX_Unmarshal(b []byte) error {
@@ -4148,7 +4598,7 @@ func (m *TimestampedError) Reset()         { *m = TimestampedError{} }
 func (m *TimestampedError) String() string { return proto.CompactTextString(m) }
 func (*TimestampedError) ProtoMessage()    {}
 func (*TimestampedError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{41}
+	return fileDescriptor_77a6da22d6a3feb1, []int{45}
 }
 
 func (m *TimestampedError) XXX_Unmarshal(b []byte) error {
@@ -4198,7 +4648,7 @@ func (m *ListPeersRequest) Reset()         { *m = ListPeersRequest{} }
 func (m *ListPeersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPeersRequest) ProtoMessage()    {}
 func (*ListPeersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{42}
+	return fileDescriptor_77a6da22d6a3feb1, []int{46}
 }
 
 func (m *ListPeersRequest) XXX_Unmarshal(b []byte) error {
@@ -4238,7 +4688,7 @@ func (m *ListPeersResponse) Reset()         { *m = ListPeersResponse{} }
 func (m *ListPeersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPeersResponse) ProtoMessage()    {}
 func (*ListPeersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{43}
+	return fileDescriptor_77a6da22d6a3feb1, []int{47}
 }
 
 func (m *ListPeersResponse) XXX_Unmarshal(b []byte) error {
@@ -4276,7 +4726,7 @@ func (m *PeerEventSubscription) Reset()         { *m = PeerEventSubscription{} }
 func (m *PeerEventSubscription) String() string { return proto.CompactTextString(m) }
 func (*PeerEventSubscription) ProtoMessage()    {}
 func (*PeerEventSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{44}
+	return fileDescriptor_77a6da22d6a3feb1, []int{48}
 }
 
 func (m *PeerEventSubscription) XXX_Unmarshal(b []byte) error {
@@ -4310,7 +4760,7 @@ func (m *PeerEvent) Reset()         { *m = PeerEvent{} }
 func (m *PeerEvent) String() string { return proto.CompactTextString(m) }
 func (*PeerEvent) ProtoMessage()    {}
 func (*PeerEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{45}
+	return fileDescriptor_77a6da22d6a3feb1, []int{49}
 }
 
 func (m *PeerEvent) XXX_Unmarshal(b []byte) error {
@@ -4355,7 +4805,7 @@ func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
 func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetInfoRequest) ProtoMessage()    {}
 func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{46}
+	return fileDescriptor_77a6da22d6a3feb1, []int{50}
 }
 
 func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -4426,7 +4876,7 @@ func (m *GetInfoResponse) Reset()         { *m = GetInfoResponse{} }
 func (m *GetInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetInfoResponse) ProtoMessage()    {}
 func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{47}
+	return fileDescriptor_77a6da22d6a3feb1, []int{51}
 }
 
 func (m *GetInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -4584,7 +5034,7 @@ func (m *GetRecoveryInfoRequest) Reset()         { *m = GetRecoveryInfoRequest{}
 func (m *GetRecoveryInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoRequest) ProtoMessage()    {}
 func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{48}
+	return fileDescriptor_77a6da22d6a3feb1, []int{52}
 }
 
 func (m *GetRecoveryInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -4621,7 +5071,7 @@ func (m *GetRecoveryInfoResponse) Reset()         { *m = GetRecoveryInfoResponse
 func (m *GetRecoveryInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoResponse) ProtoMessage()    {}
 func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{49}
+	return fileDescriptor_77a6da22d6a3feb1, []int{53}
 }
 
 func (m *GetRecoveryInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -4677,7 +5127,7 @@ func (m *Chain) Reset()         { *m = Chain{} }
 func (m *Chain) String() string { return proto.CompactTextString(m) }
 func (*Chain) ProtoMessage()    {}
 func (*Chain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{50}
+	return fileDescriptor_77a6da22d6a3feb1, []int{54}
 }
 
 func (m *Chain) XXX_Unmarshal(b []byte) error {
@@ -4725,7 +5175,7 @@ func (m *ConfirmationUpdate) Reset()         { *m = ConfirmationUpdate{} }
 func (m *ConfirmationUpdate) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationUpdate) ProtoMessage()    {}
 func (*ConfirmationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{51}
+	return fileDescriptor_77a6da22d6a3feb1, []int{55}
 }
 
 func (m *ConfirmationUpdate) XXX_Unmarshal(b []byte) error {
@@ -4778,7 +5228,7 @@ func (m *ChannelOpenUpdate) Reset()         { *m = ChannelOpenUpdate{} }
 func (m *ChannelOpenUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelOpenUpdate) ProtoMessage()    {}
 func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{52}
+	return fileDescriptor_77a6da22d6a3feb1, []int{56}
 }
 
 func (m *ChannelOpenUpdate) XXX_Unmarshal(b []byte) error {
@@ -4818,7 +5268,7 @@ func (m *ChannelCloseUpdate) Reset()         { *m = ChannelCloseUpdate{} }
 func (m *ChannelCloseUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelCloseUpdate) ProtoMessage()    {}
 func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{53}
+	return fileDescriptor_77a6da22d6a3feb1, []int{57}
 }
 
 func (m *ChannelCloseUpdate) XXX_Unmarshal(b []byte) error {
@@ -4877,7 +5327,19 @@ type CloseChannelRequest struct {
 	DeliveryAddress string `protobuf:"bytes,5,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"`
 	// A manual fee rate set in sat/vbyte that should be used when crafting the
 	// closure transaction.
-	SatPerVbyte          uint64   `protobuf:"varint,6,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	SatPerVbyte uint64 `protobuf:"varint,6,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	//
+	//The maximum fee rate in sat/vbyte we're willing to propose or accept for
+	//the cooperative closure transaction. If set, the fee range is sent to the
+	//remote party, and the negotiation fails if it doesn't overlap with theirs.
+	//If not set, a multiple of the target fee rate is used when we pay for the
+	//closure transaction.
+	MaxFeePerVbyte uint64 `protobuf:"varint,7,opt,name=max_fee_per_vbyte,json=maxFeePerVbyte,proto3" json:"max_fee_per_vbyte,omitempty"`
+	//
+	//The minimum fee rate in sat/vbyte we're willing to propose or accept for
+	//the cooperative closure transaction. If set, the fee range is sent to the
+	//remote party, and the negotiation fails if it doesn't overlap with theirs.
+	MinFeePerVbyte       uint64   `protobuf:"varint,8,opt,name=min_fee_per_vbyte,json=minFeePerVbyte,proto3" json:"min_fee_per_vbyte,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4887,7 +5349,7 @@ func (m *CloseChannelRequest) Reset()         { *m = CloseChannelRequest{} }
 func (m *CloseChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseChannelRequest) ProtoMessage()    {}
 func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{54}
+	return fileDescriptor_77a6da22d6a3feb1, []int{58}
 }
 
 func (m *CloseChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -4951,6 +5413,20 @@ func (m *CloseChannelRequest) GetSatPerVbyte() uint64 {
 	return 0
 }
 
+func (m *CloseChannelRequest) GetMaxFeePerVbyte() uint64 {
+	if m != nil {
+		return m.MaxFeePerVbyte
+	}
+	return 0
+}
+
+func (m *CloseChannelRequest) GetMinFeePerVbyte() uint64 {
+	if m != nil {
+		return m.MinFeePerVbyte
+	}
+	return 0
+}
+
 type CloseStatusUpdate struct {
 	// Types that are valid to be assigned to Update:
 	//	*CloseStatusUpdate_ClosePending
@@ -4965,7 +5441,7 @@ func (m *CloseStatusUpdate) Reset()         { *m = CloseStatusUpdate{} }
 func (m *CloseStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*CloseStatusUpdate) ProtoMessage()    {}
 func (*CloseStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{55}
+	return fileDescriptor_77a6da22d6a3feb1, []int{59}
 }
 
 func (m *CloseStatusUpdate) XXX_Unmarshal(b []byte) error {
@@ -5043,7 +5519,7 @@ func (m *PendingUpdate) Reset()         { *m = PendingUpdate{} }
 func (m *PendingUpdate) String() string { return proto.CompactTextString(m) }
 func (*PendingUpdate) ProtoMessage()    {}
 func (*PendingUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{56}
+	return fileDescriptor_77a6da22d6a3feb1, []int{60}
 }
 
 func (m *PendingUpdate) XXX_Unmarshal(b []byte) error {
@@ -5102,7 +5578,7 @@ func (m *ReadyForPsbtFunding) Reset()         { *m = ReadyForPsbtFunding{} }
 func (m *ReadyForPsbtFunding) String() string { return proto.CompactTextString(m) }
 func (*ReadyForPsbtFunding) ProtoMessage()    {}
 func (*ReadyForPsbtFunding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{57}
+	return fileDescriptor_77a6da22d6a3feb1, []int{61}
 }
 
 func (m *ReadyForPsbtFunding) XXX_Unmarshal(b []byte) error {
@@ -5214,7 +5690,14 @@ type OpenChannelRequest struct {
 	MaxLocalCsv uint32 `protobuf:"varint,17,opt,name=max_local_csv,json=maxLocalCsv,proto3" json:"max_local_csv,omitempty"`
 	//*
 	//Whether tx fees should be subtracted from channel balance.
-	SubtractFees         bool     `protobuf:"varint,18,opt,name=subtract_fees,json=subtractFees,proto3" json:"subtract_fees,omitempty"`
+	SubtractFees bool `protobuf:"varint,18,opt,name=subtract_fees,json=subtractFees,proto3" json:"subtract_fees,omitempty"`
+	//
+	//If set, then a zero-conf channel is opened, which can be used before the
+	//funding transaction confirms. The remote peer must signal support for
+	//zero-conf channels and its channel acceptor must explicitly consent to
+	//trusting us not to double spend the funding transaction. Only private
+	//channels can be zero-conf.
+	ZeroConf             bool     `protobuf:"varint,19,opt,name=zero_conf,json=zeroConf,proto3" json:"zero_conf,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5224,7 +5707,7 @@ func (m *OpenChannelRequest) Reset()         { *m = OpenChannelRequest{} }
 func (m *OpenChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*OpenChannelRequest) ProtoMessage()    {}
 func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{58}
+	return fileDescriptor_77a6da22d6a3feb1, []int{62}
 }
 
 func (m *OpenChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -5373,6 +5856,242 @@ func (m *OpenChannelRequest) GetSubtractFees() bool {
 	return false
 }
 
+func (m *OpenChannelRequest) GetZeroConf() bool {
+	if m != nil {
+		return m.ZeroConf
+	}
+	return false
+}
+
+type BatchOpenChannelRequest struct {
+	// The list of channels to open.
+	Channels []*BatchOpenChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// The target number of blocks that the funding transaction should be
+	// confirmed by.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// A manual fee rate set in sat/vByte that should be used when crafting the
+	// funding transaction.
+	SatPerVbyte int64 `protobuf:"varint,3,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	// An optional label for the batch transaction, limited to 500 characters.
+	Label                string   `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchOpenChannelRequest) Reset()         { *m = BatchOpenChannelRequest{} }
+func (m *BatchOpenChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchOpenChannelRequest) ProtoMessage()    {}
+func (*BatchOpenChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{63}
+}
+
+func (m *BatchOpenChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOpenChannelRequest.Unmarshal(m, b)
+}
+func (m *BatchOpenChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOpenChannelRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchOpenChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOpenChannelRequest.Merge(m, src)
+}
+func (m *BatchOpenChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchOpenChannelRequest.Size(m)
+}
+func (m *BatchOpenChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOpenChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOpenChannelRequest proto.InternalMessageInfo
+
+func (m *BatchOpenChannelRequest) GetChannels() []*BatchOpenChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *BatchOpenChannelRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *BatchOpenChannelRequest) GetSatPerVbyte() int64 {
+	if m != nil {
+		return m.SatPerVbyte
+	}
+	return 0
+}
+
+func (m *BatchOpenChannelRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type BatchOpenChannel struct {
+	// The pubkey of the node to open a channel with. When using REST, this
+	// field must be encoded as base64.
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,json=nodePubkey,proto3" json:"node_pubkey,omitempty"`
+	// The number of satoshis the wallet should commit to the channel.
+	LocalFundingAmount int64 `protobuf:"varint,2,opt,name=local_funding_amount,json=localFundingAmount,proto3" json:"local_funding_amount,omitempty"`
+	// The number of satoshis to push to the remote side as part of the initial
+	// commitment state.
+	PushSat int64 `protobuf:"varint,3,opt,name=push_sat,json=pushSat,proto3" json:"push_sat,omitempty"`
+	// Whether this channel should be private, not announced to the greater
+	// network.
+	Private bool `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	// The minimum value in millisatoshi we will require for incoming HTLCs on
+	// the channel.
+	MinHtlcMsat int64 `protobuf:"varint,5,opt,name=min_htlc_msat,json=minHtlcMsat,proto3" json:"min_htlc_msat,omitempty"`
+	// The delay we require on the remote's commitment transaction. If this is
+	// not set, it will be scaled automatically with the channel size.
+	RemoteCsvDelay uint32 `protobuf:"varint,6,opt,name=remote_csv_delay,json=remoteCsvDelay,proto3" json:"remote_csv_delay,omitempty"`
+	//
+	//Close address is an optional address which specifies the address to which
+	//funds should be paid out to upon cooperative close. This field may only be
+	//set if the peer supports the option upfront feature bit (call listpeers
+	//to check). The remote peer will only accept cooperative closes to this
+	//address if it is set.
+	//
+	//Note: If this value is set on channel creation, you will *not* be able to
+	//cooperatively close out to a different address.
+	CloseAddress string `protobuf:"bytes,7,opt,name=close_address,json=closeAddress,proto3" json:"close_address,omitempty"`
+	//
+	//An optional, unique identifier of 32 random bytes that will be used as the
+	//pending channel ID to identify the channel while it is in the pre-pending
+	//state. If none is specified, a random one is generated.
+	PendingChanId        []byte   `protobuf:"bytes,8,opt,name=pending_chan_id,json=pendingChanId,proto3" json:"pending_chan_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchOpenChannel) Reset()         { *m = BatchOpenChannel{} }
+func (m *BatchOpenChannel) String() string { return proto.CompactTextString(m) }
+func (*BatchOpenChannel) ProtoMessage()    {}
+func (*BatchOpenChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{64}
+}
+
+func (m *BatchOpenChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOpenChannel.Unmarshal(m, b)
+}
+func (m *BatchOpenChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOpenChannel.Marshal(b, m, deterministic)
+}
+func (m *BatchOpenChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOpenChannel.Merge(m, src)
+}
+func (m *BatchOpenChannel) XXX_Size() int {
+	return xxx_messageInfo_BatchOpenChannel.Size(m)
+}
+func (m *BatchOpenChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOpenChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOpenChannel proto.InternalMessageInfo
+
+func (m *BatchOpenChannel) GetNodePubkey() []byte {
+	if m != nil {
+		return m.NodePubkey
+	}
+	return nil
+}
+
+func (m *BatchOpenChannel) GetLocalFundingAmount() int64 {
+	if m != nil {
+		return m.LocalFundingAmount
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetPushSat() int64 {
+	if m != nil {
+		return m.PushSat
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+func (m *BatchOpenChannel) GetMinHtlcMsat() int64 {
+	if m != nil {
+		return m.MinHtlcMsat
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetRemoteCsvDelay() uint32 {
+	if m != nil {
+		return m.RemoteCsvDelay
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetCloseAddress() string {
+	if m != nil {
+		return m.CloseAddress
+	}
+	return ""
+}
+
+func (m *BatchOpenChannel) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
+type BatchOpenChannelResponse struct {
+	// The pending channels of the published batch transaction, in the order
+	// of the request.
+	PendingChannels      []*PendingUpdate `protobuf:"bytes,1,rep,name=pending_channels,json=pendingChannels,proto3" json:"pending_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BatchOpenChannelResponse) Reset()         { *m = BatchOpenChannelResponse{} }
+func (m *BatchOpenChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchOpenChannelResponse) ProtoMessage()    {}
+func (*BatchOpenChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{65}
+}
+
+func (m *BatchOpenChannelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOpenChannelResponse.Unmarshal(m, b)
+}
+func (m *BatchOpenChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOpenChannelResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchOpenChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOpenChannelResponse.Merge(m, src)
+}
+func (m *BatchOpenChannelResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchOpenChannelResponse.Size(m)
+}
+func (m *BatchOpenChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOpenChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOpenChannelResponse proto.InternalMessageInfo
+
+func (m *BatchOpenChannelResponse) GetPendingChannels() []*PendingUpdate {
+	if m != nil {
+		return m.PendingChannels
+	}
+	return nil
+}
+
 type OpenStatusUpdate struct {
 	// Types that are valid to be assigned to Update:
 	//	*OpenStatusUpdate_ChanPending
@@ -5392,7 +6111,7 @@ func (m *OpenStatusUpdate) Reset()         { *m = OpenStatusUpdate{} }
 func (m *OpenStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*OpenStatusUpdate) ProtoMessage()    {}
 func (*OpenStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{59}
+	return fileDescriptor_77a6da22d6a3feb1, []int{66}
 }
 
 func (m *OpenStatusUpdate) XXX_Unmarshal(b []byte) error {
@@ -5493,7 +6212,7 @@ func (m *KeyLocator) Reset()         { *m = KeyLocator{} }
 func (m *KeyLocator) String() string { return proto.CompactTextString(m) }
 func (*KeyLocator) ProtoMessage()    {}
 func (*KeyLocator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{60}
+	return fileDescriptor_77a6da22d6a3feb1, []int{67}
 }
 
 func (m *KeyLocator) XXX_Unmarshal(b []byte) error {
@@ -5544,7 +6263,7 @@ func (m *KeyDescriptor) Reset()         { *m = KeyDescriptor{} }
 func (m *KeyDescriptor) String() string { return proto.CompactTextString(m) }
 func (*KeyDescriptor) ProtoMessage()    {}
 func (*KeyDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{61}
+	return fileDescriptor_77a6da22d6a3feb1, []int{68}
 }
 
 func (m *KeyDescriptor) XXX_Unmarshal(b []byte) error {
@@ -5612,7 +6331,7 @@ func (m *ChanPointShim) Reset()         { *m = ChanPointShim{} }
 func (m *ChanPointShim) String() string { return proto.CompactTextString(m) }
 func (*ChanPointShim) ProtoMessage()    {}
 func (*ChanPointShim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62}
+	return fileDescriptor_77a6da22d6a3feb1, []int{69}
 }
 
 func (m *ChanPointShim) XXX_Unmarshal(b []byte) error {
@@ -5702,7 +6421,7 @@ func (m *PsbtShim) Reset()         { *m = PsbtShim{} }
 func (m *PsbtShim) String() string { return proto.CompactTextString(m) }
 func (*PsbtShim) ProtoMessage()    {}
 func (*PsbtShim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{63}
+	return fileDescriptor_77a6da22d6a3feb1, []int{70}
 }
 
 func (m *PsbtShim) XXX_Unmarshal(b []byte) error {
@@ -5758,7 +6477,7 @@ func (m *FundingShim) Reset()         { *m = FundingShim{} }
 func (m *FundingShim) String() string { return proto.CompactTextString(m) }
 func (*FundingShim) ProtoMessage()    {}
 func (*FundingShim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{64}
+	return fileDescriptor_77a6da22d6a3feb1, []int{71}
 }
 
 func (m *FundingShim) XXX_Unmarshal(b []byte) error {
@@ -5836,7 +6555,7 @@ func (m *FundingShimCancel) Reset()         { *m = FundingShimCancel{} }
 func (m *FundingShimCancel) String() string { return proto.CompactTextString(m) }
 func (*FundingShimCancel) ProtoMessage()    {}
 func (*FundingShimCancel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{65}
+	return fileDescriptor_77a6da22d6a3feb1, []int{72}
 }
 
 func (m *FundingShimCancel) XXX_Unmarshal(b []byte) error {
@@ -5871,7 +6590,16 @@ type FundingPsbtVerify struct {
 	//step.
 	FundedPsbt []byte `protobuf:"bytes,1,opt,name=funded_psbt,json=fundedPsbt,proto3" json:"funded_psbt,omitempty"`
 	// The pending channel ID of the channel to get the PSBT for.
-	PendingChanId        []byte   `protobuf:"bytes,2,opt,name=pending_chan_id,json=pendingChanId,proto3" json:"pending_chan_id,omitempty"`
+	PendingChanId []byte `protobuf:"bytes,2,opt,name=pending_chan_id,json=pendingChanId,proto3" json:"pending_chan_id,omitempty"`
+	//
+	//Can only be used if the no_publish flag was set to true in the OpenChannel
+	//call meaning that the caller is solely responsible for publishing the final
+	//funding transaction. If skip_finalize is set to true then lnd will not wait
+	//for a FundingStateStep call with the PsbtFinalize message and instead
+	//continue the channel negotiation with the unsigned transaction of the
+	//funded PSBT. This is only safe if all inputs of the PSBT are native SegWit
+	//inputs, as the transaction ID would otherwise change when signing.
+	SkipFinalize         bool     `protobuf:"varint,3,opt,name=skip_finalize,json=skipFinalize,proto3" json:"skip_finalize,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5881,7 +6609,7 @@ func (m *FundingPsbtVerify) Reset()         { *m = FundingPsbtVerify{} }
 func (m *FundingPsbtVerify) String() string { return proto.CompactTextString(m) }
 func (*FundingPsbtVerify) ProtoMessage()    {}
 func (*FundingPsbtVerify) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{66}
+	return fileDescriptor_77a6da22d6a3feb1, []int{73}
 }
 
 func (m *FundingPsbtVerify) XXX_Unmarshal(b []byte) error {
@@ -5916,6 +6644,13 @@ func (m *FundingPsbtVerify) GetPendingChanId() []byte {
 	return nil
 }
 
+func (m *FundingPsbtVerify) GetSkipFinalize() bool {
+	if m != nil {
+		return m.SkipFinalize
+	}
+	return false
+}
+
 type FundingPsbtFinalize struct {
 	//
 	//The funded PSBT that contains all witness data to send the exact channel
@@ -5938,7 +6673,7 @@ func (m *FundingPsbtFinalize) Reset()         { *m = FundingPsbtFinalize{} }
 func (m *FundingPsbtFinalize) String() string { return proto.CompactTextString(m) }
 func (*FundingPsbtFinalize) ProtoMessage()    {}
 func (*FundingPsbtFinalize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{67}
+	return fileDescriptor_77a6da22d6a3feb1, []int{74}
 }
 
 func (m *FundingPsbtFinalize) XXX_Unmarshal(b []byte) error {
@@ -5996,7 +6731,7 @@ func (m *FundingTransitionMsg) Reset()         { *m = FundingTransitionMsg{} }
 func (m *FundingTransitionMsg) String() string { return proto.CompactTextString(m) }
 func (*FundingTransitionMsg) ProtoMessage()    {}
 func (*FundingTransitionMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{68}
+	return fileDescriptor_77a6da22d6a3feb1, []int{75}
 }
 
 func (m *FundingTransitionMsg) XXX_Unmarshal(b []byte) error {
@@ -6100,7 +6835,7 @@ func (m *FundingStateStepResp) Reset()         { *m = FundingStateStepResp{} }
 func (m *FundingStateStepResp) String() string { return proto.CompactTextString(m) }
 func (*FundingStateStepResp) ProtoMessage()    {}
 func (*FundingStateStepResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{69}
+	return fileDescriptor_77a6da22d6a3feb1, []int{76}
 }
 
 func (m *FundingStateStepResp) XXX_Unmarshal(b []byte) error {
@@ -6146,7 +6881,7 @@ func (m *PendingHTLC) Reset()         { *m = PendingHTLC{} }
 func (m *PendingHTLC) String() string { return proto.CompactTextString(m) }
 func (*PendingHTLC) ProtoMessage()    {}
 func (*PendingHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{70}
+	return fileDescriptor_77a6da22d6a3feb1, []int{77}
 }
 
 func (m *PendingHTLC) XXX_Unmarshal(b []byte) error {
@@ -6219,7 +6954,7 @@ func (m *PendingChannelsRequest) Reset()         { *m = PendingChannelsRequest{}
 func (m *PendingChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsRequest) ProtoMessage()    {}
 func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{71}
+	return fileDescriptor_77a6da22d6a3feb1, []int{78}
 }
 
 func (m *PendingChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -6254,16 +6989,21 @@ type PendingChannelsResponse struct {
 	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels,json=pendingForceClosingChannels,proto3" json:"pending_force_closing_channels,omitempty"`
 	// Channels waiting for closing tx to confirm
 	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels,json=waitingCloseChannels,proto3" json:"waiting_close_channels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
-	XXX_sizecache        int32                                          `json:"-"`
+	//
+	//Open channels with htlcs that reached their broadcast delta, but whose
+	//value is below the minimum htlc value for force closing. Instead of force
+	//closing these channels, the htlcs are monitored until they are resolved.
+	DustAtRiskChannels   []*PendingChannelsResponse_DustAtRiskChannel `protobuf:"bytes,6,rep,name=dust_at_risk_channels,json=dustAtRiskChannels,proto3" json:"dust_at_risk_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
 }
 
 func (m *PendingChannelsResponse) Reset()         { *m = PendingChannelsResponse{} }
 func (m *PendingChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse) ProtoMessage()    {}
 func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72}
+	return fileDescriptor_77a6da22d6a3feb1, []int{79}
 }
 
 func (m *PendingChannelsResponse) XXX_Unmarshal(b []byte) error {
@@ -6320,6 +7060,13 @@ func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsRe
 	return nil
 }
 
+func (m *PendingChannelsResponse) GetDustAtRiskChannels() []*PendingChannelsResponse_DustAtRiskChannel {
+	if m != nil {
+		return m.DustAtRiskChannels
+	}
+	return nil
+}
+
 type PendingChannelsResponse_PendingChannel struct {
 	RemoteNodePub string `protobuf:"bytes,1,opt,name=remote_node_pub,json=remoteNodePub,proto3" json:"remote_node_pub,omitempty"`
 	ChannelPoint  string `protobuf:"bytes,2,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
@@ -6348,7 +7095,7 @@ func (m *PendingChannelsResponse_PendingChannel) Reset() {
 func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{79, 0}
 }
 
 func (m *PendingChannelsResponse_PendingChannel) XXX_Unmarshal(b []byte) error {
@@ -6464,7 +7211,7 @@ func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
 }
 func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72, 1}
+	return fileDescriptor_77a6da22d6a3feb1, []int{79, 1}
 }
 
 func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Unmarshal(b []byte) error {
@@ -6528,10 +7275,16 @@ type PendingChannelsResponse_WaitingCloseChannel struct {
 	//
 	//A list of valid commitment transactions. Any of these can confirm at
 	//this point.
-	Commitments          *PendingChannelsResponse_Commitments `protobuf:"bytes,3,opt,name=commitments,proto3" json:"commitments,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
-	XXX_unrecognized     []byte                               `json:"-"`
-	XXX_sizecache        int32                                `json:"-"`
+	Commitments *PendingChannelsResponse_Commitments `protobuf:"bytes,3,opt,name=commitments,proto3" json:"commitments,omitempty"`
+	//
+	//The effective fee rate in sat/vbyte of the package made up of the
+	//commitment transaction that is bumped through its anchor and the
+	//transaction sweeping the anchor. If no anchor is being swept, this is
+	//the fee rate of the local commitment transaction itself.
+	PackageSatPerVbyte   uint64   `protobuf:"varint,4,opt,name=package_sat_per_vbyte,json=packageSatPerVbyte,proto3" json:"package_sat_per_vbyte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
@@ -6542,7 +7295,7 @@ func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
 }
 func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72, 2}
+	return fileDescriptor_77a6da22d6a3feb1, []int{79, 2}
 }
 
 func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Unmarshal(b []byte) error {
@@ -6584,6 +7337,13 @@ func (m *PendingChannelsResponse_WaitingCloseChannel) GetCommitments() *PendingC
 	return nil
 }
 
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetPackageSatPerVbyte() uint64 {
+	if m != nil {
+		return m.PackageSatPerVbyte
+	}
+	return 0
+}
+
 type PendingChannelsResponse_Commitments struct {
 	// Hash of the local version of the commitment tx.
 	LocalTxid string `protobuf:"bytes,1,opt,name=local_txid,json=localTxid,proto3" json:"local_txid,omitempty"`
@@ -6612,7 +7372,7 @@ func (m *PendingChannelsResponse_Commitments) Reset()         { *m = PendingChan
 func (m *PendingChannelsResponse_Commitments) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_Commitments) ProtoMessage()    {}
 func (*PendingChannelsResponse_Commitments) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72, 3}
+	return fileDescriptor_77a6da22d6a3feb1, []int{79, 3}
 }
 
 func (m *PendingChannelsResponse_Commitments) XXX_Unmarshal(b []byte) error {
@@ -6689,7 +7449,7 @@ func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingCh
 func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72, 4}
+	return fileDescriptor_77a6da22d6a3feb1, []int{79, 4}
 }
 
 func (m *PendingChannelsResponse_ClosedChannel) XXX_Unmarshal(b []byte) error {
@@ -6755,7 +7515,7 @@ func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
 }
 func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72, 5}
+	return fileDescriptor_77a6da22d6a3feb1, []int{79, 5}
 }
 
 func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Unmarshal(b []byte) error {
@@ -6832,56 +7592,198 @@ func (m *PendingChannelsResponse_ForceClosedChannel) GetAnchor() PendingChannels
 	return PendingChannelsResponse_ForceClosedChannel_LIMBO
 }
 
-type ChannelEventSubscription struct {
+type PendingChannelsResponse_DustAtRiskHTLC struct {
+	// The direction within the channel that the htlc was sent
+	Incoming bool `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	// The total value of the htlc in satoshis
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The payment hash of the htlc
+	HashLock []byte `protobuf:"bytes,3,opt,name=hash_lock,json=hashLock,proto3" json:"hash_lock,omitempty"`
+	// The absolute block height at which the htlc expires
+	ExpirationHeight uint32 `protobuf:"varint,4,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	//
+	//The number of blocks remaining until the htlc expires. Negative
+	//values indicate how many blocks have passed since its expiry.
+	BlocksTilExpiry      int32    `protobuf:"varint,5,opt,name=blocks_til_expiry,json=blocksTilExpiry,proto3" json:"blocks_til_expiry,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelEventSubscription) Reset()         { *m = ChannelEventSubscription{} }
-func (m *ChannelEventSubscription) String() string { return proto.CompactTextString(m) }
-func (*ChannelEventSubscription) ProtoMessage()    {}
-func (*ChannelEventSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{73}
+func (m *PendingChannelsResponse_DustAtRiskHTLC) Reset() {
+	*m = PendingChannelsResponse_DustAtRiskHTLC{}
+}
+func (m *PendingChannelsResponse_DustAtRiskHTLC) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_DustAtRiskHTLC) ProtoMessage()    {}
+func (*PendingChannelsResponse_DustAtRiskHTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{79, 6}
 }
 
-func (m *ChannelEventSubscription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelEventSubscription.Unmarshal(m, b)
+func (m *PendingChannelsResponse_DustAtRiskHTLC) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_DustAtRiskHTLC.Unmarshal(m, b)
 }
-func (m *ChannelEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelEventSubscription.Marshal(b, m, deterministic)
+func (m *PendingChannelsResponse_DustAtRiskHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_DustAtRiskHTLC.Marshal(b, m, deterministic)
 }
-func (m *ChannelEventSubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelEventSubscription.Merge(m, src)
+func (m *PendingChannelsResponse_DustAtRiskHTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_DustAtRiskHTLC.Merge(m, src)
 }
-func (m *ChannelEventSubscription) XXX_Size() int {
-	return xxx_messageInfo_ChannelEventSubscription.Size(m)
+func (m *PendingChannelsResponse_DustAtRiskHTLC) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_DustAtRiskHTLC.Size(m)
 }
-func (m *ChannelEventSubscription) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelEventSubscription.DiscardUnknown(m)
+func (m *PendingChannelsResponse_DustAtRiskHTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_DustAtRiskHTLC.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelEventSubscription proto.InternalMessageInfo
+var xxx_messageInfo_PendingChannelsResponse_DustAtRiskHTLC proto.InternalMessageInfo
 
-type ChannelEventUpdate struct {
-	// Types that are valid to be assigned to Channel:
-	//	*ChannelEventUpdate_OpenChannel
-	//	*ChannelEventUpdate_ClosedChannel
-	//	*ChannelEventUpdate_ActiveChannel
-	//	*ChannelEventUpdate_InactiveChannel
-	//	*ChannelEventUpdate_PendingOpenChannel
-	Channel              isChannelEventUpdate_Channel  `protobuf_oneof:"channel"`
-	Type                 ChannelEventUpdate_UpdateType `protobuf:"varint,5,opt,name=type,proto3,enum=lnrpc.ChannelEventUpdate_UpdateType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+func (m *PendingChannelsResponse_DustAtRiskHTLC) GetIncoming() bool {
+	if m != nil {
+		return m.Incoming
+	}
+	return false
+}
+
+func (m *PendingChannelsResponse_DustAtRiskHTLC) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_DustAtRiskHTLC) GetHashLock() []byte {
+	if m != nil {
+		return m.HashLock
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse_DustAtRiskHTLC) GetExpirationHeight() uint32 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_DustAtRiskHTLC) GetBlocksTilExpiry() int32 {
+	if m != nil {
+		return m.BlocksTilExpiry
+	}
+	return 0
+}
+
+type PendingChannelsResponse_DustAtRiskChannel struct {
+	// The open channel that carries the htlcs at risk
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// The htlcs that are kept at risk instead of force closing
+	Htlcs []*PendingChannelsResponse_DustAtRiskHTLC `protobuf:"bytes,2,rep,name=htlcs,proto3" json:"htlcs,omitempty"`
+	// The total value in satoshis of the htlcs at risk
+	AmountAtRisk         int64    `protobuf:"varint,3,opt,name=amount_at_risk,json=amountAtRisk,proto3" json:"amount_at_risk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingChannelsResponse_DustAtRiskChannel) Reset() {
+	*m = PendingChannelsResponse_DustAtRiskChannel{}
+}
+func (m *PendingChannelsResponse_DustAtRiskChannel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_DustAtRiskChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_DustAtRiskChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{79, 7}
+}
+
+func (m *PendingChannelsResponse_DustAtRiskChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_DustAtRiskChannel.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse_DustAtRiskChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_DustAtRiskChannel.Marshal(b, m, deterministic)
+}
+func (m *PendingChannelsResponse_DustAtRiskChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_DustAtRiskChannel.Merge(m, src)
+}
+func (m *PendingChannelsResponse_DustAtRiskChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_DustAtRiskChannel.Size(m)
+}
+func (m *PendingChannelsResponse_DustAtRiskChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_DustAtRiskChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelsResponse_DustAtRiskChannel proto.InternalMessageInfo
+
+func (m *PendingChannelsResponse_DustAtRiskChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse_DustAtRiskChannel) GetHtlcs() []*PendingChannelsResponse_DustAtRiskHTLC {
+	if m != nil {
+		return m.Htlcs
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse_DustAtRiskChannel) GetAmountAtRisk() int64 {
+	if m != nil {
+		return m.AmountAtRisk
+	}
+	return 0
+}
+
+type ChannelEventSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelEventSubscription) Reset()         { *m = ChannelEventSubscription{} }
+func (m *ChannelEventSubscription) String() string { return proto.CompactTextString(m) }
+func (*ChannelEventSubscription) ProtoMessage()    {}
+func (*ChannelEventSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{80}
+}
+
+func (m *ChannelEventSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEventSubscription.Unmarshal(m, b)
+}
+func (m *ChannelEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEventSubscription.Marshal(b, m, deterministic)
+}
+func (m *ChannelEventSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEventSubscription.Merge(m, src)
+}
+func (m *ChannelEventSubscription) XXX_Size() int {
+	return xxx_messageInfo_ChannelEventSubscription.Size(m)
+}
+func (m *ChannelEventSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEventSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEventSubscription proto.InternalMessageInfo
+
+type ChannelEventUpdate struct {
+	// Types that are valid to be assigned to Channel:
+	//	*ChannelEventUpdate_OpenChannel
+	//	*ChannelEventUpdate_ClosedChannel
+	//	*ChannelEventUpdate_ActiveChannel
+	//	*ChannelEventUpdate_InactiveChannel
+	//	*ChannelEventUpdate_PendingOpenChannel
+	Channel              isChannelEventUpdate_Channel  `protobuf_oneof:"channel"`
+	Type                 ChannelEventUpdate_UpdateType `protobuf:"varint,5,opt,name=type,proto3,enum=lnrpc.ChannelEventUpdate_UpdateType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
 func (m *ChannelEventUpdate) Reset()         { *m = ChannelEventUpdate{} }
 func (m *ChannelEventUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelEventUpdate) ProtoMessage()    {}
 func (*ChannelEventUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{74}
+	return fileDescriptor_77a6da22d6a3feb1, []int{81}
 }
 
 func (m *ChannelEventUpdate) XXX_Unmarshal(b []byte) error {
@@ -7010,7 +7912,7 @@ func (m *WalletAccountBalance) Reset()         { *m = WalletAccountBalance{} }
 func (m *WalletAccountBalance) String() string { return proto.CompactTextString(m) }
 func (*WalletAccountBalance) ProtoMessage()    {}
 func (*WalletAccountBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{75}
+	return fileDescriptor_77a6da22d6a3feb1, []int{82}
 }
 
 func (m *WalletAccountBalance) XXX_Unmarshal(b []byte) error {
@@ -7055,7 +7957,7 @@ func (m *WalletBalanceRequest) Reset()         { *m = WalletBalanceRequest{} }
 func (m *WalletBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*WalletBalanceRequest) ProtoMessage()    {}
 func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{76}
+	return fileDescriptor_77a6da22d6a3feb1, []int{83}
 }
 
 func (m *WalletBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -7094,7 +7996,7 @@ func (m *WalletBalanceResponse) Reset()         { *m = WalletBalanceResponse{} }
 func (m *WalletBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*WalletBalanceResponse) ProtoMessage()    {}
 func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{77}
+	return fileDescriptor_77a6da22d6a3feb1, []int{84}
 }
 
 func (m *WalletBalanceResponse) XXX_Unmarshal(b []byte) error {
@@ -7157,7 +8059,7 @@ func (m *Amount) Reset()         { *m = Amount{} }
 func (m *Amount) String() string { return proto.CompactTextString(m) }
 func (*Amount) ProtoMessage()    {}
 func (*Amount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{78}
+	return fileDescriptor_77a6da22d6a3feb1, []int{85}
 }
 
 func (m *Amount) XXX_Unmarshal(b []byte) error {
@@ -7202,7 +8104,7 @@ func (m *ChannelBalanceRequest) Reset()         { *m = ChannelBalanceRequest{} }
 func (m *ChannelBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelBalanceRequest) ProtoMessage()    {}
 func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{79}
+	return fileDescriptor_77a6da22d6a3feb1, []int{86}
 }
 
 func (m *ChannelBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -7249,7 +8151,7 @@ func (m *ChannelBalanceResponse) Reset()         { *m = ChannelBalanceResponse{}
 func (m *ChannelBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*ChannelBalanceResponse) ProtoMessage()    {}
 func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{80}
+	return fileDescriptor_77a6da22d6a3feb1, []int{87}
 }
 
 func (m *ChannelBalanceResponse) XXX_Unmarshal(b []byte) error {
@@ -7401,17 +8303,23 @@ type QueryRoutesRequest struct {
 	//optional or remote may be set, but not both. If this field is nil or empty,
 	//the router will try to load destination features from the graph as a
 	//fallback.
-	DestFeatures         []FeatureBit `protobuf:"varint,17,rep,packed,name=dest_features,json=destFeatures,proto3,enum=lnrpc.FeatureBit" json:"dest_features,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	DestFeatures []FeatureBit `protobuf:"varint,17,rep,packed,name=dest_features,json=destFeatures,proto3,enum=lnrpc.FeatureBit" json:"dest_features,omitempty"`
+	//
+	//The time preference for this route. Set to -1 to optimize for fees only, to
+	//1 to optimize for reliability only or a value inbetween for a mix. The
+	//default of 0 uses the trade-off configured through the attempt cost
+	//settings of the node.
+	TimePref             float64  `protobuf:"fixed64,18,opt,name=time_pref,json=timePref,proto3" json:"time_pref,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
 func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRoutesRequest) ProtoMessage()    {}
 func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{81}
+	return fileDescriptor_77a6da22d6a3feb1, []int{88}
 }
 
 func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
@@ -7545,6 +8453,13 @@ func (m *QueryRoutesRequest) GetDestFeatures() []FeatureBit {
 	return nil
 }
 
+func (m *QueryRoutesRequest) GetTimePref() float64 {
+	if m != nil {
+		return m.TimePref
+	}
+	return 0
+}
+
 type NodePair struct {
 	//
 	//The sending node of the pair. When using REST, this field must be encoded as
@@ -7563,7 +8478,7 @@ func (m *NodePair) Reset()         { *m = NodePair{} }
 func (m *NodePair) String() string { return proto.CompactTextString(m) }
 func (*NodePair) ProtoMessage()    {}
 func (*NodePair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{82}
+	return fileDescriptor_77a6da22d6a3feb1, []int{89}
 }
 
 func (m *NodePair) XXX_Unmarshal(b []byte) error {
@@ -7616,7 +8531,7 @@ func (m *EdgeLocator) Reset()         { *m = EdgeLocator{} }
 func (m *EdgeLocator) String() string { return proto.CompactTextString(m) }
 func (*EdgeLocator) ProtoMessage()    {}
 func (*EdgeLocator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{83}
+	return fileDescriptor_77a6da22d6a3feb1, []int{90}
 }
 
 func (m *EdgeLocator) XXX_Unmarshal(b []byte) error {
@@ -7669,7 +8584,7 @@ func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
 func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRoutesResponse) ProtoMessage()    {}
 func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{84}
+	return fileDescriptor_77a6da22d6a3feb1, []int{91}
 }
 
 func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
@@ -7745,7 +8660,7 @@ func (m *Hop) Reset()         { *m = Hop{} }
 func (m *Hop) String() string { return proto.CompactTextString(m) }
 func (*Hop) ProtoMessage()    {}
 func (*Hop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{85}
+	return fileDescriptor_77a6da22d6a3feb1, []int{92}
 }
 
 func (m *Hop) XXX_Unmarshal(b []byte) error {
@@ -7867,7 +8782,7 @@ func (m *MPPRecord) Reset()         { *m = MPPRecord{} }
 func (m *MPPRecord) String() string { return proto.CompactTextString(m) }
 func (*MPPRecord) ProtoMessage()    {}
 func (*MPPRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{86}
+	return fileDescriptor_77a6da22d6a3feb1, []int{93}
 }
 
 func (m *MPPRecord) XXX_Unmarshal(b []byte) error {
@@ -7945,7 +8860,7 @@ func (m *Route) Reset()         { *m = Route{} }
 func (m *Route) String() string { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()    {}
 func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{87}
+	return fileDescriptor_77a6da22d6a3feb1, []int{94}
 }
 
 func (m *Route) XXX_Unmarshal(b []byte) error {
@@ -8024,7 +8939,7 @@ func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()    {}
 func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{88}
+	return fileDescriptor_77a6da22d6a3feb1, []int{95}
 }
 
 func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -8081,7 +8996,7 @@ func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
 func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()    {}
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{89}
+	return fileDescriptor_77a6da22d6a3feb1, []int{96}
 }
 
 func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
@@ -8151,7 +9066,7 @@ func (m *LightningNode) Reset()         { *m = LightningNode{} }
 func (m *LightningNode) String() string { return proto.CompactTextString(m) }
 func (*LightningNode) ProtoMessage()    {}
 func (*LightningNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{90}
+	return fileDescriptor_77a6da22d6a3feb1, []int{97}
 }
 
 func (m *LightningNode) XXX_Unmarshal(b []byte) error {
@@ -8226,7 +9141,7 @@ func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
 func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()    {}
 func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{91}
+	return fileDescriptor_77a6da22d6a3feb1, []int{98}
 }
 
 func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
@@ -8262,23 +9177,29 @@ func (m *NodeAddress) GetAddr() string {
 }
 
 type RoutingPolicy struct {
-	TimeLockDelta        uint32   `protobuf:"varint,1,opt,name=time_lock_delta,json=timeLockDelta,proto3" json:"time_lock_delta,omitempty"`
-	MinHtlc              int64    `protobuf:"varint,2,opt,name=min_htlc,json=minHtlc,proto3" json:"min_htlc,omitempty"`
-	FeeBaseMsat          int64    `protobuf:"varint,3,opt,name=fee_base_msat,json=feeBaseMsat,proto3" json:"fee_base_msat,omitempty"`
-	FeeRateMilliMsat     int64    `protobuf:"varint,4,opt,name=fee_rate_milli_msat,json=feeRateMilliMsat,proto3" json:"fee_rate_milli_msat,omitempty"`
-	Disabled             bool     `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	MaxHtlcMsat          uint64   `protobuf:"varint,6,opt,name=max_htlc_msat,json=maxHtlcMsat,proto3" json:"max_htlc_msat,omitempty"`
-	LastUpdate           uint32   `protobuf:"varint,7,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	TimeLockDelta    uint32 `protobuf:"varint,1,opt,name=time_lock_delta,json=timeLockDelta,proto3" json:"time_lock_delta,omitempty"`
+	MinHtlc          int64  `protobuf:"varint,2,opt,name=min_htlc,json=minHtlc,proto3" json:"min_htlc,omitempty"`
+	FeeBaseMsat      int64  `protobuf:"varint,3,opt,name=fee_base_msat,json=feeBaseMsat,proto3" json:"fee_base_msat,omitempty"`
+	FeeRateMilliMsat int64  `protobuf:"varint,4,opt,name=fee_rate_milli_msat,json=feeRateMilliMsat,proto3" json:"fee_rate_milli_msat,omitempty"`
+	Disabled         bool   `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	MaxHtlcMsat      uint64 `protobuf:"varint,6,opt,name=max_htlc_msat,json=maxHtlcMsat,proto3" json:"max_htlc_msat,omitempty"`
+	LastUpdate       uint32 `protobuf:"varint,7,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	// The inbound base fee charged for HTLCs arriving over the channel. May be
+	// negative, in which case it acts as a discount.
+	InboundFeeBaseMsat int32 `protobuf:"varint,8,opt,name=inbound_fee_base_msat,json=inboundFeeBaseMsat,proto3" json:"inbound_fee_base_msat,omitempty"`
+	// The inbound fee rate charged for HTLCs arriving over the channel, in
+	// parts per million. May be negative, in which case it acts as a discount.
+	InboundFeeRateMilliMsat int32    `protobuf:"varint,9,opt,name=inbound_fee_rate_milli_msat,json=inboundFeeRateMilliMsat,proto3" json:"inbound_fee_rate_milli_msat,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
 }
 
 func (m *RoutingPolicy) Reset()         { *m = RoutingPolicy{} }
 func (m *RoutingPolicy) String() string { return proto.CompactTextString(m) }
 func (*RoutingPolicy) ProtoMessage()    {}
 func (*RoutingPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{92}
+	return fileDescriptor_77a6da22d6a3feb1, []int{99}
 }
 
 func (m *RoutingPolicy) XXX_Unmarshal(b []byte) error {
@@ -8348,6 +9269,20 @@ func (m *RoutingPolicy) GetLastUpdate() uint32 {
 	return 0
 }
 
+func (m *RoutingPolicy) GetInboundFeeBaseMsat() int32 {
+	if m != nil {
+		return m.InboundFeeBaseMsat
+	}
+	return 0
+}
+
+func (m *RoutingPolicy) GetInboundFeeRateMilliMsat() int32 {
+	if m != nil {
+		return m.InboundFeeRateMilliMsat
+	}
+	return 0
+}
+
 //
 //A fully authenticated channel along with all its unique attributes.
 //Once an authenticated channel announcement has been processed on the network,
@@ -8376,7 +9311,7 @@ func (m *ChannelEdge) Reset()         { *m = ChannelEdge{} }
 func (m *ChannelEdge) String() string { return proto.CompactTextString(m) }
 func (*ChannelEdge) ProtoMessage()    {}
 func (*ChannelEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{93}
+	return fileDescriptor_77a6da22d6a3feb1, []int{100}
 }
 
 func (m *ChannelEdge) XXX_Unmarshal(b []byte) error {
@@ -8469,7 +9404,7 @@ func (m *ChannelGraphRequest) Reset()         { *m = ChannelGraphRequest{} }
 func (m *ChannelGraphRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelGraphRequest) ProtoMessage()    {}
 func (*ChannelGraphRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{94}
+	return fileDescriptor_77a6da22d6a3feb1, []int{101}
 }
 
 func (m *ChannelGraphRequest) XXX_Unmarshal(b []byte) error {
@@ -8512,7 +9447,7 @@ func (m *ChannelGraph) Reset()         { *m = ChannelGraph{} }
 func (m *ChannelGraph) String() string { return proto.CompactTextString(m) }
 func (*ChannelGraph) ProtoMessage()    {}
 func (*ChannelGraph) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{95}
+	return fileDescriptor_77a6da22d6a3feb1, []int{102}
 }
 
 func (m *ChannelGraph) XXX_Unmarshal(b []byte) error {
@@ -8559,7 +9494,7 @@ func (m *NodeMetricsRequest) Reset()         { *m = NodeMetricsRequest{} }
 func (m *NodeMetricsRequest) String() string { return proto.CompactTextString(m) }
 func (*NodeMetricsRequest) ProtoMessage()    {}
 func (*NodeMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{96}
+	return fileDescriptor_77a6da22d6a3feb1, []int{103}
 }
 
 func (m *NodeMetricsRequest) XXX_Unmarshal(b []byte) error {
@@ -8604,7 +9539,7 @@ func (m *NodeMetricsResponse) Reset()         { *m = NodeMetricsResponse{} }
 func (m *NodeMetricsResponse) String() string { return proto.CompactTextString(m) }
 func (*NodeMetricsResponse) ProtoMessage()    {}
 func (*NodeMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{97}
+	return fileDescriptor_77a6da22d6a3feb1, []int{104}
 }
 
 func (m *NodeMetricsResponse) XXX_Unmarshal(b []byte) error {
@@ -8646,7 +9581,7 @@ func (m *FloatMetric) Reset()         { *m = FloatMetric{} }
 func (m *FloatMetric) String() string { return proto.CompactTextString(m) }
 func (*FloatMetric) ProtoMessage()    {}
 func (*FloatMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{98}
+	return fileDescriptor_77a6da22d6a3feb1, []int{105}
 }
 
 func (m *FloatMetric) XXX_Unmarshal(b []byte) error {
@@ -8696,7 +9631,7 @@ func (m *ChanInfoRequest) Reset()         { *m = ChanInfoRequest{} }
 func (m *ChanInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ChanInfoRequest) ProtoMessage()    {}
 func (*ChanInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{99}
+	return fileDescriptor_77a6da22d6a3feb1, []int{106}
 }
 
 func (m *ChanInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -8734,7 +9669,7 @@ func (m *NetworkInfoRequest) Reset()         { *m = NetworkInfoRequest{} }
 func (m *NetworkInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfoRequest) ProtoMessage()    {}
 func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{100}
+	return fileDescriptor_77a6da22d6a3feb1, []int{107}
 }
 
 func (m *NetworkInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -8777,7 +9712,7 @@ func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
 func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfo) ProtoMessage()    {}
 func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{101}
+	return fileDescriptor_77a6da22d6a3feb1, []int{108}
 }
 
 func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
@@ -8885,7 +9820,7 @@ func (m *StopRequest) Reset()         { *m = StopRequest{} }
 func (m *StopRequest) String() string { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()    {}
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{102}
+	return fileDescriptor_77a6da22d6a3feb1, []int{109}
 }
 
 func (m *StopRequest) XXX_Unmarshal(b []byte) error {
@@ -8916,7 +9851,7 @@ func (m *StopResponse) Reset()         { *m = StopResponse{} }
 func (m *StopResponse) String() string { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()    {}
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{103}
+	return fileDescriptor_77a6da22d6a3feb1, []int{110}
 }
 
 func (m *StopResponse) XXX_Unmarshal(b []byte) error {
@@ -8947,7 +9882,7 @@ func (m *GraphTopologySubscription) Reset()         { *m = GraphTopologySubscrip
 func (m *GraphTopologySubscription) String() string { return proto.CompactTextString(m) }
 func (*GraphTopologySubscription) ProtoMessage()    {}
 func (*GraphTopologySubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{104}
+	return fileDescriptor_77a6da22d6a3feb1, []int{111}
 }
 
 func (m *GraphTopologySubscription) XXX_Unmarshal(b []byte) error {
@@ -8981,7 +9916,7 @@ func (m *GraphTopologyUpdate) Reset()         { *m = GraphTopologyUpdate{} }
 func (m *GraphTopologyUpdate) String() string { return proto.CompactTextString(m) }
 func (*GraphTopologyUpdate) ProtoMessage()    {}
 func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{105}
+	return fileDescriptor_77a6da22d6a3feb1, []int{112}
 }
 
 func (m *GraphTopologyUpdate) XXX_Unmarshal(b []byte) error {
@@ -9042,7 +9977,7 @@ func (m *NodeUpdate) Reset()         { *m = NodeUpdate{} }
 func (m *NodeUpdate) String() string { return proto.CompactTextString(m) }
 func (*NodeUpdate) ProtoMessage()    {}
 func (*NodeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{106}
+	return fileDescriptor_77a6da22d6a3feb1, []int{113}
 }
 
 func (m *NodeUpdate) XXX_Unmarshal(b []byte) error {
@@ -9126,7 +10061,7 @@ func (m *ChannelEdgeUpdate) Reset()         { *m = ChannelEdgeUpdate{} }
 func (m *ChannelEdgeUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelEdgeUpdate) ProtoMessage()    {}
 func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{107}
+	return fileDescriptor_77a6da22d6a3feb1, []int{114}
 }
 
 func (m *ChannelEdgeUpdate) XXX_Unmarshal(b []byte) error {
@@ -9207,7 +10142,7 @@ func (m *ClosedChannelUpdate) Reset()         { *m = ClosedChannelUpdate{} }
 func (m *ClosedChannelUpdate) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelUpdate) ProtoMessage()    {}
 func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{108}
+	return fileDescriptor_77a6da22d6a3feb1, []int{115}
 }
 
 func (m *ClosedChannelUpdate) XXX_Unmarshal(b []byte) error {
@@ -9278,7 +10213,7 @@ func (m *HopHint) Reset()         { *m = HopHint{} }
 func (m *HopHint) String() string { return proto.CompactTextString(m) }
 func (*HopHint) ProtoMessage()    {}
 func (*HopHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{109}
+	return fileDescriptor_77a6da22d6a3feb1, []int{116}
 }
 
 func (m *HopHint) XXX_Unmarshal(b []byte) error {
@@ -9348,7 +10283,7 @@ func (m *RouteHint) Reset()         { *m = RouteHint{} }
 func (m *RouteHint) String() string { return proto.CompactTextString(m) }
 func (*RouteHint) ProtoMessage()    {}
 func (*RouteHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{110}
+	return fileDescriptor_77a6da22d6a3feb1, []int{117}
 }
 
 func (m *RouteHint) XXX_Unmarshal(b []byte) error {
@@ -9476,17 +10411,23 @@ type Invoice struct {
 	//The payment address of this invoice. This value will be used in MPP
 	//payments, and also for newer invoies that always require the MPP paylaod
 	//for added end-to-end security.
-	PaymentAddr          []byte   `protobuf:"bytes,26,opt,name=payment_addr,json=paymentAddr,proto3" json:"payment_addr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	PaymentAddr []byte `protobuf:"bytes,26,opt,name=payment_addr,json=paymentAddr,proto3" json:"payment_addr,omitempty"`
+	//
+	//Only set in the updates of SubscribeSingleInvoice that report an htlc that
+	//was rejected by the acceptance policy of the invoice, or that was canceled
+	//because it came close to its expiry while the hold invoice was accepted.
+	//A policy rejection leaves the invoice itself unchanged.
+	HtlcRejection        *InvoiceHtlcRejection `protobuf:"bytes,27,opt,name=htlc_rejection,json=htlcRejection,proto3" json:"htlc_rejection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *Invoice) Reset()         { *m = Invoice{} }
 func (m *Invoice) String() string { return proto.CompactTextString(m) }
 func (*Invoice) ProtoMessage()    {}
 func (*Invoice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{111}
+	return fileDescriptor_77a6da22d6a3feb1, []int{118}
 }
 
 func (m *Invoice) XXX_Unmarshal(b []byte) error {
@@ -9684,6 +10625,13 @@ func (m *Invoice) GetPaymentAddr() []byte {
 	return nil
 }
 
+func (m *Invoice) GetHtlcRejection() *InvoiceHtlcRejection {
+	if m != nil {
+		return m.HtlcRejection
+	}
+	return nil
+}
+
 // Details of an HTLC that paid to an invoice
 type InvoiceHTLC struct {
 	// Short channel id over which the htlc was received.
@@ -9717,7 +10665,7 @@ func (m *InvoiceHTLC) Reset()         { *m = InvoiceHTLC{} }
 func (m *InvoiceHTLC) String() string { return proto.CompactTextString(m) }
 func (*InvoiceHTLC) ProtoMessage()    {}
 func (*InvoiceHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{112}
+	return fileDescriptor_77a6da22d6a3feb1, []int{119}
 }
 
 func (m *InvoiceHTLC) XXX_Unmarshal(b []byte) error {
@@ -9840,7 +10788,7 @@ func (m *AMP) Reset()         { *m = AMP{} }
 func (m *AMP) String() string { return proto.CompactTextString(m) }
 func (*AMP) ProtoMessage()    {}
 func (*AMP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{113}
+	return fileDescriptor_77a6da22d6a3feb1, []int{120}
 }
 
 func (m *AMP) XXX_Unmarshal(b []byte) error {
@@ -9896,49 +10844,137 @@ func (m *AMP) GetPreimage() []byte {
 	return nil
 }
 
-type AddInvoiceResponse struct {
-	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,json=rHash,proto3" json:"r_hash,omitempty"`
-	//
-	//A bare-bones invoice for a payment within the Lightning Network. With the
-	//details of the invoice, the sender has all the data necessary to send a
-	//payment to the recipient.
-	PaymentRequest string `protobuf:"bytes,2,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
-	//
-	//The "add" index of this invoice. Each newly created invoice will increment
-	//this index making it monotonically increasing. Callers to the
-	//SubscribeInvoices call can use this to instantly get notified of all added
-	//invoices with an add_index greater than this one.
-	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index,json=addIndex,proto3" json:"add_index,omitempty"`
+// Details of an HTLC that was rejected by the acceptance policy of an invoice.
+type InvoiceHtlcRejection struct {
+	// Short channel id over which the htlc was received.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	// Index identifying the htlc on the channel.
+	HtlcIndex uint64 `protobuf:"varint,2,opt,name=htlc_index,json=htlcIndex,proto3" json:"htlc_index,omitempty"`
+	// The amount of the htlc in msat.
+	AmtMsat uint64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	// Block height at which this htlc expires.
+	ExpiryHeight int32 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// The reason why the htlc was rejected or canceled.
+	Reason InvoiceHtlcRejection_Reason `protobuf:"varint,5,opt,name=reason,proto3,enum=lnrpc.InvoiceHtlcRejection_Reason" json:"reason,omitempty"`
 	//
-	//The payment address of the generated invoice. This value should be used
-	//in all payments for this invoice as we require it for end to end
-	//security.
-	PaymentAddr          []byte   `protobuf:"bytes,17,opt,name=payment_addr,json=paymentAddr,proto3" json:"payment_addr,omitempty"`
+	//The type of the custom record that was missing or had an unexpected value.
+	//Only set for custom record rejections.
+	CustomRecordType     uint64   `protobuf:"varint,6,opt,name=custom_record_type,json=customRecordType,proto3" json:"custom_record_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddInvoiceResponse) Reset()         { *m = AddInvoiceResponse{} }
-func (m *AddInvoiceResponse) String() string { return proto.CompactTextString(m) }
-func (*AddInvoiceResponse) ProtoMessage()    {}
-func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{114}
+func (m *InvoiceHtlcRejection) Reset()         { *m = InvoiceHtlcRejection{} }
+func (m *InvoiceHtlcRejection) String() string { return proto.CompactTextString(m) }
+func (*InvoiceHtlcRejection) ProtoMessage()    {}
+func (*InvoiceHtlcRejection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{121}
 }
 
-func (m *AddInvoiceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddInvoiceResponse.Unmarshal(m, b)
+func (m *InvoiceHtlcRejection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvoiceHtlcRejection.Unmarshal(m, b)
 }
-func (m *AddInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddInvoiceResponse.Marshal(b, m, deterministic)
+func (m *InvoiceHtlcRejection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvoiceHtlcRejection.Marshal(b, m, deterministic)
 }
-func (m *AddInvoiceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddInvoiceResponse.Merge(m, src)
+func (m *InvoiceHtlcRejection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvoiceHtlcRejection.Merge(m, src)
 }
-func (m *AddInvoiceResponse) XXX_Size() int {
-	return xxx_messageInfo_AddInvoiceResponse.Size(m)
+func (m *InvoiceHtlcRejection) XXX_Size() int {
+	return xxx_messageInfo_InvoiceHtlcRejection.Size(m)
 }
-func (m *AddInvoiceResponse) XXX_DiscardUnknown() {
+func (m *InvoiceHtlcRejection) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvoiceHtlcRejection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvoiceHtlcRejection proto.InternalMessageInfo
+
+func (m *InvoiceHtlcRejection) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *InvoiceHtlcRejection) GetHtlcIndex() uint64 {
+	if m != nil {
+		return m.HtlcIndex
+	}
+	return 0
+}
+
+func (m *InvoiceHtlcRejection) GetAmtMsat() uint64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
+}
+
+func (m *InvoiceHtlcRejection) GetExpiryHeight() int32 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *InvoiceHtlcRejection) GetReason() InvoiceHtlcRejection_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return InvoiceHtlcRejection_UNKNOWN
+}
+
+func (m *InvoiceHtlcRejection) GetCustomRecordType() uint64 {
+	if m != nil {
+		return m.CustomRecordType
+	}
+	return 0
+}
+
+type AddInvoiceResponse struct {
+	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,json=rHash,proto3" json:"r_hash,omitempty"`
+	//
+	//A bare-bones invoice for a payment within the Lightning Network. With the
+	//details of the invoice, the sender has all the data necessary to send a
+	//payment to the recipient.
+	PaymentRequest string `protobuf:"bytes,2,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	//
+	//The "add" index of this invoice. Each newly created invoice will increment
+	//this index making it monotonically increasing. Callers to the
+	//SubscribeInvoices call can use this to instantly get notified of all added
+	//invoices with an add_index greater than this one.
+	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index,json=addIndex,proto3" json:"add_index,omitempty"`
+	//
+	//The payment address of the generated invoice. This value should be used
+	//in all payments for this invoice as we require it for end to end
+	//security.
+	PaymentAddr          []byte   `protobuf:"bytes,17,opt,name=payment_addr,json=paymentAddr,proto3" json:"payment_addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddInvoiceResponse) Reset()         { *m = AddInvoiceResponse{} }
+func (m *AddInvoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*AddInvoiceResponse) ProtoMessage()    {}
+func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{122}
+}
+
+func (m *AddInvoiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddInvoiceResponse.Unmarshal(m, b)
+}
+func (m *AddInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddInvoiceResponse.Marshal(b, m, deterministic)
+}
+func (m *AddInvoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddInvoiceResponse.Merge(m, src)
+}
+func (m *AddInvoiceResponse) XXX_Size() int {
+	return xxx_messageInfo_AddInvoiceResponse.Size(m)
+}
+func (m *AddInvoiceResponse) XXX_DiscardUnknown() {
 	xxx_messageInfo_AddInvoiceResponse.DiscardUnknown(m)
 }
 
@@ -9992,7 +11028,7 @@ func (m *PaymentHash) Reset()         { *m = PaymentHash{} }
 func (m *PaymentHash) String() string { return proto.CompactTextString(m) }
 func (*PaymentHash) ProtoMessage()    {}
 func (*PaymentHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{115}
+	return fileDescriptor_77a6da22d6a3feb1, []int{123}
 }
 
 func (m *PaymentHash) XXX_Unmarshal(b []byte) error {
@@ -10052,7 +11088,7 @@ func (m *ListInvoiceRequest) Reset()         { *m = ListInvoiceRequest{} }
 func (m *ListInvoiceRequest) String() string { return proto.CompactTextString(m) }
 func (*ListInvoiceRequest) ProtoMessage()    {}
 func (*ListInvoiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{116}
+	return fileDescriptor_77a6da22d6a3feb1, []int{124}
 }
 
 func (m *ListInvoiceRequest) XXX_Unmarshal(b []byte) error {
@@ -10123,7 +11159,7 @@ func (m *ListInvoiceResponse) Reset()         { *m = ListInvoiceResponse{} }
 func (m *ListInvoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*ListInvoiceResponse) ProtoMessage()    {}
 func (*ListInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{117}
+	return fileDescriptor_77a6da22d6a3feb1, []int{125}
 }
 
 func (m *ListInvoiceResponse) XXX_Unmarshal(b []byte) error {
@@ -10187,7 +11223,7 @@ func (m *InvoiceSubscription) Reset()         { *m = InvoiceSubscription{} }
 func (m *InvoiceSubscription) String() string { return proto.CompactTextString(m) }
 func (*InvoiceSubscription) ProtoMessage()    {}
 func (*InvoiceSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{118}
+	return fileDescriptor_77a6da22d6a3feb1, []int{126}
 }
 
 func (m *InvoiceSubscription) XXX_Unmarshal(b []byte) error {
@@ -10264,7 +11300,7 @@ func (m *Payment) Reset()         { *m = Payment{} }
 func (m *Payment) String() string { return proto.CompactTextString(m) }
 func (*Payment) ProtoMessage()    {}
 func (*Payment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{119}
+	return fileDescriptor_77a6da22d6a3feb1, []int{127}
 }
 
 func (m *Payment) XXX_Unmarshal(b []byte) error {
@@ -10409,7 +11445,9 @@ type HTLCAttempt struct {
 	// Detailed htlc failure info.
 	Failure *Failure `protobuf:"bytes,5,opt,name=failure,proto3" json:"failure,omitempty"`
 	// The preimage that was used to settle the HTLC.
-	Preimage             []byte   `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	Preimage []byte `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// The time preference that was used to find the route of this HTLC.
+	TimePref             float64  `protobuf:"fixed64,8,opt,name=time_pref,json=timePref,proto3" json:"time_pref,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -10419,7 +11457,7 @@ func (m *HTLCAttempt) Reset()         { *m = HTLCAttempt{} }
 func (m *HTLCAttempt) String() string { return proto.CompactTextString(m) }
 func (*HTLCAttempt) ProtoMessage()    {}
 func (*HTLCAttempt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{120}
+	return fileDescriptor_77a6da22d6a3feb1, []int{128}
 }
 
 func (m *HTLCAttempt) XXX_Unmarshal(b []byte) error {
@@ -10489,6 +11527,13 @@ func (m *HTLCAttempt) GetPreimage() []byte {
 	return nil
 }
 
+func (m *HTLCAttempt) GetTimePref() float64 {
+	if m != nil {
+		return m.TimePref
+	}
+	return 0
+}
+
 type ListPaymentsRequest struct {
 	//
 	//If true, then return payments that have not yet fully completed. This means
@@ -10519,7 +11564,7 @@ func (m *ListPaymentsRequest) Reset()         { *m = ListPaymentsRequest{} }
 func (m *ListPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPaymentsRequest) ProtoMessage()    {}
 func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{121}
+	return fileDescriptor_77a6da22d6a3feb1, []int{129}
 }
 
 func (m *ListPaymentsRequest) XXX_Unmarshal(b []byte) error {
@@ -10588,7 +11633,7 @@ func (m *ListPaymentsResponse) Reset()         { *m = ListPaymentsResponse{} }
 func (m *ListPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPaymentsResponse) ProtoMessage()    {}
 func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{122}
+	return fileDescriptor_77a6da22d6a3feb1, []int{130}
 }
 
 func (m *ListPaymentsResponse) XXX_Unmarshal(b []byte) error {
@@ -10645,7 +11690,7 @@ func (m *DeleteAllPaymentsRequest) Reset()         { *m = DeleteAllPaymentsReque
 func (m *DeleteAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsRequest) ProtoMessage()    {}
 func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{123}
+	return fileDescriptor_77a6da22d6a3feb1, []int{131}
 }
 
 func (m *DeleteAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
@@ -10690,7 +11735,7 @@ func (m *DeleteAllPaymentsResponse) Reset()         { *m = DeleteAllPaymentsResp
 func (m *DeleteAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsResponse) ProtoMessage()    {}
 func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{124}
+	return fileDescriptor_77a6da22d6a3feb1, []int{132}
 }
 
 func (m *DeleteAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
@@ -10711,6 +11756,160 @@ func (m *DeleteAllPaymentsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteAllPaymentsResponse proto.InternalMessageInfo
 
+type DeletePaymentRequest struct {
+	// Payment hash of the payment to delete.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	//
+	//Only delete the failed HTLCs of the payment, not the payment itself.
+	FailedHtlcsOnly      bool     `protobuf:"varint,2,opt,name=failed_htlcs_only,json=failedHtlcsOnly,proto3" json:"failed_htlcs_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePaymentRequest) Reset()         { *m = DeletePaymentRequest{} }
+func (m *DeletePaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*DeletePaymentRequest) ProtoMessage()    {}
+func (*DeletePaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{133}
+}
+
+func (m *DeletePaymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletePaymentRequest.Unmarshal(m, b)
+}
+func (m *DeletePaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletePaymentRequest.Marshal(b, m, deterministic)
+}
+func (m *DeletePaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePaymentRequest.Merge(m, src)
+}
+func (m *DeletePaymentRequest) XXX_Size() int {
+	return xxx_messageInfo_DeletePaymentRequest.Size(m)
+}
+func (m *DeletePaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePaymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePaymentRequest proto.InternalMessageInfo
+
+func (m *DeletePaymentRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *DeletePaymentRequest) GetFailedHtlcsOnly() bool {
+	if m != nil {
+		return m.FailedHtlcsOnly
+	}
+	return false
+}
+
+type DeletePaymentResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePaymentResponse) Reset()         { *m = DeletePaymentResponse{} }
+func (m *DeletePaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*DeletePaymentResponse) ProtoMessage()    {}
+func (*DeletePaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{134}
+}
+
+func (m *DeletePaymentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletePaymentResponse.Unmarshal(m, b)
+}
+func (m *DeletePaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletePaymentResponse.Marshal(b, m, deterministic)
+}
+func (m *DeletePaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePaymentResponse.Merge(m, src)
+}
+func (m *DeletePaymentResponse) XXX_Size() int {
+	return xxx_messageInfo_DeletePaymentResponse.Size(m)
+}
+func (m *DeletePaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePaymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePaymentResponse proto.InternalMessageInfo
+
+type DeleteInvoiceRequest struct {
+	//
+	//The payment hash of the invoice to delete. When using REST, this field must
+	//be encoded as base64.
+	RHash                []byte   `protobuf:"bytes,1,opt,name=r_hash,json=rHash,proto3" json:"r_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteInvoiceRequest) Reset()         { *m = DeleteInvoiceRequest{} }
+func (m *DeleteInvoiceRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteInvoiceRequest) ProtoMessage()    {}
+func (*DeleteInvoiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{135}
+}
+
+func (m *DeleteInvoiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteInvoiceRequest.Unmarshal(m, b)
+}
+func (m *DeleteInvoiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteInvoiceRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteInvoiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteInvoiceRequest.Merge(m, src)
+}
+func (m *DeleteInvoiceRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteInvoiceRequest.Size(m)
+}
+func (m *DeleteInvoiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteInvoiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteInvoiceRequest proto.InternalMessageInfo
+
+func (m *DeleteInvoiceRequest) GetRHash() []byte {
+	if m != nil {
+		return m.RHash
+	}
+	return nil
+}
+
+type DeleteInvoiceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteInvoiceResponse) Reset()         { *m = DeleteInvoiceResponse{} }
+func (m *DeleteInvoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteInvoiceResponse) ProtoMessage()    {}
+func (*DeleteInvoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{136}
+}
+
+func (m *DeleteInvoiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteInvoiceResponse.Unmarshal(m, b)
+}
+func (m *DeleteInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteInvoiceResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteInvoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteInvoiceResponse.Merge(m, src)
+}
+func (m *DeleteInvoiceResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteInvoiceResponse.Size(m)
+}
+func (m *DeleteInvoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteInvoiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteInvoiceResponse proto.InternalMessageInfo
+
 type AbandonChannelRequest struct {
 	ChannelPoint           *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
 	PendingFundingShimOnly bool          `protobuf:"varint,2,opt,name=pending_funding_shim_only,json=pendingFundingShimOnly,proto3" json:"pending_funding_shim_only,omitempty"`
@@ -10723,7 +11922,7 @@ func (m *AbandonChannelRequest) Reset()         { *m = AbandonChannelRequest{} }
 func (m *AbandonChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*AbandonChannelRequest) ProtoMessage()    {}
 func (*AbandonChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{125}
+	return fileDescriptor_77a6da22d6a3feb1, []int{137}
 }
 
 func (m *AbandonChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -10768,7 +11967,7 @@ func (m *AbandonChannelResponse) Reset()         { *m = AbandonChannelResponse{}
 func (m *AbandonChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*AbandonChannelResponse) ProtoMessage()    {}
 func (*AbandonChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{126}
+	return fileDescriptor_77a6da22d6a3feb1, []int{138}
 }
 
 func (m *AbandonChannelResponse) XXX_Unmarshal(b []byte) error {
@@ -10801,7 +12000,7 @@ func (m *DebugLevelRequest) Reset()         { *m = DebugLevelRequest{} }
 func (m *DebugLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelRequest) ProtoMessage()    {}
 func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{127}
+	return fileDescriptor_77a6da22d6a3feb1, []int{139}
 }
 
 func (m *DebugLevelRequest) XXX_Unmarshal(b []byte) error {
@@ -10847,7 +12046,7 @@ func (m *DebugLevelResponse) Reset()         { *m = DebugLevelResponse{} }
 func (m *DebugLevelResponse) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelResponse) ProtoMessage()    {}
 func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{128}
+	return fileDescriptor_77a6da22d6a3feb1, []int{140}
 }
 
 func (m *DebugLevelResponse) XXX_Unmarshal(b []byte) error {
@@ -10887,7 +12086,7 @@ func (m *PayReqString) Reset()         { *m = PayReqString{} }
 func (m *PayReqString) String() string { return proto.CompactTextString(m) }
 func (*PayReqString) ProtoMessage()    {}
 func (*PayReqString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{129}
+	return fileDescriptor_77a6da22d6a3feb1, []int{141}
 }
 
 func (m *PayReqString) XXX_Unmarshal(b []byte) error {
@@ -10938,7 +12137,7 @@ func (m *PayReq) Reset()         { *m = PayReq{} }
 func (m *PayReq) String() string { return proto.CompactTextString(m) }
 func (*PayReq) ProtoMessage()    {}
 func (*PayReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{130}
+	return fileDescriptor_77a6da22d6a3feb1, []int{142}
 }
 
 func (m *PayReq) XXX_Unmarshal(b []byte) error {
@@ -11063,7 +12262,7 @@ func (m *Feature) Reset()         { *m = Feature{} }
 func (m *Feature) String() string { return proto.CompactTextString(m) }
 func (*Feature) ProtoMessage()    {}
 func (*Feature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{131}
+	return fileDescriptor_77a6da22d6a3feb1, []int{143}
 }
 
 func (m *Feature) XXX_Unmarshal(b []byte) error {
@@ -11115,7 +12314,7 @@ func (m *FeeReportRequest) Reset()         { *m = FeeReportRequest{} }
 func (m *FeeReportRequest) String() string { return proto.CompactTextString(m) }
 func (*FeeReportRequest) ProtoMessage()    {}
 func (*FeeReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{132}
+	return fileDescriptor_77a6da22d6a3feb1, []int{144}
 }
 
 func (m *FeeReportRequest) XXX_Unmarshal(b []byte) error {
@@ -11148,17 +12347,22 @@ type ChannelFeeReport struct {
 	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil,json=feePerMil,proto3" json:"fee_per_mil,omitempty"`
 	// The effective fee rate in milli-satoshis. Computed by dividing the
 	// fee_per_mil value by 1 million.
-	FeeRate              float64  `protobuf:"fixed64,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// The inbound base fee charged for HTLCs arriving over the channel.
+	InboundFeeBaseMsat int32 `protobuf:"varint,6,opt,name=inbound_fee_base_msat,json=inboundFeeBaseMsat,proto3" json:"inbound_fee_base_msat,omitempty"`
+	// The inbound fee rate charged for HTLCs arriving over the channel, in
+	// parts per million.
+	InboundFeeRateMilliMsat int32    `protobuf:"varint,7,opt,name=inbound_fee_rate_milli_msat,json=inboundFeeRateMilliMsat,proto3" json:"inbound_fee_rate_milli_msat,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
 }
 
 func (m *ChannelFeeReport) Reset()         { *m = ChannelFeeReport{} }
 func (m *ChannelFeeReport) String() string { return proto.CompactTextString(m) }
 func (*ChannelFeeReport) ProtoMessage()    {}
 func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{133}
+	return fileDescriptor_77a6da22d6a3feb1, []int{145}
 }
 
 func (m *ChannelFeeReport) XXX_Unmarshal(b []byte) error {
@@ -11214,6 +12418,20 @@ func (m *ChannelFeeReport) GetFeeRate() float64 {
 	return 0
 }
 
+func (m *ChannelFeeReport) GetInboundFeeBaseMsat() int32 {
+	if m != nil {
+		return m.InboundFeeBaseMsat
+	}
+	return 0
+}
+
+func (m *ChannelFeeReport) GetInboundFeeRateMilliMsat() int32 {
+	if m != nil {
+		return m.InboundFeeRateMilliMsat
+	}
+	return 0
+}
+
 type FeeReportResponse struct {
 	// An array of channel fee reports which describes the current fee schedule
 	// for each channel.
@@ -11236,7 +12454,7 @@ func (m *FeeReportResponse) Reset()         { *m = FeeReportResponse{} }
 func (m *FeeReportResponse) String() string { return proto.CompactTextString(m) }
 func (*FeeReportResponse) ProtoMessage()    {}
 func (*FeeReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{134}
+	return fileDescriptor_77a6da22d6a3feb1, []int{146}
 }
 
 func (m *FeeReportResponse) XXX_Unmarshal(b []byte) error {
@@ -11304,17 +12522,21 @@ type PolicyUpdateRequest struct {
 	// min_htlc_msat_specified is true.
 	MinHtlcMsat uint64 `protobuf:"varint,7,opt,name=min_htlc_msat,json=minHtlcMsat,proto3" json:"min_htlc_msat,omitempty"`
 	// If true, min_htlc_msat is applied.
-	MinHtlcMsatSpecified bool     `protobuf:"varint,8,opt,name=min_htlc_msat_specified,json=minHtlcMsatSpecified,proto3" json:"min_htlc_msat_specified,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	MinHtlcMsatSpecified bool `protobuf:"varint,8,opt,name=min_htlc_msat_specified,json=minHtlcMsatSpecified,proto3" json:"min_htlc_msat_specified,omitempty"`
+	// Optional inbound fee. If unset, the inbound fee of the channel will be
+	// unchanged. A zero inbound fee removes any inbound fee that was set
+	// before.
+	InboundFee           *InboundFee `protobuf:"bytes,9,opt,name=inbound_fee,json=inboundFee,proto3" json:"inbound_fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *PolicyUpdateRequest) Reset()         { *m = PolicyUpdateRequest{} }
 func (m *PolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*PolicyUpdateRequest) ProtoMessage()    {}
 func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{135}
+	return fileDescriptor_77a6da22d6a3feb1, []int{147}
 }
 
 func (m *PolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
@@ -11414,6 +12636,13 @@ func (m *PolicyUpdateRequest) GetMinHtlcMsatSpecified() bool {
 	return false
 }
 
+func (m *PolicyUpdateRequest) GetInboundFee() *InboundFee {
+	if m != nil {
+		return m.InboundFee
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*PolicyUpdateRequest) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -11422,42 +12651,93 @@ func (*PolicyUpdateRequest) XXX_OneofWrappers() []interface{} {
 	}
 }
 
-type PolicyUpdateResponse struct {
+type InboundFee struct {
+	// The inbound base fee charged regardless of the number of milli-satoshis
+	// received in the channel. A negative value acts as a discount.
+	FeeBaseMsat int32 `protobuf:"varint,1,opt,name=fee_base_msat,json=feeBaseMsat,proto3" json:"fee_base_msat,omitempty"`
+	// The inbound fee rate in parts per million. A negative value acts as a
+	// discount.
+	FeeRateMilliMsat     int32    `protobuf:"varint,2,opt,name=fee_rate_milli_msat,json=feeRateMilliMsat,proto3" json:"fee_rate_milli_msat,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PolicyUpdateResponse) Reset()         { *m = PolicyUpdateResponse{} }
-func (m *PolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
-func (*PolicyUpdateResponse) ProtoMessage()    {}
-func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{136}
+func (m *InboundFee) Reset()         { *m = InboundFee{} }
+func (m *InboundFee) String() string { return proto.CompactTextString(m) }
+func (*InboundFee) ProtoMessage()    {}
+func (*InboundFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{148}
 }
 
-func (m *PolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PolicyUpdateResponse.Unmarshal(m, b)
+func (m *InboundFee) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InboundFee.Unmarshal(m, b)
 }
-func (m *PolicyUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PolicyUpdateResponse.Marshal(b, m, deterministic)
+func (m *InboundFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InboundFee.Marshal(b, m, deterministic)
 }
-func (m *PolicyUpdateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PolicyUpdateResponse.Merge(m, src)
+func (m *InboundFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundFee.Merge(m, src)
 }
-func (m *PolicyUpdateResponse) XXX_Size() int {
-	return xxx_messageInfo_PolicyUpdateResponse.Size(m)
+func (m *InboundFee) XXX_Size() int {
+	return xxx_messageInfo_InboundFee.Size(m)
 }
-func (m *PolicyUpdateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PolicyUpdateResponse.DiscardUnknown(m)
+func (m *InboundFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundFee.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PolicyUpdateResponse proto.InternalMessageInfo
+var xxx_messageInfo_InboundFee proto.InternalMessageInfo
 
-type ForwardingHistoryRequest struct {
-	// Start time is the starting point of the forwarding history request. All
-	// records beyond this point will be included, respecting the end time, and
-	// the index offset.
-	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+func (m *InboundFee) GetFeeBaseMsat() int32 {
+	if m != nil {
+		return m.FeeBaseMsat
+	}
+	return 0
+}
+
+func (m *InboundFee) GetFeeRateMilliMsat() int32 {
+	if m != nil {
+		return m.FeeRateMilliMsat
+	}
+	return 0
+}
+
+type PolicyUpdateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PolicyUpdateResponse) Reset()         { *m = PolicyUpdateResponse{} }
+func (m *PolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*PolicyUpdateResponse) ProtoMessage()    {}
+func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{149}
+}
+
+func (m *PolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyUpdateResponse.Unmarshal(m, b)
+}
+func (m *PolicyUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyUpdateResponse.Marshal(b, m, deterministic)
+}
+func (m *PolicyUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyUpdateResponse.Merge(m, src)
+}
+func (m *PolicyUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_PolicyUpdateResponse.Size(m)
+}
+func (m *PolicyUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyUpdateResponse proto.InternalMessageInfo
+
+type ForwardingHistoryRequest struct {
+	// Start time is the starting point of the forwarding history request. All
+	// records beyond this point will be included, respecting the end time, and
+	// the index offset.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 	// End time is the end point of the forwarding history request. The
 	// response will carry at most 50k records between the start time and the
 	// end time. The index offset can be used to implement pagination.
@@ -11477,7 +12757,7 @@ func (m *ForwardingHistoryRequest) Reset()         { *m = ForwardingHistoryReque
 func (m *ForwardingHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*ForwardingHistoryRequest) ProtoMessage()    {}
 func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{137}
+	return fileDescriptor_77a6da22d6a3feb1, []int{150}
 }
 
 func (m *ForwardingHistoryRequest) XXX_Unmarshal(b []byte) error {
@@ -11559,153 +12839,586 @@ type ForwardingEvent struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ForwardingEvent) Reset()         { *m = ForwardingEvent{} }
-func (m *ForwardingEvent) String() string { return proto.CompactTextString(m) }
-func (*ForwardingEvent) ProtoMessage()    {}
-func (*ForwardingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{138}
+func (m *ForwardingEvent) Reset()         { *m = ForwardingEvent{} }
+func (m *ForwardingEvent) String() string { return proto.CompactTextString(m) }
+func (*ForwardingEvent) ProtoMessage()    {}
+func (*ForwardingEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{151}
+}
+
+func (m *ForwardingEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingEvent.Unmarshal(m, b)
+}
+func (m *ForwardingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingEvent.Marshal(b, m, deterministic)
+}
+func (m *ForwardingEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingEvent.Merge(m, src)
+}
+func (m *ForwardingEvent) XXX_Size() int {
+	return xxx_messageInfo_ForwardingEvent.Size(m)
+}
+func (m *ForwardingEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardingEvent proto.InternalMessageInfo
+
+// Deprecated: Do not use.
+func (m *ForwardingEvent) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetChanIdIn() uint64 {
+	if m != nil {
+		return m.ChanIdIn
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetChanIdOut() uint64 {
+	if m != nil {
+		return m.ChanIdOut
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetAmtIn() uint64 {
+	if m != nil {
+		return m.AmtIn
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetAmtOut() uint64 {
+	if m != nil {
+		return m.AmtOut
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetFeeMsat() uint64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetAmtInMsat() uint64 {
+	if m != nil {
+		return m.AmtInMsat
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetAmtOutMsat() uint64 {
+	if m != nil {
+		return m.AmtOutMsat
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetTimestampNs() uint64 {
+	if m != nil {
+		return m.TimestampNs
+	}
+	return 0
+}
+
+type ForwardingHistoryResponse struct {
+	// A list of forwarding events from the time slice of the time series
+	// specified in the request.
+	ForwardingEvents []*ForwardingEvent `protobuf:"bytes,1,rep,name=forwarding_events,json=forwardingEvents,proto3" json:"forwarding_events,omitempty"`
+	// The index of the last time in the set of returned forwarding events. Can
+	// be used to seek further, pagination style.
+	LastOffsetIndex      uint32   `protobuf:"varint,2,opt,name=last_offset_index,json=lastOffsetIndex,proto3" json:"last_offset_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForwardingHistoryResponse) Reset()         { *m = ForwardingHistoryResponse{} }
+func (m *ForwardingHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ForwardingHistoryResponse) ProtoMessage()    {}
+func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{152}
+}
+
+func (m *ForwardingHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingHistoryResponse.Unmarshal(m, b)
+}
+func (m *ForwardingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *ForwardingHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingHistoryResponse.Merge(m, src)
+}
+func (m *ForwardingHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ForwardingHistoryResponse.Size(m)
+}
+func (m *ForwardingHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardingHistoryResponse proto.InternalMessageInfo
+
+func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
+	if m != nil {
+		return m.ForwardingEvents
+	}
+	return nil
+}
+
+func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
+	if m != nil {
+		return m.LastOffsetIndex
+	}
+	return 0
+}
+
+type ForwardingHistoryStatsRequest struct {
+	// Start time is the starting point of the aggregated time range, in
+	// seconds since the unix epoch.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End time is the end point of the aggregated time range, in seconds
+	// since the unix epoch. If not set, the current time is used.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The interval of the time buckets that the statistics are grouped into.
+	Interval             ForwardingHistoryStatsRequest_Interval `protobuf:"varint,3,opt,name=interval,proto3,enum=lnrpc.ForwardingHistoryStatsRequest_Interval" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *ForwardingHistoryStatsRequest) Reset()         { *m = ForwardingHistoryStatsRequest{} }
+func (m *ForwardingHistoryStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*ForwardingHistoryStatsRequest) ProtoMessage()    {}
+func (*ForwardingHistoryStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{153}
+}
+
+func (m *ForwardingHistoryStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingHistoryStatsRequest.Unmarshal(m, b)
+}
+func (m *ForwardingHistoryStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingHistoryStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *ForwardingHistoryStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingHistoryStatsRequest.Merge(m, src)
+}
+func (m *ForwardingHistoryStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_ForwardingHistoryStatsRequest.Size(m)
+}
+func (m *ForwardingHistoryStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingHistoryStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardingHistoryStatsRequest proto.InternalMessageInfo
+
+func (m *ForwardingHistoryStatsRequest) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ForwardingHistoryStatsRequest) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *ForwardingHistoryStatsRequest) GetInterval() ForwardingHistoryStatsRequest_Interval {
+	if m != nil {
+		return m.Interval
+	}
+	return ForwardingHistoryStatsRequest_TOTAL
+}
+
+type ForwardingStats struct {
+	// The number of forwards that entered through the channel or peer.
+	NumIncoming uint64 `protobuf:"varint,1,opt,name=num_incoming,json=numIncoming,proto3" json:"num_incoming,omitempty"`
+	// The total amount of the incoming htlcs of these forwards.
+	AmtInMsat uint64 `protobuf:"varint,2,opt,name=amt_in_msat,json=amtInMsat,proto3" json:"amt_in_msat,omitempty"`
+	// The total fee earned by these forwards.
+	FeeInMsat uint64 `protobuf:"varint,3,opt,name=fee_in_msat,json=feeInMsat,proto3" json:"fee_in_msat,omitempty"`
+	// The number of forwards that left through the channel or peer.
+	NumOutgoing uint64 `protobuf:"varint,4,opt,name=num_outgoing,json=numOutgoing,proto3" json:"num_outgoing,omitempty"`
+	// The total amount of the outgoing htlcs of these forwards.
+	AmtOutMsat uint64 `protobuf:"varint,5,opt,name=amt_out_msat,json=amtOutMsat,proto3" json:"amt_out_msat,omitempty"`
+	// The total fee earned by these forwards.
+	FeeOutMsat           uint64   `protobuf:"varint,6,opt,name=fee_out_msat,json=feeOutMsat,proto3" json:"fee_out_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForwardingStats) Reset()         { *m = ForwardingStats{} }
+func (m *ForwardingStats) String() string { return proto.CompactTextString(m) }
+func (*ForwardingStats) ProtoMessage()    {}
+func (*ForwardingStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{154}
+}
+
+func (m *ForwardingStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingStats.Unmarshal(m, b)
+}
+func (m *ForwardingStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingStats.Marshal(b, m, deterministic)
+}
+func (m *ForwardingStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingStats.Merge(m, src)
+}
+func (m *ForwardingStats) XXX_Size() int {
+	return xxx_messageInfo_ForwardingStats.Size(m)
+}
+func (m *ForwardingStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardingStats proto.InternalMessageInfo
+
+func (m *ForwardingStats) GetNumIncoming() uint64 {
+	if m != nil {
+		return m.NumIncoming
+	}
+	return 0
+}
+
+func (m *ForwardingStats) GetAmtInMsat() uint64 {
+	if m != nil {
+		return m.AmtInMsat
+	}
+	return 0
+}
+
+func (m *ForwardingStats) GetFeeInMsat() uint64 {
+	if m != nil {
+		return m.FeeInMsat
+	}
+	return 0
+}
+
+func (m *ForwardingStats) GetNumOutgoing() uint64 {
+	if m != nil {
+		return m.NumOutgoing
+	}
+	return 0
+}
+
+func (m *ForwardingStats) GetAmtOutMsat() uint64 {
+	if m != nil {
+		return m.AmtOutMsat
+	}
+	return 0
+}
+
+func (m *ForwardingStats) GetFeeOutMsat() uint64 {
+	if m != nil {
+		return m.FeeOutMsat
+	}
+	return 0
+}
+
+type ChannelForwardingStats struct {
+	// The short channel id of the channel.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	// The identity pubkey of the channel peer, if the channel is known.
+	RemotePubkey string `protobuf:"bytes,2,opt,name=remote_pubkey,json=remotePubkey,proto3" json:"remote_pubkey,omitempty"`
+	// The forwarding totals of the channel.
+	Stats *ForwardingStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	// Whether the channel has been closed.
+	Closed bool `protobuf:"varint,4,opt,name=closed,proto3" json:"closed,omitempty"`
+	// The type of the close, if the channel has been closed.
+	CloseType ChannelCloseSummary_ClosureType `protobuf:"varint,5,opt,name=close_type,json=closeType,proto3,enum=lnrpc.ChannelCloseSummary_ClosureType" json:"close_type,omitempty"`
+	// The txid of the closing transaction, if the channel has been closed.
+	ClosingTxHash string `protobuf:"bytes,6,opt,name=closing_tx_hash,json=closingTxHash,proto3" json:"closing_tx_hash,omitempty"`
+	// The height at which the channel was closed, if it has been closed.
+	CloseHeight          uint32   `protobuf:"varint,7,opt,name=close_height,json=closeHeight,proto3" json:"close_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelForwardingStats) Reset()         { *m = ChannelForwardingStats{} }
+func (m *ChannelForwardingStats) String() string { return proto.CompactTextString(m) }
+func (*ChannelForwardingStats) ProtoMessage()    {}
+func (*ChannelForwardingStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{155}
+}
+
+func (m *ChannelForwardingStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelForwardingStats.Unmarshal(m, b)
+}
+func (m *ChannelForwardingStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelForwardingStats.Marshal(b, m, deterministic)
+}
+func (m *ChannelForwardingStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelForwardingStats.Merge(m, src)
+}
+func (m *ChannelForwardingStats) XXX_Size() int {
+	return xxx_messageInfo_ChannelForwardingStats.Size(m)
+}
+func (m *ChannelForwardingStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelForwardingStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelForwardingStats proto.InternalMessageInfo
+
+func (m *ChannelForwardingStats) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *ChannelForwardingStats) GetRemotePubkey() string {
+	if m != nil {
+		return m.RemotePubkey
+	}
+	return ""
+}
+
+func (m *ChannelForwardingStats) GetStats() *ForwardingStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *ChannelForwardingStats) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
+func (m *ChannelForwardingStats) GetCloseType() ChannelCloseSummary_ClosureType {
+	if m != nil {
+		return m.CloseType
+	}
+	return ChannelCloseSummary_COOPERATIVE_CLOSE
+}
+
+func (m *ChannelForwardingStats) GetClosingTxHash() string {
+	if m != nil {
+		return m.ClosingTxHash
+	}
+	return ""
+}
+
+func (m *ChannelForwardingStats) GetCloseHeight() uint32 {
+	if m != nil {
+		return m.CloseHeight
+	}
+	return 0
+}
+
+type PeerForwardingStats struct {
+	// The identity pubkey of the peer.
+	RemotePubkey string `protobuf:"bytes,1,opt,name=remote_pubkey,json=remotePubkey,proto3" json:"remote_pubkey,omitempty"`
+	// The forwarding totals of all channels with the peer.
+	Stats                *ForwardingStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *ForwardingEvent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ForwardingEvent.Unmarshal(m, b)
+func (m *PeerForwardingStats) Reset()         { *m = PeerForwardingStats{} }
+func (m *PeerForwardingStats) String() string { return proto.CompactTextString(m) }
+func (*PeerForwardingStats) ProtoMessage()    {}
+func (*PeerForwardingStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{156}
 }
-func (m *ForwardingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ForwardingEvent.Marshal(b, m, deterministic)
+
+func (m *PeerForwardingStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerForwardingStats.Unmarshal(m, b)
 }
-func (m *ForwardingEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForwardingEvent.Merge(m, src)
+func (m *PeerForwardingStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerForwardingStats.Marshal(b, m, deterministic)
 }
-func (m *ForwardingEvent) XXX_Size() int {
-	return xxx_messageInfo_ForwardingEvent.Size(m)
+func (m *PeerForwardingStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerForwardingStats.Merge(m, src)
 }
-func (m *ForwardingEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForwardingEvent.DiscardUnknown(m)
+func (m *PeerForwardingStats) XXX_Size() int {
+	return xxx_messageInfo_PeerForwardingStats.Size(m)
+}
+func (m *PeerForwardingStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerForwardingStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ForwardingEvent proto.InternalMessageInfo
+var xxx_messageInfo_PeerForwardingStats proto.InternalMessageInfo
 
-// Deprecated: Do not use.
-func (m *ForwardingEvent) GetTimestamp() uint64 {
+func (m *PeerForwardingStats) GetRemotePubkey() string {
 	if m != nil {
-		return m.Timestamp
+		return m.RemotePubkey
 	}
-	return 0
+	return ""
 }
 
-func (m *ForwardingEvent) GetChanIdIn() uint64 {
+func (m *PeerForwardingStats) GetStats() *ForwardingStats {
 	if m != nil {
-		return m.ChanIdIn
+		return m.Stats
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingEvent) GetChanIdOut() uint64 {
+type ForwardingStatsBucket struct {
+	// The start time of the bucket, in seconds since the unix epoch.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The end time of the bucket, in seconds since the unix epoch.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The total number of forwards within the bucket.
+	NumForwards uint64 `protobuf:"varint,3,opt,name=num_forwards,json=numForwards,proto3" json:"num_forwards,omitempty"`
+	// The total amount of the incoming htlcs within the bucket.
+	AmtInMsat uint64 `protobuf:"varint,4,opt,name=amt_in_msat,json=amtInMsat,proto3" json:"amt_in_msat,omitempty"`
+	// The total amount of the outgoing htlcs within the bucket.
+	AmtOutMsat uint64 `protobuf:"varint,5,opt,name=amt_out_msat,json=amtOutMsat,proto3" json:"amt_out_msat,omitempty"`
+	// The total fee earned within the bucket.
+	FeeMsat uint64 `protobuf:"varint,6,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+	// The forwarding totals per channel.
+	Channels []*ChannelForwardingStats `protobuf:"bytes,7,rep,name=channels,proto3" json:"channels,omitempty"`
+	// The forwarding totals per peer. Channels with an unknown peer are not
+	// included.
+	Peers                []*PeerForwardingStats `protobuf:"bytes,8,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ForwardingStatsBucket) Reset()         { *m = ForwardingStatsBucket{} }
+func (m *ForwardingStatsBucket) String() string { return proto.CompactTextString(m) }
+func (*ForwardingStatsBucket) ProtoMessage()    {}
+func (*ForwardingStatsBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{157}
+}
+
+func (m *ForwardingStatsBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingStatsBucket.Unmarshal(m, b)
+}
+func (m *ForwardingStatsBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingStatsBucket.Marshal(b, m, deterministic)
+}
+func (m *ForwardingStatsBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingStatsBucket.Merge(m, src)
+}
+func (m *ForwardingStatsBucket) XXX_Size() int {
+	return xxx_messageInfo_ForwardingStatsBucket.Size(m)
+}
+func (m *ForwardingStatsBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingStatsBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardingStatsBucket proto.InternalMessageInfo
+
+func (m *ForwardingStatsBucket) GetStartTime() uint64 {
 	if m != nil {
-		return m.ChanIdOut
+		return m.StartTime
 	}
 	return 0
 }
 
-func (m *ForwardingEvent) GetAmtIn() uint64 {
+func (m *ForwardingStatsBucket) GetEndTime() uint64 {
 	if m != nil {
-		return m.AmtIn
+		return m.EndTime
 	}
 	return 0
 }
 
-func (m *ForwardingEvent) GetAmtOut() uint64 {
+func (m *ForwardingStatsBucket) GetNumForwards() uint64 {
 	if m != nil {
-		return m.AmtOut
+		return m.NumForwards
 	}
 	return 0
 }
 
-func (m *ForwardingEvent) GetFee() uint64 {
+func (m *ForwardingStatsBucket) GetAmtInMsat() uint64 {
 	if m != nil {
-		return m.Fee
+		return m.AmtInMsat
 	}
 	return 0
 }
 
-func (m *ForwardingEvent) GetFeeMsat() uint64 {
+func (m *ForwardingStatsBucket) GetAmtOutMsat() uint64 {
 	if m != nil {
-		return m.FeeMsat
+		return m.AmtOutMsat
 	}
 	return 0
 }
 
-func (m *ForwardingEvent) GetAmtInMsat() uint64 {
+func (m *ForwardingStatsBucket) GetFeeMsat() uint64 {
 	if m != nil {
-		return m.AmtInMsat
+		return m.FeeMsat
 	}
 	return 0
 }
 
-func (m *ForwardingEvent) GetAmtOutMsat() uint64 {
+func (m *ForwardingStatsBucket) GetChannels() []*ChannelForwardingStats {
 	if m != nil {
-		return m.AmtOutMsat
+		return m.Channels
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingEvent) GetTimestampNs() uint64 {
+func (m *ForwardingStatsBucket) GetPeers() []*PeerForwardingStats {
 	if m != nil {
-		return m.TimestampNs
+		return m.Peers
 	}
-	return 0
+	return nil
 }
 
-type ForwardingHistoryResponse struct {
-	// A list of forwarding events from the time slice of the time series
-	// specified in the request.
-	ForwardingEvents []*ForwardingEvent `protobuf:"bytes,1,rep,name=forwarding_events,json=forwardingEvents,proto3" json:"forwarding_events,omitempty"`
-	// The index of the last time in the set of returned forwarding events. Can
-	// be used to seek further, pagination style.
-	LastOffsetIndex      uint32   `protobuf:"varint,2,opt,name=last_offset_index,json=lastOffsetIndex,proto3" json:"last_offset_index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ForwardingHistoryStatsResponse struct {
+	// The time buckets that contain at least one forward, ordered by start
+	// time. If no interval was requested, a single bucket is returned.
+	Buckets              []*ForwardingStatsBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *ForwardingHistoryResponse) Reset()         { *m = ForwardingHistoryResponse{} }
-func (m *ForwardingHistoryResponse) String() string { return proto.CompactTextString(m) }
-func (*ForwardingHistoryResponse) ProtoMessage()    {}
-func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{139}
+func (m *ForwardingHistoryStatsResponse) Reset()         { *m = ForwardingHistoryStatsResponse{} }
+func (m *ForwardingHistoryStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*ForwardingHistoryStatsResponse) ProtoMessage()    {}
+func (*ForwardingHistoryStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{158}
 }
 
-func (m *ForwardingHistoryResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ForwardingHistoryResponse.Unmarshal(m, b)
+func (m *ForwardingHistoryStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingHistoryStatsResponse.Unmarshal(m, b)
 }
-func (m *ForwardingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ForwardingHistoryResponse.Marshal(b, m, deterministic)
+func (m *ForwardingHistoryStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingHistoryStatsResponse.Marshal(b, m, deterministic)
 }
-func (m *ForwardingHistoryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForwardingHistoryResponse.Merge(m, src)
+func (m *ForwardingHistoryStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingHistoryStatsResponse.Merge(m, src)
 }
-func (m *ForwardingHistoryResponse) XXX_Size() int {
-	return xxx_messageInfo_ForwardingHistoryResponse.Size(m)
+func (m *ForwardingHistoryStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_ForwardingHistoryStatsResponse.Size(m)
 }
-func (m *ForwardingHistoryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForwardingHistoryResponse.DiscardUnknown(m)
+func (m *ForwardingHistoryStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingHistoryStatsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ForwardingHistoryResponse proto.InternalMessageInfo
+var xxx_messageInfo_ForwardingHistoryStatsResponse proto.InternalMessageInfo
 
-func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
+func (m *ForwardingHistoryStatsResponse) GetBuckets() []*ForwardingStatsBucket {
 	if m != nil {
-		return m.ForwardingEvents
+		return m.Buckets
 	}
 	return nil
 }
 
-func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
-	if m != nil {
-		return m.LastOffsetIndex
-	}
-	return 0
-}
-
 type ExportChannelBackupRequest struct {
 	// The target channel point to obtain a back up for.
 	ChanPoint            *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
@@ -11718,7 +13431,7 @@ func (m *ExportChannelBackupRequest) Reset()         { *m = ExportChannelBackupR
 func (m *ExportChannelBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*ExportChannelBackupRequest) ProtoMessage()    {}
 func (*ExportChannelBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{140}
+	return fileDescriptor_77a6da22d6a3feb1, []int{159}
 }
 
 func (m *ExportChannelBackupRequest) XXX_Unmarshal(b []byte) error {
@@ -11765,7 +13478,7 @@ func (m *ChannelBackup) Reset()         { *m = ChannelBackup{} }
 func (m *ChannelBackup) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackup) ProtoMessage()    {}
 func (*ChannelBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{141}
+	return fileDescriptor_77a6da22d6a3feb1, []int{160}
 }
 
 func (m *ChannelBackup) XXX_Unmarshal(b []byte) error {
@@ -11819,7 +13532,7 @@ func (m *MultiChanBackup) Reset()         { *m = MultiChanBackup{} }
 func (m *MultiChanBackup) String() string { return proto.CompactTextString(m) }
 func (*MultiChanBackup) ProtoMessage()    {}
 func (*MultiChanBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{142}
+	return fileDescriptor_77a6da22d6a3feb1, []int{161}
 }
 
 func (m *MultiChanBackup) XXX_Unmarshal(b []byte) error {
@@ -11864,7 +13577,7 @@ func (m *ChanBackupExportRequest) Reset()         { *m = ChanBackupExportRequest
 func (m *ChanBackupExportRequest) String() string { return proto.CompactTextString(m) }
 func (*ChanBackupExportRequest) ProtoMessage()    {}
 func (*ChanBackupExportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{143}
+	return fileDescriptor_77a6da22d6a3feb1, []int{162}
 }
 
 func (m *ChanBackupExportRequest) XXX_Unmarshal(b []byte) error {
@@ -11903,7 +13616,7 @@ func (m *ChanBackupSnapshot) Reset()         { *m = ChanBackupSnapshot{} }
 func (m *ChanBackupSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ChanBackupSnapshot) ProtoMessage()    {}
 func (*ChanBackupSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{144}
+	return fileDescriptor_77a6da22d6a3feb1, []int{163}
 }
 
 func (m *ChanBackupSnapshot) XXX_Unmarshal(b []byte) error {
@@ -11951,7 +13664,7 @@ func (m *ChannelBackups) Reset()         { *m = ChannelBackups{} }
 func (m *ChannelBackups) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackups) ProtoMessage()    {}
 func (*ChannelBackups) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{145}
+	return fileDescriptor_77a6da22d6a3feb1, []int{164}
 }
 
 func (m *ChannelBackups) XXX_Unmarshal(b []byte) error {
@@ -11993,7 +13706,7 @@ func (m *RestoreChanBackupRequest) Reset()         { *m = RestoreChanBackupReque
 func (m *RestoreChanBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreChanBackupRequest) ProtoMessage()    {}
 func (*RestoreChanBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{146}
+	return fileDescriptor_77a6da22d6a3feb1, []int{165}
 }
 
 func (m *RestoreChanBackupRequest) XXX_Unmarshal(b []byte) error {
@@ -12069,7 +13782,7 @@ func (m *RestoreBackupResponse) Reset()         { *m = RestoreBackupResponse{} }
 func (m *RestoreBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreBackupResponse) ProtoMessage()    {}
 func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{147}
+	return fileDescriptor_77a6da22d6a3feb1, []int{166}
 }
 
 func (m *RestoreBackupResponse) XXX_Unmarshal(b []byte) error {
@@ -12100,7 +13813,7 @@ func (m *ChannelBackupSubscription) Reset()         { *m = ChannelBackupSubscrip
 func (m *ChannelBackupSubscription) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackupSubscription) ProtoMessage()    {}
 func (*ChannelBackupSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{148}
+	return fileDescriptor_77a6da22d6a3feb1, []int{167}
 }
 
 func (m *ChannelBackupSubscription) XXX_Unmarshal(b []byte) error {
@@ -12131,7 +13844,7 @@ func (m *VerifyChanBackupResponse) Reset()         { *m = VerifyChanBackupRespon
 func (m *VerifyChanBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyChanBackupResponse) ProtoMessage()    {}
 func (*VerifyChanBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{149}
+	return fileDescriptor_77a6da22d6a3feb1, []int{168}
 }
 
 func (m *VerifyChanBackupResponse) XXX_Unmarshal(b []byte) error {
@@ -12166,7 +13879,7 @@ func (m *MacaroonPermission) Reset()         { *m = MacaroonPermission{} }
 func (m *MacaroonPermission) String() string { return proto.CompactTextString(m) }
 func (*MacaroonPermission) ProtoMessage()    {}
 func (*MacaroonPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{150}
+	return fileDescriptor_77a6da22d6a3feb1, []int{169}
 }
 
 func (m *MacaroonPermission) XXX_Unmarshal(b []byte) error {
@@ -12215,7 +13928,7 @@ func (m *BakeMacaroonRequest) Reset()         { *m = BakeMacaroonRequest{} }
 func (m *BakeMacaroonRequest) String() string { return proto.CompactTextString(m) }
 func (*BakeMacaroonRequest) ProtoMessage()    {}
 func (*BakeMacaroonRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{151}
+	return fileDescriptor_77a6da22d6a3feb1, []int{170}
 }
 
 func (m *BakeMacaroonRequest) XXX_Unmarshal(b []byte) error {
@@ -12262,7 +13975,7 @@ func (m *BakeMacaroonResponse) Reset()         { *m = BakeMacaroonResponse{} }
 func (m *BakeMacaroonResponse) String() string { return proto.CompactTextString(m) }
 func (*BakeMacaroonResponse) ProtoMessage()    {}
 func (*BakeMacaroonResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{152}
+	return fileDescriptor_77a6da22d6a3feb1, []int{171}
 }
 
 func (m *BakeMacaroonResponse) XXX_Unmarshal(b []byte) error {
@@ -12300,7 +14013,7 @@ func (m *ListMacaroonIDsRequest) Reset()         { *m = ListMacaroonIDsRequest{}
 func (m *ListMacaroonIDsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListMacaroonIDsRequest) ProtoMessage()    {}
 func (*ListMacaroonIDsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{153}
+	return fileDescriptor_77a6da22d6a3feb1, []int{172}
 }
 
 func (m *ListMacaroonIDsRequest) XXX_Unmarshal(b []byte) error {
@@ -12333,7 +14046,7 @@ func (m *ListMacaroonIDsResponse) Reset()         { *m = ListMacaroonIDsResponse
 func (m *ListMacaroonIDsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListMacaroonIDsResponse) ProtoMessage()    {}
 func (*ListMacaroonIDsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{154}
+	return fileDescriptor_77a6da22d6a3feb1, []int{173}
 }
 
 func (m *ListMacaroonIDsResponse) XXX_Unmarshal(b []byte) error {
@@ -12373,7 +14086,7 @@ func (m *DeleteMacaroonIDRequest) Reset()         { *m = DeleteMacaroonIDRequest
 func (m *DeleteMacaroonIDRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteMacaroonIDRequest) ProtoMessage()    {}
 func (*DeleteMacaroonIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{155}
+	return fileDescriptor_77a6da22d6a3feb1, []int{174}
 }
 
 func (m *DeleteMacaroonIDRequest) XXX_Unmarshal(b []byte) error {
@@ -12413,7 +14126,7 @@ func (m *DeleteMacaroonIDResponse) Reset()         { *m = DeleteMacaroonIDRespon
 func (m *DeleteMacaroonIDResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteMacaroonIDResponse) ProtoMessage()    {}
 func (*DeleteMacaroonIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{156}
+	return fileDescriptor_77a6da22d6a3feb1, []int{175}
 }
 
 func (m *DeleteMacaroonIDResponse) XXX_Unmarshal(b []byte) error {
@@ -12453,7 +14166,7 @@ func (m *MacaroonPermissionList) Reset()         { *m = MacaroonPermissionList{}
 func (m *MacaroonPermissionList) String() string { return proto.CompactTextString(m) }
 func (*MacaroonPermissionList) ProtoMessage()    {}
 func (*MacaroonPermissionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{157}
+	return fileDescriptor_77a6da22d6a3feb1, []int{176}
 }
 
 func (m *MacaroonPermissionList) XXX_Unmarshal(b []byte) error {
@@ -12491,7 +14204,7 @@ func (m *ListPermissionsRequest) Reset()         { *m = ListPermissionsRequest{}
 func (m *ListPermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPermissionsRequest) ProtoMessage()    {}
 func (*ListPermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{158}
+	return fileDescriptor_77a6da22d6a3feb1, []int{177}
 }
 
 func (m *ListPermissionsRequest) XXX_Unmarshal(b []byte) error {
@@ -12526,7 +14239,7 @@ func (m *ListPermissionsResponse) Reset()         { *m = ListPermissionsResponse
 func (m *ListPermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPermissionsResponse) ProtoMessage()    {}
 func (*ListPermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{159}
+	return fileDescriptor_77a6da22d6a3feb1, []int{178}
 }
 
 func (m *ListPermissionsResponse) XXX_Unmarshal(b []byte) error {
@@ -12582,7 +14295,7 @@ func (m *Failure) Reset()         { *m = Failure{} }
 func (m *Failure) String() string { return proto.CompactTextString(m) }
 func (*Failure) ProtoMessage()    {}
 func (*Failure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{160}
+	return fileDescriptor_77a6da22d6a3feb1, []int{179}
 }
 
 func (m *Failure) XXX_Unmarshal(b []byte) error {
@@ -12726,7 +14439,7 @@ func (m *ChannelUpdate) Reset()         { *m = ChannelUpdate{} }
 func (m *ChannelUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelUpdate) ProtoMessage()    {}
 func (*ChannelUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{161}
+	return fileDescriptor_77a6da22d6a3feb1, []int{180}
 }
 
 func (m *ChannelUpdate) XXX_Unmarshal(b []byte) error {
@@ -12844,7 +14557,7 @@ func (m *MacaroonId) Reset()         { *m = MacaroonId{} }
 func (m *MacaroonId) String() string { return proto.CompactTextString(m) }
 func (*MacaroonId) ProtoMessage()    {}
 func (*MacaroonId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{162}
+	return fileDescriptor_77a6da22d6a3feb1, []int{181}
 }
 
 func (m *MacaroonId) XXX_Unmarshal(b []byte) error {
@@ -12898,7 +14611,7 @@ func (m *Op) Reset()         { *m = Op{} }
 func (m *Op) String() string { return proto.CompactTextString(m) }
 func (*Op) ProtoMessage()    {}
 func (*Op) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{163}
+	return fileDescriptor_77a6da22d6a3feb1, []int{182}
 }
 
 func (m *Op) XXX_Unmarshal(b []byte) error {
@@ -12949,8 +14662,10 @@ func init() {
 	proto.RegisterEnum("lnrpc.PendingChannelsResponse_ForceClosedChannel_AnchorState", PendingChannelsResponse_ForceClosedChannel_AnchorState_name, PendingChannelsResponse_ForceClosedChannel_AnchorState_value)
 	proto.RegisterEnum("lnrpc.ChannelEventUpdate_UpdateType", ChannelEventUpdate_UpdateType_name, ChannelEventUpdate_UpdateType_value)
 	proto.RegisterEnum("lnrpc.Invoice_InvoiceState", Invoice_InvoiceState_name, Invoice_InvoiceState_value)
+	proto.RegisterEnum("lnrpc.InvoiceHtlcRejection_Reason", InvoiceHtlcRejection_Reason_name, InvoiceHtlcRejection_Reason_value)
 	proto.RegisterEnum("lnrpc.Payment_PaymentStatus", Payment_PaymentStatus_name, Payment_PaymentStatus_value)
 	proto.RegisterEnum("lnrpc.HTLCAttempt_HTLCStatus", HTLCAttempt_HTLCStatus_name, HTLCAttempt_HTLCStatus_value)
+	proto.RegisterEnum("lnrpc.ForwardingHistoryStatsRequest_Interval", ForwardingHistoryStatsRequest_Interval_name, ForwardingHistoryStatsRequest_Interval_value)
 	proto.RegisterEnum("lnrpc.Failure_FailureCode", Failure_FailureCode_name, Failure_FailureCode_value)
 	proto.RegisterType((*Utxo)(nil), "lnrpc.Utxo")
 	proto.RegisterType((*Transaction)(nil), "lnrpc.Transaction")
@@ -12995,6 +14710,10 @@ func init() {
 	proto.RegisterType((*Resolution)(nil), "lnrpc.Resolution")
 	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
 	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
+	proto.RegisterType((*ChannelCloseReportRequest)(nil), "lnrpc.ChannelCloseReportRequest")
+	proto.RegisterType((*CloseOutputReport)(nil), "lnrpc.CloseOutputReport")
+	proto.RegisterType((*SweepReport)(nil), "lnrpc.SweepReport")
+	proto.RegisterType((*ChannelCloseReportResponse)(nil), "lnrpc.ChannelCloseReportResponse")
 	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
 	proto.RegisterMapType((map[uint32]*Feature)(nil), "lnrpc.Peer.FeaturesEntry")
 	proto.RegisterType((*TimestampedError)(nil), "lnrpc.TimestampedError")
@@ -13016,6 +14735,9 @@ func init() {
 	proto.RegisterType((*PendingUpdate)(nil), "lnrpc.PendingUpdate")
 	proto.RegisterType((*ReadyForPsbtFunding)(nil), "lnrpc.ReadyForPsbtFunding")
 	proto.RegisterType((*OpenChannelRequest)(nil), "lnrpc.OpenChannelRequest")
+	proto.RegisterType((*BatchOpenChannelRequest)(nil), "lnrpc.BatchOpenChannelRequest")
+	proto.RegisterType((*BatchOpenChannel)(nil), "lnrpc.BatchOpenChannel")
+	proto.RegisterType((*BatchOpenChannelResponse)(nil), "lnrpc.BatchOpenChannelResponse")
 	proto.RegisterType((*OpenStatusUpdate)(nil), "lnrpc.OpenStatusUpdate")
 	proto.RegisterType((*KeyLocator)(nil), "lnrpc.KeyLocator")
 	proto.RegisterType((*KeyDescriptor)(nil), "lnrpc.KeyDescriptor")
@@ -13036,6 +14758,8 @@ func init() {
 	proto.RegisterType((*PendingChannelsResponse_Commitments)(nil), "lnrpc.PendingChannelsResponse.Commitments")
 	proto.RegisterType((*PendingChannelsResponse_ClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ClosedChannel")
 	proto.RegisterType((*PendingChannelsResponse_ForceClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ForceClosedChannel")
+	proto.RegisterType((*PendingChannelsResponse_DustAtRiskHTLC)(nil), "lnrpc.PendingChannelsResponse.DustAtRiskHTLC")
+	proto.RegisterType((*PendingChannelsResponse_DustAtRiskChannel)(nil), "lnrpc.PendingChannelsResponse.DustAtRiskChannel")
 	proto.RegisterType((*ChannelEventSubscription)(nil), "lnrpc.ChannelEventSubscription")
 	proto.RegisterType((*ChannelEventUpdate)(nil), "lnrpc.ChannelEventUpdate")
 	proto.RegisterType((*WalletAccountBalance)(nil), "lnrpc.WalletAccountBalance")
@@ -13085,6 +14809,7 @@ func init() {
 	proto.RegisterType((*InvoiceHTLC)(nil), "lnrpc.InvoiceHTLC")
 	proto.RegisterMapType((map[uint64][]byte)(nil), "lnrpc.InvoiceHTLC.CustomRecordsEntry")
 	proto.RegisterType((*AMP)(nil), "lnrpc.AMP")
+	proto.RegisterType((*InvoiceHtlcRejection)(nil), "lnrpc.InvoiceHtlcRejection")
 	proto.RegisterType((*AddInvoiceResponse)(nil), "lnrpc.AddInvoiceResponse")
 	proto.RegisterType((*PaymentHash)(nil), "lnrpc.PaymentHash")
 	proto.RegisterType((*ListInvoiceRequest)(nil), "lnrpc.ListInvoiceRequest")
@@ -13096,6 +14821,10 @@ func init() {
 	proto.RegisterType((*ListPaymentsResponse)(nil), "lnrpc.ListPaymentsResponse")
 	proto.RegisterType((*DeleteAllPaymentsRequest)(nil), "lnrpc.DeleteAllPaymentsRequest")
 	proto.RegisterType((*DeleteAllPaymentsResponse)(nil), "lnrpc.DeleteAllPaymentsResponse")
+	proto.RegisterType((*DeletePaymentRequest)(nil), "lnrpc.DeletePaymentRequest")
+	proto.RegisterType((*DeletePaymentResponse)(nil), "lnrpc.DeletePaymentResponse")
+	proto.RegisterType((*DeleteInvoiceRequest)(nil), "lnrpc.DeleteInvoiceRequest")
+	proto.RegisterType((*DeleteInvoiceResponse)(nil), "lnrpc.DeleteInvoiceResponse")
 	proto.RegisterType((*AbandonChannelRequest)(nil), "lnrpc.AbandonChannelRequest")
 	proto.RegisterType((*AbandonChannelResponse)(nil), "lnrpc.AbandonChannelResponse")
 	proto.RegisterType((*DebugLevelRequest)(nil), "lnrpc.DebugLevelRequest")
@@ -13108,10 +14837,17 @@ func init() {
 	proto.RegisterType((*ChannelFeeReport)(nil), "lnrpc.ChannelFeeReport")
 	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
 	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
+	proto.RegisterType((*InboundFee)(nil), "lnrpc.InboundFee")
 	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
 	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
 	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
 	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
+	proto.RegisterType((*ForwardingHistoryStatsRequest)(nil), "lnrpc.ForwardingHistoryStatsRequest")
+	proto.RegisterType((*ForwardingStats)(nil), "lnrpc.ForwardingStats")
+	proto.RegisterType((*ChannelForwardingStats)(nil), "lnrpc.ChannelForwardingStats")
+	proto.RegisterType((*PeerForwardingStats)(nil), "lnrpc.PeerForwardingStats")
+	proto.RegisterType((*ForwardingStatsBucket)(nil), "lnrpc.ForwardingStatsBucket")
+	proto.RegisterType((*ForwardingHistoryStatsResponse)(nil), "lnrpc.ForwardingHistoryStatsResponse")
 	proto.RegisterType((*ExportChannelBackupRequest)(nil), "lnrpc.ExportChannelBackupRequest")
 	proto.RegisterType((*ChannelBackup)(nil), "lnrpc.ChannelBackup")
 	proto.RegisterType((*MultiChanBackup)(nil), "lnrpc.MultiChanBackup")
@@ -13142,801 +14878,875 @@ func init() {
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 12703 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0xbd, 0x6b, 0x6c, 0x23, 0x59,
-	0x76, 0x18, 0xdc, 0x7c, 0x89, 0xe4, 0x21, 0x29, 0x51, 0x57, 0x2f, 0xb6, 0x7a, 0x7a, 0xba, 0xa7,
-	0x66, 0x76, 0xa6, 0xb7, 0x67, 0x47, 0xd3, 0xd3, 0x33, 0x3d, 0x8f, 0x9d, 0xcf, 0xeb, 0xa5, 0x24,
-	0xaa, 0xc5, 0x6d, 0x89, 0xd4, 0x16, 0xa9, 0x1e, 0xb7, 0x61, 0xbb, 0x5c, 0x22, 0xaf, 0xa4, 0xfa,
-	0x9a, 0xac, 0xe2, 0x56, 0x15, 0xd5, 0xd2, 0x06, 0x01, 0xfc, 0xc3, 0xb1, 0x03, 0xc3, 0x08, 0x10,
-	0x20, 0x0e, 0x10, 0x24, 0x46, 0x12, 0x24, 0x48, 0xfe, 0x19, 0x06, 0x6c, 0xff, 0x4a, 0x7e, 0x27,
-	0x08, 0x90, 0x20, 0x08, 0x10, 0x23, 0x0f, 0x04, 0x09, 0x02, 0xc4, 0x0e, 0x10, 0x03, 0x89, 0x01,
-	0xff, 0x4c, 0x82, 0x04, 0xf7, 0xdc, 0x47, 0xdd, 0x7a, 0xa8, 0xbb, 0x67, 0x3d, 0xd9, 0x3f, 0x12,
-	0xeb, 0xdc, 0x73, 0xdf, 0xf7, 0x9e, 0x7b, 0x5e, 0xf7, 0x5c, 0xa8, 0xfa, 0xb3, 0xd1, 0xd6, 0xcc,
-	0xf7, 0x42, 0x8f, 0x94, 0x26, 0xae, 0x3f, 0x1b, 0x19, 0x7f, 0x9c, 0x83, 0xe2, 0x71, 0x78, 0xe9,
-	0x91, 0x47, 0x50, 0xb7, 0xc7, 0x63, 0x9f, 0x06, 0x81, 0x15, 0x5e, 0xcd, 0x68, 0x2b, 0x77, 0x37,
-	0x77, 0x6f, 0xf1, 0x21, 0xd9, 0x42, 0xb4, 0xad, 0x36, 0x4f, 0x1a, 0x5e, 0xcd, 0xa8, 0x59, 0xb3,
-	0xa3, 0x0f, 0xd2, 0x82, 0xb2, 0xf8, 0x6c, 0xe5, 0xef, 0xe6, 0xee, 0x55, 0x4d, 0xf9, 0x49, 0x6e,
-	0x03, 0xd8, 0x53, 0x6f, 0xee, 0x86, 0x56, 0x60, 0x87, 0xad, 0xc2, 0xdd, 0xdc, 0xbd, 0x82, 0x59,
-	0xe5, 0x90, 0x81, 0x1d, 0x92, 0x5b, 0x50, 0x9d, 0x3d, 0xb7, 0x82, 0x91, 0xef, 0xcc, 0xc2, 0x56,
-	0x11, 0xb3, 0x56, 0x66, 0xcf, 0x07, 0xf8, 0x4d, 0xde, 0x87, 0x8a, 0x37, 0x0f, 0x67, 0x9e, 0xe3,
-	0x86, 0xad, 0xd2, 0xdd, 0xdc, 0xbd, 0xda, 0xc3, 0x25, 0xd1, 0x90, 0xfe, 0x3c, 0x3c, 0x62, 0x60,
-	0x53, 0x21, 0x90, 0x77, 0xa0, 0x31, 0xf2, 0xdc, 0x53, 0xc7, 0x9f, 0xda, 0xa1, 0xe3, 0xb9, 0x41,
-	0x6b, 0x01, 0xeb, 0x8a, 0x03, 0x8d, 0x7f, 0x92, 0x87, 0xda, 0xd0, 0xb7, 0xdd, 0xc0, 0x1e, 0x31,
-	0x00, 0xd9, 0x80, 0x72, 0x78, 0x69, 0x9d, 0xdb, 0xc1, 0x39, 0x76, 0xb5, 0x6a, 0x2e, 0x84, 0x97,
-	0xfb, 0x76, 0x70, 0x4e, 0xd6, 0x61, 0x81, 0xb7, 0x12, 0x3b, 0x54, 0x30, 0xc5, 0x17, 0x79, 0x1f,
-	0x96, 0xdd, 0xf9, 0xd4, 0x8a, 0x57, 0xc5, 0xba, 0x55, 0x32, 0x9b, 0xee, 0x7c, 0xba, 0xa3, 0xc3,
-	0x59, 0xe7, 0x4f, 0x26, 0xde, 0xe8, 0x39, 0xaf, 0x80, 0x77, 0xaf, 0x8a, 0x10, 0xac, 0xe3, 0x2d,
-	0xa8, 0x8b, 0x64, 0xea, 0x9c, 0x9d, 0xf3, 0x3e, 0x96, 0xcc, 0x1a, 0x47, 0x40, 0x10, 0x2b, 0x21,
-	0x74, 0xa6, 0xd4, 0x0a, 0x42, 0x7b, 0x3a, 0x13, 0x5d, 0xaa, 0x32, 0xc8, 0x80, 0x01, 0x30, 0xd9,
-	0x0b, 0xed, 0x89, 0x75, 0x4a, 0x69, 0xd0, 0x2a, 0x8b, 0x64, 0x06, 0xd9, 0xa3, 0x34, 0x20, 0xdf,
-	0x82, 0xc5, 0x31, 0x0d, 0x42, 0x4b, 0x4c, 0x06, 0x0d, 0x5a, 0x95, 0xbb, 0x85, 0x7b, 0x55, 0xb3,
-	0xc1, 0xa0, 0x6d, 0x09, 0x24, 0x6f, 0x00, 0xf8, 0xf6, 0x0b, 0x8b, 0x0d, 0x04, 0xbd, 0x6c, 0x55,
-	0xf9, 0x2c, 0xf8, 0xf6, 0x8b, 0xe1, 0xe5, 0x3e, 0xbd, 0x24, 0xab, 0x50, 0x9a, 0xd8, 0x27, 0x74,
-	0xd2, 0x02, 0x4c, 0xe0, 0x1f, 0x46, 0x08, 0xeb, 0x8f, 0x69, 0xa8, 0x0d, 0x65, 0x60, 0xd2, 0x1f,
-	0xcd, 0x69, 0x10, 0xb2, 0x5e, 0x05, 0xa1, 0xed, 0x87, 0xb2, 0x57, 0x39, 0xde, 0x2b, 0x84, 0x45,
-	0xbd, 0xa2, 0xee, 0x58, 0x22, 0xe4, 0x11, 0xa1, 0x4a, 0xdd, 0xb1, 0x48, 0x66, 0xab, 0x69, 0x34,
-	0xc2, 0xc1, 0x2f, 0x88, 0xd5, 0xc4, 0x3f, 0x8d, 0x03, 0x20, 0x5a, 0x95, 0xbb, 0x34, 0xb4, 0x9d,
-	0x49, 0x40, 0x3e, 0x85, 0x7a, 0xa8, 0x35, 0xa4, 0x95, 0xbb, 0x5b, 0xb8, 0x57, 0x53, 0x8b, 0x56,
-	0xcb, 0x60, 0xc6, 0xf0, 0x8c, 0x73, 0xa8, 0xec, 0x51, 0x7a, 0xe0, 0x4c, 0x9d, 0x90, 0xac, 0x43,
-	0xe9, 0xd4, 0xb9, 0xa4, 0x63, 0x6c, 0x6e, 0x61, 0xff, 0x86, 0xc9, 0x3f, 0xc9, 0x1d, 0x00, 0xfc,
-	0x61, 0x4d, 0xd5, 0xfa, 0xdd, 0xbf, 0x61, 0x56, 0x11, 0x76, 0x18, 0xd8, 0x21, 0xd9, 0x84, 0xf2,
-	0x8c, 0xfa, 0x23, 0x2a, 0x57, 0xca, 0xfe, 0x0d, 0x53, 0x02, 0xb6, 0xcb, 0x50, 0x9a, 0xb0, 0xd2,
-	0x8d, 0xff, 0x56, 0x82, 0xda, 0x80, 0xba, 0x63, 0x39, 0x46, 0x04, 0x8a, 0x6c, 0x0a, 0xb0, 0xb2,
-	0xba, 0x89, 0xbf, 0xc9, 0xdb, 0x50, 0xc3, 0xc9, 0x0a, 0x42, 0xdf, 0x71, 0xcf, 0xf8, 0x3e, 0xda,
-	0xce, 0xb7, 0x72, 0x26, 0x30, 0xf0, 0x00, 0xa1, 0xa4, 0x09, 0x05, 0x7b, 0x2a, 0xf7, 0x11, 0xfb,
-	0x49, 0x6e, 0x42, 0xc5, 0x9e, 0x86, 0xbc, 0x79, 0x75, 0x04, 0x97, 0xed, 0x69, 0x88, 0x4d, 0x7b,
-	0x0b, 0xea, 0x33, 0xfb, 0x6a, 0x4a, 0xdd, 0x30, 0x5a, 0x80, 0x75, 0xb3, 0x26, 0x60, 0xb8, 0x04,
-	0x1f, 0xc2, 0x8a, 0x8e, 0x22, 0x2b, 0x2f, 0xa9, 0xca, 0x97, 0x35, 0x6c, 0xd1, 0x86, 0xf7, 0x60,
-	0x49, 0xe6, 0xf1, 0x79, 0x7f, 0x70, 0x61, 0x56, 0xcd, 0x45, 0x01, 0x96, 0xbd, 0xbc, 0x07, 0xcd,
-	0x53, 0xc7, 0xb5, 0x27, 0xd6, 0x68, 0x12, 0x5e, 0x58, 0x63, 0x3a, 0x09, 0x6d, 0x5c, 0xa3, 0x25,
-	0x73, 0x11, 0xe1, 0x3b, 0x93, 0xf0, 0x62, 0x97, 0x41, 0xc9, 0x77, 0xa0, 0x7a, 0x4a, 0xa9, 0x85,
-	0x83, 0xd5, 0xaa, 0xc4, 0xb6, 0xba, 0x9c, 0x21, 0xb3, 0x72, 0x2a, 0xe7, 0xea, 0x3b, 0xd0, 0xf4,
-	0xe6, 0xe1, 0x99, 0xe7, 0xb8, 0x67, 0xd6, 0xe8, 0xdc, 0x76, 0x2d, 0x67, 0x8c, 0xab, 0xb6, 0xb8,
-	0x9d, 0x7f, 0x90, 0x33, 0x17, 0x65, 0xda, 0xce, 0xb9, 0xed, 0x76, 0xc7, 0xe4, 0x5d, 0x58, 0x9a,
-	0xd8, 0x41, 0x68, 0x9d, 0x7b, 0x33, 0x6b, 0x36, 0x3f, 0x79, 0x4e, 0xaf, 0x5a, 0x0d, 0x1c, 0x88,
-	0x06, 0x03, 0xef, 0x7b, 0xb3, 0x23, 0x04, 0xb2, 0x45, 0x89, 0xed, 0xe4, 0x8d, 0x60, 0x8b, 0xbd,
-	0x61, 0x56, 0x19, 0x84, 0x57, 0xfa, 0x0c, 0x56, 0x70, 0x7a, 0x46, 0xf3, 0x20, 0xf4, 0xa6, 0x96,
-	0x4f, 0x47, 0x9e, 0x3f, 0x0e, 0x5a, 0x35, 0x5c, 0x6b, 0xdf, 0x16, 0x8d, 0xd5, 0xe6, 0x78, 0x6b,
-	0x97, 0x06, 0xe1, 0x0e, 0x22, 0x9b, 0x1c, 0xb7, 0xe3, 0x86, 0xfe, 0x95, 0xb9, 0x3c, 0x4e, 0xc2,
-	0xc9, 0x77, 0x80, 0xd8, 0x93, 0x89, 0xf7, 0xc2, 0x0a, 0xe8, 0xe4, 0xd4, 0x12, 0x83, 0xd8, 0x5a,
-	0xbc, 0x9b, 0xbb, 0x57, 0x31, 0x9b, 0x98, 0x32, 0xa0, 0x93, 0xd3, 0x23, 0x0e, 0x27, 0x9f, 0x02,
-	0x6e, 0x5f, 0xeb, 0x94, 0xda, 0xe1, 0xdc, 0xa7, 0x41, 0x6b, 0xe9, 0x6e, 0xe1, 0xde, 0xe2, 0xc3,
-	0x65, 0x35, 0x5e, 0x08, 0xde, 0x76, 0x42, 0xb3, 0xce, 0xf0, 0xc4, 0x77, 0xa0, 0xaf, 0x06, 0x46,
-	0x0f, 0x5a, 0xcd, 0xd8, 0x6a, 0x60, 0xd4, 0x60, 0x73, 0x17, 0xd6, 0xb3, 0x5b, 0xcd, 0xd6, 0x1d,
-	0x1b, 0x38, 0xb6, 0x5e, 0x8b, 0x26, 0xfb, 0xc9, 0xc8, 0xc2, 0x85, 0x3d, 0x99, 0x53, 0x5c, 0xa8,
-	0x75, 0x93, 0x7f, 0x7c, 0x37, 0xff, 0x79, 0xce, 0xf8, 0xfd, 0x1c, 0xd4, 0xf9, 0x40, 0x04, 0x33,
-	0xcf, 0x0d, 0x28, 0x79, 0x1b, 0x1a, 0xb2, 0x66, 0xea, 0xfb, 0x9e, 0x2f, 0x48, 0xad, 0x6c, 0x4e,
-	0x87, 0xc1, 0xc8, 0xb7, 0xa1, 0x29, 0x91, 0x66, 0x3e, 0x75, 0xa6, 0xf6, 0x99, 0x2c, 0x5a, 0xae,
-	0xb6, 0x23, 0x01, 0x26, 0x1f, 0x45, 0xe5, 0xf9, 0xde, 0x3c, 0xa4, 0xb8, 0x1d, 0x6a, 0x0f, 0xeb,
-	0x62, 0x04, 0x4c, 0x06, 0x53, 0xa5, 0xe3, 0xd7, 0x6b, 0x6c, 0x05, 0xe3, 0xb7, 0x72, 0x40, 0x58,
-	0xb3, 0x87, 0x1e, 0x2f, 0x20, 0x22, 0x67, 0xb1, 0x9c, 0xb9, 0xd7, 0xde, 0x44, 0xf9, 0x97, 0x6d,
-	0x22, 0x03, 0x4a, 0xbc, 0xed, 0xc5, 0x8c, 0xb6, 0xf3, 0xa4, 0x1f, 0x14, 0x2b, 0x85, 0x66, 0xd1,
-	0xf8, 0xf7, 0x05, 0x58, 0x65, 0x4b, 0xd9, 0xa5, 0x93, 0xf6, 0x68, 0x44, 0x67, 0x6a, 0x7b, 0xdd,
-	0x81, 0x9a, 0xeb, 0x8d, 0xa9, 0x5c, 0xd4, 0xbc, 0x61, 0xc0, 0x40, 0xda, 0x8a, 0x3e, 0xb7, 0x1d,
-	0x97, 0x37, 0x9c, 0x0f, 0x66, 0x15, 0x21, 0xd8, 0xec, 0x77, 0x61, 0x69, 0x46, 0xdd, 0xb1, 0xbe,
-	0x8b, 0x0a, 0x7c, 0x63, 0x08, 0xb0, 0xd8, 0x40, 0x77, 0xa0, 0x76, 0x3a, 0xe7, 0x78, 0x8c, 0xf6,
-	0x14, 0x71, 0x0d, 0x80, 0x00, 0xb5, 0x39, 0x09, 0x9a, 0xcd, 0x83, 0x73, 0x4c, 0x2d, 0x61, 0x6a,
-	0x99, 0x7d, 0xb3, 0xa4, 0xdb, 0x00, 0xe3, 0x79, 0x10, 0x8a, 0x4d, 0xb5, 0x80, 0x89, 0x55, 0x06,
-	0xe1, 0x9b, 0xea, 0x03, 0x58, 0x99, 0xda, 0x97, 0x16, 0xae, 0x1d, 0xcb, 0x71, 0xad, 0xd3, 0x09,
-	0x9e, 0x08, 0x65, 0xc4, 0x6b, 0x4e, 0xed, 0xcb, 0xa7, 0x2c, 0xa5, 0xeb, 0xee, 0x21, 0x9c, 0x51,
-	0x9e, 0x11, 0x1f, 0x09, 0xcb, 0xa7, 0x01, 0xf5, 0x2f, 0x28, 0x12, 0x8b, 0xa2, 0xb9, 0x28, 0xc0,
-	0x26, 0x87, 0xb2, 0x16, 0x4d, 0x59, 0xbf, 0xc3, 0xc9, 0x88, 0x53, 0x06, 0xb3, 0x3c, 0x75, 0xdc,
-	0xfd, 0x70, 0x32, 0x62, 0x87, 0x1d, 0x23, 0x35, 0x33, 0xea, 0x5b, 0xcf, 0x5f, 0xe0, 0x36, 0x2f,
-	0x22, 0x69, 0x39, 0xa2, 0xfe, 0x93, 0x17, 0x8c, 0x1f, 0x19, 0x05, 0x48, 0xab, 0xec, 0xab, 0x56,
-	0x0d, 0x69, 0x40, 0x65, 0x14, 0x30, 0x2a, 0x65, 0x5f, 0xb1, 0x7d, 0xca, 0x5a, 0x6b, 0xe3, 0x2c,
-	0xd0, 0x31, 0x16, 0x1f, 0x20, 0xd1, 0x6d, 0x60, 0x63, 0xdb, 0x22, 0x81, 0xd5, 0x13, 0xb0, 0x55,
-	0x2f, 0x1b, 0x7b, 0x3a, 0xb1, 0xcf, 0x02, 0xa4, 0x3a, 0x0d, 0xb3, 0x2e, 0x80, 0x7b, 0x0c, 0x66,
-	0xfc, 0x59, 0x1e, 0xd6, 0x12, 0x93, 0x2b, 0x36, 0x0d, 0x63, 0x40, 0x10, 0x82, 0x13, 0x5b, 0x31,
-	0xc5, 0x57, 0xd6, 0xac, 0xe5, 0xb3, 0x66, 0x6d, 0x15, 0x4a, 0x7c, 0xb3, 0xf1, 0x23, 0x94, 0x7f,
-	0xb0, 0x5d, 0x36, 0x9f, 0x9d, 0xfa, 0x1e, 0xe3, 0xc7, 0xce, 0xe7, 0xe1, 0xd8, 0x7b, 0xe1, 0x0a,
-	0xbe, 0x64, 0x49, 0xc0, 0x07, 0x02, 0x1c, 0x1f, 0x8a, 0x52, 0x62, 0x28, 0xee, 0x40, 0x4d, 0xcc,
-	0x00, 0xf2, 0x75, 0x7c, 0x62, 0x41, 0x80, 0x18, 0x63, 0xf7, 0x3e, 0x10, 0x35, 0x9f, 0x16, 0x1b,
-	0x35, 0x3c, 0xa0, 0xf8, 0xc4, 0x2e, 0x39, 0x62, 0x42, 0x0f, 0xed, 0x4b, 0x3c, 0xa8, 0xde, 0x81,
-	0x45, 0x86, 0xc2, 0xc6, 0xd3, 0xe2, 0xe7, 0x7e, 0x85, 0x8f, 0xd5, 0xd4, 0xbe, 0x64, 0x83, 0xb9,
-	0x83, 0xac, 0xd7, 0x9b, 0x50, 0x93, 0x93, 0x6a, 0x39, 0xae, 0x98, 0xd7, 0xaa, 0x98, 0xd7, 0xae,
-	0xcb, 0x8e, 0x1b, 0x96, 0xce, 0xc7, 0xc9, 0x1a, 0xd3, 0x59, 0x78, 0x2e, 0xc8, 0xf8, 0xe2, 0xd4,
-	0x71, 0xf9, 0xf0, 0xee, 0x32, 0xa8, 0xf1, 0xdb, 0x39, 0xa8, 0x8b, 0x51, 0x47, 0x36, 0x92, 0x6c,
-	0x01, 0x91, 0x4b, 0x3c, 0xbc, 0x74, 0xc6, 0xd6, 0xc9, 0x55, 0x48, 0x03, 0xbe, 0xa3, 0xf6, 0x6f,
-	0x98, 0x4d, 0x91, 0x36, 0xbc, 0x74, 0xc6, 0xdb, 0x2c, 0x85, 0xdc, 0x87, 0x66, 0x0c, 0x3f, 0x08,
-	0x7d, 0xbe, 0xdd, 0xf7, 0x6f, 0x98, 0x8b, 0x1a, 0xf6, 0x20, 0xf4, 0x19, 0x01, 0x61, 0x4c, 0xea,
-	0x3c, 0xb4, 0x1c, 0x77, 0x4c, 0x2f, 0x71, 0x3e, 0x1a, 0x66, 0x8d, 0xc3, 0xba, 0x0c, 0xb4, 0xbd,
-	0x08, 0x75, 0xbd, 0x38, 0xe3, 0x0c, 0x2a, 0x92, 0xc3, 0x45, 0x16, 0x2f, 0xd1, 0x24, 0xb3, 0x1a,
-	0xaa, 0x96, 0xdc, 0x84, 0x4a, 0xbc, 0x05, 0x66, 0x39, 0x7c, 0xed, 0x8a, 0x8d, 0xef, 0x41, 0xf3,
-	0x80, 0x4d, 0x84, 0xcb, 0x76, 0xb2, 0xe0, 0xd8, 0xd7, 0x61, 0x41, 0xa3, 0x28, 0x55, 0x53, 0x7c,
-	0x31, 0x9e, 0xe5, 0xdc, 0x0b, 0x42, 0x51, 0x0b, 0xfe, 0x36, 0xfe, 0x69, 0x0e, 0x48, 0x27, 0x08,
-	0x9d, 0xa9, 0x1d, 0xd2, 0x3d, 0xaa, 0x68, 0x66, 0x1f, 0xea, 0xac, 0xb4, 0xa1, 0xd7, 0xe6, 0x2c,
-	0x34, 0x67, 0xc8, 0xde, 0x17, 0x34, 0x2e, 0x9d, 0x61, 0x4b, 0xc7, 0xe6, 0xc7, 0x64, 0xac, 0x00,
-	0xb6, 0xdc, 0x42, 0xdb, 0x3f, 0xa3, 0x21, 0x32, 0xde, 0x82, 0x63, 0x04, 0x0e, 0x62, 0x2c, 0xf7,
-	0xe6, 0xcf, 0xc2, 0x72, 0xaa, 0x0c, 0xfd, 0xd0, 0xaa, 0x66, 0x1c, 0x5a, 0x05, 0xfd, 0xd0, 0xfa,
-	0xf5, 0x1c, 0xac, 0xc4, 0x1a, 0x26, 0xb6, 0xe1, 0x06, 0x94, 0x19, 0xb9, 0x60, 0x8b, 0x37, 0xc7,
-	0x05, 0x81, 0x53, 0x8a, 0x0b, 0xfc, 0x63, 0x58, 0x3d, 0xa5, 0xd4, 0xb7, 0x43, 0x4c, 0x44, 0x7a,
-	0xc2, 0xa6, 0x88, 0x97, 0xcc, 0xa9, 0xbe, 0x48, 0x1f, 0xd8, 0xe1, 0x11, 0xf5, 0xd9, 0x74, 0x11,
-	0x03, 0x1a, 0x12, 0xf9, 0x02, 0xb1, 0x0b, 0xb8, 0x88, 0x6b, 0x01, 0xa2, 0x3c, 0x65, 0x20, 0xe3,
-	0x4f, 0xf2, 0xb0, 0xc4, 0xce, 0xa1, 0x43, 0xdb, 0xbd, 0x92, 0x03, 0x7a, 0x90, 0x39, 0xa0, 0xf7,
-	0x34, 0xae, 0x43, 0xc3, 0xfe, 0xba, 0xa3, 0x59, 0x48, 0x8e, 0x66, 0xba, 0x99, 0xc5, 0x54, 0x33,
-	0xc9, 0x3b, 0x50, 0x8f, 0xf5, 0xbb, 0xa4, 0xfa, 0x0d, 0x41, 0xd4, 0x61, 0x25, 0x3c, 0x2c, 0x68,
-	0xc2, 0x03, 0x23, 0x2d, 0x6c, 0xa7, 0xb2, 0xda, 0x03, 0xc1, 0x11, 0x32, 0x7a, 0xcd, 0xea, 0x0e,
-	0x98, 0x84, 0x15, 0x30, 0x52, 0x66, 0xcd, 0x5d, 0x21, 0x65, 0xd1, 0x31, 0xd2, 0x83, 0x8a, 0xd9,
-	0xc4, 0x84, 0xe3, 0x08, 0xfe, 0xe7, 0x9f, 0xf7, 0x77, 0xa1, 0x19, 0x0d, 0x9f, 0x98, 0x73, 0x02,
-	0x45, 0xb6, 0x87, 0x44, 0x01, 0xf8, 0xdb, 0xf8, 0xfb, 0x79, 0x8e, 0xb8, 0xe3, 0x39, 0x91, 0xa8,
-	0x43, 0xa0, 0x88, 0xac, 0x94, 0x40, 0x64, 0xbf, 0xaf, 0x15, 0x1c, 0x7f, 0x8a, 0x83, 0x7e, 0x13,
-	0x2a, 0x01, 0x1b, 0x40, 0x7b, 0xc2, 0xc7, 0xbd, 0x62, 0x96, 0xd9, 0x77, 0x7b, 0x32, 0x89, 0xe6,
-	0xa3, 0x7c, 0xed, 0x7c, 0x54, 0x5e, 0x67, 0x3e, 0xaa, 0xd9, 0xf3, 0x61, 0xbc, 0x07, 0xcb, 0xda,
-	0x28, 0xbd, 0x64, 0x3c, 0xcf, 0x81, 0x1c, 0x38, 0x41, 0x78, 0xec, 0xb2, 0x22, 0x14, 0x4b, 0x13,
-	0x6b, 0x48, 0x2e, 0xd1, 0x10, 0x96, 0x68, 0x5f, 0x8a, 0xc4, 0xbc, 0x48, 0xb4, 0x2f, 0x79, 0xe2,
-	0xf5, 0x32, 0xe3, 0xe7, 0xb0, 0x12, 0xab, 0x49, 0x34, 0xea, 0x2d, 0x28, 0xcd, 0xc3, 0x4b, 0x4f,
-	0x4a, 0x8b, 0x35, 0xb1, 0x97, 0x8e, 0xc3, 0x4b, 0xcf, 0xe4, 0x29, 0xc6, 0x31, 0x2c, 0xf7, 0xe8,
-	0x0b, 0x41, 0x17, 0x65, 0x13, 0xdf, 0x85, 0xe2, 0x2b, 0x34, 0x23, 0x98, 0xae, 0x37, 0x28, 0x1f,
-	0x6f, 0xd0, 0x16, 0x10, 0xbd, 0x58, 0xd1, 0x1e, 0x4d, 0x85, 0x92, 0x8b, 0xa9, 0x50, 0x8c, 0x77,
-	0x81, 0x0c, 0x9c, 0x33, 0xf7, 0x90, 0x06, 0x81, 0x7d, 0xa6, 0x68, 0x6c, 0x13, 0x0a, 0xd3, 0xe0,
-	0x4c, 0x1c, 0x08, 0xec, 0xa7, 0xf1, 0x31, 0xac, 0xc4, 0xf0, 0x44, 0xc1, 0x6f, 0x40, 0x35, 0x70,
-	0xce, 0x5c, 0x94, 0x02, 0x44, 0xd1, 0x11, 0xc0, 0xd8, 0x83, 0xd5, 0xa7, 0xd4, 0x77, 0x4e, 0xaf,
-	0x5e, 0x55, 0x7c, 0xbc, 0x9c, 0x7c, 0xb2, 0x9c, 0x0e, 0xac, 0x25, 0xca, 0x11, 0xd5, 0xf3, 0xad,
-	0x27, 0x66, 0xbf, 0x62, 0xf2, 0x0f, 0xed, 0x90, 0xc9, 0xeb, 0x87, 0x8c, 0xe1, 0x01, 0xd9, 0xf1,
-	0x5c, 0x97, 0x8e, 0xc2, 0x23, 0x4a, 0x7d, 0xd9, 0x98, 0xf7, 0xb5, 0x7d, 0x56, 0x7b, 0xb8, 0x21,
-	0xc6, 0x3c, 0x79, 0x72, 0x89, 0x0d, 0x48, 0xa0, 0x38, 0xa3, 0xfe, 0x14, 0x0b, 0xae, 0x98, 0xf8,
-	0x9b, 0x0d, 0x6e, 0xe8, 0x4c, 0xa9, 0x37, 0x0f, 0x05, 0xc5, 0x95, 0x9f, 0xc6, 0x1a, 0xac, 0xc4,
-	0x2a, 0xe4, 0xad, 0x36, 0x1e, 0xc0, 0xda, 0xae, 0x13, 0x8c, 0xd2, 0x4d, 0xd9, 0x80, 0xf2, 0x6c,
-	0x7e, 0x62, 0xc5, 0x8f, 0xc7, 0x27, 0xf4, 0xca, 0x68, 0xc1, 0x7a, 0x32, 0x87, 0x28, 0xeb, 0xd7,
-	0xf2, 0x50, 0xdc, 0x1f, 0x1e, 0xec, 0x90, 0x4d, 0xa8, 0x38, 0xee, 0xc8, 0x9b, 0x32, 0xe1, 0x80,
-	0x8f, 0x86, 0xfa, 0xbe, 0x96, 0x6c, 0xdc, 0x82, 0x2a, 0xca, 0x14, 0x13, 0x6f, 0xf4, 0x5c, 0xb0,
-	0xe7, 0x15, 0x06, 0x38, 0xf0, 0x46, 0xcf, 0xd9, 0xd6, 0xa4, 0x97, 0x33, 0xc7, 0x47, 0x75, 0x93,
-	0x54, 0xa7, 0x14, 0x39, 0x3f, 0x1a, 0x25, 0x44, 0x4a, 0x17, 0xc1, 0x3a, 0x31, 0x66, 0x80, 0xf3,
-	0xe9, 0xd5, 0x73, 0x64, 0x9d, 0xc6, 0xf4, 0x92, 0x7c, 0x00, 0xe4, 0xd4, 0xf3, 0x5f, 0xd8, 0xbe,
-	0x62, 0x2d, 0x5d, 0x41, 0xb6, 0x8b, 0xe6, 0x72, 0x94, 0x22, 0xd8, 0x26, 0xf2, 0x10, 0xd6, 0x34,
-	0x74, 0xad, 0x60, 0xce, 0xe2, 0xad, 0x44, 0x89, 0xfb, 0xb2, 0x0a, 0xe3, 0x57, 0xf3, 0x40, 0x44,
-	0xfe, 0x1d, 0xcf, 0x0d, 0x42, 0xdf, 0x76, 0xdc, 0x30, 0x88, 0x33, 0x9a, 0xb9, 0x04, 0xa3, 0x79,
-	0x0f, 0x9a, 0xc8, 0xe6, 0xea, 0xdc, 0x66, 0x3e, 0xe2, 0xf9, 0xcd, 0x88, 0xe3, 0x7c, 0x07, 0x16,
-	0x23, 0x51, 0x43, 0x69, 0x1b, 0x8b, 0x66, 0x5d, 0x89, 0x1b, 0x0c, 0xeb, 0x43, 0x58, 0x65, 0x44,
-	0x44, 0xb2, 0xd0, 0x4a, 0x75, 0xc2, 0x89, 0xed, 0xf2, 0xd4, 0xbe, 0x3c, 0xa2, 0x52, 0xb0, 0x41,
-	0xde, 0xd4, 0x80, 0x86, 0xe2, 0x3a, 0x11, 0x93, 0x8f, 0x5c, 0x4d, 0xf0, 0x9d, 0x88, 0x93, 0x2d,
-	0x18, 0x2c, 0x64, 0x0b, 0x06, 0xc6, 0xbf, 0xa9, 0x42, 0x59, 0x0e, 0x23, 0x72, 0xf9, 0xa1, 0x73,
-	0x41, 0x23, 0x2e, 0x9f, 0x7d, 0x31, 0xe1, 0xc1, 0xa7, 0x53, 0x2f, 0x54, 0xd2, 0x1d, 0xdf, 0x26,
-	0x75, 0x0e, 0x14, 0xf2, 0x9d, 0x26, 0x61, 0x70, 0x25, 0x29, 0xa7, 0x7c, 0x52, 0xc2, 0xe0, 0xfc,
-	0xe3, 0x2d, 0x28, 0x4b, 0x39, 0xa1, 0xa8, 0x74, 0x24, 0x0b, 0x23, 0x2e, 0x24, 0x6c, 0x42, 0x65,
-	0x64, 0xcf, 0xec, 0x91, 0x13, 0x72, 0x16, 0xbf, 0x60, 0xaa, 0x6f, 0x56, 0xfa, 0xc4, 0x1b, 0xd9,
-	0x13, 0xeb, 0xc4, 0x9e, 0xd8, 0xee, 0x88, 0x0a, 0xed, 0x63, 0x1d, 0x81, 0xdb, 0x1c, 0x46, 0xbe,
-	0x05, 0x8b, 0xa2, 0x9d, 0x12, 0x8b, 0x2b, 0x21, 0x45, 0xeb, 0x25, 0x1a, 0x93, 0x44, 0xbd, 0x29,
-	0x9b, 0x97, 0x53, 0xca, 0x65, 0xb6, 0x82, 0x59, 0xe5, 0x90, 0x3d, 0x8a, 0xbd, 0x15, 0xc9, 0x2f,
-	0xf8, 0x1a, 0xae, 0xf2, 0xaa, 0x38, 0xf0, 0x2b, 0xbe, 0x7e, 0xd3, 0x82, 0x5b, 0x41, 0x13, 0xdc,
-	0xde, 0x87, 0xe5, 0xb9, 0x1b, 0xd0, 0x30, 0x9c, 0xd0, 0xb1, 0x6a, 0x4b, 0x0d, 0x91, 0x9a, 0x2a,
-	0x41, 0x36, 0x67, 0x0b, 0x56, 0xb8, 0xda, 0x34, 0xb0, 0x43, 0x2f, 0x38, 0x77, 0x02, 0x2b, 0xa0,
-	0xae, 0x54, 0x9f, 0x2d, 0x63, 0xd2, 0x40, 0xa4, 0x0c, 0xb8, 0xca, 0x65, 0x23, 0x81, 0xef, 0xd3,
-	0x11, 0x75, 0x2e, 0xe8, 0x18, 0x85, 0xba, 0x82, 0xb9, 0x16, 0xcb, 0x63, 0x8a, 0x44, 0x94, 0xd0,
-	0xe7, 0x53, 0x6b, 0x3e, 0x1b, 0xdb, 0x8c, 0x79, 0x5f, 0xe4, 0x52, 0x92, 0x3b, 0x9f, 0x1e, 0x73,
-	0x08, 0x79, 0x00, 0x52, 0x6a, 0x13, 0x6b, 0x66, 0x29, 0x76, 0x18, 0x31, 0xaa, 0x61, 0xd6, 0x05,
-	0x06, 0x97, 0x2a, 0xef, 0xe8, 0x9b, 0xa5, 0xc9, 0x56, 0x18, 0x1e, 0xff, 0xd1, 0x86, 0x69, 0x41,
-	0x79, 0xe6, 0x3b, 0x17, 0x76, 0x48, 0x5b, 0xcb, 0xfc, 0xec, 0x17, 0x9f, 0x8c, 0x80, 0x3b, 0xae,
-	0x13, 0x3a, 0x76, 0xe8, 0xf9, 0x2d, 0x82, 0x69, 0x11, 0x80, 0xdc, 0x87, 0x65, 0x5c, 0x27, 0x41,
-	0x68, 0x87, 0xf3, 0x40, 0x88, 0xac, 0x2b, 0x5c, 0x34, 0x64, 0x09, 0x03, 0x84, 0xa3, 0xd4, 0x4a,
-	0x3e, 0x83, 0x75, 0xbe, 0x34, 0x52, 0x5b, 0x73, 0x55, 0x31, 0x24, 0x2b, 0x88, 0xb1, 0x13, 0xdf,
-	0xa3, 0x5f, 0xc0, 0x86, 0x58, 0x2e, 0xa9, 0x9c, 0x6b, 0x2a, 0xe7, 0x2a, 0x47, 0x49, 0x64, 0xdd,
-	0x82, 0x65, 0xd6, 0x34, 0x67, 0x64, 0x89, 0x12, 0xd8, 0xae, 0x58, 0x67, 0xbd, 0xc0, 0x4c, 0x4b,
-	0x3c, 0xd1, 0xc4, 0xb4, 0x27, 0xf4, 0x8a, 0x7c, 0x0f, 0x96, 0xf8, 0xf2, 0x41, 0xbd, 0x0c, 0x1e,
-	0xd9, 0x9b, 0x78, 0x64, 0xaf, 0x89, 0xc1, 0xdd, 0x51, 0xa9, 0x78, 0x6a, 0x2f, 0x8e, 0x62, 0xdf,
-	0x6c, 0x6b, 0x4c, 0x9c, 0x53, 0xca, 0xce, 0x89, 0xd6, 0x06, 0x5f, 0x6c, 0xf2, 0x9b, 0xed, 0xda,
-	0xf9, 0x0c, 0x53, 0x5a, 0x9c, 0x58, 0xf3, 0x2f, 0x5c, 0xc7, 0x13, 0x2f, 0xa0, 0x52, 0xe1, 0xde,
-	0xba, 0x29, 0x36, 0x24, 0x03, 0x4a, 0xf9, 0x8a, 0x09, 0xf0, 0x5c, 0x5b, 0xa2, 0xcc, 0x22, 0xb7,
-	0x70, 0x61, 0x34, 0xb8, 0xd2, 0x44, 0x9a, 0x46, 0x18, 0xc3, 0x78, 0x6e, 0xbf, 0x90, 0x64, 0xfd,
-	0x0d, 0xa4, 0x26, 0xc0, 0x40, 0x82, 0xa0, 0xef, 0xc1, 0xb2, 0x98, 0x85, 0x88, 0x98, 0xb6, 0x6e,
-	0xe3, 0x11, 0x79, 0x53, 0xf6, 0x31, 0x45, 0x6d, 0xcd, 0x26, 0x9f, 0x17, 0x8d, 0xfe, 0xee, 0x03,
-	0x91, 0x93, 0xa2, 0x15, 0xf4, 0xe6, 0xab, 0x0a, 0x5a, 0x16, 0xd3, 0x14, 0x81, 0x8c, 0xdf, 0xcb,
-	0x71, 0x5e, 0x4b, 0x60, 0x07, 0x9a, 0xa6, 0x8a, 0xd3, 0x35, 0xcb, 0x73, 0x27, 0x57, 0x82, 0xd4,
-	0x01, 0x07, 0xf5, 0xdd, 0x09, 0xd2, 0x1a, 0xc7, 0xd5, 0x51, 0xf8, 0xe1, 0x5d, 0x97, 0x40, 0x44,
-	0xba, 0x03, 0xb5, 0xd9, 0xfc, 0x64, 0xe2, 0x8c, 0x38, 0x4a, 0x81, 0x97, 0xc2, 0x41, 0x88, 0xf0,
-	0x16, 0xd4, 0xc5, 0x5a, 0xe7, 0x18, 0x45, 0xc4, 0xa8, 0x09, 0x18, 0xa2, 0x20, 0x73, 0x40, 0x7d,
-	0x24, 0x76, 0x75, 0x13, 0x7f, 0x1b, 0xdb, 0xb0, 0x1a, 0x6f, 0xb4, 0xe0, 0x5c, 0xee, 0x43, 0x45,
-	0x50, 0x52, 0xa9, 0xe6, 0x5d, 0x8c, 0x8f, 0x86, 0xa9, 0xd2, 0x8d, 0x7f, 0x5b, 0x82, 0x15, 0x39,
-	0x46, 0x6c, 0xb2, 0x07, 0xf3, 0xe9, 0xd4, 0xf6, 0x33, 0x48, 0x74, 0xee, 0xe5, 0x24, 0x3a, 0x9f,
-	0x22, 0xd1, 0x71, 0x25, 0x1e, 0xa7, 0xf0, 0x71, 0x25, 0x1e, 0x5b, 0x5d, 0x5c, 0x75, 0xa0, 0xdb,
-	0x99, 0x1a, 0x02, 0x3c, 0xe4, 0xf6, 0xac, 0xd4, 0x81, 0x52, 0xca, 0x38, 0x50, 0xf4, 0xe3, 0x60,
-	0x21, 0x71, 0x1c, 0xbc, 0x05, 0x7c, 0x19, 0xcb, 0xf5, 0x58, 0xe6, 0xda, 0x04, 0x84, 0x89, 0x05,
-	0xf9, 0x1e, 0x2c, 0x25, 0x29, 0x30, 0x27, 0xf5, 0x8b, 0x19, 0xf4, 0xd7, 0x99, 0x52, 0x64, 0x6a,
-	0x34, 0xe4, 0xaa, 0xa0, 0xbf, 0xce, 0x94, 0x1e, 0x60, 0x8a, 0xc4, 0xef, 0x00, 0xf0, 0xba, 0x71,
-	0x1b, 0x03, 0x6e, 0xe3, 0x77, 0x13, 0x2b, 0x53, 0x1b, 0xf5, 0x2d, 0xf6, 0x31, 0xf7, 0x29, 0xee,
-	0xeb, 0x2a, 0xe6, 0xc4, 0x2d, 0xfd, 0x19, 0x2c, 0x7a, 0x33, 0xea, 0x5a, 0x11, 0x15, 0xac, 0x61,
-	0x51, 0x4d, 0x51, 0x54, 0x57, 0xc2, 0xcd, 0x06, 0xc3, 0x53, 0x9f, 0xe4, 0x0b, 0x3e, 0xc8, 0x54,
-	0xcb, 0x59, 0xbf, 0x26, 0xe7, 0x22, 0x22, 0x46, 0x59, 0x3f, 0x46, 0x45, 0x99, 0x37, 0x99, 0x73,
-	0xd3, 0x54, 0x03, 0xd7, 0x91, 0xd4, 0xd5, 0x9b, 0x2a, 0xc5, 0xd4, 0xb1, 0x8c, 0xdf, 0xc8, 0x41,
-	0x4d, 0xeb, 0x03, 0x59, 0x83, 0xe5, 0x9d, 0x7e, 0xff, 0xa8, 0x63, 0xb6, 0x87, 0xdd, 0xa7, 0x1d,
-	0x6b, 0xe7, 0xa0, 0x3f, 0xe8, 0x34, 0x6f, 0x30, 0xf0, 0x41, 0x7f, 0xa7, 0x7d, 0x60, 0xed, 0xf5,
-	0xcd, 0x1d, 0x09, 0xce, 0x91, 0x75, 0x20, 0x66, 0xe7, 0xb0, 0x3f, 0xec, 0xc4, 0xe0, 0x79, 0xd2,
-	0x84, 0xfa, 0xb6, 0xd9, 0x69, 0xef, 0xec, 0x0b, 0x48, 0x81, 0xac, 0x42, 0x73, 0xef, 0xb8, 0xb7,
-	0xdb, 0xed, 0x3d, 0xb6, 0x76, 0xda, 0xbd, 0x9d, 0xce, 0x41, 0x67, 0xb7, 0x59, 0x24, 0x0d, 0xa8,
-	0xb6, 0xb7, 0xdb, 0xbd, 0xdd, 0x7e, 0xaf, 0xb3, 0xdb, 0x2c, 0x19, 0x7f, 0x92, 0x03, 0x88, 0x1a,
-	0xca, 0xe8, 0x6a, 0xd4, 0x54, 0xdd, 0x48, 0xbc, 0x96, 0xea, 0x14, 0xa7, 0xab, 0x7e, 0xec, 0x9b,
-	0x3c, 0x84, 0xb2, 0x37, 0x0f, 0x47, 0xde, 0x94, 0x0b, 0x11, 0x8b, 0x0f, 0x5b, 0xa9, 0x7c, 0x7d,
-	0x9e, 0x6e, 0x4a, 0xc4, 0x98, 0x21, 0xb8, 0xf0, 0x2a, 0x43, 0x70, 0xdc, 0xe2, 0xcc, 0xf9, 0x3a,
-	0xcd, 0xe2, 0x7c, 0x1b, 0x20, 0x78, 0x41, 0xe9, 0x0c, 0x35, 0x6d, 0x62, 0x17, 0x54, 0x11, 0x32,
-	0x64, 0x72, 0xe9, 0x7f, 0xcc, 0xc1, 0x1a, 0xae, 0xa5, 0x71, 0x92, 0x88, 0xdd, 0x85, 0xda, 0xc8,
-	0xf3, 0x66, 0x94, 0x31, 0xd5, 0x8a, 0x5f, 0xd3, 0x41, 0x8c, 0x40, 0x71, 0x82, 0x7c, 0xea, 0xf9,
-	0x23, 0x2a, 0x68, 0x18, 0x20, 0x68, 0x8f, 0x41, 0xd8, 0x1e, 0x12, 0x9b, 0x90, 0x63, 0x70, 0x12,
-	0x56, 0xe3, 0x30, 0x8e, 0xb2, 0x0e, 0x0b, 0x27, 0x3e, 0xb5, 0x47, 0xe7, 0x82, 0x7a, 0x89, 0x2f,
-	0xf2, 0xed, 0x48, 0xe3, 0x38, 0x62, 0x7b, 0x62, 0x42, 0x79, 0xe3, 0x2b, 0xe6, 0x92, 0x80, 0xef,
-	0x08, 0x30, 0x3b, 0xe7, 0xed, 0x13, 0xdb, 0x1d, 0x7b, 0x2e, 0x1d, 0x0b, 0xf9, 0x3f, 0x02, 0x18,
-	0x47, 0xb0, 0x9e, 0xec, 0x9f, 0xa0, 0x77, 0x9f, 0x6a, 0xf4, 0x8e, 0x0b, 0xc5, 0x9b, 0xd7, 0xef,
-	0x31, 0x8d, 0xf6, 0xfd, 0xa7, 0x22, 0x14, 0x99, 0xc0, 0x73, 0xad, 0x6c, 0xa4, 0xcb, 0xb6, 0x85,
-	0x94, 0x7b, 0x00, 0x2a, 0x36, 0x39, 0x03, 0x26, 0x26, 0x0b, 0x21, 0xc8, 0x78, 0xa9, 0x64, 0x9f,
-	0x8e, 0x2e, 0xa4, 0xcc, 0x82, 0x10, 0x93, 0x8e, 0x2e, 0x50, 0xd1, 0x61, 0x87, 0x3c, 0x2f, 0xa7,
-	0x57, 0xe5, 0xc0, 0x0e, 0x31, 0xa7, 0x48, 0xc2, 0x7c, 0x65, 0x95, 0x84, 0xb9, 0x5a, 0x50, 0x76,
-	0xdc, 0x13, 0x6f, 0xee, 0x4a, 0xb5, 0x92, 0xfc, 0x44, 0x6f, 0x04, 0xa4, 0xa4, 0xec, 0x68, 0xe7,
-	0xd4, 0xa8, 0xc2, 0x00, 0x43, 0x76, 0xb8, 0x7f, 0x04, 0xd5, 0xe0, 0xca, 0x1d, 0xe9, 0x34, 0x68,
-	0x55, 0x8c, 0x0f, 0xeb, 0xfd, 0xd6, 0xe0, 0xca, 0x1d, 0xe1, 0x8a, 0xaf, 0x04, 0xe2, 0x17, 0x79,
-	0x04, 0x15, 0x65, 0xa5, 0xe3, 0x27, 0xc8, 0x4d, 0x3d, 0x87, 0x34, 0xcd, 0x71, 0x1d, 0x9d, 0x42,
-	0x25, 0x1f, 0xc2, 0x02, 0x6a, 0xeb, 0x83, 0x56, 0x1d, 0x33, 0x49, 0x81, 0x97, 0x35, 0x03, 0x1d,
-	0x01, 0xe8, 0x18, 0x6d, 0x66, 0xa6, 0x40, 0x63, 0xc3, 0x74, 0x3a, 0xb1, 0x67, 0x42, 0x77, 0xde,
-	0xe0, 0xf6, 0x74, 0x06, 0xe1, 0x8a, 0xf3, 0xbb, 0x50, 0x47, 0x0b, 0x28, 0xe2, 0xb8, 0x9c, 0x0f,
-	0x2d, 0x98, 0xc0, 0x60, 0x7b, 0x13, 0x7b, 0xd6, 0x0b, 0x36, 0x9f, 0x40, 0x23, 0xd6, 0x18, 0x5d,
-	0x85, 0xd6, 0xe0, 0x2a, 0xb4, 0x77, 0x74, 0x15, 0x5a, 0x74, 0x14, 0x8a, 0x6c, 0xba, 0x4a, 0xed,
-	0x08, 0x2a, 0x72, 0x2c, 0x18, 0xcd, 0x39, 0xee, 0x3d, 0xe9, 0xf5, 0xbf, 0xea, 0x59, 0x83, 0x67,
-	0xbd, 0x9d, 0xe6, 0x0d, 0xb2, 0x04, 0xb5, 0xf6, 0x0e, 0x92, 0x31, 0x04, 0xe4, 0x18, 0xca, 0x51,
-	0x7b, 0x30, 0x50, 0x90, 0x3c, 0x43, 0x39, 0xea, 0xf6, 0x7a, 0x9d, 0x5d, 0x0e, 0x28, 0x18, 0x7b,
-	0xd0, 0x4c, 0xf6, 0x9d, 0xad, 0xf2, 0x50, 0xc2, 0x84, 0x5d, 0x32, 0x02, 0x44, 0xd6, 0x8f, 0xbc,
-	0x66, 0xfd, 0x30, 0x1e, 0x41, 0x93, 0x9d, 0xf4, 0x6c, 0xf0, 0x75, 0x77, 0x85, 0x09, 0xe3, 0xc5,
-	0x75, 0xdb, 0x64, 0xc5, 0xac, 0x71, 0x18, 0x56, 0x65, 0x7c, 0x0a, 0xcb, 0x5a, 0xb6, 0x48, 0x7f,
-	0xc4, 0xb8, 0x87, 0xa4, 0xfe, 0x08, 0x25, 0x7f, 0x9e, 0x62, 0x6c, 0xc0, 0x1a, 0xfb, 0xec, 0x5c,
-	0x50, 0x37, 0x1c, 0xcc, 0x4f, 0xb8, 0x97, 0x8b, 0xe3, 0xb9, 0xc6, 0xaf, 0xe6, 0xa0, 0xaa, 0x52,
-	0xae, 0xdf, 0x36, 0x5b, 0x42, 0xd5, 0xc4, 0xe9, 0xe4, 0xa6, 0x56, 0x03, 0x66, 0xdc, 0xc2, 0xbf,
-	0x91, 0xca, 0xc9, 0xd8, 0x82, 0xaa, 0x02, 0xe1, 0x20, 0x76, 0x3a, 0xa6, 0xd5, 0xef, 0x1d, 0x74,
-	0x7b, 0xec, 0xb4, 0x60, 0xe3, 0x8c, 0x80, 0xbd, 0x3d, 0x84, 0xe4, 0x8c, 0x26, 0x2c, 0x3e, 0xa6,
-	0x61, 0xd7, 0x3d, 0xf5, 0xc4, 0x60, 0x18, 0xbf, 0xbe, 0x00, 0x4b, 0x0a, 0x14, 0x29, 0xa6, 0x2e,
-	0xa8, 0x1f, 0x38, 0x9e, 0x8b, 0x0b, 0xa7, 0x6a, 0xca, 0x4f, 0x46, 0xef, 0x84, 0xd8, 0x86, 0x7c,
-	0xc7, 0x2a, 0xa6, 0x0a, 0x41, 0x0f, 0x99, 0x8e, 0xf7, 0x60, 0xc9, 0x19, 0x53, 0x37, 0x74, 0xc2,
-	0x2b, 0x2b, 0x66, 0x53, 0x58, 0x94, 0x60, 0xc1, 0x78, 0xac, 0x42, 0xc9, 0x9e, 0x38, 0xb6, 0xf4,
-	0x1e, 0xe2, 0x1f, 0x0c, 0x3a, 0xf2, 0x26, 0x9e, 0x8f, 0x82, 0x4c, 0xd5, 0xe4, 0x1f, 0xe4, 0x01,
-	0xac, 0x32, 0xa1, 0x4a, 0x37, 0x82, 0x21, 0xc9, 0xe2, 0xe6, 0x0d, 0xe2, 0xce, 0xa7, 0x47, 0x91,
-	0x21, 0x8c, 0xa5, 0x30, 0x76, 0x83, 0xe5, 0x10, 0xfc, 0xa5, 0xca, 0xc0, 0x15, 0x25, 0xcb, 0xee,
-	0x7c, 0xda, 0xc6, 0x14, 0x85, 0xff, 0x10, 0xd6, 0x18, 0xbe, 0xe2, 0x48, 0x55, 0x8e, 0x25, 0xcc,
-	0xc1, 0x0a, 0xeb, 0x8a, 0x34, 0x95, 0xe7, 0x16, 0x54, 0x79, 0xab, 0xd8, 0x92, 0x10, 0xd6, 0x32,
-	0x6c, 0x0a, 0xf5, 0x83, 0x94, 0xa3, 0x0f, 0xd7, 0x0c, 0x24, 0x1d, 0x7d, 0x34, 0x57, 0xa1, 0x4a,
-	0xd2, 0x55, 0xe8, 0x21, 0xac, 0x9d, 0xb0, 0x35, 0x7a, 0x4e, 0xed, 0x31, 0xf5, 0xad, 0x68, 0xe5,
-	0x73, 0xf9, 0x73, 0x85, 0x25, 0xee, 0x63, 0x9a, 0xda, 0x28, 0x8c, 0x35, 0x64, 0x94, 0x88, 0x8e,
-	0xad, 0xd0, 0xb3, 0x90, 0x63, 0x14, 0x6a, 0xdb, 0x06, 0x07, 0x0f, 0xbd, 0x1d, 0x06, 0x8c, 0xe3,
-	0x9d, 0xf9, 0xf6, 0xec, 0x5c, 0x48, 0x87, 0x0a, 0xef, 0x31, 0x03, 0x92, 0x37, 0xa0, 0xcc, 0xf6,
-	0x84, 0x4b, 0xb9, 0x77, 0x04, 0x97, 0xbb, 0x24, 0x88, 0xbc, 0x03, 0x0b, 0x58, 0x47, 0xd0, 0x6a,
-	0xe2, 0x86, 0xa8, 0x47, 0x67, 0x87, 0xe3, 0x9a, 0x22, 0x8d, 0xf1, 0xdf, 0x73, 0xdf, 0xe1, 0x84,
-	0xad, 0x6a, 0xe2, 0x6f, 0xf2, 0x7d, 0x8d, 0x4a, 0xae, 0x60, 0xde, 0x77, 0x44, 0xde, 0xc4, 0x52,
-	0xbc, 0x8e, 0x60, 0x7e, 0xa3, 0xe4, 0xeb, 0x07, 0xc5, 0x4a, 0xad, 0x59, 0x37, 0x5a, 0xe8, 0xdf,
-	0x64, 0xd2, 0x91, 0x77, 0x41, 0xfd, 0xab, 0xd8, 0x1e, 0xc9, 0xc1, 0x46, 0x2a, 0x29, 0xf2, 0x74,
-	0xf0, 0x05, 0xdc, 0x9a, 0x7a, 0x63, 0xc9, 0x25, 0xd4, 0x25, 0xf0, 0xd0, 0x1b, 0x33, 0x6e, 0x66,
-	0x59, 0x21, 0x9d, 0x3a, 0xae, 0x13, 0x9c, 0xd3, 0xb1, 0x60, 0x16, 0x9a, 0x32, 0x61, 0x4f, 0xc0,
-	0x19, 0x4b, 0x3e, 0xf3, 0xbd, 0x33, 0x75, 0x76, 0xe6, 0x4c, 0xf5, 0x6d, 0x7c, 0x06, 0x25, 0x3e,
-	0x83, 0x6c, 0xa3, 0xe0, 0xfc, 0xe6, 0xc4, 0x46, 0x41, 0x68, 0x0b, 0xca, 0x2e, 0x0d, 0x5f, 0x78,
-	0xfe, 0x73, 0xa9, 0x81, 0x16, 0x9f, 0xc6, 0x8f, 0x51, 0xcb, 0xaa, 0x1c, 0xd5, 0xb8, 0x36, 0x82,
-	0x2d, 0x61, 0xbe, 0x04, 0x83, 0x73, 0x5b, 0x28, 0x7e, 0x2b, 0x08, 0x18, 0x9c, 0xdb, 0xa9, 0x25,
-	0x9c, 0x4f, 0xfb, 0xaa, 0xbd, 0x03, 0x8b, 0xd2, 0x35, 0x2e, 0xb0, 0x26, 0xf4, 0x34, 0x14, 0x5b,
-	0xb2, 0x2e, 0xfc, 0xe2, 0x82, 0x03, 0x7a, 0x1a, 0x1a, 0x87, 0xb0, 0x2c, 0x36, 0x4d, 0x7f, 0x46,
-	0x65, 0xd5, 0x9f, 0x67, 0x89, 0x49, 0xb5, 0x87, 0x2b, 0x71, 0xfe, 0x83, 0x73, 0x7a, 0x31, 0xd9,
-	0xc9, 0xf8, 0x61, 0xa4, 0x52, 0x64, 0xdc, 0x89, 0x28, 0x4f, 0x08, 0x2b, 0xd2, 0xa0, 0x2a, 0x9d,
-	0x36, 0x94, 0x48, 0xe4, 0x8c, 0xd9, 0xe8, 0x04, 0xf3, 0xd1, 0x48, 0xba, 0x2c, 0x56, 0x4c, 0xf9,
-	0x69, 0xfc, 0xcf, 0x1c, 0xac, 0x60, 0x61, 0x52, 0xcc, 0x13, 0x27, 0xc5, 0x4f, 0xdc, 0x48, 0x36,
-	0x3f, 0x3a, 0x4b, 0xc8, 0x3f, 0x5e, 0x6d, 0x11, 0x4a, 0x5a, 0x7b, 0x8a, 0x99, 0xd6, 0x9e, 0x6f,
-	0x43, 0x73, 0x4c, 0x27, 0x0e, 0x2e, 0x27, 0xc9, 0x65, 0x71, 0xb6, 0x76, 0x49, 0xc2, 0xa5, 0xea,
-	0x21, 0x65, 0x62, 0x5a, 0x48, 0x9b, 0x1f, 0xff, 0x7a, 0x0e, 0x96, 0x39, 0xa3, 0x87, 0x0a, 0x1f,
-	0x31, 0xa0, 0x5f, 0x4a, 0xcd, 0x86, 0x20, 0xbb, 0xa2, 0xef, 0x11, 0x03, 0x84, 0x50, 0x8e, 0xbc,
-	0x7f, 0x43, 0x68, 0x3c, 0x04, 0x94, 0x7c, 0x17, 0x45, 0x58, 0xd7, 0x42, 0xa0, 0x60, 0xe0, 0x6f,
-	0x66, 0xb0, 0x96, 0x2a, 0x3b, 0x93, 0x6f, 0x5d, 0x04, 0x6d, 0x57, 0x60, 0x81, 0xab, 0xcf, 0x8c,
-	0x3d, 0x68, 0xc4, 0xaa, 0x89, 0x99, 0x95, 0xea, 0xdc, 0xac, 0x94, 0xb2, 0x79, 0xe7, 0xd3, 0x36,
-	0xef, 0x2b, 0x58, 0x31, 0xa9, 0x3d, 0xbe, 0xda, 0xf3, 0xfc, 0xa3, 0xe0, 0x24, 0xdc, 0xe3, 0xdc,
-	0x33, 0x3b, 0xab, 0x94, 0x97, 0x4b, 0xcc, 0x0e, 0x23, 0xed, 0xf9, 0x72, 0x10, 0xbf, 0x05, 0x8b,
-	0x91, 0x3b, 0x8c, 0xa6, 0xb1, 0x6f, 0x28, 0x8f, 0x18, 0x64, 0xba, 0x08, 0x14, 0x67, 0xc1, 0x49,
-	0x28, 0x74, 0xf6, 0xf8, 0xdb, 0xf8, 0xa3, 0x12, 0x10, 0xb6, 0xea, 0x13, 0x0b, 0x2b, 0x35, 0x2d,
-	0xb9, 0xb4, 0xe5, 0x2f, 0xe1, 0xec, 0x93, 0x4f, 0x39, 0xfb, 0x3c, 0x00, 0xa2, 0x21, 0x48, 0x1f,
-	0xa4, 0x82, 0xf2, 0x41, 0x6a, 0x46, 0xb8, 0xc2, 0x05, 0xe9, 0x01, 0xac, 0x0a, 0x71, 0x25, 0xde,
-	0x1d, 0x5c, 0x66, 0x26, 0xe1, 0x72, 0x4b, 0xac, 0x4f, 0xd2, 0xd1, 0x47, 0xaa, 0xc1, 0x0b, 0xdc,
-	0xd1, 0x47, 0x6a, 0xab, 0xb4, 0xc5, 0xbc, 0xf0, 0xca, 0xc5, 0x5c, 0xce, 0x5c, 0xcc, 0x9a, 0xf6,
-	0xb2, 0x12, 0xd7, 0x5e, 0xa6, 0xf4, 0xf0, 0x9c, 0x3f, 0x8f, 0xe9, 0xe1, 0xef, 0x41, 0x53, 0x6a,
-	0xb2, 0x94, 0x8e, 0x54, 0x78, 0x80, 0x08, 0x65, 0x95, 0xd4, 0x92, 0xc6, 0x0c, 0x8d, 0xb5, 0xd7,
-	0xb1, 0x78, 0xd6, 0xb3, 0x2d, 0x9e, 0x69, 0x9d, 0x5f, 0x23, 0x43, 0xe7, 0xf7, 0x28, 0x72, 0xf0,
-	0x08, 0xce, 0x9d, 0x29, 0x32, 0x52, 0x91, 0x87, 0xaa, 0x18, 0xe4, 0xc1, 0xb9, 0x33, 0x35, 0xa5,
-	0xab, 0x15, 0xfb, 0x20, 0x3b, 0x70, 0x47, 0xf4, 0x27, 0xc3, 0x4b, 0x8a, 0x8f, 0xc2, 0x12, 0x2e,
-	0x95, 0x4d, 0x8e, 0x76, 0x98, 0x70, 0x98, 0x4a, 0x0c, 0x8a, 0xf4, 0xb1, 0x09, 0xb8, 0xe2, 0x58,
-	0x0e, 0xca, 0x21, 0x77, 0xb2, 0x41, 0xf2, 0xc0, 0x50, 0x84, 0x52, 0x31, 0xb8, 0x40, 0xbe, 0xab,
-	0x61, 0xd6, 0xa6, 0xf6, 0xe5, 0x01, 0x2a, 0x0d, 0x83, 0x0b, 0xd6, 0xdd, 0x60, 0x7e, 0x12, 0xfa,
-	0xf6, 0x28, 0xe4, 0x4e, 0xc7, 0x9c, 0x55, 0xa8, 0x4b, 0xe0, 0x1e, 0xa5, 0x81, 0xf1, 0x67, 0x39,
-	0x68, 0xb2, 0x75, 0x1e, 0x23, 0x21, 0x5f, 0x00, 0x12, 0xc5, 0xd7, 0xa4, 0x20, 0x35, 0x86, 0x2b,
-	0x09, 0xc8, 0x67, 0x80, 0x14, 0xc1, 0xf2, 0x66, 0xd4, 0x15, 0xf4, 0xa3, 0x15, 0xa7, 0x1f, 0xd1,
-	0x59, 0xb2, 0x7f, 0x83, 0x8b, 0xa6, 0x0c, 0x42, 0xbe, 0x80, 0x2a, 0xdb, 0x78, 0xb8, 0xc2, 0x85,
-	0x0b, 0xf9, 0xa6, 0x52, 0x37, 0xa4, 0x68, 0x00, 0xcb, 0x3a, 0x13, 0x9f, 0x59, 0x7e, 0x56, 0xc5,
-	0x0c, 0x3f, 0x2b, 0x8d, 0x40, 0xed, 0x03, 0x3c, 0xa1, 0x57, 0x6c, 0xa4, 0x42, 0xcf, 0x67, 0x0c,
-	0x1d, 0xdb, 0x87, 0xa7, 0xf6, 0xd4, 0x11, 0x2a, 0xcf, 0x92, 0x59, 0x7d, 0x4e, 0xaf, 0xf6, 0x10,
-	0xc0, 0x16, 0x20, 0x4b, 0x8e, 0xa8, 0x54, 0xc9, 0xac, 0x3c, 0xa7, 0x57, 0x9c, 0x44, 0x59, 0xd0,
-	0x78, 0x42, 0xaf, 0x76, 0x29, 0x97, 0x18, 0x3c, 0x9f, 0xcd, 0x8c, 0x6f, 0xbf, 0x60, 0x22, 0x42,
-	0xcc, 0x0f, 0xa8, 0xe6, 0xdb, 0x2f, 0x9e, 0xd0, 0x2b, 0xe9, 0x93, 0x54, 0x66, 0xe9, 0x13, 0x6f,
-	0x24, 0x78, 0x1c, 0xa9, 0x65, 0x8a, 0x1a, 0x65, 0x2e, 0x3c, 0xc7, 0xdf, 0xc6, 0x9f, 0xe6, 0xa0,
-	0xc1, 0xda, 0x8f, 0xc7, 0x13, 0x2e, 0x35, 0xe1, 0x58, 0x9c, 0x8b, 0x1c, 0x8b, 0x1f, 0x0a, 0xaa,
-	0xcd, 0xcf, 0xba, 0xfc, 0xf5, 0x67, 0x1d, 0xce, 0x0d, 0x3f, 0xe8, 0x3e, 0x82, 0x2a, 0x5f, 0x3d,
-	0x8c, 0x46, 0x15, 0x62, 0x13, 0x1c, 0xeb, 0x90, 0x59, 0x41, 0xb4, 0x27, 0xdc, 0x49, 0x51, 0x53,
-	0xe8, 0xf3, 0x21, 0xae, 0xfa, 0x4a, 0x8d, 0x9f, 0x31, 0x0d, 0xa5, 0x6b, 0x9c, 0x14, 0x75, 0x6d,
-	0xf9, 0x42, 0x52, 0x5b, 0x6e, 0xb8, 0x50, 0x61, 0x53, 0x8d, 0x9d, 0xcd, 0x28, 0x34, 0x97, 0x55,
-	0x28, 0xe3, 0x88, 0x6c, 0x76, 0xe8, 0x31, 0x42, 0x9e, 0x17, 0x1c, 0x91, 0x1d, 0x50, 0x56, 0x10,
-	0x6b, 0xb8, 0xeb, 0x59, 0xa8, 0x7e, 0x16, 0x8a, 0xd9, 0x8a, 0x59, 0x75, 0xbd, 0x23, 0x0e, 0x30,
-	0xfe, 0x52, 0x0e, 0x6a, 0xda, 0xc6, 0x46, 0x7b, 0x84, 0x1a, 0x4e, 0x4e, 0x05, 0xe2, 0x3b, 0x20,
-	0x36, 0x1f, 0xfb, 0x37, 0xcc, 0xc6, 0x28, 0x36, 0x41, 0x5b, 0x62, 0x29, 0x63, 0xce, 0x7c, 0x4c,
-	0x09, 0x26, 0xfb, 0x25, 0xd7, 0x2f, 0xfb, 0xbd, 0xbd, 0x00, 0x45, 0x86, 0x6a, 0x7c, 0x09, 0xcb,
-	0x5a, 0x33, 0xb8, 0x92, 0xe8, 0x75, 0x07, 0xc0, 0xf8, 0x05, 0x95, 0x99, 0xd5, 0xc1, 0x0d, 0xfc,
-	0xd2, 0x1f, 0x94, 0x8e, 0xf9, 0xb8, 0x08, 0xbf, 0x53, 0x0e, 0xc2, 0x91, 0x79, 0x4d, 0x17, 0x45,
-	0xe3, 0x57, 0x72, 0xb0, 0xa2, 0x15, 0xbf, 0xe7, 0xb8, 0xf6, 0xc4, 0xf9, 0x31, 0x9e, 0x75, 0x81,
-	0x73, 0xe6, 0x26, 0x2a, 0xe0, 0xa0, 0xaf, 0x53, 0x01, 0xb9, 0x0b, 0x75, 0xee, 0x80, 0xce, 0xaf,
-	0x37, 0x88, 0xb3, 0x18, 0x10, 0x66, 0xda, 0x2f, 0x86, 0x97, 0xc6, 0xdf, 0xc8, 0xc3, 0xaa, 0x68,
-	0x02, 0xde, 0x13, 0x70, 0x18, 0x3f, 0x7c, 0x18, 0x9c, 0x91, 0x2f, 0xa0, 0xc1, 0x86, 0xcf, 0xf2,
-	0xe9, 0x99, 0x13, 0x84, 0x54, 0xfa, 0x1e, 0x64, 0x90, 0x6c, 0xc6, 0xee, 0x30, 0x54, 0x53, 0x60,
-	0x92, 0x2f, 0xa1, 0x86, 0x59, 0xb9, 0x9e, 0x4e, 0xcc, 0x55, 0x2b, 0x9d, 0x91, 0xcf, 0xc5, 0xfe,
-	0x0d, 0x13, 0x82, 0x68, 0x66, 0xbe, 0x84, 0x1a, 0x4e, 0xf3, 0x05, 0x8e, 0x75, 0x82, 0xd8, 0xa5,
-	0xe6, 0x82, 0x65, 0x9e, 0x45, 0x33, 0xd3, 0x86, 0x06, 0x27, 0x77, 0x62, 0x24, 0x85, 0x73, 0xf1,
-	0x66, 0x3a, 0xbb, 0x1c, 0x6b, 0xd6, 0xf8, 0x99, 0xf6, 0xbd, 0x5d, 0x85, 0x72, 0xe8, 0x3b, 0x67,
-	0x67, 0xd4, 0x37, 0xd6, 0xd5, 0xd0, 0x30, 0x3a, 0x4e, 0x07, 0x21, 0x9d, 0x31, 0x41, 0xc7, 0xf8,
-	0xe7, 0x39, 0xa8, 0x09, 0xca, 0xfc, 0x13, 0xbb, 0x35, 0x6c, 0x26, 0x34, 0xba, 0x55, 0x4d, 0x81,
-	0xfb, 0x1e, 0x2c, 0x4d, 0x99, 0x54, 0xe6, 0x84, 0x57, 0x71, 0x9f, 0x86, 0x45, 0x09, 0x16, 0x02,
-	0xc7, 0x16, 0xac, 0xa0, 0xfc, 0x11, 0x58, 0xa1, 0x33, 0xb1, 0x64, 0xa2, 0xb8, 0x46, 0xb3, 0xcc,
-	0x93, 0x86, 0xce, 0xe4, 0x50, 0x24, 0x30, 0x36, 0x3c, 0x08, 0xed, 0x33, 0x2a, 0xa8, 0x03, 0xff,
-	0x60, 0x92, 0x5e, 0x42, 0x61, 0x20, 0x25, 0xbd, 0xff, 0xbd, 0x0c, 0x1b, 0xa9, 0x24, 0x21, 0xe9,
-	0x29, 0x13, 0xf2, 0xc4, 0x99, 0x9e, 0x78, 0xca, 0x84, 0x91, 0xd3, 0x4c, 0xc8, 0x07, 0x2c, 0x45,
-	0x9a, 0x30, 0x28, 0xac, 0xc9, 0x25, 0x8b, 0x36, 0x08, 0xa5, 0x53, 0xc8, 0xa3, 0xc4, 0xfb, 0x51,
-	0xfc, 0x18, 0x4c, 0x56, 0x27, 0xe1, 0x3a, 0xf3, 0xb8, 0x32, 0x4b, 0xc1, 0x02, 0xf2, 0xff, 0x43,
-	0x4b, 0xed, 0x0c, 0x21, 0x00, 0x69, 0x0a, 0x12, 0x56, 0xd3, 0x77, 0x5e, 0x51, 0x53, 0x4c, 0x39,
-	0x8c, 0xfc, 0xd9, 0xba, 0xdc, 0x54, 0xbc, 0x40, 0x55, 0xd7, 0x05, 0xbc, 0x29, 0xeb, 0x42, 0x81,
-	0x26, 0x5d, 0x63, 0xf1, 0xb5, 0xfa, 0x86, 0x8a, 0xef, 0x58, 0xb5, 0xe6, 0x2d, 0x51, 0xb0, 0x4a,
-	0xd2, 0xeb, 0x3d, 0x87, 0xf5, 0x17, 0xb6, 0x13, 0xca, 0x3e, 0x6a, 0xfa, 0x99, 0x12, 0xd6, 0xf7,
-	0xf0, 0x15, 0xf5, 0x7d, 0xc5, 0x33, 0xc7, 0x44, 0xbc, 0xd5, 0x17, 0x69, 0x60, 0xb0, 0xf9, 0x77,
-	0x0b, 0xb0, 0x18, 0x2f, 0x85, 0x91, 0x1e, 0x71, 0x5c, 0x49, 0x6e, 0x5b, 0x88, 0x09, 0xc2, 0xbc,
-	0xd6, 0xe3, 0x5c, 0x76, 0xda, 0xf0, 0x97, 0xcf, 0x30, 0xfc, 0xe9, 0xf6, 0xb6, 0xc2, 0xab, 0xdc,
-	0x2f, 0x8a, 0xaf, 0xe5, 0x7e, 0x51, 0xca, 0x72, 0xbf, 0xf8, 0xf8, 0x5a, 0x7b, 0x3d, 0xd7, 0x9a,
-	0x67, 0xda, 0xea, 0x1f, 0x5d, 0x6f, 0xab, 0xe7, 0x0a, 0xf5, 0xeb, 0xec, 0xf4, 0x9a, 0x97, 0x41,
-	0xe5, 0x1a, 0x2b, 0x99, 0xe6, 0x77, 0x90, 0x61, 0xa7, 0xaf, 0x7e, 0x0d, 0x3b, 0xfd, 0xe6, 0x9f,
-	0xe6, 0x80, 0xa4, 0x77, 0x07, 0x79, 0xcc, 0x6d, 0xaa, 0x2e, 0x9d, 0x08, 0xca, 0xfd, 0xc1, 0xeb,
-	0xed, 0x30, 0xb9, 0x20, 0x64, 0x6e, 0xf2, 0x21, 0xac, 0xe8, 0x97, 0xfd, 0x74, 0xfd, 0x47, 0xc3,
-	0x24, 0x7a, 0x52, 0xa4, 0xc9, 0xd3, 0x7c, 0x5d, 0x8a, 0xaf, 0xf4, 0x75, 0x29, 0xbd, 0xd2, 0xd7,
-	0x65, 0x21, 0xee, 0xeb, 0xb2, 0xf9, 0xaf, 0x72, 0xb0, 0x92, 0xb1, 0x88, 0xbf, 0xb9, 0x3e, 0xb3,
-	0xb5, 0x17, 0x23, 0x6b, 0x79, 0xb1, 0xf6, 0x74, 0x8a, 0x76, 0x20, 0xb5, 0xbf, 0x6c, 0x2a, 0x02,
-	0x71, 0x52, 0xdd, 0x7f, 0x15, 0x75, 0x89, 0x72, 0x98, 0x7a, 0xf6, 0xcd, 0xbf, 0x97, 0x87, 0x9a,
-	0x96, 0xc8, 0x46, 0x91, 0x2f, 0x59, 0xcd, 0x73, 0x94, 0xf3, 0x96, 0xa8, 0xbd, 0xc1, 0xfb, 0x07,
-	0xb8, 0x38, 0x31, 0x9d, 0x6f, 0x2e, 0xc1, 0x48, 0x22, 0xc2, 0x16, 0xac, 0x48, 0x7b, 0x37, 0x8d,
-	0x3c, 0xeb, 0xc5, 0x59, 0x23, 0x5c, 0x17, 0x44, 0x23, 0x11, 0xff, 0x43, 0x29, 0x0c, 0x47, 0x73,
-	0xa7, 0xd9, 0x0f, 0x97, 0x85, 0xd3, 0x84, 0x98, 0x44, 0xb6, 0xce, 0x3f, 0x82, 0x35, 0xe5, 0x35,
-	0x11, 0xcb, 0xc1, 0xad, 0x54, 0x44, 0x7a, 0x47, 0x68, 0x59, 0xbe, 0x0f, 0xb7, 0x13, 0x6d, 0x4a,
-	0x64, 0xe5, 0xea, 0x98, 0x9b, 0xb1, 0xd6, 0xe9, 0x25, 0x6c, 0xfe, 0x05, 0x68, 0xc4, 0x08, 0xe5,
-	0x37, 0x37, 0xe5, 0x49, 0x8d, 0x19, 0x1f, 0x51, 0x5d, 0x63, 0xb6, 0xf9, 0xdf, 0x0b, 0x40, 0xd2,
-	0xb4, 0xfa, 0xa7, 0xd9, 0x84, 0xf4, 0xc2, 0x2c, 0x64, 0x2c, 0xcc, 0xff, 0x67, 0xfc, 0x43, 0xa4,
-	0xb8, 0xd5, 0x9c, 0x16, 0xf8, 0xe6, 0x6c, 0xaa, 0x04, 0xd9, 0x8a, 0xcf, 0x92, 0xae, 0x5d, 0x95,
-	0xd8, 0xad, 0x54, 0x8d, 0x81, 0x4a, 0x78, 0x78, 0x1d, 0xc3, 0x82, 0xed, 0x8e, 0xce, 0x3d, 0x5f,
-	0xd0, 0xc1, 0x9f, 0xf9, 0xda, 0xc7, 0xe7, 0x56, 0x1b, 0xf3, 0x23, 0xd7, 0x66, 0x8a, 0xc2, 0x8c,
-	0x8f, 0xa0, 0xa6, 0x81, 0x49, 0x15, 0x4a, 0x07, 0xdd, 0xc3, 0xed, 0x7e, 0xf3, 0x06, 0x69, 0x40,
-	0xd5, 0xec, 0xec, 0xf4, 0x9f, 0x76, 0xcc, 0xce, 0x6e, 0x33, 0x47, 0x2a, 0x50, 0x3c, 0xe8, 0x0f,
-	0x86, 0xcd, 0xbc, 0xb1, 0x09, 0x2d, 0x51, 0x62, 0xda, 0x84, 0xf5, 0x5b, 0x45, 0xa5, 0x78, 0xc5,
-	0x44, 0x21, 0xe4, 0x7f, 0x0c, 0x75, 0x9d, 0xbd, 0x11, 0x2b, 0x22, 0xe1, 0x37, 0xc3, 0xc4, 0x7b,
-	0x4f, 0xa3, 0xd5, 0x3b, 0xc0, 0xbd, 0x26, 0xc6, 0x2a, 0x5b, 0x3e, 0xc6, 0xb7, 0x66, 0x98, 0x9f,
-	0x51, 0x3e, 0x8a, 0x2d, 0xc3, 0xff, 0x0f, 0x16, 0xe3, 0xe6, 0x1a, 0x41, 0x91, 0xb2, 0x44, 0x56,
-	0x96, 0x3b, 0x66, 0xbf, 0x21, 0xdf, 0x87, 0x66, 0xd2, 0xdc, 0x23, 0x98, 0xe7, 0x6b, 0xf2, 0x2f,
-	0x39, 0x71, 0x0b, 0x10, 0xd9, 0x87, 0xd5, 0x2c, 0x06, 0x0f, 0xd7, 0xc7, 0xf5, 0x6a, 0x0e, 0x92,
-	0x66, 0xe2, 0xc8, 0xe7, 0xc2, 0xec, 0x57, 0xc2, 0xe9, 0x7f, 0x27, 0x5e, 0xbf, 0x36, 0xd8, 0x5b,
-	0xfc, 0x9f, 0x66, 0x00, 0xbc, 0x00, 0x88, 0x60, 0xa4, 0x09, 0xf5, 0xfe, 0x51, 0xa7, 0x67, 0xed,
-	0xec, 0xb7, 0x7b, 0xbd, 0xce, 0x41, 0xf3, 0x06, 0x21, 0xb0, 0x88, 0xae, 0x1f, 0xbb, 0x0a, 0x96,
-	0x63, 0x30, 0x61, 0x8f, 0x95, 0xb0, 0x3c, 0x59, 0x85, 0x66, 0xb7, 0x97, 0x80, 0x16, 0x48, 0x0b,
-	0x56, 0x8f, 0x3a, 0xdc, 0x5b, 0x24, 0x56, 0x6e, 0x91, 0x09, 0x0d, 0xa2, 0xbb, 0x46, 0x08, 0xab,
-	0x5f, 0xd9, 0x93, 0x09, 0x0d, 0xdb, 0xdc, 0xdb, 0x5d, 0x6e, 0x87, 0xf7, 0x61, 0x59, 0xe9, 0xcc,
-	0x12, 0xdc, 0x72, 0x53, 0x25, 0x48, 0xe4, 0x0f, 0x61, 0x45, 0x53, 0xbd, 0x25, 0x4e, 0x21, 0xa2,
-	0x25, 0x89, 0x0c, 0x4c, 0x54, 0xe1, 0xb5, 0x0a, 0x80, 0xe4, 0xe0, 0xff, 0x30, 0x0f, 0x6b, 0x89,
-	0x84, 0xc8, 0x52, 0xc3, 0xf9, 0xf7, 0x78, 0x5b, 0xea, 0x08, 0x7c, 0x69, 0xa3, 0xf3, 0x5f, 0xaf,
-	0xd1, 0x85, 0xeb, 0x1a, 0x4d, 0x9e, 0xc1, 0x92, 0xb8, 0x12, 0xa0, 0xf1, 0x78, 0x8c, 0x46, 0x3c,
-	0x10, 0x53, 0x9e, 0xd9, 0xf2, 0xad, 0xf8, 0xc0, 0x72, 0x53, 0xd8, 0xa2, 0x1d, 0x03, 0x6e, 0xfe,
-	0x12, 0xac, 0x64, 0xa0, 0x65, 0x5c, 0x8c, 0xf9, 0x28, 0x6e, 0x16, 0xbb, 0x15, 0xab, 0x39, 0x5e,
-	0x84, 0x6e, 0xe2, 0xdf, 0x82, 0x05, 0xa1, 0x12, 0x6e, 0x42, 0x41, 0xde, 0x8d, 0x2a, 0x9a, 0xec,
-	0x27, 0x21, 0x50, 0x9c, 0x46, 0x5e, 0xda, 0xf8, 0xdb, 0xd8, 0x50, 0xb7, 0x1c, 0x13, 0x13, 0xf4,
-	0x2b, 0x45, 0x58, 0x4f, 0xa6, 0xa8, 0x7b, 0x0b, 0xe5, 0xd8, 0xdc, 0x70, 0x73, 0xa3, 0x00, 0x91,
-	0x4f, 0x12, 0xdb, 0x2d, 0x36, 0x3b, 0x88, 0xaa, 0x6f, 0x2d, 0x39, 0xe4, 0x0f, 0x93, 0x4c, 0x35,
-	0xa7, 0x11, 0x0d, 0x79, 0x8b, 0x03, 0xfb, 0x94, 0xe0, 0xb1, 0x3f, 0x49, 0xf1, 0xd8, 0xc5, 0xac,
-	0x4c, 0x09, 0x96, 0xbb, 0x03, 0x1b, 0x91, 0x3f, 0x72, 0xbc, 0xce, 0x52, 0x56, 0xf6, 0x35, 0x85,
-	0x7d, 0xa0, 0x57, 0xfe, 0x18, 0x5a, 0x51, 0x31, 0x89, 0x66, 0x2c, 0x64, 0x95, 0xb3, 0xae, 0xd0,
-	0xcd, 0x58, 0x7b, 0x7e, 0x00, 0x9b, 0xb1, 0xf1, 0x8a, 0x37, 0xa9, 0x9c, 0x55, 0xd4, 0x86, 0x36,
-	0x80, 0xb1, 0x46, 0x1d, 0xc0, 0xad, 0x58, 0x59, 0x89, 0x76, 0x55, 0xb2, 0x0a, 0x6b, 0x69, 0x85,
-	0xc5, 0x5a, 0x66, 0xfc, 0xce, 0x02, 0x90, 0x1f, 0xce, 0xa9, 0x7f, 0x85, 0x77, 0x9f, 0x83, 0x57,
-	0x5d, 0xb4, 0x90, 0x9a, 0xca, 0xfc, 0x6b, 0x85, 0x40, 0xc8, 0x0a, 0x41, 0x50, 0x7c, 0x75, 0x08,
-	0x82, 0xd2, 0xab, 0x42, 0x10, 0xbc, 0x0d, 0x0d, 0xe7, 0xcc, 0xf5, 0x18, 0x23, 0xc0, 0xe4, 0xc0,
-	0xa0, 0xb5, 0x70, 0xb7, 0x70, 0xaf, 0x6e, 0xd6, 0x05, 0x90, 0x49, 0x81, 0x01, 0xf9, 0x32, 0x42,
-	0xa2, 0xe3, 0x33, 0x0c, 0xd0, 0xa1, 0xb3, 0x00, 0x9d, 0xf1, 0x19, 0x15, 0x8a, 0x59, 0x5c, 0xb0,
-	0x32, 0x33, 0x83, 0x07, 0xe4, 0x1d, 0x58, 0x0c, 0xbc, 0x39, 0x13, 0xab, 0xe5, 0x30, 0x70, 0xa7,
-	0x80, 0x3a, 0x87, 0x1e, 0x49, 0x17, 0x91, 0x95, 0x79, 0x40, 0xad, 0xa9, 0x13, 0x04, 0x4c, 0x38,
-	0x19, 0x79, 0x6e, 0xe8, 0x7b, 0x13, 0x61, 0xe7, 0x5f, 0x9e, 0x07, 0xf4, 0x90, 0xa7, 0xec, 0xf0,
-	0x04, 0xf2, 0x49, 0xd4, 0xa4, 0x99, 0xed, 0xf8, 0x41, 0x0b, 0xb0, 0x49, 0xb2, 0xa7, 0x28, 0xbd,
-	0xda, 0x8e, 0xaf, 0xda, 0xc2, 0x3e, 0x82, 0x44, 0x68, 0x84, 0x5a, 0x32, 0x34, 0xc2, 0x2f, 0x67,
-	0x87, 0x46, 0x68, 0xc4, 0x88, 0x59, 0x7a, 0x8a, 0xbf, 0x56, 0x84, 0x84, 0x74, 0xc4, 0x87, 0xc5,
-	0xaf, 0x13, 0xf1, 0x61, 0x29, 0x2b, 0xe2, 0xc3, 0x47, 0x50, 0xc3, 0x8b, 0xf6, 0xd6, 0x39, 0x7a,
-	0x3c, 0x73, 0xbf, 0x85, 0xa6, 0x7e, 0x13, 0x7f, 0xdf, 0x71, 0x43, 0x13, 0x7c, 0xf9, 0x33, 0x48,
-	0x07, 0x5f, 0x58, 0x7e, 0xad, 0xe0, 0x0b, 0xdf, 0x4c, 0x64, 0x05, 0x11, 0x10, 0x60, 0x0b, 0x2a,
-	0x72, 0x9e, 0x18, 0xb1, 0x3d, 0xf5, 0xbd, 0xa9, 0xb4, 0x81, 0xb2, 0xdf, 0x64, 0x11, 0xf2, 0xa1,
-	0x27, 0x32, 0xe7, 0x43, 0xcf, 0xf8, 0x45, 0xa8, 0x69, 0x4b, 0x8d, 0xbc, 0xc5, 0xf5, 0xfa, 0x2e,
-	0x9d, 0x48, 0xb5, 0x2f, 0x1f, 0xc5, 0xaa, 0x80, 0x76, 0xc7, 0xec, 0xdc, 0x1b, 0x3b, 0x3e, 0xc5,
-	0x30, 0x29, 0x96, 0x4f, 0x2f, 0xa8, 0x1f, 0x48, 0xdb, 0x75, 0x53, 0x25, 0x98, 0x1c, 0x6e, 0xfc,
-	0x12, 0xac, 0xc4, 0xe6, 0x56, 0x90, 0xef, 0x77, 0x60, 0x01, 0xc7, 0x4d, 0x3a, 0x48, 0xc5, 0x23,
-	0x1c, 0x88, 0x34, 0x0c, 0x16, 0xc3, 0xcd, 0xee, 0xd6, 0xcc, 0xf7, 0x4e, 0xb0, 0x92, 0x9c, 0x59,
-	0x13, 0xb0, 0x23, 0xdf, 0x3b, 0x31, 0xfe, 0x43, 0x01, 0x0a, 0xfb, 0xde, 0x4c, 0xf7, 0x92, 0xce,
-	0xa5, 0xbc, 0xa4, 0x85, 0xba, 0xc5, 0x52, 0xea, 0x14, 0x21, 0xb1, 0xa2, 0x21, 0x59, 0xaa, 0x54,
-	0xee, 0xc1, 0x22, 0xa3, 0x13, 0xa1, 0x67, 0x89, 0xdb, 0x49, 0xfc, 0x70, 0xe6, 0x9b, 0xcf, 0x9e,
-	0x86, 0x43, 0x6f, 0x8f, 0xc3, 0xc9, 0x2a, 0x14, 0x94, 0xf0, 0x8e, 0xc9, 0xec, 0x93, 0xac, 0xc3,
-	0x02, 0xde, 0xaa, 0x92, 0xd7, 0xe1, 0xc5, 0x17, 0xf9, 0x00, 0x56, 0xe2, 0xe5, 0x72, 0x52, 0x24,
-	0x24, 0x03, 0xbd, 0x60, 0xa4, 0x49, 0x37, 0x81, 0xd1, 0x91, 0xe8, 0x42, 0x7c, 0xc1, 0x2c, 0x9f,
-	0x52, 0x8a, 0x49, 0x1a, 0xd1, 0xab, 0xc4, 0x88, 0xde, 0x1d, 0xa8, 0x85, 0x93, 0x0b, 0x6b, 0x66,
-	0x5f, 0x4d, 0x3c, 0x5b, 0x5e, 0xbf, 0x84, 0x70, 0x72, 0x71, 0xc4, 0x21, 0xe4, 0x43, 0x80, 0xe9,
-	0x6c, 0x26, 0xf6, 0x1e, 0x1a, 0x3d, 0xa3, 0xa5, 0x7c, 0x78, 0x74, 0xc4, 0x97, 0x9c, 0x59, 0x9d,
-	0xce, 0x66, 0xfc, 0x27, 0xd9, 0x85, 0xc5, 0xcc, 0x50, 0x26, 0xb7, 0xe5, 0xdd, 0x13, 0x6f, 0xb6,
-	0x95, 0xb1, 0x39, 0x1b, 0x23, 0x1d, 0xb6, 0xf9, 0x7d, 0x20, 0x7f, 0xce, 0x68, 0x21, 0x43, 0xa8,
-	0xaa, 0xf6, 0xa5, 0x62, 0x94, 0xd4, 0x52, 0x31, 0x4a, 0x18, 0x5d, 0xe4, 0x8c, 0x9b, 0x22, 0xf9,
-	0xa0, 0x71, 0x6e, 0xe2, 0xd6, 0x96, 0xf1, 0x9f, 0x73, 0x50, 0xe2, 0x91, 0x3f, 0xde, 0x85, 0x25,
-	0x8e, 0xaf, 0x3c, 0xce, 0x85, 0x5b, 0x10, 0xe7, 0xff, 0x86, 0xc2, 0xd9, 0x9c, 0x6d, 0x0b, 0x2d,
-	0x94, 0x52, 0xc4, 0x46, 0x68, 0xe1, 0x94, 0xee, 0x40, 0x55, 0x55, 0xad, 0x2d, 0x9d, 0x8a, 0xac,
-	0x99, 0xbc, 0x09, 0xc5, 0x73, 0x6f, 0x26, 0xf5, 0x9e, 0x10, 0x8d, 0xa4, 0x89, 0xf0, 0xa8, 0x2d,
-	0xac, 0x8e, 0xe8, 0x36, 0x59, 0x41, 0xb4, 0x85, 0x55, 0x22, 0xe3, 0x21, 0x24, 0xfa, 0xb8, 0x90,
-	0xd1, 0xc7, 0x63, 0x58, 0x62, 0x74, 0x40, 0xf3, 0x4d, 0xba, 0xfe, 0xd0, 0xfc, 0x36, 0x93, 0x6f,
-	0x46, 0x93, 0xf9, 0x98, 0xea, 0x9a, 0x67, 0x74, 0x1f, 0x16, 0x70, 0x29, 0x57, 0x1a, 0xbf, 0x93,
-	0xe3, 0xf4, 0x85, 0x95, 0x4b, 0xee, 0x41, 0xd1, 0x95, 0x7e, 0x4c, 0x91, 0x14, 0xa3, 0x6e, 0x5e,
-	0x32, 0x3c, 0x13, 0x31, 0xd8, 0xd4, 0xa1, 0xf7, 0x8f, 0x5e, 0x7a, 0xc3, 0xac, 0xb9, 0xf3, 0xa9,
-	0x52, 0xdc, 0x7e, 0x4b, 0x76, 0x2b, 0xa1, 0xf4, 0xe4, 0xbd, 0x57, 0xdb, 0x74, 0x4b, 0xf3, 0x43,
-	0x2e, 0xc6, 0x4e, 0x4c, 0x29, 0x03, 0x8d, 0xcf, 0xa8, 0xe6, 0x7f, 0xfc, 0xfb, 0x79, 0x68, 0xc4,
-	0x5a, 0x84, 0x8e, 0xd8, 0xec, 0x00, 0xe0, 0x86, 0x59, 0x31, 0xdf, 0xe8, 0xef, 0x2a, 0xc4, 0x54,
-	0x6d, 0x9c, 0xf2, 0xb1, 0x71, 0x52, 0x8e, 0x88, 0x05, 0xdd, 0x11, 0xf1, 0x01, 0x54, 0xa3, 0x10,
-	0x5a, 0xf1, 0x26, 0xb1, 0xfa, 0xe4, 0xfd, 0xd3, 0x08, 0x29, 0x72, 0x5d, 0x2c, 0xe9, 0xae, 0x8b,
-	0xdf, 0xd3, 0x3c, 0xdd, 0x16, 0xb0, 0x18, 0x23, 0x6b, 0x44, 0x7f, 0x2a, 0x7e, 0x6e, 0xc6, 0x97,
-	0x50, 0xd3, 0x1a, 0xaf, 0x7b, 0x8b, 0xe5, 0x62, 0xde, 0x62, 0xea, 0x96, 0x7b, 0x3e, 0xba, 0xe5,
-	0x6e, 0xfc, 0x5a, 0x1e, 0x1a, 0x6c, 0x7f, 0x39, 0xee, 0xd9, 0x91, 0x37, 0x71, 0x46, 0x68, 0xa8,
-	0x55, 0x3b, 0x4c, 0x30, 0x5a, 0x72, 0x9f, 0x89, 0x2d, 0xc6, 0xf9, 0x2c, 0x3d, 0x34, 0x0b, 0x27,
-	0xd2, 0x2a, 0x34, 0x8b, 0x01, 0x0d, 0x46, 0x18, 0xd1, 0xe4, 0x1a, 0x85, 0xdb, 0x32, 0x6b, 0xa7,
-	0x94, 0x6e, 0xdb, 0x01, 0xa7, 0x90, 0x1f, 0xc0, 0x0a, 0xc3, 0xc1, 0xb8, 0x0b, 0x53, 0x67, 0x32,
-	0x71, 0xa2, 0xeb, 0x9b, 0x05, 0xb3, 0x79, 0x4a, 0xa9, 0x69, 0x87, 0xf4, 0x90, 0x25, 0x88, 0xe8,
-	0x5c, 0x95, 0xb1, 0x13, 0xd8, 0x27, 0x91, 0xbb, 0xbc, 0xfa, 0x96, 0xee, 0x0e, 0x91, 0x47, 0x89,
-	0xf0, 0x86, 0x12, 0x41, 0x47, 0x30, 0x7f, 0x62, 0x25, 0x95, 0x93, 0x2b, 0xc9, 0xf8, 0xc7, 0x79,
-	0xa8, 0x69, 0xcb, 0xf2, 0x75, 0x4e, 0xd7, 0xdb, 0x29, 0xc3, 0x7a, 0x55, 0xb7, 0xa1, 0xbf, 0x1d,
-	0xaf, 0xb2, 0xa0, 0xee, 0xf8, 0xe9, 0x0b, 0xf8, 0x16, 0x54, 0xd9, 0xae, 0xfb, 0x08, 0x0d, 0x10,
-	0x22, 0x6e, 0x1e, 0x02, 0x8e, 0xe6, 0x27, 0x32, 0xf1, 0x21, 0x26, 0x96, 0xa2, 0xc4, 0x87, 0x2c,
-	0xf1, 0x65, 0x77, 0x7c, 0x3e, 0x83, 0xba, 0x28, 0x15, 0xe7, 0x54, 0x88, 0x05, 0xab, 0xda, 0xc9,
-	0xad, 0xe6, 0xdb, 0xac, 0xf1, 0xea, 0xf8, 0xe4, 0x8b, 0x8c, 0x0f, 0x65, 0xc6, 0xca, 0xab, 0x32,
-	0x3e, 0xe4, 0x1f, 0xc6, 0x9e, 0xba, 0x36, 0x85, 0x3e, 0xa6, 0x92, 0x8e, 0x7d, 0x08, 0x2b, 0x92,
-	0x5c, 0xcd, 0x5d, 0xdb, 0x75, 0xbd, 0xb9, 0x3b, 0xa2, 0xf2, 0x0a, 0x39, 0x11, 0x49, 0xc7, 0x51,
-	0x8a, 0x31, 0x56, 0x01, 0x5d, 0xb8, 0xaf, 0xea, 0x7d, 0x28, 0x71, 0xbe, 0x9c, 0x33, 0x1f, 0xd9,
-	0x84, 0x8b, 0xa3, 0x90, 0x7b, 0x50, 0xe2, 0xec, 0x79, 0xfe, 0x5a, 0x62, 0xc3, 0x11, 0x8c, 0x36,
-	0x10, 0x96, 0xf1, 0x90, 0x86, 0xbe, 0x33, 0x0a, 0xa2, 0xdb, 0xe9, 0xa5, 0xf0, 0x6a, 0x26, 0xea,
-	0x8a, 0xec, 0x16, 0x11, 0x26, 0x6a, 0x68, 0x38, 0x0e, 0x3b, 0x98, 0x56, 0x62, 0x65, 0x08, 0x76,
-	0x69, 0x02, 0xeb, 0x27, 0x34, 0x7c, 0x41, 0xa9, 0xeb, 0x32, 0x66, 0x68, 0x44, 0xdd, 0xd0, 0xb7,
-	0x27, 0x6c, 0x92, 0x78, 0x0f, 0x1e, 0xa5, 0x4a, 0x8d, 0x34, 0x80, 0xdb, 0x51, 0xc6, 0x1d, 0x95,
-	0x8f, 0xd3, 0x8e, 0xb5, 0x93, 0xac, 0xb4, 0xcd, 0x5f, 0x80, 0xcd, 0xeb, 0x33, 0x65, 0xa8, 0x09,
-	0xee, 0xc5, 0xa9, 0x8a, 0xb2, 0x82, 0x4f, 0x3c, 0x3b, 0xe4, 0xad, 0xd1, 0x29, 0x4b, 0x0f, 0x6a,
-	0x5a, 0x4a, 0x74, 0xf6, 0xe7, 0x90, 0xb9, 0xe3, 0x1f, 0xec, 0x44, 0x72, 0x3d, 0x7f, 0x8a, 0x56,
-	0xe7, 0xb1, 0x15, 0x95, 0x9e, 0x33, 0x97, 0x22, 0x38, 0x7a, 0x33, 0x19, 0x5b, 0xb0, 0x84, 0x9c,
-	0xbd, 0x76, 0xd0, 0xbd, 0x8c, 0x19, 0x34, 0x56, 0x81, 0xf4, 0x38, 0xed, 0xd2, 0xfd, 0x76, 0xff,
-	0xb0, 0x00, 0x35, 0x0d, 0xcc, 0x4e, 0x23, 0x74, 0x76, 0xb6, 0xc6, 0x8e, 0x3d, 0xa5, 0xd2, 0xc4,
-	0xdf, 0x30, 0x1b, 0x08, 0xdd, 0x15, 0x40, 0x76, 0x16, 0xdb, 0x17, 0x67, 0x96, 0x37, 0x0f, 0xad,
-	0x31, 0x3d, 0xf3, 0xa9, 0x6c, 0x65, 0xdd, 0xbe, 0x38, 0xeb, 0xcf, 0xc3, 0x5d, 0x84, 0xc9, 0x08,
-	0x46, 0x1a, 0x56, 0x41, 0x45, 0x30, 0x8a, 0xb0, 0x84, 0x93, 0x38, 0x5f, 0x99, 0x45, 0xe5, 0x24,
-	0xce, 0xa5, 0xc5, 0xe4, 0x01, 0x5a, 0x4a, 0x1f, 0xa0, 0x9f, 0xc0, 0x3a, 0x3f, 0x40, 0x05, 0x69,
-	0xb6, 0x12, 0x3b, 0x79, 0x15, 0x53, 0x45, 0x27, 0x35, 0xb6, 0xb7, 0xc9, 0x7a, 0x20, 0xc9, 0x52,
-	0xe0, 0xfc, 0x98, 0x13, 0xb2, 0x9c, 0xc9, 0x7a, 0x26, 0x0a, 0x1f, 0x38, 0x3f, 0xa6, 0x32, 0x82,
-	0x52, 0x0c, 0x53, 0xdc, 0xe0, 0x9b, 0x3a, 0x6e, 0x12, 0xd3, 0xbe, 0x8c, 0x63, 0x56, 0x05, 0xa6,
-	0x7d, 0xa9, 0x63, 0x3e, 0x82, 0x8d, 0x29, 0x1d, 0x3b, 0x76, 0xbc, 0x58, 0x2b, 0x62, 0xdc, 0x56,
-	0x79, 0xb2, 0x96, 0x67, 0xc0, 0x05, 0x77, 0x36, 0x1a, 0x3f, 0xf6, 0xa6, 0x27, 0x0e, 0xe7, 0x59,
-	0xb8, 0x9f, 0x5e, 0xd1, 0x5c, 0x74, 0xe7, 0xd3, 0x9f, 0x47, 0x30, 0xcb, 0x12, 0x18, 0x0d, 0xa8,
-	0x0d, 0x42, 0x6f, 0x26, 0xa7, 0x79, 0x11, 0xea, 0xfc, 0x53, 0x44, 0x5f, 0xb8, 0x05, 0x37, 0x91,
-	0x24, 0x0c, 0xbd, 0x99, 0x37, 0xf1, 0xce, 0xae, 0x62, 0x5a, 0xec, 0x7f, 0x91, 0x83, 0x95, 0x58,
-	0xaa, 0x20, 0xaf, 0x9f, 0x70, 0x7a, 0xa6, 0x6e, 0x6e, 0xe7, 0x62, 0xd7, 0xf6, 0xd8, 0x7c, 0x71,
-	0x44, 0x4e, 0xcc, 0xe4, 0x6d, 0xee, 0x76, 0x14, 0x9e, 0x4c, 0x66, 0xe4, 0x24, 0xa5, 0x95, 0x26,
-	0x29, 0x22, 0xbf, 0x0c, 0x5c, 0x26, 0x8b, 0xf8, 0x19, 0x71, 0xcb, 0x72, 0x2c, 0xba, 0x5c, 0x88,
-	0xdf, 0xc3, 0xd2, 0x35, 0xde, 0xb2, 0x05, 0x91, 0x1a, 0x3c, 0x30, 0x7e, 0x37, 0x0f, 0x10, 0xb5,
-	0x0e, 0x6f, 0x82, 0x29, 0xbe, 0x25, 0x87, 0x2e, 0xf7, 0x1a, 0x8f, 0xf2, 0x16, 0xd4, 0xd5, 0xed,
-	0x8c, 0x88, 0x13, 0xaa, 0x49, 0x18, 0x63, 0x87, 0xde, 0x87, 0xa5, 0xb3, 0x89, 0x77, 0x82, 0x1c,
-	0xab, 0xe0, 0x5b, 0xd0, 0x87, 0x06, 0xcf, 0xa3, 0x45, 0x9e, 0xa4, 0x02, 0x0c, 0x2a, 0xde, 0xa9,
-	0x98, 0x79, 0x89, 0x23, 0xc6, 0x09, 0x7d, 0x99, 0xe2, 0x84, 0xee, 0xa4, 0x06, 0xf7, 0xa7, 0xc3,
-	0x06, 0xfd, 0xd5, 0xbc, 0x72, 0x48, 0x8f, 0xe6, 0xe5, 0xe5, 0xc2, 0xe6, 0x4f, 0xe2, 0x19, 0xf7,
-	0x32, 0x53, 0xff, 0x97, 0xb0, 0xe8, 0xf3, 0x23, 0x52, 0x9e, 0x9f, 0xc5, 0x97, 0x9c, 0x9f, 0x0d,
-	0x3f, 0xc6, 0x77, 0x7d, 0x1b, 0x9a, 0xf6, 0xf8, 0x82, 0xfa, 0xa1, 0x83, 0x96, 0x33, 0xe4, 0xd6,
-	0x85, 0xfb, 0xb7, 0x06, 0x47, 0xb6, 0xf8, 0x3d, 0x58, 0x12, 0xf1, 0x49, 0x14, 0xa6, 0x08, 0xdc,
-	0x19, 0x81, 0x19, 0xa2, 0xf1, 0x0f, 0xa5, 0x07, 0x7c, 0x7c, 0xad, 0xbd, 0x7c, 0x54, 0xf4, 0x1e,
-	0xe6, 0xd3, 0xce, 0x0c, 0x62, 0x59, 0x0b, 0x83, 0x9c, 0xa0, 0x8e, 0x1c, 0x28, 0xcc, 0x71, 0xf1,
-	0x61, 0x2d, 0xbe, 0xce, 0xb0, 0x1a, 0xff, 0x32, 0x07, 0xe5, 0x7d, 0x6f, 0xb6, 0xef, 0xf0, 0x7b,
-	0x54, 0xb8, 0x69, 0x95, 0xbd, 0x78, 0x81, 0x7d, 0xa2, 0x1b, 0xdf, 0x4b, 0xee, 0x57, 0x67, 0x32,
-	0x9d, 0x8d, 0x38, 0xd3, 0xf9, 0x3d, 0xb8, 0x85, 0xe6, 0x78, 0xdf, 0x9b, 0x79, 0x3e, 0x23, 0x1c,
-	0xf6, 0x84, 0x33, 0x9f, 0x9e, 0x1b, 0x9e, 0x4b, 0x4a, 0x7e, 0xf3, 0x94, 0xd2, 0x23, 0x0d, 0xe3,
-	0x50, 0x21, 0x60, 0x6c, 0x85, 0x49, 0x78, 0x61, 0x71, 0x7d, 0x81, 0xe0, 0x8e, 0x39, 0x7d, 0x5f,
-	0x62, 0x09, 0x1d, 0x84, 0x23, 0x7f, 0x6c, 0x7c, 0x0e, 0x55, 0xa5, 0x7a, 0x22, 0xef, 0x43, 0xf5,
-	0xdc, 0x9b, 0x09, 0xfd, 0x54, 0x2e, 0x76, 0x07, 0x5d, 0xf4, 0xda, 0xac, 0x9c, 0xf3, 0x1f, 0x81,
-	0xf1, 0x7f, 0xca, 0x50, 0xee, 0xba, 0x17, 0x9e, 0x33, 0x42, 0xdf, 0xf8, 0x29, 0x9d, 0x7a, 0x32,
-	0xe4, 0x12, 0xfb, 0x8d, 0x9e, 0x96, 0x51, 0x6c, 0xcd, 0x82, 0xf0, 0xb4, 0x54, 0x51, 0x35, 0xd7,
-	0x60, 0xc1, 0xd7, 0x83, 0x63, 0x96, 0x7c, 0xbc, 0x79, 0xa4, 0x4e, 0xef, 0x92, 0x16, 0x3a, 0x8b,
-	0x95, 0xc5, 0xdd, 0x91, 0x71, 0xc8, 0x78, 0x7c, 0x84, 0x2a, 0x42, 0x70, 0xc0, 0xde, 0x80, 0xb2,
-	0xd0, 0x42, 0xf3, 0x0b, 0xa8, 0x5c, 0x77, 0x2f, 0x40, 0xb8, 0x1a, 0x7c, 0xca, 0xdd, 0x29, 0x14,
-	0x5b, 0x5d, 0x30, 0xeb, 0x12, 0xb8, 0x6b, 0x73, 0x87, 0x77, 0x8e, 0xcf, 0x51, 0xf8, 0x31, 0x04,
-	0x1c, 0x84, 0x08, 0x19, 0x61, 0x68, 0xab, 0x99, 0x61, 0x68, 0xf1, 0x82, 0x84, 0xa2, 0xf9, 0xbc,
-	0x8b, 0xc0, 0x23, 0x8b, 0x6a, 0x70, 0x19, 0xf5, 0x59, 0x68, 0x78, 0x78, 0xe8, 0x10, 0xa9, 0xe1,
-	0x79, 0x1b, 0x1a, 0xa7, 0xf6, 0x64, 0x72, 0x62, 0x8f, 0x9e, 0x73, 0xc5, 0x44, 0x9d, 0xeb, 0x62,
-	0x25, 0x10, 0x35, 0x13, 0x77, 0xa0, 0xa6, 0xcd, 0x32, 0xfa, 0x81, 0x17, 0x4d, 0x88, 0xe6, 0x37,
-	0xa9, 0x6f, 0x5c, 0x7c, 0x0d, 0x7d, 0xa3, 0xe6, 0x0f, 0xbf, 0x14, 0xf7, 0x87, 0xbf, 0x85, 0xb4,
-	0x5d, 0x38, 0x10, 0x37, 0x79, 0x18, 0x4b, 0x7b, 0x3c, 0xe6, 0xc1, 0x7c, 0xde, 0x82, 0xba, 0x18,
-	0x3c, 0x9e, 0xbe, 0x2c, 0x2e, 0x14, 0x20, 0x8c, 0xa3, 0xdc, 0xe6, 0x4a, 0xf3, 0x99, 0xed, 0x8c,
-	0xd1, 0x87, 0x5b, 0xd8, 0x57, 0xec, 0x69, 0x78, 0x64, 0x3b, 0xe8, 0x3a, 0x29, 0x93, 0xf1, 0xac,
-	0x5e, 0xe1, 0xe3, 0x2f, 0x92, 0x07, 0x3c, 0x30, 0x8e, 0xc2, 0x98, 0xaa, 0xd8, 0x1f, 0x66, 0x4d,
-	0xa0, 0xe0, 0x3a, 0xf8, 0x08, 0x3d, 0xee, 0x42, 0x8a, 0xd1, 0x3d, 0x16, 0x95, 0x79, 0x49, 0xac,
-	0x52, 0xf9, 0x9f, 0x1b, 0xaa, 0x39, 0x26, 0x63, 0x35, 0xb9, 0xbd, 0x7c, 0x3d, 0xc6, 0x8d, 0x0b,
-	0x54, 0xb4, 0x97, 0x73, 0x04, 0xf2, 0xb9, 0x76, 0x86, 0xb4, 0x10, 0xf9, 0x8d, 0x44, 0xf9, 0xd7,
-	0x5d, 0xb0, 0xbd, 0x0d, 0xe0, 0x04, 0xec, 0xcc, 0x0b, 0xa8, 0x3b, 0xc6, 0x20, 0x1d, 0x15, 0xb3,
-	0xea, 0x04, 0x4f, 0x38, 0x20, 0xa5, 0x85, 0xda, 0x4c, 0x47, 0xca, 0xfd, 0x46, 0x8f, 0xa0, 0x36,
-	0xd4, 0xf5, 0x91, 0x20, 0x15, 0x28, 0xf6, 0x8f, 0x3a, 0xbd, 0xe6, 0x0d, 0x52, 0x83, 0xf2, 0xa0,
-	0x33, 0x1c, 0x1e, 0xa0, 0x61, 0xbe, 0x0e, 0x15, 0x75, 0x4b, 0x3f, 0xcf, 0xbe, 0xda, 0x3b, 0x3b,
-	0x9d, 0xa3, 0x61, 0x67, 0xb7, 0x59, 0xf8, 0x41, 0xb1, 0x92, 0x6f, 0x16, 0x8c, 0xff, 0x55, 0x80,
-	0x9a, 0x36, 0x50, 0x2f, 0xa7, 0xd7, 0xf1, 0x78, 0x50, 0xf9, 0x64, 0x3c, 0x28, 0xdd, 0xa8, 0x22,
-	0x62, 0x66, 0x49, 0xa3, 0xca, 0xdb, 0xd0, 0x10, 0x41, 0x36, 0x35, 0xf7, 0x8a, 0x92, 0x59, 0xe7,
-	0x40, 0x41, 0xcd, 0x31, 0xe6, 0x07, 0x22, 0xe1, 0x6d, 0xea, 0x92, 0x58, 0x3f, 0x08, 0xc2, 0xfb,
-	0xd4, 0x78, 0x19, 0x3e, 0xf0, 0x26, 0x17, 0x94, 0x63, 0x70, 0x16, 0xb6, 0x26, 0x60, 0x43, 0x11,
-	0x4f, 0x45, 0x90, 0x4c, 0x2d, 0xe8, 0x44, 0xc9, 0xac, 0x73, 0xa0, 0xa8, 0xe8, 0x03, 0xb9, 0xc6,
-	0xb8, 0xb3, 0xd9, 0x46, 0x7a, 0xc1, 0xc4, 0xd6, 0xd7, 0x41, 0x4a, 0xef, 0x59, 0xc5, 0xb5, 0xf3,
-	0xad, 0x74, 0xbe, 0x57, 0xeb, 0x3f, 0xc9, 0xfb, 0x40, 0xa6, 0xb3, 0x99, 0x95, 0xa1, 0x91, 0x2c,
-	0x9a, 0x4b, 0xd3, 0xd9, 0x6c, 0xa8, 0x29, 0xec, 0xc8, 0x1b, 0x50, 0xb0, 0xa7, 0x33, 0x24, 0x2d,
-	0x91, 0x76, 0xb0, 0x7d, 0x78, 0x64, 0x32, 0xf0, 0x37, 0xa0, 0x4a, 0xfd, 0xcd, 0x1c, 0x14, 0xda,
-	0x87, 0x47, 0x48, 0xe8, 0x3d, 0x2f, 0xb4, 0x82, 0x73, 0x5b, 0x84, 0x7c, 0x63, 0x84, 0xde, 0xf3,
-	0xc2, 0x01, 0x03, 0x30, 0x42, 0x1f, 0xd0, 0x30, 0x72, 0x9a, 0x2e, 0x05, 0x34, 0xe4, 0x1e, 0xf4,
-	0xa3, 0x73, 0x67, 0x32, 0x8e, 0x45, 0x0b, 0x05, 0x04, 0xf1, 0x15, 0x41, 0xa0, 0xa8, 0x1d, 0x0f,
-	0xf8, 0x9b, 0x5f, 0x4f, 0x14, 0x27, 0x0a, 0xf7, 0xcb, 0x57, 0xdf, 0xc6, 0x5f, 0xcb, 0x01, 0x69,
-	0x33, 0x8a, 0x84, 0x03, 0xaa, 0x24, 0xdd, 0xe8, 0x9c, 0xc9, 0xe9, 0xe7, 0x4c, 0x06, 0x39, 0xcf,
-	0x67, 0x92, 0xf3, 0x57, 0x11, 0xbe, 0xd8, 0xd6, 0x5d, 0x4e, 0x6d, 0x5d, 0x63, 0x0f, 0x6a, 0x47,
-	0x5a, 0xf0, 0xe6, 0xbb, 0xec, 0x54, 0x94, 0x61, 0x9b, 0xf9, 0x79, 0xc9, 0xb5, 0xba, 0xbe, 0x88,
-	0xd6, 0xac, 0x35, 0x38, 0xaf, 0x35, 0xd8, 0xf8, 0x3b, 0x39, 0x1e, 0xc2, 0x50, 0xf5, 0x2f, 0x8a,
-	0x17, 0x2d, 0x8d, 0xa3, 0x51, 0xb0, 0x9b, 0x9a, 0x34, 0x7f, 0x8a, 0x38, 0x35, 0xd8, 0x7a, 0xcb,
-	0x3b, 0x3d, 0x0d, 0xa8, 0xf4, 0x31, 0xab, 0x21, 0xac, 0x8f, 0x20, 0x29, 0xfe, 0x30, 0x19, 0xcb,
-	0xe1, 0xe5, 0x07, 0xc2, 0xb1, 0x8c, 0x89, 0x3f, 0x87, 0xf6, 0xa5, 0xa8, 0x35, 0x60, 0x33, 0x20,
-	0x2c, 0x34, 0x32, 0xd8, 0x83, 0xfa, 0x36, 0xfe, 0xa6, 0x88, 0xc7, 0x93, 0x9c, 0x82, 0xfb, 0x50,
-	0x51, 0xa5, 0xc6, 0xb9, 0x0a, 0x89, 0xa9, 0xd2, 0x19, 0xef, 0x82, 0xea, 0xa8, 0x58, 0x8b, 0x39,
-	0xb5, 0x40, 0x2b, 0x5b, 0x57, 0x6b, 0xf5, 0x77, 0x80, 0x9c, 0x3a, 0x7e, 0x12, 0x99, 0x53, 0x8f,
-	0x26, 0xa6, 0x68, 0xd8, 0xc6, 0x31, 0xac, 0x48, 0xb2, 0xa7, 0xc9, 0x64, 0xf1, 0xf9, 0xcd, 0xbd,
-	0xe2, 0x60, 0xcb, 0xa7, 0x0e, 0x36, 0xe3, 0x37, 0x4a, 0x50, 0x96, 0xb1, 0xd2, 0xb3, 0x82, 0x77,
-	0x57, 0xe3, 0xc1, 0xbb, 0x5b, 0xb1, 0xd0, 0xa0, 0x38, 0xf5, 0x82, 0xc7, 0x79, 0x2f, 0xc9, 0xa6,
-	0x68, 0xd6, 0xa2, 0x18, 0xab, 0x22, 0xac, 0x45, 0xa5, 0xb8, 0xb5, 0x28, 0x2b, 0xa0, 0x39, 0x67,
-	0xb7, 0x53, 0x01, 0xcd, 0x6f, 0x01, 0xe7, 0x9d, 0x34, 0xe7, 0xda, 0x0a, 0x02, 0x44, 0xc0, 0x12,
-	0x8d, 0xd5, 0xaa, 0x24, 0x59, 0xad, 0xd7, 0x66, 0x83, 0x3e, 0x81, 0x05, 0x1e, 0xdb, 0x4b, 0x04,
-	0xaf, 0x90, 0x87, 0xa5, 0x18, 0x2b, 0xf9, 0x9f, 0xdf, 0xd9, 0x32, 0x05, 0xae, 0x1e, 0xff, 0xb6,
-	0x16, 0x8b, 0x7f, 0xab, 0x5b, 0xb1, 0xea, 0x71, 0x2b, 0xd6, 0x3d, 0x68, 0xaa, 0x81, 0x43, 0x9d,
-	0xb0, 0x1b, 0x88, 0x7b, 0xea, 0x8b, 0x12, 0xce, 0xc8, 0x7b, 0x2f, 0x88, 0x0e, 0xfb, 0xc5, 0xd8,
-	0x61, 0xcf, 0x88, 0x6f, 0x3b, 0x0c, 0xe9, 0x74, 0x16, 0xca, 0xc3, 0x5e, 0x8b, 0x21, 0xcf, 0x67,
-	0x9e, 0x5f, 0x7c, 0x93, 0xd3, 0xcb, 0x57, 0xc7, 0x36, 0x2c, 0x9e, 0xda, 0xce, 0x64, 0xee, 0x53,
-	0xcb, 0xa7, 0x76, 0xe0, 0xb9, 0x48, 0x1f, 0x22, 0xbe, 0x43, 0x74, 0x71, 0x8f, 0xe3, 0x98, 0x88,
-	0x62, 0x36, 0x4e, 0xf5, 0x4f, 0xbc, 0x66, 0xaa, 0x8f, 0x04, 0x3b, 0x83, 0x45, 0x08, 0x0b, 0xee,
-	0x2b, 0xd7, 0xed, 0x59, 0x7b, 0x07, 0xdd, 0xc7, 0xfb, 0xc3, 0x66, 0x8e, 0x7d, 0x0e, 0x8e, 0x77,
-	0x76, 0x3a, 0x9d, 0x5d, 0x3c, 0x93, 0x01, 0x16, 0xf6, 0xda, 0xdd, 0x03, 0x71, 0x22, 0x17, 0x9b,
-	0x25, 0xe3, 0x0f, 0xf3, 0x50, 0xd3, 0x7a, 0x83, 0xc1, 0x69, 0xf8, 0x4f, 0x46, 0x7f, 0xcb, 0x22,
-	0x38, 0x0d, 0x87, 0x74, 0xc7, 0xe4, 0x91, 0x9a, 0x23, 0x1e, 0x53, 0xe7, 0x76, 0x7a, 0x40, 0xb6,
-	0xe4, 0x89, 0xa6, 0x4d, 0x92, 0x0a, 0x26, 0x9f, 0xbf, 0x36, 0x98, 0x3c, 0x79, 0x17, 0x96, 0x64,
-	0xcd, 0x72, 0x4e, 0x84, 0xf5, 0x45, 0x80, 0xc5, 0x94, 0xbc, 0x2b, 0xe2, 0xfb, 0x88, 0x63, 0x99,
-	0xe1, 0x15, 0xa5, 0x4f, 0xb9, 0x3a, 0x99, 0x71, 0xea, 0xca, 0x62, 0xe0, 0x84, 0xb7, 0x84, 0x62,
-	0x70, 0xc4, 0x70, 0xca, 0xe4, 0xd8, 0x19, 0xb1, 0x90, 0x38, 0x23, 0x3e, 0x05, 0x88, 0xfa, 0x13,
-	0x1f, 0xdd, 0x1b, 0xf1, 0xd1, 0xcd, 0x69, 0xa3, 0x9b, 0x37, 0xfe, 0x81, 0xa0, 0x6c, 0x62, 0xaa,
-	0x94, 0x2e, 0xf6, 0x03, 0x90, 0xda, 0x61, 0x0b, 0xef, 0xa0, 0xcc, 0x26, 0x34, 0x94, 0xb7, 0xf0,
-	0x97, 0x45, 0x4a, 0x57, 0x25, 0xa4, 0x28, 0x71, 0x3e, 0x4d, 0x89, 0xdf, 0x82, 0x3a, 0x06, 0x8c,
-	0x14, 0x15, 0xc9, 0x88, 0xcd, 0x53, 0xfb, 0x52, 0xd6, 0x1d, 0x23, 0xc1, 0xc5, 0x04, 0x09, 0xfe,
-	0x5b, 0x39, 0x1e, 0x5d, 0x2c, 0x6a, 0x68, 0x44, 0x83, 0x55, 0x99, 0x71, 0x1a, 0x2c, 0x50, 0x4d,
-	0x95, 0x7e, 0x0d, 0x5d, 0xcd, 0x67, 0xd3, 0xd5, 0x6c, 0x8a, 0x5d, 0xc8, 0xa4, 0xd8, 0xc6, 0x25,
-	0xb4, 0x76, 0x29, 0x1b, 0x8a, 0xf6, 0x64, 0x92, 0x1c, 0xcb, 0x07, 0xb0, 0xca, 0xa6, 0x10, 0x5d,
-	0x45, 0x78, 0x8a, 0x7e, 0xa2, 0x11, 0x9e, 0x26, 0x33, 0xe1, 0xc1, 0x76, 0x1f, 0x96, 0x45, 0x0e,
-	0xdc, 0xb4, 0x7a, 0x28, 0xb7, 0x25, 0x9e, 0x80, 0x2e, 0xae, 0x0c, 0xd7, 0xb8, 0x05, 0x37, 0x33,
-	0x6a, 0x16, 0x4a, 0xbb, 0xdf, 0xcc, 0xc1, 0x5a, 0x9b, 0x87, 0x2c, 0xfa, 0xc6, 0x2e, 0xe1, 0x7f,
-	0x01, 0x37, 0xd5, 0x75, 0x15, 0xed, 0x2e, 0xae, 0xde, 0x48, 0x79, 0xd3, 0x45, 0xbb, 0xa4, 0x85,
-	0x6d, 0x6d, 0xc1, 0x7a, 0xb2, 0x35, 0xa2, 0xa1, 0x7b, 0xb0, 0xbc, 0x4b, 0x4f, 0xe6, 0x67, 0x07,
-	0xf4, 0x22, 0x6a, 0x23, 0x81, 0x62, 0x70, 0xee, 0xbd, 0x10, 0x03, 0x85, 0xbf, 0xd1, 0x9f, 0x9d,
-	0xe1, 0x58, 0xc1, 0x8c, 0x8e, 0xa4, 0xd1, 0x07, 0x21, 0x83, 0x19, 0x1d, 0x19, 0x8f, 0x80, 0xe8,
-	0xe5, 0x88, 0x35, 0xc2, 0x64, 0xe0, 0xf9, 0x89, 0x15, 0x5c, 0x05, 0x21, 0x9d, 0xca, 0xfb, 0xe8,
-	0x10, 0xcc, 0x4f, 0x06, 0x1c, 0x62, 0xbc, 0x07, 0xf5, 0x23, 0xfb, 0xca, 0xa4, 0x3f, 0x12, 0x57,
-	0xba, 0x37, 0xa0, 0x3c, 0xb3, 0xaf, 0xd8, 0x41, 0xa0, 0xec, 0xbf, 0x98, 0x6c, 0xfc, 0x6e, 0x11,
-	0x16, 0x38, 0x26, 0xb9, 0xcb, 0xdf, 0x99, 0x71, 0x5c, 0x24, 0xc4, 0xf2, 0x48, 0xd4, 0x40, 0xa9,
-	0x53, 0x33, 0x9f, 0x3e, 0x35, 0x85, 0xb2, 0x5a, 0xc6, 0xc3, 0x94, 0x96, 0x3a, 0x77, 0x3e, 0x95,
-	0x41, 0x30, 0xe3, 0x01, 0x7a, 0x8a, 0xd1, 0xcb, 0x45, 0x3c, 0x38, 0x49, 0xdc, 0x97, 0x22, 0x92,
-	0xb4, 0x79, 0xeb, 0x24, 0x33, 0x20, 0x0e, 0x4c, 0x1d, 0x94, 0x29, 0xce, 0x97, 0x65, 0xbc, 0x83,
-	0xb8, 0x38, 0x9f, 0x12, 0xdb, 0x2b, 0xaf, 0x16, 0xdb, 0xb9, 0x16, 0xfb, 0x25, 0x62, 0x3b, 0xbc,
-	0x86, 0xd8, 0xfe, 0x1a, 0x7e, 0x0c, 0x37, 0xa1, 0x82, 0x1c, 0x9e, 0x76, 0x7e, 0x32, 0xce, 0x8e,
-	0x9d, 0x9f, 0x9f, 0x69, 0x82, 0x2d, 0x77, 0xa2, 0xd2, 0x0e, 0x30, 0x93, 0xfe, 0xe8, 0xa7, 0xa3,
-	0x18, 0x7d, 0x06, 0x65, 0x01, 0x65, 0x0b, 0xda, 0xb5, 0xa7, 0x32, 0xea, 0x33, 0xfe, 0x66, 0xc3,
-	0x86, 0x71, 0x50, 0x7f, 0x34, 0x77, 0x7c, 0x3a, 0x96, 0xd1, 0x18, 0x1d, 0xa4, 0x1e, 0x0c, 0xc2,
-	0x3a, 0xc8, 0x84, 0x6c, 0x57, 0x3e, 0x31, 0x51, 0x31, 0xcb, 0x4e, 0xf0, 0x84, 0x7d, 0x1a, 0x04,
-	0x9a, 0x18, 0x63, 0x7f, 0xe6, 0xf9, 0x92, 0x3d, 0x31, 0x7e, 0x2f, 0x07, 0x4d, 0xb1, 0xbb, 0x54,
-	0x9a, 0x2e, 0xc0, 0x96, 0xae, 0xf3, 0xf9, 0x79, 0x79, 0x6c, 0x45, 0x03, 0x1a, 0xa8, 0xda, 0x53,
-	0xbc, 0x0a, 0x57, 0x4d, 0xd6, 0x18, 0x70, 0x4f, 0xf0, 0x2b, 0x6f, 0x42, 0x4d, 0xde, 0xb6, 0x99,
-	0x3a, 0x13, 0xf9, 0x48, 0x19, 0xbf, 0x6e, 0x73, 0xe8, 0x4c, 0x24, 0xab, 0xe3, 0xdb, 0x22, 0x06,
-	0x47, 0x0e, 0x59, 0x1d, 0xd3, 0x0e, 0xa9, 0xf1, 0x07, 0x39, 0x58, 0xd6, 0xba, 0x22, 0xf6, 0xed,
-	0x77, 0xa1, 0xae, 0x9e, 0xfe, 0xa0, 0x8a, 0xc7, 0xde, 0x88, 0xd3, 0xa8, 0x28, 0x5b, 0x6d, 0xa4,
-	0x20, 0x01, 0x6b, 0xcc, 0xd8, 0xbe, 0xe2, 0x57, 0x42, 0xe6, 0x53, 0x29, 0x97, 0x8f, 0xed, 0xab,
-	0x3d, 0x4a, 0x07, 0xf3, 0x29, 0xb9, 0x0b, 0xf5, 0x17, 0x94, 0x3e, 0x57, 0x08, 0x9c, 0xb0, 0x03,
-	0x83, 0x09, 0x0c, 0x03, 0x1a, 0x53, 0xcf, 0x0d, 0xcf, 0x15, 0x8a, 0x90, 0x2f, 0x10, 0xc8, 0x71,
-	0x8c, 0x7f, 0x9d, 0x87, 0x15, 0xae, 0x40, 0x16, 0x66, 0x04, 0x41, 0xba, 0x5a, 0xb0, 0xc0, 0xb5,
-	0xfa, 0x9c, 0x78, 0xed, 0xdf, 0x30, 0xc5, 0x37, 0xf9, 0xe4, 0x35, 0x95, 0xde, 0x32, 0x7c, 0xc7,
-	0x35, 0xc3, 0x5f, 0x48, 0x0f, 0xff, 0xf5, 0xc3, 0x9b, 0xe5, 0x54, 0x50, 0xca, 0x72, 0x2a, 0x78,
-	0x1d, 0x53, 0x7e, 0x2a, 0x80, 0x44, 0x39, 0x1d, 0xc8, 0xf9, 0x11, 0x6c, 0xc4, 0x70, 0x90, 0x5a,
-	0x3b, 0xa7, 0x8e, 0x7a, 0x81, 0x60, 0x55, 0xc3, 0x1e, 0xc8, 0xb4, 0xed, 0x32, 0x94, 0x82, 0x91,
-	0x37, 0x43, 0x3f, 0xf4, 0xf8, 0xa8, 0x8a, 0x63, 0xe2, 0xb7, 0x73, 0xd0, 0xda, 0x8b, 0x22, 0x62,
-	0x3b, 0x41, 0xe8, 0xf9, 0xea, 0x71, 0x87, 0xdb, 0x00, 0xfc, 0xc1, 0x34, 0x54, 0x83, 0x88, 0x50,
-	0x66, 0x08, 0x41, 0x25, 0xc8, 0x4d, 0xa8, 0x50, 0x77, 0xcc, 0x13, 0xf9, 0x6a, 0x28, 0x53, 0x77,
-	0x2c, 0x55, 0x28, 0xa9, 0x43, 0xbe, 0x11, 0x67, 0x5f, 0x44, 0x50, 0x1e, 0x36, 0x3a, 0xf4, 0x02,
-	0x99, 0x8d, 0xa2, 0x0a, 0xca, 0x73, 0x68, 0x5f, 0xe2, 0x75, 0x82, 0xc0, 0xf8, 0x47, 0x79, 0x58,
-	0x8a, 0xda, 0xc7, 0xc3, 0x92, 0xdd, 0x4d, 0x05, 0x58, 0x13, 0xfe, 0x50, 0x8a, 0x86, 0xdf, 0x15,
-	0x4b, 0xc2, 0x61, 0xd2, 0x9a, 0xa6, 0x5a, 0xaf, 0xf0, 0x0d, 0xda, 0x75, 0x89, 0x01, 0x35, 0x89,
-	0xe1, 0xcd, 0x43, 0x2d, 0x00, 0x75, 0x95, 0xa3, 0xf4, 0xe7, 0x21, 0x13, 0xaf, 0xed, 0x29, 0xe3,
-	0x56, 0x84, 0x80, 0x5b, 0xb2, 0xa7, 0x61, 0x17, 0x5f, 0xe6, 0x63, 0x60, 0x96, 0x8d, 0x4f, 0x26,
-	0xc3, 0x62, 0xf8, 0x4d, 0x2e, 0x6d, 0xf1, 0xd9, 0x43, 0x49, 0x4b, 0x17, 0x45, 0xf8, 0x7b, 0x40,
-	0x4a, 0x14, 0x79, 0x13, 0x6a, 0xbc, 0xf0, 0x28, 0x66, 0x08, 0x46, 0x83, 0x0c, 0xbb, 0x2e, 0xa6,
-	0x0b, 0x35, 0xa7, 0x37, 0x8f, 0x69, 0x6e, 0x80, 0x57, 0x25, 0x1f, 0x51, 0x53, 0x3d, 0xb6, 0x94,
-	0x11, 0xb2, 0xa6, 0x60, 0xbd, 0x80, 0x71, 0x2b, 0x37, 0x33, 0x66, 0x57, 0x10, 0x83, 0x1d, 0xd0,
-	0xc2, 0xa7, 0xcb, 0x49, 0xe0, 0x14, 0x61, 0x5d, 0x52, 0xdf, 0xf8, 0xd0, 0x9b, 0xcd, 0xd3, 0x38,
-	0x20, 0x92, 0xc2, 0xf9, 0x44, 0xc7, 0x82, 0xdb, 0x20, 0x4f, 0xc7, 0x67, 0x9b, 0x0b, 0xc0, 0x47,
-	0xb0, 0xd9, 0xb9, 0x64, 0x84, 0x45, 0x39, 0xd6, 0x8f, 0x9e, 0xcf, 0xa5, 0x7d, 0x34, 0x61, 0x65,
-	0xc9, 0xbd, 0x96, 0x95, 0x65, 0xcc, 0xa3, 0x45, 0xa8, 0xb2, 0x7e, 0x92, 0x42, 0xb8, 0x7e, 0xc9,
-	0x76, 0xad, 0x13, 0x2c, 0x42, 0x46, 0xb0, 0x61, 0x20, 0x5e, 0xa8, 0x11, 0xc0, 0xd2, 0xe1, 0x7c,
-	0x12, 0x3a, 0x3b, 0x0a, 0x44, 0x3e, 0x11, 0x79, 0xb0, 0x1e, 0x39, 0x6a, 0x99, 0x15, 0x81, 0xaa,
-	0x08, 0x07, 0x6b, 0xca, 0x0a, 0xb2, 0xd2, 0xf5, 0x2d, 0x4d, 0xe3, 0x35, 0x18, 0x37, 0x61, 0x23,
-	0xfa, 0xe2, 0xc3, 0x26, 0x4f, 0xa4, 0xbf, 0x9d, 0xe3, 0x57, 0x9c, 0x78, 0xda, 0xc0, 0xb5, 0x67,
-	0xc1, 0xb9, 0x17, 0x92, 0x0e, 0xac, 0x04, 0x8e, 0x7b, 0x36, 0xa1, 0x7a, 0xf1, 0x81, 0x18, 0x84,
-	0xb5, 0x78, 0xdb, 0x78, 0xd6, 0xc0, 0x5c, 0xe6, 0x39, 0xa2, 0xd2, 0x02, 0xb2, 0x7d, 0x5d, 0x23,
-	0xa3, 0x65, 0x91, 0x18, 0x8d, 0x74, 0xe3, 0xbb, 0xb0, 0x18, 0xaf, 0x88, 0x7c, 0x26, 0x82, 0xac,
-	0x44, 0xad, 0x2a, 0x24, 0x42, 0x4c, 0x44, 0x0b, 0xa2, 0x16, 0x8d, 0x7d, 0x60, 0xfc, 0x95, 0x1c,
-	0xb4, 0x4c, 0xca, 0x56, 0xae, 0xd6, 0x4a, 0xb9, 0x66, 0xbe, 0x9b, 0x2a, 0xf5, 0xfa, 0xbe, 0xca,
-	0xd8, 0x2d, 0xb2, 0x45, 0xdf, 0xb9, 0x76, 0x32, 0xf6, 0x6f, 0xa4, 0x7a, 0xb4, 0x5d, 0x81, 0x05,
-	0x8e, 0x62, 0x6c, 0xc0, 0x9a, 0x68, 0x8f, 0x6c, 0x4b, 0x64, 0xd0, 0x8f, 0xd5, 0x18, 0x33, 0xe8,
-	0x6f, 0x42, 0x8b, 0xc7, 0x42, 0xd0, 0x3b, 0x21, 0x32, 0xee, 0x02, 0x39, 0xb4, 0x47, 0xb6, 0xef,
-	0x79, 0xee, 0x11, 0xf5, 0x85, 0xcb, 0x3c, 0x32, 0xa2, 0x68, 0xef, 0x96, 0x1c, 0x33, 0xff, 0x92,
-	0x91, 0xf9, 0x3d, 0x57, 0x7a, 0x08, 0xf2, 0x2f, 0xc3, 0x87, 0x95, 0x6d, 0xfb, 0x39, 0x95, 0x25,
-	0xc9, 0x21, 0xfa, 0x12, 0x6a, 0x33, 0x55, 0xa8, 0x1c, 0x77, 0x19, 0xe4, 0x2a, 0x5d, 0xad, 0xa9,
-	0x63, 0x33, 0x2a, 0x85, 0x0a, 0x5b, 0x8c, 0x08, 0x33, 0x96, 0x67, 0x3e, 0x03, 0x3d, 0xa1, 0x57,
-	0xdd, 0xb1, 0xf1, 0x10, 0x56, 0xe3, 0x75, 0x0a, 0xd2, 0xb2, 0x09, 0x95, 0xa9, 0x80, 0x89, 0xd6,
-	0xab, 0x6f, 0x26, 0xb3, 0x30, 0xb9, 0x53, 0xe6, 0xe9, 0xee, 0xaa, 0xb0, 0x06, 0x5f, 0xc2, 0x46,
-	0x2a, 0x45, 0x14, 0x78, 0x17, 0xea, 0x5a, 0x43, 0x78, 0x37, 0x8a, 0x26, 0xa8, 0x96, 0x04, 0xc6,
-	0x17, 0xb0, 0xc1, 0xc5, 0xb6, 0x28, 0xbb, 0x1c, 0x82, 0x44, 0x2f, 0x72, 0xc9, 0x5e, 0x7c, 0x22,
-	0x65, 0x4d, 0x3d, 0x6b, 0x14, 0x64, 0x72, 0x8c, 0x69, 0xd2, 0xc9, 0x4b, 0x7e, 0x1a, 0xc7, 0xb0,
-	0x9e, 0x1e, 0x3e, 0xd6, 0xfe, 0x3f, 0xd7, 0x90, 0xcb, 0xe1, 0x89, 0x92, 0xd5, 0xf0, 0xfc, 0x97,
-	0x1c, 0x1f, 0x9f, 0x58, 0x92, 0x68, 0xe6, 0x18, 0xc8, 0x94, 0x86, 0xe7, 0xde, 0xd8, 0x4a, 0xd7,
-	0xfc, 0x48, 0xf9, 0x98, 0x65, 0xe6, 0xdd, 0x3a, 0xc4, 0x8c, 0x5a, 0x8a, 0xb8, 0xed, 0x30, 0x4d,
-	0xc2, 0x37, 0x47, 0xb0, 0x9e, 0x8d, 0x9c, 0xe1, 0x99, 0xf5, 0x71, 0x9c, 0x9f, 0xbf, 0x7d, 0x6d,
-	0xf7, 0x59, 0xb3, 0x74, 0xf6, 0xfe, 0x77, 0x2b, 0x50, 0x16, 0xaa, 0x1a, 0xb2, 0x05, 0xc5, 0x91,
-	0xf4, 0xf2, 0x8d, 0x02, 0x8d, 0x8a, 0x54, 0xf9, 0x7f, 0x07, 0x7d, 0x7d, 0x19, 0x1e, 0xf9, 0x12,
-	0x16, 0xe3, 0x8e, 0x2e, 0x89, 0x58, 0x3f, 0x71, 0x0f, 0x95, 0xc6, 0x28, 0xe1, 0x44, 0x50, 0x8d,
-	0x78, 0x30, 0xce, 0x9a, 0x56, 0xce, 0x35, 0x26, 0xcd, 0x73, 0x99, 0x58, 0x17, 0x9c, 0xdb, 0xd6,
-	0xc3, 0x47, 0x9f, 0x0a, 0xa3, 0x42, 0x0d, 0x81, 0x83, 0x73, 0xfb, 0xe1, 0xa3, 0x4f, 0x93, 0x02,
-	0x9b, 0x08, 0xf5, 0xa3, 0x09, 0x6c, 0xab, 0x50, 0xe2, 0xcf, 0x17, 0x70, 0x77, 0x4d, 0xfe, 0x21,
-	0xd5, 0x19, 0x73, 0x9f, 0x5a, 0xe2, 0x62, 0x0d, 0x3f, 0x45, 0xf9, 0x53, 0x73, 0x44, 0xa4, 0x0d,
-	0x30, 0x89, 0xab, 0x13, 0xd7, 0x61, 0xe1, 0x3c, 0x7a, 0x8f, 0xa2, 0x61, 0x8a, 0x2f, 0xe3, 0x7f,
-	0x94, 0xa0, 0xa6, 0x0d, 0x0a, 0xa9, 0x43, 0xc5, 0xec, 0x0c, 0x3a, 0xe6, 0xd3, 0xce, 0x6e, 0xf3,
-	0x06, 0xb9, 0x07, 0xef, 0x74, 0x7b, 0x3b, 0x7d, 0xd3, 0xec, 0xec, 0x0c, 0xad, 0xbe, 0x69, 0xc9,
-	0xf8, 0xb7, 0x47, 0xed, 0x67, 0x87, 0x9d, 0xde, 0xd0, 0xda, 0xed, 0x0c, 0xdb, 0xdd, 0x83, 0x41,
-	0x33, 0x47, 0xde, 0x80, 0x56, 0x84, 0x29, 0x93, 0xdb, 0x87, 0xfd, 0xe3, 0xde, 0xb0, 0x99, 0x27,
-	0x77, 0xe0, 0xd6, 0x5e, 0xb7, 0xd7, 0x3e, 0xb0, 0x22, 0x9c, 0x9d, 0x83, 0xe1, 0x53, 0xab, 0xf3,
-	0x73, 0x47, 0x5d, 0xf3, 0x59, 0xb3, 0x90, 0x85, 0xb0, 0x3f, 0x3c, 0xd8, 0x91, 0x25, 0x14, 0xc9,
-	0x4d, 0x58, 0xe3, 0x08, 0x3c, 0x8b, 0x35, 0xec, 0xf7, 0xad, 0x41, 0xbf, 0xdf, 0x6b, 0x96, 0xc8,
-	0x32, 0x34, 0xba, 0xbd, 0xa7, 0xed, 0x83, 0xee, 0xae, 0x65, 0x76, 0xda, 0x07, 0x87, 0xcd, 0x05,
-	0xb2, 0x02, 0x4b, 0x49, 0xbc, 0x32, 0x2b, 0x42, 0xe2, 0xf5, 0x7b, 0xdd, 0x7e, 0xcf, 0x7a, 0xda,
-	0x31, 0x07, 0xdd, 0x7e, 0xaf, 0x59, 0x21, 0xeb, 0x40, 0xe2, 0x49, 0xfb, 0x87, 0xed, 0x9d, 0x66,
-	0x95, 0xac, 0xc1, 0x72, 0x1c, 0xfe, 0xa4, 0xf3, 0xac, 0x09, 0xa4, 0x05, 0xab, 0xbc, 0x61, 0xd6,
-	0x76, 0xe7, 0xa0, 0xff, 0x95, 0x75, 0xd8, 0xed, 0x75, 0x0f, 0x8f, 0x0f, 0x9b, 0x35, 0x8c, 0x42,
-	0xde, 0xe9, 0x58, 0xdd, 0xde, 0xe0, 0x78, 0x6f, 0xaf, 0xbb, 0xd3, 0xed, 0xf4, 0x86, 0xcd, 0x3a,
-	0xaf, 0x39, 0xab, 0xe3, 0x0d, 0x96, 0x41, 0xdc, 0x3d, 0xb5, 0x76, 0xbb, 0x83, 0xf6, 0xf6, 0x41,
-	0x67, 0xb7, 0xb9, 0x48, 0x6e, 0xc3, 0xcd, 0x61, 0xe7, 0xf0, 0xa8, 0x6f, 0xb6, 0xcd, 0x67, 0xf2,
-	0x6e, 0xaa, 0xb5, 0xd7, 0xee, 0x1e, 0x1c, 0x9b, 0x9d, 0xe6, 0x12, 0x79, 0x0b, 0x6e, 0x9b, 0x9d,
-	0x1f, 0x1e, 0x77, 0xcd, 0xce, 0xae, 0xd5, 0xeb, 0xef, 0x76, 0xac, 0xbd, 0x4e, 0x7b, 0x78, 0x6c,
-	0x76, 0xac, 0xc3, 0xee, 0x60, 0xd0, 0xed, 0x3d, 0x6e, 0x36, 0xc9, 0x3b, 0x70, 0x57, 0xa1, 0xa8,
-	0x02, 0x12, 0x58, 0xcb, 0xac, 0x7f, 0x72, 0x4a, 0x7b, 0x9d, 0x9f, 0x1b, 0x5a, 0x47, 0x9d, 0x8e,
-	0xd9, 0x24, 0x64, 0x13, 0xd6, 0xa3, 0xea, 0x79, 0x05, 0xa2, 0xee, 0x15, 0x96, 0x76, 0xd4, 0x31,
-	0x0f, 0xdb, 0x3d, 0x36, 0xc1, 0xb1, 0xb4, 0x55, 0xd6, 0xec, 0x28, 0x2d, 0xd9, 0xec, 0x35, 0x42,
-	0x60, 0x51, 0x9b, 0x95, 0xbd, 0xb6, 0xd9, 0x5c, 0x27, 0x4b, 0x50, 0x3b, 0x3c, 0x3a, 0xb2, 0x86,
-	0xdd, 0xc3, 0x4e, 0xff, 0x78, 0xd8, 0xdc, 0x48, 0xcf, 0xd2, 0x51, 0xfb, 0xd9, 0x41, 0xbf, 0xbd,
-	0xdb, 0x6c, 0x91, 0x35, 0x68, 0x76, 0x7b, 0xc3, 0x8e, 0xc9, 0x96, 0x81, 0x2c, 0xf5, 0x8f, 0xca,
-	0x64, 0x15, 0x96, 0x64, 0x27, 0x24, 0xf4, 0x8f, 0xcb, 0x64, 0x03, 0xc8, 0x71, 0xcf, 0xec, 0xb4,
-	0x77, 0xd9, 0x98, 0xaa, 0x84, 0xff, 0x5a, 0x16, 0xe6, 0xe5, 0xdf, 0x2b, 0x28, 0x3e, 0x30, 0x72,
-	0x30, 0x8b, 0xbf, 0x2d, 0x55, 0xd7, 0xde, 0x84, 0x7a, 0xd5, 0xfb, 0xa3, 0x9a, 0x70, 0x5f, 0x48,
-	0x09, 0xf7, 0x29, 0xed, 0x51, 0x43, 0x97, 0x3c, 0xde, 0x86, 0xc6, 0x94, 0xbf, 0x33, 0x25, 0x1e,
-	0x2a, 0x01, 0xe1, 0x6d, 0xc9, 0x81, 0xfc, 0x95, 0x92, 0xd4, 0x03, 0x9c, 0xa5, 0xf4, 0x03, 0x9c,
-	0x59, 0x12, 0xe6, 0x42, 0x96, 0x84, 0x79, 0x1f, 0x96, 0x39, 0xd5, 0x72, 0x5c, 0x67, 0x2a, 0xf5,
-	0x36, 0xe2, 0x39, 0x4b, 0xa4, 0x5e, 0x1c, 0x2e, 0x05, 0x5a, 0x29, 0xf4, 0x0a, 0xea, 0x52, 0x16,
-	0xf2, 0x6e, 0x4c, 0xd6, 0xe5, 0x44, 0x45, 0xc9, 0xba, 0xaa, 0x06, 0xfb, 0x32, 0xaa, 0xa1, 0xa6,
-	0xd5, 0xc0, 0xe1, 0x58, 0xc3, 0x7d, 0x58, 0xa6, 0x97, 0xa1, 0x6f, 0x5b, 0xde, 0xcc, 0xfe, 0xd1,
-	0x1c, 0x5d, 0x64, 0x6c, 0xd4, 0x22, 0xd5, 0xcd, 0x25, 0x4c, 0xe8, 0x23, 0x7c, 0xd7, 0x0e, 0x6d,
-	0xe3, 0x17, 0x01, 0xd4, 0x81, 0x8b, 0xcf, 0x82, 0xba, 0x9e, 0xbc, 0x53, 0x5b, 0x37, 0xf9, 0x07,
-	0xce, 0x63, 0xe8, 0xf9, 0xf6, 0x19, 0xed, 0x4a, 0xab, 0x70, 0x04, 0x20, 0xb7, 0xa0, 0xe0, 0xcd,
-	0xa4, 0x2f, 0x62, 0x55, 0x46, 0xde, 0x9f, 0x99, 0x0c, 0x6a, 0x7c, 0x0a, 0xf9, 0xfe, 0xec, 0x5a,
-	0x2e, 0x0a, 0x5f, 0x41, 0xe3, 0x4f, 0x1f, 0xe4, 0xd1, 0xff, 0x50, 0x7e, 0xde, 0xff, 0x8b, 0x50,
-	0xd3, 0x1e, 0x4d, 0x23, 0x1b, 0xb0, 0xf2, 0x55, 0x77, 0xd8, 0xeb, 0x0c, 0x06, 0xd6, 0xd1, 0xf1,
-	0xf6, 0x93, 0xce, 0x33, 0x6b, 0xbf, 0x3d, 0xd8, 0x6f, 0xde, 0x60, 0x64, 0xa6, 0xd7, 0x19, 0x0c,
-	0x3b, 0xbb, 0x31, 0x78, 0x8e, 0xbc, 0x09, 0x9b, 0xc7, 0xbd, 0xe3, 0x41, 0x67, 0xd7, 0xca, 0xca,
-	0x97, 0x67, 0xfb, 0x4a, 0xa4, 0x67, 0x64, 0x2f, 0xdc, 0xff, 0x25, 0x58, 0x8c, 0x07, 0x96, 0x21,
-	0x00, 0x0b, 0x07, 0x9d, 0xc7, 0xed, 0x9d, 0x67, 0xfc, 0x65, 0x85, 0xc1, 0xb0, 0x3d, 0xec, 0xee,
-	0x58, 0xe2, 0x25, 0x05, 0x46, 0xc3, 0x72, 0xa4, 0x06, 0xe5, 0x76, 0x6f, 0x67, 0xbf, 0x6f, 0x0e,
-	0x9a, 0x79, 0xf2, 0x06, 0x6c, 0xc8, 0x2d, 0xb4, 0xd3, 0x3f, 0x3c, 0xec, 0x0e, 0x91, 0x7c, 0x0f,
-	0x9f, 0x1d, 0xb1, 0x1d, 0x73, 0xdf, 0x86, 0x6a, 0xf4, 0x08, 0x04, 0x92, 0xc4, 0xee, 0xb0, 0xdb,
-	0x1e, 0x46, 0xe7, 0x41, 0xf3, 0x06, 0xa3, 0xb8, 0x11, 0x18, 0x5f, 0x72, 0x68, 0xe6, 0xf8, 0xdd,
-	0x7b, 0x09, 0xe4, 0xb5, 0x37, 0xf3, 0x8c, 0x0c, 0x44, 0xd0, 0xed, 0xfe, 0x90, 0x75, 0xe1, 0x97,
-	0x61, 0x31, 0xfe, 0xd6, 0x02, 0x69, 0x42, 0x9d, 0xd5, 0xaf, 0x55, 0x01, 0xb0, 0xc0, 0x5b, 0xdc,
-	0xcc, 0x71, 0x9a, 0xbf, 0xd3, 0x3f, 0xec, 0xf6, 0x1e, 0xe3, 0x41, 0xd1, 0xcc, 0x33, 0x50, 0xff,
-	0x78, 0xf8, 0xb8, 0xaf, 0x40, 0x05, 0x96, 0x83, 0x77, 0xa7, 0x59, 0xbc, 0xff, 0x23, 0x58, 0x4e,
-	0xbd, 0xca, 0xc0, 0x5a, 0xdd, 0x3f, 0x1e, 0xee, 0xf4, 0x0f, 0xf5, 0x7a, 0x6a, 0x50, 0xde, 0x39,
-	0x68, 0x77, 0x0f, 0xd1, 0x50, 0xd3, 0x80, 0xea, 0x71, 0x4f, 0x7e, 0xe6, 0xe3, 0xef, 0x49, 0x14,
-	0x18, 0xf5, 0xda, 0xeb, 0x9a, 0x83, 0xa1, 0x35, 0x18, 0xb6, 0x1f, 0x77, 0x9a, 0x45, 0x96, 0x57,
-	0x92, 0xb2, 0xd2, 0xfd, 0x2f, 0x60, 0x31, 0xee, 0x38, 0x1f, 0xb7, 0xbf, 0x6d, 0xc2, 0xfa, 0x76,
-	0x67, 0xf8, 0x55, 0xa7, 0xd3, 0xc3, 0x29, 0xdf, 0xe9, 0xf4, 0x86, 0x66, 0xfb, 0xa0, 0x3b, 0x7c,
-	0xd6, 0xcc, 0xdd, 0xff, 0x12, 0x9a, 0x49, 0xa7, 0x8f, 0x98, 0x97, 0xcc, 0xcb, 0xdc, 0x69, 0xee,
-	0xff, 0xbb, 0x1c, 0xac, 0x66, 0x99, 0x07, 0xd9, 0xc2, 0x14, 0x84, 0x90, 0x9d, 0x94, 0x83, 0x7e,
-	0xcf, 0xea, 0xf5, 0x31, 0x9e, 0xfa, 0x26, 0xac, 0x27, 0x12, 0x64, 0x2f, 0x72, 0xe4, 0x16, 0x6c,
-	0xa4, 0x32, 0x59, 0x66, 0xff, 0x18, 0xe7, 0xb2, 0x05, 0xab, 0x89, 0xc4, 0x8e, 0x69, 0xf6, 0xcd,
-	0x66, 0x81, 0x7c, 0x07, 0xee, 0x25, 0x52, 0xd2, 0xfc, 0x81, 0x64, 0x1f, 0x8a, 0xe4, 0x3d, 0x78,
-	0x3b, 0x85, 0x1d, 0x1d, 0xa1, 0xd6, 0x76, 0xfb, 0x80, 0x75, 0xaf, 0x59, 0xba, 0xff, 0x07, 0x45,
-	0x80, 0xe8, 0x66, 0x2a, 0xab, 0x7f, 0xb7, 0x3d, 0x6c, 0x1f, 0xf4, 0xd9, 0x9e, 0x31, 0xfb, 0x43,
-	0x56, 0xba, 0xd9, 0xf9, 0x61, 0xf3, 0x46, 0x66, 0x4a, 0xff, 0x88, 0x75, 0x68, 0x03, 0x56, 0xf8,
-	0xfa, 0x3b, 0x60, 0xdd, 0x60, 0xcb, 0x85, 0x87, 0xe6, 0x67, 0x4c, 0xc8, 0xf1, 0xd1, 0x9e, 0xd9,
-	0xef, 0x0d, 0xad, 0xc1, 0xfe, 0xf1, 0x70, 0x17, 0x23, 0xfd, 0xef, 0x98, 0xdd, 0x23, 0x5e, 0x66,
-	0xf1, 0x65, 0x08, 0xac, 0xe8, 0x12, 0xdb, 0xe0, 0x8f, 0xfb, 0x83, 0x41, 0xf7, 0xc8, 0xfa, 0xe1,
-	0x71, 0xc7, 0xec, 0x76, 0x06, 0x98, 0x71, 0x21, 0x03, 0xce, 0xf0, 0xcb, 0x6c, 0xcd, 0x0e, 0x0f,
-	0x9e, 0x8a, 0x83, 0x8e, 0xa1, 0x56, 0xe2, 0x20, 0x86, 0x55, 0x65, 0xb3, 0xc3, 0x0e, 0xe7, 0x8c,
-	0x92, 0xe1, 0x9a, 0x34, 0x96, 0xaf, 0xc6, 0x8e, 0xd2, 0xd4, 0xce, 0xc7, 0x6c, 0xf5, 0xec, 0x24,
-	0x96, 0x0b, 0x39, 0x12, 0xc5, 0xbf, 0xed, 0xee, 0x9a, 0x98, 0x61, 0x31, 0x05, 0x65, 0xb8, 0x4b,
-	0x6c, 0x11, 0xb2, 0xd3, 0x9b, 0xa1, 0x34, 0xe5, 0x07, 0x4b, 0x59, 0x66, 0x3d, 0xfe, 0xea, 0xf8,
-	0x70, 0xbb, 0x2f, 0xd9, 0x00, 0xde, 0x5e, 0x92, 0x01, 0x67, 0xf8, 0x2b, 0xf8, 0x94, 0x02, 0x27,
-	0x47, 0x88, 0xb8, 0xaa, 0x03, 0x18, 0xc6, 0x1a, 0x23, 0x82, 0x12, 0xf0, 0xf3, 0x1d, 0xb3, 0x6f,
-	0x31, 0x3e, 0x0b, 0x79, 0x44, 0x86, 0xbf, 0x7e, 0x7d, 0x32, 0xcb, 0xbd, 0xf1, 0xf0, 0x9f, 0xbd,
-	0x05, 0x55, 0x75, 0x63, 0x86, 0xfc, 0x00, 0x1a, 0xb1, 0x80, 0x14, 0xe4, 0x56, 0x76, 0x98, 0x0a,
-	0x94, 0xa2, 0x36, 0xdf, 0x78, 0x59, 0x0c, 0x0b, 0x72, 0xa8, 0x29, 0x2e, 0x78, 0x61, 0x6f, 0x24,
-	0x95, 0x09, 0xb1, 0xd2, 0x6e, 0x5f, 0x93, 0x2a, 0x8a, 0x7b, 0x82, 0xaf, 0x16, 0x60, 0x00, 0x47,
-	0x71, 0xbc, 0x90, 0xdb, 0x51, 0x08, 0x79, 0x1d, 0x2e, 0x0b, 0x94, 0x62, 0xa2, 0x96, 0xb6, 0x4b,
-	0x43, 0xdb, 0x99, 0x04, 0x64, 0x17, 0x6a, 0xda, 0x43, 0xc0, 0xe4, 0xe6, 0xb5, 0xaf, 0x16, 0x6f,
-	0x6e, 0x66, 0x25, 0x89, 0x26, 0x7d, 0x0f, 0xaa, 0xea, 0x21, 0x54, 0xb2, 0xa1, 0x3d, 0xd4, 0xab,
-	0x3f, 0x20, 0xbb, 0xd9, 0x4a, 0x27, 0x88, 0xfc, 0xbb, 0x50, 0xd3, 0x5e, 0x2d, 0x55, 0xad, 0x48,
-	0xbf, 0x99, 0xaa, 0x5a, 0x91, 0xf5, 0xc8, 0xe9, 0x01, 0xac, 0x09, 0xf5, 0xc8, 0x09, 0xfd, 0x3a,
-	0xc3, 0x43, 0xd2, 0xc3, 0xf3, 0x20, 0x47, 0xbe, 0x84, 0x8a, 0x7c, 0x2b, 0x97, 0xac, 0x67, 0xbf,
-	0x3d, 0xbc, 0xb9, 0x91, 0x82, 0x8b, 0xa6, 0xb4, 0x01, 0xa2, 0x57, 0x4f, 0x89, 0xec, 0x78, 0xea,
-	0x7d, 0x55, 0x35, 0x33, 0x19, 0x4f, 0xa4, 0xee, 0x42, 0x4d, 0x7b, 0xe0, 0x54, 0x8d, 0x49, 0xfa,
-	0x71, 0x54, 0x35, 0x26, 0x59, 0xef, 0xa1, 0xfe, 0x00, 0x1a, 0xb1, 0x97, 0x4a, 0xd5, 0x3a, 0xce,
-	0x7a, 0x07, 0x55, 0xad, 0xe3, 0xec, 0xc7, 0x4d, 0x77, 0xa1, 0xa6, 0xbd, 0x1e, 0xaa, 0x5a, 0x94,
-	0x7e, 0xc2, 0x54, 0xb5, 0x28, 0xe3, 0xb1, 0x51, 0xb6, 0x1b, 0xe2, 0x4f, 0x87, 0xaa, 0xdd, 0x90,
-	0xf9, 0x06, 0xa9, 0xda, 0x0d, 0xd9, 0xef, 0x8d, 0xb2, 0xa5, 0xa7, 0x9e, 0x2b, 0x21, 0x1b, 0x31,
-	0xad, 0x44, 0xf4, 0xee, 0x89, 0x5a, 0x7a, 0xe9, 0x97, 0x4d, 0x1e, 0xc3, 0x8a, 0x5a, 0x34, 0xea,
-	0xb1, 0x91, 0x40, 0xb5, 0x29, 0xf3, 0x49, 0x93, 0xcd, 0x66, 0x32, 0xf5, 0x41, 0x8e, 0x7c, 0x0e,
-	0x65, 0xf1, 0x82, 0x03, 0x59, 0x4b, 0xbe, 0xe8, 0xc0, 0x1b, 0xb1, 0x9e, 0xfd, 0xd0, 0x03, 0x39,
-	0xc2, 0x0d, 0xad, 0x3f, 0xb1, 0xa0, 0xaf, 0xd8, 0x8c, 0x57, 0x19, 0x36, 0xdf, 0xbc, 0x2e, 0x39,
-	0x2a, 0x31, 0xf9, 0x2c, 0xc8, 0xed, 0xeb, 0x02, 0x6b, 0xc5, 0x4b, 0xbc, 0x2e, 0x02, 0xe8, 0x63,
-	0xa8, 0xeb, 0xcf, 0xc6, 0x11, 0x7d, 0x1f, 0x26, 0xcb, 0xba, 0x95, 0x99, 0x26, 0x0a, 0x7a, 0x0a,
-	0xeb, 0x6a, 0xbc, 0xf5, 0x28, 0x4f, 0x01, 0xb9, 0x93, 0x11, 0xfb, 0x29, 0x36, 0xea, 0x37, 0xaf,
-	0x0d, 0x0e, 0xf5, 0x20, 0x87, 0x44, 0x36, 0xf6, 0xd2, 0x53, 0x44, 0x64, 0xb3, 0x1e, 0xb8, 0x8a,
-	0x88, 0x6c, 0xf6, 0xf3, 0x50, 0x6d, 0x58, 0xd2, 0xa2, 0x54, 0x0d, 0xae, 0xdc, 0x91, 0x5a, 0xef,
-	0xe9, 0x98, 0xf6, 0x9b, 0x59, 0x4a, 0x7a, 0xb2, 0x03, 0x35, 0x3d, 0xd0, 0xd5, 0x4b, 0xb2, 0x6f,
-	0x68, 0x49, 0x7a, 0x14, 0xf1, 0x07, 0x39, 0x72, 0x00, 0xcd, 0x64, 0x58, 0x5a, 0xb5, 0x85, 0xb3,
-	0x42, 0xf9, 0x6e, 0x26, 0x12, 0x63, 0xc1, 0x6c, 0xd9, 0xba, 0x10, 0x55, 0xf3, 0x47, 0x5a, 0x3d,
-	0x3f, 0x79, 0x14, 0x71, 0xb8, 0x1c, 0x06, 0x55, 0x5a, 0x22, 0x15, 0x9b, 0x7d, 0x2f, 0xf7, 0x20,
-	0x47, 0xf6, 0xa0, 0x1e, 0x8b, 0xca, 0x18, 0xbb, 0xbc, 0x95, 0xe8, 0x66, 0x4b, 0x4f, 0x4b, 0xf4,
-	0xf3, 0x10, 0x16, 0xe3, 0x4e, 0x27, 0xaa, 0x61, 0x99, 0x9e, 0x31, 0x6a, 0xfa, 0xb2, 0x3d, 0x55,
-	0xc8, 0xcf, 0x42, 0x8d, 0xd1, 0x64, 0xe9, 0x19, 0x49, 0x34, 0x3a, 0x9d, 0x9c, 0x33, 0x0e, 0x13,
-	0x5a, 0xf3, 0xc2, 0x5f, 0xce, 0xe7, 0xb0, 0x5f, 0xdf, 0xe5, 0xcf, 0xd2, 0x4b, 0xe7, 0x38, 0x36,
-	0xff, 0xaf, 0x5b, 0x08, 0xd9, 0xe3, 0x95, 0x0f, 0x3d, 0x1e, 0x93, 0xe1, 0xa6, 0x86, 0x23, 0x60,
-	0xaf, 0xd7, 0x86, 0x36, 0x6f, 0x83, 0xc8, 0x13, 0x5b, 0x83, 0xaf, 0x59, 0x16, 0xf9, 0x0c, 0x20,
-	0x72, 0x4a, 0x26, 0x09, 0xbf, 0x57, 0xb5, 0xa1, 0x32, 0xfc, 0x96, 0x3b, 0x7c, 0xbf, 0x2b, 0xc7,
-	0x5b, 0xfd, 0x48, 0x8e, 0xfb, 0x00, 0xc7, 0x8e, 0xe4, 0x64, 0x31, 0x1f, 0x43, 0xe3, 0xc0, 0xf3,
-	0x9e, 0xcf, 0x67, 0xea, 0xaa, 0x4e, 0xdc, 0xed, 0x6b, 0xdf, 0x0e, 0xce, 0x37, 0x13, 0xcd, 0x22,
-	0x6d, 0x58, 0x56, 0x24, 0x22, 0xf2, 0xfc, 0x8d, 0x23, 0xc5, 0x08, 0x43, 0xa2, 0x80, 0x07, 0x39,
-	0xf2, 0x10, 0xea, 0xbb, 0x74, 0x84, 0x71, 0x63, 0xd0, 0x0d, 0x68, 0x25, 0xe6, 0x52, 0xc2, 0xfd,
-	0x87, 0x36, 0x1b, 0x31, 0xa0, 0x24, 0x71, 0x91, 0xa3, 0x9b, 0x7e, 0x66, 0xc4, 0xbd, 0xc5, 0x62,
-	0x24, 0x2e, 0xe5, 0xec, 0xf6, 0x14, 0x96, 0x53, 0xce, 0x5e, 0x8a, 0xba, 0x5d, 0xe7, 0x80, 0xb6,
-	0x79, 0xf7, 0x7a, 0x04, 0x51, 0xee, 0xf7, 0xa1, 0xc1, 0x83, 0xca, 0x9f, 0x50, 0x7e, 0xef, 0x3b,
-	0x11, 0x32, 0x50, 0xbf, 0x54, 0x9e, 0x24, 0x49, 0x3c, 0xc3, 0x63, 0x7c, 0x03, 0x4b, 0xbb, 0x55,
-	0xad, 0xe6, 0x35, 0x7d, 0xd3, 0x5b, 0xcd, 0x6b, 0xd6, 0x05, 0xee, 0x2f, 0xa0, 0xf6, 0x98, 0x86,
-	0xf2, 0x9e, 0xb2, 0xe2, 0x8f, 0x12, 0x17, 0x97, 0x37, 0x33, 0x6e, 0x97, 0x93, 0x4f, 0x31, 0xab,
-	0x8a, 0xb9, 0xb1, 0xae, 0xd5, 0xa2, 0x67, 0x5d, 0x4a, 0xc0, 0x19, 0xf7, 0xa1, 0x45, 0xde, 0x51,
-	0x0d, 0x4f, 0x47, 0x5a, 0x52, 0x0d, 0xcf, 0x0a, 0xd4, 0xf3, 0xb3, 0x7c, 0x04, 0xb4, 0x9b, 0xd1,
-	0x11, 0x0b, 0x96, 0xbc, 0x44, 0xad, 0x9a, 0xaf, 0xa3, 0x3f, 0x02, 0x18, 0x84, 0xde, 0x6c, 0xd7,
-	0xa6, 0x53, 0xcf, 0x8d, 0x68, 0x42, 0x74, 0x27, 0x37, 0xda, 0x88, 0xda, 0xc5, 0x5c, 0xf2, 0x95,
-	0xc6, 0x9b, 0xc6, 0xa6, 0x44, 0x4e, 0xfb, 0xb5, 0xd7, 0x76, 0x55, 0x77, 0x32, 0xae, 0xee, 0x22,
-	0x91, 0x80, 0xc8, 0x97, 0x4e, 0x71, 0x9a, 0x29, 0x37, 0x3d, 0xb5, 0xd7, 0x33, 0x1c, 0xef, 0xbe,
-	0x07, 0xd5, 0xc8, 0x09, 0x69, 0x23, 0x0a, 0x03, 0x16, 0x73, 0x59, 0x52, 0xd4, 0x3b, 0xed, 0x00,
-	0xd4, 0x83, 0x15, 0xde, 0x1c, 0x75, 0xfc, 0xe1, 0x5d, 0x4d, 0xf5, 0x84, 0x5b, 0xda, 0xf3, 0x46,
-	0xed, 0x9f, 0x2c, 0xff, 0x11, 0xb6, 0x7f, 0x52, 0x0e, 0x06, 0x6a, 0xff, 0x5c, 0xe7, 0x58, 0xa2,
-	0xf6, 0xcf, 0xf5, 0xbe, 0x09, 0x3d, 0x58, 0xc9, 0x70, 0x15, 0x20, 0x6f, 0x49, 0xc1, 0xe6, 0x5a,
-	0x37, 0x82, 0xcd, 0x4c, 0x93, 0x32, 0x19, 0xc2, 0x06, 0xcf, 0xd3, 0x9e, 0x4c, 0x12, 0x96, 0xe9,
-	0x37, 0xb5, 0x0c, 0x19, 0xd6, 0xf6, 0x18, 0x2b, 0x93, 0xb0, 0xb8, 0xf7, 0xa0, 0x99, 0x34, 0xea,
-	0x92, 0xeb, 0xd1, 0x37, 0xef, 0xc4, 0x58, 0xf6, 0xb4, 0x21, 0x98, 0x3c, 0x55, 0xa6, 0xe5, 0x44,
-	0x1b, 0xef, 0x44, 0x4f, 0x91, 0x66, 0x1a, 0xc2, 0x95, 0x34, 0x90, 0x69, 0x99, 0x26, 0x3f, 0x07,
-	0x1b, 0xc9, 0x15, 0x2d, 0x4b, 0xbe, 0x9b, 0x35, 0x5c, 0xd7, 0xb2, 0x72, 0xf1, 0x0e, 0x3d, 0xc8,
-	0x31, 0x42, 0xac, 0x1b, 0x80, 0xd5, 0x42, 0xca, 0xb0, 0x44, 0xab, 0x85, 0x94, 0x69, 0x31, 0x3e,
-	0x82, 0xa5, 0x84, 0xed, 0x57, 0xb1, 0xc1, 0xd9, 0xd6, 0x62, 0xc5, 0x06, 0x5f, 0x67, 0x32, 0x1e,
-	0x40, 0x33, 0x69, 0xd5, 0x55, 0x73, 0x7d, 0x8d, 0xa5, 0x78, 0xf3, 0xce, 0xb5, 0xe9, 0xf1, 0x66,
-	0x6a, 0xf6, 0xcf, 0x58, 0x33, 0xd3, 0x56, 0xdb, 0x58, 0x33, 0x33, 0xac, 0xaf, 0xdb, 0xef, 0xfd,
-	0xfc, 0xb7, 0xce, 0x9c, 0xf0, 0x7c, 0x7e, 0xb2, 0x35, 0xf2, 0xa6, 0x1f, 0x4e, 0xa4, 0x56, 0x43,
-	0x04, 0x52, 0xf8, 0x70, 0xe2, 0x8e, 0x3f, 0xc4, 0x02, 0x4e, 0x16, 0x66, 0xbe, 0x17, 0x7a, 0x1f,
-	0xff, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x74, 0x20, 0x46, 0x2f, 0x25, 0x95, 0x00, 0x00,
+	// 13883 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0xbd, 0x5d, 0x8c, 0x24, 0x59,
+	0x76, 0x10, 0xdc, 0xf9, 0x57, 0x99, 0x79, 0x32, 0xb3, 0x2a, 0x2b, 0xea, 0x2f, 0x3b, 0xbb, 0x7b,
+	0xa6, 0x27, 0x76, 0x76, 0xa7, 0xb7, 0x67, 0xa6, 0xa6, 0xa7, 0xe7, 0x7f, 0xc7, 0x5e, 0x6f, 0x56,
+	0x56, 0x56, 0x57, 0x6e, 0x57, 0x55, 0xd6, 0x46, 0x66, 0xcd, 0x6c, 0xef, 0xf7, 0xd9, 0xf1, 0x45,
+	0x65, 0x46, 0x55, 0x85, 0x3b, 0x33, 0x22, 0x37, 0x23, 0xb2, 0xbb, 0x6b, 0xad, 0x4f, 0x32, 0xc6,
+	0x60, 0xcb, 0x42, 0xc8, 0x48, 0x18, 0x09, 0x61, 0x0b, 0x10, 0x16, 0x88, 0x17, 0xcb, 0x92, 0xed,
+	0x17, 0x40, 0x7e, 0x41, 0xc2, 0x0f, 0x80, 0x10, 0x0f, 0x96, 0x40, 0x20, 0x21, 0x24, 0x30, 0x12,
+	0x08, 0xb0, 0x0c, 0x12, 0x20, 0xf1, 0x80, 0xce, 0x3d, 0xf7, 0x46, 0xdc, 0x1b, 0x11, 0xd9, 0x55,
+	0xb3, 0x3b, 0x5e, 0xf1, 0x52, 0x95, 0x71, 0xce, 0xb9, 0xff, 0xf7, 0x9e, 0x7b, 0xee, 0x39, 0xe7,
+	0x9e, 0x0b, 0xe5, 0xd9, 0x74, 0xb8, 0x3d, 0x9d, 0x79, 0x81, 0xa7, 0x15, 0xc6, 0xee, 0x6c, 0x3a,
+	0xd4, 0xff, 0x28, 0x03, 0xf9, 0x93, 0xe0, 0x85, 0xa7, 0x7d, 0x00, 0x55, 0x6b, 0x34, 0x9a, 0xd9,
+	0xbe, 0x6f, 0x06, 0x97, 0x53, 0xbb, 0x91, 0xb9, 0x9b, 0xb9, 0xb7, 0xfc, 0x50, 0xdb, 0x66, 0x64,
+	0xdb, 0x2d, 0x42, 0x0d, 0x2e, 0xa7, 0xb6, 0x51, 0xb1, 0xa2, 0x0f, 0xad, 0x01, 0x45, 0xfe, 0xd9,
+	0xc8, 0xde, 0xcd, 0xdc, 0x2b, 0x1b, 0xe2, 0x53, 0xbb, 0x03, 0x60, 0x4d, 0xbc, 0xb9, 0x1b, 0x98,
+	0xbe, 0x15, 0x34, 0x72, 0x77, 0x33, 0xf7, 0x72, 0x46, 0x99, 0x20, 0x7d, 0x2b, 0xd0, 0x6e, 0x41,
+	0x79, 0xfa, 0xd4, 0xf4, 0x87, 0x33, 0x67, 0x1a, 0x34, 0xf2, 0x2c, 0x69, 0x69, 0xfa, 0xb4, 0xcf,
+	0xbe, 0xb5, 0x37, 0xa1, 0xe4, 0xcd, 0x83, 0xa9, 0xe7, 0xb8, 0x41, 0xa3, 0x70, 0x37, 0x73, 0xaf,
+	0xf2, 0x70, 0x85, 0x57, 0xa4, 0x37, 0x0f, 0x8e, 0x11, 0x6c, 0x84, 0x04, 0xda, 0xeb, 0x50, 0x1b,
+	0x7a, 0xee, 0x99, 0x33, 0x9b, 0x58, 0x81, 0xe3, 0xb9, 0x7e, 0x63, 0x89, 0x95, 0xa5, 0x02, 0xf5,
+	0x7f, 0x94, 0x85, 0xca, 0x60, 0x66, 0xb9, 0xbe, 0x35, 0x44, 0x80, 0xb6, 0x05, 0xc5, 0xe0, 0x85,
+	0x79, 0x61, 0xf9, 0x17, 0xac, 0xa9, 0x65, 0x63, 0x29, 0x78, 0xb1, 0x6f, 0xf9, 0x17, 0xda, 0x26,
+	0x2c, 0x51, 0x2d, 0x59, 0x83, 0x72, 0x06, 0xff, 0xd2, 0xde, 0x84, 0x55, 0x77, 0x3e, 0x31, 0xd5,
+	0xa2, 0xb0, 0x59, 0x05, 0xa3, 0xee, 0xce, 0x27, 0x6d, 0x19, 0x8e, 0x8d, 0x3f, 0x1d, 0x7b, 0xc3,
+	0xa7, 0x54, 0x00, 0x35, 0xaf, 0xcc, 0x20, 0xac, 0x8c, 0xd7, 0xa0, 0xca, 0xd1, 0xb6, 0x73, 0x7e,
+	0x41, 0x6d, 0x2c, 0x18, 0x15, 0x22, 0x60, 0x20, 0xcc, 0x21, 0x70, 0x26, 0xb6, 0xe9, 0x07, 0xd6,
+	0x64, 0xca, 0x9b, 0x54, 0x46, 0x48, 0x1f, 0x01, 0x0c, 0xed, 0x05, 0xd6, 0xd8, 0x3c, 0xb3, 0x6d,
+	0xbf, 0x51, 0xe4, 0x68, 0x84, 0xec, 0xd9, 0xb6, 0xaf, 0x7d, 0x15, 0x96, 0x47, 0xb6, 0x1f, 0x98,
+	0x7c, 0x30, 0x6c, 0xbf, 0x51, 0xba, 0x9b, 0xbb, 0x57, 0x36, 0x6a, 0x08, 0x6d, 0x09, 0xa0, 0x76,
+	0x1b, 0x60, 0x66, 0x3d, 0x37, 0xb1, 0x23, 0xec, 0x17, 0x8d, 0x32, 0x8d, 0xc2, 0xcc, 0x7a, 0x3e,
+	0x78, 0xb1, 0x6f, 0xbf, 0xd0, 0xd6, 0xa1, 0x30, 0xb6, 0x4e, 0xed, 0x71, 0x03, 0x18, 0x82, 0x3e,
+	0xf4, 0x00, 0x36, 0x1f, 0xd9, 0x81, 0xd4, 0x95, 0xbe, 0x61, 0x7f, 0x7f, 0x6e, 0xfb, 0x01, 0xb6,
+	0xca, 0x0f, 0xac, 0x59, 0x20, 0x5a, 0x95, 0xa1, 0x56, 0x31, 0x58, 0xd4, 0x2a, 0xdb, 0x1d, 0x09,
+	0x82, 0x2c, 0x23, 0x28, 0xdb, 0xee, 0x88, 0xa3, 0x71, 0x36, 0x0d, 0x87, 0xac, 0xf3, 0x73, 0x7c,
+	0x36, 0xd1, 0xa7, 0x7e, 0x00, 0x9a, 0x54, 0xe4, 0xae, 0x1d, 0x58, 0xce, 0xd8, 0xd7, 0x3e, 0x84,
+	0x6a, 0x20, 0x55, 0xa4, 0x91, 0xb9, 0x9b, 0xbb, 0x57, 0x09, 0x27, 0xad, 0x94, 0xc0, 0x50, 0xe8,
+	0xf4, 0x0b, 0x28, 0xed, 0xd9, 0xf6, 0x81, 0x33, 0x71, 0x02, 0x6d, 0x13, 0x0a, 0x67, 0xce, 0x0b,
+	0x7b, 0xc4, 0xaa, 0x9b, 0xdb, 0xbf, 0x61, 0xd0, 0xa7, 0xf6, 0x2a, 0x00, 0xfb, 0x61, 0x4e, 0xc2,
+	0xf9, 0xbb, 0x7f, 0xc3, 0x28, 0x33, 0xd8, 0xa1, 0x6f, 0x05, 0x5a, 0x13, 0x8a, 0x53, 0x7b, 0x36,
+	0xb4, 0xc5, 0x4c, 0xd9, 0xbf, 0x61, 0x08, 0xc0, 0x4e, 0x11, 0x0a, 0x63, 0xcc, 0x5d, 0xff, 0x8f,
+	0x05, 0xa8, 0xf4, 0x6d, 0x77, 0x24, 0xfa, 0x48, 0x83, 0x3c, 0x0e, 0x01, 0x2b, 0xac, 0x6a, 0xb0,
+	0xdf, 0xda, 0x57, 0xa0, 0x82, 0xff, 0x4d, 0x3f, 0x98, 0x39, 0xee, 0x39, 0xad, 0xa3, 0x9d, 0x6c,
+	0x23, 0x63, 0x00, 0x82, 0xfb, 0x0c, 0xaa, 0xd5, 0x21, 0x67, 0x4d, 0xc4, 0x3a, 0xc2, 0x9f, 0xda,
+	0x4d, 0x28, 0x59, 0x93, 0x80, 0xaa, 0x57, 0x65, 0xe0, 0xa2, 0x35, 0x09, 0x58, 0xd5, 0x5e, 0x83,
+	0xea, 0xd4, 0xba, 0x9c, 0xd8, 0x6e, 0x10, 0x4d, 0xc0, 0xaa, 0x51, 0xe1, 0x30, 0x36, 0x05, 0x1f,
+	0xc2, 0x9a, 0x4c, 0x22, 0x0a, 0x2f, 0x84, 0x85, 0xaf, 0x4a, 0xd4, 0xbc, 0x0e, 0x6f, 0xc0, 0x8a,
+	0x48, 0x33, 0xa3, 0xf6, 0xb0, 0x89, 0x59, 0x36, 0x96, 0x39, 0x58, 0xb4, 0xf2, 0x1e, 0xd4, 0xcf,
+	0x1c, 0xd7, 0x1a, 0x9b, 0xc3, 0x71, 0xf0, 0xcc, 0x1c, 0xd9, 0xe3, 0xc0, 0x62, 0x73, 0xb4, 0x60,
+	0x2c, 0x33, 0x78, 0x7b, 0x1c, 0x3c, 0xdb, 0x45, 0xa8, 0xf6, 0x16, 0x94, 0xcf, 0x6c, 0xdb, 0x64,
+	0x9d, 0xd5, 0x28, 0x29, 0x4b, 0x5d, 0x8c, 0x90, 0x51, 0x3a, 0xe3, 0xbf, 0xb4, 0xb7, 0xa0, 0xee,
+	0xcd, 0x83, 0x73, 0xcf, 0x71, 0xcf, 0xcd, 0xe1, 0x85, 0xe5, 0x9a, 0xce, 0x88, 0xcd, 0xda, 0xfc,
+	0x4e, 0xf6, 0x41, 0xc6, 0x58, 0x16, 0xb8, 0xf6, 0x85, 0xe5, 0x76, 0x47, 0xda, 0xd7, 0x60, 0x65,
+	0x6c, 0xf9, 0x81, 0x79, 0xe1, 0x4d, 0xcd, 0xe9, 0xfc, 0xf4, 0xa9, 0x7d, 0xd9, 0xa8, 0xb1, 0x8e,
+	0xa8, 0x21, 0x78, 0xdf, 0x9b, 0x1e, 0x33, 0x20, 0x4e, 0x4a, 0x56, 0x4f, 0xaa, 0x04, 0x4e, 0xf6,
+	0x9a, 0x51, 0x46, 0x08, 0x15, 0xfa, 0x04, 0xd6, 0xd8, 0xf0, 0x0c, 0xe7, 0x7e, 0xe0, 0x4d, 0xcc,
+	0x99, 0x3d, 0xf4, 0x66, 0x23, 0xbf, 0x51, 0x61, 0x73, 0xed, 0xeb, 0xbc, 0xb2, 0xd2, 0x18, 0x6f,
+	0xef, 0xda, 0x7e, 0xd0, 0x66, 0xc4, 0x06, 0xd1, 0x76, 0xdc, 0x60, 0x76, 0x69, 0xac, 0x8e, 0xe2,
+	0x70, 0xed, 0x2d, 0xd0, 0xac, 0xf1, 0xd8, 0x7b, 0x6e, 0xfa, 0xf6, 0xf8, 0xcc, 0xe4, 0x9d, 0xd8,
+	0x58, 0xbe, 0x9b, 0xb9, 0x57, 0x32, 0xea, 0x0c, 0xd3, 0xb7, 0xc7, 0x67, 0xc7, 0x04, 0xd7, 0x3e,
+	0x04, 0xb6, 0x7c, 0xcd, 0x33, 0xdb, 0x0a, 0xe6, 0x33, 0xdb, 0x6f, 0xac, 0xdc, 0xcd, 0xdd, 0x5b,
+	0x7e, 0xb8, 0x1a, 0xf6, 0x17, 0x03, 0xef, 0x38, 0x81, 0x51, 0x45, 0x3a, 0xfe, 0xed, 0xcb, 0xb3,
+	0x01, 0xf9, 0x41, 0xa3, 0xae, 0xcc, 0x06, 0xe4, 0x06, 0xcd, 0x5d, 0xd8, 0x4c, 0xaf, 0x35, 0xce,
+	0x3b, 0xec, 0x38, 0x9c, 0xaf, 0x79, 0x03, 0x7f, 0x22, 0x5b, 0x78, 0x66, 0x8d, 0xe7, 0x36, 0x9b,
+	0xa8, 0x55, 0x83, 0x3e, 0xbe, 0x91, 0xfd, 0x38, 0xa3, 0xff, 0x6e, 0x06, 0xaa, 0xd4, 0x11, 0xfe,
+	0xd4, 0x73, 0x7d, 0x5b, 0xfb, 0x0a, 0xd4, 0x44, 0xc9, 0xf6, 0x6c, 0xe6, 0xcd, 0x38, 0xab, 0x15,
+	0xd5, 0xe9, 0x20, 0x4c, 0xfb, 0x3a, 0xd4, 0x05, 0xd1, 0x74, 0x66, 0x3b, 0x13, 0xeb, 0x5c, 0x64,
+	0x2d, 0x66, 0xdb, 0x31, 0x07, 0x6b, 0xef, 0x46, 0xf9, 0xcd, 0xbc, 0x79, 0x60, 0xb3, 0xe5, 0x50,
+	0x79, 0x58, 0xe5, 0x3d, 0x60, 0x20, 0x2c, 0xcc, 0x9d, 0x7d, 0x5d, 0x63, 0x29, 0xe8, 0xbf, 0x96,
+	0x01, 0x0d, 0xab, 0x3d, 0xf0, 0x28, 0x83, 0x88, 0x9d, 0x29, 0x29, 0x33, 0xd7, 0x5e, 0x44, 0xd9,
+	0x97, 0x2d, 0x22, 0x1d, 0x0a, 0x54, 0xf7, 0x7c, 0x4a, 0xdd, 0x09, 0xf5, 0xed, 0x7c, 0x29, 0x57,
+	0xcf, 0xeb, 0xbf, 0x90, 0x87, 0x75, 0x9c, 0xca, 0xae, 0x3d, 0x6e, 0x0d, 0x87, 0xf6, 0x34, 0x5c,
+	0x5e, 0xaf, 0x42, 0xc5, 0xf5, 0x46, 0xb6, 0x98, 0xd4, 0x54, 0x31, 0x40, 0x90, 0x34, 0xa3, 0x2f,
+	0x2c, 0xc7, 0xa5, 0x8a, 0x53, 0x67, 0x96, 0x19, 0x84, 0x55, 0xfb, 0x6b, 0xb0, 0x32, 0xb5, 0xdd,
+	0x91, 0xbc, 0x8a, 0x72, 0xb4, 0x30, 0x38, 0x98, 0x2f, 0xa0, 0x57, 0xa1, 0x72, 0x36, 0x27, 0x3a,
+	0xe4, 0x3d, 0x79, 0x36, 0x07, 0x80, 0x83, 0x5a, 0xc4, 0x82, 0xa6, 0x73, 0xff, 0x82, 0x61, 0x0b,
+	0x0c, 0x5b, 0xc4, 0x6f, 0x44, 0xdd, 0x01, 0x18, 0xcd, 0xfd, 0x80, 0x2f, 0xaa, 0x25, 0x86, 0x2c,
+	0x23, 0x84, 0x16, 0xd5, 0xdb, 0xb0, 0x36, 0xb1, 0x5e, 0x98, 0x6c, 0xee, 0x98, 0x8e, 0x6b, 0x9e,
+	0x8d, 0xd9, 0x8e, 0x50, 0x64, 0x74, 0xf5, 0x89, 0xf5, 0xe2, 0x33, 0xc4, 0x74, 0xdd, 0x3d, 0x06,
+	0x47, 0xce, 0x33, 0xa4, 0x9e, 0x30, 0x67, 0xb6, 0x6f, 0xcf, 0x9e, 0xd9, 0x8c, 0x59, 0xe4, 0x8d,
+	0x65, 0x0e, 0x36, 0x08, 0x8a, 0x35, 0x9a, 0x60, 0xbb, 0x83, 0xf1, 0x90, 0x38, 0x83, 0x51, 0x9c,
+	0x38, 0xee, 0x7e, 0x30, 0x1e, 0xe2, 0x66, 0x87, 0xac, 0x66, 0x6a, 0xcf, 0xcc, 0xa7, 0xcf, 0xd9,
+	0x32, 0xcf, 0x33, 0xd6, 0x72, 0x6c, 0xcf, 0x1e, 0x3f, 0x47, 0x79, 0x64, 0xe8, 0x33, 0x5e, 0x65,
+	0x5d, 0x36, 0x2a, 0x8c, 0x07, 0x94, 0x86, 0x3e, 0x72, 0x29, 0xeb, 0x12, 0xd7, 0x29, 0xd6, 0xd6,
+	0x62, 0xa3, 0x60, 0x8f, 0x58, 0xf6, 0x3e, 0x63, 0xba, 0x35, 0x56, 0xd9, 0x16, 0x47, 0x60, 0x39,
+	0x3e, 0xce, 0x7a, 0x51, 0xd9, 0xb3, 0xb1, 0x75, 0xee, 0x33, 0xae, 0x53, 0x33, 0xaa, 0x1c, 0xb8,
+	0x87, 0x30, 0x1c, 0x83, 0xe7, 0x96, 0x1b, 0xf8, 0xe6, 0x0f, 0xec, 0x99, 0xc7, 0xa4, 0x0a, 0xbe,
+	0xee, 0x6b, 0x0c, 0xfc, 0x3d, 0x7b, 0xe6, 0xa1, 0x48, 0xa1, 0xff, 0x6a, 0x0e, 0x36, 0x62, 0x93,
+	0x80, 0x2f, 0x2e, 0x14, 0x54, 0x18, 0x84, 0x4d, 0x80, 0x92, 0xc1, 0xbf, 0xd2, 0x46, 0x37, 0x9b,
+	0x36, 0xba, 0xeb, 0x50, 0xa0, 0x45, 0x49, 0x5b, 0x6d, 0xc1, 0x16, 0xab, 0x71, 0x3e, 0x3d, 0x9b,
+	0x79, 0x28, 0xb7, 0x5d, 0xcc, 0x83, 0x91, 0xf7, 0xdc, 0xe5, 0xf2, 0xcb, 0x0a, 0x87, 0xf7, 0x39,
+	0x58, 0xed, 0xb2, 0x42, 0xac, 0xcb, 0x5e, 0x85, 0x0a, 0x1f, 0x29, 0x26, 0xff, 0xd1, 0x04, 0x00,
+	0x0e, 0x42, 0x01, 0xf0, 0x4d, 0xd0, 0xc2, 0x71, 0x37, 0xb1, 0x77, 0xd9, 0x46, 0x46, 0x13, 0x60,
+	0xc5, 0xe1, 0x03, 0x7f, 0x68, 0xbd, 0x60, 0x1b, 0xda, 0xeb, 0xb0, 0x8c, 0x24, 0xd8, 0xef, 0x26,
+	0xc9, 0x07, 0x25, 0xea, 0xd3, 0x89, 0xf5, 0x02, 0x3b, 0xbd, 0x8d, 0x30, 0xed, 0x15, 0xa8, 0x88,
+	0xc1, 0x37, 0x1d, 0x97, 0x8f, 0x7f, 0x99, 0x8f, 0x7f, 0xd7, 0xc5, 0x6d, 0x09, 0xf1, 0xd4, 0x4f,
+	0xe6, 0xc8, 0x9e, 0x06, 0x17, 0x9c, 0xdd, 0x2f, 0x4f, 0x1c, 0x97, 0xba, 0x77, 0x17, 0xa1, 0xd8,
+	0xb4, 0x68, 0x5c, 0x2a, 0xac, 0x7b, 0x4b, 0x3f, 0x10, 0x43, 0xf2, 0x1b, 0x19, 0xa8, 0xf2, 0x21,
+	0x61, 0xb2, 0xa8, 0xb6, 0x0d, 0x9a, 0x58, 0x27, 0xc1, 0x0b, 0x67, 0x64, 0x9e, 0x5e, 0x06, 0xb6,
+	0x4f, 0xcb, 0x72, 0xff, 0x86, 0x51, 0xe7, 0xb8, 0xc1, 0x0b, 0x67, 0xb4, 0x83, 0x18, 0xed, 0x3e,
+	0xd4, 0x15, 0x7a, 0x3f, 0x98, 0x11, 0xcf, 0xd8, 0xbf, 0x61, 0x2c, 0x4b, 0xd4, 0xfd, 0x60, 0x86,
+	0x5c, 0x08, 0x25, 0xdd, 0x79, 0x60, 0x3a, 0xee, 0xc8, 0x7e, 0xc1, 0x06, 0xab, 0x66, 0x54, 0x08,
+	0xd6, 0x45, 0xd0, 0xce, 0x32, 0x54, 0xe5, 0xec, 0xf4, 0x73, 0x28, 0x09, 0x31, 0x99, 0xc9, 0x89,
+	0xb1, 0x2a, 0x19, 0xe5, 0x20, 0xac, 0xc9, 0x4d, 0x28, 0xa9, 0x35, 0x30, 0x8a, 0xc1, 0xb5, 0x0b,
+	0xd6, 0xbf, 0x09, 0xf5, 0x03, 0x1c, 0x25, 0x17, 0xd9, 0x01, 0x17, 0xfb, 0x37, 0x61, 0x49, 0x62,
+	0x4b, 0x65, 0x83, 0x7f, 0xa1, 0xe0, 0x73, 0xe1, 0xf9, 0x01, 0x2f, 0x85, 0xfd, 0xd6, 0xff, 0x20,
+	0x03, 0x5a, 0xc7, 0x0f, 0x9c, 0x89, 0x15, 0xd8, 0x7b, 0x76, 0xc8, 0x78, 0x7b, 0x50, 0xc5, 0xdc,
+	0x06, 0x5e, 0x8b, 0xe4, 0x70, 0x92, 0xea, 0xde, 0xe4, 0x8c, 0x32, 0x99, 0x60, 0x5b, 0xa6, 0xa6,
+	0xbd, 0x56, 0xc9, 0x00, 0xe7, 0x62, 0x60, 0xcd, 0xce, 0xed, 0x80, 0xc6, 0x93, 0xc4, 0x4e, 0x20,
+	0x10, 0x8e, 0x68, 0xf3, 0xa7, 0x60, 0x35, 0x91, 0x87, 0xbc, 0xf3, 0x95, 0x53, 0x76, 0xbe, 0x9c,
+	0xbc, 0xf3, 0xfd, 0x52, 0x06, 0xd6, 0x94, 0x8a, 0xf1, 0x35, 0xba, 0x05, 0x45, 0xe4, 0x39, 0x38,
+	0xb3, 0x33, 0x74, 0x9a, 0x38, 0xb3, 0xd9, 0xec, 0x7f, 0x0f, 0xd6, 0xcf, 0x6c, 0x7b, 0x66, 0x05,
+	0x0c, 0xc9, 0x98, 0x12, 0x0e, 0x11, 0xe5, 0x4c, 0x5b, 0x07, 0xc7, 0xf7, 0xad, 0xe0, 0xd8, 0x9e,
+	0xe1, 0x70, 0x69, 0x3a, 0xd4, 0x04, 0xf1, 0x33, 0x46, 0x9d, 0x63, 0x33, 0xbc, 0xe2, 0x33, 0x92,
+	0xcf, 0x10, 0xa4, 0xff, 0xa7, 0x2c, 0xac, 0xe0, 0x66, 0x76, 0x68, 0xb9, 0x97, 0xa2, 0x43, 0x0f,
+	0x52, 0x3b, 0xf4, 0x9e, 0x24, 0xba, 0x48, 0xd4, 0x5f, 0xb4, 0x37, 0x73, 0xf1, 0xde, 0x4c, 0x56,
+	0x33, 0x9f, 0xa8, 0xa6, 0xf6, 0x3a, 0x54, 0x95, 0x76, 0x17, 0xc2, 0x76, 0x83, 0x1f, 0x35, 0x38,
+	0x3c, 0x81, 0x2c, 0x49, 0x27, 0x10, 0x5c, 0x9c, 0xb8, 0x8c, 0xb1, 0x74, 0x9f, 0x8b, 0x95, 0xc8,
+	0xf4, 0xb1, 0x6c, 0x1f, 0x8f, 0x69, 0x3e, 0xf2, 0x39, 0x73, 0xee, 0xf2, 0xa3, 0x9a, 0x3d, 0x62,
+	0xcc, 0xa2, 0x64, 0xd4, 0x19, 0xe2, 0x24, 0x82, 0xff, 0xe8, 0xe3, 0xfe, 0x35, 0xa8, 0x47, 0xdd,
+	0xc7, 0xc7, 0x5c, 0x83, 0x3c, 0xae, 0x21, 0x9e, 0x01, 0xfb, 0xad, 0xff, 0x66, 0x96, 0x08, 0xdb,
+	0x9e, 0x13, 0x9d, 0x97, 0x34, 0xc8, 0x33, 0x79, 0x8c, 0x13, 0xe2, 0xef, 0x85, 0xa7, 0xcf, 0x1f,
+	0x63, 0xa7, 0xdf, 0x84, 0x92, 0x8f, 0x1d, 0x68, 0x8d, 0xa9, 0xdf, 0x4b, 0x46, 0x11, 0xbf, 0x5b,
+	0xe3, 0x71, 0x34, 0x1e, 0xc5, 0x85, 0xe3, 0x51, 0xba, 0xce, 0x78, 0x94, 0xd3, 0xc7, 0x43, 0x7f,
+	0x03, 0x56, 0xa5, 0x5e, 0x7a, 0x49, 0x7f, 0x5e, 0x80, 0x76, 0xe0, 0xf8, 0xc1, 0x89, 0x8b, 0x59,
+	0x84, 0x72, 0x91, 0x52, 0x91, 0x4c, 0xac, 0x22, 0x88, 0xb4, 0x5e, 0x70, 0x64, 0x96, 0x23, 0xad,
+	0x17, 0x84, 0x5c, 0x7c, 0xf0, 0xfc, 0x18, 0xd6, 0x94, 0x92, 0x78, 0xa5, 0x5e, 0x83, 0xc2, 0x3c,
+	0x78, 0xe1, 0x89, 0x23, 0x67, 0x85, 0xaf, 0x25, 0x54, 0xa5, 0x18, 0x84, 0xd1, 0x4f, 0x60, 0xf5,
+	0xc8, 0x7e, 0xce, 0xf9, 0xa2, 0xa8, 0xe2, 0xd7, 0x20, 0x7f, 0x85, 0x7a, 0x85, 0xe1, 0xe5, 0x0a,
+	0x65, 0xd5, 0x0a, 0x6d, 0x83, 0x26, 0x67, 0xcb, 0xeb, 0x23, 0xe9, 0x61, 0x32, 0x8a, 0x1e, 0x46,
+	0xff, 0x1a, 0x68, 0x7d, 0xe7, 0xdc, 0x3d, 0xb4, 0x7d, 0xdf, 0x3a, 0x0f, 0x79, 0x6c, 0x1d, 0x72,
+	0x13, 0xff, 0x9c, 0x6f, 0x08, 0xf8, 0x53, 0x7f, 0x0f, 0xd6, 0x14, 0x3a, 0x9e, 0xf1, 0x6d, 0x28,
+	0xfb, 0xce, 0xb9, 0xcb, 0x8e, 0x12, 0x3c, 0xeb, 0x08, 0xa0, 0xef, 0xc1, 0xfa, 0x67, 0xf6, 0xcc,
+	0x39, 0xbb, 0xbc, 0x2a, 0x7b, 0x35, 0x9f, 0x6c, 0x3c, 0x9f, 0x0e, 0x6c, 0xc4, 0xf2, 0xe1, 0xc5,
+	0xd3, 0xd2, 0xe3, 0xa3, 0x5f, 0x32, 0xe8, 0x43, 0xda, 0x64, 0xb2, 0xf2, 0x26, 0xa3, 0x7b, 0xa0,
+	0xb5, 0x3d, 0xd7, 0xb5, 0x87, 0xc1, 0xb1, 0x6d, 0xcf, 0x44, 0x65, 0xde, 0x94, 0xd6, 0x59, 0xe5,
+	0xe1, 0x16, 0xef, 0xf3, 0xf8, 0xce, 0xc5, 0x17, 0xa0, 0x06, 0xf9, 0xa9, 0x3d, 0x9b, 0xb0, 0x8c,
+	0x4b, 0x06, 0xfb, 0x8d, 0x9d, 0x8b, 0x9a, 0x17, 0x6f, 0x1e, 0x70, 0x8e, 0x2b, 0x3e, 0xf5, 0x0d,
+	0x58, 0x53, 0x0a, 0xa4, 0x5a, 0xeb, 0x0f, 0x60, 0x63, 0xd7, 0xf1, 0x87, 0xc9, 0xaa, 0x6c, 0x41,
+	0x71, 0x3a, 0x3f, 0x35, 0xd5, 0xed, 0xf1, 0xb1, 0x7d, 0xa9, 0x37, 0x60, 0x33, 0x9e, 0x82, 0xe7,
+	0xf5, 0xe7, 0xb3, 0x90, 0xdf, 0x1f, 0x1c, 0xb4, 0xb5, 0x26, 0x94, 0x1c, 0x77, 0xe8, 0x4d, 0xf0,
+	0x84, 0x41, 0xbd, 0x11, 0x7e, 0x2f, 0x64, 0x1b, 0xb7, 0xa0, 0xcc, 0x0e, 0x26, 0xa8, 0x58, 0xe2,
+	0x32, 0x7e, 0x09, 0x01, 0x07, 0xde, 0xf0, 0x29, 0x2e, 0x4d, 0xfb, 0xc5, 0xd4, 0x99, 0x31, 0x9d,
+	0x95, 0xd0, 0xc9, 0xe4, 0x49, 0xa8, 0x8d, 0x10, 0x91, 0xe6, 0x86, 0xcb, 0x55, 0x28, 0x0c, 0x90,
+	0xb0, 0x5f, 0xbe, 0x60, 0x72, 0xd5, 0xc8, 0x7e, 0xa1, 0xbd, 0x0d, 0xda, 0x99, 0x37, 0x7b, 0x6e,
+	0xcd, 0x42, 0xb9, 0xd3, 0xe5, 0x6c, 0x3b, 0x6f, 0xac, 0x46, 0x18, 0x2e, 0x36, 0x69, 0x0f, 0x61,
+	0x43, 0x22, 0x97, 0x32, 0x26, 0xf9, 0x6f, 0x2d, 0x42, 0xee, 0x8b, 0x22, 0xf4, 0x5f, 0xcc, 0x82,
+	0xc6, 0xd3, 0xb7, 0x3d, 0xd7, 0x0f, 0x66, 0x96, 0xe3, 0x06, 0xbe, 0x2a, 0x85, 0x66, 0x62, 0x52,
+	0xe8, 0x3d, 0xa8, 0x33, 0x19, 0x58, 0x16, 0x45, 0xb3, 0xd1, 0xc1, 0xc1, 0x88, 0xc4, 0xd1, 0xd7,
+	0x61, 0x39, 0x3a, 0xaf, 0x84, 0x2a, 0xcb, 0xbc, 0x51, 0x0d, 0xcf, 0x2c, 0x48, 0xf5, 0x0e, 0xac,
+	0x23, 0x13, 0x11, 0xf2, 0x75, 0xa8, 0x7f, 0x21, 0x66, 0xbb, 0x3a, 0xb1, 0x5e, 0x1c, 0xdb, 0xe2,
+	0x74, 0xc4, 0x04, 0x57, 0x1d, 0x6a, 0xa1, 0x48, 0xca, 0x28, 0xa9, 0xe7, 0x2a, 0x5c, 0x28, 0x65,
+	0x34, 0xe9, 0xa7, 0x8b, 0xa5, 0xf4, 0xd3, 0x85, 0xfe, 0xdf, 0xcb, 0x50, 0x14, 0xdd, 0xc8, 0x8e,
+	0x00, 0x81, 0xf3, 0xcc, 0x8e, 0x8e, 0x00, 0xf8, 0x85, 0x27, 0x90, 0x99, 0x3d, 0xf1, 0x82, 0xf0,
+	0x88, 0x48, 0xcb, 0xa4, 0x4a, 0x40, 0x7e, 0x48, 0x94, 0x8e, 0x29, 0xa4, 0x69, 0x25, 0xce, 0x27,
+	0x8e, 0x29, 0x24, 0x3f, 0xde, 0x82, 0xa2, 0x38, 0x44, 0xe4, 0x43, 0x45, 0xcb, 0xd2, 0x90, 0x4e,
+	0x10, 0x4d, 0x28, 0x0d, 0xad, 0xa9, 0x35, 0x74, 0x02, 0x92, 0xff, 0x73, 0x46, 0xf8, 0x8d, 0xb9,
+	0x8f, 0xbd, 0xa1, 0x35, 0x36, 0x4f, 0xad, 0xb1, 0xe5, 0x0e, 0x6d, 0xae, 0xc2, 0xac, 0x32, 0xe0,
+	0x0e, 0xc1, 0x50, 0x4d, 0xc9, 0xeb, 0x29, 0xa8, 0x48, 0x93, 0xc9, 0x6b, 0x2f, 0xc8, 0xf0, 0x38,
+	0xeb, 0x4d, 0x70, 0x5c, 0xce, 0x6c, 0x3a, 0xf8, 0xe5, 0x8c, 0x32, 0x41, 0xf6, 0x6c, 0xd6, 0x5a,
+	0x8e, 0x7e, 0x4e, 0x73, 0xb8, 0x4c, 0x45, 0x11, 0xf0, 0x73, 0x06, 0x4b, 0x39, 0xfd, 0xe5, 0xa4,
+	0xd3, 0xdf, 0x9b, 0xb0, 0x3a, 0x77, 0x7d, 0x3b, 0x08, 0xc6, 0xf6, 0x28, 0xac, 0x4b, 0x85, 0x11,
+	0xd5, 0x43, 0x84, 0xa8, 0xce, 0x36, 0xac, 0x91, 0xee, 0xd5, 0xb7, 0x02, 0xcf, 0xbf, 0x70, 0x7c,
+	0xd3, 0xb7, 0x5d, 0xa1, 0x83, 0x5b, 0x65, 0xa8, 0x3e, 0xc7, 0xf4, 0x49, 0x6f, 0xb3, 0x15, 0xa3,
+	0x9f, 0xd9, 0x43, 0xdb, 0x79, 0x66, 0x8f, 0xd8, 0xc9, 0x30, 0x67, 0x6c, 0x28, 0x69, 0x0c, 0x8e,
+	0x64, 0xc7, 0xfc, 0xf9, 0xc4, 0x9c, 0x4f, 0x47, 0x16, 0x0a, 0xef, 0xcb, 0x74, 0x84, 0x72, 0xe7,
+	0x93, 0x13, 0x82, 0x68, 0x0f, 0x40, 0x1c, 0xe9, 0xf8, 0x9c, 0x59, 0x51, 0x36, 0x23, 0xe4, 0x1a,
+	0x46, 0x95, 0x53, 0xd0, 0xd1, 0xf4, 0x55, 0x79, 0xb1, 0xa0, 0x1e, 0xa8, 0xc6, 0xb6, 0xff, 0x68,
+	0xc1, 0x34, 0xa0, 0x38, 0x9d, 0x39, 0xcf, 0xac, 0xc0, 0x6e, 0xac, 0xd2, 0xde, 0xcf, 0x3f, 0x91,
+	0x81, 0x3b, 0xae, 0x13, 0x38, 0x56, 0xe0, 0xcd, 0x1a, 0x1a, 0xc3, 0x45, 0x00, 0xed, 0x3e, 0xac,
+	0xb2, 0x79, 0xe2, 0x07, 0x56, 0x30, 0xf7, 0xf9, 0xb9, 0x77, 0x8d, 0xce, 0x8d, 0x88, 0xe8, 0x33,
+	0x38, 0x1d, 0x7d, 0x3f, 0x82, 0x4d, 0x9a, 0x1a, 0x89, 0xa5, 0xb9, 0x1e, 0x0a, 0x24, 0x6b, 0x8c,
+	0xa2, 0xad, 0xae, 0xd1, 0x4f, 0x60, 0x8b, 0x4f, 0x97, 0x44, 0xca, 0x8d, 0x30, 0xe5, 0x3a, 0x91,
+	0xc4, 0x92, 0x6e, 0xc3, 0x2a, 0x56, 0xcd, 0x19, 0x9a, 0x3c, 0x07, 0x5c, 0x15, 0x9b, 0xd8, 0x0a,
+	0x96, 0x68, 0x85, 0x90, 0x06, 0xc3, 0x3d, 0xb6, 0x2f, 0xb5, 0x6f, 0xc2, 0x0a, 0x4d, 0x1f, 0xa6,
+	0xdc, 0x61, 0x5b, 0x76, 0x93, 0x6d, 0xd9, 0x1b, 0xbc, 0x73, 0xdb, 0x21, 0x96, 0xed, 0xda, 0xcb,
+	0x43, 0xe5, 0x1b, 0x97, 0xc6, 0xd8, 0x39, 0xb3, 0x71, 0x9f, 0x68, 0x6c, 0xd1, 0x64, 0x13, 0xdf,
+	0xb8, 0x6a, 0xe7, 0x53, 0x86, 0x69, 0x10, 0xb3, 0xa6, 0x2f, 0x36, 0x8f, 0xc7, 0x9e, 0x6f, 0x0b,
+	0xad, 0x7d, 0xe3, 0x26, 0x5f, 0x90, 0x08, 0x14, 0xe7, 0x2b, 0x3c, 0xdd, 0x93, 0xca, 0x25, 0xb4,
+	0xad, 0xdc, 0x62, 0x13, 0xa3, 0x46, 0x9a, 0x17, 0x61, 0x5f, 0x41, 0x81, 0xf1, 0xc2, 0x7a, 0x2e,
+	0xd8, 0xfa, 0x6d, 0xc6, 0x4d, 0x00, 0x41, 0x9c, 0xa1, 0xef, 0xc1, 0x2a, 0x1f, 0x85, 0x88, 0x99,
+	0x36, 0xee, 0xb0, 0x2d, 0xf2, 0xa6, 0x68, 0x63, 0x82, 0xdb, 0x1a, 0x75, 0x1a, 0x97, 0x08, 0xa2,
+	0xed, 0x83, 0x26, 0x06, 0x45, 0xca, 0xe8, 0x95, 0xab, 0x32, 0x5a, 0xe5, 0xc3, 0xa4, 0x72, 0xf2,
+	0xe8, 0xd0, 0xfd, 0x6a, 0xec, 0xd0, 0xfd, 0x3b, 0x19, 0x12, 0xc4, 0x78, 0x56, 0xbe, 0xa4, 0x0b,
+	0x23, 0xa6, 0x67, 0x7a, 0xee, 0xf8, 0x92, 0xf3, 0x41, 0x20, 0x50, 0xcf, 0x1d, 0x33, 0x46, 0xe4,
+	0xb8, 0x32, 0x09, 0xed, 0xec, 0x55, 0xc7, 0x95, 0x88, 0x5e, 0x85, 0xca, 0x74, 0x7e, 0x3a, 0x76,
+	0x86, 0x44, 0x92, 0xa3, 0x5c, 0x08, 0xc4, 0x08, 0x50, 0x19, 0x48, 0x0b, 0x81, 0x28, 0xf2, 0x8c,
+	0xa2, 0xc2, 0x61, 0x8c, 0x84, 0x49, 0x0e, 0xf6, 0x8c, 0x71, 0xc2, 0xaa, 0xc1, 0x7e, 0xeb, 0x3b,
+	0xb0, 0xae, 0x56, 0x9a, 0x8b, 0x35, 0xf7, 0xa1, 0xc4, 0xd9, 0xac, 0x50, 0x24, 0x2f, 0xab, 0x5d,
+	0x65, 0x84, 0x78, 0xfd, 0x4f, 0x0a, 0xb0, 0x26, 0x3a, 0x10, 0x67, 0x42, 0x7f, 0x3e, 0x99, 0x58,
+	0xb3, 0x14, 0xfe, 0x9d, 0x79, 0x39, 0xff, 0xce, 0x26, 0xf8, 0xb7, 0xaa, 0x26, 0x24, 0xf6, 0xaf,
+	0xaa, 0x09, 0x71, 0xea, 0x91, 0x5e, 0x41, 0xb6, 0x64, 0xd5, 0x38, 0x78, 0x40, 0x16, 0xb3, 0xc4,
+	0x6e, 0x53, 0x48, 0xd9, 0x6d, 0xe4, 0xbd, 0x62, 0x29, 0xb6, 0x57, 0xbc, 0x06, 0x34, 0xc7, 0xc5,
+	0x64, 0x2d, 0x92, 0xaa, 0x81, 0xc1, 0xf8, 0x6c, 0x7d, 0x03, 0x56, 0xe2, 0xec, 0x99, 0xf6, 0x81,
+	0xe5, 0x14, 0xe6, 0x8c, 0x76, 0x33, 0x94, 0x78, 0x24, 0xe2, 0x32, 0x67, 0xce, 0xce, 0xc4, 0x3e,
+	0x60, 0x18, 0x41, 0xdf, 0x01, 0xa0, 0xb2, 0xd9, 0x1a, 0x07, 0xb6, 0xc6, 0xbf, 0x16, 0x9b, 0xb6,
+	0x52, 0xaf, 0x6f, 0xe3, 0xc7, 0x7c, 0x66, 0xb3, 0x45, 0x5f, 0x66, 0x29, 0xf1, 0xa7, 0xf6, 0x11,
+	0x2c, 0x7b, 0x53, 0xdb, 0x35, 0x23, 0x16, 0x59, 0x61, 0x59, 0xd5, 0x79, 0x56, 0x5d, 0x01, 0x37,
+	0x6a, 0x48, 0x17, 0x7e, 0x6a, 0x9f, 0x50, 0x27, 0xdb, 0x52, 0xca, 0xea, 0x82, 0x94, 0xcb, 0x8c,
+	0x30, 0x4a, 0xfa, 0x1e, 0x53, 0xb1, 0x79, 0xe3, 0x39, 0x19, 0xbf, 0x6a, 0x6c, 0x1e, 0x09, 0x6b,
+	0x80, 0x11, 0x62, 0x0c, 0x99, 0x4a, 0xff, 0xcd, 0x0c, 0x54, 0xa4, 0x36, 0x68, 0x1b, 0xb0, 0xda,
+	0xee, 0xf5, 0x8e, 0x3b, 0x46, 0x6b, 0xd0, 0xfd, 0xac, 0x63, 0xb6, 0x0f, 0x7a, 0xfd, 0x4e, 0xfd,
+	0x06, 0x82, 0x0f, 0x7a, 0xed, 0xd6, 0x81, 0xb9, 0xd7, 0x33, 0xda, 0x02, 0x9c, 0xd1, 0x36, 0x41,
+	0x33, 0x3a, 0x87, 0xbd, 0x41, 0x47, 0x81, 0x67, 0xb5, 0x3a, 0x54, 0x77, 0x8c, 0x4e, 0xab, 0xbd,
+	0xcf, 0x21, 0x39, 0x6d, 0x1d, 0xea, 0x7b, 0x27, 0x47, 0xbb, 0xdd, 0xa3, 0x47, 0x66, 0xbb, 0x75,
+	0xd4, 0xee, 0x1c, 0x74, 0x76, 0xeb, 0x79, 0xad, 0x06, 0xe5, 0xd6, 0x4e, 0xeb, 0x68, 0xb7, 0x77,
+	0xd4, 0xd9, 0xad, 0x17, 0xb4, 0x06, 0xac, 0x0b, 0xa2, 0xdd, 0xde, 0xc9, 0xce, 0x41, 0xc7, 0xec,
+	0x1f, 0x77, 0x8e, 0x06, 0xf5, 0x25, 0xfd, 0x57, 0xb2, 0x00, 0x51, 0x13, 0x90, 0x1d, 0x47, 0x8d,
+	0x90, 0x0d, 0xd4, 0x1b, 0x89, 0xe6, 0x12, 0x3b, 0x9e, 0x29, 0xdf, 0xda, 0x43, 0x28, 0x7a, 0xf3,
+	0x60, 0xe8, 0x4d, 0xe8, 0xec, 0xb1, 0xfc, 0xb0, 0x91, 0x48, 0xd7, 0x23, 0xbc, 0x21, 0x08, 0x15,
+	0x23, 0x74, 0xee, 0x2a, 0x23, 0xb4, 0x6a, 0xed, 0x26, 0x71, 0x50, 0xb2, 0x76, 0xdf, 0x01, 0xf0,
+	0x9f, 0xdb, 0xf6, 0x94, 0x29, 0xe8, 0xf8, 0xfa, 0x28, 0x33, 0x08, 0xea, 0xf9, 0xae, 0x30, 0xf6,
+	0xea, 0xbf, 0x9a, 0x85, 0x0d, 0x36, 0x09, 0x47, 0x71, 0xee, 0x77, 0x17, 0x2a, 0x43, 0xcf, 0x9b,
+	0xda, 0x33, 0x4b, 0x92, 0x02, 0x65, 0x10, 0x72, 0x36, 0x62, 0xf3, 0x67, 0xde, 0x6c, 0x68, 0x73,
+	0xe6, 0x07, 0x0c, 0xb4, 0x87, 0x10, 0x5c, 0x7c, 0x7c, 0xf5, 0x12, 0x05, 0xf1, 0xbe, 0x0a, 0xc1,
+	0x88, 0x64, 0x13, 0x96, 0x4e, 0x67, 0xb6, 0x35, 0xbc, 0xe0, 0x6c, 0x8f, 0x7f, 0xa1, 0xae, 0x58,
+	0x28, 0x1e, 0x87, 0xb8, 0x98, 0xc6, 0x36, 0xb5, 0xad, 0x64, 0xac, 0x70, 0x78, 0x9b, 0x83, 0x51,
+	0x7a, 0xb0, 0x4e, 0x2d, 0x77, 0xe4, 0xb9, 0xf6, 0x88, 0x6b, 0x15, 0x22, 0x80, 0xf6, 0x00, 0xd6,
+	0x45, 0x46, 0x23, 0x6f, 0x7e, 0x3a, 0xb6, 0x4d, 0x76, 0xda, 0x66, 0x8c, 0xa0, 0x64, 0x08, 0xe5,
+	0xea, 0x2e, 0x43, 0xf5, 0x11, 0xa3, 0x1f, 0xc3, 0x66, 0xbc, 0x47, 0x38, 0x6b, 0xfd, 0x50, 0x62,
+	0xad, 0x74, 0x38, 0x6f, 0x2e, 0x5e, 0xce, 0x12, 0x9b, 0x3d, 0x81, 0x9b, 0x32, 0x81, 0x61, 0x4f,
+	0xbd, 0x59, 0xa8, 0x59, 0xf8, 0x38, 0x8d, 0xd7, 0x56, 0x1e, 0xae, 0xa9, 0x39, 0xd3, 0xa4, 0x50,
+	0x18, 0xb0, 0xfe, 0x77, 0x32, 0xb0, 0xca, 0x32, 0xec, 0x31, 0xc5, 0x29, 0x65, 0x9b, 0x50, 0xae,
+	0x66, 0x12, 0xca, 0xd5, 0xd8, 0x8c, 0xca, 0xbe, 0xd4, 0x7f, 0x22, 0x17, 0xf3, 0x9f, 0x88, 0x71,
+	0x86, 0xfc, 0xb5, 0x38, 0xc3, 0xef, 0x67, 0xa0, 0xd2, 0xc7, 0x29, 0xc9, 0xeb, 0x98, 0xa2, 0x77,
+	0x91, 0xf5, 0x99, 0x59, 0x45, 0x9f, 0x79, 0x8b, 0xec, 0xb8, 0x4f, 0x5d, 0xb4, 0x17, 0xd0, 0x14,
+	0x42, 0xe9, 0xfa, 0x31, 0x7e, 0xd3, 0x06, 0x31, 0xf4, 0x9e, 0xd9, 0x33, 0x7b, 0x14, 0xae, 0x8f,
+	0x9c, 0x51, 0x0d, 0x81, 0x7d, 0xb2, 0x59, 0xff, 0x68, 0x3e, 0x11, 0xfa, 0x9f, 0xcd, 0x41, 0x33,
+	0x6d, 0x08, 0xf9, 0xc4, 0x78, 0x1f, 0x8a, 0x3e, 0x8d, 0x3a, 0x1f, 0xbd, 0x97, 0xcd, 0x0b, 0x41,
+	0xca, 0x39, 0xc7, 0x74, 0x1e, 0xa0, 0xd2, 0x08, 0xbb, 0x51, 0x70, 0x8e, 0xc4, 0xa0, 0x1a, 0x82,
+	0x50, 0xdb, 0x83, 0x8d, 0xb9, 0x3b, 0xb1, 0x82, 0xe1, 0x85, 0x3d, 0x32, 0xe5, 0x81, 0xc8, 0x2d,
+	0x1a, 0x88, 0xf5, 0x90, 0x3e, 0x02, 0xfa, 0xf2, 0x06, 0x2c, 0x7a, 0x3d, 0xcf, 0x7d, 0x5b, 0x08,
+	0xbc, 0x47, 0x9d, 0x7f, 0x1f, 0x96, 0x18, 0x2f, 0xf1, 0x1b, 0x05, 0xc5, 0x01, 0x42, 0x1a, 0x4d,
+	0x83, 0x53, 0x44, 0x87, 0x17, 0x75, 0x44, 0x96, 0xa4, 0xc3, 0x8b, 0x21, 0x0f, 0xcb, 0x3b, 0xb0,
+	0x4e, 0xf4, 0xc4, 0xbf, 0xd0, 0xdd, 0xc4, 0x14, 0x86, 0x9a, 0xf0, 0xb4, 0x83, 0x28, 0xf4, 0x3b,
+	0xe9, 0x5b, 0x81, 0xfe, 0x6f, 0xf2, 0x90, 0x47, 0x05, 0xc6, 0x42, 0x5d, 0x87, 0xac, 0xab, 0xca,
+	0x25, 0x7c, 0x86, 0x98, 0xa1, 0x82, 0x0e, 0x54, 0x9c, 0x8b, 0x32, 0x08, 0x3b, 0x48, 0x85, 0xe8,
+	0x99, 0x3d, 0x7c, 0x26, 0x74, 0x10, 0x0c, 0x62, 0xd8, 0xc3, 0x67, 0x4c, 0x71, 0x69, 0x05, 0x94,
+	0x96, 0xda, 0x53, 0xf4, 0xad, 0x80, 0xa5, 0xe4, 0x28, 0x96, 0xae, 0x18, 0xa2, 0x58, 0xaa, 0x06,
+	0x14, 0x1d, 0xf7, 0xd4, 0x9b, 0xbb, 0x42, 0x4d, 0x2c, 0x3e, 0xd9, 0x12, 0x63, 0xc2, 0x8f, 0x33,
+	0x11, 0x02, 0x44, 0x09, 0x01, 0x03, 0x14, 0xd6, 0xdf, 0x85, 0xb2, 0x7f, 0xe9, 0x0e, 0x65, 0xb1,
+	0x61, 0x9d, 0x77, 0x3b, 0xb6, 0x7e, 0xbb, 0x7f, 0xe9, 0x0e, 0xd9, 0x56, 0x54, 0xf2, 0xf9, 0x2f,
+	0xed, 0x03, 0x28, 0x85, 0xa6, 0x7b, 0x12, 0xfa, 0x6e, 0xca, 0x29, 0x84, 0xbd, 0x9e, 0x74, 0xee,
+	0x21, 0xa9, 0xf6, 0x0e, 0x2c, 0x31, 0xd3, 0x1c, 0x1a, 0x1c, 0x73, 0x92, 0x02, 0x0b, 0xab, 0xc1,
+	0x56, 0x82, 0x3d, 0x62, 0x86, 0x74, 0x83, 0x93, 0x61, 0x37, 0x9d, 0x8d, 0xad, 0x29, 0x37, 0x94,
+	0xd5, 0xc8, 0xc9, 0x06, 0x21, 0x64, 0x25, 0xbb, 0x0b, 0x55, 0xe6, 0x16, 0xc1, 0x68, 0x5c, 0x3a,
+	0x57, 0xe6, 0x0c, 0x40, 0xd8, 0xde, 0xd8, 0x9a, 0x1e, 0xf9, 0xcd, 0xc7, 0x50, 0x53, 0x2a, 0x23,
+	0xab, 0xc4, 0x6b, 0xa4, 0x12, 0x7f, 0x5d, 0x56, 0x89, 0x47, 0xd2, 0x2b, 0x4f, 0x26, 0xab, 0xc8,
+	0x8f, 0xa1, 0x24, 0xfa, 0x02, 0xc5, 0x84, 0x93, 0xa3, 0xc7, 0x47, 0xbd, 0xcf, 0x8f, 0xcc, 0xfe,
+	0x93, 0xa3, 0x76, 0xfd, 0x86, 0xb6, 0x02, 0x95, 0x56, 0x9b, 0x49, 0x1e, 0x0c, 0x90, 0x41, 0x92,
+	0xe3, 0x56, 0xbf, 0x1f, 0x42, 0xb2, 0x48, 0x72, 0xdc, 0x3d, 0x3a, 0xea, 0xec, 0x12, 0x20, 0xa7,
+	0xef, 0x41, 0x3d, 0xde, 0x76, 0xdc, 0x5f, 0x02, 0x01, 0xe3, 0xce, 0x0a, 0x11, 0x20, 0x32, 0x75,
+	0x66, 0x25, 0x53, 0xa7, 0xfe, 0x01, 0x9a, 0xaf, 0x7c, 0xa6, 0x6d, 0x93, 0x7d, 0x98, 0xc6, 0x56,
+	0x60, 0xfb, 0xb2, 0xc3, 0x42, 0xc9, 0xa8, 0x10, 0x8c, 0x15, 0xa5, 0x7f, 0x08, 0xab, 0x52, 0xb2,
+	0x48, 0x1f, 0x8c, 0x02, 0x7f, 0x5c, 0x1f, 0x8c, 0x44, 0x06, 0x61, 0xf4, 0x2d, 0xd8, 0xc0, 0xcf,
+	0xce, 0x33, 0xdb, 0x0d, 0xfa, 0xf3, 0x53, 0x62, 0xdd, 0x8e, 0xe7, 0xea, 0xbf, 0x98, 0x81, 0x72,
+	0x88, 0x59, 0xbc, 0x6c, 0xb6, 0xb9, 0xea, 0x98, 0x04, 0x98, 0xa6, 0x54, 0x02, 0x4b, 0xb8, 0xcd,
+	0xfe, 0x46, 0x2a, 0x64, 0x7d, 0x1b, 0xca, 0x21, 0x88, 0x75, 0x62, 0xa7, 0x63, 0x98, 0xbd, 0xa3,
+	0x83, 0xee, 0x11, 0x0a, 0x78, 0xd8, 0xcf, 0x0c, 0xb0, 0xb7, 0xc7, 0x20, 0x19, 0xbd, 0x0e, 0xcb,
+	0x8f, 0xec, 0xa0, 0xeb, 0x9e, 0x79, 0xbc, 0x33, 0xf4, 0x5f, 0x5a, 0x82, 0x95, 0x10, 0x14, 0x29,
+	0x9a, 0x9f, 0xd9, 0x33, 0xdf, 0xf1, 0x5c, 0x36, 0x71, 0xca, 0x86, 0xf8, 0x44, 0x49, 0x83, 0xab,
+	0x61, 0xd8, 0x51, 0x61, 0x9d, 0x61, 0xb9, 0xe2, 0x86, 0x9d, 0x13, 0xde, 0x80, 0x15, 0x67, 0x64,
+	0xbb, 0x81, 0x13, 0x5c, 0x9a, 0x8a, 0x8d, 0x70, 0x59, 0x80, 0xf9, 0x59, 0x61, 0x1d, 0x0a, 0xd6,
+	0xd8, 0xb1, 0x84, 0x4b, 0x21, 0x7d, 0x20, 0x74, 0xe8, 0x8d, 0xbd, 0x19, 0x53, 0x4c, 0x94, 0x0d,
+	0xfa, 0x40, 0xd1, 0x01, 0x95, 0x24, 0xb2, 0xc5, 0x9b, 0x6d, 0xfd, 0x64, 0xae, 0xd4, 0xdc, 0xf9,
+	0xe4, 0x38, 0xb2, 0x7a, 0x23, 0x06, 0x39, 0x20, 0xa6, 0xe0, 0x47, 0xc2, 0x30, 0x01, 0x29, 0x3e,
+	0xd1, 0xc7, 0xaf, 0xc5, 0x30, 0x21, 0xfd, 0x43, 0xd8, 0x40, 0x7a, 0xc7, 0x8d, 0xa7, 0x58, 0x61,
+	0x29, 0x30, 0xb3, 0xae, 0x6b, 0xa9, 0x69, 0x6e, 0x41, 0x99, 0x6a, 0x85, 0x53, 0x82, 0x9b, 0xc6,
+	0x59, 0x55, 0xec, 0x99, 0x9f, 0xd8, 0xe9, 0x48, 0xd3, 0x17, 0xdf, 0xe9, 0x24, 0xff, 0xc1, 0x52,
+	0xdc, 0x7f, 0xf0, 0x21, 0x6c, 0x9c, 0xe2, 0x1c, 0xbd, 0xb0, 0xad, 0x91, 0x3d, 0x33, 0xa3, 0x99,
+	0x4f, 0xfa, 0xa4, 0x35, 0x44, 0xee, 0x33, 0x5c, 0xb8, 0x50, 0x70, 0x33, 0x41, 0x4e, 0x64, 0x8f,
+	0xcc, 0xc0, 0x33, 0xd9, 0x21, 0x8f, 0x9b, 0x61, 0x6a, 0x04, 0x1e, 0x78, 0x6d, 0x04, 0xaa, 0x74,
+	0xe7, 0x33, 0x6b, 0x7a, 0xd1, 0xd0, 0x54, 0xba, 0x47, 0x08, 0xd4, 0x6e, 0x43, 0x11, 0xd7, 0x84,
+	0x6b, 0x93, 0xcb, 0x14, 0xe9, 0x51, 0x04, 0x48, 0x7b, 0x1d, 0x96, 0x58, 0x19, 0x7e, 0xa3, 0x7e,
+	0x37, 0x27, 0xb9, 0xb9, 0xb0, 0x32, 0x0c, 0x8e, 0x43, 0x11, 0x63, 0x3e, 0x73, 0x88, 0xb1, 0x95,
+	0x0d, 0xf6, 0x5b, 0xfb, 0x96, 0xc4, 0x25, 0xd7, 0x58, 0xda, 0xd7, 0x79, 0xda, 0xd8, 0x54, 0x5c,
+	0xc4, 0x30, 0xbf, 0x54, 0xf6, 0xf5, 0xed, 0x7c, 0xa9, 0x52, 0xaf, 0xa2, 0x7a, 0xfe, 0x91, 0x1d,
+	0xf0, 0x8d, 0xf1, 0x52, 0x59, 0x23, 0x19, 0xd8, 0x4a, 0xa0, 0x22, 0xf7, 0x27, 0xbe, 0xcb, 0x5e,
+	0x9a, 0x13, 0x6f, 0x24, 0xe4, 0x73, 0x21, 0xf7, 0x5c, 0x1e, 0x7a, 0x23, 0x3c, 0x66, 0xac, 0x86,
+	0x44, 0x67, 0x8e, 0xeb, 0xf8, 0x17, 0xf6, 0x88, 0x8b, 0xe9, 0x75, 0x81, 0xd8, 0xe3, 0x70, 0x3c,
+	0x45, 0x4f, 0x67, 0xde, 0x79, 0xb8, 0x77, 0x66, 0x8c, 0xf0, 0x5b, 0xff, 0x08, 0x0a, 0x34, 0x82,
+	0xb8, 0x50, 0xf0, 0x07, 0x5f, 0x5d, 0xf4, 0x81, 0x0b, 0xd7, 0xb5, 0x83, 0xe7, 0xde, 0xec, 0xa9,
+	0xb0, 0x28, 0xf1, 0x4f, 0xfd, 0x07, 0xcc, 0x6a, 0x12, 0x7a, 0xaf, 0x92, 0x76, 0x11, 0xa7, 0x30,
+	0x4d, 0x41, 0xff, 0xc2, 0xe2, 0x86, 0x9c, 0x12, 0x03, 0xf4, 0x2f, 0xac, 0xc4, 0x14, 0xce, 0x26,
+	0x85, 0xb5, 0xd7, 0x61, 0x59, 0xf8, 0xcb, 0xfa, 0xe6, 0xd8, 0x3e, 0x0b, 0xf8, 0x92, 0xac, 0x72,
+	0x67, 0x59, 0xff, 0xc0, 0x3e, 0x0b, 0xf4, 0x43, 0x58, 0xe5, 0x8b, 0xa6, 0x37, 0xb5, 0x45, 0xd1,
+	0x3f, 0xbc, 0xb4, 0xfd, 0x9d, 0xc8, 0x44, 0x80, 0xe2, 0x19, 0xcf, 0x8f, 0xeb, 0x17, 0x84, 0x83,
+	0x84, 0xf0, 0xe4, 0x0a, 0xb5, 0x18, 0xce, 0x08, 0x7b, 0xc7, 0x9f, 0x0f, 0x87, 0xc2, 0x8f, 0xb9,
+	0x64, 0x88, 0x4f, 0xfd, 0x9f, 0x67, 0x61, 0x8d, 0x65, 0xd6, 0x16, 0x9e, 0x46, 0x3f, 0xe2, 0x91,
+	0x00, 0xc7, 0x47, 0x3e, 0x8c, 0xd1, 0xc7, 0xd5, 0x16, 0xde, 0xb8, 0xf5, 0x36, 0x9f, 0x6a, 0xbd,
+	0xfd, 0x3a, 0xd4, 0x47, 0xf6, 0xd8, 0x61, 0xd3, 0x49, 0x48, 0x59, 0x74, 0xde, 0x5c, 0x11, 0x70,
+	0xa1, 0x4a, 0x4c, 0x98, 0x8c, 0x97, 0x92, 0x26, 0xe3, 0xaf, 0x03, 0x1a, 0x35, 0x4c, 0xa1, 0x3a,
+	0x27, 0x3a, 0x32, 0xd2, 0xa0, 0x47, 0xce, 0x9e, 0x6d, 0x2b, 0xa4, 0x8e, 0x1b, 0x23, 0xe5, 0x5e,
+	0x5a, 0x13, 0xc7, 0x95, 0x48, 0xf5, 0xbf, 0x22, 0x0e, 0x45, 0xa4, 0x16, 0xe6, 0xc3, 0xf4, 0xa9,
+	0xd0, 0x7f, 0x72, 0x66, 0xce, 0x7b, 0x34, 0x12, 0xab, 0x18, 0x94, 0x88, 0xf7, 0x6f, 0x70, 0xbd,
+	0x28, 0x87, 0x6a, 0xdf, 0x60, 0xba, 0x2c, 0xd7, 0x64, 0x40, 0x7e, 0x5e, 0xbf, 0x99, 0x22, 0xe0,
+	0x87, 0xc9, 0x51, 0xd1, 0xe5, 0x32, 0xd0, 0x4e, 0x09, 0x15, 0xb2, 0x08, 0xd6, 0xf7, 0xa0, 0xa6,
+	0x14, 0xa3, 0x1c, 0x82, 0xaa, 0xfc, 0x10, 0x14, 0x3f, 0xbc, 0x65, 0x93, 0x9e, 0x31, 0x97, 0xb0,
+	0x66, 0xd8, 0xd6, 0xe8, 0x72, 0xcf, 0x9b, 0x1d, 0xfb, 0xa7, 0xc1, 0x1e, 0x1d, 0x60, 0x71, 0x07,
+	0x0c, 0x1d, 0xea, 0x14, 0x6b, 0xad, 0xf0, 0xfa, 0x11, 0x43, 0xf3, 0x55, 0x58, 0x0e, 0x09, 0x65,
+	0xbb, 0x5e, 0x4d, 0xd0, 0x31, 0x20, 0x53, 0x39, 0xfa, 0xa7, 0x01, 0xb7, 0xec, 0xb1, 0xdf, 0xfa,
+	0x2f, 0x2c, 0x81, 0x86, 0x6b, 0x29, 0x36, 0x5d, 0x13, 0x83, 0x9d, 0x49, 0x0e, 0x76, 0xcc, 0xaf,
+	0x30, 0x9b, 0xf0, 0x2b, 0x7c, 0x00, 0x9a, 0x44, 0x20, 0xdc, 0x1d, 0x73, 0xa1, 0xbb, 0x63, 0x3d,
+	0xa2, 0xe5, 0xde, 0x8e, 0x0f, 0x60, 0x9d, 0xab, 0x1f, 0xd4, 0xe6, 0xd0, 0x39, 0x46, 0x63, 0xb8,
+	0x3d, 0xa5, 0x4d, 0xc2, 0xa7, 0x50, 0x18, 0xcb, 0x72, 0xe4, 0x53, 0x28, 0x74, 0xda, 0xd2, 0x12,
+	0x59, 0xba, 0x72, 0x89, 0x14, 0x53, 0x97, 0x88, 0x64, 0xe3, 0x28, 0xa9, 0x36, 0x8e, 0x84, 0xb5,
+	0x8e, 0xa4, 0x7e, 0xc5, 0x5a, 0x77, 0x0f, 0xea, 0x42, 0xdf, 0x1d, 0x5a, 0x52, 0xb8, 0x13, 0x19,
+	0xc1, 0xdb, 0xc2, 0x96, 0xa2, 0xb8, 0x23, 0x54, 0xae, 0xe3, 0x17, 0x51, 0x4d, 0xf7, 0x8b, 0x48,
+	0x5a, 0x06, 0x6a, 0x29, 0x96, 0x81, 0x0f, 0x22, 0x37, 0x30, 0xff, 0xc2, 0x99, 0x30, 0xf1, 0x2c,
+	0x3a, 0x0b, 0xf2, 0x4e, 0xee, 0x5f, 0x38, 0x13, 0xa3, 0x72, 0x16, 0x7d, 0x68, 0x6d, 0x78, 0x95,
+	0xb7, 0x27, 0xc5, 0x21, 0x93, 0x7a, 0x61, 0x85, 0x4d, 0x95, 0x26, 0x91, 0x1d, 0xc6, 0x7c, 0x33,
+	0x63, 0x9d, 0x22, 0xdc, 0xf4, 0xfc, 0x46, 0x5d, 0xee, 0x94, 0x43, 0xf2, 0xd3, 0x63, 0x4c, 0x07,
+	0x49, 0xb8, 0xe9, 0xc1, 0x7f, 0xc6, 0xa4, 0xb9, 0x9a, 0x51, 0x99, 0x58, 0x2f, 0x0e, 0x10, 0xd6,
+	0xf6, 0x9f, 0x61, 0x73, 0xfd, 0xf9, 0x69, 0x30, 0xb3, 0x86, 0x01, 0xdd, 0x6f, 0x20, 0x01, 0xa4,
+	0x2a, 0x80, 0xec, 0x8a, 0x83, 0x62, 0x2d, 0x58, 0x8b, 0x59, 0x0b, 0xfe, 0x6e, 0x06, 0xb6, 0x76,
+	0xf0, 0x40, 0x9d, 0xb2, 0x12, 0xde, 0x4b, 0x28, 0x88, 0xc4, 0x89, 0x2a, 0x91, 0x22, 0x24, 0xbc,
+	0xd2, 0x85, 0x2c, 0xdd, 0x37, 0x2b, 0xa7, 0xae, 0xaf, 0xd0, 0x7d, 0x26, 0x2f, 0x5f, 0xa8, 0xf8,
+	0xbd, 0x2c, 0xd4, 0xe3, 0x25, 0x5f, 0xed, 0xe2, 0xbb, 0x68, 0x61, 0x65, 0xaf, 0xb5, 0xb0, 0x72,
+	0xea, 0xc2, 0x92, 0x56, 0x44, 0xfe, 0x8a, 0x15, 0x51, 0xb8, 0xde, 0x8a, 0x58, 0x4a, 0x5d, 0x11,
+	0x89, 0x79, 0x5c, 0x5c, 0x60, 0xe1, 0x8a, 0xf9, 0xaf, 0x96, 0x52, 0xfc, 0x57, 0xf5, 0xff, 0x07,
+	0x1a, 0xc9, 0x21, 0xe6, 0x92, 0xd7, 0x4f, 0x41, 0x3d, 0x71, 0x22, 0xa0, 0xb1, 0x4e, 0xdd, 0x4d,
+	0x8c, 0x95, 0xa9, 0x7a, 0x48, 0xd0, 0xff, 0x5b, 0x06, 0xea, 0x98, 0xb1, 0xb2, 0x41, 0x7d, 0x02,
+	0x6c, 0x23, 0xbf, 0xe6, 0xfe, 0x54, 0x41, 0x5a, 0x0e, 0xd4, 0x3e, 0x02, 0xb6, 0xdf, 0x98, 0xa8,
+	0xfc, 0xe7, 0xbb, 0x53, 0x43, 0xdd, 0x9d, 0x22, 0xf9, 0x67, 0xff, 0x06, 0x4d, 0x3c, 0x84, 0x68,
+	0x9f, 0x40, 0x19, 0xd9, 0x3a, 0x1b, 0x66, 0x7e, 0x17, 0xaa, 0x19, 0xea, 0x8f, 0x12, 0x3b, 0x0c,
+	0x26, 0x9d, 0xf2, 0xcf, 0xb4, 0x8e, 0xcc, 0xa7, 0x74, 0xa4, 0xb4, 0xfd, 0xed, 0x03, 0x3c, 0xb6,
+	0x2f, 0x71, 0x1d, 0xa2, 0x7d, 0xe1, 0x0e, 0x00, 0x72, 0xf9, 0x33, 0x6b, 0xe2, 0x70, 0xcb, 0x5a,
+	0xc1, 0x28, 0x3f, 0xb5, 0x2f, 0xf7, 0x18, 0x00, 0x17, 0x20, 0xa2, 0xa3, 0x3d, 0xb0, 0x60, 0x94,
+	0x9e, 0xda, 0x97, 0xb4, 0x01, 0x9a, 0x50, 0x7b, 0x6c, 0x5f, 0xee, 0xda, 0x74, 0xca, 0xf5, 0x66,
+	0x38, 0x91, 0xf0, 0xaa, 0x11, 0xa6, 0x90, 0x7d, 0x51, 0x2b, 0x33, 0xeb, 0xf9, 0x63, 0xfb, 0x52,
+	0xf8, 0xc5, 0x16, 0x11, 0x3f, 0xf6, 0x86, 0x5c, 0x2e, 0x17, 0x9a, 0xb2, 0xa8, 0x52, 0xc6, 0xd2,
+	0x53, 0xf6, 0x5b, 0xff, 0xe3, 0x0c, 0xd4, 0xb0, 0xfe, 0x4c, 0xa4, 0x62, 0x8c, 0x8c, 0xdf, 0x90,
+	0xc9, 0x44, 0x37, 0x64, 0x1e, 0x72, 0x99, 0x80, 0xe4, 0xb3, 0xec, 0x62, 0xf9, 0x8c, 0x8d, 0x0d,
+	0xfb, 0x89, 0x7a, 0x1d, 0x5a, 0x57, 0xb8, 0xec, 0x72, 0xca, 0x00, 0x2b, 0x0d, 0x32, 0x4a, 0x8c,
+	0xec, 0x31, 0x79, 0xdb, 0x4b, 0x46, 0x65, 0xea, 0xe2, 0xf2, 0x2c, 0x34, 0x25, 0xa7, 0x0c, 0x43,
+	0x61, 0x81, 0xb7, 0xbd, 0x6c, 0xb1, 0x5d, 0x8a, 0x5b, 0x6c, 0x75, 0x17, 0x4a, 0x38, 0xd4, 0xac,
+	0xb1, 0x29, 0x99, 0x66, 0xd2, 0x32, 0x45, 0x29, 0xde, 0x42, 0x91, 0xca, 0x3f, 0xa5, 0x1e, 0x40,
+	0x29, 0xde, 0xf2, 0x6d, 0xcc, 0x08, 0x2b, 0xee, 0x7a, 0x26, 0xb3, 0x72, 0x72, 0xfb, 0x5f, 0xc9,
+	0x28, 0xbb, 0xde, 0x31, 0x01, 0xf4, 0x3f, 0x97, 0x81, 0x8a, 0xb4, 0x6d, 0x30, 0x9b, 0x78, 0xd8,
+	0x9d, 0xb4, 0xc7, 0xa8, 0x2b, 0x40, 0x19, 0x8f, 0xfd, 0x1b, 0x46, 0x6d, 0xa8, 0x0c, 0xd0, 0x36,
+	0x9f, 0xca, 0x2c, 0x65, 0x56, 0xb1, 0xa8, 0x88, 0x76, 0x89, 0xf9, 0x8b, 0xbf, 0x77, 0x96, 0x20,
+	0x8f, 0xa4, 0xfa, 0xa7, 0xb0, 0x2a, 0x55, 0x83, 0x4c, 0x0a, 0xd7, 0xed, 0x00, 0xfd, 0xcf, 0x64,
+	0xc2, 0xd4, 0x58, 0x08, 0x79, 0x99, 0x89, 0x9b, 0x0d, 0xf6, 0x88, 0x3a, 0x86, 0x52, 0x02, 0x81,
+	0x58, 0xd7, 0x5c, 0xd7, 0x89, 0x1e, 0xb7, 0xaa, 0xa7, 0xce, 0xd4, 0x64, 0xd7, 0x9a, 0x9c, 0x1f,
+	0x08, 0xf3, 0x49, 0x15, 0x81, 0x7b, 0x1c, 0xa6, 0xff, 0x7c, 0x06, 0xd6, 0xa4, 0x3a, 0x08, 0x38,
+	0xd6, 0x02, 0x5d, 0xe0, 0x62, 0xb5, 0x20, 0xd0, 0x17, 0xaa, 0xc5, 0x5d, 0xa8, 0xb2, 0x0a, 0x98,
+	0x74, 0x9b, 0x8f, 0xcb, 0x83, 0xc0, 0x60, 0x06, 0x5e, 0xe7, 0xd3, 0xff, 0x6a, 0x16, 0xd6, 0x79,
+	0x15, 0xd8, 0xb5, 0x38, 0x07, 0x4f, 0x7a, 0x87, 0xfe, 0xb9, 0xf6, 0x09, 0xd4, 0xb0, 0x93, 0xcd,
+	0x99, 0x7d, 0xee, 0xf8, 0x81, 0x2d, 0xbc, 0xe4, 0x52, 0xc4, 0x06, 0x14, 0xb9, 0x91, 0xd4, 0xe0,
+	0x94, 0xda, 0xa7, 0x50, 0x61, 0x49, 0xc9, 0xf6, 0xd3, 0xc8, 0x2a, 0x5c, 0x2d, 0x31, 0x62, 0xfb,
+	0x37, 0x0c, 0xf0, 0xc3, 0x2f, 0x4c, 0xcc, 0x26, 0xc3, 0x33, 0x36, 0x20, 0x8d, 0x5c, 0x5a, 0xe2,
+	0x68, 0xc0, 0x30, 0xf1, 0x34, 0xfc, 0xd2, 0x5a, 0x50, 0x23, 0xa6, 0x28, 0x7a, 0x3d, 0xaf, 0x30,
+	0xc6, 0x94, 0xbe, 0xc6, 0xca, 0x4f, 0xa5, 0xef, 0x9d, 0x32, 0x14, 0x83, 0x99, 0x73, 0x7e, 0x6e,
+	0xcf, 0xf4, 0xcd, 0xb0, 0x6b, 0x90, 0xdb, 0xdb, 0xfd, 0x00, 0x95, 0xe6, 0xfe, 0x54, 0xff, 0x27,
+	0x19, 0xa8, 0x70, 0xfe, 0xfd, 0x43, 0x3b, 0xe0, 0x35, 0x63, 0x46, 0xc4, 0xb2, 0x64, 0x33, 0x7c,
+	0x03, 0x56, 0x26, 0xa8, 0x6f, 0x40, 0x7d, 0x98, 0xe2, 0x7d, 0xb7, 0x2c, 0xc0, 0xfc, 0x28, 0xbd,
+	0x0d, 0x6b, 0xec, 0x64, 0xed, 0x9b, 0x81, 0x33, 0x36, 0x05, 0x92, 0x5b, 0x48, 0x56, 0x09, 0x35,
+	0x70, 0xc6, 0x87, 0x1c, 0x81, 0x72, 0x86, 0x1f, 0xe0, 0x35, 0x2a, 0xe2, 0x21, 0xf4, 0x81, 0x3a,
+	0x8c, 0x98, 0x2a, 0x4c, 0xe8, 0x30, 0x7e, 0x7d, 0x13, 0xb6, 0x12, 0x28, 0xbe, 0x93, 0x86, 0xf6,
+	0x82, 0xb1, 0x33, 0x39, 0xf5, 0x42, 0x7b, 0x7a, 0x46, 0x52, 0xff, 0x1f, 0x20, 0x46, 0xd8, 0xd3,
+	0x6d, 0xd8, 0x10, 0x53, 0x96, 0x19, 0xc4, 0xc3, 0xed, 0x97, 0xac, 0x27, 0xef, 0xaa, 0x9b, 0x65,
+	0xbc, 0x38, 0x01, 0x97, 0xf7, 0xf4, 0xb5, 0x69, 0x02, 0xe6, 0x6b, 0x3f, 0x0b, 0x8d, 0x70, 0x65,
+	0xf0, 0xa3, 0xbd, 0xa4, 0xfa, 0xc3, 0x92, 0xde, 0xba, 0xa2, 0x24, 0xc5, 0x7c, 0xc8, 0xce, 0x08,
+	0x9b, 0x62, 0x51, 0x51, 0x86, 0x61, 0x59, 0xcf, 0xe0, 0x15, 0x51, 0x16, 0x3b, 0xaa, 0x27, 0x4b,
+	0xcc, 0x5f, 0xab, 0x6d, 0xcc, 0x98, 0xaa, 0x14, 0x6b, 0xdc, 0xe2, 0x19, 0x87, 0x28, 0xb9, 0xdc,
+	0x0b, 0xd8, 0x7c, 0x6e, 0x39, 0x81, 0x68, 0xa3, 0xa4, 0x79, 0x24, 0x33, 0xcf, 0xc3, 0x2b, 0xca,
+	0xfb, 0x9c, 0x12, 0x2b, 0xca, 0x8b, 0xf5, 0xe7, 0x49, 0xa0, 0xaf, 0x0d, 0x61, 0x83, 0x39, 0x3f,
+	0x5a, 0x81, 0x39, 0x73, 0xfc, 0xa7, 0x51, 0x41, 0x4b, 0xac, 0xa0, 0x07, 0x57, 0x14, 0xb4, 0x3b,
+	0xf7, 0x83, 0x56, 0x60, 0x38, 0xfe, 0x53, 0x51, 0x8c, 0x36, 0x8a, 0x83, 0xfc, 0xe6, 0xdf, 0xcc,
+	0xc1, 0xb2, 0x9a, 0x03, 0xf2, 0x37, 0xbe, 0x73, 0x0a, 0x61, 0x97, 0x9f, 0x87, 0xb9, 0x43, 0xc9,
+	0x11, 0xc9, 0xbb, 0x49, 0x57, 0x97, 0x6c, 0x8a, 0xab, 0x8b, 0xec, 0x61, 0x92, 0xbb, 0xca, 0x1b,
+	0x31, 0x7f, 0x2d, 0x6f, 0xc4, 0x42, 0x9a, 0x37, 0xe2, 0x7b, 0x0b, 0xdd, 0xd7, 0xc8, 0xe8, 0x94,
+	0xea, 0xba, 0xf6, 0xc1, 0x62, 0xd7, 0x35, 0xb2, 0x47, 0x2d, 0x72, 0x5b, 0x93, 0x9c, 0xee, 0x4a,
+	0x0b, 0xfc, 0x42, 0x22, 0x92, 0x34, 0xb7, 0xb5, 0xf2, 0x17, 0x70, 0x5b, 0x6b, 0xfe, 0x71, 0x06,
+	0xb4, 0xe4, 0x12, 0xd4, 0x1e, 0x41, 0x51, 0xb8, 0xf4, 0xd2, 0xf6, 0xf0, 0xf6, 0xf5, 0x96, 0x31,
+	0x87, 0x1b, 0x22, 0xb5, 0xf6, 0x0e, 0xac, 0xc9, 0x17, 0xe8, 0x65, 0xf5, 0x61, 0xcd, 0xd0, 0x64,
+	0x54, 0xa4, 0x08, 0x97, 0x5c, 0x3f, 0xf3, 0x57, 0xba, 0x7e, 0x16, 0xae, 0x74, 0xfd, 0x5c, 0x52,
+	0x5d, 0x3f, 0x9b, 0xbf, 0x98, 0x85, 0xb5, 0x94, 0x95, 0xf2, 0xe5, 0xb5, 0x19, 0xe7, 0x9e, 0xc2,
+	0x3b, 0xb3, 0x7c, 0xee, 0xc9, 0x6c, 0xf3, 0x00, 0x2a, 0xd1, 0x50, 0xf8, 0x7c, 0x3b, 0xbc, 0x7f,
+	0x15, 0x0b, 0x8b, 0x52, 0x18, 0x72, 0x72, 0xed, 0x5d, 0xd8, 0x98, 0x5a, 0xc3, 0xa7, 0xd6, 0xb9,
+	0x6d, 0xaa, 0x87, 0x52, 0x32, 0xa9, 0x6a, 0x1c, 0xd9, 0x8f, 0xce, 0xa6, 0xcd, 0xbf, 0x95, 0x85,
+	0x8a, 0x94, 0x1f, 0x76, 0x3c, 0xcd, 0x72, 0xc9, 0x07, 0x80, 0x24, 0x63, 0xa6, 0x2f, 0x65, 0xd7,
+	0xfb, 0xd8, 0x7c, 0x66, 0x78, 0x5a, 0x8f, 0x5c, 0x0c, 0x66, 0x04, 0xdb, 0xb0, 0xc6, 0x09, 0x04,
+	0xef, 0x64, 0x84, 0xb4, 0x07, 0x72, 0xe7, 0x3f, 0xde, 0x2e, 0x46, 0xff, 0x8e, 0x38, 0xcf, 0x46,
+	0xc3, 0x2d, 0xb9, 0xd2, 0xac, 0x72, 0xb7, 0x43, 0x3e, 0xee, 0xb8, 0x34, 0xde, 0x85, 0x8d, 0xd0,
+	0xef, 0x50, 0x49, 0x41, 0x76, 0x61, 0x4d, 0xf8, 0x17, 0x4a, 0x49, 0xbe, 0x05, 0x77, 0x62, 0x75,
+	0x8a, 0x25, 0x25, 0x05, 0xe8, 0x4d, 0xa5, 0x76, 0x72, 0x0e, 0xcd, 0x9f, 0x83, 0x9a, 0xc2, 0xc0,
+	0xbf, 0xbc, 0x59, 0x12, 0xd7, 0x51, 0x53, 0x8f, 0xca, 0x3a, 0xea, 0xe6, 0x7f, 0xc9, 0x81, 0x96,
+	0xdc, 0x43, 0x7e, 0x9c, 0x55, 0x48, 0xce, 0xe5, 0x5c, 0xca, 0x5c, 0xfe, 0x53, 0x93, 0x6b, 0x22,
+	0x53, 0x89, 0xe4, 0xd9, 0x47, 0xeb, 0xb9, 0x1e, 0x22, 0x44, 0x2d, 0x3e, 0x8a, 0x3b, 0x47, 0x97,
+	0x14, 0xdf, 0x08, 0x49, 0xb0, 0x8b, 0xf9, 0x48, 0x9f, 0xc0, 0x92, 0xe5, 0x0e, 0x2f, 0xbc, 0x19,
+	0x67, 0x9d, 0x3f, 0xf9, 0x85, 0xb7, 0xf5, 0xed, 0x16, 0x4b, 0xcf, 0xa4, 0x49, 0x83, 0x67, 0xa6,
+	0xbf, 0x0b, 0x15, 0x09, 0xac, 0x95, 0xa1, 0x70, 0xd0, 0x3d, 0xdc, 0xe9, 0xd5, 0x6f, 0xa0, 0x53,
+	0x9c, 0xd1, 0x69, 0xf7, 0x3e, 0xeb, 0x18, 0x9d, 0xdd, 0x7a, 0x46, 0x2b, 0x41, 0xfe, 0xa0, 0xd7,
+	0x1f, 0xd4, 0xb3, 0xcd, 0xbf, 0x97, 0x81, 0xe5, 0x68, 0x6f, 0xfd, 0xbf, 0xe0, 0x12, 0xc8, 0x7d,
+	0x58, 0x95, 0x06, 0x8c, 0xa1, 0xc5, 0x70, 0xad, 0x84, 0xc3, 0xd5, 0x61, 0xe0, 0xe6, 0x1f, 0x64,
+	0x60, 0x35, 0x21, 0x18, 0x7c, 0x79, 0x93, 0xb5, 0x0d, 0x05, 0x1a, 0x56, 0x92, 0x2b, 0xdf, 0xbe,
+	0xb6, 0x88, 0xc2, 0x46, 0x9c, 0xd2, 0xa2, 0x8d, 0x8a, 0xfa, 0x48, 0x48, 0x3e, 0x62, 0x3e, 0x13,
+	0x94, 0x12, 0xe8, 0x4d, 0x68, 0xf0, 0xfc, 0x92, 0xb6, 0xfb, 0x5f, 0xcb, 0x83, 0x26, 0x23, 0xb9,
+	0xa6, 0xe8, 0x3d, 0xa8, 0xca, 0xd2, 0x6f, 0x23, 0xa3, 0x98, 0x19, 0x79, 0x02, 0xd4, 0x11, 0x79,
+	0xd2, 0x2e, 0xdb, 0x06, 0xf2, 0xf0, 0x1c, 0x85, 0xc9, 0xb2, 0x57, 0xf9, 0x29, 0xb1, 0x43, 0xb6,
+	0xc2, 0x0d, 0x7e, 0x02, 0x96, 0x55, 0x3b, 0x75, 0x23, 0xb7, 0x50, 0xef, 0x81, 0xa9, 0x15, 0xc3,
+	0xb5, 0xf6, 0x2d, 0xa8, 0xc7, 0xed, 0xdc, 0x8d, 0xfc, 0xcb, 0xd2, 0xaf, 0x38, 0xaa, 0xe9, 0x5b,
+	0xdb, 0x87, 0xf5, 0x34, 0xf9, 0xbf, 0xb1, 0xa4, 0x68, 0x0a, 0xe2, 0xba, 0x32, 0x2d, 0x29, 0xe3,
+	0x6b, 0x1f, 0x73, 0x7f, 0x87, 0x02, 0x5b, 0x85, 0xaf, 0xab, 0xe5, 0x4b, 0x9d, 0xbd, 0x4d, 0xff,
+	0x24, 0xcf, 0x87, 0x67, 0x00, 0x11, 0x0c, 0x3d, 0x1d, 0x7a, 0xc7, 0x9d, 0x23, 0xb3, 0xbd, 0xdf,
+	0x3a, 0x3a, 0xea, 0x1c, 0xd4, 0x6f, 0x68, 0x1a, 0x2c, 0x33, 0x37, 0xd5, 0xdd, 0x10, 0x96, 0x41,
+	0x18, 0x77, 0x44, 0x11, 0xb0, 0x2c, 0xfa, 0xb0, 0x76, 0x8f, 0x62, 0xd0, 0x1c, 0x3a, 0xad, 0x1e,
+	0x77, 0xc8, 0x69, 0x55, 0xc9, 0x37, 0x8f, 0x67, 0x4a, 0xde, 0x5c, 0x3d, 0x80, 0xf5, 0xcf, 0xad,
+	0xf1, 0xd8, 0x0e, 0x5a, 0x74, 0x6d, 0x4f, 0x70, 0xa5, 0x37, 0x61, 0x35, 0x54, 0xeb, 0xc7, 0x0e,
+	0x53, 0xf5, 0x10, 0x21, 0x88, 0xdf, 0x81, 0xb5, 0xb9, 0x9b, 0x24, 0xe7, 0x2a, 0xde, 0xb9, 0x1b,
+	0x4f, 0xa0, 0x6f, 0x8a, 0x52, 0x39, 0x40, 0x1c, 0xf0, 0xfe, 0x30, 0x0b, 0x1b, 0x31, 0x44, 0x64,
+	0xa2, 0xa6, 0xe3, 0x9d, 0x5a, 0x97, 0x2a, 0x03, 0xbe, 0xb4, 0xd2, 0xd9, 0x2f, 0x56, 0xe9, 0xdc,
+	0xa2, 0x4a, 0x6b, 0x4f, 0x60, 0x85, 0xdf, 0x6d, 0x94, 0xa4, 0x73, 0xf9, 0xd8, 0x91, 0x5a, 0xf3,
+	0x6d, 0xb5, 0x63, 0xc9, 0x07, 0x60, 0xd9, 0x52, 0x80, 0xcd, 0x9f, 0x81, 0xb5, 0x14, 0xb2, 0x94,
+	0x1b, 0xbe, 0xef, 0xaa, 0xfe, 0x00, 0xb7, 0x94, 0x92, 0xd5, 0x2c, 0x64, 0xdf, 0xa6, 0x6d, 0x58,
+	0xe2, 0xca, 0xf5, 0x3a, 0xe4, 0xc4, 0x25, 0xef, 0xbc, 0x81, 0x3f, 0xd1, 0x36, 0x37, 0x89, 0xae,
+	0x9b, 0xb1, 0xdf, 0xe8, 0x02, 0x24, 0x0e, 0x9c, 0xea, 0x00, 0xfd, 0x7c, 0x1e, 0x36, 0xe3, 0x98,
+	0xf0, 0x02, 0x66, 0x51, 0x19, 0x1b, 0xf2, 0xb3, 0xe0, 0x20, 0xed, 0xfd, 0xd8, 0x72, 0x53, 0x46,
+	0x87, 0x91, 0xca, 0x4b, 0x4b, 0x74, 0xf9, 0xc3, 0xf8, 0x71, 0x88, 0x78, 0x44, 0x4d, 0x5c, 0x47,
+	0x65, 0x6d, 0x8a, 0x9d, 0x8e, 0xde, 0x4f, 0x9c, 0x8e, 0xf2, 0x69, 0x89, 0x62, 0x87, 0xa5, 0x0e,
+	0x6c, 0x45, 0x17, 0xab, 0xd4, 0x32, 0x0b, 0x69, 0xc9, 0x37, 0x42, 0xea, 0x03, 0xb9, 0xf0, 0x47,
+	0xd0, 0x88, 0xb2, 0x89, 0x55, 0x63, 0x29, 0x2d, 0x9f, 0xcd, 0x90, 0xdc, 0x50, 0xea, 0xf3, 0x6d,
+	0x68, 0x2a, 0xfd, 0xa5, 0x56, 0xa9, 0x98, 0x96, 0xd5, 0x96, 0xd4, 0x81, 0x4a, 0xa5, 0x0e, 0xe0,
+	0x96, 0x92, 0x57, 0xac, 0x5e, 0xa5, 0xb4, 0xcc, 0x1a, 0x52, 0x66, 0x4a, 0xcd, 0xf4, 0x7f, 0xbc,
+	0x04, 0xda, 0x77, 0xe6, 0xf6, 0xec, 0x92, 0x45, 0x82, 0xf1, 0xaf, 0xba, 0x31, 0x2a, 0xd4, 0xdd,
+	0xd9, 0x6b, 0x05, 0x84, 0x4a, 0x0b, 0xc8, 0x94, 0xbf, 0x3a, 0x20, 0x53, 0xe1, 0xaa, 0x80, 0x4c,
+	0x78, 0xb9, 0xe6, 0xdc, 0xf5, 0x50, 0x1e, 0xc3, 0x13, 0x3c, 0x29, 0x0c, 0xaa, 0x46, 0x95, 0x03,
+	0xf1, 0xfc, 0xee, 0xa3, 0x5d, 0x5f, 0x10, 0xd9, 0xa3, 0x73, 0x16, 0xae, 0x4c, 0x96, 0xc4, 0x3a,
+	0xa3, 0x73, 0x9b, 0x6b, 0xf7, 0xd9, 0x84, 0x15, 0x89, 0x11, 0xce, 0xb6, 0x68, 0xdf, 0x9b, 0xa3,
+	0xd6, 0x45, 0x74, 0x03, 0x79, 0x43, 0x55, 0x09, 0x7a, 0x2c, 0x7c, 0xe3, 0xd6, 0xe6, 0xbe, 0x6d,
+	0x4e, 0x1c, 0xdf, 0x47, 0x31, 0x66, 0xe8, 0xb9, 0xc1, 0xcc, 0x1b, 0x73, 0x07, 0xa7, 0xd5, 0xb9,
+	0x6f, 0x1f, 0x12, 0xa6, 0x4d, 0x08, 0xed, 0xfd, 0xa8, 0x4a, 0x53, 0xcb, 0x99, 0xf9, 0x0d, 0xb8,
+	0x9b, 0x93, 0x5a, 0xca, 0xf4, 0x0e, 0x96, 0x33, 0x0b, 0xeb, 0x82, 0x1f, 0x7e, 0x2c, 0x50, 0x54,
+	0x25, 0x1e, 0x28, 0xea, 0xff, 0x4b, 0x0f, 0x14, 0x55, 0x53, 0x98, 0x59, 0x72, 0x88, 0xbf, 0x50,
+	0xbc, 0xa8, 0x64, 0xfc, 0xab, 0xe5, 0x2f, 0x12, 0xff, 0x6a, 0x25, 0x2d, 0xfe, 0xd5, 0xbb, 0x50,
+	0x61, 0x61, 0x87, 0xcc, 0x0b, 0x76, 0x75, 0x8b, 0x1c, 0xb6, 0xea, 0x72, 0x5c, 0xa2, 0x7d, 0xc7,
+	0x0d, 0x0c, 0x98, 0x89, 0x9f, 0x7e, 0x32, 0x14, 0xd5, 0xea, 0xf5, 0x42, 0x51, 0xdd, 0x22, 0x37,
+	0x4d, 0x73, 0x3a, 0xb3, 0xcf, 0x98, 0x55, 0x37, 0x63, 0x94, 0x10, 0x70, 0x3c, 0xb3, 0xcf, 0xbe,
+	0x9c, 0x20, 0x54, 0x3c, 0x76, 0xd2, 0x36, 0x94, 0xc4, 0x20, 0x22, 0x27, 0x3e, 0x9b, 0x79, 0x13,
+	0xe1, 0xc3, 0x81, 0xbf, 0xb5, 0x65, 0xc8, 0x06, 0x1e, 0x4f, 0x9c, 0x0d, 0x3c, 0xfd, 0xa7, 0xa1,
+	0x22, 0xcd, 0x43, 0xed, 0x35, 0x00, 0xa1, 0x70, 0xe2, 0xa7, 0x5f, 0xea, 0xe2, 0x32, 0x87, 0x76,
+	0x47, 0xb8, 0x29, 0x8e, 0x9c, 0x99, 0xcd, 0x22, 0xca, 0x99, 0x33, 0x1b, 0xbd, 0x20, 0x85, 0x47,
+	0x4f, 0x3d, 0x44, 0x18, 0x04, 0xd7, 0x7f, 0x06, 0xd6, 0x94, 0x81, 0xe7, 0xbc, 0xfd, 0x75, 0x58,
+	0x62, 0x9d, 0x2a, 0x8c, 0x93, 0x6a, 0x30, 0x28, 0x8e, 0x63, 0x71, 0xf5, 0xc8, 0x19, 0xc9, 0x9c,
+	0xce, 0xbc, 0x53, 0x56, 0x48, 0xc6, 0xa8, 0x70, 0xd8, 0xf1, 0xcc, 0x3b, 0xd5, 0xff, 0x75, 0x0e,
+	0x72, 0xfb, 0xde, 0x54, 0xbe, 0xee, 0x95, 0x49, 0x5c, 0xf7, 0xe2, 0x5a, 0x34, 0x33, 0xd4, 0x92,
+	0x71, 0x45, 0x04, 0x02, 0xdb, 0x1c, 0xa6, 0xdd, 0x43, 0x91, 0x38, 0x30, 0x03, 0xcf, 0xe4, 0x77,
+	0xb0, 0x69, 0xe7, 0xa6, 0x95, 0x69, 0x4d, 0x82, 0x81, 0xb7, 0x47, 0x70, 0x6d, 0x1d, 0x72, 0xa1,
+	0x4e, 0x86, 0xa1, 0xf1, 0x13, 0x0f, 0x21, 0xd2, 0xb9, 0xa0, 0x66, 0xf0, 0x2f, 0x0c, 0xf8, 0xa4,
+	0xe6, 0x4b, 0x7c, 0x8a, 0x9f, 0xde, 0xe4, 0x8c, 0x19, 0xc3, 0xba, 0x89, 0x5e, 0x80, 0x76, 0x14,
+	0x13, 0x28, 0x67, 0xe0, 0xc5, 0x03, 0x86, 0x92, 0x38, 0x62, 0x49, 0xe1, 0x88, 0x68, 0x40, 0x1b,
+	0x3f, 0xc3, 0x30, 0x6a, 0x63, 0xcf, 0x12, 0x41, 0x26, 0x20, 0x18, 0x3f, 0x3b, 0x26, 0x88, 0xf6,
+	0x0e, 0xc0, 0x64, 0x3a, 0xe5, 0x0b, 0x93, 0x39, 0x6d, 0x44, 0xf3, 0xfc, 0xf0, 0xf8, 0x98, 0xa6,
+	0x9c, 0x51, 0x9e, 0x4c, 0xa7, 0xf4, 0x53, 0xdb, 0x85, 0xe5, 0xd4, 0xa8, 0x6f, 0x77, 0x78, 0xa2,
+	0x7d, 0x6f, 0xba, 0x9d, 0xb2, 0x72, 0x6b, 0x43, 0x19, 0xd6, 0xfc, 0x16, 0x68, 0x3f, 0x62, 0x60,
+	0xb5, 0x01, 0x94, 0xc3, 0xfa, 0x25, 0xc2, 0xb9, 0x55, 0x12, 0xe1, 0xdc, 0x90, 0x69, 0x92, 0x54,
+	0x17, 0xee, 0x07, 0x20, 0x89, 0x75, 0xfc, 0x6e, 0xba, 0xfe, 0x6f, 0x33, 0x50, 0x60, 0x33, 0x0d,
+	0x39, 0x05, 0xd1, 0x87, 0x57, 0xe7, 0xb8, 0xb3, 0x24, 0x09, 0x87, 0x03, 0x7e, 0x6b, 0x0e, 0x97,
+	0x85, 0x14, 0x75, 0x32, 0x92, 0x31, 0xa4, 0xc8, 0x93, 0xaf, 0x42, 0x39, 0x2c, 0x5a, 0x9a, 0x3a,
+	0x25, 0x51, 0xb2, 0xf6, 0x0a, 0x06, 0x02, 0x9a, 0x0a, 0x9d, 0x39, 0x44, 0x3d, 0x69, 0x30, 0x78,
+	0x54, 0x17, 0x2c, 0x43, 0xf6, 0x39, 0xa8, 0x85, 0x85, 0x88, 0x90, 0x50, 0xb1, 0x36, 0x2e, 0xa5,
+	0xb4, 0xf1, 0x04, 0x56, 0x90, 0x0f, 0x48, 0x1e, 0x9b, 0x8b, 0x77, 0xd4, 0xaf, 0xe3, 0xe1, 0x67,
+	0x38, 0x9e, 0x8f, 0x6c, 0xd9, 0x6a, 0xc1, 0xae, 0x33, 0x71, 0x78, 0xe8, 0x1e, 0xf0, 0x5b, 0x19,
+	0x28, 0x89, 0x7c, 0xb5, 0x7b, 0x90, 0x77, 0x85, 0x77, 0x67, 0x74, 0xc4, 0x09, 0xe3, 0x4b, 0x20,
+	0x9d, 0xc1, 0x28, 0x70, 0xe8, 0x98, 0x4f, 0xa4, 0x9c, 0x7b, 0xcd, 0xc0, 0x5b, 0xde, 0x22, 0x67,
+	0x54, 0x42, 0x53, 0xb3, 0x62, 0xba, 0x6c, 0x6a, 0x7d, 0xb8, 0x4c, 0xb7, 0x25, 0x27, 0x96, 0xbc,
+	0xb2, 0x9d, 0x8a, 0x03, 0xd2, 0xe8, 0xdc, 0x96, 0x6e, 0x37, 0xfd, 0x6e, 0x16, 0x6a, 0x4a, 0x8d,
+	0xd8, 0xc5, 0x30, 0xdc, 0x1d, 0xc8, 0xf4, 0xcf, 0xc7, 0x9b, 0xdd, 0x02, 0xe0, 0x67, 0x58, 0xa9,
+	0x9f, 0xb2, 0x4a, 0x3f, 0x85, 0xee, 0xd9, 0x39, 0xd9, 0x3d, 0xfb, 0x01, 0x94, 0xa3, 0x68, 0xa3,
+	0x6a, 0x95, 0xb0, 0x3c, 0x11, 0x65, 0x23, 0x22, 0x8a, 0x1c, 0xba, 0x0b, 0xb2, 0x43, 0xf7, 0x37,
+	0x25, 0xff, 0x5f, 0x32, 0x3f, 0xe8, 0x69, 0x3d, 0xfa, 0x63, 0xf1, 0xfe, 0xd5, 0x3f, 0x85, 0x8a,
+	0x54, 0x79, 0xd9, 0x87, 0x36, 0xa3, 0xf8, 0xd0, 0x86, 0xb1, 0x7c, 0xb2, 0x51, 0x2c, 0x1f, 0xfd,
+	0x7f, 0x64, 0xa1, 0x86, 0xeb, 0x0b, 0x4d, 0x91, 0xde, 0xd8, 0x19, 0x32, 0x57, 0x80, 0x70, 0x85,
+	0x71, 0x29, 0x4c, 0xac, 0x33, 0xbe, 0xc4, 0x48, 0x08, 0x93, 0xa3, 0xd8, 0x11, 0x93, 0x0e, 0xa3,
+	0xd8, 0xe9, 0x50, 0x43, 0xc6, 0xc8, 0x8c, 0xfa, 0x51, 0x64, 0x52, 0xa3, 0x72, 0x66, 0xdb, 0x3b,
+	0x96, 0x4f, 0x1c, 0xf2, 0x6d, 0x58, 0x43, 0x1a, 0x16, 0x5d, 0x6a, 0xe2, 0x8c, 0xc7, 0x4e, 0x14,
+	0xa4, 0x22, 0x67, 0xd4, 0xcf, 0x6c, 0xdb, 0xb0, 0x02, 0xfb, 0x10, 0x11, 0x3c, 0x90, 0x69, 0x69,
+	0xe4, 0xf8, 0xd6, 0x69, 0x74, 0x7d, 0x2f, 0xfc, 0x16, 0xee, 0x5a, 0x91, 0xff, 0x0f, 0xf7, 0x11,
+	0xe5, 0x71, 0xd7, 0x58, 0xfa, 0xd8, 0x4c, 0x2a, 0x26, 0x66, 0xd2, 0xbb, 0xb0, 0xc1, 0xef, 0xd4,
+	0x98, 0x6a, 0xdd, 0x29, 0x58, 0x90, 0xc6, 0x91, 0x7b, 0x52, 0x13, 0x7e, 0x02, 0x6e, 0xc9, 0x49,
+	0xe2, 0x4d, 0x29, 0xb3, 0x84, 0x5b, 0x51, 0x42, 0xa5, 0x45, 0xfa, 0x3f, 0x40, 0x65, 0x76, 0xb4,
+	0x0e, 0xae, 0xb3, 0x9d, 0xdf, 0x49, 0xf8, 0x8a, 0x94, 0x65, 0xb7, 0x90, 0xaf, 0xa8, 0x6d, 0xcc,
+	0x85, 0xa1, 0x13, 0xe4, 0x76, 0xa2, 0xd7, 0xbf, 0x37, 0xb2, 0xdf, 0x65, 0x86, 0x2c, 0x1e, 0xd3,
+	0x98, 0x01, 0xd0, 0x86, 0xc5, 0x91, 0x0f, 0x19, 0xb2, 0x10, 0x21, 0x1f, 0x22, 0xf2, 0x65, 0xb7,
+	0xa3, 0x3f, 0x82, 0x2a, 0xcf, 0x95, 0x4d, 0xa2, 0x46, 0x51, 0x61, 0x33, 0xca, 0x04, 0x33, 0x2a,
+	0x54, 0x1c, 0xfb, 0x10, 0x09, 0x1f, 0x8a, 0x84, 0xa5, 0xab, 0x12, 0x3e, 0xa4, 0x0f, 0x7d, 0x2f,
+	0xbc, 0x70, 0xce, 0x5c, 0xfd, 0x05, 0xe3, 0x7c, 0x07, 0xd6, 0x04, 0x7f, 0x9c, 0xbb, 0x96, 0xeb,
+	0x7a, 0x73, 0x77, 0x68, 0x8b, 0xc8, 0x3c, 0x1a, 0x47, 0x9d, 0x44, 0x18, 0x7d, 0x04, 0x55, 0x39,
+	0x1f, 0xed, 0x3e, 0x14, 0xe8, 0x94, 0xa0, 0xba, 0x62, 0xa9, 0x9c, 0x92, 0x48, 0xb4, 0x7b, 0x50,
+	0xa0, 0xc3, 0x42, 0x76, 0x21, 0x77, 0x23, 0x02, 0xbd, 0x05, 0x1a, 0x26, 0x3c, 0xb4, 0x83, 0x99,
+	0x33, 0xf4, 0xa3, 0xa0, 0x3f, 0x05, 0xd4, 0x05, 0x51, 0x59, 0x91, 0xfd, 0x2b, 0xa2, 0x64, 0xfa,
+	0x22, 0xa2, 0xc1, 0x9d, 0x70, 0x4d, 0xc9, 0x83, 0xcb, 0x67, 0x63, 0xd8, 0x3c, 0xb5, 0x83, 0xe7,
+	0xb6, 0xed, 0xba, 0x28, 0x7d, 0x0d, 0x6d, 0x37, 0x98, 0x59, 0x63, 0x1c, 0x24, 0x6a, 0xc1, 0x07,
+	0x89, 0x5c, 0xc3, 0xb4, 0xdb, 0x3b, 0x51, 0xc2, 0x76, 0x98, 0x8e, 0x98, 0xd5, 0xc6, 0x69, 0x1a,
+	0xae, 0xf9, 0xff, 0x42, 0x73, 0x71, 0xa2, 0x14, 0xa5, 0xc5, 0x3d, 0x95, 0x8d, 0x85, 0x2e, 0x1b,
+	0x63, 0xcf, 0x0a, 0xa8, 0x36, 0x32, 0x2b, 0x3b, 0x82, 0x8a, 0x84, 0x89, 0x84, 0x8d, 0x0c, 0x93,
+	0x26, 0xe9, 0x03, 0xb7, 0x40, 0xd7, 0x9b, 0x4d, 0x98, 0x8b, 0xc4, 0xc8, 0x8c, 0x72, 0xcf, 0x18,
+	0x2b, 0x11, 0x9c, 0xb9, 0x7f, 0xea, 0xdb, 0xb0, 0xc2, 0xce, 0x19, 0xd2, 0xce, 0xfa, 0x32, 0xe9,
+	0x53, 0x5f, 0xc7, 0xb8, 0x55, 0x8c, 0x59, 0x4a, 0x49, 0xf4, 0x3f, 0xcc, 0x41, 0x45, 0x02, 0xe3,
+	0xf6, 0xc7, 0xee, 0x9c, 0x98, 0x23, 0xc7, 0x9a, 0xd8, 0xc2, 0x1f, 0xa5, 0x66, 0xd4, 0x18, 0x74,
+	0x97, 0x03, 0x99, 0xe2, 0xf6, 0xd9, 0xb9, 0xe9, 0xcd, 0x03, 0x73, 0x64, 0x9f, 0xcf, 0x6c, 0x51,
+	0xcb, 0xaa, 0xf5, 0xec, 0xbc, 0x37, 0x0f, 0x76, 0x19, 0x4c, 0x44, 0x8d, 0x94, 0xa8, 0x72, 0x61,
+	0xd4, 0xc8, 0x88, 0x8a, 0xdf, 0xd5, 0xa1, 0x99, 0x99, 0x0f, 0xef, 0xea, 0xd0, 0xd9, 0x35, 0xbe,
+	0x63, 0x17, 0x92, 0x3b, 0xf6, 0xfb, 0xb0, 0x49, 0x3b, 0x36, 0xdf, 0x0b, 0xcc, 0xd8, 0x4a, 0xa6,
+	0xfb, 0x93, 0xbc, 0x91, 0x92, 0x9c, 0x5d, 0xc7, 0x16, 0x08, 0xb6, 0xe4, 0xa3, 0x17, 0x4b, 0x91,
+	0xb5, 0x01, 0x5b, 0xc6, 0x33, 0xef, 0xa3, 0x97, 0x10, 0x8f, 0x5a, 0xa9, 0x50, 0xf2, 0xd8, 0x07,
+	0xe8, 0x4d, 0x1c, 0xa3, 0xc4, 0x10, 0x67, 0x32, 0x65, 0x99, 0x53, 0x5a, 0x2f, 0x64, 0xca, 0x0f,
+	0x60, 0x6b, 0x62, 0x8f, 0x1c, 0x4b, 0xcd, 0xd6, 0x8c, 0x24, 0xc5, 0x75, 0x42, 0x4b, 0x69, 0xfa,
+	0xa4, 0x46, 0xc0, 0xde, 0xf8, 0x81, 0x37, 0x39, 0x75, 0x48, 0x48, 0x22, 0xc7, 0xe6, 0xbc, 0x81,
+	0x77, 0x3d, 0xbe, 0xc7, 0xc0, 0x98, 0xc4, 0xd7, 0x6b, 0x50, 0xe9, 0x07, 0xde, 0x54, 0x0c, 0xf3,
+	0x32, 0x54, 0xe9, 0x93, 0x07, 0xb5, 0xba, 0x05, 0x37, 0x19, 0x4b, 0x18, 0x78, 0x53, 0x6f, 0xec,
+	0x9d, 0x5f, 0x2a, 0x3a, 0xf5, 0x7f, 0x9a, 0x81, 0x35, 0x05, 0xcb, 0xd9, 0xeb, 0xfb, 0xc4, 0xcf,
+	0xc2, 0x80, 0x38, 0x19, 0xe5, 0x36, 0x2d, 0x8e, 0x17, 0x11, 0x12, 0x33, 0xa3, 0xdf, 0xbe, 0xd6,
+	0x8a, 0x42, 0xc7, 0x8a, 0x84, 0xb1, 0x8b, 0xbc, 0x11, 0x4b, 0xe1, 0xe9, 0x45, 0x50, 0x59, 0x91,
+	0xc5, 0x4f, 0x42, 0x55, 0x52, 0xcc, 0x0b, 0x07, 0x93, 0xa6, 0x7c, 0x11, 0x78, 0xd4, 0x96, 0x93,
+	0xf0, 0xd8, 0x15, 0x23, 0xea, 0x8b, 0xdf, 0xce, 0x02, 0x44, 0xb5, 0x63, 0x57, 0xe1, 0x43, 0x41,
+	0x29, 0xc3, 0x6e, 0x3e, 0x45, 0x00, 0x9c, 0x70, 0xe1, 0x25, 0xb9, 0x48, 0xf4, 0xaa, 0x08, 0x18,
+	0xca, 0x5f, 0x6f, 0xc2, 0xca, 0xf9, 0xd8, 0x3b, 0x65, 0x22, 0x32, 0x17, 0x94, 0x98, 0x55, 0x87,
+	0xed, 0x47, 0xcb, 0x84, 0x0a, 0x4f, 0xdc, 0xa1, 0xb0, 0x96, 0x4f, 0xbd, 0x4b, 0xa7, 0x88, 0x5e,
+	0x9f, 0x26, 0x44, 0xaf, 0x57, 0x13, 0x9d, 0xfb, 0xe3, 0x91, 0xbb, 0xfe, 0x52, 0x16, 0x56, 0x13,
+	0xe3, 0xf2, 0xf2, 0xd3, 0xed, 0x0f, 0xe3, 0xec, 0xf9, 0x32, 0x97, 0x91, 0x4f, 0x61, 0x79, 0x46,
+	0x5b, 0xa4, 0xd8, 0x3f, 0xf3, 0x2f, 0xd9, 0x3f, 0x6b, 0x33, 0xf9, 0x13, 0xf9, 0xa8, 0x35, 0x7a,
+	0x66, 0xcf, 0x02, 0x87, 0x99, 0x53, 0xd9, 0xf1, 0x80, 0xdf, 0xc2, 0x91, 0xe0, 0x4c, 0x0e, 0xc7,
+	0xd0, 0xc6, 0x14, 0xf6, 0x2d, 0xa4, 0xe4, 0x41, 0xd5, 0x23, 0x30, 0x12, 0x62, 0x24, 0x81, 0xb5,
+	0x94, 0xb9, 0xf6, 0xf2, 0x5e, 0x91, 0x5b, 0x98, 0x4d, 0x3a, 0xc5, 0xf0, 0x69, 0xcd, 0xcd, 0x7e,
+	0x9c, 0x3b, 0x12, 0x90, 0x9b, 0xfc, 0xd4, 0x6e, 0xcd, 0x5f, 0xa7, 0x5b, 0xf5, 0x7f, 0x96, 0x81,
+	0xe2, 0xbe, 0x37, 0x45, 0x55, 0x11, 0x9e, 0x22, 0xd8, 0xa2, 0x0d, 0x9d, 0x08, 0x96, 0xf0, 0xb3,
+	0x3b, 0x92, 0xab, 0x9d, 0x8c, 0x4c, 0x93, 0x2a, 0xe5, 0xd6, 0x54, 0x29, 0xf7, 0x9b, 0x70, 0x0b,
+	0x69, 0xa6, 0x33, 0x0f, 0x2f, 0xb8, 0x3b, 0x9e, 0x6b, 0x8d, 0x49, 0x44, 0xf4, 0xdc, 0xe0, 0x42,
+	0x70, 0xf2, 0x9b, 0xe8, 0xe7, 0x21, 0x51, 0x1c, 0x86, 0x04, 0x2c, 0x64, 0x15, 0x6a, 0xf3, 0x48,
+	0x41, 0xc1, 0xc5, 0x71, 0xe2, 0xef, 0x2b, 0x88, 0x20, 0x3b, 0x26, 0x13, 0xc8, 0xf5, 0x8f, 0xa1,
+	0x1c, 0x2a, 0xc2, 0xb4, 0x37, 0xa1, 0x8c, 0x2a, 0x35, 0xd2, 0x96, 0x65, 0x94, 0xe8, 0x3d, 0xbc,
+	0xd5, 0x46, 0xe9, 0x82, 0x7e, 0xf8, 0xfa, 0x3f, 0x2c, 0x41, 0xb1, 0xeb, 0x3e, 0xf3, 0x9c, 0x21,
+	0xbb, 0x4c, 0x34, 0xb1, 0x27, 0x9e, 0x88, 0xa8, 0x80, 0xbf, 0x99, 0xf3, 0x70, 0x14, 0xf7, 0x3c,
+	0xc7, 0x9d, 0x87, 0xc3, 0x88, 0xe7, 0x1b, 0xb0, 0x34, 0x93, 0x03, 0x97, 0x17, 0x66, 0xec, 0x02,
+	0x68, 0xb8, 0x7b, 0x17, 0xa4, 0x88, 0xa4, 0x98, 0x17, 0xfb, 0x41, 0x5d, 0x46, 0x61, 0xa7, 0xca,
+	0x0c, 0xc2, 0x3a, 0xec, 0x36, 0x14, 0xb9, 0x4e, 0x9c, 0x22, 0x70, 0x90, 0x25, 0x81, 0x83, 0xd8,
+	0x6c, 0x98, 0xd9, 0x64, 0x06, 0x0e, 0xe5, 0x78, 0xd4, 0x0e, 0x71, 0xe0, 0xae, 0x45, 0x37, 0x84,
+	0x88, 0x9e, 0x48, 0x68, 0x1b, 0x02, 0x02, 0x31, 0x82, 0x94, 0x27, 0x02, 0xca, 0xa9, 0x4f, 0x04,
+	0xb0, 0x7b, 0x6a, 0x21, 0xcf, 0xa7, 0x26, 0x02, 0x45, 0x7d, 0x97, 0xe0, 0xe2, 0x45, 0x0e, 0xae,
+	0x52, 0xa2, 0x88, 0x6c, 0xfc, 0x0b, 0x6b, 0x7c, 0x66, 0x8d, 0xc7, 0xa7, 0xd6, 0xf0, 0x29, 0x69,
+	0x42, 0xaa, 0xa4, 0x19, 0x16, 0x40, 0xa6, 0x0a, 0xc1, 0x5b, 0xc9, 0xd1, 0x28, 0xb3, 0x8b, 0x33,
+	0x79, 0x03, 0xa2, 0xf1, 0x8d, 0x6b, 0x3f, 0x97, 0xaf, 0xa1, 0xfd, 0x94, 0xae, 0x4b, 0xac, 0xa8,
+	0xd7, 0x25, 0x6e, 0x31, 0xde, 0xce, 0x7d, 0xe2, 0xeb, 0xac, 0xac, 0x92, 0x35, 0x1a, 0x51, 0x44,
+	0x0f, 0xd4, 0xe3, 0x51, 0xe7, 0x11, 0x7e, 0x95, 0xdf, 0xc0, 0x62, 0x30, 0x11, 0xf4, 0x83, 0xa9,
+	0xf0, 0xa7, 0x96, 0x33, 0x6a, 0x68, 0xa1, 0xf2, 0x04, 0xd5, 0xf8, 0xc7, 0x96, 0xc3, 0xfc, 0x7c,
+	0x05, 0x9a, 0xed, 0xd5, 0x6b, 0xd4, 0xff, 0x1c, 0xdd, 0xa7, 0x78, 0x83, 0x21, 0xc5, 0x24, 0x0c,
+	0xa9, 0x66, 0x54, 0x38, 0x09, 0x9b, 0x07, 0xef, 0x32, 0xf7, 0xd0, 0xc0, 0x66, 0x41, 0xd3, 0x96,
+	0x43, 0x63, 0x17, 0x9f, 0xa5, 0xe2, 0x3f, 0x79, 0x2f, 0x10, 0x25, 0x8a, 0x9a, 0x64, 0x6d, 0xdf,
+	0x54, 0xa4, 0x71, 0x4e, 0x2a, 0x9b, 0xd4, 0x3f, 0x96, 0xf6, 0x90, 0x06, 0x23, 0xbe, 0x1d, 0xcb,
+	0x7f, 0x51, 0x9c, 0x83, 0x3b, 0x00, 0x8e, 0x8f, 0x7b, 0x9e, 0x6f, 0xbb, 0xa3, 0xc6, 0x4d, 0x1e,
+	0x61, 0xce, 0x7f, 0x4c, 0x80, 0x84, 0xda, 0xab, 0x99, 0x54, 0x7b, 0xed, 0xc0, 0x32, 0x3b, 0xa8,
+	0xce, 0xec, 0x9f, 0x25, 0xf5, 0x6b, 0xe3, 0x96, 0x62, 0xce, 0x13, 0xd5, 0x0d, 0xc6, 0x43, 0x43,
+	0x90, 0x18, 0xb5, 0x0b, 0xf9, 0xf3, 0xcb, 0xdd, 0xc6, 0x5a, 0x50, 0x95, 0x7b, 0x13, 0x5d, 0x3b,
+	0xd0, 0x64, 0x5c, 0xbf, 0xa1, 0x55, 0xa0, 0xd8, 0xef, 0x0c, 0x06, 0x07, 0xcc, 0xe3, 0xa3, 0x0a,
+	0xa5, 0x30, 0x46, 0x52, 0x16, 0xbf, 0x5a, 0xed, 0x76, 0xe7, 0x78, 0xd0, 0xd9, 0xad, 0xe7, 0xbe,
+	0x9d, 0x2f, 0x65, 0xeb, 0x39, 0xfd, 0x7f, 0xe7, 0xa0, 0x22, 0x75, 0xf6, 0xcb, 0x79, 0xbe, 0x1a,
+	0xaa, 0x33, 0x1b, 0x0f, 0xd5, 0x29, 0x9b, 0x89, 0x78, 0x38, 0x53, 0x61, 0x26, 0xfa, 0x0a, 0xd4,
+	0x78, 0x70, 0x74, 0xc9, 0x11, 0xa4, 0x60, 0x54, 0x09, 0xc8, 0x77, 0x04, 0x16, 0x71, 0x8d, 0x11,
+	0xb1, 0xc0, 0x18, 0x05, 0x3e, 0x07, 0x19, 0x88, 0x85, 0xc6, 0x60, 0x11, 0x85, 0x7c, 0x6f, 0xfc,
+	0xcc, 0x26, 0x0a, 0x12, 0x83, 0x2b, 0x1c, 0x36, 0xe0, 0xa1, 0xee, 0x38, 0xdb, 0x95, 0x42, 0x7e,
+	0x15, 0x8c, 0x2a, 0x01, 0x79, 0x41, 0x6f, 0x8b, 0x79, 0x4a, 0x8e, 0x8f, 0x5b, 0xc9, 0x49, 0xa7,
+	0xcc, 0xd1, 0x83, 0x84, 0xb2, 0xb6, 0xcc, 0xe6, 0xdf, 0x57, 0x93, 0xe9, 0xae, 0x56, 0xda, 0x62,
+	0x78, 0x7a, 0xd4, 0x15, 0xa7, 0xa8, 0x51, 0xf3, 0xc6, 0xca, 0x64, 0x3a, 0x1d, 0x48, 0x5a, 0x46,
+	0xed, 0x36, 0xda, 0xe2, 0xa6, 0x8c, 0x3d, 0x45, 0x2a, 0xcd, 0xd6, 0xe1, 0x31, 0xda, 0xe5, 0xa6,
+	0x5f, 0x82, 0xfe, 0xf7, 0x2f, 0x64, 0x20, 0xd7, 0x3a, 0x3c, 0x66, 0x9b, 0x85, 0xe7, 0xe1, 0x0d,
+	0x0a, 0x8b, 0x47, 0xe3, 0xc5, 0xcd, 0xc2, 0xf3, 0x82, 0x3e, 0x02, 0x70, 0xb3, 0xf0, 0xed, 0x20,
+	0xba, 0x25, 0x50, 0xf0, 0xed, 0x80, 0x2e, 0x96, 0x0c, 0x2f, 0x9c, 0xf1, 0x48, 0x09, 0xe4, 0x0e,
+	0x0c, 0x44, 0x33, 0x02, 0x63, 0xb3, 0x47, 0x5b, 0x0c, 0xfb, 0x4d, 0x37, 0xcd, 0xf9, 0xae, 0x44,
+	0xd7, 0x55, 0xc2, 0x6f, 0xfd, 0x7f, 0x65, 0x61, 0x3d, 0x6d, 0x2d, 0xfd, 0x29, 0x4e, 0x4b, 0x75,
+	0xb6, 0xe4, 0x53, 0x66, 0xcb, 0x37, 0x60, 0x69, 0x66, 0x5b, 0xbe, 0xe7, 0x72, 0x87, 0x11, 0xfd,
+	0x25, 0x8b, 0x1e, 0xaf, 0x4e, 0xf9, 0x9e, 0x6b, 0xf0, 0x14, 0x18, 0x81, 0x55, 0x99, 0x3a, 0xe4,
+	0x39, 0x4b, 0xaa, 0xae, 0xba, 0x3c, 0x2f, 0x50, 0x69, 0xa0, 0xff, 0x1c, 0x2c, 0x51, 0x7a, 0x5c,
+	0xc5, 0x3c, 0x9e, 0x49, 0xfd, 0x86, 0xb6, 0x06, 0x2b, 0x9d, 0xef, 0x1e, 0x77, 0x8d, 0x27, 0xe6,
+	0xa0, 0xd7, 0x33, 0xfb, 0xbd, 0xde, 0x51, 0x3d, 0xa3, 0xdd, 0x84, 0x8d, 0xc3, 0x6e, 0xbf, 0xcf,
+	0xc2, 0xa0, 0x9d, 0xf4, 0x07, 0xbd, 0x43, 0x13, 0x5d, 0xbd, 0x0c, 0x5c, 0xe7, 0x4d, 0xd8, 0x54,
+	0x40, 0xe6, 0x61, 0xb7, 0x7f, 0xd8, 0x1a, 0xb4, 0xf7, 0xc9, 0xc7, 0x64, 0xbf, 0x77, 0xb0, 0x6b,
+	0xc6, 0x33, 0xcc, 0xeb, 0x7f, 0x39, 0x03, 0x5a, 0x6b, 0x34, 0xe2, 0xad, 0x0a, 0x35, 0x15, 0x91,
+	0x9c, 0x90, 0x91, 0xe5, 0x84, 0x94, 0xed, 0x38, 0x9b, 0xba, 0x1d, 0x5f, 0xb5, 0x71, 0x29, 0xac,
+	0x77, 0x35, 0xc1, 0x7a, 0xf5, 0x3d, 0xa8, 0x1c, 0x4b, 0x0f, 0xa3, 0xdc, 0x05, 0xa0, 0xea, 0xb0,
+	0x97, 0x05, 0x32, 0xe1, 0x05, 0xe1, 0xd2, 0x8c, 0xbf, 0x84, 0x22, 0x55, 0x38, 0x2b, 0x55, 0x58,
+	0xff, 0x1b, 0x19, 0x8a, 0xec, 0x1d, 0xb6, 0x2f, 0x7a, 0x8b, 0x45, 0x98, 0xda, 0xa3, 0x30, 0x8f,
+	0x15, 0x61, 0x4c, 0xe7, 0x11, 0x1a, 0x59, 0xed, 0x4d, 0xef, 0xec, 0xcc, 0xb7, 0x85, 0xe3, 0x68,
+	0x85, 0xc1, 0x7a, 0x0c, 0x24, 0x8e, 0xaf, 0x78, 0x46, 0x76, 0x28, 0x7f, 0xbf, 0x51, 0x08, 0x8f,
+	0xaf, 0x87, 0xd6, 0x0b, 0x5e, 0xaa, 0x8f, 0xb3, 0x9f, 0x9b, 0xf4, 0x44, 0xb4, 0xb2, 0xf0, 0x5b,
+	0xff, 0x6b, 0x3c, 0x12, 0x65, 0x7c, 0x08, 0xee, 0xa3, 0x6b, 0x1e, 0xcf, 0x55, 0x95, 0x0a, 0x05,
+	0x65, 0x88, 0x47, 0xd9, 0x93, 0xa9, 0x13, 0x95, 0x1a, 0xd3, 0x92, 0x60, 0x36, 0xdb, 0xae, 0x54,
+	0xeb, 0xb7, 0x40, 0x3b, 0x73, 0x66, 0x71, 0x62, 0x5a, 0x22, 0x75, 0x86, 0x91, 0xa8, 0xf5, 0x13,
+	0x58, 0x13, 0x5b, 0x8e, 0x74, 0xa6, 0x56, 0xc7, 0x37, 0x73, 0x85, 0x60, 0x92, 0x4d, 0x08, 0x26,
+	0xfa, 0xaf, 0x14, 0xa0, 0xc8, 0x07, 0x38, 0xf5, 0x61, 0x9c, 0xb2, 0xfa, 0x30, 0x4e, 0x43, 0x89,
+	0x98, 0xcf, 0x86, 0x9e, 0x00, 0xda, 0x1b, 0x71, 0x31, 0x53, 0x32, 0x2f, 0x2a, 0xa2, 0x26, 0x37,
+	0x2f, 0x16, 0x54, 0xf3, 0x62, 0xda, 0x63, 0x41, 0x74, 0x5c, 0x4a, 0x3c, 0x16, 0x74, 0x0b, 0x48,
+	0xf6, 0x95, 0x9c, 0xec, 0x4b, 0x0c, 0xc0, 0x03, 0xf2, 0x49, 0xa2, 0x72, 0x29, 0x2e, 0x2a, 0x5f,
+	0x5b, 0x8c, 0x7d, 0x1f, 0x96, 0x28, 0xe4, 0x2d, 0x8f, 0x01, 0x25, 0x84, 0x1d, 0xde, 0x57, 0xe2,
+	0x3f, 0x5d, 0x23, 0x35, 0x38, 0xad, 0x1c, 0x46, 0xad, 0xa2, 0x84, 0x51, 0x93, 0xcd, 0x9e, 0x55,
+	0xd5, 0xec, 0x89, 0xa1, 0xac, 0x45, 0xc7, 0x31, 0x23, 0x82, 0xeb, 0xf3, 0x70, 0x2f, 0xcb, 0x02,
+	0x8e, 0x5b, 0xeb, 0x91, 0x1f, 0x09, 0x6b, 0xcb, 0x8a, 0xb0, 0x86, 0x1b, 0x5f, 0x2b, 0x08, 0xec,
+	0xc9, 0x34, 0x10, 0xc2, 0x9a, 0xf4, 0x3e, 0x13, 0x8d, 0x3c, 0xdd, 0xf4, 0x16, 0xc3, 0x4b, 0xb3,
+	0x63, 0x07, 0x96, 0xcf, 0x2c, 0x67, 0x3c, 0x9f, 0xd9, 0x26, 0x67, 0xb0, 0x75, 0x45, 0x6e, 0xe4,
+	0x4d, 0xdc, 0x23, 0x1a, 0xce, 0x59, 0x6b, 0x67, 0xf2, 0x27, 0x8b, 0xab, 0x20, 0xf7, 0x84, 0xca,
+	0x39, 0x6b, 0x50, 0xee, 0x1e, 0x99, 0x7b, 0x07, 0xdd, 0x47, 0xfb, 0x83, 0x7a, 0x06, 0x3f, 0xfb,
+	0x27, 0xed, 0x76, 0xa7, 0xb3, 0xcb, 0xe4, 0x21, 0x80, 0xa5, 0xbd, 0x56, 0xf7, 0x80, 0x4b, 0x43,
+	0xf9, 0x7a, 0x41, 0xff, 0x93, 0x2c, 0x54, 0xa4, 0xd6, 0xe0, 0x60, 0x5a, 0xf4, 0x13, 0x77, 0x1e,
+	0x8a, 0x4e, 0x51, 0xe6, 0x90, 0xee, 0x48, 0xfb, 0x20, 0x1c, 0x23, 0x8a, 0x19, 0x79, 0x27, 0xd9,
+	0x21, 0xdb, 0x42, 0x9a, 0x90, 0x06, 0x29, 0x7c, 0xa8, 0x29, 0xbb, 0xf0, 0xa1, 0x26, 0x34, 0xe8,
+	0x88, 0x92, 0xc5, 0x98, 0x70, 0x73, 0x1d, 0x07, 0xf3, 0x21, 0xf9, 0x1a, 0xac, 0xc8, 0x22, 0x91,
+	0xe9, 0xfa, 0x22, 0x92, 0x9b, 0x24, 0x15, 0xb1, 0xa1, 0x2b, 0xf2, 0x8e, 0xe3, 0xbe, 0x37, 0xa1,
+	0x70, 0xc9, 0xbb, 0x53, 0xa0, 0x95, 0xfd, 0x79, 0x49, 0xdd, 0x9f, 0x55, 0x2f, 0x8b, 0x92, 0xea,
+	0x65, 0xa1, 0x7f, 0x08, 0x10, 0x35, 0x56, 0xed, 0xfa, 0x1b, 0x6a, 0xd7, 0x67, 0xa4, 0xae, 0xcf,
+	0xea, 0x7f, 0x9b, 0xb3, 0x3d, 0x3e, 0x8e, 0xa1, 0xa2, 0xfd, 0x6d, 0x10, 0xaa, 0x7f, 0x93, 0x79,
+	0x22, 0x4f, 0xc7, 0x76, 0x20, 0x22, 0xdd, 0xac, 0x72, 0x4c, 0x37, 0x44, 0x24, 0xd8, 0x74, 0x36,
+	0xc9, 0xa6, 0x5f, 0x83, 0x2a, 0x0b, 0xb2, 0xce, 0x0b, 0x12, 0xaf, 0x9c, 0x60, 0x70, 0x75, 0x0e,
+	0x52, 0xf8, 0x73, 0x3e, 0xc6, 0x9f, 0x7f, 0x3d, 0x43, 0x41, 0x77, 0xa3, 0x8a, 0x46, 0x0c, 0x3a,
+	0xcc, 0x53, 0x65, 0xd0, 0x9c, 0xd4, 0x08, 0xf1, 0x0b, 0x98, 0x6e, 0x36, 0x9d, 0xe9, 0xa6, 0xb3,
+	0xf3, 0x5c, 0x2a, 0x3b, 0xd7, 0x5f, 0x40, 0x63, 0xd7, 0xc6, 0xae, 0x68, 0x8d, 0xc7, 0xf1, 0xbe,
+	0xc4, 0x38, 0x98, 0x96, 0x33, 0x66, 0x5e, 0x49, 0x84, 0x91, 0xb7, 0x3b, 0x8d, 0x70, 0x22, 0x11,
+	0xdb, 0xf5, 0xee, 0xc3, 0x2a, 0x4f, 0xc1, 0x56, 0xb4, 0x1c, 0xe1, 0x78, 0x85, 0x10, 0xcc, 0xa9,
+	0x1d, 0x69, 0x51, 0xe9, 0x9a, 0x52, 0x32, 0xd7, 0xc8, 0xda, 0xb0, 0x4e, 0xc8, 0x63, 0x95, 0xc1,
+	0x5d, 0xe3, 0x15, 0xb4, 0x2f, 0x52, 0x87, 0x2d, 0xd8, 0x88, 0x15, 0xc3, 0xcb, 0x7f, 0x5b, 0x94,
+	0x1f, 0xdb, 0xf9, 0xd3, 0x05, 0x9b, 0x28, 0x9f, 0xd8, 0x2e, 0x8c, 0xa2, 0xf2, 0x46, 0x8b, 0x02,
+	0x8b, 0x7e, 0x69, 0x01, 0x7b, 0x3e, 0x81, 0x9b, 0xe1, 0x05, 0x40, 0x29, 0xc2, 0x86, 0xdc, 0x50,
+	0x71, 0x77, 0x50, 0xba, 0xf6, 0xca, 0xda, 0xdb, 0x80, 0xcd, 0x78, 0x6d, 0x78, 0x45, 0xf7, 0x60,
+	0x75, 0xd7, 0x3e, 0x9d, 0x9f, 0x1f, 0xd8, 0xcf, 0xa2, 0x3a, 0x6a, 0x78, 0xe5, 0xd9, 0x7b, 0xce,
+	0x07, 0x9c, 0xfd, 0x66, 0x37, 0x71, 0x90, 0xc6, 0xf4, 0xa7, 0xf6, 0x50, 0x58, 0x26, 0x19, 0xa4,
+	0x3f, 0xb5, 0x87, 0xfa, 0x07, 0xa0, 0xc9, 0xf9, 0xf0, 0xb9, 0x8e, 0x8a, 0x9a, 0xf9, 0xa9, 0xe9,
+	0x5f, 0xfa, 0x81, 0x3d, 0x11, 0x51, 0x66, 0xc0, 0x9f, 0x9f, 0xf6, 0x09, 0xa2, 0xbf, 0x01, 0xd5,
+	0x63, 0x0b, 0xdf, 0xfc, 0xe1, 0x81, 0x5a, 0xd0, 0xda, 0x6f, 0x5d, 0xe2, 0x6e, 0x17, 0x7a, 0x45,
+	0x30, 0xb4, 0xfe, 0xdb, 0x79, 0x58, 0x22, 0x4a, 0x8c, 0x36, 0x3b, 0xb2, 0xfd, 0xc0, 0x71, 0xd9,
+	0x6e, 0x23, 0xf6, 0x7d, 0x09, 0x94, 0x98, 0x2d, 0xd9, 0xa4, 0x68, 0xc0, 0x2d, 0x2a, 0x22, 0x16,
+	0xbe, 0xb0, 0x5f, 0xbb, 0xf3, 0x89, 0x08, 0x80, 0xaf, 0x06, 0xf3, 0xcb, 0x47, 0x61, 0x3e, 0x19,
+	0x20, 0xe6, 0x61, 0x14, 0xa9, 0x83, 0xa8, 0x76, 0x42, 0xe2, 0xe1, 0x52, 0x81, 0x0c, 0x4a, 0xd5,
+	0x39, 0x15, 0x45, 0x6c, 0x24, 0x55, 0xe7, 0x94, 0xd0, 0x2d, 0x95, 0xae, 0xd6, 0x2d, 0x91, 0xa9,
+	0xe5, 0x25, 0xba, 0x25, 0xb8, 0x86, 0x6e, 0xe9, 0x1a, 0xde, 0x3d, 0x37, 0xa1, 0xc4, 0xc4, 0x58,
+	0x49, 0x48, 0x40, 0xf1, 0x15, 0x85, 0x84, 0x8f, 0x24, 0xed, 0x0b, 0xf9, 0x1d, 0x4a, 0xbb, 0xb4,
+	0x61, 0x7f, 0xff, 0xc7, 0xa3, 0xbd, 0x7f, 0x02, 0x45, 0x0e, 0xc5, 0x09, 0xed, 0x5a, 0x13, 0xf1,
+	0xe2, 0x0b, 0xfb, 0x8d, 0xdd, 0xc6, 0xde, 0x40, 0xf8, 0xfe, 0xdc, 0x99, 0xd9, 0x23, 0x11, 0x6c,
+	0xdd, 0xf1, 0x0d, 0x0e, 0xc1, 0x06, 0xa2, 0x26, 0xc8, 0x15, 0x6f, 0xcf, 0x61, 0x4c, 0x4e, 0x9f,
+	0x85, 0x92, 0xd5, 0x35, 0xa8, 0xb3, 0xf7, 0xb5, 0xa4, 0xe0, 0xbc, 0xec, 0x71, 0x25, 0xbe, 0xba,
+	0x42, 0x9c, 0x7c, 0x14, 0x2d, 0x2c, 0xf2, 0x84, 0x7b, 0x79, 0xe8, 0x74, 0x1d, 0x6a, 0x4c, 0xff,
+	0x1c, 0x0a, 0x64, 0xa4, 0x3f, 0xaf, 0x20, 0x70, 0x8f, 0x0b, 0x65, 0xaf, 0x40, 0x45, 0x5c, 0x2d,
+	0x9c, 0x38, 0x63, 0xf1, 0xca, 0x31, 0xdd, 0x2d, 0x3c, 0x74, 0xc6, 0x42, 0x9e, 0x9b, 0x89, 0xc8,
+	0x2a, 0x19, 0x26, 0xcf, 0x19, 0x2f, 0x75, 0x8a, 0x58, 0xfa, 0x61, 0x9d, 0x22, 0x8a, 0x2f, 0x77,
+	0x8a, 0xf8, 0x3d, 0x8c, 0x84, 0x60, 0xc7, 0xa3, 0xe2, 0x7e, 0x03, 0xaa, 0xe1, 0x63, 0x85, 0xb6,
+	0x1d, 0x8f, 0x88, 0x13, 0xef, 0x56, 0x0a, 0x6a, 0x42, 0x10, 0x1f, 0x5b, 0x3f, 0xb2, 0x2e, 0x59,
+	0x5d, 0xfc, 0xf9, 0x44, 0x1c, 0xe9, 0x47, 0xd6, 0x25, 0xde, 0x96, 0x9b, 0x4f, 0x50, 0x5d, 0xf9,
+	0xdc, 0xb6, 0x9f, 0x86, 0x04, 0xb4, 0x23, 0x02, 0xc2, 0x38, 0x05, 0xba, 0x97, 0xa0, 0x36, 0x3e,
+	0x24, 0xe1, 0xa7, 0x36, 0x06, 0x24, 0x1a, 0xfd, 0x97, 0x73, 0xb0, 0x46, 0x66, 0x15, 0x6e, 0x5c,
+	0xe3, 0xbc, 0xb2, 0x01, 0x4b, 0x64, 0xeb, 0x22, 0x6e, 0xb9, 0x7f, 0xc3, 0xe0, 0xdf, 0xda, 0xfb,
+	0xd7, 0x34, 0x05, 0x89, 0x28, 0x60, 0x0b, 0xc6, 0x3b, 0x97, 0x1c, 0xef, 0x97, 0x8c, 0x67, 0x8a,
+	0x6f, 0x4f, 0x21, 0xcd, 0xb7, 0xe7, 0x3a, 0x1e, 0x35, 0x89, 0xa8, 0x3b, 0xc5, 0xe4, 0xab, 0x31,
+	0x68, 0xc2, 0x95, 0x69, 0xd8, 0xf6, 0xe0, 0x9c, 0x39, 0xe1, 0x73, 0x67, 0xeb, 0x12, 0x75, 0x5f,
+	0xe0, 0xb4, 0x87, 0x50, 0x91, 0xe6, 0x10, 0xe3, 0x59, 0x91, 0x0d, 0xb5, 0x1b, 0x4d, 0x1d, 0x90,
+	0xe6, 0x5f, 0x11, 0x0a, 0xfe, 0xd0, 0x9b, 0xda, 0xba, 0x09, 0x10, 0x91, 0x24, 0x8d, 0x34, 0xfc,
+	0xc1, 0xe7, 0x6b, 0xb8, 0x22, 0x51, 0xb8, 0x98, 0x84, 0x2b, 0x12, 0x5e, 0x60, 0x51, 0x87, 0x9a,
+	0x6f, 0x96, 0xbf, 0x91, 0x81, 0xc6, 0x5e, 0xf4, 0x26, 0x90, 0xe3, 0x07, 0xde, 0x2c, 0x7c, 0xde,
+	0x0e, 0x83, 0xab, 0xb3, 0x77, 0xa7, 0x99, 0xb6, 0x91, 0x07, 0x7f, 0x65, 0x10, 0xa6, 0x6b, 0xbc,
+	0x09, 0x25, 0xdb, 0x1d, 0x11, 0x92, 0xa6, 0x68, 0x11, 0x1f, 0x7b, 0xe5, 0x9a, 0xca, 0x84, 0xc8,
+	0x56, 0x53, 0x85, 0x51, 0x1e, 0xc6, 0x10, 0x87, 0xcc, 0x7e, 0xc6, 0x44, 0xc7, 0x7c, 0x18, 0xc6,
+	0xf0, 0xd0, 0x7a, 0xc1, 0xee, 0x21, 0xf9, 0xfa, 0xdf, 0xcf, 0xc2, 0x4a, 0x54, 0x3f, 0x06, 0xd4,
+	0xee, 0x26, 0x42, 0xd2, 0x72, 0x5f, 0x49, 0x01, 0x44, 0x2d, 0x09, 0xe7, 0x51, 0xa6, 0xe3, 0x4a,
+	0x56, 0xb0, 0x12, 0xb1, 0xa9, 0xae, 0xab, 0xe9, 0x50, 0x11, 0x14, 0xde, 0x3c, 0x90, 0x9e, 0xe0,
+	0x29, 0x13, 0x49, 0x6f, 0xce, 0x24, 0x24, 0x54, 0x9c, 0x39, 0x2e, 0xd7, 0x65, 0x14, 0xac, 0x49,
+	0xd0, 0x65, 0x0f, 0x9c, 0x23, 0xd8, 0x9b, 0x8b, 0x19, 0x86, 0x54, 0x48, 0x5f, 0xa7, 0x83, 0x35,
+	0x4d, 0x29, 0xfc, 0xa9, 0x9c, 0x3a, 0x29, 0x60, 0x5f, 0x78, 0xea, 0x7c, 0x05, 0x2a, 0x94, 0x79,
+	0xe4, 0x77, 0xc5, 0x02, 0xdb, 0x07, 0x5d, 0x97, 0xe1, 0xb9, 0x45, 0x02, 0x5d, 0x2c, 0x24, 0x05,
+	0x29, 0x50, 0x51, 0xe2, 0x2d, 0xea, 0xb0, 0xc5, 0x66, 0xe8, 0x2f, 0x50, 0x09, 0x61, 0x47, 0x3e,
+	0xca, 0x6c, 0x37, 0x53, 0x46, 0x97, 0x73, 0xa8, 0x36, 0x48, 0x0f, 0x48, 0x89, 0x41, 0x20, 0x36,
+	0xb5, 0x29, 0xf6, 0x20, 0xb5, 0xeb, 0x8d, 0xfa, 0x99, 0x0a, 0x88, 0x14, 0x2e, 0x34, 0xd0, 0x4a,
+	0xe0, 0x3e, 0x26, 0xa1, 0xd3, 0x68, 0x93, 0xae, 0xe3, 0x5f, 0x64, 0xe0, 0x4e, 0xa2, 0x3a, 0x78,
+	0x5e, 0xf2, 0x7f, 0xf4, 0x19, 0xd7, 0x45, 0x25, 0x51, 0x60, 0xcf, 0x9e, 0x59, 0xb4, 0x5b, 0x2c,
+	0x87, 0x37, 0x17, 0x5f, 0x5a, 0xe2, 0x76, 0x97, 0x27, 0x32, 0xc2, 0xe4, 0xfa, 0x43, 0x28, 0x09,
+	0x28, 0xde, 0x26, 0x1d, 0xf4, 0x06, 0x2d, 0xbc, 0xdc, 0x56, 0x82, 0xfc, 0x7e, 0xef, 0xc4, 0xa8,
+	0x67, 0xb4, 0x22, 0xe4, 0x76, 0x5b, 0x4f, 0xea, 0x59, 0x04, 0x7d, 0xde, 0xe9, 0x3c, 0xae, 0xe7,
+	0xf4, 0x7f, 0x95, 0x91, 0xe7, 0x29, 0x2b, 0x41, 0x08, 0x64, 0xca, 0xb5, 0xd2, 0x3c, 0x13, 0xc8,
+	0xba, 0x1c, 0x14, 0x9f, 0x05, 0xd9, 0xf8, 0x2c, 0xe0, 0xdb, 0xa0, 0xc0, 0x13, 0x9f, 0xc7, 0x6d,
+	0x90, 0xe3, 0x79, 0x11, 0xe2, 0x7e, 0x82, 0xe0, 0xf2, 0xee, 0x7c, 0xd2, 0xe3, 0xa0, 0xc4, 0x44,
+	0x2a, 0x24, 0x26, 0x12, 0x06, 0xb9, 0xb1, 0xed, 0x88, 0x82, 0x66, 0x35, 0x5e, 0xed, 0xe7, 0x14,
+	0xfa, 0x6f, 0x65, 0xc3, 0x5b, 0x54, 0xf1, 0x46, 0x6e, 0xc5, 0x34, 0xd3, 0xb2, 0x28, 0x70, 0xf5,
+	0x53, 0x59, 0x6f, 0x91, 0x25, 0x42, 0xdc, 0xcb, 0x4f, 0x4e, 0x3b, 0x1a, 0x2b, 0x22, 0x42, 0x01,
+	0x95, 0x4c, 0xe8, 0xe2, 0xb9, 0x04, 0xfa, 0x8a, 0x3d, 0x35, 0x52, 0xf8, 0x61, 0x9f, 0x1a, 0x49,
+	0x79, 0x96, 0x65, 0x29, 0xed, 0x59, 0x96, 0xab, 0x5f, 0x55, 0xd1, 0x2f, 0x60, 0x0d, 0x43, 0x12,
+	0xc7, 0x3b, 0x2b, 0xd1, 0x27, 0x99, 0x97, 0xf5, 0x49, 0xf6, 0x1a, 0x7d, 0xa2, 0xff, 0x7e, 0x16,
+	0x36, 0x62, 0xa8, 0x9d, 0xf9, 0xf0, 0xa9, 0xfd, 0x23, 0x72, 0x6f, 0x9c, 0x55, 0x7c, 0xad, 0x87,
+	0x7a, 0x02, 0x77, 0x3e, 0xe1, 0x25, 0xf9, 0xf1, 0x89, 0x9b, 0xbf, 0x8a, 0x7d, 0x25, 0x67, 0x9d,
+	0xcc, 0x1b, 0x97, 0x54, 0xde, 0xf8, 0x89, 0xe4, 0x83, 0x5d, 0x54, 0xee, 0x05, 0xa4, 0x4f, 0x42,
+	0x29, 0x9c, 0xe0, 0x03, 0x11, 0x2e, 0xbc, 0xa4, 0xb8, 0x12, 0xa5, 0x0c, 0x86, 0x88, 0x1e, 0xfe,
+	0x5d, 0x78, 0x65, 0x11, 0x87, 0x08, 0x1f, 0xbe, 0x28, 0x9e, 0xb2, 0x2e, 0x15, 0xdc, 0xf1, 0x76,
+	0xfa, 0x90, 0x50, 0xbf, 0x1b, 0x82, 0x58, 0x3f, 0x86, 0x66, 0xe7, 0x05, 0x0a, 0x77, 0xe1, 0x05,
+	0xc4, 0xe1, 0xd3, 0xb9, 0xf0, 0xdc, 0x8a, 0xf9, 0x7f, 0x64, 0xae, 0xe5, 0xff, 0x31, 0x82, 0x9a,
+	0x92, 0xd7, 0x0f, 0x93, 0x09, 0x59, 0xad, 0x2c, 0xbc, 0xd1, 0x88, 0x59, 0x88, 0x60, 0xa4, 0x08,
+	0xa2, 0x4c, 0x75, 0x1f, 0x56, 0x0e, 0xe7, 0xe3, 0xc0, 0x69, 0x87, 0x20, 0xed, 0x7d, 0xa8, 0x44,
+	0xe5, 0x88, 0x6e, 0x48, 0x2d, 0x08, 0xc2, 0x82, 0xd8, 0xde, 0x30, 0xc1, 0x8c, 0xcc, 0x64, 0x79,
+	0x2b, 0x13, 0xb5, 0x04, 0xfd, 0x26, 0x6c, 0x45, 0x5f, 0xd4, 0x6d, 0xe2, 0x18, 0xf2, 0xd7, 0x33,
+	0xa0, 0x45, 0xb8, 0xbe, 0x6b, 0x4d, 0xfd, 0x0b, 0x2f, 0xd0, 0x3a, 0xb0, 0x86, 0x8b, 0x72, 0x6c,
+	0xcb, 0xd9, 0xfb, 0xbc, 0x13, 0x36, 0xd4, 0xba, 0x51, 0x52, 0xdf, 0x58, 0xa5, 0x14, 0x51, 0x6e,
+	0xbe, 0xb6, 0xb3, 0xa8, 0x92, 0xd1, 0xd2, 0x8b, 0xf5, 0x46, 0xb2, 0xf2, 0x5d, 0x58, 0x56, 0x0b,
+	0x42, 0x17, 0xe1, 0x58, 0xad, 0x72, 0xb1, 0x78, 0x6e, 0xd1, 0x84, 0xa8, 0x44, 0x7d, 0xef, 0xeb,
+	0x7f, 0x31, 0x03, 0x0d, 0xc3, 0xc6, 0x59, 0x28, 0xd5, 0x52, 0xcc, 0x99, 0x6f, 0x24, 0x72, 0x5d,
+	0xdc, 0x56, 0x11, 0x28, 0x51, 0xd4, 0xe8, 0xad, 0x85, 0x83, 0x81, 0xb7, 0xcd, 0x63, 0x2d, 0xc2,
+	0xd0, 0x85, 0x44, 0x82, 0x0a, 0x21, 0x5e, 0x1f, 0x51, 0x97, 0xc8, 0xd5, 0x50, 0x29, 0x51, 0x71,
+	0x35, 0x6c, 0x42, 0x83, 0x42, 0x8a, 0xc9, 0x8d, 0xe0, 0x09, 0x77, 0x41, 0x3b, 0xb4, 0x86, 0xd6,
+	0xcc, 0xf3, 0xdc, 0x63, 0x7b, 0xc6, 0xaf, 0x16, 0x32, 0xed, 0x03, 0xf3, 0xc4, 0x13, 0x6a, 0x12,
+	0xfa, 0x12, 0x4f, 0x31, 0x7a, 0xae, 0xb8, 0x2c, 0x41, 0x5f, 0xfa, 0x0c, 0xd6, 0x76, 0xac, 0xa7,
+	0xb6, 0xc8, 0x49, 0x74, 0x11, 0x86, 0x3f, 0x0b, 0x33, 0x15, 0xfd, 0x2e, 0xe2, 0x15, 0x27, 0x8b,
+	0x35, 0x64, 0x6a, 0xe4, 0x6a, 0xcc, 0x0c, 0xcc, 0xc2, 0x2f, 0x8e, 0xc4, 0x76, 0x8c, 0xa0, 0xc7,
+	0xf6, 0x65, 0x77, 0xa4, 0x3f, 0x84, 0x75, 0xb5, 0x4c, 0xce, 0x21, 0x9a, 0x50, 0x9a, 0x70, 0x18,
+	0xaf, 0x7d, 0xf8, 0x8d, 0x8a, 0x2a, 0x54, 0x9a, 0x8a, 0x34, 0xdd, 0xdd, 0x30, 0x3a, 0xd8, 0xa7,
+	0xb0, 0x95, 0xc0, 0xf0, 0x0c, 0xef, 0x42, 0x55, 0xaa, 0x08, 0x35, 0x03, 0x5f, 0x79, 0x17, 0x35,
+	0xf1, 0xf5, 0x4f, 0x60, 0x8b, 0xf4, 0x74, 0x51, 0x72, 0xd1, 0x05, 0xb1, 0x56, 0x64, 0xe2, 0xad,
+	0x78, 0x1f, 0x1a, 0xc9, 0xa4, 0xd1, 0x2b, 0x04, 0x23, 0x86, 0x13, 0xee, 0xe7, 0xe2, 0x53, 0x3f,
+	0x81, 0xcd, 0x64, 0xf7, 0x1d, 0x38, 0x3f, 0x62, 0x97, 0x8b, 0xee, 0x89, 0xd0, 0x61, 0xf7, 0xfc,
+	0xbb, 0x0c, 0x6c, 0x25, 0x50, 0xbc, 0x9a, 0x23, 0xd0, 0x26, 0x76, 0x70, 0xe1, 0x8d, 0xcc, 0x64,
+	0xc9, 0x1f, 0x84, 0xde, 0xef, 0xa9, 0x69, 0xb7, 0x0f, 0x59, 0x42, 0x09, 0xc3, 0x6f, 0x85, 0x4e,
+	0xe2, 0xf0, 0xe6, 0x10, 0x36, 0xd3, 0x89, 0x53, 0x7c, 0xc6, 0xdf, 0x53, 0x95, 0x38, 0x77, 0x16,
+	0x36, 0x1f, 0xab, 0x25, 0xeb, 0x74, 0x7e, 0xbb, 0x04, 0x45, 0x6e, 0x84, 0xc0, 0x97, 0x28, 0x86,
+	0xe2, 0xc2, 0x53, 0xf4, 0x12, 0x05, 0xc7, 0x8a, 0xff, 0x6d, 0x76, 0xed, 0x09, 0xe9, 0xd0, 0x95,
+	0x52, 0x75, 0xc1, 0x8d, 0x05, 0xd6, 0x54, 0x7d, 0x67, 0x6b, 0xc3, 0x98, 0x7b, 0x63, 0x39, 0x3a,
+	0x07, 0xd3, 0x06, 0x5e, 0xba, 0x90, 0x0e, 0xca, 0x9e, 0x8b, 0xba, 0x3c, 0xff, 0xc2, 0x32, 0x1f,
+	0x7e, 0xf0, 0x21, 0x77, 0x55, 0xa8, 0x30, 0x60, 0xff, 0xc2, 0x7a, 0xf8, 0xc1, 0x87, 0x71, 0x2d,
+	0x1d, 0x8f, 0xab, 0x29, 0x69, 0xe9, 0x30, 0x1e, 0x3b, 0x7b, 0xaf, 0x92, 0x24, 0x24, 0xfa, 0x10,
+	0xba, 0x78, 0x34, 0x7b, 0xf1, 0x0b, 0xc8, 0x74, 0x68, 0x28, 0x31, 0x22, 0x8d, 0xe3, 0xfa, 0x0c,
+	0x45, 0x86, 0xb2, 0x4d, 0x58, 0xba, 0x88, 0x1e, 0x20, 0xad, 0x19, 0xfc, 0x4b, 0xff, 0xaf, 0x05,
+	0xa8, 0x48, 0x9d, 0x82, 0x0e, 0x3e, 0x46, 0xa7, 0xdf, 0x31, 0x3e, 0xeb, 0xec, 0xd6, 0x6f, 0x68,
+	0xf7, 0xe0, 0xf5, 0xee, 0x51, 0xbb, 0x67, 0x18, 0x9d, 0xf6, 0xc0, 0xec, 0x19, 0xa6, 0x78, 0x20,
+	0xe5, 0xb8, 0xf5, 0xe4, 0xb0, 0x73, 0x34, 0x30, 0x77, 0x3b, 0x83, 0x56, 0xf7, 0xa0, 0x5f, 0xcf,
+	0x68, 0xb7, 0xa1, 0x11, 0x51, 0x0a, 0x74, 0xeb, 0xb0, 0x77, 0x72, 0x34, 0xa8, 0x67, 0xb5, 0x57,
+	0xe1, 0xd6, 0x5e, 0xf7, 0xa8, 0x75, 0x60, 0x46, 0x34, 0xed, 0x83, 0xc1, 0x67, 0xdc, 0x85, 0xa0,
+	0x9e, 0x4b, 0x23, 0x40, 0x43, 0x90, 0xc8, 0x21, 0x8f, 0xbe, 0x0a, 0x44, 0x10, 0xf7, 0x3a, 0x28,
+	0x68, 0xab, 0x50, 0xeb, 0x1e, 0x7d, 0xd6, 0x3a, 0xe8, 0xee, 0x9a, 0x46, 0xa7, 0x75, 0x70, 0x58,
+	0x5f, 0x4a, 0x73, 0x77, 0x28, 0x62, 0x16, 0x82, 0xae, 0x77, 0xd4, 0xed, 0x1d, 0x99, 0x9f, 0x75,
+	0x8c, 0x7e, 0xb7, 0x77, 0x54, 0x2f, 0xe1, 0xd3, 0x71, 0x2a, 0x6a, 0xff, 0xb0, 0xd5, 0xae, 0x97,
+	0xf1, 0xa5, 0x39, 0x15, 0xfe, 0xb8, 0xf3, 0xa4, 0x0e, 0xe8, 0x01, 0x41, 0x15, 0x33, 0x77, 0x3a,
+	0x07, 0xbd, 0xcf, 0xcd, 0xc3, 0xee, 0x51, 0xf7, 0xf0, 0xe4, 0xb0, 0x5e, 0x61, 0x2f, 0xcb, 0x75,
+	0x3a, 0x66, 0xf7, 0xa8, 0x7f, 0xb2, 0xb7, 0xd7, 0x6d, 0x77, 0xf1, 0xc1, 0xb8, 0x2a, 0x95, 0x9c,
+	0xd6, 0xf0, 0x1a, 0x26, 0xe0, 0x31, 0x3a, 0xcc, 0xdd, 0x6e, 0xbf, 0xb5, 0x83, 0xf6, 0xac, 0x65,
+	0xed, 0x0e, 0xdc, 0x1c, 0x74, 0x0e, 0x8f, 0x7b, 0x46, 0xcb, 0x78, 0x22, 0x62, 0x78, 0x98, 0x68,
+	0xed, 0x3a, 0x31, 0x3a, 0xf5, 0x15, 0xed, 0x35, 0xb8, 0x63, 0x74, 0xbe, 0x73, 0xd2, 0x35, 0x3a,
+	0xbb, 0xe6, 0x51, 0x6f, 0xb7, 0x63, 0xee, 0x75, 0x5a, 0x83, 0x13, 0xa3, 0x63, 0x72, 0x77, 0x8e,
+	0x7a, 0x5d, 0x7b, 0x1d, 0xee, 0x86, 0x24, 0x61, 0x06, 0x31, 0xaa, 0x55, 0x6c, 0x9f, 0x18, 0xd2,
+	0xa3, 0xce, 0x77, 0x07, 0x26, 0xbe, 0xba, 0x52, 0xd7, 0xd0, 0xfb, 0x23, 0x2a, 0x9e, 0x0a, 0xe0,
+	0x65, 0xaf, 0x21, 0xee, 0xb8, 0x63, 0x1c, 0xb6, 0x8e, 0x70, 0x80, 0x15, 0xdc, 0x3a, 0x56, 0x3b,
+	0xc2, 0xc5, 0xab, 0xbd, 0x81, 0x61, 0x4c, 0xa4, 0x51, 0xd9, 0x6b, 0x19, 0xf5, 0x4d, 0x7c, 0xfb,
+	0xe5, 0xf0, 0xf8, 0xd8, 0x1c, 0x74, 0x0f, 0x3b, 0xbd, 0x93, 0x41, 0x7d, 0x2b, 0x39, 0x4a, 0xc7,
+	0xad, 0x27, 0x07, 0xbd, 0xd6, 0x6e, 0xbd, 0xa1, 0x6d, 0x60, 0xc8, 0x93, 0x41, 0xc7, 0x38, 0x6a,
+	0x45, 0xb9, 0xfe, 0xfb, 0xa2, 0xb6, 0x0e, 0x2b, 0xa2, 0x11, 0x02, 0xfa, 0x47, 0x45, 0x6d, 0x0b,
+	0xb4, 0x93, 0x23, 0xa3, 0xd3, 0xda, 0xc5, 0x3e, 0x0d, 0x11, 0xff, 0xa1, 0xc8, 0x9d, 0xd6, 0x7e,
+	0x27, 0x17, 0xca, 0x81, 0x91, 0xeb, 0xbb, 0xfa, 0x98, 0x78, 0x55, 0x7a, 0x04, 0x3c, 0xf6, 0x1c,
+	0x25, 0x09, 0x60, 0xd2, 0x73, 0x94, 0x92, 0x46, 0x37, 0x97, 0xd0, 0xe8, 0x26, 0x4c, 0x06, 0x35,
+	0x59, 0xd1, 0xf2, 0x15, 0xa8, 0x4d, 0xe8, 0x61, 0x71, 0xfe, 0x32, 0x2d, 0xf0, 0x7b, 0x20, 0x04,
+	0xa4, 0x67, 0x69, 0x25, 0xa5, 0x30, 0x11, 0x91, 0xfa, 0x4e, 0xa8, 0x47, 0x89, 0x28, 0x45, 0xcb,
+	0xb7, 0x94, 0xa6, 0xe5, 0xbb, 0x0f, 0xab, 0xc4, 0xb5, 0x1c, 0xd7, 0x99, 0x08, 0x65, 0x3d, 0xa9,
+	0x5c, 0x56, 0x18, 0xf7, 0x22, 0xb8, 0x38, 0x62, 0x08, 0xc5, 0x23, 0xe7, 0x2e, 0x45, 0xae, 0x73,
+	0x54, 0xf4, 0x8d, 0xc4, 0x54, 0x42, 0x7d, 0x63, 0x58, 0x82, 0xf5, 0x22, 0x2a, 0xa1, 0x22, 0x95,
+	0x60, 0xbd, 0x08, 0x4b, 0xb8, 0x8f, 0x41, 0x9e, 0x82, 0x99, 0x65, 0x7a, 0x53, 0xeb, 0xfb, 0x73,
+	0xe6, 0xbc, 0x6b, 0x31, 0xd3, 0x41, 0xd5, 0x58, 0x61, 0x88, 0x1e, 0x83, 0xef, 0x5a, 0x81, 0xa5,
+	0xff, 0x34, 0x40, 0xb8, 0xe1, 0xe2, 0x25, 0xef, 0x82, 0xeb, 0x89, 0xd8, 0x23, 0x55, 0x83, 0x3e,
+	0xd8, 0x38, 0x06, 0xde, 0xcc, 0x3a, 0xb7, 0xbb, 0xc2, 0xd7, 0x2c, 0x02, 0x68, 0xb7, 0x20, 0xe7,
+	0x4d, 0xc5, 0x2d, 0x89, 0xb2, 0x78, 0x33, 0x71, 0x6a, 0x20, 0x54, 0xff, 0x10, 0xb2, 0xbd, 0xe9,
+	0x42, 0x29, 0x8a, 0x3d, 0x7b, 0x4f, 0x6f, 0xa5, 0x65, 0xd9, 0xcd, 0x08, 0xf1, 0x79, 0xff, 0xff,
+	0x87, 0x8a, 0xf4, 0x4a, 0xbe, 0xb6, 0x05, 0x6b, 0x9f, 0x77, 0x07, 0x47, 0x9d, 0x7e, 0xdf, 0x3c,
+	0x3e, 0xd9, 0x79, 0xdc, 0x79, 0x62, 0xee, 0xb7, 0xfa, 0xfb, 0xf5, 0x1b, 0xc8, 0x66, 0x8e, 0x3a,
+	0xfd, 0x41, 0x67, 0x57, 0x81, 0x67, 0xb4, 0x57, 0xa0, 0x79, 0x72, 0x74, 0x82, 0x31, 0x7f, 0xd2,
+	0xd2, 0x65, 0x71, 0x5d, 0x71, 0x7c, 0x4a, 0xf2, 0xdc, 0xfd, 0x9f, 0x81, 0x65, 0x35, 0x74, 0x22,
+	0xda, 0xc6, 0x0f, 0x3a, 0x8f, 0x5a, 0xed, 0x27, 0xf4, 0x5a, 0x66, 0x7f, 0xd0, 0x1a, 0x74, 0xdb,
+	0x26, 0x7f, 0x1d, 0x13, 0x79, 0x58, 0x06, 0x9d, 0x1c, 0x5a, 0x47, 0xed, 0xfd, 0x9e, 0xd1, 0xaf,
+	0x67, 0xb5, 0xdb, 0xb0, 0x25, 0x96, 0x50, 0xbb, 0x77, 0x78, 0xd8, 0x1d, 0x30, 0xf6, 0x3d, 0x78,
+	0x72, 0x8c, 0x2b, 0xe6, 0xbe, 0x05, 0xe5, 0xe8, 0x61, 0x4f, 0xc6, 0x12, 0xbb, 0x83, 0x6e, 0x6b,
+	0x10, 0xed, 0x07, 0xe4, 0x60, 0x16, 0x81, 0xd9, 0xeb, 0x9c, 0xf5, 0x0c, 0xc5, 0x28, 0x12, 0x40,
+	0x2a, 0xbd, 0x9e, 0x45, 0x36, 0x10, 0x41, 0x77, 0x7a, 0x03, 0x6c, 0xc2, 0x18, 0x96, 0xd5, 0x57,
+	0x32, 0x31, 0x32, 0x12, 0x96, 0x2f, 0x15, 0x01, 0xb0, 0x44, 0x35, 0xae, 0x67, 0x88, 0xe7, 0xb7,
+	0x7b, 0x87, 0xe8, 0xbb, 0x86, 0x1b, 0x45, 0x3d, 0x8b, 0xa0, 0xde, 0xc9, 0xe0, 0x51, 0x2f, 0x04,
+	0xe5, 0x30, 0x05, 0x35, 0xa7, 0x9e, 0xc7, 0xdf, 0xf4, 0x0a, 0x68, 0xbd, 0x70, 0xff, 0xfb, 0xb0,
+	0x9a, 0x78, 0x5b, 0x13, 0x5b, 0xd0, 0x3b, 0x19, 0xb4, 0x7b, 0x87, 0x72, 0x99, 0x15, 0x28, 0xb6,
+	0x0f, 0x5a, 0xdd, 0x43, 0xe6, 0x71, 0x50, 0x83, 0xf2, 0xc9, 0x91, 0xf8, 0xcc, 0xaa, 0xef, 0x85,
+	0xe6, 0x90, 0x93, 0xed, 0x75, 0x8d, 0xfe, 0xc0, 0xec, 0x0f, 0x5a, 0x8f, 0x3a, 0xf5, 0x3c, 0xa6,
+	0x15, 0x6c, 0xad, 0x70, 0xff, 0x13, 0x58, 0x56, 0xaf, 0xf7, 0xa9, 0x5e, 0x26, 0x4d, 0xd8, 0xdc,
+	0xe9, 0x0c, 0x3e, 0xef, 0x74, 0x8e, 0xd8, 0xf0, 0xb7, 0x3b, 0x47, 0x03, 0xa3, 0x75, 0xd0, 0x1d,
+	0x3c, 0xa9, 0x67, 0xee, 0x7f, 0x0a, 0xf5, 0xb8, 0x5b, 0xa9, 0xe2, 0x87, 0xfb, 0x32, 0x87, 0xdd,
+	0xfb, 0xff, 0x32, 0x03, 0xeb, 0x69, 0x4e, 0x30, 0x38, 0x49, 0x39, 0x53, 0xc4, 0x5d, 0xb3, 0xdf,
+	0x3b, 0x32, 0x8f, 0x7a, 0xec, 0xf1, 0xad, 0x26, 0x6c, 0xc6, 0x10, 0xa2, 0x15, 0x19, 0xed, 0x16,
+	0x6c, 0x25, 0x12, 0x99, 0x46, 0xef, 0x84, 0x8d, 0x2b, 0x3e, 0x98, 0xaa, 0x22, 0x3b, 0x86, 0xd1,
+	0x33, 0xea, 0x39, 0xed, 0x2d, 0xb8, 0x17, 0xc3, 0x24, 0x65, 0x05, 0x21, 0x4a, 0xe4, 0xb5, 0x37,
+	0xe0, 0x2b, 0x09, 0xea, 0x68, 0x3b, 0x35, 0x77, 0x5a, 0x07, 0xd8, 0xbc, 0x7a, 0xe1, 0xfe, 0x7f,
+	0xce, 0x03, 0x44, 0xd1, 0x3c, 0xb0, 0xfc, 0xdd, 0xd6, 0xa0, 0x75, 0xd0, 0xc3, 0xf5, 0x63, 0xf4,
+	0x06, 0x98, 0xbb, 0xd1, 0xf9, 0x4e, 0xfd, 0x46, 0x2a, 0xa6, 0x77, 0x8c, 0x0d, 0xda, 0x82, 0x35,
+	0x9a, 0x8b, 0x07, 0xd8, 0x0c, 0x9c, 0x3a, 0xf4, 0x8e, 0x1b, 0x0a, 0x24, 0x27, 0xc7, 0x7b, 0x46,
+	0xef, 0x68, 0x60, 0xf6, 0xf7, 0x4f, 0x06, 0xbb, 0xec, 0x59, 0xb8, 0xb6, 0xd1, 0x3d, 0xa6, 0x3c,
+	0xf3, 0x2f, 0x23, 0xc0, 0xac, 0x0b, 0xb8, 0xd8, 0x1f, 0xf5, 0xfa, 0xfd, 0xee, 0xb1, 0xf9, 0x9d,
+	0x93, 0x8e, 0xd1, 0xed, 0xf4, 0x59, 0xc2, 0xa5, 0x14, 0x38, 0xd2, 0x17, 0x71, 0xfe, 0x0e, 0x0e,
+	0x3e, 0xe3, 0x9b, 0x1e, 0x92, 0x96, 0x54, 0x10, 0x52, 0x95, 0x71, 0x74, 0x70, 0xa3, 0x4e, 0xc9,
+	0x19, 0x16, 0xe0, 0x30, 0x5d, 0x05, 0xb7, 0xd5, 0x04, 0x17, 0x60, 0xc9, 0xaa, 0xe9, 0x28, 0x4c,
+	0xc5, 0xa4, 0x93, 0x50, 0x96, 0xdb, 0xdd, 0x35, 0x58, 0x82, 0xe5, 0x04, 0x14, 0x69, 0x57, 0x70,
+	0x12, 0xe2, 0x4e, 0x8e, 0x24, 0x75, 0xf1, 0x81, 0x98, 0x55, 0x6c, 0xf1, 0xe7, 0x27, 0x87, 0x3b,
+	0x3d, 0x21, 0x12, 0x50, 0x7d, 0xb5, 0x14, 0x38, 0xd2, 0xaf, 0xb1, 0x77, 0xf7, 0x88, 0x35, 0x31,
+	0xc2, 0x75, 0x19, 0x80, 0x14, 0x1b, 0xc8, 0x10, 0x05, 0xe0, 0x7b, 0x1d, 0xa3, 0x67, 0xa2, 0xcc,
+	0xc5, 0xe4, 0x45, 0xa4, 0xdf, 0x5c, 0x8c, 0xc6, 0xd4, 0x5b, 0x4c, 0xbc, 0x31, 0x5a, 0x87, 0xc7,
+	0x3d, 0x7c, 0x5c, 0x8e, 0x4d, 0x5f, 0x1c, 0x78, 0x4c, 0xfa, 0xf1, 0x02, 0x1c, 0xa6, 0xfb, 0xe4,
+	0xe1, 0xff, 0x7c, 0x1d, 0xca, 0xe1, 0x7d, 0x60, 0xed, 0xdb, 0x50, 0x53, 0x82, 0x7f, 0x69, 0xb7,
+	0xd2, 0x43, 0x82, 0xb1, 0x93, 0x58, 0xf3, 0xf6, 0xcb, 0xe2, 0x85, 0x69, 0x87, 0x92, 0xf2, 0x83,
+	0x32, 0xbb, 0x1d, 0x57, 0x48, 0x28, 0xb9, 0xdd, 0x59, 0x80, 0xe5, 0xd9, 0x3d, 0x66, 0x4f, 0xe3,
+	0xb1, 0x58, 0xea, 0x7c, 0x8b, 0xd2, 0xee, 0x44, 0xef, 0x94, 0xc9, 0x70, 0x91, 0xa1, 0x38, 0x6a,
+	0x4a, 0xb8, 0x5d, 0x3b, 0xb0, 0x9c, 0xb1, 0xaf, 0xed, 0x42, 0xa5, 0xe3, 0x07, 0xce, 0xc4, 0x0a,
+	0x68, 0xd7, 0xe7, 0x94, 0x12, 0x4c, 0x64, 0xd2, 0x4c, 0x43, 0xf1, 0x2a, 0x7d, 0x13, 0xca, 0x7d,
+	0xdb, 0x1d, 0xb5, 0x3d, 0xc7, 0xf5, 0x35, 0x61, 0xc1, 0x0d, 0x21, 0x22, 0x87, 0x46, 0x12, 0xc1,
+	0xd3, 0xef, 0x42, 0x05, 0x4f, 0x7d, 0x27, 0x2e, 0x7b, 0x7c, 0x37, 0xac, 0x85, 0x04, 0x8b, 0xd7,
+	0x42, 0x41, 0xf1, 0x5c, 0x0e, 0x60, 0x83, 0xab, 0x58, 0x4e, 0xed, 0x2f, 0xd2, 0x3d, 0x5a, 0xb2,
+	0x7b, 0x1e, 0x64, 0xf0, 0x4a, 0x22, 0x56, 0xf4, 0xd0, 0x72, 0x2f, 0xb5, 0x4d, 0xa9, 0xe6, 0x08,
+	0x10, 0x29, 0xb7, 0x12, 0x70, 0x5e, 0x95, 0x16, 0xc0, 0x91, 0xfd, 0x3c, 0x0c, 0xde, 0x20, 0xee,
+	0x32, 0x86, 0xa0, 0xf8, 0xc8, 0xc8, 0x98, 0xa8, 0x4f, 0xfa, 0xce, 0xb9, 0x7b, 0x48, 0x82, 0x62,
+	0xd8, 0x27, 0x12, 0x2c, 0xde, 0x27, 0x0a, 0x8a, 0xe7, 0xf2, 0x6d, 0xa8, 0x91, 0x9a, 0x49, 0xe4,
+	0x23, 0xe6, 0xb1, 0x02, 0x8d, 0xcf, 0xe3, 0x18, 0x32, 0xaa, 0x51, 0x9b, 0x2e, 0x06, 0xb2, 0x57,
+	0x56, 0x45, 0x8d, 0x24, 0x58, 0xbc, 0x46, 0x0a, 0x2a, 0x5a, 0x0d, 0xbb, 0x8e, 0x3f, 0x94, 0x32,
+	0x12, 0xa5, 0xaa, 0xe0, 0xf8, 0x6a, 0x88, 0x63, 0xa3, 0xa9, 0x17, 0xbe, 0x89, 0x19, 0x4e, 0xbd,
+	0xf8, 0xe3, 0x9a, 0xcd, 0x46, 0x12, 0xc1, 0xd3, 0x3f, 0x82, 0xb5, 0x70, 0xd2, 0x84, 0x2f, 0x5a,
+	0xfa, 0x61, 0x9d, 0x52, 0xdf, 0xcd, 0x6c, 0xd6, 0xe3, 0xd8, 0x07, 0x19, 0xed, 0x63, 0x28, 0xf2,
+	0x67, 0x02, 0xb5, 0x8d, 0xf8, 0xb3, 0x81, 0x54, 0x89, 0xcd, 0xf4, 0xd7, 0x04, 0xb5, 0x63, 0xb6,
+	0xa0, 0xe5, 0x77, 0xfc, 0xe4, 0x19, 0x9b, 0xf2, 0xf4, 0x5f, 0xf3, 0x95, 0x45, 0xe8, 0x28, 0xc7,
+	0xf8, 0xdb, 0x93, 0x77, 0x16, 0x85, 0x29, 0x55, 0x73, 0x5c, 0x14, 0x8c, 0xff, 0x11, 0x54, 0xb1,
+	0xef, 0xc2, 0xec, 0xe4, 0x75, 0x18, 0xcf, 0xeb, 0x56, 0x2a, 0x8e, 0x67, 0xf4, 0x19, 0x6c, 0x86,
+	0xfd, 0x2d, 0x47, 0xd4, 0xf4, 0xb5, 0x57, 0x53, 0xe2, 0x6c, 0x2a, 0xbd, 0x7e, 0x73, 0x61, 0x20,
+	0xce, 0x07, 0x19, 0xc6, 0x64, 0x95, 0x67, 0xb9, 0x23, 0x26, 0x9b, 0xf6, 0x7e, 0x79, 0xf3, 0xce,
+	0x02, 0x2c, 0xaf, 0xe6, 0x13, 0xf5, 0x39, 0x3f, 0xee, 0xda, 0x73, 0x37, 0xc5, 0x66, 0xa6, 0x78,
+	0x04, 0x35, 0x5f, 0x7b, 0x09, 0x45, 0xc8, 0x1b, 0x56, 0xa4, 0x60, 0xa3, 0xf8, 0x42, 0x6d, 0xb8,
+	0x94, 0x92, 0x6f, 0x46, 0x35, 0xd3, 0x6c, 0x08, 0x5a, 0x1b, 0x2a, 0x12, 0xe9, 0xcb, 0x92, 0x6f,
+	0x49, 0x28, 0xf9, 0x45, 0xa1, 0x07, 0x19, 0xad, 0x9f, 0xf2, 0xf8, 0xd3, 0x2b, 0x8b, 0xde, 0xa3,
+	0xe2, 0xd9, 0xbd, 0xba, 0x10, 0x1f, 0xf2, 0xe0, 0x7a, 0xfc, 0x45, 0x8b, 0x90, 0xe5, 0xa4, 0xbd,
+	0x02, 0xd2, 0x8c, 0x21, 0x95, 0x77, 0x30, 0x70, 0x1e, 0xf3, 0x02, 0x5a, 0xec, 0x92, 0x97, 0x37,
+	0x8b, 0x6f, 0x9d, 0x04, 0x17, 0xc5, 0x37, 0x6f, 0xa5, 0x63, 0x59, 0xe5, 0xef, 0x65, 0x1e, 0x64,
+	0xb4, 0x3d, 0xa8, 0x2a, 0xb1, 0xd6, 0x95, 0xab, 0xf4, 0xb1, 0xc6, 0x2a, 0xef, 0x6d, 0xc7, 0x3a,
+	0xef, 0x10, 0x96, 0x55, 0xef, 0xca, 0xb0, 0x62, 0xa9, 0x2e, 0xa0, 0xcd, 0x3b, 0x0b, 0xb0, 0xe1,
+	0xab, 0x51, 0x15, 0xdc, 0x43, 0xc4, 0x3d, 0x07, 0x4d, 0xda, 0x57, 0xe2, 0x13, 0x81, 0x60, 0xdc,
+	0x52, 0x90, 0xfb, 0xe5, 0x6c, 0x86, 0xb5, 0xeb, 0x1b, 0xb0, 0x22, 0x65, 0xc0, 0x26, 0xd5, 0x75,
+	0x33, 0xd1, 0xf6, 0xa8, 0xf0, 0x81, 0x47, 0x21, 0xb9, 0x6e, 0x4a, 0x34, 0x1c, 0x76, 0xbd, 0x3a,
+	0xb4, 0x60, 0x45, 0x4a, 0xa3, 0x4c, 0xec, 0x6b, 0xe6, 0xa5, 0x7d, 0x04, 0x10, 0x5d, 0x31, 0xd2,
+	0x62, 0xb7, 0x58, 0x42, 0x06, 0x90, 0x72, 0x0b, 0xa9, 0x43, 0xfc, 0x29, 0xbc, 0x46, 0x23, 0x8b,
+	0x10, 0xaa, 0x5f, 0x6f, 0xb3, 0x99, 0x86, 0xe2, 0xd9, 0xbc, 0x07, 0xb5, 0x03, 0xcf, 0x7b, 0x3a,
+	0x9f, 0x8a, 0x2a, 0x68, 0xaa, 0x9f, 0x36, 0xaa, 0x83, 0x9a, 0xb1, 0x6a, 0xe1, 0x1e, 0xab, 0x78,
+	0x04, 0x87, 0x13, 0x3e, 0xcd, 0xad, 0xb8, 0x79, 0x3b, 0x1d, 0x19, 0x32, 0x87, 0xd5, 0x90, 0x3d,
+	0x46, 0x77, 0x82, 0xd4, 0x02, 0x15, 0xa6, 0x18, 0xab, 0xcc, 0x83, 0x8c, 0xf6, 0x10, 0xaa, 0xbb,
+	0xf6, 0x90, 0x85, 0x20, 0x64, 0xbe, 0xb3, 0x6b, 0x8a, 0x1f, 0x26, 0x39, 0xdd, 0x36, 0x6b, 0x0a,
+	0x50, 0xb0, 0xf7, 0xc8, 0xcb, 0x5d, 0xde, 0x2f, 0x55, 0x57, 0xf1, 0xe6, 0xad, 0x54, 0x5c, 0xc8,
+	0xde, 0x57, 0x13, 0x9e, 0xde, 0x21, 0x67, 0x5f, 0xe4, 0x7d, 0xde, 0xbc, 0xbb, 0x98, 0x20, 0x92,
+	0x63, 0x14, 0xef, 0xed, 0x58, 0x1f, 0xab, 0xae, 0xe3, 0xcd, 0xdb, 0xe9, 0x48, 0x9e, 0xd7, 0xb7,
+	0x30, 0x2f, 0xea, 0x62, 0x8a, 0x0e, 0x14, 0x0b, 0x73, 0x2d, 0x87, 0x1e, 0x6a, 0xae, 0xa5, 0xe0,
+	0xb4, 0x47, 0xec, 0xc1, 0x6a, 0x29, 0xf6, 0x4e, 0x38, 0xdf, 0x92, 0xf1, 0x80, 0x9a, 0xcd, 0x34,
+	0x14, 0xaf, 0xca, 0x27, 0x50, 0x79, 0x64, 0x07, 0x22, 0x9a, 0x4d, 0x28, 0x67, 0xc6, 0xc2, 0xdb,
+	0x34, 0x53, 0x62, 0x10, 0x69, 0x1f, 0xb2, 0xa4, 0x61, 0x28, 0xb8, 0x4d, 0xa9, 0x14, 0x39, 0xe9,
+	0x4a, 0x0c, 0x8e, 0x52, 0x9c, 0x14, 0x10, 0x32, 0xac, 0x78, 0x32, 0x3a, 0x68, 0xb3, 0x99, 0x86,
+	0x0a, 0x19, 0x16, 0xeb, 0x01, 0x29, 0x7e, 0x4e, 0x24, 0xca, 0xc6, 0x43, 0xed, 0x34, 0xb5, 0x24,
+	0x4a, 0xfb, 0x00, 0x00, 0xe3, 0xb2, 0xec, 0x5a, 0xf6, 0xc4, 0x73, 0x23, 0x5e, 0x15, 0x45, 0x6e,
+	0x69, 0xae, 0x29, 0x30, 0x5e, 0xee, 0xe7, 0x92, 0x8c, 0xaf, 0x0c, 0x89, 0x98, 0x42, 0x0b, 0x83,
+	0xbb, 0x34, 0x9b, 0x69, 0x14, 0x21, 0x43, 0x6f, 0x01, 0x44, 0xce, 0xec, 0xa1, 0xc4, 0x9e, 0xf0,
+	0x93, 0x6f, 0xde, 0x4c, 0xc1, 0x44, 0xa2, 0x68, 0xe4, 0x05, 0xbc, 0x15, 0x85, 0xae, 0x55, 0x25,
+	0x84, 0x46, 0x12, 0xc1, 0xd3, 0x1f, 0xc1, 0x1a, 0x55, 0x27, 0xdc, 0xeb, 0x59, 0x44, 0x8f, 0xd0,
+	0x45, 0x23, 0xe9, 0x89, 0xda, 0xbc, 0x95, 0x8a, 0x8b, 0xd6, 0x62, 0xc2, 0x71, 0x23, 0x5c, 0x8b,
+	0x8b, 0x7c, 0x1a, 0x9b, 0x77, 0x17, 0x13, 0xf0, 0x7c, 0x6d, 0xd8, 0x4c, 0x77, 0x08, 0xd1, 0x5e,
+	0xbf, 0x8e, 0x47, 0x59, 0xf3, 0xab, 0x57, 0x50, 0x45, 0xdd, 0x91, 0xe2, 0x1d, 0xa2, 0x09, 0x09,
+	0x6b, 0xb1, 0xe7, 0x48, 0x33, 0xd5, 0x8b, 0x40, 0x1b, 0xc0, 0x16, 0xa5, 0x69, 0x8d, 0xc7, 0x31,
+	0x67, 0x84, 0x57, 0xa4, 0x04, 0x29, 0x0e, 0x16, 0xcd, 0x9b, 0x09, 0x7c, 0xe8, 0x64, 0x71, 0x04,
+	0xf5, 0xb8, 0x1d, 0x5f, 0x5b, 0x4c, 0x1e, 0x0a, 0x50, 0x8b, 0x6c, 0xff, 0xda, 0x67, 0xa1, 0x37,
+	0x41, 0xac, 0x8e, 0x22, 0xe5, 0x22, 0xdf, 0x87, 0xe6, 0x6d, 0x95, 0x20, 0x96, 0xef, 0x77, 0x61,
+	0x2b, 0xbe, 0x70, 0x44, 0xce, 0x77, 0xd3, 0xba, 0x6b, 0xa1, 0xe4, 0xad, 0x36, 0xe8, 0x41, 0x06,
+	0xf7, 0x0e, 0xd9, 0xe6, 0x1f, 0xce, 0xd7, 0x14, 0xe7, 0x83, 0xe6, 0xad, 0x54, 0x5c, 0x74, 0x6a,
+	0x89, 0x99, 0xfb, 0xc3, 0x53, 0x4b, 0xba, 0x83, 0x40, 0xf3, 0x95, 0x45, 0x68, 0x9e, 0x63, 0x1f,
+	0xea, 0x71, 0x43, 0x7e, 0x38, 0xd6, 0x0b, 0x9c, 0x03, 0x9a, 0xaf, 0x2e, 0xc4, 0xab, 0xd5, 0x94,
+	0x4c, 0xde, 0x4a, 0x35, 0x93, 0x86, 0xfa, 0xe6, 0x2b, 0x8b, 0xd0, 0x94, 0xe3, 0xce, 0x1b, 0xdf,
+	0xfb, 0xea, 0xb9, 0x13, 0x5c, 0xcc, 0x4f, 0xb7, 0x87, 0xde, 0xe4, 0x9d, 0xb1, 0x50, 0x42, 0xf1,
+	0xa8, 0x5e, 0xef, 0x8c, 0xdd, 0xd1, 0x3b, 0x2c, 0x83, 0xd3, 0xa5, 0xe9, 0xcc, 0x0b, 0xbc, 0xf7,
+	0xfe, 0xcf, 0x00, 0x76, 0x99, 0x5a, 0x52, 0x4e, 0xa9, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -14060,6 +15870,13 @@ type LightningClient interface {
 	//ClosedChannels returns a description of all the closed channels that
 	//this node was a participant in.
 	ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error)
+	// lncli: `closereport`
+	//ChannelCloseReport returns a detailed account of how the funds of a closed
+	//channel were resolved on chain. It lists every output of the closing
+	//transaction along with the resolutions that handled it, and the
+	//transactions that swept the funds together with their fees and the amounts
+	//they recovered, including the justice transactions of breach remedies.
+	ChannelCloseReport(ctx context.Context, in *ChannelCloseReportRequest, opts ...grpc.CallOption) (*ChannelCloseReportResponse, error)
 	//
 	//OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	//call is meant to be consumed by clients to the REST proxy. As with all
@@ -14076,6 +15893,13 @@ type LightningClient interface {
 	//arguments specified in the OpenChannelRequest, this pending channel ID can
 	//then be used to manually progress the channel funding flow.
 	OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error)
+	// lncli: `batchopenchannel`
+	//BatchOpenChannel attempts to open multiple single-funded channels in a
+	//single transaction in an atomic way. This means either all channel open
+	//requests succeed at once or all attempts are aborted if any of them fail.
+	//This is the safer variant of using PSBTs to manually fund a batch of
+	//channels through the OpenChannel RPC.
+	BatchOpenChannel(ctx context.Context, in *BatchOpenChannelRequest, opts ...grpc.CallOption) (*BatchOpenChannelResponse, error)
 	//
 	//FundingStateStep is an advanced funding related call that allows the caller
 	//to either execute some preparatory steps for a funding workflow, or
@@ -14153,6 +15977,12 @@ type LightningClient interface {
 	//The passed payment hash *must* be exactly 32 bytes, if not, an error is
 	//returned.
 	LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error)
+	// lncli: `deleteinvoice`
+	//DeleteInvoice deletes a settled or canceled invoice from the database. Open
+	//and accepted invoices can't be deleted, they need to be canceled first. The
+	//add and settle indexes of the remaining invoices are not changed, so invoice
+	//subscriptions can still be resumed from them.
+	DeleteInvoice(ctx context.Context, in *DeleteInvoiceRequest, opts ...grpc.CallOption) (*DeleteInvoiceResponse, error)
 	//
 	//SubscribeInvoices returns a uni-directional stream (server -> client) for
 	//notifying the client of newly added/settled invoices. The caller can
@@ -14175,6 +16005,10 @@ type LightningClient interface {
 	//
 	//DeleteAllPayments deletes all outgoing payments from DB.
 	DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error)
+	// lncli: `deletepayment`
+	//DeletePayment deletes a single outgoing payment from DB. In-flight payments
+	//can't be deleted, since that would be unsafe.
+	DeletePayment(ctx context.Context, in *DeletePaymentRequest, opts ...grpc.CallOption) (*DeletePaymentResponse, error)
 	// lncli: `describegraph`
 	//DescribeGraph returns a description of the latest graph state from the
 	//point of view of the node. The graph information is partitioned into two
@@ -14251,6 +16085,13 @@ type LightningClient interface {
 	//the index offset of the last entry. The index offset can be provided to the
 	//request to allow the caller to skip a series of records.
 	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
+	// lncli: `fwdingstats`
+	//ForwardingHistoryStats allows the caller to obtain aggregated statistics
+	//of the forwarding history within the target time range. Volume and fees
+	//are totaled per channel and per peer in both directions, optionally
+	//grouped into hourly, daily or weekly buckets. Channels that have been
+	//closed in the meantime are annotated with their close data.
+	ForwardingHistoryStats(ctx context.Context, in *ForwardingHistoryStatsRequest, opts ...grpc.CallOption) (*ForwardingHistoryStatsResponse, error)
 	// lncli: `exportchanbackup`
 	//ExportChannelBackup attempts to return an encrypted static channel backup
 	//for the target channel identified by it channel point. The backup is
@@ -14570,6 +16411,15 @@ func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannels
 	return out, nil
 }
 
+func (c *lightningClient) ChannelCloseReport(ctx context.Context, in *ChannelCloseReportRequest, opts ...grpc.CallOption) (*ChannelCloseReportResponse, error) {
+	out := new(ChannelCloseReportResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ChannelCloseReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error) {
 	out := new(ChannelPoint)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/OpenChannelSync", in, out, opts...)
@@ -14611,6 +16461,15 @@ func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
 	return m, nil
 }
 
+func (c *lightningClient) BatchOpenChannel(ctx context.Context, in *BatchOpenChannelRequest, opts ...grpc.CallOption) (*BatchOpenChannelResponse, error) {
+	out := new(BatchOpenChannelResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BatchOpenChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) FundingStateStep(ctx context.Context, in *FundingTransitionMsg, opts ...grpc.CallOption) (*FundingStateStepResp, error) {
 	out := new(FundingStateStepResp)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/FundingStateStep", in, out, opts...)
@@ -14801,6 +16660,15 @@ func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, op
 	return out, nil
 }
 
+func (c *lightningClient) DeleteInvoice(ctx context.Context, in *DeleteInvoiceRequest, opts ...grpc.CallOption) (*DeleteInvoiceResponse, error) {
+	out := new(DeleteInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DeleteInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/SubscribeInvoices", opts...)
 	if err != nil {
@@ -14860,6 +16728,15 @@ func (c *lightningClient) DeleteAllPayments(ctx context.Context, in *DeleteAllPa
 	return out, nil
 }
 
+func (c *lightningClient) DeletePayment(ctx context.Context, in *DeletePaymentRequest, opts ...grpc.CallOption) (*DeletePaymentResponse, error) {
+	out := new(DeletePaymentResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DeletePayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error) {
 	out := new(ChannelGraph)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DescribeGraph", in, out, opts...)
@@ -14991,6 +16868,15 @@ func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingH
 	return out, nil
 }
 
+func (c *lightningClient) ForwardingHistoryStats(ctx context.Context, in *ForwardingHistoryStatsRequest, opts ...grpc.CallOption) (*ForwardingHistoryStatsResponse, error) {
+	out := new(ForwardingHistoryStatsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ForwardingHistoryStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ChannelBackup, error) {
 	out := new(ChannelBackup)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ExportChannelBackup", in, out, opts...)
@@ -15206,6 +17092,13 @@ type LightningServer interface {
 	//ClosedChannels returns a description of all the closed channels that
 	//this node was a participant in.
 	ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error)
+	// lncli: `closereport`
+	//ChannelCloseReport returns a detailed account of how the funds of a closed
+	//channel were resolved on chain. It lists every output of the closing
+	//transaction along with the resolutions that handled it, and the
+	//transactions that swept the funds together with their fees and the amounts
+	//they recovered, including the justice transactions of breach remedies.
+	ChannelCloseReport(context.Context, *ChannelCloseReportRequest) (*ChannelCloseReportResponse, error)
 	//
 	//OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	//call is meant to be consumed by clients to the REST proxy. As with all
@@ -15222,6 +17115,13 @@ type LightningServer interface {
 	//arguments specified in the OpenChannelRequest, this pending channel ID can
 	//then be used to manually progress the channel funding flow.
 	OpenChannel(*OpenChannelRequest, Lightning_OpenChannelServer) error
+	// lncli: `batchopenchannel`
+	//BatchOpenChannel attempts to open multiple single-funded channels in a
+	//single transaction in an atomic way. This means either all channel open
+	//requests succeed at once or all attempts are aborted if any of them fail.
+	//This is the safer variant of using PSBTs to manually fund a batch of
+	//channels through the OpenChannel RPC.
+	BatchOpenChannel(context.Context, *BatchOpenChannelRequest) (*BatchOpenChannelResponse, error)
 	//
 	//FundingStateStep is an advanced funding related call that allows the caller
 	//to either execute some preparatory steps for a funding workflow, or
@@ -15299,6 +17199,12 @@ type LightningServer interface {
 	//The passed payment hash *must* be exactly 32 bytes, if not, an error is
 	//returned.
 	LookupInvoice(context.Context, *PaymentHash) (*Invoice, error)
+	// lncli: `deleteinvoice`
+	//DeleteInvoice deletes a settled or canceled invoice from the database. Open
+	//and accepted invoices can't be deleted, they need to be canceled first. The
+	//add and settle indexes of the remaining invoices are not changed, so invoice
+	//subscriptions can still be resumed from them.
+	DeleteInvoice(context.Context, *DeleteInvoiceRequest) (*DeleteInvoiceResponse, error)
 	//
 	//SubscribeInvoices returns a uni-directional stream (server -> client) for
 	//notifying the client of newly added/settled invoices. The caller can
@@ -15321,6 +17227,10 @@ type LightningServer interface {
 	//
 	//DeleteAllPayments deletes all outgoing payments from DB.
 	DeleteAllPayments(context.Context, *DeleteAllPaymentsRequest) (*DeleteAllPaymentsResponse, error)
+	// lncli: `deletepayment`
+	//DeletePayment deletes a single outgoing payment from DB. In-flight payments
+	//can't be deleted, since that would be unsafe.
+	DeletePayment(context.Context, *DeletePaymentRequest) (*DeletePaymentResponse, error)
 	// lncli: `describegraph`
 	//DescribeGraph returns a description of the latest graph state from the
 	//point of view of the node. The graph information is partitioned into two
@@ -15397,6 +17307,13 @@ type LightningServer interface {
 	//the index offset of the last entry. The index offset can be provided to the
 	//request to allow the caller to skip a series of records.
 	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
+	// lncli: `fwdingstats`
+	//ForwardingHistoryStats allows the caller to obtain aggregated statistics
+	//of the forwarding history within the target time range. Volume and fees
+	//are totaled per channel and per peer in both directions, optionally
+	//grouped into hourly, daily or weekly buckets. Channels that have been
+	//closed in the meantime are annotated with their close data.
+	ForwardingHistoryStats(context.Context, *ForwardingHistoryStatsRequest) (*ForwardingHistoryStatsResponse, error)
 	// lncli: `exportchanbackup`
 	//ExportChannelBackup attempts to return an encrypted static channel backup
 	//for the target channel identified by it channel point. The backup is
@@ -15517,12 +17434,18 @@ func (*UnimplementedLightningServer) SubscribeChannelEvents(req *ChannelEventSub
 func (*UnimplementedLightningServer) ClosedChannels(ctx context.Context, req *ClosedChannelsRequest) (*ClosedChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClosedChannels not implemented")
 }
+func (*UnimplementedLightningServer) ChannelCloseReport(ctx context.Context, req *ChannelCloseReportRequest) (*ChannelCloseReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelCloseReport not implemented")
+}
 func (*UnimplementedLightningServer) OpenChannelSync(ctx context.Context, req *OpenChannelRequest) (*ChannelPoint, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenChannelSync not implemented")
 }
 func (*UnimplementedLightningServer) OpenChannel(req *OpenChannelRequest, srv Lightning_OpenChannelServer) error {
 	return status.Errorf(codes.Unimplemented, "method OpenChannel not implemented")
 }
+func (*UnimplementedLightningServer) BatchOpenChannel(ctx context.Context, req *BatchOpenChannelRequest) (*BatchOpenChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchOpenChannel not implemented")
+}
 func (*UnimplementedLightningServer) FundingStateStep(ctx context.Context, req *FundingTransitionMsg) (*FundingStateStepResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundingStateStep not implemented")
 }
@@ -15556,6 +17479,9 @@ func (*UnimplementedLightningServer) ListInvoices(ctx context.Context, req *List
 func (*UnimplementedLightningServer) LookupInvoice(ctx context.Context, req *PaymentHash) (*Invoice, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupInvoice not implemented")
 }
+func (*UnimplementedLightningServer) DeleteInvoice(ctx context.Context, req *DeleteInvoiceRequest) (*DeleteInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInvoice not implemented")
+}
 func (*UnimplementedLightningServer) SubscribeInvoices(req *InvoiceSubscription, srv Lightning_SubscribeInvoicesServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeInvoices not implemented")
 }
@@ -15568,6 +17494,9 @@ func (*UnimplementedLightningServer) ListPayments(ctx context.Context, req *List
 func (*UnimplementedLightningServer) DeleteAllPayments(ctx context.Context, req *DeleteAllPaymentsRequest) (*DeleteAllPaymentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAllPayments not implemented")
 }
+func (*UnimplementedLightningServer) DeletePayment(ctx context.Context, req *DeletePaymentRequest) (*DeletePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePayment not implemented")
+}
 func (*UnimplementedLightningServer) DescribeGraph(ctx context.Context, req *ChannelGraphRequest) (*ChannelGraph, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeGraph not implemented")
 }
@@ -15604,6 +17533,9 @@ func (*UnimplementedLightningServer) UpdateChannelPolicy(ctx context.Context, re
 func (*UnimplementedLightningServer) ForwardingHistory(ctx context.Context, req *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForwardingHistory not implemented")
 }
+func (*UnimplementedLightningServer) ForwardingHistoryStats(ctx context.Context, req *ForwardingHistoryStatsRequest) (*ForwardingHistoryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardingHistoryStats not implemented")
+}
 func (*UnimplementedLightningServer) ExportChannelBackup(ctx context.Context, req *ExportChannelBackupRequest) (*ChannelBackup, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportChannelBackup not implemented")
 }
@@ -16023,6 +17955,24 @@ func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ChannelCloseReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelCloseReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ChannelCloseReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ChannelCloseReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ChannelCloseReport(ctx, req.(*ChannelCloseReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_OpenChannelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OpenChannelRequest)
 	if err := dec(in); err != nil {
@@ -16062,6 +18012,24 @@ func (x *lightningOpenChannelServer) Send(m *OpenStatusUpdate) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_BatchOpenChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchOpenChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BatchOpenChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BatchOpenChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BatchOpenChannel(ctx, req.(*BatchOpenChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_FundingStateStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FundingTransitionMsg)
 	if err := dec(in); err != nil {
@@ -16287,6 +18255,24 @@ func _Lightning_LookupInvoice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_DeleteInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DeleteInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DeleteInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DeleteInvoice(ctx, req.(*DeleteInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_SubscribeInvoices_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(InvoiceSubscription)
 	if err := stream.RecvMsg(m); err != nil {
@@ -16362,6 +18348,24 @@ func _Lightning_DeleteAllPayments_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_DeletePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DeletePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DeletePayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DeletePayment(ctx, req.(*DeletePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_DescribeGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChannelGraphRequest)
 	if err := dec(in); err != nil {
@@ -16581,6 +18585,24 @@ func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ForwardingHistoryStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardingHistoryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ForwardingHistoryStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ForwardingHistoryStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ForwardingHistoryStats(ctx, req.(*ForwardingHistoryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ExportChannelBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportChannelBackupRequest)
 	if err := dec(in); err != nil {
@@ -16822,10 +18844,18 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClosedChannels",
 			Handler:    _Lightning_ClosedChannels_Handler,
 		},
+		{
+			MethodName: "ChannelCloseReport",
+			Handler:    _Lightning_ChannelCloseReport_Handler,
+		},
 		{
 			MethodName: "OpenChannelSync",
 			Handler:    _Lightning_OpenChannelSync_Handler,
 		},
+		{
+			MethodName: "BatchOpenChannel",
+			Handler:    _Lightning_BatchOpenChannel_Handler,
+		},
 		{
 			MethodName: "FundingStateStep",
 			Handler:    _Lightning_FundingStateStep_Handler,
@@ -16854,6 +18884,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LookupInvoice",
 			Handler:    _Lightning_LookupInvoice_Handler,
 		},
+		{
+			MethodName: "DeleteInvoice",
+			Handler:    _Lightning_DeleteInvoice_Handler,
+		},
 		{
 			MethodName: "DecodePayReq",
 			Handler:    _Lightning_DecodePayReq_Handler,
@@ -16866,6 +18900,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAllPayments",
 			Handler:    _Lightning_DeleteAllPayments_Handler,
 		},
+		{
+			MethodName: "DeletePayment",
+			Handler:    _Lightning_DeletePayment_Handler,
+		},
 		{
 			MethodName: "DescribeGraph",
 			Handler:    _Lightning_DescribeGraph_Handler,
@@ -16910,6 +18948,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForwardingHistory",
 			Handler:    _Lightning_ForwardingHistory_Handler,
 		},
+		{
+			MethodName: "ForwardingHistoryStats",
+			Handler:    _Lightning_ForwardingHistoryStats_Handler,
+		},
 		{
 			MethodName: "ExportChannelBackup",
 			Handler:    _Lightning_ExportChannelBackup_Handler,
@@ -4,6 +4,7 @@ package walletrpc
 
 import (
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
@@ -59,4 +60,12 @@ type Config struct {
 
 	// ChainParams are the parameters of the wallet's backing chain.
 	ChainParams *chaincfg.Params
+
+	// ChainArb is used to bump the fee of unconfirmed force closes through
+	// their anchor outputs.
+	ChainArb *contractcourt.ChainArbitrator
+
+	// Consolidator merges small wallet utxos into a single output paying
+	// to cold storage.
+	Consolidator *sweep.Consolidator
 }
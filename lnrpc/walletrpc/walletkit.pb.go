@@ -1514,10 +1514,15 @@ type BumpFeeRequest struct {
 	//
 	//The fee rate, expressed in sat/vbyte, that should be used to spend the input
 	//with.
-	SatPerVbyte          uint64   `protobuf:"varint,5,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SatPerVbyte uint64 `protobuf:"varint,5,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	//
+	//The channel point of a channel with an unconfirmed force close, whose fee
+	//should be bumped through its anchor outputs. If set, the outpoint must not
+	//be set.
+	ChanPoint            *lnrpc.ChannelPoint `protobuf:"bytes,6,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *BumpFeeRequest) Reset()         { *m = BumpFeeRequest{} }
@@ -1581,6 +1586,13 @@ func (m *BumpFeeRequest) GetSatPerVbyte() uint64 {
 	return 0
 }
 
+func (m *BumpFeeRequest) GetChanPoint() *lnrpc.ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
 type BumpFeeResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -2354,6 +2366,170 @@ func (m *ListLeasesResponse) GetLockedUtxos() []*UtxoLease {
 	return nil
 }
 
+type ConsolidateUtxosRequest struct {
+	//
+	//The fee rate, expressed in sat/vbyte, that should be used for the
+	//consolidation. If neither this nor target_conf is set, the configured
+	//consolidation confirmation target is used to estimate the fee rate.
+	SatPerVbyte uint64 `protobuf:"varint,1,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	// The target number of blocks that the consolidation should confirm in.
+	TargetConf uint32 `protobuf:"varint,2,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// If true, the consolidation is only previewed and not published.
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsolidateUtxosRequest) Reset()         { *m = ConsolidateUtxosRequest{} }
+func (m *ConsolidateUtxosRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsolidateUtxosRequest) ProtoMessage()    {}
+func (*ConsolidateUtxosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{39}
+}
+
+func (m *ConsolidateUtxosRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsolidateUtxosRequest.Unmarshal(m, b)
+}
+func (m *ConsolidateUtxosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsolidateUtxosRequest.Marshal(b, m, deterministic)
+}
+func (m *ConsolidateUtxosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsolidateUtxosRequest.Merge(m, src)
+}
+func (m *ConsolidateUtxosRequest) XXX_Size() int {
+	return xxx_messageInfo_ConsolidateUtxosRequest.Size(m)
+}
+func (m *ConsolidateUtxosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsolidateUtxosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsolidateUtxosRequest proto.InternalMessageInfo
+
+func (m *ConsolidateUtxosRequest) GetSatPerVbyte() uint64 {
+	if m != nil {
+		return m.SatPerVbyte
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosRequest) GetTargetConf() uint32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type ConsolidateUtxosResponse struct {
+	// The consolidation transaction in the raw wire format.
+	RawTx []byte `protobuf:"bytes,1,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	// The txid of the consolidation transaction.
+	Txid string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	// The address that the consolidation pays to.
+	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	// The wallet utxos that are consolidated.
+	Inputs []*lnrpc.OutPoint `protobuf:"bytes,4,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// The value of the consolidated output in satoshis.
+	AmountSat int64 `protobuf:"varint,5,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
+	// The fee paid by the consolidation transaction in satoshis.
+	FeeSat int64 `protobuf:"varint,6,opt,name=fee_sat,json=feeSat,proto3" json:"fee_sat,omitempty"`
+	// The fee rate of the consolidation transaction in sat/vbyte.
+	SatPerVbyte uint64 `protobuf:"varint,7,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	// Whether the consolidation transaction was published.
+	Published            bool     `protobuf:"varint,8,opt,name=published,proto3" json:"published,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsolidateUtxosResponse) Reset()         { *m = ConsolidateUtxosResponse{} }
+func (m *ConsolidateUtxosResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsolidateUtxosResponse) ProtoMessage()    {}
+func (*ConsolidateUtxosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{40}
+}
+
+func (m *ConsolidateUtxosResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsolidateUtxosResponse.Unmarshal(m, b)
+}
+func (m *ConsolidateUtxosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsolidateUtxosResponse.Marshal(b, m, deterministic)
+}
+func (m *ConsolidateUtxosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsolidateUtxosResponse.Merge(m, src)
+}
+func (m *ConsolidateUtxosResponse) XXX_Size() int {
+	return xxx_messageInfo_ConsolidateUtxosResponse.Size(m)
+}
+func (m *ConsolidateUtxosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsolidateUtxosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsolidateUtxosResponse proto.InternalMessageInfo
+
+func (m *ConsolidateUtxosResponse) GetRawTx() []byte {
+	if m != nil {
+		return m.RawTx
+	}
+	return nil
+}
+
+func (m *ConsolidateUtxosResponse) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *ConsolidateUtxosResponse) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ConsolidateUtxosResponse) GetInputs() []*lnrpc.OutPoint {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *ConsolidateUtxosResponse) GetAmountSat() int64 {
+	if m != nil {
+		return m.AmountSat
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosResponse) GetFeeSat() int64 {
+	if m != nil {
+		return m.FeeSat
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosResponse) GetSatPerVbyte() uint64 {
+	if m != nil {
+		return m.SatPerVbyte
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosResponse) GetPublished() bool {
+	if m != nil {
+		return m.Published
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("walletrpc.AddressType", AddressType_name, AddressType_value)
 	proto.RegisterEnum("walletrpc.WitnessType", WitnessType_name, WitnessType_value)
@@ -2398,153 +2574,164 @@ func init() {
 	proto.RegisterType((*FinalizePsbtResponse)(nil), "walletrpc.FinalizePsbtResponse")
 	proto.RegisterType((*ListLeasesRequest)(nil), "walletrpc.ListLeasesRequest")
 	proto.RegisterType((*ListLeasesResponse)(nil), "walletrpc.ListLeasesResponse")
+	proto.RegisterType((*ConsolidateUtxosRequest)(nil), "walletrpc.ConsolidateUtxosRequest")
+	proto.RegisterType((*ConsolidateUtxosResponse)(nil), "walletrpc.ConsolidateUtxosResponse")
 }
 
 func init() { proto.RegisterFile("walletrpc/walletkit.proto", fileDescriptor_6cc6942ac78249e5) }
 
 var fileDescriptor_6cc6942ac78249e5 = []byte{
-	// 2249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x73, 0xe2, 0xc8,
-	0x11, 0x5f, 0x01, 0xb6, 0xa1, 0x01, 0x1b, 0x0f, 0xf8, 0xcf, 0xb2, 0xde, 0xb3, 0x57, 0x97, 0x64,
-	0x9d, 0xbb, 0x3d, 0x5c, 0xd9, 0xcb, 0xe6, 0x76, 0x37, 0xa9, 0x54, 0x6c, 0x8c, 0x0b, 0x17, 0xd8,
-	0x38, 0x02, 0xaf, 0xb3, 0xc9, 0x83, 0x4a, 0x46, 0x63, 0xa3, 0x32, 0x48, 0x3a, 0x69, 0x30, 0x70,
-	0x4f, 0xf7, 0x35, 0x52, 0x95, 0xef, 0x90, 0xaa, 0x3c, 0xa7, 0xf2, 0x9e, 0xca, 0xb7, 0xc8, 0xe7,
-	0xc8, 0x43, 0x6a, 0xfe, 0x48, 0x1a, 0x09, 0xec, 0xbb, 0xab, 0xbb, 0x27, 0xa3, 0xfe, 0xf5, 0xf4,
-	0xf4, 0xf4, 0x9f, 0xe9, 0xee, 0x31, 0x3c, 0x9d, 0x18, 0xc3, 0x21, 0x26, 0x9e, 0xdb, 0x3f, 0xe0,
-	0xbf, 0xee, 0x2c, 0x52, 0x73, 0x3d, 0x87, 0x38, 0x28, 0x17, 0x42, 0xd5, 0x9c, 0xe7, 0xf6, 0x39,
-	0xb5, 0x5a, 0xf1, 0xad, 0x5b, 0x9b, 0xb2, 0xd3, 0xbf, 0xd8, 0xe3, 0x54, 0x75, 0x00, 0xa8, 0x6d,
-	0xf9, 0xe4, 0xd2, 0xf6, 0x5d, 0x6c, 0x13, 0x0d, 0x7f, 0x3d, 0xc6, 0x3e, 0x41, 0xcf, 0x20, 0x37,
-	0xb2, 0x6c, 0xbd, 0xef, 0xd8, 0x37, 0xfe, 0xb6, 0xb2, 0xa7, 0xec, 0x2f, 0x69, 0xd9, 0x91, 0x65,
-	0xd7, 0xe9, 0x37, 0x03, 0x8d, 0xa9, 0x00, 0x53, 0x02, 0x34, 0xa6, 0x1c, 0xdc, 0x86, 0x15, 0xa3,
-	0xdf, 0x77, 0xc6, 0x36, 0xd9, 0x4e, 0xef, 0x29, 0xfb, 0x39, 0x2d, 0xf8, 0x54, 0xdf, 0x42, 0x39,
-	0xb6, 0x93, 0xef, 0x3a, 0xb6, 0x8f, 0xd1, 0x0b, 0x58, 0x1a, 0x93, 0xa9, 0x43, 0xb7, 0x49, 0xef,
-	0xe7, 0x5f, 0xe7, 0x6b, 0x43, 0xaa, 0x64, 0xed, 0x92, 0x4c, 0x1d, 0x8d, 0x23, 0xea, 0xb7, 0x0a,
-	0xa0, 0x36, 0x36, 0x7c, 0xdc, 0x19, 0x13, 0x77, 0x1c, 0x2a, 0xb9, 0x0a, 0x29, 0xcb, 0x64, 0xda,
-	0x15, 0xb4, 0x94, 0x65, 0xa2, 0xcf, 0x21, 0xeb, 0x8c, 0x89, 0xeb, 0x58, 0x36, 0x61, 0x6a, 0xe5,
-	0x5f, 0xaf, 0x09, 0x61, 0x9d, 0x31, 0xb9, 0xa0, 0x64, 0x2d, 0x64, 0x40, 0x5f, 0x00, 0xc2, 0x53,
-	0xd7, 0xf2, 0x0c, 0x62, 0x39, 0xb6, 0xee, 0xe3, 0xbe, 0x63, 0x9b, 0x3e, 0x53, 0x39, 0xa3, 0xad,
-	0x47, 0x48, 0x97, 0x03, 0xea, 0x1b, 0x28, 0xc7, 0x34, 0x10, 0xca, 0x7f, 0x02, 0x10, 0xf1, 0x32,
-	0x55, 0x32, 0x9a, 0x44, 0x51, 0xbb, 0x50, 0xd1, 0xf0, 0xf0, 0xa7, 0x55, 0x5d, 0xdd, 0x82, 0x8d,
-	0x84, 0x50, 0xae, 0x8d, 0xfa, 0x47, 0x58, 0x6e, 0xe1, 0x99, 0x86, 0xbf, 0x46, 0xfb, 0x50, 0xba,
-	0xc3, 0x33, 0xfd, 0xc6, 0xb2, 0x6f, 0xb1, 0xa7, 0xbb, 0x1e, 0x95, 0xcb, 0xdd, 0xb8, 0x7a, 0x87,
-	0x67, 0x27, 0x8c, 0x7c, 0x41, 0xa9, 0xe8, 0x39, 0x00, 0xe3, 0x34, 0x46, 0xd6, 0x70, 0x26, 0xbc,
-	0x99, 0xa3, 0x3c, 0x8c, 0xa0, 0xbe, 0x84, 0xfc, 0xa1, 0x69, 0x7a, 0x81, 0xde, 0x92, 0x77, 0x95,
-	0xb8, 0x77, 0x55, 0x28, 0x70, 0x46, 0x61, 0x19, 0x04, 0x19, 0xc3, 0x34, 0x3d, 0xc1, 0xc6, 0x7e,
-	0xab, 0xff, 0x4d, 0xc1, 0xca, 0x21, 0xe7, 0xa7, 0xb8, 0x6d, 0x8c, 0x70, 0x80, 0xd3, 0xdf, 0xe8,
-	0x1d, 0x14, 0x28, 0x1f, 0xf6, 0x7d, 0x9d, 0xcc, 0x5c, 0xcc, 0xb4, 0x59, 0x7d, 0xbd, 0x59, 0x0b,
-	0xc3, 0xb9, 0x76, 0xc8, 0xe1, 0xde, 0xcc, 0xc5, 0x5a, 0xde, 0x88, 0x3e, 0x50, 0x0d, 0xca, 0x78,
-	0x4a, 0xb0, 0x6d, 0x62, 0x53, 0x77, 0xc7, 0xd7, 0x43, 0xab, 0xaf, 0xdf, 0xe1, 0x99, 0x08, 0xc1,
-	0xf5, 0x00, 0xba, 0x60, 0x48, 0x0b, 0xcf, 0xd0, 0xaf, 0x61, 0x73, 0x64, 0xf8, 0x04, 0x7b, 0x7a,
-	0x64, 0x27, 0x6e, 0xa6, 0xcc, 0x9e, 0xb2, 0x5f, 0xd4, 0x2a, 0x1c, 0x6d, 0x05, 0xc6, 0x62, 0x18,
-	0x7a, 0x09, 0x6b, 0x26, 0xf6, 0xac, 0x7b, 0x1e, 0x34, 0xae, 0x41, 0x06, 0xdb, 0x4b, 0x6c, 0x87,
-	0xd5, 0x88, 0x7c, 0x61, 0x90, 0x01, 0x7a, 0x45, 0xa3, 0x8b, 0x60, 0xcf, 0x36, 0x86, 0x6c, 0x03,
-	0x6e, 0xb2, 0x65, 0x26, 0xba, 0x14, 0x20, 0x2d, 0x3c, 0xab, 0x33, 0x5b, 0xbc, 0x02, 0x64, 0xd9,
-	0x73, 0xdc, 0x2b, 0x9c, 0x3b, 0x40, 0x42, 0xee, 0xe7, 0x00, 0x13, 0x83, 0xf4, 0x07, 0xba, 0x63,
-	0x0f, 0x67, 0xdb, 0xd9, 0x3d, 0x65, 0x3f, 0xab, 0xe5, 0x18, 0xa5, 0x63, 0x0f, 0x67, 0xaa, 0xc9,
-	0xd3, 0x4c, 0xd8, 0xd9, 0x0f, 0x3c, 0xf7, 0xd3, 0xda, 0x5b, 0x3d, 0x81, 0x4a, 0x7c, 0x17, 0xe1,
-	0xf6, 0x1a, 0x64, 0x45, 0x44, 0x04, 0x09, 0x8d, 0x64, 0x71, 0x1c, 0xd2, 0x42, 0x1e, 0xf5, 0x3f,
-	0x0a, 0x54, 0x4e, 0x47, 0xae, 0xe3, 0x05, 0xa2, 0x1e, 0xd3, 0xf7, 0x01, 0x27, 0xa7, 0x7e, 0xb8,
-	0x93, 0xd3, 0x8f, 0x38, 0x39, 0x69, 0x95, 0xcc, 0xf7, 0xb7, 0xca, 0x16, 0x6c, 0x24, 0x0e, 0x23,
-	0x32, 0xd3, 0x83, 0x4d, 0x0e, 0x84, 0xca, 0x05, 0xe7, 0x7c, 0x0e, 0x20, 0x1d, 0x85, 0xdf, 0x08,
-	0x39, 0x37, 0x3c, 0xc2, 0x8f, 0x70, 0xd1, 0x53, 0xd8, 0x9a, 0xdb, 0x53, 0xa8, 0xf3, 0x1e, 0xf2,
-	0x3d, 0xcf, 0xb0, 0x7d, 0xa3, 0x4f, 0x23, 0x16, 0x6d, 0xc0, 0x32, 0x99, 0xea, 0x03, 0x3c, 0x15,
-	0xfb, 0x2f, 0x91, 0x69, 0x13, 0x4f, 0x51, 0x05, 0x96, 0x86, 0xc6, 0x35, 0x1e, 0x0a, 0x03, 0xf3,
-	0x0f, 0xf5, 0x37, 0xb0, 0xc6, 0x04, 0xfa, 0x83, 0xd0, 0xe9, 0x9f, 0x42, 0xd1, 0xe5, 0x24, 0x1d,
-	0x7b, 0x9e, 0x13, 0x24, 0x7d, 0x41, 0x10, 0x1b, 0x94, 0xa6, 0xfe, 0x53, 0x01, 0xd4, 0xc5, 0xb6,
-	0xc9, 0xef, 0xac, 0x30, 0x2e, 0x77, 0x00, 0x7c, 0x83, 0xe8, 0x2e, 0x75, 0xd2, 0x84, 0x2d, 0x4c,
-	0x6b, 0x59, 0xdf, 0x20, 0x17, 0xd8, 0x6b, 0x4d, 0xd0, 0x3e, 0xac, 0x38, 0x9c, 0x7f, 0x3b, 0xc5,
-	0xa2, 0x69, 0xb5, 0x26, 0xaa, 0x58, 0xad, 0x37, 0xed, 0x8c, 0x89, 0x16, 0xc0, 0x91, 0xb2, 0x69,
-	0x49, 0xd9, 0x78, 0x1d, 0xcb, 0x24, 0xea, 0xd8, 0xe7, 0xb0, 0x4e, 0x4b, 0x91, 0xa9, 0x8f, 0x6d,
-	0xca, 0x60, 0x79, 0x23, 0x6c, 0xb2, 0x7c, 0xce, 0x6a, 0x25, 0x06, 0x5c, 0x46, 0x74, 0xf5, 0x15,
-	0x94, 0x63, 0xda, 0x8b, 0xa3, 0x6f, 0xc0, 0xb2, 0x67, 0x4c, 0x74, 0x12, 0x9a, 0xce, 0x33, 0x26,
-	0xbd, 0xa9, 0xfa, 0x06, 0x50, 0xc3, 0x27, 0xd6, 0xc8, 0x20, 0xf8, 0x04, 0xe3, 0xe0, 0xac, 0xbb,
-	0x90, 0xa7, 0x02, 0x75, 0x62, 0x78, 0xb7, 0x38, 0xb8, 0x90, 0x81, 0x92, 0x7a, 0x8c, 0xa2, 0x7e,
-	0x09, 0xe5, 0xd8, 0x32, 0xb1, 0xc9, 0xa3, 0x36, 0x52, 0xff, 0x97, 0x86, 0xc2, 0x05, 0xb6, 0x4d,
-	0xcb, 0xbe, 0xed, 0x4e, 0x30, 0x76, 0x63, 0xc5, 0x44, 0xf9, 0xae, 0x3a, 0xf8, 0x0e, 0x0a, 0x13,
-	0x8b, 0xd8, 0x8f, 0x04, 0xd8, 0x15, 0x87, 0x79, 0x80, 0x4d, 0xa2, 0x0f, 0x1a, 0xba, 0xc6, 0x88,
-	0x86, 0xb9, 0xee, 0x1b, 0x41, 0x4a, 0xe5, 0x38, 0xa5, 0x6b, 0x10, 0xf4, 0x33, 0x28, 0x04, 0x5a,
-	0x5f, 0xcf, 0x08, 0xcf, 0xa3, 0xe2, 0x51, 0x6a, 0x5b, 0xd1, 0x80, 0xeb, 0x7e, 0x34, 0x23, 0x98,
-	0xd6, 0xe1, 0x6b, 0xcf, 0x31, 0xcc, 0xbe, 0xe1, 0x13, 0xdd, 0x20, 0x04, 0x8f, 0x5c, 0xe2, 0x33,
-	0x2f, 0x14, 0xb5, 0xf5, 0x10, 0x39, 0x14, 0x00, 0x7a, 0x0d, 0x1b, 0x36, 0x9e, 0x12, 0x3d, 0x5a,
-	0x33, 0xc0, 0xd6, 0xed, 0x20, 0xb8, 0x5b, 0xcb, 0x14, 0x3c, 0x0a, 0xb0, 0x26, 0x83, 0xe8, 0x1a,
-	0x8f, 0x7b, 0x00, 0x9b, 0xba, 0xec, 0x80, 0x2c, 0x5f, 0x13, 0x82, 0xf5, 0xd0, 0x13, 0xe8, 0x2b,
-	0xd8, 0x8c, 0xd6, 0xc4, 0x8e, 0x91, 0x0b, 0x8f, 0x11, 0x2d, 0xec, 0x46, 0xe7, 0x51, 0xa1, 0x18,
-	0xb0, 0xdf, 0x33, 0x7e, 0x60, 0x4d, 0x41, 0x9e, 0x1f, 0xf9, 0x03, 0x25, 0xa1, 0x37, 0xb0, 0x35,
-	0x2f, 0x9c, 0x73, 0xe7, 0x19, 0x77, 0x25, 0x21, 0x99, 0x2f, 0xab, 0xc0, 0xd2, 0x8d, 0xe3, 0xf5,
-	0x31, 0xab, 0x0c, 0x59, 0x8d, 0x7f, 0xa8, 0x9b, 0x50, 0x91, 0xbd, 0x1f, 0x24, 0x96, 0x7a, 0x05,
-	0x1b, 0x09, 0xba, 0x88, 0xa6, 0xdf, 0xc3, 0xaa, 0xcb, 0x01, 0xdd, 0x67, 0x88, 0xb8, 0xa8, 0xb7,
-	0x24, 0x9f, 0xcb, 0x2b, 0xb5, 0xa2, 0x2b, 0xcb, 0x51, 0xff, 0xa5, 0xc0, 0xea, 0xd1, 0x78, 0xe4,
-	0x4a, 0x81, 0xfd, 0x83, 0x22, 0x6e, 0x17, 0xf2, 0xdc, 0xfe, 0xcc, 0x17, 0x2c, 0xe0, 0x8a, 0x1a,
-	0x70, 0x12, 0xf5, 0xc0, 0x5c, 0xe0, 0xa4, 0x17, 0x06, 0x4e, 0x68, 0x8d, 0x8c, 0x64, 0x8d, 0x79,
-	0xf3, 0x2f, 0xcd, 0x99, 0x5f, 0x5d, 0x87, 0xb5, 0x50, 0x7f, 0x71, 0x21, 0x7e, 0x01, 0xeb, 0xb4,
-	0x9c, 0xc5, 0x2c, 0x48, 0x9b, 0x9d, 0x7b, 0xec, 0x5d, 0x3b, 0x3e, 0xaf, 0x42, 0x59, 0x2d, 0xf8,
-	0x54, 0xbf, 0x4d, 0xf1, 0xae, 0x39, 0x61, 0xd9, 0x36, 0x94, 0x49, 0x74, 0xad, 0xea, 0x26, 0x26,
-	0x86, 0x35, 0xf4, 0x85, 0x45, 0x9e, 0x0a, 0x8b, 0x48, 0x17, 0xef, 0x31, 0x67, 0x68, 0x3e, 0xd1,
-	0x10, 0x99, 0xa3, 0xa2, 0x2b, 0x58, 0x93, 0xa5, 0x59, 0xa6, 0x2f, 0x5a, 0xc3, 0x57, 0x92, 0xa3,
-	0xe6, 0xb5, 0x90, 0x37, 0x38, 0x3d, 0xa6, 0xc2, 0x57, 0x25, 0x31, 0xa7, 0xa6, 0x5f, 0x7d, 0x07,
-	0xab, 0x71, 0x1e, 0xda, 0xd7, 0x24, 0xb7, 0xa2, 0x31, 0x91, 0x4b, 0x2e, 0x3d, 0xca, 0xc2, 0x32,
-	0x8f, 0x19, 0xd5, 0x80, 0xad, 0x36, 0xbd, 0x62, 0x25, 0x49, 0x52, 0xe9, 0x26, 0xd3, 0xb0, 0xbd,
-	0x65, 0xbf, 0x17, 0xd7, 0x12, 0xb4, 0x03, 0x39, 0xe7, 0x1e, 0x7b, 0x13, 0xcf, 0x12, 0x6e, 0xce,
-	0x6a, 0x11, 0x41, 0xad, 0xc2, 0xf6, 0xfc, 0x16, 0xc2, 0x61, 0xff, 0x56, 0x60, 0xed, 0x64, 0x6c,
-	0x9b, 0x17, 0xfe, 0x75, 0xd8, 0x32, 0x54, 0x20, 0xe3, 0xfa, 0xd7, 0x3c, 0x02, 0x0b, 0xcd, 0x27,
-	0x1a, 0xfb, 0x42, 0xbf, 0x84, 0xb4, 0x67, 0x4c, 0x84, 0xe9, 0x36, 0x24, 0xd3, 0xf5, 0xa6, 0x3d,
-	0x3c, 0x72, 0x87, 0x06, 0xc1, 0xcd, 0x27, 0x1a, 0xe5, 0x41, 0x2f, 0xe2, 0x91, 0xc9, 0xe2, 0xae,
-	0xa9, 0x24, 0x62, 0x33, 0x11, 0x5f, 0x34, 0xfa, 0x32, 0x4d, 0x25, 0x9e, 0xe0, 0x52, 0x9b, 0xbc,
-	0x14, 0x6b, 0x93, 0x8f, 0x00, 0xb2, 0x44, 0xec, 0x7a, 0xb4, 0x0c, 0x99, 0x1b, 0x8c, 0x7d, 0xf5,
-	0x6f, 0x0a, 0x94, 0xa2, 0xb3, 0x88, 0x58, 0xda, 0x85, 0xfc, 0xcd, 0x98, 0x77, 0x3a, 0xe1, 0x99,
-	0x34, 0xe0, 0x24, 0xca, 0x48, 0x9b, 0xa1, 0xfe, 0xc0, 0xb0, 0x6f, 0xb1, 0xce, 0x4b, 0xa0, 0x6e,
-	0xd9, 0x26, 0x9e, 0x8a, 0x0e, 0x7e, 0x9d, 0x43, 0xbc, 0x5a, 0x9d, 0x52, 0x00, 0x7d, 0x05, 0x85,
-	0xa1, 0xd3, 0xbf, 0xc3, 0xa6, 0xce, 0xc7, 0xad, 0x34, 0x4b, 0xfa, 0x8a, 0x64, 0x10, 0x3a, 0x72,
-	0xb1, 0x21, 0x47, 0xcb, 0x73, 0xce, 0x4b, 0x36, 0x7d, 0xfd, 0x5d, 0x01, 0x88, 0x6c, 0x85, 0x5e,
-	0xc2, 0xb2, 0x65, 0xb3, 0x8a, 0xcc, 0xaf, 0x8d, 0xb9, 0x4c, 0x17, 0x30, 0xfa, 0x5d, 0xb2, 0x76,
-	0xab, 0x0b, 0x8d, 0x5f, 0x13, 0x25, 0xb5, 0x61, 0x13, 0x6f, 0x16, 0xd6, 0xf3, 0xea, 0x7b, 0x28,
-	0xc8, 0x00, 0x2a, 0x41, 0x3a, 0x68, 0x90, 0x72, 0x1a, 0xfd, 0x49, 0x43, 0xea, 0xde, 0x18, 0x8e,
-	0x79, 0xc9, 0xca, 0x68, 0xfc, 0xe3, 0x7d, 0xea, 0xad, 0xa2, 0x0e, 0x20, 0x17, 0x9e, 0xe5, 0xc7,
-	0x4d, 0x89, 0xf1, 0xf9, 0x2e, 0x3d, 0x37, 0xdf, 0x5d, 0x40, 0xf9, 0xc4, 0xb2, 0x8d, 0xa1, 0xf5,
-	0x0d, 0x96, 0x23, 0xf1, 0x3b, 0x9d, 0xf7, 0x60, 0x80, 0xa8, 0x1f, 0xa1, 0x12, 0x97, 0x18, 0xc5,
-	0x03, 0x9b, 0xdb, 0xe3, 0x22, 0x39, 0x89, 0x89, 0xdc, 0x83, 0x02, 0xed, 0x44, 0x6e, 0xe8, 0x62,
-	0xda, 0x8f, 0xa4, 0x38, 0x87, 0x67, 0x4c, 0x98, 0xbc, 0xde, 0x54, 0x2d, 0xf3, 0x4b, 0x8e, 0x99,
-	0x25, 0x2c, 0x13, 0x67, 0xfc, 0x26, 0x0b, 0x88, 0x62, 0xb7, 0x64, 0xb0, 0x28, 0xdf, 0x33, 0x58,
-	0x3e, 0xfb, 0x86, 0xcf, 0x8b, 0x41, 0x8b, 0x90, 0x87, 0x95, 0xcb, 0xf3, 0xd6, 0x79, 0xe7, 0xea,
-	0xbc, 0xf4, 0x04, 0x6d, 0x41, 0xf9, 0xea, 0xb4, 0x77, 0xde, 0xe8, 0x76, 0xf5, 0x8b, 0xcb, 0xa3,
-	0x56, 0xe3, 0xa3, 0xde, 0x3c, 0xec, 0x36, 0x4b, 0x0a, 0xfa, 0x04, 0xaa, 0xe7, 0x8d, 0x6e, 0xaf,
-	0x71, 0xac, 0x2f, 0xc2, 0x53, 0xe8, 0xe7, 0xf0, 0xa2, 0xf9, 0xf1, 0x48, 0x3b, 0x3d, 0xd6, 0x1f,
-	0x61, 0x4b, 0x7f, 0xf6, 0xd7, 0x34, 0xe4, 0xa5, 0x66, 0x05, 0x95, 0x61, 0x4d, 0x6c, 0x1e, 0x2c,
-	0x28, 0x3d, 0x41, 0xdb, 0x50, 0xa9, 0x77, 0xce, 0xce, 0x4e, 0x7b, 0x67, 0x8d, 0xf3, 0x9e, 0xde,
-	0x3b, 0x3d, 0x6b, 0xe8, 0xed, 0x4e, 0xbd, 0x55, 0x52, 0xa8, 0x7a, 0x12, 0x72, 0xde, 0xd1, 0x8f,
-	0x1b, 0xed, 0xc3, 0x8f, 0xa5, 0x14, 0xda, 0x80, 0x75, 0x09, 0xd0, 0x1a, 0x1f, 0x3a, 0xad, 0x46,
-	0x29, 0x4d, 0xf9, 0x9b, 0xbd, 0x76, 0x5d, 0xef, 0x9c, 0x9c, 0x34, 0xb4, 0xc6, 0x71, 0x00, 0x64,
-	0xe8, 0x16, 0x0c, 0x38, 0xac, 0xd7, 0x1b, 0x17, 0xbd, 0x08, 0x59, 0x62, 0x07, 0x91, 0x97, 0xd0,
-	0xed, 0x3b, 0x97, 0x3d, 0xbd, 0xdb, 0xa8, 0x77, 0xce, 0x8f, 0xf5, 0x76, 0xe3, 0x43, 0xa3, 0x5d,
-	0x5a, 0x46, 0xbf, 0x00, 0x35, 0x2e, 0xa0, 0x7b, 0x59, 0xaf, 0xd3, 0xf3, 0xc6, 0xf8, 0x56, 0xd0,
-	0x2e, 0x3c, 0x4b, 0x68, 0x70, 0xd6, 0xe9, 0x35, 0x02, 0xa9, 0xa5, 0x2c, 0xda, 0x83, 0x9d, 0xa4,
-	0x26, 0x8c, 0x43, 0xc8, 0x2b, 0xe5, 0xd0, 0x0e, 0x6c, 0x33, 0x0e, 0x59, 0x72, 0xa0, 0x2f, 0xa0,
-	0x0a, 0x94, 0x02, 0x53, 0x87, 0x76, 0xce, 0xa3, 0x67, 0xb0, 0x95, 0xf0, 0x43, 0x08, 0x16, 0x12,
-	0xc6, 0x3a, 0x3c, 0xaf, 0x37, 0x3b, 0x5a, 0xa9, 0xf8, 0xfa, 0x1f, 0x79, 0xc8, 0x5d, 0xb1, 0xe0,
-	0x69, 0x59, 0x04, 0xb5, 0x21, 0x2f, 0x3d, 0x05, 0xa1, 0xe7, 0x89, 0x82, 0x16, 0x7f, 0x8c, 0xaa,
-	0x7e, 0xf2, 0x10, 0x1c, 0x96, 0xdd, 0xbc, 0xf4, 0x36, 0x13, 0x97, 0x36, 0xf7, 0xf4, 0x12, 0x97,
-	0xb6, 0xe0, 0x49, 0x47, 0x83, 0x62, 0xec, 0x75, 0x05, 0xed, 0x4a, 0x0b, 0x16, 0x3d, 0xe6, 0x54,
-	0xf7, 0x1e, 0x66, 0x10, 0x32, 0x4f, 0x01, 0xa2, 0x24, 0x43, 0x3b, 0x89, 0xf3, 0xc4, 0x12, 0xb2,
-	0xfa, 0xfc, 0x01, 0x54, 0x88, 0x7a, 0x0f, 0xc5, 0x63, 0xec, 0x59, 0xf7, 0xf8, 0x1c, 0x4f, 0x09,
-	0x9d, 0x10, 0xd7, 0x25, 0x7e, 0x3e, 0x57, 0x56, 0x37, 0xc3, 0x21, 0xa9, 0x85, 0x67, 0xc7, 0xd8,
-	0xef, 0x7b, 0x96, 0x4b, 0x1c, 0x0f, 0xbd, 0x85, 0x1c, 0x5f, 0x4b, 0xd7, 0x95, 0x65, 0xa6, 0xb6,
-	0xd3, 0x37, 0x88, 0xe3, 0x3d, 0xb8, 0xf2, 0xb7, 0x90, 0xa5, 0xfb, 0xd1, 0xd4, 0x46, 0xc9, 0xe1,
-	0x33, 0x50, 0x7c, 0x6b, 0x8e, 0x2e, 0x54, 0xee, 0x40, 0x41, 0x7e, 0x2b, 0x40, 0x49, 0x7f, 0x26,
-	0x9e, 0x2a, 0xaa, 0xbb, 0x0f, 0xe2, 0x91, 0x8b, 0x62, 0x63, 0x76, 0xcc, 0x45, 0x8b, 0x5e, 0x13,
-	0x62, 0x2e, 0x5a, 0x38, 0xa1, 0xa3, 0x3f, 0xc1, 0x5a, 0x62, 0x5a, 0x46, 0x2f, 0xe6, 0x16, 0x25,
-	0xa7, 0xf7, 0xaa, 0xfa, 0x18, 0x8b, 0x90, 0xdc, 0x04, 0x24, 0x06, 0x66, 0x79, 0xe6, 0x96, 0xad,
-	0x28, 0xd1, 0xab, 0x55, 0xb9, 0x0b, 0x4f, 0xcc, 0xd9, 0x6d, 0xc8, 0x4b, 0x33, 0x68, 0x2c, 0xd0,
-	0xe7, 0x27, 0xeb, 0x58, 0xa0, 0x2f, 0x1a, 0x5d, 0xdb, 0x90, 0x97, 0x86, 0xcd, 0x98, 0xb4, 0xf9,
-	0xd9, 0x35, 0x26, 0x6d, 0xd1, 0x8c, 0xaa, 0x41, 0x31, 0x36, 0x6e, 0xc4, 0x7c, 0xb2, 0x68, 0x40,
-	0x89, 0xf9, 0x64, 0xf1, 0xa4, 0xf2, 0x07, 0x58, 0x11, 0x8d, 0x3a, 0x7a, 0x2a, 0x31, 0xc7, 0x87,
-	0x8f, 0x98, 0xc5, 0x12, 0x7d, 0x7d, 0x90, 0x78, 0x42, 0xa5, 0x9d, 0x07, 0x1a, 0xe7, 0xc5, 0x89,
-	0x97, 0x50, 0xe6, 0x2f, 0x50, 0x4a, 0x76, 0xa3, 0x48, 0x76, 0xff, 0x03, 0xdd, 0x70, 0xf5, 0xd3,
-	0x47, 0x79, 0x84, 0xf0, 0x3a, 0x64, 0x83, 0x0e, 0x10, 0xc9, 0xe7, 0x49, 0xb4, 0xb8, 0xd5, 0x67,
-	0x0b, 0xb1, 0x28, 0xcf, 0xe4, 0xd6, 0x21, 0x96, 0x67, 0x0b, 0xba, 0x94, 0x58, 0x9e, 0x2d, 0xea,
-	0x39, 0x8e, 0x7e, 0xf5, 0xe7, 0x83, 0x5b, 0x8b, 0x0c, 0xc6, 0xd7, 0xb5, 0xbe, 0x33, 0x3a, 0x18,
-	0xd2, 0x61, 0xda, 0xb6, 0xec, 0x5b, 0x1b, 0x93, 0x89, 0xe3, 0xdd, 0x1d, 0x0c, 0x6d, 0xf3, 0x80,
-	0x75, 0x4e, 0x07, 0xa1, 0x9c, 0xeb, 0x65, 0xf6, 0x5f, 0x85, 0x2f, 0xff, 0x1f, 0x00, 0x00, 0xff,
-	0xff, 0x0a, 0xc3, 0xd3, 0xee, 0x9e, 0x18, 0x00, 0x00,
+	// 2391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xdd, 0x52, 0x23, 0xc7,
+	0xf5, 0x5f, 0x7d, 0x20, 0xa4, 0x23, 0x01, 0xa2, 0x25, 0x10, 0x3b, 0xcb, 0x02, 0x3b, 0xfe, 0xff,
+	0x63, 0x62, 0xaf, 0xa1, 0x82, 0xe3, 0xd8, 0xde, 0xa4, 0x52, 0x01, 0x21, 0x4a, 0x14, 0x02, 0x91,
+	0x91, 0x58, 0xb2, 0xc9, 0xc5, 0xd4, 0xa0, 0x69, 0xd0, 0x14, 0xd2, 0xcc, 0x78, 0xa6, 0xb5, 0x92,
+	0x7c, 0xe5, 0x37, 0xc8, 0x75, 0xaa, 0xf2, 0x0e, 0x79, 0x81, 0xbc, 0x40, 0x2a, 0x6f, 0x91, 0x47,
+	0xc8, 0xb5, 0x2f, 0x52, 0xfd, 0x31, 0x33, 0x3d, 0x23, 0x89, 0xb5, 0xcb, 0xbe, 0x42, 0x73, 0x7e,
+	0xa7, 0x4f, 0x9f, 0x3e, 0xe7, 0x74, 0x9f, 0x0f, 0xe0, 0xf9, 0xd8, 0x18, 0x0c, 0x30, 0xf1, 0xdc,
+	0xde, 0x21, 0xff, 0xf5, 0x68, 0x91, 0x03, 0xd7, 0x73, 0x88, 0x83, 0x0a, 0x21, 0xa4, 0x14, 0x3c,
+	0xb7, 0xc7, 0xa9, 0x4a, 0xd5, 0xb7, 0x1e, 0x6c, 0xca, 0x4e, 0xff, 0x62, 0x8f, 0x53, 0xd5, 0x3e,
+	0xa0, 0x96, 0xe5, 0x93, 0x1b, 0xdb, 0x77, 0xb1, 0x4d, 0x34, 0xfc, 0xcd, 0x08, 0xfb, 0x04, 0xbd,
+	0x80, 0xc2, 0xd0, 0xb2, 0xf5, 0x9e, 0x63, 0xdf, 0xfb, 0x5b, 0xa9, 0xbd, 0xd4, 0xfe, 0x92, 0x96,
+	0x1f, 0x5a, 0x76, 0x9d, 0x7e, 0x33, 0xd0, 0x98, 0x08, 0x30, 0x2d, 0x40, 0x63, 0xc2, 0xc1, 0x2d,
+	0x58, 0x36, 0x7a, 0x3d, 0x67, 0x64, 0x93, 0xad, 0xcc, 0x5e, 0x6a, 0xbf, 0xa0, 0x05, 0x9f, 0xea,
+	0x57, 0x50, 0x89, 0xed, 0xe4, 0xbb, 0x8e, 0xed, 0x63, 0xf4, 0x0a, 0x96, 0x46, 0x64, 0xe2, 0xd0,
+	0x6d, 0x32, 0xfb, 0xc5, 0xa3, 0xe2, 0xc1, 0x80, 0x2a, 0x79, 0x70, 0x43, 0x26, 0x8e, 0xc6, 0x11,
+	0xf5, 0xbb, 0x14, 0xa0, 0x16, 0x36, 0x7c, 0xdc, 0x1e, 0x11, 0x77, 0x14, 0x2a, 0xb9, 0x0a, 0x69,
+	0xcb, 0x64, 0xda, 0x95, 0xb4, 0xb4, 0x65, 0xa2, 0x4f, 0x21, 0xef, 0x8c, 0x88, 0xeb, 0x58, 0x36,
+	0x61, 0x6a, 0x15, 0x8f, 0xd6, 0x84, 0xb0, 0xf6, 0x88, 0x5c, 0x53, 0xb2, 0x16, 0x32, 0xa0, 0xcf,
+	0x00, 0xe1, 0x89, 0x6b, 0x79, 0x06, 0xb1, 0x1c, 0x5b, 0xf7, 0x71, 0xcf, 0xb1, 0x4d, 0x9f, 0xa9,
+	0x9c, 0xd5, 0xd6, 0x23, 0xa4, 0xc3, 0x01, 0xf5, 0x0b, 0xa8, 0xc4, 0x34, 0x10, 0xca, 0xef, 0x00,
+	0x44, 0xbc, 0x4c, 0x95, 0xac, 0x26, 0x51, 0xd4, 0x0e, 0x54, 0x35, 0x3c, 0xf8, 0x79, 0x55, 0x57,
+	0x6b, 0xb0, 0x91, 0x10, 0xca, 0xb5, 0x51, 0xff, 0x08, 0xb9, 0x0b, 0x3c, 0xd5, 0xf0, 0x37, 0x68,
+	0x1f, 0xca, 0x8f, 0x78, 0xaa, 0xdf, 0x5b, 0xf6, 0x03, 0xf6, 0x74, 0xd7, 0xa3, 0x72, 0xb9, 0x1b,
+	0x57, 0x1f, 0xf1, 0xf4, 0x8c, 0x91, 0xaf, 0x29, 0x15, 0xbd, 0x04, 0x60, 0x9c, 0xc6, 0xd0, 0x1a,
+	0x4c, 0x85, 0x37, 0x0b, 0x94, 0x87, 0x11, 0xd4, 0x8f, 0xa1, 0x78, 0x6c, 0x9a, 0x5e, 0xa0, 0xb7,
+	0xe4, 0xdd, 0x54, 0xdc, 0xbb, 0x2a, 0x94, 0x38, 0xa3, 0xb0, 0x0c, 0x82, 0xac, 0x61, 0x9a, 0x9e,
+	0x60, 0x63, 0xbf, 0xd5, 0xff, 0xa4, 0x61, 0xf9, 0x98, 0xf3, 0x53, 0xdc, 0x36, 0x86, 0x38, 0xc0,
+	0xe9, 0x6f, 0xf4, 0x35, 0x94, 0x28, 0x1f, 0xf6, 0x7d, 0x9d, 0x4c, 0x5d, 0xcc, 0xb4, 0x59, 0x3d,
+	0xda, 0x3c, 0x08, 0xc3, 0xf9, 0xe0, 0x98, 0xc3, 0xdd, 0xa9, 0x8b, 0xb5, 0xa2, 0x11, 0x7d, 0xa0,
+	0x03, 0xa8, 0xe0, 0x09, 0xc1, 0xb6, 0x89, 0x4d, 0xdd, 0x1d, 0xdd, 0x0d, 0xac, 0x9e, 0xfe, 0x88,
+	0xa7, 0x22, 0x04, 0xd7, 0x03, 0xe8, 0x9a, 0x21, 0x17, 0x78, 0x8a, 0x7e, 0x0d, 0x9b, 0x43, 0xc3,
+	0x27, 0xd8, 0xd3, 0x23, 0x3b, 0x71, 0x33, 0x65, 0xf7, 0x52, 0xfb, 0x2b, 0x5a, 0x95, 0xa3, 0x17,
+	0x81, 0xb1, 0x18, 0x86, 0x3e, 0x86, 0x35, 0x13, 0x7b, 0xd6, 0x7b, 0x1e, 0x34, 0xae, 0x41, 0xfa,
+	0x5b, 0x4b, 0x6c, 0x87, 0xd5, 0x88, 0x7c, 0x6d, 0x90, 0x3e, 0x7a, 0x4d, 0xa3, 0x8b, 0x60, 0xcf,
+	0x36, 0x06, 0x6c, 0x03, 0x6e, 0xb2, 0x1c, 0x13, 0x5d, 0x0e, 0x90, 0x0b, 0x3c, 0xad, 0x33, 0x5b,
+	0xbc, 0x06, 0x64, 0xd9, 0x33, 0xdc, 0xcb, 0x9c, 0xdb, 0xb2, 0x13, 0xdc, 0x2f, 0x01, 0xc6, 0x06,
+	0xe9, 0xf5, 0x75, 0xc7, 0x1e, 0x4c, 0xb7, 0xf2, 0x7b, 0xa9, 0xfd, 0xbc, 0x56, 0x60, 0x94, 0xb6,
+	0x3d, 0x98, 0xaa, 0x26, 0xbf, 0x66, 0xc2, 0xce, 0x7e, 0xe0, 0xb9, 0x9f, 0xd7, 0xde, 0xea, 0x19,
+	0x54, 0xe3, 0xbb, 0x08, 0xb7, 0x1f, 0x40, 0x5e, 0x44, 0x44, 0x70, 0xa1, 0x91, 0x2c, 0x8e, 0x43,
+	0x5a, 0xc8, 0xa3, 0xfe, 0x3b, 0x05, 0xd5, 0xf3, 0xa1, 0xeb, 0x78, 0x81, 0xa8, 0xa7, 0xf4, 0x5d,
+	0xe0, 0xe4, 0xf4, 0x8f, 0x77, 0x72, 0xe6, 0x09, 0x27, 0x27, 0xad, 0x92, 0xfd, 0xe1, 0x56, 0xa9,
+	0xc1, 0x46, 0xe2, 0x30, 0xe2, 0x66, 0x7a, 0xb0, 0xc9, 0x81, 0x50, 0xb9, 0xe0, 0x9c, 0x2f, 0x01,
+	0xa4, 0xa3, 0xf0, 0x17, 0xa1, 0xe0, 0x86, 0x47, 0xf8, 0x09, 0x2e, 0x7a, 0x0e, 0xb5, 0x99, 0x3d,
+	0x85, 0x3a, 0x6f, 0xa0, 0xd8, 0xf5, 0x0c, 0xdb, 0x37, 0x7a, 0x34, 0x62, 0xd1, 0x06, 0xe4, 0xc8,
+	0x44, 0xef, 0xe3, 0x89, 0xd8, 0x7f, 0x89, 0x4c, 0x9a, 0x78, 0x82, 0xaa, 0xb0, 0x34, 0x30, 0xee,
+	0xf0, 0x40, 0x18, 0x98, 0x7f, 0xa8, 0xbf, 0x81, 0x35, 0x26, 0xd0, 0xef, 0x87, 0x4e, 0xff, 0x08,
+	0x56, 0x5c, 0x4e, 0xd2, 0xb1, 0xe7, 0x39, 0xc1, 0xa5, 0x2f, 0x09, 0x62, 0x83, 0xd2, 0xd4, 0x7f,
+	0xa6, 0x00, 0x75, 0xb0, 0x6d, 0xf2, 0x37, 0x2b, 0x8c, 0xcb, 0x6d, 0x00, 0xdf, 0x20, 0xba, 0x4b,
+	0x9d, 0x34, 0x66, 0x0b, 0x33, 0x5a, 0xde, 0x37, 0xc8, 0x35, 0xf6, 0x2e, 0xc6, 0x68, 0x1f, 0x96,
+	0x1d, 0xce, 0xbf, 0x95, 0x66, 0xd1, 0xb4, 0x7a, 0x20, 0xb2, 0xd8, 0x41, 0x77, 0xd2, 0x1e, 0x11,
+	0x2d, 0x80, 0x23, 0x65, 0x33, 0x92, 0xb2, 0xf1, 0x3c, 0x96, 0x4d, 0xe4, 0xb1, 0x4f, 0x61, 0x9d,
+	0xa6, 0x22, 0x53, 0x1f, 0xd9, 0x94, 0xc1, 0xf2, 0x86, 0xd8, 0x64, 0xf7, 0x39, 0xaf, 0x95, 0x19,
+	0x70, 0x13, 0xd1, 0xd5, 0xd7, 0x50, 0x89, 0x69, 0x2f, 0x8e, 0xbe, 0x01, 0x39, 0xcf, 0x18, 0xeb,
+	0x24, 0x34, 0x9d, 0x67, 0x8c, 0xbb, 0x13, 0xf5, 0x0b, 0x40, 0x0d, 0x9f, 0x58, 0x43, 0x83, 0xe0,
+	0x33, 0x8c, 0x83, 0xb3, 0xee, 0x42, 0x91, 0x0a, 0xd4, 0x89, 0xe1, 0x3d, 0xe0, 0xe0, 0x41, 0x06,
+	0x4a, 0xea, 0x32, 0x8a, 0xfa, 0x39, 0x54, 0x62, 0xcb, 0xc4, 0x26, 0x4f, 0xda, 0x48, 0xfd, 0x3e,
+	0x03, 0xa5, 0x6b, 0x6c, 0x9b, 0x96, 0xfd, 0xd0, 0x19, 0x63, 0xec, 0xc6, 0x92, 0x49, 0xea, 0x43,
+	0x79, 0xf0, 0x6b, 0x28, 0x8d, 0x2d, 0x62, 0x3f, 0x11, 0x60, 0xb7, 0x1c, 0xe6, 0x01, 0x36, 0x8e,
+	0x3e, 0x68, 0xe8, 0x1a, 0x43, 0x1a, 0xe6, 0xba, 0x6f, 0x04, 0x57, 0xaa, 0xc0, 0x29, 0x1d, 0x83,
+	0xa0, 0xff, 0x83, 0x52, 0xa0, 0xf5, 0xdd, 0x94, 0xf0, 0x7b, 0xb4, 0x72, 0x92, 0xde, 0x4a, 0x69,
+	0xc0, 0x75, 0x3f, 0x99, 0x12, 0x4c, 0xf3, 0xf0, 0x9d, 0xe7, 0x18, 0x66, 0xcf, 0xf0, 0x89, 0x6e,
+	0x10, 0x82, 0x87, 0x2e, 0xf1, 0x99, 0x17, 0x56, 0xb4, 0xf5, 0x10, 0x39, 0x16, 0x00, 0x3a, 0x82,
+	0x0d, 0x1b, 0x4f, 0x88, 0x1e, 0xad, 0xe9, 0x63, 0xeb, 0xa1, 0x1f, 0xbc, 0xad, 0x15, 0x0a, 0x9e,
+	0x04, 0x58, 0x93, 0x41, 0x74, 0x8d, 0xc7, 0x3d, 0x80, 0x4d, 0x5d, 0x76, 0x40, 0x9e, 0xaf, 0x09,
+	0xc1, 0x7a, 0xe8, 0x09, 0xf4, 0x25, 0x6c, 0x46, 0x6b, 0x62, 0xc7, 0x28, 0x84, 0xc7, 0x88, 0x16,
+	0x76, 0xa2, 0xf3, 0xa8, 0xb0, 0x12, 0xb0, 0xbf, 0x67, 0xfc, 0xc0, 0x8a, 0x82, 0x22, 0x3f, 0xf2,
+	0x5b, 0x4a, 0x42, 0x5f, 0x40, 0x6d, 0x56, 0x38, 0xe7, 0x2e, 0x32, 0xee, 0x6a, 0x42, 0x32, 0x5f,
+	0x56, 0x85, 0xa5, 0x7b, 0xc7, 0xeb, 0x61, 0x96, 0x19, 0xf2, 0x1a, 0xff, 0x50, 0x37, 0xa1, 0x2a,
+	0x7b, 0x3f, 0xb8, 0x58, 0xea, 0x2d, 0x6c, 0x24, 0xe8, 0x22, 0x9a, 0x7e, 0x0f, 0xab, 0x2e, 0x07,
+	0x74, 0x9f, 0x21, 0xe2, 0xa1, 0xae, 0x49, 0x3e, 0x97, 0x57, 0x6a, 0x2b, 0xae, 0x2c, 0x47, 0xfd,
+	0x6f, 0x0a, 0x56, 0x4f, 0x46, 0x43, 0x57, 0x0a, 0xec, 0x1f, 0x15, 0x71, 0xbb, 0x50, 0xe4, 0xf6,
+	0x67, 0xbe, 0x60, 0x01, 0xb7, 0xa2, 0x01, 0x27, 0x51, 0x0f, 0xcc, 0x04, 0x4e, 0x66, 0x6e, 0xe0,
+	0x84, 0xd6, 0xc8, 0x4a, 0xd6, 0x98, 0x35, 0xff, 0xd2, 0xac, 0xf9, 0x8f, 0x00, 0x7a, 0x7d, 0xc3,
+	0xd6, 0xb9, 0xbe, 0x39, 0xa6, 0x6f, 0x45, 0xe8, 0x5b, 0xef, 0x1b, 0xb6, 0x8d, 0x07, 0x5c, 0xe7,
+	0x02, 0x65, 0x63, 0x3f, 0xd5, 0x75, 0x58, 0x0b, 0xcf, 0x2c, 0x1e, 0xd1, 0xcf, 0x60, 0x9d, 0xa6,
+	0xc0, 0x98, 0xd5, 0x69, 0x81, 0xf4, 0x1e, 0x7b, 0x77, 0x8e, 0xcf, 0x33, 0x57, 0x5e, 0x0b, 0x3e,
+	0xd5, 0xef, 0xd2, 0x80, 0x64, 0x7e, 0xe1, 0x8d, 0x16, 0x54, 0x48, 0xf4, 0x14, 0xeb, 0x26, 0x26,
+	0x86, 0x35, 0xf0, 0x85, 0x15, 0x9f, 0x0b, 0xad, 0xa4, 0xc7, 0xfa, 0x94, 0x33, 0x34, 0x9f, 0x69,
+	0x88, 0xcc, 0x50, 0xd1, 0x2d, 0xac, 0xc9, 0xd2, 0x2c, 0xd3, 0x17, 0xe5, 0xe4, 0x6b, 0xc9, 0xb9,
+	0xb3, 0x5a, 0xc8, 0x1b, 0x9c, 0x9f, 0x52, 0xe1, 0xab, 0x92, 0x98, 0x73, 0xd3, 0x57, 0xbe, 0x86,
+	0xd5, 0x38, 0x0f, 0xad, 0x85, 0x92, 0x5b, 0xd1, 0x38, 0x2a, 0x24, 0x97, 0x9e, 0xe4, 0x21, 0xc7,
+	0xe3, 0x4c, 0x35, 0xa0, 0xd6, 0xa2, 0xcf, 0xb2, 0x24, 0x49, 0x4a, 0xf7, 0x64, 0x12, 0x96, 0xc4,
+	0xec, 0xf7, 0xfc, 0xfc, 0x83, 0xb6, 0xa1, 0xe0, 0xbc, 0xc7, 0xde, 0xd8, 0xb3, 0x44, 0x68, 0xe4,
+	0xb5, 0x88, 0xa0, 0x2a, 0xb0, 0x35, 0xbb, 0x85, 0x70, 0xd8, 0xbf, 0x52, 0xb0, 0x76, 0x36, 0xb2,
+	0xcd, 0x6b, 0xff, 0x2e, 0x2c, 0x33, 0xaa, 0x90, 0x75, 0xfd, 0x3b, 0x1e, 0xb5, 0xa5, 0xe6, 0x33,
+	0x8d, 0x7d, 0xa1, 0x5f, 0x42, 0xc6, 0x33, 0xc6, 0xc2, 0x74, 0x1b, 0x92, 0xe9, 0xba, 0x93, 0x2e,
+	0x1e, 0xba, 0x03, 0x83, 0xe0, 0xe6, 0x33, 0x8d, 0xf2, 0xa0, 0x57, 0xf1, 0x68, 0x66, 0xb1, 0xda,
+	0x4c, 0x25, 0xe2, 0x39, 0x11, 0x93, 0x34, 0x62, 0xb3, 0xcd, 0x54, 0x3c, 0x2a, 0xa5, 0xd2, 0x7a,
+	0x29, 0x56, 0x5a, 0x9f, 0x00, 0xe4, 0x89, 0xd8, 0xf5, 0x24, 0x07, 0xd9, 0x7b, 0x8c, 0x7d, 0xf5,
+	0xef, 0x29, 0x28, 0x47, 0x67, 0x11, 0xb1, 0xb4, 0x0b, 0xc5, 0xfb, 0x11, 0xaf, 0x8e, 0xc2, 0x33,
+	0x69, 0xc0, 0x49, 0x94, 0x91, 0x16, 0x50, 0x34, 0xa4, 0x1f, 0xb0, 0xce, 0xd3, 0xa6, 0x6e, 0xd9,
+	0x26, 0x9e, 0x88, 0xaa, 0x7f, 0x9d, 0x43, 0x3c, 0xc3, 0x9d, 0x53, 0x00, 0x7d, 0x09, 0xa5, 0x81,
+	0xd3, 0x7b, 0xc4, 0xa6, 0xce, 0x5b, 0xb4, 0x0c, 0x7b, 0x28, 0xaa, 0x92, 0x41, 0x68, 0x9b, 0xc6,
+	0x1a, 0x23, 0xad, 0xc8, 0x39, 0x6f, 0x58, 0xc7, 0xf6, 0x8f, 0x14, 0x40, 0x64, 0x2b, 0xf4, 0x31,
+	0xe4, 0x2c, 0x9b, 0x65, 0x71, 0xfe, 0xd4, 0xcc, 0xbc, 0x0e, 0x02, 0x46, 0xbf, 0x4b, 0xe6, 0x7b,
+	0x75, 0xae, 0xf1, 0x0f, 0x44, 0x1a, 0x6e, 0xd8, 0xc4, 0x9b, 0x86, 0x35, 0x80, 0xf2, 0x06, 0x4a,
+	0x32, 0x80, 0xca, 0x90, 0x09, 0x8a, 0xaa, 0x82, 0x46, 0x7f, 0xd2, 0x90, 0x7a, 0x6f, 0x0c, 0x46,
+	0x3c, 0xcd, 0x65, 0x35, 0xfe, 0xf1, 0x26, 0xfd, 0x55, 0x4a, 0xed, 0x43, 0x21, 0x3c, 0xcb, 0x4f,
+	0xeb, 0x2c, 0xe3, 0x3d, 0x61, 0x66, 0xa6, 0x27, 0xbc, 0x86, 0xca, 0x99, 0x65, 0x1b, 0x03, 0xeb,
+	0x5b, 0x2c, 0x47, 0xe2, 0x07, 0x9d, 0xb7, 0x30, 0x40, 0xd4, 0x77, 0x50, 0x8d, 0x4b, 0x8c, 0xe2,
+	0x81, 0xf5, 0xfa, 0x71, 0x91, 0x9c, 0xc4, 0x44, 0xee, 0x41, 0x89, 0x56, 0x2f, 0xf7, 0x74, 0x31,
+	0xad, 0x61, 0xd2, 0x9c, 0xc3, 0x33, 0xc6, 0x4c, 0x5e, 0x77, 0xa2, 0x56, 0xf8, 0x23, 0xc7, 0xcc,
+	0x12, 0xa6, 0x96, 0x4b, 0x40, 0x32, 0x51, 0xec, 0x96, 0x0c, 0x96, 0xd4, 0x0f, 0x0d, 0x96, 0x31,
+	0xd4, 0xea, 0x8e, 0xed, 0x3b, 0x03, 0xcb, 0x34, 0x08, 0x66, 0xb4, 0xc0, 0x28, 0x33, 0xcf, 0x79,
+	0x6a, 0xf6, 0x39, 0xff, 0x60, 0x3e, 0xa9, 0xc1, 0xb2, 0xe9, 0x4d, 0x75, 0x6f, 0x64, 0x8b, 0xf7,
+	0x22, 0x67, 0x7a, 0x53, 0x6d, 0x64, 0xab, 0xdf, 0xa7, 0x60, 0x6b, 0x76, 0xe7, 0x27, 0x2b, 0xbb,
+	0xf0, 0xa1, 0xe2, 0x6f, 0x12, 0xfb, 0x1d, 0xf6, 0xba, 0x99, 0xa8, 0xd7, 0x95, 0x42, 0x3e, 0xfb,
+	0x74, 0xc8, 0xc7, 0xab, 0xa8, 0x25, 0x56, 0xdc, 0x49, 0x55, 0x54, 0x0d, 0x96, 0xef, 0x31, 0x66,
+	0x58, 0x8e, 0x61, 0xb9, 0x7b, 0x8c, 0x3b, 0xc6, 0x1c, 0xd3, 0x2c, 0xcf, 0x9a, 0x66, 0x1b, 0x0a,
+	0xa2, 0x06, 0xc7, 0x66, 0xd0, 0x29, 0x86, 0x84, 0x4f, 0xbe, 0xe5, 0xbd, 0x7d, 0x50, 0xce, 0x15,
+	0x61, 0xf9, 0xe6, 0xea, 0xe2, 0xaa, 0x7d, 0x7b, 0x55, 0x7e, 0x86, 0x6a, 0x50, 0xb9, 0x3d, 0xef,
+	0x5e, 0x35, 0x3a, 0x1d, 0xfd, 0xfa, 0xe6, 0xe4, 0xa2, 0xf1, 0x4e, 0x6f, 0x1e, 0x77, 0x9a, 0xe5,
+	0x14, 0xda, 0x01, 0xe5, 0xaa, 0xd1, 0xe9, 0x36, 0x4e, 0xf5, 0x79, 0x78, 0x1a, 0xfd, 0x3f, 0xbc,
+	0x6a, 0xbe, 0x3b, 0xd1, 0xce, 0x4f, 0xf5, 0x27, 0xd8, 0x32, 0x9f, 0xfc, 0x2d, 0x03, 0x45, 0xa9,
+	0xb0, 0x44, 0x15, 0x58, 0x13, 0x9b, 0x07, 0x0b, 0xca, 0xcf, 0xd0, 0x16, 0x54, 0xeb, 0xed, 0xcb,
+	0xcb, 0xf3, 0xee, 0x65, 0xe3, 0xaa, 0xab, 0x77, 0xcf, 0x2f, 0x1b, 0x7a, 0xab, 0x5d, 0xbf, 0x28,
+	0xa7, 0xa8, 0x7a, 0x12, 0x72, 0xd5, 0xd6, 0x4f, 0x1b, 0xad, 0xe3, 0x77, 0xe5, 0x34, 0xda, 0x80,
+	0x75, 0x09, 0xd0, 0x1a, 0x6f, 0xdb, 0x17, 0x8d, 0x72, 0x86, 0xf2, 0x37, 0xbb, 0xad, 0xba, 0xde,
+	0x3e, 0x3b, 0x6b, 0x68, 0x8d, 0xd3, 0x00, 0xc8, 0xd2, 0x2d, 0x18, 0x70, 0x5c, 0xaf, 0x37, 0xae,
+	0xbb, 0x11, 0xb2, 0xc4, 0x0e, 0x22, 0x2f, 0xa1, 0xdb, 0xb7, 0x6f, 0xba, 0x7a, 0xa7, 0x51, 0x6f,
+	0x5f, 0x9d, 0xea, 0xad, 0xc6, 0xdb, 0x46, 0xab, 0x9c, 0x43, 0xbf, 0x00, 0x35, 0x2e, 0xa0, 0x73,
+	0x53, 0xaf, 0xd3, 0xf3, 0xc6, 0xf8, 0x96, 0xd1, 0x2e, 0xbc, 0x48, 0x68, 0x70, 0xd9, 0xee, 0x36,
+	0x02, 0xa9, 0xe5, 0x3c, 0xda, 0x83, 0xed, 0xa4, 0x26, 0x8c, 0x43, 0xc8, 0x2b, 0x17, 0xd0, 0x36,
+	0x6c, 0x31, 0x0e, 0x59, 0x72, 0xa0, 0x2f, 0xa0, 0x2a, 0x94, 0x03, 0x53, 0x87, 0x76, 0x2e, 0xa2,
+	0x17, 0x50, 0x4b, 0xf8, 0x21, 0x04, 0x4b, 0x09, 0x63, 0x1d, 0x5f, 0xd5, 0x9b, 0x6d, 0xad, 0xbc,
+	0x72, 0xf4, 0xd7, 0x12, 0x14, 0x6e, 0xd9, 0xa5, 0xbd, 0xb0, 0x08, 0x6a, 0x41, 0x51, 0x1a, 0xdb,
+	0xa1, 0x97, 0x89, 0x42, 0x22, 0x3e, 0x38, 0x54, 0x76, 0x16, 0xc1, 0x61, 0xb9, 0x53, 0x94, 0xe6,
+	0x68, 0x71, 0x69, 0x33, 0x63, 0x32, 0x65, 0x67, 0x11, 0x2c, 0xa4, 0x69, 0xb0, 0x12, 0x9b, 0x84,
+	0xa1, 0x5d, 0x69, 0xc1, 0xbc, 0xc1, 0x9b, 0xb2, 0xb7, 0x98, 0x41, 0xc8, 0x3c, 0x07, 0x88, 0x1e,
+	0x37, 0xb4, 0x9d, 0x38, 0x4f, 0xec, 0x21, 0x54, 0x5e, 0x2e, 0x40, 0x85, 0xa8, 0x37, 0xb0, 0x72,
+	0x4a, 0xe7, 0x42, 0xf8, 0x0a, 0x4f, 0x08, 0xed, 0xe6, 0xd7, 0x25, 0x7e, 0x3e, 0x03, 0x50, 0x36,
+	0xc3, 0x86, 0xf6, 0x02, 0x4f, 0x4f, 0xb1, 0xdf, 0xf3, 0x2c, 0x97, 0x38, 0x1e, 0xfa, 0x0a, 0x0a,
+	0x7c, 0x2d, 0x5d, 0x57, 0x91, 0x99, 0x5a, 0x4e, 0xcf, 0x20, 0x8e, 0xb7, 0x70, 0xe5, 0x6f, 0x21,
+	0x4f, 0xf7, 0xa3, 0x57, 0x1b, 0x25, 0x07, 0x05, 0x81, 0xe2, 0xb5, 0x19, 0xba, 0x50, 0xb9, 0x0d,
+	0x25, 0x79, 0xae, 0x83, 0x92, 0xfe, 0x4c, 0x8c, 0x95, 0x94, 0xdd, 0x85, 0x78, 0xe4, 0xa2, 0xd8,
+	0x48, 0x24, 0xe6, 0xa2, 0x79, 0x93, 0x1f, 0x65, 0x6f, 0x31, 0x83, 0x90, 0xf9, 0x27, 0x58, 0x4b,
+	0x4c, 0x36, 0xd0, 0xab, 0x99, 0x45, 0xc9, 0x49, 0x8b, 0xa2, 0x3e, 0xc5, 0x22, 0x24, 0x37, 0x01,
+	0x89, 0xe1, 0x86, 0x3c, 0x1f, 0x91, 0xad, 0x28, 0xd1, 0x15, 0x45, 0xa2, 0x27, 0x67, 0x22, 0x2d,
+	0x28, 0x4a, 0xf3, 0x82, 0x58, 0xa0, 0xcf, 0x4e, 0x41, 0x94, 0x9d, 0x45, 0x70, 0x24, 0x4d, 0x1a,
+	0x0c, 0xc4, 0xa4, 0xcd, 0xce, 0x19, 0x94, 0x9d, 0x45, 0x70, 0xe4, 0x93, 0x58, 0x6b, 0x18, 0xf3,
+	0xc9, 0xbc, 0x66, 0x52, 0xd9, 0x5b, 0xcc, 0x20, 0x64, 0xfe, 0x01, 0x96, 0x45, 0x83, 0x84, 0x9e,
+	0x4b, 0xcc, 0xf1, 0x46, 0x51, 0x51, 0xe6, 0x41, 0xf1, 0x8b, 0x27, 0x54, 0xda, 0x5e, 0xd0, 0xb0,
+	0xcc, 0xbf, 0x78, 0x09, 0x65, 0xfe, 0x02, 0xe5, 0x64, 0x17, 0x80, 0x64, 0xf7, 0x2f, 0xe8, 0x42,
+	0x94, 0x8f, 0x9e, 0xe4, 0x11, 0xc2, 0xeb, 0x90, 0x0f, 0x2a, 0x6f, 0x24, 0x9f, 0x27, 0xd1, 0x5a,
+	0x28, 0x2f, 0xe6, 0x62, 0xd1, 0x3d, 0x93, 0x4b, 0xb6, 0xd8, 0x3d, 0x9b, 0x53, 0x1d, 0x2a, 0xbb,
+	0x0b, 0xf1, 0xe8, 0xc8, 0xc9, 0x52, 0x26, 0x76, 0xe4, 0x05, 0x15, 0x96, 0xf2, 0xd1, 0x93, 0x3c,
+	0x5c, 0xf8, 0xc9, 0xaf, 0xfe, 0x7c, 0xf8, 0x60, 0x91, 0xfe, 0xe8, 0xee, 0xa0, 0xe7, 0x0c, 0x0f,
+	0x07, 0x74, 0xaa, 0x62, 0x5b, 0xf6, 0x83, 0x8d, 0xc9, 0xd8, 0xf1, 0x1e, 0x0f, 0x07, 0xb6, 0x79,
+	0xc8, 0xaa, 0x9b, 0xc3, 0x50, 0xd6, 0x5d, 0x8e, 0xfd, 0x7b, 0xe9, 0xf3, 0xff, 0x0d, 0x00, 0x71,
+	0x5b, 0x0c, 0xf1, 0xa7, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2672,6 +2859,12 @@ type WalletKitClient interface {
 	//done by specifying an outpoint within the low fee transaction that is under
 	//the control of the wallet.
 	//
+	//The fee of an unconfirmed force close of an anchor channel can be bumped
+	//by specifying the channel point instead of an outpoint. The anchors of the
+	//commitment transactions are then swept with a fee chosen such that the
+	//package made up of the commitment and the sweep reaches the requested fee
+	//rate. Repeated calls replace the earlier sweep.
+	//
 	//The fee preference can be expressed either as a specific fee rate or a delta
 	//of blocks in which the output should be swept on-chain within. If a fee
 	//preference is not explicitly specified, then an error is returned.
@@ -2724,6 +2917,15 @@ type WalletKitClient interface {
 	//caller's responsibility to either publish the transaction on success or
 	//unlock/release any locked UTXOs in case of an error in this method.
 	FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error)
+	//
+	//ConsolidateUtxos merges the small confirmed utxos of the wallet into a
+	//single output paying to the configured cold storage address, or to a fresh
+	//address derived from the configured cold storage xpub. Only utxos up to the
+	//configured maximum value are consolidated, and the configured reserve, or
+	//the value required to fee bump our anchor channels if larger, is left in
+	//the wallet. If dry_run is set, the consolidation is crafted but not
+	//published, which allows to preview its result.
+	ConsolidateUtxos(ctx context.Context, in *ConsolidateUtxosRequest, opts ...grpc.CallOption) (*ConsolidateUtxosResponse, error)
 }
 
 type walletKitClient struct {
@@ -2905,6 +3107,15 @@ func (c *walletKitClient) FinalizePsbt(ctx context.Context, in *FinalizePsbtRequ
 	return out, nil
 }
 
+func (c *walletKitClient) ConsolidateUtxos(ctx context.Context, in *ConsolidateUtxosRequest, opts ...grpc.CallOption) (*ConsolidateUtxosResponse, error) {
+	out := new(ConsolidateUtxosResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ConsolidateUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletKitServer is the server API for WalletKit service.
 type WalletKitServer interface {
 	//
@@ -3020,6 +3231,12 @@ type WalletKitServer interface {
 	//done by specifying an outpoint within the low fee transaction that is under
 	//the control of the wallet.
 	//
+	//The fee of an unconfirmed force close of an anchor channel can be bumped
+	//by specifying the channel point instead of an outpoint. The anchors of the
+	//commitment transactions are then swept with a fee chosen such that the
+	//package made up of the commitment and the sweep reaches the requested fee
+	//rate. Repeated calls replace the earlier sweep.
+	//
 	//The fee preference can be expressed either as a specific fee rate or a delta
 	//of blocks in which the output should be swept on-chain within. If a fee
 	//preference is not explicitly specified, then an error is returned.
@@ -3072,6 +3289,15 @@ type WalletKitServer interface {
 	//caller's responsibility to either publish the transaction on success or
 	//unlock/release any locked UTXOs in case of an error in this method.
 	FinalizePsbt(context.Context, *FinalizePsbtRequest) (*FinalizePsbtResponse, error)
+	//
+	//ConsolidateUtxos merges the small confirmed utxos of the wallet into a
+	//single output paying to the configured cold storage address, or to a fresh
+	//address derived from the configured cold storage xpub. Only utxos up to the
+	//configured maximum value are consolidated, and the configured reserve, or
+	//the value required to fee bump our anchor channels if larger, is left in
+	//the wallet. If dry_run is set, the consolidation is crafted but not
+	//published, which allows to preview its result.
+	ConsolidateUtxos(context.Context, *ConsolidateUtxosRequest) (*ConsolidateUtxosResponse, error)
 }
 
 // UnimplementedWalletKitServer can be embedded to have forward compatible implementations.
@@ -3135,6 +3361,9 @@ func (*UnimplementedWalletKitServer) FundPsbt(ctx context.Context, req *FundPsbt
 func (*UnimplementedWalletKitServer) FinalizePsbt(ctx context.Context, req *FinalizePsbtRequest) (*FinalizePsbtResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizePsbt not implemented")
 }
+func (*UnimplementedWalletKitServer) ConsolidateUtxos(ctx context.Context, req *ConsolidateUtxosRequest) (*ConsolidateUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidateUtxos not implemented")
+}
 
 func RegisterWalletKitServer(s *grpc.Server, srv WalletKitServer) {
 	s.RegisterService(&_WalletKit_serviceDesc, srv)
@@ -3482,6 +3711,24 @@ func _WalletKit_FinalizePsbt_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletKit_ConsolidateUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidateUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ConsolidateUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ConsolidateUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ConsolidateUtxos(ctx, req.(*ConsolidateUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WalletKit_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "walletrpc.WalletKit",
 	HandlerType: (*WalletKitServer)(nil),
@@ -3562,6 +3809,10 @@ var _WalletKit_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizePsbt",
 			Handler:    _WalletKit_FinalizePsbt_Handler,
 		},
+		{
+			MethodName: "ConsolidateUtxos",
+			Handler:    _WalletKit_ConsolidateUtxos_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "walletrpc/walletkit.proto",
@@ -143,6 +143,10 @@ var (
 			Entity: "onchain",
 			Action: "write",
 		}},
+		"/walletrpc.WalletKit/ConsolidateUtxos": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultWalletKitMacFilename is the default name of the wallet kit
@@ -760,12 +764,6 @@ func unmarshallOutPoint(op *lnrpc.OutPoint) (*wire.OutPoint, error) {
 func (w *WalletKit) BumpFee(ctx context.Context,
 	in *BumpFeeRequest) (*BumpFeeResponse, error) {
 
-	// Parse the outpoint from the request.
-	op, err := unmarshallOutPoint(in.Outpoint)
-	if err != nil {
-		return nil, err
-	}
-
 	// We only allow using either the deprecated field or the new field.
 	if in.SatPerByte != 0 && in.SatPerVbyte != 0 {
 		return nil, fmt.Errorf("either SatPerByte or " +
@@ -784,6 +782,23 @@ func (w *WalletKit) BumpFee(ctx context.Context,
 		FeeRate:    satPerKw,
 	}
 
+	// If a channel point is given, we'll bump the fee of its unconfirmed
+	// force close through the anchors of the commitments.
+	if in.ChanPoint != nil {
+		if in.Outpoint != nil {
+			return nil, errors.New("either Outpoint or ChanPoint " +
+				"should be set, but not both")
+		}
+
+		return w.bumpAnchorFee(in.ChanPoint, feePreference)
+	}
+
+	// Parse the outpoint from the request.
+	op, err := unmarshallOutPoint(in.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
 	// We'll attempt to bump the fee of the input through the UtxoSweeper.
 	// If it is currently attempting to sweep the input, then it'll simply
 	// bump its fee, which will result in a replacement transaction (RBF)
@@ -859,6 +874,31 @@ func (w *WalletKit) BumpFee(ctx context.Context,
 	return &BumpFeeResponse{}, nil
 }
 
+// bumpAnchorFee bumps the fee of the unconfirmed force close of the given
+// channel through the anchors of its commitments. The fee preference is
+// validated by the sweeper.
+func (w *WalletKit) bumpAnchorFee(rpcChanPoint *lnrpc.ChannelPoint,
+	feePreference sweep.FeePreference) (*BumpFeeResponse, error) {
+
+	txid, err := lnrpc.GetChanPointFundingTxid(rpcChanPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.OutPoint{
+		Hash:  *txid,
+		Index: rpcChanPoint.OutputIndex,
+	}
+
+	log.Debugf("Attempting to CPFP force close of channel %v", chanPoint)
+
+	err = w.cfg.ChainArb.BumpAnchorFee(chanPoint, feePreference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BumpFeeResponse{}, nil
+}
+
 // ListSweeps returns a list of the sweeps that our node has published.
 func (w *WalletKit) ListSweeps(ctx context.Context,
 	in *ListSweepsRequest) (*ListSweepsResponse, error) {
@@ -1425,3 +1465,59 @@ func (w *WalletKit) ImportPublicKey(ctx context.Context,
 
 	return &ImportPublicKeyResponse{}, nil
 }
+
+// ConsolidateUtxos merges the small confirmed utxos of the wallet into a single
+// output paying to the configured cold storage address or xpub. If DryRun is
+// set, the consolidation is only previewed and not published.
+func (w *WalletKit) ConsolidateUtxos(ctx context.Context,
+	req *ConsolidateUtxosRequest) (*ConsolidateUtxosResponse, error) {
+
+	if req.SatPerVbyte != 0 && req.TargetConf != 0 {
+		return nil, fmt.Errorf("either SatPerVbyte or TargetConf " +
+			"should be set, but not both")
+	}
+
+	feeRate, err := w.cfg.Consolidator.FeeRate(sweep.FeePreference{
+		ConfTarget: req.TargetConf,
+		FeeRate: chainfee.SatPerKVByte(
+			req.SatPerVbyte * 1000,
+		).FeePerKWeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	consolidation, err := w.cfg.Consolidator.Consolidate(
+		feeRate, req.DryRun,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawTx bytes.Buffer
+	if err := consolidation.Tx.Serialize(&rawTx); err != nil {
+		return nil, err
+	}
+
+	inputs := make([]*lnrpc.OutPoint, 0, len(consolidation.Utxos))
+	for _, utxo := range consolidation.Utxos {
+		inputs = append(inputs, &lnrpc.OutPoint{
+			TxidBytes:   utxo.OutPoint.Hash[:],
+			TxidStr:     utxo.OutPoint.Hash.String(),
+			OutputIndex: utxo.OutPoint.Index,
+		})
+	}
+
+	satPerVbyte := consolidation.FeeRate.FeePerKVByte() / 1000
+
+	return &ConsolidateUtxosResponse{
+		RawTx:       rawTx.Bytes(),
+		Txid:        consolidation.Tx.TxHash().String(),
+		Addr:        consolidation.Addr.String(),
+		Inputs:      inputs,
+		AmountSat:   int64(consolidation.Amount),
+		FeeSat:      int64(consolidation.Fee),
+		SatPerVbyte: uint64(satPerVbyte),
+		Published:   consolidation.Published,
+	}, nil
+}
@@ -0,0 +1,87 @@
+//go:build walletrpc
+// +build walletrpc
+
+package walletrpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBumpFeeValidation asserts that BumpFee rejects invalid combinations of
+// its arguments before bumping any fee.
+func TestBumpFeeValidation(t *testing.T) {
+	t.Parallel()
+
+	txid := make([]byte, 32)
+	outpoint := &lnrpc.OutPoint{
+		TxidBytes: txid,
+	}
+	chanPoint := &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+			FundingTxidBytes: txid,
+		},
+	}
+	invalidChanPoint := &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{
+			FundingTxidStr: "invalid",
+		},
+	}
+
+	testCases := []struct {
+		name string
+		req  *BumpFeeRequest
+	}{
+		{
+			name: "both fee rate fields",
+			req: &BumpFeeRequest{
+				Outpoint:    outpoint,
+				SatPerByte:  1,
+				SatPerVbyte: 1,
+			},
+		},
+		{
+			name: "outpoint and channel point",
+			req: &BumpFeeRequest{
+				Outpoint:    outpoint,
+				ChanPoint:   chanPoint,
+				SatPerVbyte: 1,
+			},
+		},
+		{
+			name: "channel point without funding txid",
+			req: &BumpFeeRequest{
+				ChanPoint:   &lnrpc.ChannelPoint{},
+				SatPerVbyte: 1,
+			},
+		},
+		{
+			name: "invalid channel point funding txid",
+			req: &BumpFeeRequest{
+				ChanPoint:   invalidChanPoint,
+				SatPerVbyte: 1,
+			},
+		},
+		{
+			name: "neither outpoint nor channel point",
+			req: &BumpFeeRequest{
+				SatPerVbyte: 1,
+			},
+		},
+	}
+
+	// The wallet kit has no dependencies, so any request that passes the
+	// validation would panic.
+	w := &WalletKit{cfg: &Config{}}
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := w.BumpFee(context.Background(), testCase.req)
+			require.Error(t, err)
+		})
+	}
+}
@@ -35,6 +35,30 @@ var (
 	// shutdown script previously set for that party.
 	ErrUpfrontShutdownScriptMismatch = fmt.Errorf("shutdown script does not " +
 		"match upfront shutdown script")
+
+	// ErrNoFeeRangeOverlap is returned when the fee range sent by the
+	// remote party doesn't overlap with the range of fees we're willing to
+	// pay or accept for the closing transaction.
+	ErrNoFeeRangeOverlap = fmt.Errorf("no overlap between local and " +
+		"remote closing fee range")
+
+	// ErrFeeNegotiationStalled is returned when the remote party keeps
+	// proposing the same fee outside of our acceptable range while we've
+	// already offered the closest fee we're willing to pay or accept.
+	ErrFeeNegotiationStalled = fmt.Errorf("closing fee negotiation " +
+		"stalled outside of acceptable fee range")
+
+	// ErrInvalidFeeRange is returned when the minimum closing fee that we
+	// are willing to pay or accept exceeds the maximum one.
+	ErrInvalidFeeRange = fmt.Errorf("min closing fee exceeds max " +
+		"closing fee")
+)
+
+const (
+	// defaultMaxFeeMultiplier is the multiple of our ideal fee that we'll
+	// use as the maximum closing fee when we're paying for the closing
+	// transaction and no maximum fee rate was specified.
+	defaultMaxFeeMultiplier = 3
 )
 
 // closeState represents all the possible states the channel closer state
@@ -94,6 +118,19 @@ type ChanCloseCfg struct {
 	// Disconnect will disconnect from the remote peer in this close.
 	Disconnect func() error
 
+	// MaxFee is the highest fee rate we're willing to propose or accept
+	// for the closing transaction. If zero, a multiple of the ideal fee is
+	// used if we're paying for the closing transaction, and the fee isn't
+	// capped otherwise.
+	MaxFee chainfee.SatPerKWeight
+
+	// MinFee is the lowest fee rate we're willing to propose or accept for
+	// the closing transaction. If zero, the fee has no lower bound.
+	//
+	// NOTE: If either MinFee or MaxFee is set, the resulting fee range is
+	// sent to the remote party along with our closing proposals.
+	MinFee chainfee.SatPerKWeight
+
 	// Quit is a channel that should be sent upon in the occasion the state
 	// machine should cease all progress and shutdown.
 	Quit chan struct{}
@@ -132,6 +169,20 @@ type ChanCloser struct {
 	// simply accept the remote party's prior offer.
 	lastFeeProposal btcutil.Amount
 
+	// lastRemoteFee is the last fee that the remote party proposed to us.
+	// We'll use this to detect a negotiation that no longer makes
+	// progress.
+	lastRemoteFee btcutil.Amount
+
+	// feeRange is the range of total fees that we're willing to propose
+	// or accept for the closing transaction.
+	feeRange lnwire.FeeRange
+
+	// sendFeeRange is true if we should include our fee range in the
+	// closing proposals we send. This is the case if the range was
+	// explicitly set, or if the remote party sent us their range.
+	sendFeeRange bool
+
 	// priorFeeOffers is a map that keeps track of all the proposed fees that
 	// we've offered during the fee negotiation. We use this map to cut the
 	// negotiation early if the remote party ever sends an offer that we've
@@ -162,10 +213,12 @@ type ChanCloser struct {
 
 // NewChanCloser creates a new instance of the channel closure given the passed
 // configuration, and delivery+fee preference. The final argument should only
-// be populated iff, we're the initiator of this closing request.
+// be populated iff, we're the initiator of this closing request. An error is
+// returned if the configured minimum fee exceeds the maximum fee.
 func NewChanCloser(cfg ChanCloseCfg, deliveryScript []byte,
 	idealFeePerKw chainfee.SatPerKWeight, negotiationHeight uint32,
-	closeReq *htlcswitch.ChanClose, locallyInitiated bool) *ChanCloser {
+	closeReq *htlcswitch.ChanClose, locallyInitiated bool) (*ChanCloser,
+	error) {
 
 	// Given the target fee-per-kw, we'll compute what our ideal _total_ fee
 	// will be starting at for this fee negotiation.
@@ -186,8 +239,40 @@ func NewChanCloser(cfg ChanCloseCfg, deliveryScript []byte,
 		idealFeeSat = channelCommitFee
 	}
 
-	chancloserLog.Infof("Ideal fee for closure of ChannelPoint(%v) is: %v sat",
-		cfg.Channel.ChannelPoint(), int64(idealFeeSat))
+	// Next, we'll determine the range of fees we're willing to propose or
+	// accept. Unless a maximum was specified, we'll only cap the fee if
+	// we're the one paying for it, and never below the minimum fee.
+	feeRange := lnwire.FeeRange{
+		MinFeeSatoshis: cfg.Channel.CalcFee(cfg.MinFee),
+		MaxFeeSatoshis: cfg.Channel.State().Capacity,
+	}
+	switch {
+	case cfg.MaxFee != 0:
+		feeRange.MaxFeeSatoshis = cfg.Channel.CalcFee(cfg.MaxFee)
+
+	case cfg.Channel.IsInitiator():
+		feeRange.MaxFeeSatoshis = idealFeeSat * defaultMaxFeeMultiplier
+		if feeRange.MaxFeeSatoshis < feeRange.MinFeeSatoshis {
+			feeRange.MaxFeeSatoshis = feeRange.MinFeeSatoshis
+		}
+	}
+
+	// An explicitly set minimum fee may still exceed the maximum fee, in
+	// which case no fee would be acceptable.
+	if feeRange.MinFeeSatoshis > feeRange.MaxFeeSatoshis {
+		return nil, fmt.Errorf("%w: min fee %v, max fee %v",
+			ErrInvalidFeeRange, feeRange.MinFeeSatoshis,
+			feeRange.MaxFeeSatoshis)
+	}
+
+	// Our ideal fee must lie within that range, so we'll clamp it if
+	// needed.
+	idealFeeSat = clampFee(idealFeeSat, &feeRange)
+
+	chancloserLog.Infof("Ideal fee for closure of ChannelPoint(%v) is: %v "+
+		"sat, acceptable range: [%v, %v] sat", cfg.Channel.ChannelPoint(),
+		int64(idealFeeSat), int64(feeRange.MinFeeSatoshis),
+		int64(feeRange.MaxFeeSatoshis))
 
 	cid := lnwire.NewChanIDFromOutPoint(cfg.Channel.ChannelPoint())
 	return &ChanCloser{
@@ -198,10 +283,12 @@ func NewChanCloser(cfg ChanCloseCfg, deliveryScript []byte,
 		cfg:                 cfg,
 		negotiationHeight:   negotiationHeight,
 		idealFeeSat:         idealFeeSat,
+		feeRange:            feeRange,
+		sendFeeRange:        cfg.MinFee != 0 || cfg.MaxFee != 0,
 		localDeliveryScript: deliveryScript,
 		priorFeeOffers:      make(map[btcutil.Amount]*lnwire.ClosingSigned),
 		locallyInitiated:    locallyInitiated,
-	}
+	}, nil
 }
 
 // initChanShutdown begins the shutdown process by un-registering the channel,
@@ -484,17 +571,43 @@ func (c *ChanCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message,
 				"instead have %v", spew.Sdump(msg))
 		}
 
+		// If the remote party sent the range of fees they're willing to
+		// accept, their proposal must lie within it. We'll also send our
+		// own range from now on so they can settle on a fee within the
+		// overlap.
+		remoteProposedFee := closeSignedMsg.FeeSatoshis
+		remoteFeeRange, err := closeSignedMsg.FeeRange()
+		if err != nil {
+			return nil, false, err
+		}
+		if remoteFeeRange != nil {
+			if !remoteFeeRange.Contains(remoteProposedFee) {
+				return nil, false, fmt.Errorf("remote fee of "+
+					"%v is outside of its fee range [%v, %v]",
+					remoteProposedFee,
+					remoteFeeRange.MinFeeSatoshis,
+					remoteFeeRange.MaxFeeSatoshis)
+			}
+
+			c.sendFeeRange = true
+		}
+
 		// We'll compare the proposed total fee, to what we've proposed during
 		// the negotiations. If it doesn't match any of our prior offers, then
 		// we'll attempt to ratchet the fee closer to
-		remoteProposedFee := closeSignedMsg.FeeSatoshis
 		if _, ok := c.priorFeeOffers[remoteProposedFee]; !ok {
 			// We'll now attempt to ratchet towards a fee deemed acceptable by
-			// both parties, factoring in our ideal fee rate, and the last
-			// proposed fee by both sides.
-			feeProposal := calcCompromiseFee(c.chanPoint, c.idealFeeSat,
-				c.lastFeeProposal, remoteProposedFee,
+			// both parties, factoring in our ideal fee rate, our fee range,
+			// and the last proposed fee by both sides.
+			feeProposal, err := calcFeeProposal(
+				c.chanPoint, &c.feeRange, remoteFeeRange,
+				c.idealFeeSat, c.lastFeeProposal,
+				c.lastRemoteFee, remoteProposedFee,
 			)
+			if err != nil {
+				return nil, false, err
+			}
+			c.lastRemoteFee = remoteProposedFee
 
 			// With our new fee proposal calculated, we'll craft a new close
 			// signed signature to send to the other party so we can continue
@@ -619,6 +732,14 @@ func (c *ChanCloser) proposeCloseSigned(fee btcutil.Amount) (*lnwire.ClosingSign
 	// closure process.
 	closeSignedMsg := lnwire.NewClosingSigned(c.cid, fee, parsedSig)
 
+	// If needed, we'll let the remote party know which fees we're willing
+	// to accept.
+	if c.sendFeeRange {
+		if err := closeSignedMsg.SetFeeRange(&c.feeRange); err != nil {
+			return nil, err
+		}
+	}
+
 	// We'll also save this close signed, in the case that the remote party
 	// accepts our offer. This way, we don't have to re-sign.
 	c.priorFeeOffers[fee] = closeSignedMsg
@@ -739,3 +860,69 @@ func ParseUpfrontShutdownAddress(address string,
 
 	return txscript.PayToAddrScript(addr)
 }
+
+// clampFee returns the fee within the given range that is closest to the
+// passed fee.
+func clampFee(fee btcutil.Amount, feeRange *lnwire.FeeRange) btcutil.Amount {
+	switch {
+	case fee > feeRange.MaxFeeSatoshis:
+		return feeRange.MaxFeeSatoshis
+
+	case fee < feeRange.MinFeeSatoshis:
+		return feeRange.MinFeeSatoshis
+
+	default:
+		return fee
+	}
+}
+
+// calcFeeProposal determines the next fee to propose to the remote party,
+// taking into account the range of fees we're willing to pay or accept. If the
+// remote party sent their own fee range, we'll settle on a fee within the
+// overlap of both ranges, failing if there is none. Otherwise, we'll fall back
+// to calcCompromiseFee, capping its result to our range, and fail if the
+// remote party doesn't move towards our range once we've reached its bound.
+func calcFeeProposal(chanPoint wire.OutPoint, localRange,
+	remoteRange *lnwire.FeeRange, ourIdealFee, lastSentFee,
+	lastRemoteFee, remoteFee btcutil.Amount) (btcutil.Amount, error) {
+
+	if remoteRange != nil {
+		overlap, ok := localRange.Overlap(remoteRange)
+		if !ok {
+			return 0, fmt.Errorf("%w: local=[%v, %v], remote=[%v, %v]",
+				ErrNoFeeRangeOverlap, localRange.MinFeeSatoshis,
+				localRange.MaxFeeSatoshis,
+				remoteRange.MinFeeSatoshis,
+				remoteRange.MaxFeeSatoshis)
+		}
+
+		// As the remote fee lies within their range, the fee within
+		// the overlap that is closest to it is one that both sides
+		// accept. If their fee is also within our range, this is
+		// simply their fee.
+		return clampFee(remoteFee, overlap), nil
+	}
+
+	feeProposal := calcCompromiseFee(
+		chanPoint, ourIdealFee, lastSentFee, remoteFee,
+	)
+	if localRange.Contains(feeProposal) {
+		return feeProposal, nil
+	}
+
+	// The compromise lies outside of our range, so we'll propose the
+	// closest fee we're willing to pay or accept instead. If we've already
+	// done so and the remote party didn't budge, we'll give up rather than
+	// send the same proposal indefinitely.
+	feeProposal = clampFee(feeProposal, localRange)
+	if feeProposal == lastSentFee && remoteFee == lastRemoteFee {
+		return 0, fmt.Errorf("%w: remote fee %v, local range "+
+			"[%v, %v]", ErrFeeNegotiationStalled, remoteFee,
+			localRange.MinFeeSatoshis, localRange.MaxFeeSatoshis)
+	}
+
+	chancloserLog.Infof("ChannelPoint(%v): compromise fee outside of "+
+		"acceptable range, proposing %v", chanPoint, int64(feeProposal))
+
+	return feeProposal, nil
+}
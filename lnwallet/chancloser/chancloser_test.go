@@ -2,9 +2,13 @@ package chancloser
 
 import (
 	"crypto/rand"
+	"errors"
 	"testing"
 
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
 )
 
 // randDeliveryAddress generates a random delivery address for testing.
@@ -74,3 +78,119 @@ func TestMaybeMatchScript(t *testing.T) {
 		})
 	}
 }
+
+// TestCalcFeeProposal tests that the fee proposals made during negotiation
+// respect both our own and the remote party's fee range.
+func TestCalcFeeProposal(t *testing.T) {
+	t.Parallel()
+
+	localRange := &lnwire.FeeRange{
+		MinFeeSatoshis: 1000,
+		MaxFeeSatoshis: 3000,
+	}
+
+	tests := []struct {
+		name          string
+		remoteRange   *lnwire.FeeRange
+		idealFee      btcutil.Amount
+		lastSentFee   btcutil.Amount
+		lastRemoteFee btcutil.Amount
+		remoteFee     btcutil.Amount
+		expectedFee   btcutil.Amount
+		expectedErr   error
+	}{
+		{
+			name: "remote fee in overlap accepted",
+			remoteRange: &lnwire.FeeRange{
+				MinFeeSatoshis: 2000,
+				MaxFeeSatoshis: 6000,
+			},
+			idealFee:    1500,
+			lastSentFee: 1500,
+			remoteFee:   2500,
+			expectedFee: 2500,
+		},
+		{
+			name: "remote fee above overlap",
+			remoteRange: &lnwire.FeeRange{
+				MinFeeSatoshis: 2000,
+				MaxFeeSatoshis: 6000,
+			},
+			idealFee:    1500,
+			lastSentFee: 1500,
+			remoteFee:   5000,
+			expectedFee: 3000,
+		},
+		{
+			name: "remote fee below overlap",
+			remoteRange: &lnwire.FeeRange{
+				MinFeeSatoshis: 500,
+				MaxFeeSatoshis: 2000,
+			},
+			idealFee:    2500,
+			lastSentFee: 2500,
+			remoteFee:   600,
+			expectedFee: 1000,
+		},
+		{
+			name: "no overlap",
+			remoteRange: &lnwire.FeeRange{
+				MinFeeSatoshis: 4000,
+				MaxFeeSatoshis: 6000,
+			},
+			idealFee:    1500,
+			lastSentFee: 1500,
+			remoteFee:   5000,
+			expectedErr: ErrNoFeeRangeOverlap,
+		},
+		{
+			name:        "legacy compromise within range",
+			idealFee:    2000,
+			lastSentFee: 2000,
+			remoteFee:   2400,
+			expectedFee: 2400,
+		},
+		{
+			name:        "legacy compromise capped",
+			idealFee:    3000,
+			lastSentFee: 3000,
+			remoteFee:   9000,
+			expectedFee: 3000,
+		},
+		{
+			name:          "legacy remote moved",
+			idealFee:      3000,
+			lastSentFee:   3000,
+			lastRemoteFee: 9000,
+			remoteFee:     8000,
+			expectedFee:   3000,
+		},
+		{
+			name:          "legacy stalled",
+			idealFee:      3000,
+			lastSentFee:   3000,
+			lastRemoteFee: 9000,
+			remoteFee:     9000,
+			expectedErr:   ErrFeeNegotiationStalled,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			fee, err := calcFeeProposal(
+				wire.OutPoint{}, localRange, test.remoteRange,
+				test.idealFee, test.lastSentFee,
+				test.lastRemoteFee, test.remoteFee,
+			)
+			if test.expectedErr != nil {
+				require.True(t, errors.Is(err, test.expectedErr))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expectedFee, fee)
+		})
+	}
+}
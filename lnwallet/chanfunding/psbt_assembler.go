@@ -145,6 +145,10 @@ type PsbtIntent struct {
 	// netParams are the network parameters used to encode the P2WSH funding
 	// address.
 	netParams *chaincfg.Params
+
+	// shouldPublish specifies if the funding transaction is published by
+	// the funding manager once the channel negotiation is complete.
+	shouldPublish bool
 }
 
 // BindKeys sets both the remote and local node's keys that will be used for the
@@ -207,10 +211,19 @@ func (i *PsbtIntent) FundingParams() (btcutil.Address, int64, *psbt.Packet,
 // Verify makes sure the PSBT that is given to the intent has an output that
 // sends to the channel funding multisig address with the correct amount. A
 // simple check that at least a single input has been specified is performed.
-func (i *PsbtIntent) Verify(packet *psbt.Packet) error {
+// If skipFinalize is true, the unsigned transaction of the PSBT is used as the
+// funding transaction right away and the funding flow continues without a
+// finalize step. This is only possible if the funding transaction isn't
+// published by the funding manager, so the caller can sign and publish it
+// once the channel negotiation is complete.
+func (i *PsbtIntent) Verify(packet *psbt.Packet, skipFinalize bool) error {
 	if packet == nil {
 		return fmt.Errorf("PSBT is nil")
 	}
+	if skipFinalize && i.shouldPublish {
+		return fmt.Errorf("finalize step can only be skipped if the " +
+			"funding transaction isn't published")
+	}
 	if i.State != PsbtOutputKnown {
 		return fmt.Errorf("invalid state. got %v expected %v", i.State,
 			PsbtOutputKnown)
@@ -250,6 +263,21 @@ func (i *PsbtIntent) Verify(packet *psbt.Packet) error {
 	}
 
 	i.PendingPsbt = packet
+
+	// If the finalize step is skipped, we already have everything needed
+	// to continue the funding flow. The funding transaction is signed and
+	// published by the caller later on.
+	if skipFinalize {
+		i.State = PsbtFinalized
+		i.FinalTX = packet.UnsignedTx
+
+		i.signalPsbtReady.Do(func() {
+			close(i.PsbtReady)
+		})
+
+		return nil
+	}
+
 	i.State = PsbtVerified
 	return nil
 }
@@ -490,10 +518,11 @@ func (p *PsbtAssembler) ProvisionChannel(req *Request) (Intent, error) {
 		ShimIntent: ShimIntent{
 			localFundingAmt: p.fundingAmt,
 		},
-		State:     PsbtShimRegistered,
-		BasePsbt:  p.basePsbt,
-		PsbtReady: make(chan error, 1),
-		netParams: p.netParams,
+		State:         PsbtShimRegistered,
+		BasePsbt:      p.basePsbt,
+		PsbtReady:     make(chan error, 1),
+		netParams:     p.netParams,
+		shouldPublish: p.shouldPublish,
 	}
 
 	// A simple sanity check to ensure the provisioned request matches the
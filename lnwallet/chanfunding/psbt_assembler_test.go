@@ -118,7 +118,7 @@ func TestPsbtIntent(t *testing.T) {
 	}
 
 	// Verify the dummy PSBT with the intent.
-	err = psbtIntent.Verify(pendingPsbt)
+	err = psbtIntent.Verify(pendingPsbt, false)
 	if err != nil {
 		t.Fatalf("error verifying pending PSBT: %v", err)
 	}
@@ -280,7 +280,17 @@ func TestPsbtVerify(t *testing.T) {
 			doVerify: func(amt int64, p *psbt.Packet,
 				i *PsbtIntent) error {
 
-				return i.Verify(nil)
+				return i.Verify(nil, false)
+			},
+		},
+		{
+			name: "skip finalize on published tx",
+			expectedErr: "finalize step can only be skipped if " +
+				"the funding transaction isn't published",
+			doVerify: func(amt int64, p *psbt.Packet,
+				i *PsbtIntent) error {
+
+				return i.Verify(p, true)
 			},
 		},
 		{
@@ -291,7 +301,7 @@ func TestPsbtVerify(t *testing.T) {
 				i *PsbtIntent) error {
 
 				i.State = PsbtInitiatorCanceled
-				return i.Verify(p)
+				return i.Verify(p, false)
 			},
 		},
 		{
@@ -301,7 +311,7 @@ func TestPsbtVerify(t *testing.T) {
 				i *PsbtIntent) error {
 
 				p.UnsignedTx.TxOut[0].Value = 123
-				return i.Verify(p)
+				return i.Verify(p, false)
 			},
 		},
 		{
@@ -311,7 +321,7 @@ func TestPsbtVerify(t *testing.T) {
 				i *PsbtIntent) error {
 
 				p.UnsignedTx.TxOut[0].PkScript = []byte{1, 2, 3}
-				return i.Verify(p)
+				return i.Verify(p, false)
 			},
 		},
 		{
@@ -320,7 +330,7 @@ func TestPsbtVerify(t *testing.T) {
 			doVerify: func(amt int64, p *psbt.Packet,
 				i *PsbtIntent) error {
 
-				return i.Verify(p)
+				return i.Verify(p, false)
 			},
 		},
 		{
@@ -336,7 +346,7 @@ func TestPsbtVerify(t *testing.T) {
 						Value: int64(chanCapacity),
 					},
 				}}
-				return i.Verify(p)
+				return i.Verify(p, false)
 			},
 		},
 		{
@@ -367,7 +377,7 @@ func TestPsbtVerify(t *testing.T) {
 							},
 						},
 					}}
-				return i.Verify(p)
+				return i.Verify(p, false)
 			},
 		},
 	}
@@ -411,6 +421,64 @@ func TestPsbtVerify(t *testing.T) {
 	}
 }
 
+// TestPsbtVerifySkipFinalize tests that a verified PSBT of a funding
+// transaction that isn't published continues the funding flow without the
+// finalize step if requested.
+func TestPsbtVerifySkipFinalize(t *testing.T) {
+	t.Parallel()
+
+	// Create an assembler for a funding transaction that isn't published
+	// and bind our test keys to get the funding parameters.
+	a := NewPsbtAssembler(chanCapacity, nil, &params, false)
+	intent, err := a.ProvisionChannel(&Request{LocalAmt: chanCapacity})
+	if err != nil {
+		t.Fatalf("error provisioning channel: %v", err)
+	}
+	psbtIntent := intent.(*PsbtIntent)
+
+	_, localPubkey := btcec.PrivKeyFromBytes(btcec.S256(), localPrivkey)
+	_, remotePubkey := btcec.PrivKeyFromBytes(btcec.S256(), remotePrivkey)
+	psbtIntent.BindKeys(
+		&keychain.KeyDescriptor{PubKey: localPubkey}, remotePubkey,
+	)
+	_, _, pendingPsbt, err := psbtIntent.FundingParams()
+	if err != nil {
+		t.Fatalf("unable to get funding params: %v", err)
+	}
+
+	// Add a single unsigned input that funds the channel.
+	pendingPsbt.UnsignedTx.TxIn = []*wire.TxIn{{}}
+	pendingPsbt.Inputs = []psbt.PInput{{
+		WitnessUtxo: &wire.TxOut{
+			Value: int64(chanCapacity) + 1,
+		},
+	}}
+
+	err = psbtIntent.Verify(pendingPsbt, true)
+	if err != nil {
+		t.Fatalf("error verifying pending PSBT: %v", err)
+	}
+
+	// The funding flow should be signaled to continue with the unsigned
+	// transaction of the PSBT.
+	select {
+	case err := <-psbtIntent.PsbtReady:
+		if err != nil {
+			t.Fatalf("unexpected PSBT error: %v", err)
+		}
+	case <-time.After(defaultTimeout):
+		t.Fatalf("timeout waiting for PSBT ready")
+	}
+	if psbtIntent.State != PsbtFinalized {
+		t.Fatalf("unexpected state. got %d wanted %d",
+			psbtIntent.State, PsbtFinalized)
+	}
+	if psbtIntent.FinalTX != pendingPsbt.UnsignedTx {
+		t.Fatalf("unexpected final TX. got %v wanted %v",
+			psbtIntent.FinalTX, pendingPsbt.UnsignedTx)
+	}
+}
+
 // TestPsbtFinalize tests the PSBT finalization process more deeply than just
 // the happy path.
 func TestPsbtFinalize(t *testing.T) {
@@ -580,7 +648,7 @@ func TestPsbtFinalize(t *testing.T) {
 				},
 				FinalScriptWitness: []byte{0x01, 0x00},
 			}}
-			err = psbtIntent.Verify(pendingPsbt)
+			err = psbtIntent.Verify(pendingPsbt, false)
 			if err != nil {
 				t.Fatalf("error verifying PSBT: %v", err)
 			}
@@ -6896,6 +6896,71 @@ func (lc *LightningChannel) CommitFeeRate() chainfee.SatPerKWeight {
 	return chainfee.SatPerKWeight(lc.channelState.LocalCommitment.FeePerKw)
 }
 
+// GetDustSum returns the total value of the htlcs in the update logs that
+// would be trimmed as dust from either the local or the remote commitment
+// transaction at the given fee rate. The value of dust htlcs isn't paid out to
+// either party on chain, but goes to the miners instead.
+func (lc *LightningChannel) GetDustSum(remote bool,
+	feeRate chainfee.SatPerKWeight) lnwire.MilliSatoshi {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	var dustSum lnwire.MilliSatoshi
+
+	// Our htlcs are outgoing, while the htlcs in the remote log are
+	// incoming.
+	for e := lc.localUpdateLog.Front(); e != nil; e = e.Next() {
+		pd := e.Value.(*PaymentDescriptor)
+		if pd.EntryType != Add {
+			continue
+		}
+
+		if lc.isDust(false, remote, feeRate, pd.Amount) {
+			dustSum += pd.Amount
+		}
+	}
+	for e := lc.remoteUpdateLog.Front(); e != nil; e = e.Next() {
+		pd := e.Value.(*PaymentDescriptor)
+		if pd.EntryType != Add {
+			continue
+		}
+
+		if lc.isDust(true, remote, feeRate, pd.Amount) {
+			dustSum += pd.Amount
+		}
+	}
+
+	return dustSum
+}
+
+// HtlcIsDust returns whether an htlc of the given amount would be trimmed as
+// dust from the local or the remote commitment transaction at the given fee
+// rate.
+func (lc *LightningChannel) HtlcIsDust(incoming, remote bool,
+	feeRate chainfee.SatPerKWeight, amt lnwire.MilliSatoshi) bool {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.isDust(incoming, remote, feeRate, amt)
+}
+
+// isDust is the private, non mutexed version of HtlcIsDust.
+func (lc *LightningChannel) isDust(incoming, remote bool,
+	feeRate chainfee.SatPerKWeight, amt lnwire.MilliSatoshi) bool {
+
+	dustLimit := lc.channelState.LocalChanCfg.DustLimit
+	if remote {
+		dustLimit = lc.channelState.RemoteChanCfg.DustLimit
+	}
+
+	return htlcIsDust(
+		lc.channelState.ChanType, incoming, !remote, feeRate,
+		amt.ToSatoshis(), dustLimit,
+	)
+}
+
 // IsPending returns true if the channel's funding transaction has been fully
 // confirmed, and false otherwise.
 func (lc *LightningChannel) IsPending() bool {
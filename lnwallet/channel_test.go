@@ -9521,3 +9521,63 @@ func TestChannelLocalUnsignedUpdatesFailure(t *testing.T) {
 	err = newAliceChannel.ReceiveNewCommitment(bobSig, bobHtlcSigs)
 	require.NoError(t, err)
 }
+
+// TestGetDustSum asserts that the dust sum of both commitments takes the dust
+// limits of both parties, the direction of the htlcs and the fee rate into
+// account.
+func TestGetDustSum(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels(
+		channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+	defer cleanUp()
+
+	// Alice's dust limit is 200 sat and Bob's dust limit is 1300 sat. The
+	// htlc that Alice offers is only dust on Bob's commitment, while the
+	// htlc that Bob offers is dust on both commitments.
+	aliceHtlc, _ := createHTLC(0, lnwire.NewMSatFromSatoshis(1000))
+	_, err = aliceChannel.AddHTLC(aliceHtlc, nil)
+	require.NoError(t, err)
+	_, err = bobChannel.ReceiveHTLC(aliceHtlc)
+	require.NoError(t, err)
+
+	bobHtlc, _ := createHTLC(0, lnwire.NewMSatFromSatoshis(100))
+	_, err = bobChannel.AddHTLC(bobHtlc, nil)
+	require.NoError(t, err)
+	_, err = aliceChannel.ReceiveHTLC(bobHtlc)
+	require.NoError(t, err)
+
+	require.Equal(
+		t, lnwire.NewMSatFromSatoshis(100),
+		aliceChannel.GetDustSum(false, 0),
+	)
+	require.Equal(
+		t, lnwire.NewMSatFromSatoshis(1100),
+		aliceChannel.GetDustSum(true, 0),
+	)
+
+	// Both channels have the same view of the dust on each commitment.
+	require.Equal(
+		t, aliceChannel.GetDustSum(false, 0),
+		bobChannel.GetDustSum(true, 0),
+	)
+	require.Equal(
+		t, aliceChannel.GetDustSum(true, 0),
+		bobChannel.GetDustSum(false, 0),
+	)
+
+	// At a higher fee rate, the second level fee makes Alice's htlc dust
+	// on her own commitment as well.
+	require.False(t, aliceChannel.HtlcIsDust(
+		false, false, 0, aliceHtlc.Amount,
+	))
+	require.True(t, aliceChannel.HtlcIsDust(
+		false, false, 2000, aliceHtlc.Amount,
+	))
+	require.Equal(
+		t, lnwire.NewMSatFromSatoshis(1100),
+		aliceChannel.GetDustSum(false, 2000),
+	)
+}
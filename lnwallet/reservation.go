@@ -384,6 +384,17 @@ func (r *ChannelReservation) SetNumConfsRequired(numConfs uint16) {
 	r.partialState.NumConfsRequired = numConfs
 }
 
+// SetZeroConf marks the channel as a zero-conf channel, one that is usable
+// before its funding transaction confirms. Both parties must have agreed to
+// this as it requires the responder to trust the initiator not to double
+// spend the funding transaction.
+func (r *ChannelReservation) SetZeroConf() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.partialState.ChanType |= channeldb.ZeroConfBit
+}
+
 // CommitConstraints takes the constraints that the remote party specifies for
 // the type of commitments that we can generate for them. These constraints
 // include several parameters that serve as flow control restricting the amount
@@ -6,6 +6,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/tlv"
 )
 
 // AcceptChannel is the message Bob sends to Alice after she initiates the
@@ -94,6 +95,11 @@ type AcceptChannel struct {
 	// and its length followed by the script will be written if it is set.
 	UpfrontShutdownScript DeliveryAddress
 
+	// ChannelType is the explicit channel type the responder agreed to.
+	// It is only set if the initiator proposed a channel type, in which
+	// case the responder MUST echo it. This field is optional.
+	ChannelType *ChannelType
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -101,8 +107,8 @@ type AcceptChannel struct {
 	// NOTE: Since the upfront shutdown script MUST be present (though can
 	// be zero-length) if any TLV data is available, the script will be
 	// extracted and removed from this blob when decoding. ExtraData will
-	// contain all TLV records _except_ the DeliveryAddress record in that
-	// case.
+	// contain all TLV records _except_ the DeliveryAddress and ChannelType
+	// records in that case.
 	ExtraData ExtraOpaqueData
 }
 
@@ -116,10 +122,10 @@ var _ Message = (*AcceptChannel)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (a *AcceptChannel) Encode(w io.Writer, pver uint32) error {
-	// Since the upfront script is encoded as a TLV record, concatenate it
-	// with the ExtraData, and write them as one.
-	tlvRecords, err := packShutdownScript(
-		a.UpfrontShutdownScript, a.ExtraData,
+	// Since the upfront script and the channel type are encoded as TLV
+	// records, concatenate them with the ExtraData, and write them as one.
+	tlvRecords, err := packChannelTLVs(
+		a.UpfrontShutdownScript, a.ChannelType, a.ExtraData,
 	)
 	if err != nil {
 		return err
@@ -179,9 +185,8 @@ func (a *AcceptChannel) Decode(r io.Reader, pver uint32) error {
 		return err
 	}
 
-	a.UpfrontShutdownScript, a.ExtraData, err = parseShutdownScript(
-		tlvRecords,
-	)
+	a.UpfrontShutdownScript, a.ChannelType, a.ExtraData, err =
+		parseChannelTLVs(tlvRecords)
 	if err != nil {
 		return err
 	}
@@ -189,53 +194,62 @@ func (a *AcceptChannel) Decode(r io.Reader, pver uint32) error {
 	return nil
 }
 
-// packShutdownScript takes an upfront shutdown script and an opaque data blob
-// and concatenates them.
-func packShutdownScript(addr DeliveryAddress, extraData ExtraOpaqueData) (
-	ExtraOpaqueData, error) {
+// packChannelTLVs takes an upfront shutdown script, an optional channel type
+// and an opaque data blob and concatenates them.
+func packChannelTLVs(addr DeliveryAddress, chanType *ChannelType,
+	extraData ExtraOpaqueData) (ExtraOpaqueData, error) {
 
 	// We'll always write the upfront shutdown script record, regardless of
-	// the script being empty.
-	var tlvRecords ExtraOpaqueData
+	// the script being empty. The channel type is only written if set.
+	records := []tlv.Record{addr.NewRecord()}
+	if chanType != nil {
+		records = append(records, chanType.NewRecord())
+	}
 
-	// Pack it into a data blob as a TLV record.
-	err := tlvRecords.PackRecords(addr.NewRecord())
+	// Pack them into a data blob as TLV records.
+	var tlvRecords ExtraOpaqueData
+	err := tlvRecords.PackRecords(records...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to pack upfront shutdown "+
-			"script as TLV record: %v", err)
+			"script and channel type as TLV records: %v", err)
 	}
 
-	// Concatenate the remaining blob with the shutdown script record.
+	// Concatenate the remaining blob with the known records.
 	tlvRecords = append(tlvRecords, extraData...)
 	return tlvRecords, nil
 }
 
-// parseShutdownScript reads and extract the upfront shutdown script from the
-// passe data blob. It returns the script, if any, and the remainder of the
-// data blob.
+// parseChannelTLVs reads and extracts the upfront shutdown script and the
+// channel type from the passed data blob. It returns the script and the
+// channel type, if any, and the remainder of the data blob.
 //
 // This can be used to parse extra data for the OpenChannel and AcceptChannel
 // messages, where the shutdown script is mandatory if extra TLV data is
 // present.
-func parseShutdownScript(tlvRecords ExtraOpaqueData) (DeliveryAddress,
-	ExtraOpaqueData, error) {
+func parseChannelTLVs(tlvRecords ExtraOpaqueData) (DeliveryAddress,
+	*ChannelType, ExtraOpaqueData, error) {
 
 	// If no TLV data is present there can't be any script available.
 	if len(tlvRecords) == 0 {
-		return nil, tlvRecords, nil
+		return nil, nil, tlvRecords, nil
 	}
 
 	// Otherwise the shutdown script MUST be present.
-	var addr DeliveryAddress
-	tlvs, err := tlvRecords.ExtractRecords(addr.NewRecord())
+	var (
+		addr     DeliveryAddress
+		chanType ChannelType
+	)
+	tlvs, err := tlvRecords.ExtractRecords(
+		addr.NewRecord(), chanType.NewRecord(),
+	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Not among TLV records, this means the data was invalid.
 	if _, ok := tlvs[DeliveryAddrType]; !ok {
-		return nil, nil, fmt.Errorf("no shutdown script in non-empty " +
-			"data blob")
+		return nil, nil, nil, fmt.Errorf("no shutdown script in " +
+			"non-empty data blob")
 	}
 
 	// Now that we have retrieved the address (which can be zero-length),
@@ -244,7 +258,17 @@ func parseShutdownScript(tlvRecords ExtraOpaqueData) (DeliveryAddress,
 	addrLen := len(addr)
 	tlvRecords = tlvRecords[addrLen+2:]
 
-	return addr, tlvRecords, nil
+	// The channel type directly follows the shutdown script as the TLV
+	// stream is sorted by type, so we strip it the same way.
+	val, ok := tlvs[ChannelTypeRecordType]
+	if !ok || val != nil {
+		return addr, nil, tlvRecords, nil
+	}
+
+	typeLen := chanType.featureBitLen()
+	tlvRecords = tlvRecords[1+tlv.VarIntSize(typeLen)+typeLen:]
+
+	return addr, &chanType, tlvRecords, nil
 }
 
 // MsgType returns the MessageType code which uniquely identifies this message
@@ -0,0 +1,95 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// ChannelTypeRecordType is the TLV record type for the channel type
+	// within the name space of the OpenChannel and AcceptChannel messages.
+	ChannelTypeRecordType tlv.Type = 1
+)
+
+// ChannelType is a set of feature bits that describes the type of a channel
+// that is negotiated within the OpenChannel and AcceptChannel messages. The
+// initiator proposes a type and the responder echoes it to signal that it
+// agrees to open a channel of that type.
+type ChannelType RawFeatureVector
+
+// NewChannelType creates a channel type with all of the feature bits given as
+// arguments set.
+func NewChannelType(bits ...FeatureBit) *ChannelType {
+	return (*ChannelType)(NewRawFeatureVector(bits...))
+}
+
+// IsSet returns whether a particular feature bit is set in the channel type.
+func (c *ChannelType) IsSet(bit FeatureBit) bool {
+	return (*RawFeatureVector)(c).IsSet(bit)
+}
+
+// IsZeroConf returns true if the channel type describes a zero-conf channel,
+// one that is usable before its funding transaction is confirmed.
+func (c *ChannelType) IsZeroConf() bool {
+	return c != nil && c.IsSet(ZeroConfRequired)
+}
+
+// Equal returns true if both channel types set the same feature bits.
+func (c *ChannelType) Equal(other *ChannelType) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+
+	if len(c.features) != len(other.features) {
+		return false
+	}
+	for bit := range c.features {
+		if !other.IsSet(bit) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// featureBitLen returns the length in bytes of the encoded feature bits.
+func (c *ChannelType) featureBitLen() uint64 {
+	return uint64((*RawFeatureVector)(c).SerializeSize())
+}
+
+// NewRecord returns a TLV record that can be used to encode the channel type
+// within the ExtraData TLV stream of the OpenChannel and AcceptChannel
+// messages.
+func (c *ChannelType) NewRecord() tlv.Record {
+	return tlv.MakeDynamicRecord(
+		ChannelTypeRecordType, c, c.featureBitLen, channelTypeEncoder,
+		channelTypeDecoder,
+	)
+}
+
+// channelTypeEncoder is a custom TLV encoder for the ChannelType record.
+func channelTypeEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*ChannelType); ok {
+		return (*RawFeatureVector)(v).EncodeBase256(w)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "*lnwire.ChannelType")
+}
+
+// channelTypeDecoder is a custom TLV decoder for the ChannelType record.
+func channelTypeDecoder(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*ChannelType); ok {
+		fv := NewRawFeatureVector()
+		if err := fv.DecodeBase256(r, int(l)); err != nil {
+			return err
+		}
+
+		*v = ChannelType(*fv)
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "*lnwire.ChannelType", l, l)
+}
@@ -45,6 +45,28 @@ func NewClosingSigned(cid ChannelID, fs btcutil.Amount,
 	}
 }
 
+// SetFeeRange stores the given fee range as a TLV record in the extra data of
+// the message, replacing any data that was there before.
+func (c *ClosingSigned) SetFeeRange(feeRange *FeeRange) error {
+	return c.ExtraData.PackRecords(feeRange.Record())
+}
+
+// FeeRange returns the fee range carried in the extra data of the message, or
+// nil if the sender didn't include one.
+func (c *ClosingSigned) FeeRange() (*FeeRange, error) {
+	var feeRange FeeRange
+	tlvs, err := c.ExtraData.ExtractRecords(feeRange.Record())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := tlvs[FeeRangeRecordType]; !ok {
+		return nil, nil
+	}
+
+	return &feeRange, nil
+}
+
 // A compile time check to ensure ClosingSigned implements the lnwire.Message
 // interface.
 var _ Message = (*ClosingSigned)(nil)
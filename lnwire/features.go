@@ -129,6 +129,37 @@ const (
 	// transactions, which also imply anchor commitments.
 	AnchorsZeroFeeHtlcTxOptional FeatureBit = 23
 
+	// ScidAliasRequired is a required feature bit that signals that the
+	// node requires understanding of alias short channel IDs, which are
+	// used to route over a channel before its funding transaction is
+	// confirmed.
+	ScidAliasRequired FeatureBit = 46
+
+	// ScidAliasOptional is an optional feature bit that signals that the
+	// node understands alias short channel IDs, which are used to route
+	// over a channel before its funding transaction is confirmed.
+	ScidAliasOptional FeatureBit = 47
+
+	// ZeroConfRequired is a required feature bit that signals that the
+	// node requires support for channels that are usable before their
+	// funding transaction has been confirmed.
+	ZeroConfRequired FeatureBit = 50
+
+	// ZeroConfOptional is an optional feature bit that signals that the
+	// node supports channels that are usable before their funding
+	// transaction has been confirmed.
+	ZeroConfOptional FeatureBit = 51
+
+	// TrampolineRoutingRequired is a required feature bit that signals
+	// that the node requires the ability to forward payments that carry
+	// a trampoline payload on behalf of the sender.
+	TrampolineRoutingRequired FeatureBit = 56
+
+	// TrampolineRoutingOptional is an optional feature bit that signals
+	// that the node is able to forward payments that carry a trampoline
+	// payload on behalf of the sender.
+	TrampolineRoutingOptional FeatureBit = 57
+
 	// maxAllowedSize is a maximum allowed size of feature vector.
 	//
 	// NOTE: Within the protocol, the maximum allowed message size is 65535
@@ -172,6 +203,12 @@ var Features = map[FeatureBit]string{
 	AnchorsZeroFeeHtlcTxOptional:  "anchors-zero-fee-htlc-tx",
 	WumboChannelsRequired:         "wumbo-channels",
 	WumboChannelsOptional:         "wumbo-channels",
+	ScidAliasRequired:             "scid-alias",
+	ScidAliasOptional:             "scid-alias",
+	ZeroConfRequired:              "zero-conf",
+	ZeroConfOptional:              "zero-conf",
+	TrampolineRoutingRequired:     "trampoline-routing",
+	TrampolineRoutingOptional:     "trampoline-routing",
 }
 
 // RawFeatureVector represents a set of feature bits as defined in BOLT-09.  A
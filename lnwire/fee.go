@@ -0,0 +1,76 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// FeeRecordType is the TLV record type within the ExtraOpaqueData of a
+	// ChannelUpdate that carries the inbound fee of the announcing node
+	// for the channel. The type is odd, so nodes that don't understand it
+	// will ignore it.
+	FeeRecordType tlv.Type = 55555
+
+	// feeRecordSize is the encoded size of a Fee: a signed 32-bit base fee
+	// followed by a signed 32-bit fee rate.
+	feeRecordSize = 8
+)
+
+// Fee represents a fee schedule that can be carried in the extra data of a
+// ChannelUpdate. Unlike the regular outbound fee fields of the update, both
+// the base fee and the fee rate are signed, which allows a node to offer a
+// discount for HTLCs that arrive over the channel.
+type Fee struct {
+	// BaseFee is the base fee in msat.
+	BaseFee int32
+
+	// FeeRate is the proportional fee in parts per million.
+	FeeRate int32
+}
+
+// Record returns a TLV record that can be used to encode/decode the fee
+// within the ExtraOpaqueData TLV stream of a ChannelUpdate.
+func (f *Fee) Record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		FeeRecordType, f, feeRecordSize, feeEncoder, feeDecoder,
+	)
+}
+
+// feeEncoder is a custom TLV encoder for the Fee record.
+func feeEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*Fee); ok {
+		err := tlv.EUint32T(w, uint32(v.BaseFee), buf)
+		if err != nil {
+			return err
+		}
+
+		return tlv.EUint32T(w, uint32(v.FeeRate), buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.Fee")
+}
+
+// feeDecoder is a custom TLV decoder for the Fee record.
+func feeDecoder(r io.Reader, val interface{}, buf *[8]byte, l uint64) error {
+	if v, ok := val.(*Fee); ok && l == feeRecordSize {
+		var baseFee, feeRate uint32
+		err := tlv.DUint32(r, &baseFee, buf, 4)
+		if err != nil {
+			return err
+		}
+
+		err = tlv.DUint32(r, &feeRate, buf, 4)
+		if err != nil {
+			return err
+		}
+
+		v.BaseFee = int32(baseFee)
+		v.FeeRate = int32(feeRate)
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "lnwire.Fee", l, feeRecordSize)
+}
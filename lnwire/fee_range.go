@@ -0,0 +1,109 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// FeeRangeRecordType is the TLV record type within the ExtraData of a
+	// ClosingSigned message that carries the range of closing fees the
+	// sender is willing to accept. The type is odd, so nodes that don't
+	// understand it will ignore it.
+	FeeRangeRecordType tlv.Type = 1
+
+	// feeRangeRecordSize is the encoded size of a FeeRange: two unsigned
+	// 64-bit satoshi amounts.
+	feeRangeRecordSize = 16
+)
+
+// FeeRange is the range of total fees, in satoshis, that a party is willing to
+// pay or accept for a cooperative close transaction. It is sent in the extra
+// data of a ClosingSigned message, allowing both sides to settle on a fee
+// within the overlap of their ranges instead of ratcheting towards each
+// other's offers.
+type FeeRange struct {
+	// MinFeeSatoshis is the lowest fee the sender will accept.
+	MinFeeSatoshis btcutil.Amount
+
+	// MaxFeeSatoshis is the highest fee the sender will accept.
+	MaxFeeSatoshis btcutil.Amount
+}
+
+// Contains returns true if the given fee lies within the range.
+func (f *FeeRange) Contains(fee btcutil.Amount) bool {
+	return fee >= f.MinFeeSatoshis && fee <= f.MaxFeeSatoshis
+}
+
+// Overlap returns the intersection of the two fee ranges, or false if they
+// don't overlap.
+func (f *FeeRange) Overlap(other *FeeRange) (*FeeRange, bool) {
+	overlap := &FeeRange{
+		MinFeeSatoshis: f.MinFeeSatoshis,
+		MaxFeeSatoshis: f.MaxFeeSatoshis,
+	}
+	if other.MinFeeSatoshis > overlap.MinFeeSatoshis {
+		overlap.MinFeeSatoshis = other.MinFeeSatoshis
+	}
+	if other.MaxFeeSatoshis < overlap.MaxFeeSatoshis {
+		overlap.MaxFeeSatoshis = other.MaxFeeSatoshis
+	}
+
+	if overlap.MinFeeSatoshis > overlap.MaxFeeSatoshis {
+		return nil, false
+	}
+
+	return overlap, true
+}
+
+// Record returns a TLV record that can be used to encode/decode the fee range
+// within the ExtraData TLV stream of a ClosingSigned message.
+func (f *FeeRange) Record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		FeeRangeRecordType, f, feeRangeRecordSize, feeRangeEncoder,
+		feeRangeDecoder,
+	)
+}
+
+// feeRangeEncoder is a custom TLV encoder for the FeeRange record.
+func feeRangeEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*FeeRange); ok {
+		err := tlv.EUint64T(w, uint64(v.MinFeeSatoshis), buf)
+		if err != nil {
+			return err
+		}
+
+		return tlv.EUint64T(w, uint64(v.MaxFeeSatoshis), buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.FeeRange")
+}
+
+// feeRangeDecoder is a custom TLV decoder for the FeeRange record.
+func feeRangeDecoder(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*FeeRange); ok && l == feeRangeRecordSize {
+		var minFee, maxFee uint64
+		err := tlv.DUint64(r, &minFee, buf, 8)
+		if err != nil {
+			return err
+		}
+
+		err = tlv.DUint64(r, &maxFee, buf, 8)
+		if err != nil {
+			return err
+		}
+
+		v.MinFeeSatoshis = btcutil.Amount(minFee)
+		v.MaxFeeSatoshis = btcutil.Amount(maxFee)
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(
+		val, "lnwire.FeeRange", l, feeRangeRecordSize,
+	)
+}
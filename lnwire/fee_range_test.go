@@ -0,0 +1,88 @@
+package lnwire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestClosingSignedFeeRange tests that a fee range survives a round trip
+// through the extra data of a ClosingSigned message, and that no range is
+// returned if none was set.
+func TestClosingSignedFeeRange(t *testing.T) {
+	t.Parallel()
+
+	var msg ClosingSigned
+	feeRange, err := msg.FeeRange()
+	require.NoError(t, err)
+	require.Nil(t, feeRange)
+
+	expected := &FeeRange{MinFeeSatoshis: 1000, MaxFeeSatoshis: 5000}
+	require.NoError(t, msg.SetFeeRange(expected))
+
+	feeRange, err = msg.FeeRange()
+	require.NoError(t, err)
+	require.Equal(t, expected, feeRange)
+
+	// The fee range record type 1 followed by a length of 8 bytes instead
+	// of the expected 16 must be rejected.
+	msg.ExtraData = ExtraOpaqueData{0x01, 0x08, 0, 0, 0, 0, 0, 0, 0, 1}
+	_, err = msg.FeeRange()
+	require.Error(t, err)
+}
+
+// TestFeeRangeOverlap tests the computation of the overlap of two fee ranges.
+func TestFeeRangeOverlap(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		a, b    FeeRange
+		overlap *FeeRange
+	}{
+		{
+			name:    "identical",
+			a:       FeeRange{100, 200},
+			b:       FeeRange{100, 200},
+			overlap: &FeeRange{100, 200},
+		},
+		{
+			name:    "partial",
+			a:       FeeRange{100, 200},
+			b:       FeeRange{150, 300},
+			overlap: &FeeRange{150, 200},
+		},
+		{
+			name:    "contained",
+			a:       FeeRange{100, 400},
+			b:       FeeRange{150, 300},
+			overlap: &FeeRange{150, 300},
+		},
+		{
+			name:    "single fee",
+			a:       FeeRange{100, 200},
+			b:       FeeRange{200, 300},
+			overlap: &FeeRange{200, 200},
+		},
+		{
+			name: "disjoint",
+			a:    FeeRange{100, 200},
+			b:    FeeRange{201, 300},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			overlap, ok := testCase.a.Overlap(&testCase.b)
+			require.Equal(t, testCase.overlap != nil, ok)
+			require.Equal(t, testCase.overlap, overlap)
+
+			// The overlap must be symmetric.
+			overlap, ok = testCase.b.Overlap(&testCase.a)
+			require.Equal(t, testCase.overlap != nil, ok)
+			require.Equal(t, testCase.overlap, overlap)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package lnwire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFeeRecordEncodeDecode tests that a Fee record, including negative
+// values, survives a round trip through a ChannelUpdate's extra opaque data.
+func TestFeeRecordEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []Fee{
+		{BaseFee: 0, FeeRate: 0},
+		{BaseFee: 1000, FeeRate: 200},
+		{BaseFee: -5, FeeRate: -150},
+		{BaseFee: -2147483648, FeeRate: 2147483647},
+	}
+
+	for _, fee := range testCases {
+		fee := fee
+
+		var extraData ExtraOpaqueData
+		require.NoError(t, extraData.PackRecords(fee.Record()))
+
+		var decoded Fee
+		tlvs, err := extraData.ExtractRecords(decoded.Record())
+		require.NoError(t, err)
+		require.Contains(t, tlvs, FeeRecordType)
+		require.Equal(t, fee, decoded)
+	}
+}
+
+// TestFeeRecordInvalidLength asserts that a fee record with an unexpected
+// length is rejected.
+func TestFeeRecordInvalidLength(t *testing.T) {
+	t.Parallel()
+
+	// The record type 55555 is encoded as the varint 0xfdd903, followed by
+	// a length of 4 bytes instead of the expected 8.
+	extraData := ExtraOpaqueData{0xfd, 0xd9, 0x03, 0x04, 0, 0, 0, 1}
+
+	var decoded Fee
+	_, err := extraData.ExtractRecords(decoded.Record())
+	require.Error(t, err)
+}
@@ -373,11 +373,18 @@ func TestLightningWireProtocol(t *testing.T) {
 				req.UpfrontShutdownScript = []byte{}
 			}
 
+			// 1/2 chance of an explicit channel type.
+			if r.Intn(2) == 0 {
+				req.ChannelType = NewChannelType(
+					ScidAliasRequired, ZeroConfRequired,
+				)
+			}
+
 			// 1/2 chance how having more TLV data after the
 			// shutdown script.
 			if r.Intn(2) == 0 {
-				// TLV type 1 of length 2.
-				req.ExtraData = []byte{1, 2, 0xff, 0xff}
+				// TLV type 3 of length 2.
+				req.ExtraData = []byte{3, 2, 0xff, 0xff}
 			} else {
 				req.ExtraData = []byte{}
 			}
@@ -442,11 +449,18 @@ func TestLightningWireProtocol(t *testing.T) {
 			} else {
 				req.UpfrontShutdownScript = []byte{}
 			}
+			// 1/2 chance of an explicit channel type.
+			if r.Intn(2) == 0 {
+				req.ChannelType = NewChannelType(
+					ScidAliasRequired, ZeroConfRequired,
+				)
+			}
+
 			// 1/2 chance how having more TLV data after the
 			// shutdown script.
 			if r.Intn(2) == 0 {
-				// TLV type 1 of length 2.
-				req.ExtraData = []byte{1, 2, 0xff, 0xff}
+				// TLV type 3 of length 2.
+				req.ExtraData = []byte{3, 2, 0xff, 0xff}
 			} else {
 				req.ExtraData = []byte{}
 			}
@@ -81,6 +81,8 @@ const (
 	CodeExpiryTooFar                     FailCode = 21
 	CodeInvalidOnionPayload                       = FlagPerm | 22
 	CodeMPPTimeout                       FailCode = 23
+	CodeTrampolineFeeInsufficient                 = FlagNode | 51
+	CodeTrampolineExpiryTooSoon                   = FlagNode | 52
 )
 
 // String returns the string representation of the failure code.
@@ -158,6 +160,12 @@ func (c FailCode) String() string {
 	case CodeMPPTimeout:
 		return "MPPTimeout"
 
+	case CodeTrampolineFeeInsufficient:
+		return "TrampolineFeeInsufficient"
+
+	case CodeTrampolineExpiryTooSoon:
+		return "TrampolineExpiryTooSoon"
+
 	default:
 		return "<unknown>"
 	}
@@ -1206,6 +1214,47 @@ func (f *FailMPPTimeout) Error() string {
 	return f.Code().String()
 }
 
+// FailTrampolineFeeInsufficient is returned by a trampoline node if the fee
+// budget left by the sender doesn't cover the trampoline's own fee and the fee
+// of a route towards the next destination.
+//
+// NOTE: May only be returned by trampoline nodes.
+type FailTrampolineFeeInsufficient struct{}
+
+// Code returns the failure unique code.
+//
+// NOTE: Part of the FailureMessage interface.
+func (f *FailTrampolineFeeInsufficient) Code() FailCode {
+	return CodeTrampolineFeeInsufficient
+}
+
+// Returns a human readable string describing the target FailureMessage.
+//
+// NOTE: Implements the error interface.
+func (f *FailTrampolineFeeInsufficient) Error() string {
+	return f.Code().String()
+}
+
+// FailTrampolineExpiryTooSoon is returned by a trampoline node if the cltv
+// budget left by the sender is too small to reach the next destination.
+//
+// NOTE: May only be returned by trampoline nodes.
+type FailTrampolineExpiryTooSoon struct{}
+
+// Code returns the failure unique code.
+//
+// NOTE: Part of the FailureMessage interface.
+func (f *FailTrampolineExpiryTooSoon) Code() FailCode {
+	return CodeTrampolineExpiryTooSoon
+}
+
+// Returns a human readable string describing the target FailureMessage.
+//
+// NOTE: Implements the error interface.
+func (f *FailTrampolineExpiryTooSoon) Error() string {
+	return f.Code().String()
+}
+
 // DecodeFailure decodes, validates, and parses the lnwire onion failure, for
 // the provided protocol version.
 func DecodeFailure(r io.Reader, pver uint32) (FailureMessage, error) {
@@ -1393,6 +1442,12 @@ func makeEmptyOnionError(code FailCode) (FailureMessage, error) {
 	case CodeMPPTimeout:
 		return &FailMPPTimeout{}, nil
 
+	case CodeTrampolineFeeInsufficient:
+		return &FailTrampolineFeeInsufficient{}, nil
+
+	case CodeTrampolineExpiryTooSoon:
+		return &FailTrampolineExpiryTooSoon{}, nil
+
 	default:
 		return nil, errors.Errorf("unknown error code: %v", code)
 	}
@@ -129,6 +129,11 @@ type OpenChannel struct {
 	// and its length followed by the script will be written if it is set.
 	UpfrontShutdownScript DeliveryAddress
 
+	// ChannelType is the explicit channel type the initiator wishes to
+	// open. If the responder doesn't echo it in its AcceptChannel
+	// message, the channel must not be opened. This field is optional.
+	ChannelType *ChannelType
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -136,8 +141,8 @@ type OpenChannel struct {
 	// NOTE: Since the upfront shutdown script MUST be present (though can
 	// be zero-length) if any TLV data is available, the script will be
 	// extracted and removed from this blob when decoding. ExtraData will
-	// contain all TLV records _except_ the DeliveryAddress record in that
-	// case.
+	// contain all TLV records _except_ the DeliveryAddress and ChannelType
+	// records in that case.
 	ExtraData ExtraOpaqueData
 }
 
@@ -151,10 +156,10 @@ var _ Message = (*OpenChannel)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
-	// Since the upfront script is encoded as a TLV record, concatenate it
-	// with the ExtraData, and write them as one.
-	tlvRecords, err := packShutdownScript(
-		o.UpfrontShutdownScript, o.ExtraData,
+	// Since the upfront script and the channel type are encoded as TLV
+	// records, concatenate them with the ExtraData, and write them as one.
+	tlvRecords, err := packChannelTLVs(
+		o.UpfrontShutdownScript, o.ChannelType, o.ExtraData,
 	)
 	if err != nil {
 		return err
@@ -222,9 +227,8 @@ func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
 		return err
 	}
 
-	o.UpfrontShutdownScript, o.ExtraData, err = parseShutdownScript(
-		tlvRecords,
-	)
+	o.UpfrontShutdownScript, o.ChannelType, o.ExtraData, err =
+		parseChannelTLVs(tlvRecords)
 	if err != nil {
 		return err
 	}
@@ -1,9 +1,22 @@
 package lnwire
 
 import (
+	"encoding/binary"
 	"fmt"
 )
 
+const (
+	// AliasStartHeight is the first block height of the range that is
+	// reserved for alias short channel IDs. Real short channel IDs won't
+	// reach this height for a few centuries, so any short channel ID at or
+	// above it doesn't point to an on-chain funding output.
+	AliasStartHeight = 16_000_000
+
+	// maxShortChanIDHeight is the first block height that can't be
+	// encoded in the three bytes of a short channel ID anymore.
+	maxShortChanIDHeight = 1 << 24
+)
+
 // ShortChannelID represents the set of data which is needed to retrieve all
 // necessary data to validate the channel existence.
 type ShortChannelID struct {
@@ -22,6 +35,21 @@ type ShortChannelID struct {
 	TxPosition uint16
 }
 
+// NewAliasShortChanID returns the alias short channel ID of the channel with
+// the given channel ID. The alias is used to route over a channel before its
+// funding transaction is confirmed and is derived deterministically, so both
+// parties of the channel arrive at the same alias.
+func NewAliasShortChanID(chanID ChannelID) ShortChannelID {
+	heightRange := uint32(maxShortChanIDHeight - AliasStartHeight)
+	height := binary.BigEndian.Uint32(chanID[:4]) % heightRange
+
+	return ShortChannelID{
+		BlockHeight: AliasStartHeight + height,
+		TxIndex:     binary.BigEndian.Uint32(chanID[4:8]) & 0xFFFFFF,
+		TxPosition:  binary.BigEndian.Uint16(chanID[8:10]),
+	}
+}
+
 // NewShortChanIDFromInt returns a new ShortChannelID which is the decoded
 // version of the compact channel ID encoded within the uint64. The format of
 // the compact channel ID is as follows: 3 bytes for the block height, 3 bytes
@@ -42,6 +70,12 @@ func (c ShortChannelID) ToUint64() uint64 {
 		(uint64(c.TxPosition)))
 }
 
+// IsAlias returns true if the short channel ID is an alias that doesn't
+// point to an on-chain funding output.
+func (c ShortChannelID) IsAlias() bool {
+	return c.BlockHeight >= AliasStartHeight
+}
+
 // String generates a human-readable representation of the channel ID.
 func (c ShortChannelID) String() string {
 	return fmt.Sprintf("%d:%d:%d", c.BlockHeight, c.TxIndex, c.TxPosition)
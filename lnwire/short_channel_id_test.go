@@ -39,3 +39,35 @@ func TestShortChannelIDEncoding(t *testing.T) {
 		}
 	}
 }
+
+// TestAliasShortChanID asserts that alias short channel IDs are derived
+// deterministically and can't be confused with real short channel IDs.
+func TestAliasShortChanID(t *testing.T) {
+	t.Parallel()
+
+	var chanID ChannelID
+	for i := 0; i < 100; i++ {
+		chanID[0] = byte(i)
+		chanID[3] = byte(i * 7)
+
+		alias := NewAliasShortChanID(chanID)
+		if !alias.IsAlias() {
+			t.Fatalf("%v is not an alias", alias)
+		}
+		if alias != NewShortChanIDFromInt(alias.ToUint64()) {
+			t.Fatalf("alias %v doesn't survive encoding", alias)
+		}
+		if alias != NewAliasShortChanID(chanID) {
+			t.Fatalf("alias of %v isn't deterministic", chanID)
+		}
+	}
+
+	realID := ShortChannelID{
+		BlockHeight: AliasStartHeight - 1,
+		TxIndex:     (1 << 24) - 1,
+		TxPosition:  (1 << 16) - 1,
+	}
+	if realID.IsAlias() {
+		t.Fatalf("%v is not an alias", realID)
+	}
+}
@@ -240,6 +240,11 @@ type Config struct {
 	// invoice-related logic.
 	Invoices *invoices.InvoiceRegistry
 
+	// TrampolineForwarder is passed to the ChannelLink on creation and
+	// forwards htlcs that carry trampoline instructions. If nil,
+	// trampoline htlcs are rejected.
+	TrampolineForwarder htlcswitch.TrampolineForwarder
+
 	// ChannelNotifier is used by the link to notify other sub-systems about
 	// channel-related events and by the Brontide to subscribe to
 	// ActiveLinkEvents.
@@ -294,6 +299,11 @@ type Config struct {
 	// commitment fee. This only applies for the initiator of the channel.
 	MaxChannelFeeAllocation float64
 
+	// MaxDustExposure is used when creating ChannelLinks and is the maximum
+	// total value of dust htlcs that a channel may carry on either of its
+	// commitments.
+	MaxDustExposure lnwire.MilliSatoshi
+
 	// MaxAnchorsCommitFeeRate is the maximum fee rate we'll use as an
 	// initiator for anchor channel commitments.
 	MaxAnchorsCommitFeeRate chainfee.SatPerKWeight
@@ -697,12 +707,17 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 		// routing policy into a forwarding policy.
 		var forwardingPolicy *htlcswitch.ForwardingPolicy
 		if selfPolicy != nil {
+			// An inbound fee is optional, so a policy without one
+			// results in a zero inbound fee.
+			inboundFee, _ := selfPolicy.InboundFee()
+
 			forwardingPolicy = &htlcswitch.ForwardingPolicy{
 				MinHTLCOut:    selfPolicy.MinHTLC,
 				MaxHTLC:       selfPolicy.MaxHTLC,
 				BaseFee:       selfPolicy.FeeBaseMSat,
 				FeeRate:       selfPolicy.FeeProportionalMillionths,
 				TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
+				InboundFee:    inboundFee,
 			}
 		} else {
 			peerLog.Warnf("Unable to find our forwarding policy "+
@@ -803,6 +818,7 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 		FetchLastChannelUpdate:  p.cfg.FetchLastChanUpdate,
 		HodlMask:                p.cfg.Hodl.Mask(),
 		Registry:                p.cfg.Invoices,
+		TrampolineForwarder:     p.cfg.TrampolineForwarder,
 		Switch:                  p.cfg.Switch,
 		Circuits:                p.cfg.Switch.CircuitModifier(),
 		ForwardPackets:          p.cfg.InterceptSwitch.ForwardPackets,
@@ -824,6 +840,7 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 		TowerClient:             towerClient,
 		MaxOutgoingCltvExpiry:   p.cfg.MaxOutgoingCltvExpiry,
 		MaxFeeAllocation:        p.cfg.MaxChannelFeeAllocation,
+		MaxDustExposure:         p.cfg.MaxDustExposure,
 		MaxAnchorsCommitFeeRate: p.cfg.MaxAnchorsCommitFeeRate,
 		NotifyActiveLink:        p.cfg.ChannelNotifier.NotifyActiveLinkEvent,
 		NotifyActiveChannel:     p.cfg.ChannelNotifier.NotifyActiveChannelEvent,
@@ -2368,7 +2385,7 @@ func (p *Brontide) fetchActiveChanCloser(chanID lnwire.ChannelID) (
 			return nil, fmt.Errorf("cannot obtain best block")
 		}
 
-		chanCloser = chancloser.NewChanCloser(
+		chanCloser, err = chancloser.NewChanCloser(
 			chancloser.ChanCloseCfg{
 				Channel:           channel,
 				UnregisterChannel: p.cfg.Switch.RemoveLink,
@@ -2387,6 +2404,11 @@ func (p *Brontide) fetchActiveChanCloser(chanID lnwire.ChannelID) (
 			nil,
 			false,
 		)
+		if err != nil {
+			peerLog.Errorf("unable to create channel closer: %v",
+				err)
+			return nil, err
+		}
 		p.activeChanCloses[chanID] = chanCloser
 	}
 
@@ -2486,7 +2508,7 @@ func (p *Brontide) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 			return
 		}
 
-		chanCloser := chancloser.NewChanCloser(
+		chanCloser, err := chancloser.NewChanCloser(
 			chancloser.ChanCloseCfg{
 				Channel:           channel,
 				UnregisterChannel: p.cfg.Switch.RemoveLink,
@@ -2497,7 +2519,9 @@ func (p *Brontide) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 				Disconnect: func() error {
 					return p.cfg.DisconnectPeer(p.IdentityKey())
 				},
-				Quit: p.quit,
+				MaxFee: req.MaxFeePerKw,
+				MinFee: req.MinFeePerKw,
+				Quit:   p.quit,
 			},
 			deliveryScript,
 			req.TargetFeePerKw,
@@ -2505,6 +2529,11 @@ func (p *Brontide) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 			req,
 			true,
 		)
+		if err != nil {
+			peerLog.Errorf(err.Error())
+			req.Err <- err
+			return
+		}
 		p.activeChanCloses[chanID] = chanCloser
 
 		// Finally, we'll initiate the channel shutdown within the
@@ -2615,6 +2644,13 @@ func (p *Brontide) handleLinkFailure(failure linkFailureReport) {
 				"remote peer: %v", err)
 		}
 	}
+
+	// If requested, we'll disconnect from the peer, so that the link is
+	// restored once it reconnects.
+	if failure.linkErr.Disconnect {
+		p.Disconnect(fmt.Errorf("link(%v) failed: %v",
+			failure.shortChanID, failure.linkErr))
+	}
 }
 
 // finalizeChanClosure performs the final clean up steps once the cooperative
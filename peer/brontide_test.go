@@ -2,6 +2,7 @@ package peer
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 	"time"
 
@@ -251,6 +252,55 @@ func TestPeerChannelClosureAcceptFeeInitiator(t *testing.T) {
 	notifier.ConfChan <- &chainntnfs.TxConfirmation{}
 }
 
+// TestPeerChannelClosureInvalidFeeRange tests that a close request whose
+// minimum fee rate exceeds its maximum fee rate is rejected before the
+// shutdown is initiated.
+func TestPeerChannelClosureInvalidFeeRange(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mock.ChainNotifier{
+		SpendChan: make(chan *chainntnfs.SpendDetail),
+		EpochChan: make(chan *chainntnfs.BlockEpoch),
+		ConfChan:  make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	alicePeer, bobChan, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan, noUpdate,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	errChan := make(chan error, 1)
+	closeCommand := &htlcswitch.ChanClose{
+		CloseType:      htlcswitch.CloseRegular,
+		ChanPoint:      bobChan.ChannelPoint(),
+		Updates:        make(chan interface{}, 1),
+		TargetFeePerKw: 12500,
+		MaxFeePerKw:    12500,
+		MinFeePerKw:    25000,
+		Err:            errChan,
+	}
+	alicePeer.localCloseChanReqs <- closeCommand
+
+	select {
+	case err := <-errChan:
+		if !errors.Is(err, chancloser.ErrInvalidFeeRange) {
+			t.Fatalf("expected invalid fee range, got: %v", err)
+		}
+	case <-time.After(timeout):
+		t.Fatalf("close request not rejected")
+	}
+
+	select {
+	case outMsg := <-alicePeer.outgoingQueue:
+		t.Fatalf("unexpected message: %T", outMsg.msg)
+	default:
+	}
+}
+
 // TestPeerChannelClosureFeeNegotiationsResponder tests the shutdown
 // responder's behavior in the case where we must do several rounds of fee
 // negotiation before we agree on a fee.
@@ -0,0 +1,139 @@
+package pruner
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// Config holds the parameters and dependencies of the Pruner.
+type Config struct {
+	// PaymentAge is the age after which succeeded and failed payments are
+	// deleted. Payments are not deleted if it is zero.
+	PaymentAge time.Duration
+
+	// InvoiceAge is the age after which settled and canceled invoices are
+	// deleted. Invoices are not deleted if it is zero.
+	InvoiceAge time.Duration
+
+	// DeletePayments deletes all succeeded and failed payments that were
+	// created before the given time, and returns the number of deleted
+	// payments.
+	DeletePayments func(before time.Time) (int, error)
+
+	// DeleteInvoices deletes all invoices that were settled or canceled
+	// before the given time, and returns the number of deleted invoices.
+	DeleteInvoices func(before time.Time) (int, error)
+
+	// Ticker determines the interval at which old payments and invoices
+	// are deleted.
+	Ticker ticker.Ticker
+
+	// Clock is used to determine the current time.
+	Clock clock.Clock
+}
+
+// Pruner periodically deletes old payments and invoices to limit the growth of
+// the database. Only payments and invoices that are fully resolved are
+// deleted. The indexes of the remaining payments and invoices are not
+// changed, so subscribers can keep resuming from the indexes they know.
+type Pruner struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New returns a new pruner with the given config.
+func New(cfg *Config) *Pruner {
+	return &Pruner{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start launches the goroutine that deletes old payments and invoices.
+func (p *Pruner) Start() error {
+	p.started.Do(func() {
+		log.Infof("Pruner starting, payment age: %v, invoice age: %v",
+			p.cfg.PaymentAge, p.cfg.InvoiceAge)
+
+		p.wg.Add(1)
+		go p.pruneLoop()
+	})
+
+	return nil
+}
+
+// Stop signals the pruner to stop and waits for it to exit.
+func (p *Pruner) Stop() error {
+	p.stopped.Do(func() {
+		log.Info("Pruner shutting down")
+
+		close(p.quit)
+		p.wg.Wait()
+
+		// Stop the ticker after the goroutine reading from it has
+		// exited, to avoid a race.
+		p.cfg.Ticker.Stop()
+	})
+
+	return nil
+}
+
+// pruneLoop deletes old payments and invoices once on startup, and then every
+// time the ticker fires.
+func (p *Pruner) pruneLoop() {
+	defer p.wg.Done()
+
+	p.prune()
+
+	p.cfg.Ticker.Resume()
+
+	for {
+		select {
+		case <-p.cfg.Ticker.Ticks():
+			p.prune()
+
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// prune deletes the payments and invoices that are older than their
+// configured age. Failures are logged and retried at the next tick.
+func (p *Pruner) prune() {
+	now := p.cfg.Clock.Now()
+
+	if p.cfg.PaymentAge != 0 {
+		numDeleted, err := p.cfg.DeletePayments(
+			now.Add(-p.cfg.PaymentAge),
+		)
+		switch {
+		case err != nil:
+			log.Errorf("Unable to delete old payments: %v", err)
+
+		case numDeleted > 0:
+			log.Infof("Deleted %d old payments", numDeleted)
+		}
+	}
+
+	if p.cfg.InvoiceAge != 0 {
+		numDeleted, err := p.cfg.DeleteInvoices(
+			now.Add(-p.cfg.InvoiceAge),
+		)
+		switch {
+		case err != nil:
+			log.Errorf("Unable to delete old invoices: %v", err)
+
+		case numDeleted > 0:
+			log.Infof("Deleted %d old invoices", numDeleted)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package pruner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/ticker"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = 5 * time.Second
+
+// TestPruner tests that the pruner deletes payments and invoices that are
+// older than their configured age on startup and at every tick.
+func TestPruner(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now            = time.Unix(100000, 0)
+		testClock      = clock.NewTestClock(now)
+		testTicker     = ticker.NewForce(time.Hour)
+		paymentCutoffs = make(chan time.Time)
+		invoiceCutoffs = make(chan time.Time)
+	)
+
+	pruner := New(&Config{
+		PaymentAge: time.Hour,
+		InvoiceAge: 2 * time.Hour,
+		DeletePayments: func(before time.Time) (int, error) {
+			paymentCutoffs <- before
+			return 1, nil
+		},
+		DeleteInvoices: func(before time.Time) (int, error) {
+			invoiceCutoffs <- before
+			return 0, nil
+		},
+		Ticker: testTicker,
+		Clock:  testClock,
+	})
+	require.NoError(t, pruner.Start())
+	defer func() {
+		require.NoError(t, pruner.Stop())
+	}()
+
+	assertCutoff := func(cutoffs chan time.Time, expected time.Time) {
+		t.Helper()
+
+		select {
+		case cutoff := <-cutoffs:
+			require.Equal(t, expected, cutoff)
+
+		case <-time.After(testTimeout):
+			t.Fatal("expected prune")
+		}
+	}
+
+	// The pruner deletes old payments and invoices right after starting.
+	assertCutoff(paymentCutoffs, now.Add(-time.Hour))
+	assertCutoff(invoiceCutoffs, now.Add(-2*time.Hour))
+
+	// At the next tick, the cutoffs are based on the current time.
+	now = now.Add(time.Hour)
+	testClock.SetTime(now)
+
+	select {
+	case testTicker.Force <- now:
+	case <-time.After(testTimeout):
+		t.Fatal("tick not consumed")
+	}
+
+	assertCutoff(paymentCutoffs, now.Add(-time.Hour))
+	assertCutoff(invoiceCutoffs, now.Add(-2*time.Hour))
+}
+
+// TestPrunerDisabled tests that payments and invoices are not deleted if their
+// age is zero.
+func TestPrunerDisabled(t *testing.T) {
+	t.Parallel()
+
+	testTicker := ticker.NewForce(time.Hour)
+	invoiceCutoffs := make(chan time.Time, 1)
+
+	pruner := New(&Config{
+		InvoiceAge: time.Hour,
+		DeletePayments: func(before time.Time) (int, error) {
+			t.Fatal("payments must not be deleted")
+			return 0, nil
+		},
+		DeleteInvoices: func(before time.Time) (int, error) {
+			invoiceCutoffs <- before
+			return 0, nil
+		},
+		Ticker: testTicker,
+		Clock:  clock.NewTestClock(time.Unix(100000, 0)),
+	})
+	require.NoError(t, pruner.Start())
+
+	select {
+	case <-invoiceCutoffs:
+	case <-time.After(testTimeout):
+		t.Fatal("expected invoice prune")
+	}
+
+	require.NoError(t, pruner.Stop())
+}
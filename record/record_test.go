@@ -22,6 +22,9 @@ var (
 	testShare      = [32]byte{0x03, 0x04}
 	testSetID      = [32]byte{0x05, 0x06}
 	testChildIndex = uint16(17)
+	testNextNode   = [33]byte{0x02, 0x07}
+	testAmt        = lnwire.MilliSatoshi(1000)
+	testCltv       = uint32(600000)
 )
 
 var recordEncDecTests = []recordEncDecTest{
@@ -97,3 +100,87 @@ func TestRecordEncodeDecode(t *testing.T) {
 		})
 	}
 }
+
+// TestTrampolineEncodeDecode asserts that trampoline payloads survive an
+// encoding round trip, with and without an mpp record.
+func TestTrampolineEncodeDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		mpp  *record.MPP
+	}{
+		{
+			name: "with mpp",
+			mpp:  record.NewMPP(testTotal, testAddr),
+		},
+		{
+			name: "without mpp",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			trampoline := record.NewTrampoline(
+				testNextNode, testAmt, testCltv, test.mpp,
+			)
+
+			var b bytes.Buffer
+			if err := trampoline.Encode(&b); err != nil {
+				t.Fatalf("unable to encode trampoline: %v", err)
+			}
+
+			decoded := new(record.Trampoline)
+			err := decoded.Decode(bytes.NewReader(b.Bytes()))
+			if err != nil {
+				t.Fatalf("unable to decode trampoline: %v", err)
+			}
+
+			if decoded.NextNode() != testNextNode {
+				t.Fatal("incorrect next node")
+			}
+			if decoded.AmtToForward() != testAmt {
+				t.Fatal("incorrect amount to forward")
+			}
+			if decoded.OutgoingCltv() != testCltv {
+				t.Fatal("incorrect outgoing cltv")
+			}
+
+			mpp := decoded.MPP()
+			if test.mpp == nil {
+				if mpp != nil {
+					t.Fatal("unexpected mpp record")
+				}
+				return
+			}
+			if mpp == nil {
+				t.Fatal("expected mpp record")
+			}
+			if mpp.TotalMsat() != testTotal {
+				t.Fatal("incorrect total msat")
+			}
+			if mpp.PaymentAddr() != testAddr {
+				t.Fatal("incorrect payment addr")
+			}
+		})
+	}
+}
+
+// TestTrampolineMissingField asserts that a trampoline payload without a next
+// node is rejected.
+func TestTrampolineMissingField(t *testing.T) {
+	amt := uint64(testAmt)
+	cltv := testCltv
+
+	var b bytes.Buffer
+	stream := tlv.MustNewStream(
+		record.NewAmtToFwdRecord(&amt), record.NewLockTimeRecord(&cltv),
+	)
+	if err := stream.Encode(&b); err != nil {
+		t.Fatalf("unable to encode stream: %v", err)
+	}
+
+	err := new(record.Trampoline).Decode(bytes.NewReader(b.Bytes()))
+	if err != record.ErrTrampolineMissingField {
+		t.Fatalf("expected missing field error, got: %v", err)
+	}
+}
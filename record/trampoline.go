@@ -0,0 +1,168 @@
+package record
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// TrampolineOnionType is the type used in the onion to reference the
+	// trampoline onion. The trampoline onion is a smaller onion packet
+	// embedded in the final hop payload of the outer onion, which
+	// instructs the receiving trampoline node to forward the payment
+	// towards the next trampoline or the final destination on behalf of
+	// the sender.
+	TrampolineOnionType tlv.Type = 66100
+
+	// trampolineNextNodeType is the type used within the trampoline
+	// payload to reference the public key of the next destination.
+	trampolineNextNodeType tlv.Type = 14
+)
+
+var (
+	// ErrTrampolineMissingField is returned when a decoded trampoline
+	// payload lacks one of its mandatory fields.
+	ErrTrampolineMissingField = errors.New("trampoline payload is " +
+		"missing a required field")
+)
+
+// NewTrampolineOnionRecord creates a tlv.Record that encodes the serialized
+// trampoline onion under the trampoline onion type.
+func NewTrampolineOnionRecord(onion *[]byte) tlv.Record {
+	return tlv.MakePrimitiveRecord(TrampolineOnionType, onion)
+}
+
+// Trampoline is the payload that a trampoline node finds in its layer of the
+// trampoline onion: the node that the payment should be forwarded to, which
+// is either the next trampoline or the final destination, together with the
+// amount and cltv that this node expects. The fields are encoded as a tlv
+// stream that mirrors the layout of a regular final hop payload.
+type Trampoline struct {
+	// nextNode is the public key of the node that the trampoline should
+	// route the payment to.
+	nextNode [33]byte
+
+	// amtToForward is the amount that should arrive at the next node.
+	amtToForward lnwire.MilliSatoshi
+
+	// outgoingCltv is the absolute cltv expiry that the htlc arriving at
+	// the next node should have.
+	outgoingCltv uint32
+
+	// mpp optionally holds the payment address and total amount that
+	// should be passed on to the final destination.
+	mpp *MPP
+}
+
+// NewTrampoline generates a new trampoline payload with the given next node,
+// amount, outgoing cltv and optional mpp record.
+func NewTrampoline(nextNode [33]byte, amt lnwire.MilliSatoshi,
+	outgoingCltv uint32, mpp *MPP) *Trampoline {
+
+	return &Trampoline{
+		nextNode:     nextNode,
+		amtToForward: amt,
+		outgoingCltv: outgoingCltv,
+		mpp:          mpp,
+	}
+}
+
+// NextNode returns the public key of the node that the payment should be
+// forwarded to.
+func (t *Trampoline) NextNode() [33]byte {
+	return t.nextNode
+}
+
+// AmtToForward returns the amount that should arrive at the next node.
+func (t *Trampoline) AmtToForward() lnwire.MilliSatoshi {
+	return t.amtToForward
+}
+
+// OutgoingCltv returns the absolute cltv expiry that the htlc arriving at the
+// next node should have.
+func (t *Trampoline) OutgoingCltv() uint32 {
+	return t.outgoingCltv
+}
+
+// MPP returns the mpp record that should be passed on to the final
+// destination, or nil if there is none.
+func (t *Trampoline) MPP() *MPP {
+	return t.mpp
+}
+
+// Encode serializes the tlv stream of the trampoline payload to the given
+// io.Writer.
+func (t *Trampoline) Encode(w io.Writer) error {
+	amt := uint64(t.amtToForward)
+	records := []tlv.Record{
+		NewAmtToFwdRecord(&amt),
+		NewLockTimeRecord(&t.outgoingCltv),
+	}
+	if t.mpp != nil {
+		records = append(records, t.mpp.Record())
+	}
+	records = append(
+		records, tlv.MakePrimitiveRecord(
+			trampolineNextNodeType, &t.nextNode,
+		),
+	)
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
+}
+
+// Decode parses the tlv stream of the trampoline payload from the given
+// io.Reader.
+func (t *Trampoline) Decode(r io.Reader) error {
+	var (
+		amt uint64
+		mpp = &MPP{}
+	)
+
+	stream, err := tlv.NewStream(
+		NewAmtToFwdRecord(&amt),
+		NewLockTimeRecord(&t.outgoingCltv),
+		mpp.Record(),
+		tlv.MakePrimitiveRecord(trampolineNextNodeType, &t.nextNode),
+	)
+	if err != nil {
+		return err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return err
+	}
+
+	for _, typ := range []tlv.Type{
+		AmtOnionType, LockTimeOnionType, trampolineNextNodeType,
+	} {
+		if _, ok := parsedTypes[typ]; !ok {
+			return ErrTrampolineMissingField
+		}
+	}
+
+	t.amtToForward = lnwire.MilliSatoshi(amt)
+
+	if _, ok := parsedTypes[MPPOnionType]; ok {
+		t.mpp = mpp
+	} else {
+		t.mpp = nil
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the trampoline payload.
+func (t *Trampoline) String() string {
+	return fmt.Sprintf("next_node=%x, amt=%v, cltv=%v, mpp=%v",
+		t.nextNode, t.amtToForward, t.outgoingCltv, t.mpp)
+}
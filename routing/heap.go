@@ -3,7 +3,6 @@ package routing
 import (
 	"container/heap"
 
-	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
 )
@@ -21,7 +20,9 @@ type nodeWithDist struct {
 
 	// amountToReceive is the amount that should be received by this node.
 	// Either as final payment to the final node or as an intermediate
-	// amount that includes also the fees for subsequent hops.
+	// amount that includes also the fees for subsequent hops. The inbound
+	// fee that this node charges is not included, because it depends on
+	// the channel that the payment arrives over.
 	amountToReceive lnwire.MilliSatoshi
 
 	// incomingCltv is the expected absolute expiry height for the incoming
@@ -38,8 +39,12 @@ type nodeWithDist struct {
 	// time locks.
 	weight int64
 
+	// outboundFee is the fee that this node charges for forwarding the
+	// payment to the next hop, excluding any inbound fee.
+	outboundFee lnwire.MilliSatoshi
+
 	// nextHop is the edge this route comes from.
-	nextHop *channeldb.ChannelEdgePolicy
+	nextHop *unifiedPolicyEdge
 
 	// routingInfoSize is the total size requirement for the payloads field
 	// in the onion packet from this hop towards the final destination.
@@ -92,12 +92,14 @@ func (r *Manager) UpdatePolicy(newSchema routing.ChannelPolicy,
 		})
 
 		// Add updated policy to list of policies to send to switch.
+		inboundFee, _ := edge.InboundFee()
 		policiesToUpdate[info.ChannelPoint] = htlcswitch.ForwardingPolicy{
 			BaseFee:       edge.FeeBaseMSat,
 			FeeRate:       edge.FeeProportionalMillionths,
 			TimeLockDelta: uint32(edge.TimeLockDelta),
 			MinHTLCOut:    edge.MinHTLC,
 			MaxHTLC:       edge.MaxHTLC,
+			InboundFee:    inboundFee,
 		}
 
 		return nil
@@ -134,6 +136,15 @@ func (r *Manager) updateEdge(chanPoint wire.OutPoint,
 	)
 	edge.TimeLockDelta = uint16(newSchema.TimeLockDelta)
 
+	// If a new inbound fee is specified, store it in the extra data of
+	// the edge, so that it is announced to the network.
+	if newSchema.InboundFee != nil {
+		err := edge.SetInboundFee(*newSchema.InboundFee)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Retrieve negotiated channel htlc amt limits.
 	amtMin, amtMax, err := r.getHtlcAmtLimits(chanPoint)
 	if err != nil {
@@ -23,12 +23,14 @@ func TestManager(t *testing.T) {
 		chanCap          = btcutil.Amount(1000)
 		maxPendingAmount = lnwire.MilliSatoshi(999000)
 		minHTLC          = lnwire.MilliSatoshi(2000)
+		inboundFee       = channeldb.InboundFee{Base: -10, Rate: -20}
 	)
 
 	newPolicy := routing.ChannelPolicy{
 		FeeSchema: routing.FeeSchema{
-			BaseFee: 100,
-			FeeRate: 200,
+			BaseFee:    100,
+			FeeRate:    200,
+			InboundFee: &inboundFee,
 		},
 		TimeLockDelta: 80,
 		MaxHTLC:       5000,
@@ -59,6 +61,9 @@ func TestManager(t *testing.T) {
 		if policy.MaxHTLC != newPolicy.MaxHTLC {
 			t.Fatal("unexpected max htlc")
 		}
+		if policy.InboundFee != inboundFee {
+			t.Fatal("unexpected inbound fee")
+		}
 	}
 
 	propagateChanPolicyUpdate := func(
@@ -84,6 +89,9 @@ func TestManager(t *testing.T) {
 		if policy.MaxHTLC != newPolicy.MaxHTLC {
 			t.Fatal("unexpected max htlc")
 		}
+		if fee, ok := policy.InboundFee(); !ok || fee != inboundFee {
+			t.Fatal("unexpected inbound fee")
+		}
 
 		return nil
 	}
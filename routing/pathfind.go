@@ -42,7 +42,7 @@ const (
 type pathFinder = func(g *graphParams, r *RestrictParams,
 	cfg *PathFindingConfig, source, target route.Vertex,
 	amt lnwire.MilliSatoshi, finalHtlcExpiry int32) (
-	[]*channeldb.ChannelEdgePolicy, error)
+	[]*unifiedPolicyEdge, error)
 
 var (
 	// DefaultAttemptCost is the default fixed virtual cost in path finding
@@ -89,6 +89,11 @@ type finalHopParams struct {
 	cltvDelta   uint16
 	records     record.CustomSet
 	paymentAddr *[32]byte
+
+	// trampolineOnion optionally holds the trampoline onion that
+	// instructs the final hop, acting as trampoline, where to forward the
+	// payment to.
+	trampolineOnion []byte
 }
 
 // newRoute constructs a route using the provided path and final hop constraints.
@@ -102,7 +107,7 @@ type finalHopParams struct {
 // any feature vectors on all hops have been validated for transitive
 // dependencies.
 func newRoute(sourceVertex route.Vertex,
-	pathEdges []*channeldb.ChannelEdgePolicy, currentHeight uint32,
+	pathEdges []*unifiedPolicyEdge, currentHeight uint32,
 	finalHop finalHopParams) (*route.Route, error) {
 
 	var (
@@ -124,7 +129,7 @@ func newRoute(sourceVertex route.Vertex,
 	for i := pathLength - 1; i >= 0; i-- {
 		// Now we'll start to calculate the items within the per-hop
 		// payload for the hop this edge is leading to.
-		edge := pathEdges[i]
+		edge := pathEdges[i].policy
 
 		// We'll calculate the amounts, timelocks, and fees for each hop
 		// in the route. The base case is the final hop which includes
@@ -138,6 +143,7 @@ func newRoute(sourceVertex route.Vertex,
 			tlvPayload       bool
 			customRecords    record.CustomSet
 			mpp              *record.MPP
+			trampolineOnion  []byte
 		)
 
 		// Define a helper function that checks this edge's feature
@@ -193,6 +199,23 @@ func newRoute(sourceVertex route.Vertex,
 					*finalHop.paymentAddr,
 				)
 			}
+
+			// If the final hop is a trampoline, attach the
+			// onion that tells it where to forward the payment to.
+			// This requires the hop to signal support for
+			// trampoline routing, either in the graph or through
+			// the destination features of the payment.
+			if finalHop.trampolineOnion != nil {
+				supportsTrampoline := supports(
+					lnwire.TrampolineRoutingOptional,
+				)
+				if !tlvPayload || !supportsTrampoline {
+					return nil, errors.New("cannot attach " +
+						"trampoline onion")
+				}
+
+				trampolineOnion = finalHop.trampolineOnion
+			}
 		} else {
 			// The amount that the current hop needs to forward is
 			// equal to the incoming amount of the next hop.
@@ -202,14 +225,19 @@ func newRoute(sourceVertex route.Vertex,
 			// based on the amount that this hop needs to forward
 			// and its policy for the outgoing channel. This policy
 			// is stored as part of the incoming channel of
-			// the next hop.
-			fee = pathEdges[i+1].ComputeFee(amtToForward)
+			// the next hop. On top of that, the hop may charge an
+			// inbound fee for the channel the htlc arrives over.
+			fee = pathEdges[i].computeNodeFee(
+				pathEdges[i+1], amtToForward,
+			)
 
 			// We'll take the total timelock of the preceding hop as
 			// the outgoing timelock or this hop. Then we'll
 			// increment the total timelock incurred by this hop.
 			outgoingTimeLock = totalTimeLock
-			totalTimeLock += uint32(pathEdges[i+1].TimeLockDelta)
+			totalTimeLock += uint32(
+				pathEdges[i+1].policy.TimeLockDelta,
+			)
 		}
 
 		// Since we're traversing the path backwards atm, we prepend
@@ -223,6 +251,7 @@ func newRoute(sourceVertex route.Vertex,
 			LegacyPayload:    !tlvPayload,
 			CustomRecords:    customRecords,
 			MPP:              mpp,
+			TrampolineOnion:  trampolineOnion,
 		}
 
 		hops = append([]*route.Hop{currentHop}, hops...)
@@ -321,6 +350,18 @@ type RestrictParams struct {
 	// mitigate probing vectors and payment sniping attacks on overpaid
 	// invoices.
 	PaymentAddr *[32]byte
+
+	// TrampolineOnion is the optional trampoline onion that is attached to
+	// the final hop. It takes up space in the onion, which limits the
+	// length of the route.
+	TrampolineOnion []byte
+
+	// TimePref expresses the preference of the caller for either cheap or
+	// fast payments. The value ranges from -1 to 1. At -1, path finding
+	// optimizes for the lowest fees, while at 1 it optimizes for the
+	// highest success probability. At 0, the trade-off defined by the
+	// attempt cost in the path finding config is used.
+	TimePref float64
 }
 
 // PathFindingConfig defines global parameters that control the trade-off in
@@ -407,7 +448,7 @@ func getOutgoingBalance(node route.Vertex, outgoingChans map[uint64]struct{},
 // available bandwidth.
 func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	source, target route.Vertex, amt lnwire.MilliSatoshi,
-	finalHtlcExpiry int32) ([]*channeldb.ChannelEdgePolicy, error) {
+	finalHtlcExpiry int32) ([]*unifiedPolicyEdge, error) {
 
 	log.Infof("trying to find route for amt=%v, src=%v, dst=%v ", amt.ToSatoshis().String(), source.String(), target.String())
 
@@ -549,7 +590,8 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		LegacyPayload: !features.HasFeature(
 			lnwire.TLVOnionPayloadOptional,
 		),
-		MPP: mpp,
+		MPP:             mpp,
+		TrampolineOnion: r.TrampolineOnion,
 	}
 
 	// We can't always assume that the end destination is publicly
@@ -577,23 +619,46 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 	// Calculate the absolute attempt cost that is used for probability
 	// estimation.
-	absoluteAttemptCost := int64(cfg.AttemptCost) +
-		int64(amt)*cfg.AttemptCostPPM/1000000
+	absoluteAttemptCost := applyTimePref(
+		int64(cfg.AttemptCost)+int64(amt)*cfg.AttemptCostPPM/1000000,
+		r.TimePref,
+	)
 
-	log.Debugf("Pathfinding absolute attempt cost: %v sats",
-		float64(absoluteAttemptCost)/1000)
+	log.Debugf("Pathfinding absolute attempt cost: %v sats "+
+		"(time_pref=%v)", float64(absoluteAttemptCost)/1000, r.TimePref)
 
 	// processEdge is a helper closure that will be used to make sure edges
 	// satisfy our specific requirements.
 	processEdge := func(fromVertex route.Vertex,
 		fromFeatures *lnwire.FeatureVector,
-		edge *channeldb.ChannelEdgePolicy, toNodeDist *nodeWithDist) {
+		unifiedEdge *unifiedPolicyEdge, toNodeDist *nodeWithDist) {
 
 		edgesExpanded++
 
+		edge := unifiedEdge.policy
+
+		// Calculate the inbound fee that the to node charges for htlcs
+		// arriving over this channel. The final node doesn't charge an
+		// inbound fee. Because routing nodes never accept a negative
+		// total fee, a discount can at most cancel out the outbound
+		// fee of the to node.
+		var inboundFee int64
+		if toNodeDist.node != target {
+			inboundFee = unifiedEdge.inboundFee.CalcFee(
+				toNodeDist.amountToReceive,
+			)
+
+			minInboundFee := -int64(toNodeDist.outboundFee)
+			if inboundFee < minInboundFee {
+				inboundFee = minInboundFee
+			}
+		}
+
 		// Calculate amount that the candidate node would have to send
 		// out.
-		amountToSend := toNodeDist.amountToReceive
+		amountToSend := lnwire.MilliSatoshi(
+			int64(toNodeDist.amountToReceive) + inboundFee,
+		)
 
 		// Request the success probability for this edge.
 		edgeProbability := r.ProbabilitySource(
@@ -669,8 +734,18 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// By adding fromVertex in the route, there will be an extra
 		// weight composed of the fee that this node will charge and
 		// the amount that will be locked for timeLockDelta blocks in
-		// the HTLC that is handed out to fromVertex.
-		weight := edgeWeight(amountToReceive, fee, timeLockDelta)
+		// the HTLC that is handed out to fromVertex. The inbound fee
+		// of the to node is accounted for here as well. Because
+		// Dijkstra doesn't support negative edge weights, the combined
+		// fee is never taken below zero.
+		weightFee := int64(fee) + inboundFee
+		if weightFee < 0 {
+			weightFee = 0
+		}
+		weight := edgeWeight(
+			amountToReceive, lnwire.MilliSatoshi(weightFee),
+			timeLockDelta,
+		)
 
 		// Compute the tentative weight to this new channel/edge
 		// which is the weight from our toNode to the target node
@@ -751,7 +826,8 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			amountToReceive: amountToReceive,
 			incomingCltv:    incomingCltv,
 			probability:     probability,
-			nextHop:         edge,
+			outboundFee:     fee,
+			nextHop:         unifiedEdge,
 			routingInfoSize: routingInfoSize,
 		}
 		distance[fromVertex] = withDist
@@ -820,8 +896,13 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			return nil, err
 		}
 
+		// Route hints don't carry inbound fees, so none are assumed
+		// for them.
 		for _, reverseEdge := range additionalEdgesWithSrc[pivot] {
-			u.addPolicy(reverseEdge.sourceNode, reverseEdge.edge, 0)
+			u.addPolicy(
+				reverseEdge.sourceNode, reverseEdge.edge, 0,
+				channeldb.InboundFee{},
+			)
 		}
 
 		amtToSend := partialPath.amountToReceive
@@ -892,7 +973,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 	// Use the distance map to unravel the forward path from source to
 	// target.
-	var pathEdges []*channeldb.ChannelEdgePolicy
+	var pathEdges []*unifiedPolicyEdge
 	currentNode := source
 	for {
 		// Determine the next hop forward using the next map.
@@ -911,7 +992,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		pathEdges = append(pathEdges, currentNodeWithDist.nextHop)
 
 		// Advance current node.
-		currentNode = currentNodeWithDist.nextHop.Node.PubKeyBytes
+		currentNode = currentNodeWithDist.nextHop.policy.Node.PubKeyBytes
 
 		// Check stop condition at the end of this loop. This prevents
 		// breaking out too soon for self-payments that have target set
@@ -932,7 +1013,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	// route construction does not care where the features are actually
 	// taken from. In the future we may wish to do route construction within
 	// findPath, and avoid using ChannelEdgePolicy altogether.
-	pathEdges[len(pathEdges)-1].Node.Features = features
+	pathEdges[len(pathEdges)-1].policy.Node.Features = features
 
 	log.Debugf("Found route: probability=%v, hops=%v, fee=%v",
 		distance[source].probability, len(pathEdges),
@@ -974,6 +1055,9 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 // Fa + c/Pa < Fb + c/Pb
 //
 // So the value of F + c/P can be used to compare routes.
+//
+// The attempt cost c is what determines the trade-off between fees and
+// success probability. See applyTimePref for how a payment can shift it.
 func getProbabilityBasedDist(weight int64, probability float64, penalty int64) int64 {
 	// Clamp probability to prevent overflow.
 	const minProbability = 0.00001
@@ -984,3 +1068,15 @@ func getProbabilityBasedDist(weight int64, probability float64, penalty int64) i
 
 	return weight + int64(float64(penalty)/probability)
 }
+
+// applyTimePref scales the attempt cost according to the given time
+// preference. A positive preference increases the cost of a failed attempt,
+// which makes path finding favor reliable routes over cheap ones. A negative
+// preference decreases it, so that fees weigh more heavily. The preference is
+// scaled down so that the extremes -1 and 1 don't result in a zero or infinite
+// attempt cost.
+func applyTimePref(attemptCost int64, timePref float64) int64 {
+	timePref *= 0.9
+
+	return int64(float64(attemptCost) * (1 + timePref) / (1 - timePref))
+}
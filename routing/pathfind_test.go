@@ -342,6 +342,7 @@ type testChannelPolicy struct {
 	LastUpdate  time.Time
 	Disabled    bool
 	Features    *lnwire.FeatureVector
+	InboundFee  *channeldb.InboundFee
 }
 
 type testChannelEnd struct {
@@ -575,6 +576,12 @@ func createTestGraphFromChannels(testChannels []*testChannel, source string) (
 				FeeBaseMSat:               node1.FeeBaseMsat,
 				FeeProportionalMillionths: node1.FeeRate,
 			}
+			if node1.InboundFee != nil {
+				err := edgePolicy.SetInboundFee(*node1.InboundFee)
+				if err != nil {
+					return nil, err
+				}
+			}
 			if err := graph.UpdateEdgePolicy(edgePolicy); err != nil {
 				return nil, err
 			}
@@ -603,6 +610,12 @@ func createTestGraphFromChannels(testChannels []*testChannel, source string) (
 				FeeBaseMSat:               node2.FeeBaseMsat,
 				FeeProportionalMillionths: node2.FeeRate,
 			}
+			if node2.InboundFee != nil {
+				err := edgePolicy.SetInboundFee(*node2.InboundFee)
+				if err != nil {
+					return nil, err
+				}
+			}
 			if err := graph.UpdateEdgePolicy(edgePolicy); err != nil {
 				return nil, err
 			}
@@ -696,6 +709,77 @@ func TestFindLowestFeePath(t *testing.T) {
 	}
 }
 
+// TestFindPathInboundFee tests that an inbound discount offered by a routing
+// node is taken into account when selecting a path, and that the resulting
+// route pays the discounted fee.
+func TestFindPathInboundFee(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph with two paths from roasbeef to target. Without
+	// inbound fees, the path through b is cheapest. Node a however offers
+	// a discount on its channel with first, which makes the path through a
+	// the cheaper one.
+	policy := func(feeRate lnwire.MilliSatoshi) *testChannelPolicy {
+		return &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: feeRate,
+			MinHTLC: 1,
+			MaxHTLC: 100000000,
+		}
+	}
+
+	discountPolicy := policy(500)
+	discountPolicy.InboundFee = &channeldb.InboundFee{Rate: -400}
+
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "first", 100000, policy(100)),
+		asymmetricTestChannel(
+			"first", "a", 100000, policy(500), discountPolicy, 0,
+		),
+		symmetricTestChannel("a", "target", 100000, policy(600)),
+		symmetricTestChannel("first", "b", 100000, policy(500)),
+		symmetricTestChannel("b", "target", 100000, policy(400)),
+	}
+
+	ctx := newPathFindingTestContext(t, testChannels, "roasbeef")
+	defer ctx.cleanup()
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	target := ctx.keyFromAlias("target")
+	path, err := ctx.findPath(target, paymentAmt)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+
+	route, err := newRoute(
+		ctx.source, path, 100,
+		finalHopParams{
+			amt:       paymentAmt,
+			cltvDelta: 1,
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to create route: %v", err)
+	}
+
+	// The route should pass through a. Node a charges an outbound fee of
+	// 60 msat, minus an inbound discount of 40 msat. Node first charges 50
+	// msat for forwarding the remaining amount.
+	if route.Hops[1].PubKeyBytes != ctx.keyFromAlias("a") {
+		t.Fatalf("expected route to pass through a, but got a "+
+			"route through %v",
+			ctx.aliasFromKey(route.Hops[1].PubKeyBytes))
+	}
+	if route.Hops[0].AmtToForward != paymentAmt+20 {
+		t.Fatalf("expected first hop to forward %v, got %v",
+			paymentAmt+20, route.Hops[0].AmtToForward)
+	}
+	if route.TotalAmount != paymentAmt+70 {
+		t.Fatalf("expected total amount %v, got %v",
+			paymentAmt+70, route.TotalAmount)
+	}
+}
+
 func getAliasFromPubKey(pubKey route.Vertex,
 	aliases map[string]route.Vertex) string {
 
@@ -998,7 +1082,7 @@ func TestPathFindingWithAdditionalEdges(t *testing.T) {
 	}
 
 	find := func(r *RestrictParams) (
-		[]*channeldb.ChannelEdgePolicy, error) {
+		[]*unifiedPolicyEdge, error) {
 
 		return dbFindPath(
 			graph.graph, additionalEdges, nil,
@@ -1321,7 +1405,9 @@ func TestNewRoute(t *testing.T) {
 
 		t.Run(testCase.name, func(t *testing.T) {
 			route, err := newRoute(
-				sourceVertex, testCase.hops, startingHeight,
+				sourceVertex, toUnifiedPolicyEdges(
+					testCase.hops,
+				), startingHeight,
 				finalHopParams{
 					amt:         testCase.paymentAmount,
 					totalAmt:    testCase.paymentAmount,
@@ -1351,6 +1437,81 @@ func TestNewRoute(t *testing.T) {
 	}
 }
 
+// TestNewRouteTrampoline tests that newRoute attaches trampoline instructions
+// to the final hop, but only if that hop signals support for trampoline
+// routing.
+func TestNewRouteTrampoline(t *testing.T) {
+	t.Parallel()
+
+	const startingHeight = 100
+
+	var sourceVertex route.Vertex
+
+	newHop := func(
+		features *lnwire.FeatureVector) *channeldb.ChannelEdgePolicy {
+
+		return &channeldb.ChannelEdgePolicy{
+			Node: &channeldb.LightningNode{
+				Features: features,
+			},
+			TimeLockDelta: 40,
+		}
+	}
+
+	payAddr := [32]byte{1}
+	onion := bytes.Repeat([]byte{2}, TrampolineOnionSize)
+	finalHop := finalHopParams{
+		amt:             51000,
+		totalAmt:        102000,
+		cltvDelta:       300,
+		paymentAddr:     &payAddr,
+		trampolineOnion: onion,
+	}
+
+	// A hop that doesn't signal trampoline support can't be used.
+	_, err := newRoute(
+		sourceVertex, toUnifiedPolicyEdges(
+			[]*channeldb.ChannelEdgePolicy{newHop(tlvFeatures)},
+		), startingHeight, finalHop,
+	)
+	if err == nil {
+		t.Fatal("expected newRoute to fail")
+	}
+
+	// With trampoline support, the trampoline onion is attached to the
+	// final hop next to the mpp record of the shard.
+	features := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadOptional,
+			lnwire.PaymentAddrOptional,
+			lnwire.MPPOptional,
+			lnwire.TrampolineRoutingOptional,
+		), lnwire.Features,
+	)
+	rt, err := newRoute(
+		sourceVertex, toUnifiedPolicyEdges(
+			[]*channeldb.ChannelEdgePolicy{newHop(features)},
+		), startingHeight, finalHop,
+	)
+	if err != nil {
+		t.Fatalf("unable to create route: %v", err)
+	}
+
+	finalRouteHop := rt.Hops[0]
+	if !bytes.Equal(finalRouteHop.TrampolineOnion, onion) {
+		t.Fatal("expected trampoline onion")
+	}
+	if finalRouteHop.MPP == nil ||
+		finalRouteHop.MPP.PaymentAddr() != payAddr ||
+		finalRouteHop.MPP.TotalMsat() != finalHop.totalAmt {
+
+		t.Fatalf("unexpected mpp record: %v", finalRouteHop.MPP)
+	}
+	if finalRouteHop.AmtToForward != finalHop.amt {
+		t.Fatalf("unexpected amount: %v", finalRouteHop.AmtToForward)
+	}
+}
+
 func TestNewRoutePathTooLong(t *testing.T) {
 	t.Parallel()
 
@@ -1399,6 +1560,15 @@ func TestNewRoutePathTooLong(t *testing.T) {
 	if err != errNoPathFound {
 		t.Fatalf("not route error expected, but got %v", err)
 	}
+
+	// The same applies to the trampoline onion, which takes up a large
+	// part of the payload of the final hop.
+	ctx.restrictParams.DestCustomRecords = nil
+	ctx.restrictParams.TrampolineOnion = make([]byte, TrampolineOnionSize)
+	_, err = ctx.findPath(node20, payAmt)
+	if err != errNoPathFound {
+		t.Fatalf("not route error expected, but got %v", err)
+	}
 }
 
 func TestPathNotAvailable(t *testing.T) {
@@ -1480,7 +1650,7 @@ func TestDestTLVGraphFallback(t *testing.T) {
 	}
 
 	find := func(r *RestrictParams,
-		target route.Vertex) ([]*channeldb.ChannelEdgePolicy, error) {
+		target route.Vertex) ([]*unifiedPolicyEdge, error) {
 
 		return dbFindPath(
 			ctx.graph, nil, nil,
@@ -2212,20 +2382,34 @@ func TestPathFindSpecExample(t *testing.T) {
 }
 
 func assertExpectedPath(t *testing.T, aliasMap map[string]route.Vertex,
-	path []*channeldb.ChannelEdgePolicy, nodeAliases ...string) {
+	path []*unifiedPolicyEdge, nodeAliases ...string) {
 
 	if len(path) != len(nodeAliases) {
 		t.Fatal("number of hops and number of aliases do not match")
 	}
 
 	for i, hop := range path {
-		if hop.Node.PubKeyBytes != aliasMap[nodeAliases[i]] {
+		if hop.policy.Node.PubKeyBytes != aliasMap[nodeAliases[i]] {
 			t.Fatalf("expected %v to be pos #%v in hop, instead "+
-				"%v was", nodeAliases[i], i, hop.Node.Alias)
+				"%v was", nodeAliases[i], i,
+				hop.policy.Node.Alias)
 		}
 	}
 }
 
+// toUnifiedPolicyEdges wraps the given policies into unified policy edges
+// that don't carry an inbound fee.
+func toUnifiedPolicyEdges(
+	policies []*channeldb.ChannelEdgePolicy) []*unifiedPolicyEdge {
+
+	edges := make([]*unifiedPolicyEdge, len(policies))
+	for i, policy := range policies {
+		edges[i] = &unifiedPolicyEdge{policy: policy}
+	}
+
+	return edges
+}
+
 // TestNewRouteFromEmptyHops tests that the NewRouteFromHops function returns an
 // error when the hop list is empty.
 func TestNewRouteFromEmptyHops(t *testing.T) {
@@ -2300,10 +2484,10 @@ func TestRestrictOutgoingChannel(t *testing.T) {
 
 	// Assert that the route starts with channel chanSourceB1, in line with
 	// the specified restriction.
-	if path[0].ChannelID != chanSourceB1 {
+	if path[0].policy.ChannelID != chanSourceB1 {
 		t.Fatalf("expected route to pass through channel %v, "+
 			"but channel %v was selected instead", chanSourceB1,
-			path[0].ChannelID)
+			path[0].policy.ChannelID)
 	}
 
 	// If a direct channel to target is allowed as well, that channel is
@@ -2315,7 +2499,7 @@ func TestRestrictOutgoingChannel(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to find path: %v", err)
 	}
-	if path[0].ChannelID != chanSourceTarget {
+	if path[0].policy.ChannelID != chanSourceTarget {
 		t.Fatalf("expected route to pass through channel %v",
 			chanSourceTarget)
 	}
@@ -2359,10 +2543,10 @@ func TestRestrictLastHop(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to find path: %v", err)
 	}
-	if path[0].ChannelID != 3 {
+	if path[0].policy.ChannelID != 3 {
 		t.Fatalf("expected route to pass through channel 3, "+
 			"but channel %v was selected instead",
-			path[0].ChannelID)
+			path[0].policy.ChannelID)
 	}
 }
 
@@ -2460,6 +2644,7 @@ func TestProbabilityRouting(t *testing.T) {
 		name           string
 		p10, p11, p20  float64
 		minProbability float64
+		timePref       float64
 		expectedChan   uint64
 		amount         btcutil.Amount
 	}{
@@ -2534,6 +2719,32 @@ func TestProbabilityRouting(t *testing.T) {
 			expectedChan:   0,
 			amount:         100,
 		},
+
+		// A time preference of 1 scales the attempt cost of 10 up to
+		// 10 * 1.9 / 0.1 = 190. The three hop route distance becomes
+		// 13 + 190 / 0.4 = 488, while the more reliable two hop route
+		// only has a distance of 25 + 190 / 0.7 = 296.
+		{
+			name: "two hop time pref reliable",
+			p10:  0.8, p11: 0.5, p20: 0.7,
+			minProbability: 0.1,
+			timePref:       1,
+			expectedChan:   20,
+			amount:         100,
+		},
+
+		// A time preference of -1 scales the attempt cost of 10 down to
+		// 10 * 0.1 / 1.9 = 0.53. The fees dominate and the cheaper three
+		// hop route with distance 13 + 0.53 / 0.4 = 14 is selected over
+		// the two hop route with distance 25 + 0.53 / 0.85 = 26.
+		{
+			name: "three hop time pref cheap",
+			p10:  0.5, p11: 0.8, p20: 0.85,
+			minProbability: 0.1,
+			timePref:       -1,
+			expectedChan:   10,
+			amount:         100,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2542,14 +2753,16 @@ func TestProbabilityRouting(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			testProbabilityRouting(
 				t, tc.amount, tc.p10, tc.p11, tc.p20,
-				tc.minProbability, tc.expectedChan,
+				tc.minProbability, tc.timePref,
+				tc.expectedChan,
 			)
 		})
 	}
 }
 
 func testProbabilityRouting(t *testing.T, paymentAmt btcutil.Amount,
-	p10, p11, p20, minProbability float64, expectedChan uint64) {
+	p10, p11, p20, minProbability, timePref float64,
+	expectedChan uint64) {
 
 	t.Parallel()
 
@@ -2602,6 +2815,7 @@ func testProbabilityRouting(t *testing.T, paymentAmt btcutil.Amount,
 			return 1
 		}
 	}
+	ctx.restrictParams.TimePref = timePref
 
 	ctx.pathFindingConfig = PathFindingConfig{
 		AttemptCost:    lnwire.NewMSatFromSatoshis(9),
@@ -2623,10 +2837,10 @@ func testProbabilityRouting(t *testing.T, paymentAmt btcutil.Amount,
 	}
 
 	// Assert that the route passes through the expected channel.
-	if path[1].ChannelID != expectedChan {
+	if path[1].policy.ChannelID != expectedChan {
 		t.Fatalf("expected route to pass through channel %v, "+
 			"but channel %v was selected instead", expectedChan,
-			path[1].ChannelID)
+			path[1].policy.ChannelID)
 	}
 }
 
@@ -2689,10 +2903,10 @@ func TestEqualCostRouteSelection(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if path[1].ChannelID != 2 {
+	if path[1].policy.ChannelID != 2 {
 		t.Fatalf("expected route to pass through channel %v, "+
 			"but channel %v was selected instead", 2,
-			path[1].ChannelID)
+			path[1].policy.ChannelID)
 	}
 }
 
@@ -2872,7 +3086,7 @@ func (c *pathFindingTestContext) cleanup() {
 }
 
 func (c *pathFindingTestContext) findPath(target route.Vertex,
-	amt lnwire.MilliSatoshi) ([]*channeldb.ChannelEdgePolicy,
+	amt lnwire.MilliSatoshi) ([]*unifiedPolicyEdge,
 	error) {
 
 	return dbFindPath(
@@ -2881,16 +3095,17 @@ func (c *pathFindingTestContext) findPath(target route.Vertex,
 	)
 }
 
-func (c *pathFindingTestContext) assertPath(path []*channeldb.ChannelEdgePolicy, expected []uint64) {
+func (c *pathFindingTestContext) assertPath(path []*unifiedPolicyEdge, expected []uint64) {
 	if len(path) != len(expected) {
 		c.t.Fatalf("expected path of length %v, but got %v",
 			len(expected), len(path))
 	}
 
 	for i, edge := range path {
-		if edge.ChannelID != expected[i] {
+		if edge.policy.ChannelID != expected[i] {
 			c.t.Fatalf("expected hop %v to be channel %v, "+
-				"but got %v", i, expected[i], edge.ChannelID)
+				"but got %v", i, expected[i],
+				edge.policy.ChannelID)
 		}
 	}
 }
@@ -2902,7 +3117,7 @@ func dbFindPath(graph *channeldb.ChannelGraph,
 	bandwidthHints map[uint64]lnwire.MilliSatoshi,
 	r *RestrictParams, cfg *PathFindingConfig,
 	source, target route.Vertex, amt lnwire.MilliSatoshi,
-	finalHtlcExpiry int32) ([]*channeldb.ChannelEdgePolicy, error) {
+	finalHtlcExpiry int32) ([]*unifiedPolicyEdge, error) {
 
 	routingTx, err := newDbRoutingTx(graph)
 	if err != nil {
@@ -27,6 +27,10 @@ type paymentLifecycle struct {
 	paySession    PaymentSession
 	timeoutChan   <-chan time.Time
 	currentHeight int32
+
+	// timePref is the time preference of the payment, which is recorded
+	// with every attempt.
+	timePref float64
 }
 
 // payemntState holds a number of key insights learned from a given MPPayment
@@ -85,6 +89,7 @@ func (p *paymentLifecycle) resumePayment() ([32]byte, *route.Route, error) {
 	shardHandler := &shardHandler{
 		router:      p.router,
 		paymentHash: p.paymentHash,
+		timePref:    p.timePref,
 		shardErrors: make(chan error),
 		quit:        make(chan struct{}),
 	}
@@ -285,6 +290,10 @@ type shardHandler struct {
 	paymentHash lntypes.Hash
 	router      *ChannelRouter
 
+	// timePref is the time preference of the payment, which is recorded
+	// with every attempt.
+	timePref float64
+
 	// shardErrors is a channel where errors collected by calling
 	// collectResultAsync will be delivered. These results are meant to be
 	// inspected by calling waitForShard or checkShards, and the channel
@@ -636,6 +645,7 @@ func (p *shardHandler) createNewPaymentAttempt(rt *route.Route) (
 		AttemptTime: p.router.cfg.Clock.Now(),
 		SessionKey:  sessionKey,
 		Route:       *rt,
+		TimePref:    p.timePref,
 	}
 
 	return firstHop, htlcAdd, attempt, nil
@@ -232,6 +232,8 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		DestCustomRecords:  p.payment.DestCustomRecords,
 		DestFeatures:       p.payment.DestFeatures,
 		PaymentAddr:        p.payment.PaymentAddr,
+		TrampolineOnion:    p.payment.TrampolineOnion,
+		TimePref:           p.payment.TimePref,
 	}
 
 	finalHtlcExpiry := int32(height) + int32(finalCltvDelta)
@@ -355,11 +357,12 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		route, err := newRoute(
 			sourceVertex, path, height,
 			finalHopParams{
-				amt:         maxAmt,
-				totalAmt:    p.payment.Amount,
-				cltvDelta:   finalCltvDelta,
-				records:     p.payment.DestCustomRecords,
-				paymentAddr: p.payment.PaymentAddr,
+				amt:             maxAmt,
+				totalAmt:        p.payment.Amount,
+				cltvDelta:       finalCltvDelta,
+				records:         p.payment.DestCustomRecords,
+				paymentAddr:     p.payment.PaymentAddr,
+				trampolineOnion: p.payment.TrampolineOnion,
 			},
 		)
 		if err != nil {
@@ -44,7 +44,7 @@ func TestRequestRoute(t *testing.T) {
 	session.pathFinder = func(
 		g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		source, target route.Vertex, amt lnwire.MilliSatoshi,
-		finalHtlcExpiry int32) ([]*channeldb.ChannelEdgePolicy, error) {
+		finalHtlcExpiry int32) ([]*unifiedPolicyEdge, error) {
 
 		// We expect find path to receive a cltv limit excluding the
 		// final cltv delta (including the block padding).
@@ -52,12 +52,14 @@ func TestRequestRoute(t *testing.T) {
 			t.Fatal("wrong cltv limit")
 		}
 
-		path := []*channeldb.ChannelEdgePolicy{
+		path := []*unifiedPolicyEdge{
 			{
-				Node: &channeldb.LightningNode{
-					Features: lnwire.NewFeatureVector(
-						nil, nil,
-					),
+				policy: &channeldb.ChannelEdgePolicy{
+					Node: &channeldb.LightningNode{
+						Features: lnwire.NewFeatureVector(
+							nil, nil,
+						),
+					},
 				},
 			},
 		}
@@ -219,6 +219,18 @@ func (i *interpretedResult) processPaymentOutcomeFinal(
 		// destination correctly. Continue the payment process.
 		i.successPairRange(route, 0, n-1)
 
+	// The trampoline node that we used as the final hop wasn't able to
+	// reach the recipient within the fee or cltv budget that we gave it.
+	// The htlc reached the trampoline correctly, so the route itself isn't
+	// to blame. Retrying with the same budget won't help, so we fail the
+	// payment.
+	case *lnwire.FailTrampolineFeeInsufficient,
+		*lnwire.FailTrampolineExpiryTooSoon:
+
+		i.successPairRange(route, 0, n-1)
+
+		i.finalFailureReason = &reasonError
+
 	default:
 		// All other errors are considered terminal if coming from the
 		// final hop. They indicate that something is wrong at the
@@ -38,6 +38,12 @@ var (
 	// ErrAMPMissingMPP is returned when the caller tries to attach an AMP
 	// record but no MPP record is presented for the final hop.
 	ErrAMPMissingMPP = errors.New("cannot send AMP without MPP record")
+
+	// ErrIntermediateTrampolineHop is returned when a hop tries to deliver
+	// trampoline instructions to an intermediate hop. Only the trampoline
+	// node itself, which is the final hop of the route, can receive them.
+	ErrIntermediateTrampolineHop = errors.New("cannot send trampoline " +
+		"instructions to intermediate")
 )
 
 // Vertex is a simple alias for the serialization of a compressed Bitcoin
@@ -119,6 +125,11 @@ type Hop struct {
 	// only be set for the final hop.
 	AMP *record.AMP
 
+	// TrampolineOnion is the serialized trampoline onion that carries the
+	// instructions for a trampoline node. This field should only be set
+	// for the final hop.
+	TrampolineOnion []byte
+
 	// CustomRecords if non-nil are a set of additional TLV records that
 	// should be included in the forwarding instructions for this node.
 	CustomRecords record.CustomSet
@@ -205,6 +216,19 @@ func (h *Hop) PackHopPayload(w io.Writer, nextChanID uint64) error {
 		}
 	}
 
+	// A trampoline onion may only be attached to the final hop.
+	if h.TrampolineOnion != nil {
+		if nextChanID == 0 {
+			records = append(
+				records, record.NewTrampolineOnionRecord(
+					&h.TrampolineOnion,
+				),
+			)
+		} else {
+			return ErrIntermediateTrampolineHop
+		}
+	}
+
 	// Append any custom types destined for this hop.
 	tlvRecords := tlv.MapToRecords(h.CustomRecords)
 	records = append(records, tlvRecords...)
@@ -259,6 +283,14 @@ func (h *Hop) PayloadSize(nextChanID uint64) uint64 {
 		addRecord(record.AMPOnionType, h.AMP.PayloadSize())
 	}
 
+	// Add trampoline onion if present.
+	if h.TrampolineOnion != nil {
+		addRecord(
+			record.TrampolineOnionType,
+			uint64(len(h.TrampolineOnion)),
+		)
+	}
+
 	// Add custom records.
 	for k, v := range h.CustomRecords {
 		addRecord(tlv.Type(k), uint64(len(v)))
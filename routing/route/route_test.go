@@ -182,6 +182,7 @@ func TestPayloadSize(t *testing.T) {
 			OutgoingTimeLock: 700000,
 			MPP:              record.NewMPP(500, [32]byte{}),
 			AMP:              record.NewAMP([32]byte{}, [32]byte{}, 8),
+			TrampolineOnion:  bytes.Repeat([]byte{1}, 466),
 			CustomRecords: map[uint64][]byte{
 				100000:  {1, 2, 3},
 				1000000: {4, 5},
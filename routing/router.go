@@ -240,6 +240,11 @@ type FeeSchema struct {
 	// the effective fee rate charged per mSAT will be: (amount *
 	// FeeRate/1,000,000).
 	FeeRate uint32
+
+	// InboundFee is an optional inbound fee that will be charged for
+	// payments that arrive over the channel. If nil, the inbound fee of
+	// the channel is left unchanged.
+	InboundFee *channeldb.InboundFee
 }
 
 // ChannelPolicy holds the parameters that determine the policy we enforce
@@ -613,10 +618,11 @@ func (r *ChannelRouter) Start() error {
 			// don't need it to timeout. It will stop immediately
 			// after the existing attempt has finished anyway. We
 			// also set a zero fee limit, as no more routes should
-			// be tried.
+			// be tried. The time preference is irrelevant for the
+			// same reason.
 			_, _, err := r.sendPayment(
 				payment.Info.Value, 0,
-				payment.Info.PaymentHash, 0, paySession,
+				payment.Info.PaymentHash, 0, 0, paySession,
 			)
 			if err != nil {
 				log.Errorf("Resuming payment with hash %v "+
@@ -1285,6 +1291,15 @@ func (r *ChannelRouter) processUpdate(msg interface{},
 				"chan_id=%v", msg.ChannelID)
 		}
 
+		// The alias short channel ID of a zero-conf channel doesn't
+		// point to an on-chain output, so it can't be validated. Those
+		// are only added for our own channels, as the gossiper rejects
+		// remote announcements of them.
+		channelID := lnwire.NewShortChanIDFromInt(msg.ChannelID)
+		if channelID.IsAlias() {
+			return r.addAliasEdge(msg, op...)
+		}
+
 		// If AssumeChannelValid is present, then we are unable to
 		// perform any of the expensive checks below, so we'll
 		// short-circuit our path straight to adding the edge to our
@@ -1305,7 +1320,6 @@ func (r *ChannelRouter) processUpdate(msg interface{},
 		// Before we can add the channel to the channel graph, we need
 		// to obtain the full funding outpoint that's encoded within
 		// the channel ID.
-		channelID := lnwire.NewShortChanIDFromInt(msg.ChannelID)
 		fundingTx, err := r.fetchFundingTx(&channelID)
 		if err != nil {
 			return errors.Errorf("unable to fetch funding tx for "+
@@ -1482,6 +1496,50 @@ func (r *ChannelRouter) processUpdate(msg interface{},
 	return nil
 }
 
+// addAliasEdge adds the edge of one of our zero-conf channels that is known
+// by its alias short channel ID to the graph. As the alias doesn't point to an
+// on-chain output, the edge can't be validated. We'll still watch its funding
+// output, so the edge is pruned once the channel is closed.
+func (r *ChannelRouter) addAliasEdge(msg *channeldb.ChannelEdgeInfo,
+	op ...batch.SchedulerOption) error {
+
+	witnessScript, err := input.GenMultiSigScript(
+		msg.BitcoinKey1Bytes[:], msg.BitcoinKey2Bytes[:],
+	)
+	if err != nil {
+		return err
+	}
+	fundingPkScript, err := input.WitnessScriptHash(witnessScript)
+	if err != nil {
+		return err
+	}
+
+	if err := r.cfg.Graph.AddChannelEdge(msg, op...); err != nil {
+		return errors.Errorf("unable to add edge: %v", err)
+	}
+
+	log.Tracef("New zero-conf channel discovered! Link connects %x and "+
+		"%x with ChannelPoint(%v): alias chan_id=%v",
+		msg.NodeKey1Bytes, msg.NodeKey2Bytes, msg.ChannelPoint,
+		msg.ChannelID)
+	r.stats.incNumEdgesDiscovered()
+
+	filterUpdate := []channeldb.EdgePoint{
+		{
+			FundingPkScript: fundingPkScript,
+			OutPoint:        msg.ChannelPoint,
+		},
+	}
+	err = r.cfg.ChainView.UpdateFilter(
+		filterUpdate, atomic.LoadUint32(&r.bestHeight),
+	)
+	if err != nil {
+		return errors.Errorf("unable to update chain view: %v", err)
+	}
+
+	return nil
+}
+
 // fetchFundingTx returns the funding transaction identified by the passed
 // short channel ID.
 //
@@ -1751,6 +1809,54 @@ type LightningPayment struct {
 	//
 	// NOTE: This field is _optional_.
 	MaxShardAmt *lnwire.MilliSatoshi
+
+	// Trampoline, if set, turns the payment into a trampoline payment. In
+	// that case Target is the first trampoline node, and Amount and
+	// FinalCLTVDelta include the fee and cltv budget that the trampoline
+	// nodes may use to reach the final destination described here. The
+	// trampoline onion that instructs them is built when the payment is
+	// started.
+	//
+	// NOTE: This field is _optional_.
+	Trampoline *TrampolineDestination
+
+	// TrampolineOnion is the trampoline onion that is attached to the
+	// final hop of every route, which makes Target forward the payment as
+	// a trampoline node. It is either built from Trampoline, or set
+	// directly by a trampoline node that forwards a payment to the next
+	// trampoline.
+	//
+	// NOTE: This field is _optional_.
+	TrampolineOnion []byte
+
+	// TimePref shifts path finding between the cheapest and the most
+	// reliable routes. It ranges from -1 (cheapest) to 1 (most reliable).
+	// The default of 0 uses the trade-off defined by the attempt cost
+	// configuration.
+	TimePref float64
+}
+
+// TrampolineDestination describes the final destination of a trampoline
+// payment, which the trampoline nodes find a route to on behalf of the
+// sender.
+type TrampolineDestination struct {
+	// Hops are the trampoline nodes that the payment is routed through
+	// after the first one, which is the target of the payment itself.
+	Hops []route.Vertex
+
+	// Target is the final destination of the payment.
+	Target route.Vertex
+
+	// Amount is the amount that the final destination should receive.
+	Amount lnwire.MilliSatoshi
+
+	// FinalCLTVDelta is the cltv delta that the final destination
+	// requires for the final hop.
+	FinalCLTVDelta uint16
+
+	// PaymentAddr is the optional payment address specified by the final
+	// destination.
+	PaymentAddr *[32]byte
 }
 
 // SendPayment attempts to send a payment as described within the passed
@@ -1775,7 +1881,8 @@ func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte,
 	// for the existing attempt.
 	return r.sendPayment(
 		payment.Amount, payment.FeeLimit, payment.PaymentHash,
-		payment.PayAttemptTimeout, paySession,
+		payment.PayAttemptTimeout, payment.TimePref,
+		paySession,
 	)
 }
 
@@ -1798,7 +1905,8 @@ func (r *ChannelRouter) SendPaymentAsync(payment *LightningPayment) error {
 
 		_, _, err := r.sendPayment(
 			payment.Amount, payment.FeeLimit, payment.PaymentHash,
-			payment.PayAttemptTimeout, paySession,
+			payment.PayAttemptTimeout, payment.TimePref,
+			paySession,
 		)
 		if err != nil {
 			log.Errorf("Payment with hash %x failed: %v",
@@ -1836,6 +1944,22 @@ func spewPayment(payment *LightningPayment) logClosure {
 func (r *ChannelRouter) preparePayment(payment *LightningPayment) (
 	PaymentSession, error) {
 
+	// A trampoline payment carries the same trampoline onion in all of
+	// its shards, so we build it once up front.
+	if payment.Trampoline != nil {
+		_, height, err := r.cfg.Chain.GetBestBlock()
+		if err != nil {
+			return nil, err
+		}
+
+		payment.TrampolineOnion, err = newTrampolineOnion(
+			payment, uint32(height),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Before starting the HTLC routing attempt, we'll create a fresh
 	// payment session which will report our errors back to mission
 	// control.
@@ -2000,7 +2124,7 @@ func (r *ChannelRouter) SendToRoute(hash lntypes.Hash, rt *route.Route) (
 // the ControlTower.
 func (r *ChannelRouter) sendPayment(
 	totalAmt, feeLimit lnwire.MilliSatoshi, paymentHash lntypes.Hash,
-	timeout time.Duration,
+	timeout time.Duration, timePref float64,
 	paySession PaymentSession) ([32]byte, *route.Route, error) {
 
 	// We'll also fetch the current block height so we can properly
@@ -2019,6 +2143,7 @@ func (r *ChannelRouter) sendPayment(
 		paymentHash:   paymentHash,
 		paySession:    paySession,
 		currentHeight: currentHeight,
+		timePref:      timePref,
 	}
 
 	// If a timeout is specified, create a timeout channel. If no timeout is
@@ -2576,7 +2701,12 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliSatoshi,
 	}()
 
 	// Traverse hops backwards to accumulate fees in the running amounts.
-	source := r.selfNode.PubKeyBytes
+	// We keep track of the outbound fee of the to node, to be able to
+	// limit its inbound discount.
+	var (
+		source      = r.selfNode.PubKeyBytes
+		outboundFee lnwire.MilliSatoshi
+	)
 	for i := len(hops) - 1; i >= 0; i-- {
 		toNode := hops[i]
 
@@ -2625,12 +2755,28 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliSatoshi,
 			}
 		}
 
+		// Add the inbound fee that the to node charges for this
+		// channel. The final node doesn't charge an inbound fee, and
+		// the total fee of a node can't become negative.
+		if i < len(hops)-1 {
+			inboundFee := policy.inboundFee.CalcFee(runningAmt)
+			if inboundFee < -int64(outboundFee) {
+				inboundFee = -int64(outboundFee)
+			}
+			runningAmt = lnwire.MilliSatoshi(
+				int64(runningAmt) + inboundFee,
+			)
+		}
+
 		// Add fee for this hop.
+		outboundFee = 0
 		if !localChan {
-			runningAmt += policy.ComputeFee(runningAmt)
+			outboundFee = policy.policy.ComputeFee(runningAmt)
+			runningAmt += outboundFee
 		}
 
-		log.Tracef("Select channel %v at position %v", policy.ChannelID, i)
+		log.Tracef("Select channel %v at position %v",
+			policy.policy.ChannelID, i)
 
 		edges[i] = unifiedPolicy
 	}
@@ -2639,7 +2785,7 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliSatoshi,
 	// total amount, we make a forward pass. Because the amount may have
 	// been increased in the backward pass, fees need to be recalculated and
 	// amount ranges re-checked.
-	var pathEdges []*channeldb.ChannelEdgePolicy
+	var pathEdges []*unifiedPolicyEdge
 	receiverAmt := runningAmt
 	for i, edge := range edges {
 		policy := edge.getPolicy(receiverAmt, bandwidthHints)
@@ -2652,8 +2798,8 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliSatoshi,
 
 		if i > 0 {
 			// Decrease the amount to send while going forward.
-			receiverAmt -= policy.ComputeFeeFromIncoming(
-				receiverAmt,
+			receiverAmt = pathEdges[i-1].amtToForwardFromIncoming(
+				policy, receiverAmt,
 			)
 		}
 
@@ -0,0 +1,604 @@
+package routing
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// TrampolineForwarderConfig contains the parameters that govern the behaviour
+// of the trampoline forwarder.
+type TrampolineForwarderConfig struct {
+	// SendPayment starts an onward payment without waiting for its
+	// result. Typically this is the SendPaymentAsync method of the
+	// ChannelRouter.
+	SendPayment func(*LightningPayment) error
+
+	// Control is the control tower that is used to follow the onward
+	// payments.
+	Control ControlTower
+
+	// NodeKeyECDH is our node key, which is used to decrypt our layer of
+	// the trampoline onions that we receive.
+	NodeKeyECDH keychain.SingleKeyECDH
+
+	// Clock is used to time out incomplete sets of htlcs.
+	Clock clock.Clock
+
+	// MppTimeout is the time after which we give up waiting for the
+	// remaining htlcs of a multi-path trampoline payment.
+	MppTimeout time.Duration
+
+	// BaseFee is the fixed fee in msat that we charge for forwarding a
+	// trampoline payment.
+	BaseFee lnwire.MilliSatoshi
+
+	// FeeRate is the proportional fee in parts per million that we charge
+	// for forwarding a trampoline payment.
+	FeeRate lnwire.MilliSatoshi
+
+	// CltvDelta is the number of blocks that we require between the
+	// expiry of the incoming htlc and the maximum time lock of the onward
+	// payment.
+	CltvDelta uint32
+
+	// MaxParts is the maximum number of partial payments that may be used
+	// to complete the onward payment.
+	MaxParts uint32
+
+	// PayAttemptTimeout is the time after which we give up trying to
+	// complete the onward payment.
+	PayAttemptTimeout time.Duration
+}
+
+// trampolineSet is the set of htlcs that pay for the same trampoline
+// forward. A sender may split the payment to us into multiple htlcs, in which
+// case we only forward once all of them have arrived.
+type trampolineSet struct {
+	// onion is the trampoline onion that all htlcs of the set carry.
+	onion []byte
+
+	// payload is our decrypted layer of the trampoline onion.
+	payload *record.Trampoline
+
+	// nextOnion is the onion that is passed on to the next trampoline
+	// node, or nil if we are the last one.
+	nextOnion []byte
+
+	// total is the total amount that the sender pays us.
+	total lnwire.MilliSatoshi
+
+	// htlcs maps the circuit keys of the htlcs in the set to their
+	// amounts.
+	htlcs map[channeldb.CircuitKey]lnwire.MilliSatoshi
+
+	// sum is the sum of the amounts of all htlcs in the set.
+	sum lnwire.MilliSatoshi
+
+	// expiry is the lowest expiry of all htlcs in the set.
+	expiry uint32
+
+	// started is closed once the set is complete and the onward payment
+	// is started.
+	started chan struct{}
+}
+
+// isStarted returns whether the onward payment of the set was started.
+func (s *trampolineSet) isStarted() bool {
+	select {
+	case <-s.started:
+		return true
+	default:
+		return false
+	}
+}
+
+// TrampolineForwarder forwards exit hop htlcs that carry a trampoline onion.
+// It does so by paying the next node named in our layer of the onion with the
+// same payment hash, using the difference between the incoming amount and the
+// amount to forward (minus our own fee) as fee budget. The next node is
+// either the next trampoline, which receives the remainder of the onion, or
+// the final destination. Once the onward payment settles, the preimage is
+// used to settle the incoming htlcs.
+//
+// NOTE: This implements the htlcswitch.TrampolineForwarder interface.
+type TrampolineForwarder struct {
+	cfg *TrampolineForwarderConfig
+
+	// subscribers maps the circuit key of every trampoline htlc that we
+	// are still forwarding to the channel on which the resolution needs to
+	// be delivered.
+	subscribers map[channeldb.CircuitKey]chan<- interface{}
+
+	// sets tracks the htlcs that pay for a trampoline forward with a
+	// particular payment hash.
+	sets map[lntypes.Hash]*trampolineSet
+
+	sync.Mutex
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// A compile time check to ensure TrampolineForwarder implements the
+// htlcswitch.TrampolineForwarder interface.
+var _ htlcswitch.TrampolineForwarder = (*TrampolineForwarder)(nil)
+
+// NewTrampolineForwarder creates a new trampoline forwarder.
+func NewTrampolineForwarder(cfg *TrampolineForwarderConfig) *TrampolineForwarder {
+	return &TrampolineForwarder{
+		cfg:         cfg,
+		subscribers: make(map[channeldb.CircuitKey]chan<- interface{}),
+		sets:        make(map[lntypes.Hash]*trampolineSet),
+		quit:        make(chan struct{}),
+	}
+}
+
+// Stop signals all goroutines that follow onward payments to exit and waits
+// for them to do so. The onward payments themselves are left to the router.
+func (t *TrampolineForwarder) Stop() {
+	close(t.quit)
+	t.wg.Wait()
+}
+
+// fee returns the fee that we charge for forwarding the given amount.
+func (t *TrampolineForwarder) fee(amt lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+	return t.cfg.BaseFee + amt*t.cfg.FeeRate/1000000
+}
+
+// TrampolineFeatures returns the features that we assume a trampoline node to
+// support when paying it. Senders that use trampoline routing typically don't
+// have the graph to look them up, and a trampoline node that is unable to
+// receive such a payment wouldn't be of any use anyway.
+func TrampolineFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadOptional,
+			lnwire.PaymentAddrOptional,
+			lnwire.MPPOptional,
+			lnwire.TrampolineRoutingOptional,
+		), lnwire.Features,
+	)
+}
+
+// ForwardTrampolineHtlc starts forwarding the htlc according to the
+// instructions in the trampoline onion. If the htlc can be resolved
+// immediately, the resolution is returned. Otherwise nil is returned and the
+// resolution is sent on the passed in hodlChan once the onward payment
+// completes.
+//
+// NOTE: Part of the htlcswitch.TrampolineForwarder interface.
+func (t *TrampolineForwarder) ForwardTrampolineHtlc(payHash lntypes.Hash,
+	amt lnwire.MilliSatoshi, expiry uint32, currentHeight uint32,
+	circuitKey channeldb.CircuitKey, onion []byte, mpp *record.MPP,
+	hodlChan chan<- interface{}) (invoices.HtlcResolution, error) {
+
+	log.Debugf("Trampoline htlc %v (hash=%v, amt=%v, expiry=%v, mpp=%v) "+
+		"received", circuitKey, payHash, amt, expiry, mpp)
+
+	t.Lock()
+	defer t.Unlock()
+
+	// The first htlc of a set decrypts the onion, which all further htlcs
+	// of the set must carry as well.
+	set, ok := t.sets[payHash]
+	if !ok {
+		payload, nextOnion, err := decodeTrampolineOnion(
+			t.cfg.NodeKeyECDH, onion, payHash[:],
+		)
+		if err != nil {
+			log.Debugf("Unable to decode trampoline onion of "+
+				"htlc %v: %v", circuitKey, err)
+
+			return htlcswitch.NewTrampolineFailResolution(
+				circuitKey, lnwire.NewInvalidOnionPayload(
+					uint64(record.TrampolineOnionType), 0,
+				),
+			), nil
+		}
+
+		log.Debugf("Trampoline payload of htlc %v: %v, last "+
+			"trampoline: %v", circuitKey, payload, nextOnion == nil)
+
+		set = &trampolineSet{
+			onion:     onion,
+			payload:   payload,
+			nextOnion: nextOnion,
+			total:     amt,
+			htlcs: make(
+				map[channeldb.CircuitKey]lnwire.MilliSatoshi,
+			),
+			expiry:  expiry,
+			started: make(chan struct{}),
+		}
+		if mpp != nil {
+			set.total = mpp.TotalMsat()
+
+			t.wg.Add(1)
+			go t.expireSet(payHash, set)
+		}
+		t.sets[payHash] = set
+	}
+
+	// If the htlc is already part of the set, for example because the
+	// link re-processed it, there is nothing left to do other than
+	// waiting for the result.
+	if _, ok := set.htlcs[circuitKey]; ok {
+		t.subscribers[circuitKey] = hodlChan
+		return nil, nil
+	}
+
+	if !bytes.Equal(set.onion, onion) {
+		log.Debugf("Trampoline htlc %v carries a different onion than "+
+			"the set of %v", circuitKey, payHash)
+
+		return htlcswitch.NewTrampolineFailResolution(
+			circuitKey, lnwire.NewInvalidOnionPayload(
+				uint64(record.TrampolineOnionType), 0,
+			),
+		), nil
+	}
+
+	total := amt
+	if mpp != nil {
+		total = mpp.TotalMsat()
+	}
+	if total != set.total {
+		log.Debugf("Trampoline htlc %v has total %v, set of %v "+
+			"expects %v", circuitKey, total, payHash, set.total)
+
+		return htlcswitch.NewTrampolineFailResolution(
+			circuitKey, lnwire.NewFailIncorrectDetails(
+				amt, currentHeight,
+			),
+		), nil
+	}
+
+	set.htlcs[circuitKey] = amt
+	set.sum += amt
+	if expiry < set.expiry {
+		set.expiry = expiry
+	}
+	t.subscribers[circuitKey] = hodlChan
+
+	// Wait for the remaining htlcs of the set. Htlcs that arrive after the
+	// onward payment was started are resolved together with the others.
+	if set.sum < set.total || set.isStarted() {
+		return nil, nil
+	}
+	close(set.started)
+
+	return t.startForward(payHash, set, currentHeight, circuitKey), nil
+}
+
+// startForward checks the fee and cltv budget of a complete set and starts
+// the onward payment. If the set can't be forwarded, all its htlcs are failed
+// and the resolution of the htlc with the given circuit key is returned.
+//
+// NOTE: The caller must hold the lock.
+func (t *TrampolineForwarder) startForward(payHash lntypes.Hash,
+	set *trampolineSet, currentHeight uint32,
+	circuitKey channeldb.CircuitKey) invoices.HtlcResolution {
+
+	// The fee budget of the onward payment is what remains of the
+	// amount of the full set after deducting the amount to forward and
+	// our own fee.
+	amtToForward := set.payload.AmtToForward()
+	ownFee := t.fee(amtToForward)
+	if set.sum < amtToForward+ownFee {
+		log.Debugf("Trampoline set %v pays insufficient fee: amt=%v, "+
+			"amt_to_forward=%v, fee=%v", payHash, set.sum,
+			amtToForward, ownFee)
+
+		return t.failSet(
+			payHash, circuitKey,
+			&lnwire.FailTrampolineFeeInsufficient{},
+		)
+	}
+	feeLimit := set.sum - amtToForward - ownFee
+
+	// The onward payment must reach the next node with the requested
+	// expiry, while leaving us our cltv delta on the incoming htlc that
+	// expires first.
+	outgoingCltv := set.payload.OutgoingCltv()
+	if outgoingCltv <= currentHeight ||
+		outgoingCltv-currentHeight > math.MaxUint16 ||
+		set.expiry < outgoingCltv+t.cfg.CltvDelta {
+
+		log.Debugf("Trampoline set %v has insufficient cltv budget: "+
+			"expiry=%v, outgoing_cltv=%v, height=%v", payHash,
+			set.expiry, outgoingCltv, currentHeight)
+
+		return t.failSet(
+			payHash, circuitKey,
+			&lnwire.FailTrampolineExpiryTooSoon{},
+		)
+	}
+	finalCltvDelta := uint16(outgoingCltv - currentHeight)
+	cltvLimit := set.expiry - t.cfg.CltvDelta - currentHeight
+
+	payment := &LightningPayment{
+		Target:            route.Vertex(set.payload.NextNode()),
+		Amount:            amtToForward,
+		FeeLimit:          feeLimit,
+		CltvLimit:         cltvLimit,
+		PaymentHash:       payHash,
+		FinalCLTVDelta:    finalCltvDelta,
+		PayAttemptTimeout: t.cfg.PayAttemptTimeout,
+		MaxParts:          t.cfg.MaxParts,
+	}
+
+	switch mpp := set.payload.MPP(); {
+	// If we aren't the last trampoline, we pass the remainder of the onion
+	// on to the next one. Like the sender, we assume that it supports
+	// trampoline payments, and use a payment address of our own so that
+	// we can split the payment.
+	case set.nextOnion != nil:
+		var paymentAddr [32]byte
+		if _, err := rand.Read(paymentAddr[:]); err != nil {
+			log.Errorf("Unable to generate payment addr: %v", err)
+
+			return t.failSet(
+				payHash, circuitKey,
+				&lnwire.FailTemporaryNodeFailure{},
+			)
+		}
+
+		payment.PaymentAddr = &paymentAddr
+		payment.DestFeatures = TrampolineFeatures()
+		payment.TrampolineOnion = set.nextOnion
+
+	case mpp != nil:
+		paymentAddr := mpp.PaymentAddr()
+		payment.PaymentAddr = &paymentAddr
+
+	// Without a payment address, the final destination can't receive a
+	// multi-path payment.
+	default:
+		payment.MaxParts = 1
+	}
+
+	t.wg.Add(1)
+	go t.forward(payment, currentHeight)
+
+	return nil
+}
+
+// failSet fails all htlcs of the set with the given payment hash. The
+// resolution of the htlc with the given circuit key is returned rather than
+// delivered to its subscriber, because that htlc is still being processed.
+//
+// NOTE: The caller must hold the lock.
+func (t *TrampolineForwarder) failSet(payHash lntypes.Hash,
+	circuitKey channeldb.CircuitKey,
+	failure lnwire.FailureMessage) invoices.HtlcResolution {
+
+	delete(t.subscribers, circuitKey)
+	t.resolveLocked(payHash, func(key channeldb.CircuitKey) interface{} {
+		return htlcswitch.NewTrampolineFailResolution(key, failure)
+	})
+
+	return htlcswitch.NewTrampolineFailResolution(circuitKey, failure)
+}
+
+// expireSet fails the htlcs of the given set if it isn't complete within the
+// mpp timeout.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (t *TrampolineForwarder) expireSet(payHash lntypes.Hash,
+	set *trampolineSet) {
+
+	defer t.wg.Done()
+
+	select {
+	case <-t.cfg.Clock.TickAfter(t.cfg.MppTimeout):
+	case <-set.started:
+		return
+	case <-t.quit:
+		return
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	// The set may have completed or been resolved while we were waiting
+	// for the lock.
+	if set.isStarted() || t.sets[payHash] != set {
+		return
+	}
+
+	log.Debugf("Trampoline set %v timed out with %v of %v", payHash,
+		set.sum, set.total)
+
+	t.resolveLocked(payHash, func(key channeldb.CircuitKey) interface{} {
+		return htlcswitch.NewTrampolineFailResolution(
+			key, &lnwire.FailMPPTimeout{},
+		)
+	})
+}
+
+// forward dispatches the onward payment and waits for its outcome, after
+// which all htlcs that wait for the payment are resolved.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (t *TrampolineForwarder) forward(payment *LightningPayment,
+	acceptHeight uint32) {
+
+	defer t.wg.Done()
+
+	hash := lntypes.Hash(payment.PaymentHash)
+
+	// Start the payment. If a payment for this hash is already known to
+	// the control tower, for example because we restarted while it was
+	// in flight, we just follow it.
+	err := t.cfg.SendPayment(payment)
+	switch err {
+	case nil, channeldb.ErrPaymentInFlight, channeldb.ErrAlreadyPaid:
+
+	default:
+		log.Errorf("Unable to forward trampoline payment %v: %v",
+			hash, err)
+
+		t.resolve(hash, func(key channeldb.CircuitKey) interface{} {
+			return htlcswitch.NewTrampolineFailResolution(
+				key, &lnwire.FailTemporaryNodeFailure{},
+			)
+		})
+		return
+	}
+
+	sub, err := t.cfg.Control.SubscribePayment(hash)
+	if err != nil {
+		log.Errorf("Unable to subscribe to trampoline payment %v: %v",
+			hash, err)
+
+		t.resolve(hash, func(key channeldb.CircuitKey) interface{} {
+			return htlcswitch.NewTrampolineFailResolution(
+				key, &lnwire.FailTemporaryNodeFailure{},
+			)
+		})
+		return
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case item, ok := <-sub.Updates:
+			// The subscription ends once the payment reaches a
+			// terminal state, which we always detect below.
+			if !ok {
+				return
+			}
+
+			p := item.(*channeldb.MPPayment)
+			settle, reason := p.TerminalInfo()
+
+			// As soon as one of the htlcs of the onward payment
+			// settles, we know the preimage and can settle the
+			// incoming htlc.
+			if settle != nil {
+				log.Infof("Trampoline payment %v succeeded",
+					hash)
+
+				t.resolve(hash, func(
+					key channeldb.CircuitKey) interface{} {
+
+					return invoices.NewSettleResolution(
+						settle.Preimage, key,
+						int32(acceptHeight),
+						invoices.ResultSettled,
+					)
+				})
+				return
+			}
+
+			if p.Status != channeldb.StatusFailed ||
+				reason == nil {
+
+				continue
+			}
+
+			log.Infof("Trampoline payment %v failed: %v", hash,
+				*reason)
+
+			failure := trampolineFailure(
+				*reason, payment.Amount, acceptHeight,
+			)
+			t.resolve(hash, func(key channeldb.CircuitKey) interface{} {
+				return htlcswitch.NewTrampolineFailResolution(
+					key, failure,
+				)
+			})
+			return
+
+		case <-t.quit:
+			return
+		}
+	}
+}
+
+// resolve delivers a resolution to all htlcs that wait for the onward payment
+// with the given hash.
+func (t *TrampolineForwarder) resolve(hash lntypes.Hash,
+	resolution func(channeldb.CircuitKey) interface{}) {
+
+	t.Lock()
+	defer t.Unlock()
+
+	t.resolveLocked(hash, resolution)
+}
+
+// resolveLocked delivers a resolution to all htlcs of the set with the given
+// hash and removes the set.
+//
+// NOTE: The caller must hold the lock.
+func (t *TrampolineForwarder) resolveLocked(hash lntypes.Hash,
+	resolution func(channeldb.CircuitKey) interface{}) {
+
+	set, ok := t.sets[hash]
+	if !ok {
+		return
+	}
+	delete(t.sets, hash)
+
+	for key := range set.htlcs {
+		subscriber, ok := t.subscribers[key]
+		if !ok {
+			continue
+		}
+		delete(t.subscribers, key)
+
+		select {
+		case subscriber <- resolution(key):
+		case <-t.quit:
+			return
+		}
+	}
+}
+
+// UnsubscribeAll removes the given subscriber, so that it no longer receives
+// resolutions.
+//
+// NOTE: Part of the htlcswitch.TrampolineForwarder interface.
+func (t *TrampolineForwarder) UnsubscribeAll(subscriber chan<- interface{}) {
+	t.Lock()
+	defer t.Unlock()
+
+	for key, s := range t.subscribers {
+		if s == subscriber {
+			delete(t.subscribers, key)
+		}
+	}
+}
+
+// trampolineFailure returns the failure that is reported back to the sender
+// of a trampoline payment for which the onward payment failed.
+func trampolineFailure(reason channeldb.FailureReason,
+	amt lnwire.MilliSatoshi, height uint32) lnwire.FailureMessage {
+
+	switch reason {
+	// We couldn't find a route within the budget left by the sender. A
+	// larger fee budget may help.
+	case channeldb.FailureReasonNoRoute:
+		return &lnwire.FailTrampolineFeeInsufficient{}
+
+	// The destination rejected the payment, so retrying won't help.
+	case channeldb.FailureReasonPaymentDetails:
+		return lnwire.NewFailIncorrectDetails(amt, height)
+
+	default:
+		return &lnwire.FailTemporaryNodeFailure{}
+	}
+}
@@ -0,0 +1,405 @@
+package routing
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
+	"golang.org/x/crypto/chacha20"
+)
+
+const (
+	// trampolineOnionVersion is the version of the trampoline onions that
+	// we create and accept.
+	trampolineOnionVersion = 0
+
+	// trampolineRoutingInfoSize is the fixed size of the routing info of
+	// a trampoline onion. The trampoline onion is embedded in the final
+	// hop payload of the outer onion, so it is much smaller than the
+	// outer onion. It leaves room for the payloads of four trampoline
+	// hops.
+	trampolineRoutingInfoSize = 400
+
+	// trampolineHMACSize is the size of the hmac that authenticates each
+	// layer of a trampoline onion.
+	trampolineHMACSize = sha256.Size
+
+	// TrampolineOnionSize is the size of a serialized trampoline onion,
+	// which consists of the version, the ephemeral key, the routing info
+	// and the hmac.
+	TrampolineOnionSize = 1 + btcec.PubKeyBytesLenCompressed +
+		trampolineRoutingInfoSize + trampolineHMACSize
+)
+
+var (
+	// ErrInvalidTrampolineOnion is returned when a trampoline onion can't
+	// be parsed or its hmac doesn't match.
+	ErrInvalidTrampolineOnion = errors.New("invalid trampoline onion")
+)
+
+// trampolineHop is a node that a trampoline onion is constructed for,
+// together with the payload that the node should find in its layer.
+type trampolineHop struct {
+	pubKey  *btcec.PublicKey
+	payload *record.Trampoline
+}
+
+// newTrampolineOnion builds the trampoline onion for the passed trampoline
+// payment, given the current block height. The onion instructs the first
+// trampoline node, which is the target of the payment, to forward the payment
+// via the remaining trampoline nodes to the final destination. The fee and
+// cltv budget that the payment adds on top of the destination's amount and
+// final cltv delta is split evenly between the trampoline nodes.
+func newTrampolineOnion(payment *LightningPayment,
+	height uint32) ([]byte, error) {
+
+	dest := payment.Trampoline
+	if payment.Amount < dest.Amount ||
+		payment.FinalCLTVDelta < dest.FinalCLTVDelta {
+
+		return nil, errors.New("trampoline payment lacks budget")
+	}
+
+	nodes := append([]route.Vertex{payment.Target}, dest.Hops...)
+	numNodes := len(nodes)
+
+	feeBudget := payment.Amount - dest.Amount
+	cltvBudget := uint32(payment.FinalCLTVDelta - dest.FinalCLTVDelta)
+
+	// The final destination receives the htlc with the cltv that it
+	// requires, padded like we would for a direct payment.
+	finalCltv := height + uint32(dest.FinalCLTVDelta) +
+		uint32(BlockPadding)
+
+	hops := make([]trampolineHop, numNodes)
+	for i, node := range nodes {
+		pubKey, err := btcec.ParsePubKey(node[:], btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+
+		// The last trampoline node pays the final destination. All
+		// others pay the next trampoline node, leaving it its share
+		// of the budget plus that of the nodes after it.
+		var payload *record.Trampoline
+		remaining := numNodes - 1 - i
+		if remaining == 0 {
+			var mpp *record.MPP
+			if dest.PaymentAddr != nil {
+				mpp = record.NewMPP(
+					dest.Amount, *dest.PaymentAddr,
+				)
+			}
+
+			payload = record.NewTrampoline(
+				dest.Target, dest.Amount, finalCltv, mpp,
+			)
+		} else {
+			fee := feeBudget * lnwire.MilliSatoshi(remaining) /
+				lnwire.MilliSatoshi(numNodes)
+			cltv := cltvBudget * uint32(remaining) /
+				uint32(numNodes)
+
+			payload = record.NewTrampoline(
+				nodes[i+1], dest.Amount+fee, finalCltv+cltv,
+				nil,
+			)
+		}
+
+		hops[i] = trampolineHop{
+			pubKey:  pubKey,
+			payload: payload,
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeTrampolineOnion(sessionKey, hops, payment.PaymentHash[:])
+}
+
+// encodeTrampolineOnion constructs a trampoline onion for the given hops. The
+// construction follows that of the outer onion specified in BOLT #4, with a
+// smaller routing info. The associated data is committed to by the hmac of
+// every layer.
+func encodeTrampolineOnion(sessionKey *btcec.PrivateKey, hops []trampolineHop,
+	assocData []byte) ([]byte, error) {
+
+	if len(hops) == 0 {
+		return nil, errors.New("trampoline onion without hops")
+	}
+
+	// Serialize the payloads of all hops, each prefixed by its length.
+	// Every payload is followed by the hmac of the next layer in the
+	// routing info.
+	var (
+		payloads  = make([][]byte, len(hops))
+		totalSize int
+		scratch   [8]byte
+	)
+	for i, hop := range hops {
+		var payload bytes.Buffer
+		if err := hop.payload.Encode(&payload); err != nil {
+			return nil, err
+		}
+
+		var b bytes.Buffer
+		err := tlv.WriteVarInt(&b, uint64(payload.Len()), &scratch)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(payload.Bytes())
+
+		payloads[i] = b.Bytes()
+		totalSize += len(payloads[i]) + trampolineHMACSize
+	}
+
+	if totalSize > trampolineRoutingInfoSize {
+		return nil, fmt.Errorf("trampoline payloads of %v bytes "+
+			"exceed maximum of %v bytes", totalSize,
+			trampolineRoutingInfoSize)
+	}
+
+	// Derive the secret that we share with each hop. The ephemeral key
+	// is blinded at every hop, so that hops can't correlate the onion.
+	secrets := make([][32]byte, len(hops))
+	ephemeralPriv := new(big.Int).Set(sessionKey.D)
+	for i, hop := range hops {
+		priv, pub := btcec.PrivKeyFromBytes(
+			btcec.S256(), ephemeralPriv.Bytes(),
+		)
+
+		ecdh := &keychain.PrivKeyECDH{PrivKey: priv}
+		secret, err := ecdh.ECDH(hop.pubKey)
+		if err != nil {
+			return nil, err
+		}
+		secrets[i] = secret
+
+		ephemeralPriv.Mul(ephemeralPriv, blindingFactor(pub, secret))
+		ephemeralPriv.Mod(ephemeralPriv, btcec.S256().N)
+	}
+
+	// Every hop shifts its own payload out of the routing info and zeros
+	// in at the end, which it then decrypts. The filler anticipates the
+	// result of this, so that the hmac of the final layers commits to the
+	// full routing info.
+	var fillerSize int
+	for _, payload := range payloads[:len(payloads)-1] {
+		fillerSize += len(payload) + trampolineHMACSize
+	}
+
+	filler := make([]byte, fillerSize)
+	fillerStart := trampolineRoutingInfoSize
+	for i := 0; i < len(hops)-1; i++ {
+		hopSize := len(payloads[i]) + trampolineHMACSize
+		stream := trampolineStream(
+			trampolineKey("rho", secrets[i][:]),
+			2*trampolineRoutingInfoSize,
+		)
+
+		xorBytes(
+			filler,
+			stream[fillerStart:trampolineRoutingInfoSize+hopSize],
+		)
+		fillerStart -= hopSize
+	}
+
+	// Wrap the layers from the last hop to the first. The initial routing
+	// info is pseudo random, so that the end of the routing info doesn't
+	// reveal how many hops there are.
+	routingInfo := trampolineStream(
+		trampolineKey("pad", sessionKey.Serialize()),
+		trampolineRoutingInfoSize,
+	)
+	nextHMAC := make([]byte, trampolineHMACSize)
+	for i := len(hops) - 1; i >= 0; i-- {
+		hopSize := len(payloads[i]) + trampolineHMACSize
+
+		copy(routingInfo[hopSize:], routingInfo)
+		copy(routingInfo, payloads[i])
+		copy(routingInfo[len(payloads[i]):], nextHMAC)
+
+		xorBytes(routingInfo, trampolineStream(
+			trampolineKey("rho", secrets[i][:]),
+			trampolineRoutingInfoSize,
+		))
+
+		if i == len(hops)-1 {
+			copy(routingInfo[len(routingInfo)-len(filler):], filler)
+		}
+
+		nextHMAC = trampolineMAC(
+			trampolineKey("mu", secrets[i][:]), routingInfo,
+			assocData,
+		)
+	}
+
+	onion := make([]byte, 0, TrampolineOnionSize)
+	onion = append(onion, trampolineOnionVersion)
+	onion = append(onion, sessionKey.PubKey().SerializeCompressed()...)
+	onion = append(onion, routingInfo...)
+	onion = append(onion, nextHMAC...)
+
+	return onion, nil
+}
+
+// decodeTrampolineOnion decrypts our layer of a trampoline onion. It returns
+// the payload that we find in it and the onion that should be passed on to the
+// next trampoline node. If we are the last trampoline node, the returned onion
+// is nil.
+func decodeTrampolineOnion(nodeKey keychain.SingleKeyECDH, onion,
+	assocData []byte) (*record.Trampoline, []byte, error) {
+
+	if len(onion) != TrampolineOnionSize ||
+		onion[0] != trampolineOnionVersion {
+
+		return nil, nil, ErrInvalidTrampolineOnion
+	}
+
+	var (
+		keyEnd      = 1 + btcec.PubKeyBytesLenCompressed
+		routingEnd  = keyEnd + trampolineRoutingInfoSize
+		routingInfo = onion[keyEnd:routingEnd]
+	)
+
+	ephemeralKey, err := btcec.ParsePubKey(onion[1:keyEnd], btcec.S256())
+	if err != nil {
+		return nil, nil, ErrInvalidTrampolineOnion
+	}
+
+	secret, err := nodeKey.ECDH(ephemeralKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	expectedHMAC := trampolineMAC(
+		trampolineKey("mu", secret[:]), routingInfo, assocData,
+	)
+	if !hmac.Equal(expectedHMAC, onion[routingEnd:]) {
+		return nil, nil, ErrInvalidTrampolineOnion
+	}
+
+	// Decrypt the routing info, extended by zeros that become the end of
+	// the routing info for the next hop once our payload is shifted out.
+	extended := make([]byte, 2*trampolineRoutingInfoSize)
+	copy(extended, routingInfo)
+	xorBytes(extended, trampolineStream(
+		trampolineKey("rho", secret[:]), len(extended),
+	))
+
+	var (
+		r       = bytes.NewReader(extended)
+		scratch [8]byte
+	)
+	payloadLen, err := tlv.ReadVarInt(r, &scratch)
+	if err != nil || payloadLen > trampolineRoutingInfoSize {
+		return nil, nil, ErrInvalidTrampolineOnion
+	}
+
+	payloadBytes := make([]byte, payloadLen)
+	if _, err := io.ReadFull(r, payloadBytes); err != nil {
+		return nil, nil, ErrInvalidTrampolineOnion
+	}
+
+	payload := &record.Trampoline{}
+	err = payload.Decode(bytes.NewReader(payloadBytes))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidTrampolineOnion,
+			err)
+	}
+
+	nextHMAC := make([]byte, trampolineHMACSize)
+	if _, err := io.ReadFull(r, nextHMAC); err != nil {
+		return nil, nil, ErrInvalidTrampolineOnion
+	}
+
+	// An all zero hmac signals that we are the last trampoline node.
+	if bytes.Equal(nextHMAC, make([]byte, trampolineHMACSize)) {
+		return payload, nil, nil
+	}
+
+	// Otherwise, blind the ephemeral key for the next trampoline node.
+	factor := blindingFactor(ephemeralKey, secret)
+	x, y := btcec.S256().ScalarMult(
+		ephemeralKey.X, ephemeralKey.Y, factor.Bytes(),
+	)
+	nextKey := &btcec.PublicKey{Curve: btcec.S256(), X: x, Y: y}
+
+	hopSize := len(extended) - r.Len()
+
+	nextOnion := make([]byte, 0, TrampolineOnionSize)
+	nextOnion = append(nextOnion, trampolineOnionVersion)
+	nextOnion = append(nextOnion, nextKey.SerializeCompressed()...)
+	nextOnion = append(
+		nextOnion,
+		extended[hopSize:hopSize+trampolineRoutingInfoSize]...,
+	)
+	nextOnion = append(nextOnion, nextHMAC...)
+
+	return payload, nextOnion, nil
+}
+
+// trampolineKey derives the key of the given type from a shared secret.
+func trampolineKey(keyType string, secret []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(keyType))
+	mac.Write(secret)
+
+	return mac.Sum(nil)
+}
+
+// trampolineStream returns the first numBytes of the chacha20 key stream of
+// the given key.
+func trampolineStream(key []byte, numBytes int) []byte {
+	var nonce [chacha20.NonceSize]byte
+
+	// The keys that we derive always have the required size, so creating
+	// the cipher can't fail.
+	cipher, _ := chacha20.NewUnauthenticatedCipher(key, nonce[:])
+
+	stream := make([]byte, numBytes)
+	cipher.XORKeyStream(stream, stream)
+
+	return stream
+}
+
+// trampolineMAC computes the hmac of a trampoline onion layer.
+func trampolineMAC(key, routingInfo, assocData []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(routingInfo)
+	mac.Write(assocData)
+
+	return mac.Sum(nil)
+}
+
+// blindingFactor returns the factor that the ephemeral key of a trampoline
+// onion is blinded with after being used with the given shared secret.
+func blindingFactor(ephemeralKey *btcec.PublicKey,
+	secret [32]byte) *big.Int {
+
+	h := sha256.New()
+	h.Write(ephemeralKey.SerializeCompressed())
+	h.Write(secret[:])
+
+	return new(big.Int).SetBytes(h.Sum(nil))
+}
+
+// xorBytes xors the bytes of src into dst, up to the length of the shorter of
+// the two.
+func xorBytes(dst, src []byte) {
+	for i := 0; i < len(dst) && i < len(src); i++ {
+		dst[i] ^= src[i]
+	}
+}
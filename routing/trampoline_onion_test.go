@@ -0,0 +1,178 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTrampolineOnion asserts that every trampoline node is able to decrypt
+// its layer of a trampoline onion that routes via multiple trampolines, and
+// that the budget is split between them.
+func TestTrampolineOnion(t *testing.T) {
+	t.Parallel()
+
+	const numTrampolines = 3
+
+	var (
+		keys  []*btcec.PrivateKey
+		nodes []route.Vertex
+	)
+	for i := 0; i < numTrampolines; i++ {
+		key, err := btcec.NewPrivateKey(btcec.S256())
+		require.NoError(t, err)
+
+		keys = append(keys, key)
+		nodes = append(nodes, route.NewVertex(key.PubKey()))
+	}
+
+	destKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	const height = 1000
+	paymentAddr := [32]byte{1, 2, 3}
+	payment := &LightningPayment{
+		Target:         nodes[0],
+		Amount:         103000,
+		FinalCLTVDelta: 40 + 3*144,
+		PaymentHash:    lntypes.Hash{5},
+		Trampoline: &TrampolineDestination{
+			Hops:           nodes[1:],
+			Target:         route.NewVertex(destKey.PubKey()),
+			Amount:         100000,
+			FinalCLTVDelta: 40,
+			PaymentAddr:    &paymentAddr,
+		},
+	}
+
+	onion, err := newTrampolineOnion(payment, height)
+	require.NoError(t, err)
+	require.Len(t, onion, TrampolineOnionSize)
+
+	// The onion commits to the payment hash, so it can't be used for a
+	// different payment.
+	nodeKey := &keychain.PrivKeyECDH{PrivKey: keys[0]}
+	_, _, err = decodeTrampolineOnion(nodeKey, onion, []byte{6})
+	require.ErrorIs(t, err, ErrInvalidTrampolineOnion)
+
+	// A node that the onion isn't meant for can't decrypt it.
+	nodeKey = &keychain.PrivKeyECDH{PrivKey: keys[1]}
+	_, _, err = decodeTrampolineOnion(
+		nodeKey, onion, payment.PaymentHash[:],
+	)
+	require.ErrorIs(t, err, ErrInvalidTrampolineOnion)
+
+	finalCltv := uint32(height + 40 + int(BlockPadding))
+	expected := []struct {
+		nextNode route.Vertex
+		amt      lnwire.MilliSatoshi
+		cltv     uint32
+	}{
+		{
+			nextNode: nodes[1],
+			amt:      102000,
+			cltv:     finalCltv + 2*144,
+		},
+		{
+			nextNode: nodes[2],
+			amt:      101000,
+			cltv:     finalCltv + 144,
+		},
+		{
+			nextNode: payment.Trampoline.Target,
+			amt:      100000,
+			cltv:     finalCltv,
+		},
+	}
+
+	for i, exp := range expected {
+		nodeKey := &keychain.PrivKeyECDH{PrivKey: keys[i]}
+		payload, nextOnion, err := decodeTrampolineOnion(
+			nodeKey, onion, payment.PaymentHash[:],
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, [33]byte(exp.nextNode), payload.NextNode())
+		require.Equal(t, exp.amt, payload.AmtToForward())
+		require.Equal(t, exp.cltv, payload.OutgoingCltv())
+
+		// Only the last trampoline learns the payment address of the
+		// final destination, and has no onion left to pass on.
+		if i < numTrampolines-1 {
+			require.Nil(t, payload.MPP())
+			require.Len(t, nextOnion, TrampolineOnionSize)
+
+			onion = nextOnion
+			continue
+		}
+
+		require.NotNil(t, payload.MPP())
+		require.Equal(t, paymentAddr, payload.MPP().PaymentAddr())
+		require.Equal(t, exp.amt, payload.MPP().TotalMsat())
+		require.Nil(t, nextOnion)
+	}
+}
+
+// TestTrampolineOnionTampered asserts that a trampoline onion that was
+// modified in transit is rejected.
+func TestTrampolineOnionTampered(t *testing.T) {
+	t.Parallel()
+
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	payment := &LightningPayment{
+		Target:         route.NewVertex(key.PubKey()),
+		Amount:         2000,
+		FinalCLTVDelta: 200,
+		PaymentHash:    lntypes.Hash{1},
+		Trampoline: &TrampolineDestination{
+			Target:         route.NewVertex(key.PubKey()),
+			Amount:         1000,
+			FinalCLTVDelta: 40,
+		},
+	}
+
+	onion, err := newTrampolineOnion(payment, 100)
+	require.NoError(t, err)
+
+	onion[100] ^= 1
+
+	nodeKey := &keychain.PrivKeyECDH{PrivKey: key}
+	_, _, err = decodeTrampolineOnion(
+		nodeKey, onion, payment.PaymentHash[:],
+	)
+	require.ErrorIs(t, err, ErrInvalidTrampolineOnion)
+}
+
+// TestTrampolineOnionTooManyHops asserts that we refuse to create a trampoline
+// onion whose payloads don't fit.
+func TestTrampolineOnionTooManyHops(t *testing.T) {
+	t.Parallel()
+
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	node := route.NewVertex(key.PubKey())
+
+	payment := &LightningPayment{
+		Target:         node,
+		Amount:         2000,
+		FinalCLTVDelta: 200,
+		Trampoline: &TrampolineDestination{
+			Hops: []route.Vertex{
+				node, node, node, node, node, node, node,
+			},
+			Target:         node,
+			Amount:         1000,
+			FinalCLTVDelta: 40,
+		},
+	}
+
+	_, err = newTrampolineOnion(payment, 100)
+	require.Error(t, err)
+}
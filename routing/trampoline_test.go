@@ -0,0 +1,508 @@
+package routing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTrampolineHeight     = 1000
+	testTrampolineDelta      = 144
+	testTrampolineMppTimeout = time.Minute
+)
+
+var testTrampolineStartTime = time.Unix(1000, 0)
+
+// trampolineTestContext bundles a trampoline forwarder under test with the
+// components that it interacts with.
+type trampolineTestContext struct {
+	forwarder *TrampolineForwarder
+	control   ControlTower
+
+	// payments receives the onward payments that the forwarder starts.
+	payments chan *LightningPayment
+
+	// nodeKey is the node key of the forwarder.
+	nodeKey *btcec.PrivateKey
+
+	clock      *clock.TestClock
+	tickSignal chan time.Duration
+}
+
+// newTestTrampolineForwarder creates a trampoline forwarder that is backed by
+// a real control tower. Payments that are handed to the forwarder's
+// SendPayment are only registered with the control tower and sent out on the
+// payments channel of the returned context.
+func newTestTrampolineForwarder(t *testing.T) *trampolineTestContext {
+	db, err := initDB()
+	require.NoError(t, err)
+
+	nodeKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	ctx := &trampolineTestContext{
+		control:    NewControlTower(channeldb.NewPaymentControl(db)),
+		payments:   make(chan *LightningPayment, 1),
+		nodeKey:    nodeKey,
+		tickSignal: make(chan time.Duration, 10),
+	}
+	ctx.clock = clock.NewTestClockWithTickSignal(
+		testTrampolineStartTime, ctx.tickSignal,
+	)
+
+	ctx.forwarder = NewTrampolineForwarder(&TrampolineForwarderConfig{
+		SendPayment: func(p *LightningPayment) error {
+			err := ctx.control.InitPayment(
+				p.PaymentHash, &channeldb.PaymentCreationInfo{
+					PaymentHash: p.PaymentHash,
+					Value:       p.Amount,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			ctx.payments <- p
+			return nil
+		},
+		Control:     ctx.control,
+		NodeKeyECDH: &keychain.PrivKeyECDH{PrivKey: nodeKey},
+		Clock:       ctx.clock,
+		MppTimeout:  testTrampolineMppTimeout,
+		BaseFee:     1000,
+		FeeRate:     1000,
+		CltvDelta:   testTrampolineDelta,
+		MaxParts:    16,
+	})
+	t.Cleanup(ctx.forwarder.Stop)
+
+	return ctx
+}
+
+// onion builds a trampoline onion for the given payloads. The first payload is
+// destined for the forwarder under test, the others for random nodes.
+func (c *trampolineTestContext) onion(t *testing.T, hash lntypes.Hash,
+	payloads ...*record.Trampoline) []byte {
+
+	hops := []trampolineHop{{
+		pubKey:  c.nodeKey.PubKey(),
+		payload: payloads[0],
+	}}
+	for _, payload := range payloads[1:] {
+		key, err := btcec.NewPrivateKey(btcec.S256())
+		require.NoError(t, err)
+
+		hops = append(hops, trampolineHop{
+			pubKey:  key.PubKey(),
+			payload: payload,
+		})
+	}
+
+	sessionKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	onion, err := encodeTrampolineOnion(sessionKey, hops, hash[:])
+	require.NoError(t, err)
+
+	return onion
+}
+
+// receivePayment waits for the forwarder to start an onward payment.
+func (c *trampolineTestContext) receivePayment(
+	t *testing.T) *LightningPayment {
+
+	select {
+	case payment := <-c.payments:
+		return payment
+
+	case <-time.After(testTimeout):
+		t.Fatal("onward payment not sent")
+	}
+
+	return nil
+}
+
+// receiveResolution waits for a resolution on the given channel.
+func receiveResolution(t *testing.T, hodlChan chan interface{}) interface{} {
+	select {
+	case item := <-hodlChan:
+		return item
+
+	case <-time.After(testTimeout):
+		t.Fatal("no resolution received")
+	}
+
+	return nil
+}
+
+// TestTrampolineForwarderBudget asserts that trampoline htlcs that don't leave
+// enough fee or cltv budget are failed immediately.
+func TestTrampolineForwarderBudget(t *testing.T) {
+	t.Parallel()
+
+	ctx := newTestTrampolineForwarder(t)
+
+	var (
+		key     = channeldb.CircuitKey{HtlcID: 1}
+		hash    = lntypes.Hash{1}
+		outCltv = uint32(testTrampolineHeight + 40)
+		onion   = ctx.onion(t, hash, record.NewTrampoline(
+			route.Vertex{2}, 1000000, outCltv, nil,
+		))
+		hodlChan = make(chan interface{}, 1)
+	)
+
+	// Our fee for forwarding 1000000 msat is 2000 msat, so an incoming
+	// amount that only covers 1999 msat must be rejected.
+	resolution, err := ctx.forwarder.ForwardTrampolineHtlc(
+		hash, 1001999, outCltv+testTrampolineDelta,
+		testTrampolineHeight, key, onion, nil, hodlChan,
+	)
+	require.NoError(t, err)
+	require.Equal(t, htlcswitch.NewTrampolineFailResolution(
+		key, &lnwire.FailTrampolineFeeInsufficient{},
+	), resolution)
+
+	// Sufficient fee, but the incoming expiry leaves less than our cltv
+	// delta.
+	resolution, err = ctx.forwarder.ForwardTrampolineHtlc(
+		hash, 1002000, outCltv+testTrampolineDelta-1,
+		testTrampolineHeight, key, onion, nil, hodlChan,
+	)
+	require.NoError(t, err)
+	require.Equal(t, htlcswitch.NewTrampolineFailResolution(
+		key, &lnwire.FailTrampolineExpiryTooSoon{},
+	), resolution)
+
+	// An onion that isn't meant for us, or for a different payment hash,
+	// can't be decrypted.
+	resolution, err = ctx.forwarder.ForwardTrampolineHtlc(
+		lntypes.Hash{2}, 1002000, outCltv+testTrampolineDelta,
+		testTrampolineHeight, key, onion, nil, hodlChan,
+	)
+	require.NoError(t, err)
+	require.Equal(t, htlcswitch.NewTrampolineFailResolution(
+		key, lnwire.NewInvalidOnionPayload(
+			uint64(record.TrampolineOnionType), 0,
+		),
+	), resolution)
+}
+
+// TestTrampolineForwarderSettle tests that a trampoline htlc is forwarded
+// within the budget left by the sender, and settled once the onward payment
+// succeeds.
+func TestTrampolineForwarderSettle(t *testing.T) {
+	t.Parallel()
+
+	ctx := newTestTrampolineForwarder(t)
+
+	info, attempt, preimage, err := genInfo()
+	require.NoError(t, err)
+
+	// The onward payment must match the amount of the test route, which
+	// is 555 msat. Our fee for forwarding it is just the base fee.
+	var (
+		key     = channeldb.CircuitKey{HtlcID: 1}
+		amt     = info.Value
+		outCltv = uint32(testTrampolineHeight + 40)
+		addr    = [32]byte{3}
+		onion   = ctx.onion(t, info.PaymentHash, record.NewTrampoline(
+			route.Vertex{2}, amt, outCltv, record.NewMPP(amt, addr),
+		))
+		hodlChan = make(chan interface{}, 1)
+	)
+
+	resolution, err := ctx.forwarder.ForwardTrampolineHtlc(
+		info.PaymentHash, amt+4000, outCltv+testTrampolineDelta+10,
+		testTrampolineHeight, key, onion, nil, hodlChan,
+	)
+	require.NoError(t, err)
+	require.Nil(t, resolution)
+
+	// The onward payment should use the remaining fee budget, and leave
+	// us our cltv delta. As we are the last trampoline, the payment goes
+	// to the final destination with its payment address.
+	payment := ctx.receivePayment(t)
+	require.Equal(t, route.Vertex{2}, payment.Target)
+	require.Equal(t, amt, payment.Amount)
+	require.Equal(t, lnwire.MilliSatoshi(3000), payment.FeeLimit)
+	require.Equal(t, uint16(40), payment.FinalCLTVDelta)
+	require.Equal(t, uint32(50), payment.CltvLimit)
+	require.Equal(t, &addr, payment.PaymentAddr)
+	require.Equal(t, uint32(16), payment.MaxParts)
+	require.Nil(t, payment.TrampolineOnion)
+
+	// Re-processing the same htlc must not start a second payment.
+	resolution, err = ctx.forwarder.ForwardTrampolineHtlc(
+		info.PaymentHash, amt+4000, outCltv+testTrampolineDelta+10,
+		testTrampolineHeight, key, onion, nil, hodlChan,
+	)
+	require.NoError(t, err)
+	require.Nil(t, resolution)
+
+	// Settle the onward payment.
+	require.NoError(t, ctx.control.RegisterAttempt(
+		info.PaymentHash, attempt,
+	))
+	_, err = ctx.control.SettleAttempt(
+		info.PaymentHash, attempt.AttemptID,
+		&channeldb.HTLCSettleInfo{Preimage: preimage},
+	)
+	require.NoError(t, err)
+
+	item := receiveResolution(t, hodlChan)
+	settle, ok := item.(*invoices.HtlcSettleResolution)
+	require.True(t, ok)
+	require.Equal(t, preimage, settle.Preimage)
+	require.Equal(t, key, settle.CircuitKey())
+
+	select {
+	case <-ctx.payments:
+		t.Fatal("unexpected second onward payment")
+	default:
+	}
+}
+
+// TestTrampolineForwarderFail tests that a trampoline htlc is failed with a
+// trampoline specific failure if no route is found for the onward payment.
+func TestTrampolineForwarderFail(t *testing.T) {
+	t.Parallel()
+
+	ctx := newTestTrampolineForwarder(t)
+
+	info, _, _, err := genInfo()
+	require.NoError(t, err)
+
+	var (
+		key     = channeldb.CircuitKey{HtlcID: 1}
+		outCltv = uint32(testTrampolineHeight + 40)
+		onion   = ctx.onion(t, info.PaymentHash, record.NewTrampoline(
+			route.Vertex{2}, 1000000, outCltv, nil,
+		))
+		hodlChan = make(chan interface{}, 1)
+	)
+
+	resolution, err := ctx.forwarder.ForwardTrampolineHtlc(
+		info.PaymentHash, 1005000, outCltv+testTrampolineDelta,
+		testTrampolineHeight, key, onion, nil, hodlChan,
+	)
+	require.NoError(t, err)
+	require.Nil(t, resolution)
+
+	// Without a payment address, the payment can't be split.
+	payment := ctx.receivePayment(t)
+	require.Equal(t, uint32(1), payment.MaxParts)
+
+	err = ctx.control.Fail(info.PaymentHash, channeldb.FailureReasonNoRoute)
+	require.NoError(t, err)
+
+	require.Equal(t, htlcswitch.NewTrampolineFailResolution(
+		key, &lnwire.FailTrampolineFeeInsufficient{},
+	), receiveResolution(t, hodlChan))
+}
+
+// TestTrampolineForwarderMPP tests that the htlcs of a multi-path trampoline
+// payment are collected, and that the fee is checked against the amount of
+// the full set rather than that of the individual htlcs.
+func TestTrampolineForwarderMPP(t *testing.T) {
+	t.Parallel()
+
+	ctx := newTestTrampolineForwarder(t)
+
+	info, attempt, preimage, err := genInfo()
+	require.NoError(t, err)
+
+	var (
+		amt     = info.Value
+		total   = amt + 4000
+		outCltv = uint32(testTrampolineHeight + 40)
+		addr    = [32]byte{3}
+		onion   = ctx.onion(t, info.PaymentHash, record.NewTrampoline(
+			route.Vertex{2}, amt, outCltv, nil,
+		))
+		mpp = record.NewMPP(total, [32]byte{4})
+
+		key1      = channeldb.CircuitKey{HtlcID: 1}
+		key2      = channeldb.CircuitKey{HtlcID: 2}
+		hodlChan1 = make(chan interface{}, 1)
+		hodlChan2 = make(chan interface{}, 1)
+	)
+
+	// The first htlc doesn't even cover our fee on its own, but as part
+	// of a set it is held until the remaining htlcs arrive.
+	resolution, err := ctx.forwarder.ForwardTrampolineHtlc(
+		info.PaymentHash, 1000, outCltv+testTrampolineDelta+10,
+		testTrampolineHeight, key1, onion, mpp, hodlChan1,
+	)
+	require.NoError(t, err)
+	require.Nil(t, resolution)
+
+	// Htlcs that don't match the set are rejected.
+	otherKey := channeldb.CircuitKey{HtlcID: 3}
+	resolution, err = ctx.forwarder.ForwardTrampolineHtlc(
+		info.PaymentHash, 1000, outCltv+testTrampolineDelta+10,
+		testTrampolineHeight, otherKey, onion,
+		record.NewMPP(total+1, [32]byte{4}), make(chan interface{}, 1),
+	)
+	require.NoError(t, err)
+	require.Equal(t, htlcswitch.NewTrampolineFailResolution(
+		otherKey, lnwire.NewFailIncorrectDetails(
+			1000, testTrampolineHeight,
+		),
+	), resolution)
+
+	otherOnion := ctx.onion(t, info.PaymentHash, record.NewTrampoline(
+		route.Vertex{2}, amt, outCltv, record.NewMPP(amt, addr),
+	))
+	resolution, err = ctx.forwarder.ForwardTrampolineHtlc(
+		info.PaymentHash, 1000, outCltv+testTrampolineDelta+10,
+		testTrampolineHeight, otherKey, otherOnion, mpp,
+		make(chan interface{}, 1),
+	)
+	require.NoError(t, err)
+	require.Equal(t, htlcswitch.NewTrampolineFailResolution(
+		otherKey, lnwire.NewInvalidOnionPayload(
+			uint64(record.TrampolineOnionType), 0,
+		),
+	), resolution)
+
+	select {
+	case <-ctx.payments:
+		t.Fatal("onward payment started for incomplete set")
+	default:
+	}
+
+	// The second htlc completes the set, which starts the onward payment
+	// with the fee budget of the full set. The cltv budget is limited by
+	// the htlc that expires first.
+	resolution, err = ctx.forwarder.ForwardTrampolineHtlc(
+		info.PaymentHash, total-1000, outCltv+testTrampolineDelta+5,
+		testTrampolineHeight, key2, onion, mpp, hodlChan2,
+	)
+	require.NoError(t, err)
+	require.Nil(t, resolution)
+
+	payment := ctx.receivePayment(t)
+	require.Equal(t, amt, payment.Amount)
+	require.Equal(t, lnwire.MilliSatoshi(3000), payment.FeeLimit)
+	require.Equal(t, uint32(45), payment.CltvLimit)
+
+	// Settling the onward payment settles all htlcs of the set.
+	require.NoError(t, ctx.control.RegisterAttempt(
+		info.PaymentHash, attempt,
+	))
+	_, err = ctx.control.SettleAttempt(
+		info.PaymentHash, attempt.AttemptID,
+		&channeldb.HTLCSettleInfo{Preimage: preimage},
+	)
+	require.NoError(t, err)
+
+	for _, hodlChan := range []chan interface{}{hodlChan1, hodlChan2} {
+		item := receiveResolution(t, hodlChan)
+		settle, ok := item.(*invoices.HtlcSettleResolution)
+		require.True(t, ok)
+		require.Equal(t, preimage, settle.Preimage)
+	}
+}
+
+// TestTrampolineForwarderMPPTimeout tests that the htlcs of an incomplete
+// multi-path trampoline payment are failed after the mpp timeout.
+func TestTrampolineForwarderMPPTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := newTestTrampolineForwarder(t)
+
+	var (
+		key     = channeldb.CircuitKey{HtlcID: 1}
+		hash    = lntypes.Hash{1}
+		outCltv = uint32(testTrampolineHeight + 40)
+		onion   = ctx.onion(t, hash, record.NewTrampoline(
+			route.Vertex{2}, 1000000, outCltv, nil,
+		))
+		hodlChan = make(chan interface{}, 1)
+	)
+
+	resolution, err := ctx.forwarder.ForwardTrampolineHtlc(
+		hash, 500000, outCltv+testTrampolineDelta,
+		testTrampolineHeight, key, onion,
+		record.NewMPP(1005000, [32]byte{4}), hodlChan,
+	)
+	require.NoError(t, err)
+	require.Nil(t, resolution)
+
+	select {
+	case <-ctx.tickSignal:
+	case <-time.After(testTimeout):
+		t.Fatal("mpp timeout not started")
+	}
+	ctx.clock.SetTime(testTrampolineStartTime.Add(
+		testTrampolineMppTimeout,
+	))
+
+	require.Equal(t, htlcswitch.NewTrampolineFailResolution(
+		key, &lnwire.FailMPPTimeout{},
+	), receiveResolution(t, hodlChan))
+
+	select {
+	case <-ctx.payments:
+		t.Fatal("unexpected onward payment")
+	default:
+	}
+}
+
+// TestTrampolineForwarderNextTrampoline tests that a trampoline node that
+// isn't the last one pays the next trampoline node, passing on the remainder
+// of the onion.
+func TestTrampolineForwarderNextTrampoline(t *testing.T) {
+	t.Parallel()
+
+	ctx := newTestTrampolineForwarder(t)
+
+	var (
+		key      = channeldb.CircuitKey{HtlcID: 1}
+		hash     = lntypes.Hash{1}
+		outCltv  = uint32(testTrampolineHeight + 400)
+		nextNode = route.Vertex{2}
+		onion    = ctx.onion(t, hash,
+			record.NewTrampoline(nextNode, 1002000, outCltv, nil),
+			record.NewTrampoline(
+				route.Vertex{3}, 1000000, outCltv-200,
+				record.NewMPP(1000000, [32]byte{5}),
+			),
+		)
+		hodlChan = make(chan interface{}, 1)
+	)
+
+	resolution, err := ctx.forwarder.ForwardTrampolineHtlc(
+		hash, 1010000, outCltv+testTrampolineDelta,
+		testTrampolineHeight, key, onion, nil, hodlChan,
+	)
+	require.NoError(t, err)
+	require.Nil(t, resolution)
+
+	// The next trampoline is paid with a payment address of our own and
+	// the features that trampoline routing implies, so that we can split
+	// the payment without knowing the node from the graph.
+	payment := ctx.receivePayment(t)
+	require.Equal(t, nextNode, payment.Target)
+	require.Equal(t, lnwire.MilliSatoshi(1002000), payment.Amount)
+	require.NotNil(t, payment.PaymentAddr)
+	require.Equal(t, uint32(16), payment.MaxParts)
+	require.True(t, payment.DestFeatures.HasFeature(
+		lnwire.TrampolineRoutingOptional,
+	))
+	require.Len(t, payment.TrampolineOnion, TrampolineOnionSize)
+	require.NotEqual(t, onion, payment.TrampolineOnion)
+}
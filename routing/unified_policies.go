@@ -38,9 +38,11 @@ func newUnifiedPolicies(sourceNode, toNode route.Vertex,
 }
 
 // addPolicy adds a single channel policy. Capacity may be zero if unknown
-// (light clients).
+// (light clients). The inbound fee is the fee that the to node charges for
+// htlcs that arrive over this channel.
 func (u *unifiedPolicies) addPolicy(fromNode route.Vertex,
-	edge *channeldb.ChannelEdgePolicy, capacity btcutil.Amount) {
+	edge *channeldb.ChannelEdgePolicy, capacity btcutil.Amount,
+	inboundFee channeldb.InboundFee) {
 
 	localChan := fromNode == u.sourceNode
 
@@ -61,15 +63,16 @@ func (u *unifiedPolicies) addPolicy(fromNode route.Vertex,
 	}
 
 	policy.edges = append(policy.edges, &unifiedPolicyEdge{
-		policy:   edge,
-		capacity: capacity,
+		policy:     edge,
+		capacity:   capacity,
+		inboundFee: inboundFee,
 	})
 }
 
 // addGraphPolicies adds all policies that are known for the toNode in the
 // graph.
 func (u *unifiedPolicies) addGraphPolicies(g routingGraph) error {
-	cb := func(edgeInfo *channeldb.ChannelEdgeInfo, _,
+	cb := func(edgeInfo *channeldb.ChannelEdgeInfo, outEdge,
 		inEdge *channeldb.ChannelEdgePolicy) error {
 
 		// If there is no edge policy for this candidate node, skip.
@@ -85,8 +88,16 @@ func (u *unifiedPolicies) addGraphPolicies(g routingGraph) error {
 			return err
 		}
 
+		// The inbound fee that the to node charges for this channel is
+		// part of its own policy for the channel. If that policy isn't
+		// known, no inbound fee is assumed.
+		var inboundFee channeldb.InboundFee
+		if outEdge != nil {
+			inboundFee, _ = outEdge.InboundFee()
+		}
+
 		// Add this policy to the unified policies map.
-		u.addPolicy(fromNode, inEdge, edgeInfo.Capacity)
+		u.addPolicy(fromNode, inEdge, edgeInfo.Capacity, inboundFee)
 
 		return nil
 	}
@@ -100,6 +111,61 @@ func (u *unifiedPolicies) addGraphPolicies(g routingGraph) error {
 type unifiedPolicyEdge struct {
 	policy   *channeldb.ChannelEdgePolicy
 	capacity btcutil.Amount
+
+	// inboundFee is the fee that the node at the end of this channel
+	// charges for htlcs that arrive over it.
+	inboundFee channeldb.InboundFee
+}
+
+// computeNodeFee returns the total fee that the node at the start of the next
+// channel charges for forwarding the given amount over that channel, when the
+// htlc arrives over this channel. The outbound fee is set by the next
+// channel's policy, while the inbound fee is set by this edge. As routing
+// nodes don't accept negative fees, the result is never below zero.
+func (u *unifiedPolicyEdge) computeNodeFee(next *unifiedPolicyEdge,
+	amtToForward lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+
+	outboundFee := next.policy.ComputeFee(amtToForward)
+	inboundFee := u.inboundFee.CalcFee(amtToForward + outboundFee)
+
+	fee := int64(outboundFee) + inboundFee
+	if fee < 0 {
+		return 0
+	}
+
+	return lnwire.MilliSatoshi(fee)
+}
+
+// amtToForwardFromIncoming returns the amount that the node at the end of
+// this channel forwards over the next channel, given the amount that arrives
+// over this channel. It is the inverse of computeNodeFee, up to rounding.
+func (u *unifiedPolicyEdge) amtToForwardFromIncoming(next *unifiedPolicyEdge,
+	incomingAmt lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+
+	// Strip off the inbound fee first. It is calculated over the amount
+	// that includes the outbound fee. A discount of 100% or more can't be
+	// inverted, in which case the node is assumed to take no fee at all.
+	rateParts := int64(1000000) + int64(u.inboundFee.Rate)
+	if rateParts <= 0 {
+		return incomingAmt
+	}
+
+	withOutboundFee := (int64(incomingAmt) - int64(u.inboundFee.Base)) *
+		1000000 / rateParts
+	if withOutboundFee < 0 {
+		return incomingAmt
+	}
+
+	amt := lnwire.MilliSatoshi(withOutboundFee)
+	amt -= next.policy.ComputeFeeFromIncoming(amt)
+
+	// The total fee of a node is never negative, so it can't forward more
+	// than it receives.
+	if amt > incomingAmt {
+		return incomingAmt
+	}
+
+	return amt
 }
 
 // amtInRange checks whether an amount falls within the valid range for a
@@ -137,9 +203,10 @@ type unifiedPolicy struct {
 
 // getPolicy returns the optimal policy to use for this connection given a
 // specific amount to send. It differentiates between local and network
-// channels.
+// channels. The returned edge also carries the inbound fee that the to node
+// charges for the selected channel.
 func (u *unifiedPolicy) getPolicy(amt lnwire.MilliSatoshi,
-	bandwidthHints map[uint64]lnwire.MilliSatoshi) *channeldb.ChannelEdgePolicy {
+	bandwidthHints map[uint64]lnwire.MilliSatoshi) *unifiedPolicyEdge {
 
 	if u.localChan {
 		return u.getPolicyLocal(amt, bandwidthHints)
@@ -151,10 +218,10 @@ func (u *unifiedPolicy) getPolicy(amt lnwire.MilliSatoshi,
 // getPolicyLocal returns the optimal policy to use for this local connection
 // given a specific amount to send.
 func (u *unifiedPolicy) getPolicyLocal(amt lnwire.MilliSatoshi,
-	bandwidthHints map[uint64]lnwire.MilliSatoshi) *channeldb.ChannelEdgePolicy {
+	bandwidthHints map[uint64]lnwire.MilliSatoshi) *unifiedPolicyEdge {
 
 	var (
-		bestPolicy   *channeldb.ChannelEdgePolicy
+		bestEdge     *unifiedPolicyEdge
 		maxBandwidth lnwire.MilliSatoshi
 	)
 
@@ -196,21 +263,21 @@ func (u *unifiedPolicy) getPolicyLocal(amt lnwire.MilliSatoshi,
 		maxBandwidth = bandwidth
 
 		// Update best policy.
-		bestPolicy = edge.policy
+		bestEdge = edge
 	}
 
-	return bestPolicy
+	return bestEdge
 }
 
 // getPolicyNetwork returns the optimal policy to use for this connection given
 // a specific amount to send. The goal is to return a policy that maximizes the
 // probability of a successful forward in a non-strict forwarding context.
 func (u *unifiedPolicy) getPolicyNetwork(
-	amt lnwire.MilliSatoshi) *channeldb.ChannelEdgePolicy {
+	amt lnwire.MilliSatoshi) *unifiedPolicyEdge {
 
 	var (
-		bestPolicy  *channeldb.ChannelEdgePolicy
-		maxFee      lnwire.MilliSatoshi
+		bestEdge    *unifiedPolicyEdge
+		maxFee      int64
 		maxTimelock uint16
 	)
 
@@ -234,18 +301,20 @@ func (u *unifiedPolicy) getPolicyNetwork(
 		}
 
 		// Use the policy that results in the highest fee for this
-		// specific amount.
-		fee := edge.policy.ComputeFee(amt)
-		if fee < maxFee {
+		// specific amount. The inbound fee that the to node charges
+		// for the channel is part of the price of using it.
+		outboundFee := edge.policy.ComputeFee(amt)
+		fee := int64(outboundFee) + edge.inboundFee.CalcFee(amt)
+		if bestEdge != nil && fee < maxFee {
 			continue
 		}
 		maxFee = fee
 
-		bestPolicy = edge.policy
+		bestEdge = edge
 	}
 
 	// Return early if no channel matches.
-	if bestPolicy == nil {
+	if bestEdge == nil {
 		return nil
 	}
 
@@ -259,10 +328,14 @@ func (u *unifiedPolicy) getPolicyNetwork(
 	// get forwarded. Because we penalize pair-wise, there won't be a second
 	// chance for this node pair. But this is all only needed for nodes that
 	// have distinct policies for channels to the same peer.
-	modifiedPolicy := *bestPolicy
+	modifiedPolicy := *bestEdge.policy
 	modifiedPolicy.TimeLockDelta = maxTimelock
 
-	return &modifiedPolicy
+	return &unifiedPolicyEdge{
+		policy:     &modifiedPolicy,
+		capacity:   bestEdge.capacity,
+		inboundFee: bestEdge.inboundFee,
+	}
 }
 
 func (u *unifiedPolicy) getChanDisabledPolicy() bool {
@@ -36,15 +36,17 @@ func TestUnifiedPolicies(t *testing.T) {
 		MaxHTLC:                   400,
 		MinHTLC:                   100,
 	}
-	u.addPolicy(fromNode, &p1, 7)
-	u.addPolicy(fromNode, &p2, 7)
+	u.addPolicy(fromNode, &p1, 7, channeldb.InboundFee{})
+	u.addPolicy(fromNode, &p2, 7, channeldb.InboundFee{})
 
-	checkPolicy := func(policy *channeldb.ChannelEdgePolicy,
+	checkPolicy := func(edge *unifiedPolicyEdge,
 		feeBase lnwire.MilliSatoshi, feeRate lnwire.MilliSatoshi,
 		timeLockDelta uint16) {
 
 		t.Helper()
 
+		policy := edge.policy
+
 		if policy.FeeBaseMSat != feeBase {
 			t.Fatalf("expected fee base %v, got %v",
 				feeBase, policy.FeeBaseMSat)
@@ -89,3 +91,105 @@ func TestUnifiedPolicies(t *testing.T) {
 		p1.TimeLockDelta,
 	)
 }
+
+// TestUnifiedPoliciesInboundFee tests that the inbound fee of the to node is
+// taken into account when selecting a network channel, and that it is carried
+// in the selected edge.
+func TestUnifiedPoliciesInboundFee(t *testing.T) {
+	source := route.Vertex{1}
+	toNode := route.Vertex{2}
+	fromNode := route.Vertex{3}
+
+	u := newUnifiedPolicies(source, toNode, nil)
+
+	// Add two channels with identical outbound policies. The to node
+	// charges an inbound fee on the second channel only.
+	p1 := channeldb.ChannelEdgePolicy{
+		ChannelID:   1,
+		FeeBaseMSat: 10,
+	}
+	p2 := channeldb.ChannelEdgePolicy{
+		ChannelID:   2,
+		FeeBaseMSat: 10,
+	}
+	inboundFee := channeldb.InboundFee{Base: 5}
+
+	u.addPolicy(fromNode, &p1, 7, channeldb.InboundFee{})
+	u.addPolicy(fromNode, &p2, 7, inboundFee)
+
+	// The second channel is the most expensive one to use, so that is the
+	// one that should be selected.
+	edge := u.policies[fromNode].getPolicy(1000, nil)
+	if edge.policy.ChannelID != p2.ChannelID {
+		t.Fatalf("expected channel %v, got %v", p2.ChannelID,
+			edge.policy.ChannelID)
+	}
+	if edge.inboundFee != inboundFee {
+		t.Fatalf("expected inbound fee %v, got %v", inboundFee,
+			edge.inboundFee)
+	}
+}
+
+// TestUnifiedPolicyEdgeNodeFee tests the calculation of the total fee of a
+// node that charges an inbound fee, and the inverse calculation of the amount
+// that the node forwards.
+func TestUnifiedPolicyEdgeNodeFee(t *testing.T) {
+	outEdge := &unifiedPolicyEdge{
+		policy: &channeldb.ChannelEdgePolicy{
+			FeeBaseMSat:               1000,
+			FeeProportionalMillionths: 1000,
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		inboundFee  channeldb.InboundFee
+		expectedFee lnwire.MilliSatoshi
+	}{
+		{
+			name:        "no inbound fee",
+			expectedFee: 2000,
+		},
+		{
+			name:        "inbound fee",
+			inboundFee:  channeldb.InboundFee{Base: 100, Rate: 1000},
+			expectedFee: 3102,
+		},
+		{
+			name:        "inbound discount",
+			inboundFee:  channeldb.InboundFee{Base: -500},
+			expectedFee: 1500,
+		},
+		{
+			name:        "discount exceeds outbound fee",
+			inboundFee:  channeldb.InboundFee{Base: -5000},
+			expectedFee: 0,
+		},
+	}
+
+	const amtToForward = 1000000
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			inEdge := &unifiedPolicyEdge{
+				inboundFee: testCase.inboundFee,
+			}
+
+			fee := inEdge.computeNodeFee(outEdge, amtToForward)
+			if fee != testCase.expectedFee {
+				t.Fatalf("expected fee %v, got %v",
+					testCase.expectedFee, fee)
+			}
+
+			amt := inEdge.amtToForwardFromIncoming(
+				outEdge, amtToForward+fee,
+			)
+			if amt != amtToForward {
+				t.Fatalf("expected amount to forward %v, "+
+					"got %v", amtToForward, amt)
+			}
+		})
+	}
+}
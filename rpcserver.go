@@ -52,6 +52,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
@@ -307,6 +308,13 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/BatchOpenChannel": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/CloseChannel": {{
 			Entity: "onchain",
 			Action: "write",
@@ -358,6 +366,13 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ChannelCloseReport": {{
+			Entity: "offchain",
+			Action: "read",
+		}, {
+			Entity: "onchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SendPayment": {{
 			Entity: "offchain",
 			Action: "write",
@@ -382,6 +397,10 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "invoices",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/DeleteInvoice": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/ListInvoices": {{
 			Entity: "invoices",
 			Action: "read",
@@ -438,6 +457,10 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/DeletePayment": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/DebugLevel": {{
 			Entity: "info",
 			Action: "write",
@@ -458,6 +481,10 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ForwardingHistoryStats": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/RestoreChannelBackups": {{
 			Entity: "offchain",
 			Action: "write",
@@ -676,7 +703,8 @@ func (r *rpcServer) addDeps(s *server, macService *macaroons.Service,
 		r.cfg, s.cc, r.cfg.networkDir, macService, atpl, invoiceRegistry,
 		s.htlcSwitch, r.cfg.ActiveNetParams.Params, s.chanRouter,
 		routerBackend, s.nodeSigner, s.localChanDB, s.remoteChanDB,
-		s.sweeper, tower, s.towerClient, s.anchorTowerClient,
+		s.sweeper, s.chainArb, s.consolidator, tower, s.towerClient,
+		s.anchorTowerClient,
 		r.cfg.net.ResolveTCPAddr, genInvoiceFeatures, rpcsLog,
 	)
 	if err != nil {
@@ -1924,6 +1952,7 @@ func (r *rpcServer) parseOpenChannelReq(in *lnrpc.OpenChannelRequest,
 		MaxHtlcs:         maxHtlcs,
 		MaxLocalCsv:      uint16(in.MaxLocalCsv),
 		SubtractFees:     in.SubtractFees,
+		ZeroConf:         in.ZeroConf,
 	}, nil
 }
 
@@ -2074,6 +2103,74 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	}
 }
 
+// BatchOpenChannel attempts to open multiple single-funded channels in a
+// single transaction in an atomic way. This means either all channel open
+// requests succeed at once or all attempts are aborted if any of them fail.
+func (r *rpcServer) BatchOpenChannel(ctx context.Context,
+	in *lnrpc.BatchOpenChannelRequest) (*lnrpc.BatchOpenChannelResponse,
+	error) {
+
+	if err := r.canOpenChannel(); err != nil {
+		return nil, err
+	}
+
+	// The batch funding transaction is funded and signed by the wallet kit
+	// sub-server, so we can only offer this call if it is active.
+	var walletKitServer walletrpc.WalletKitServer
+	for _, subServer := range r.subServers {
+		if server, ok := subServer.(walletrpc.WalletKitServer); ok {
+			walletKitServer = server
+		}
+	}
+	if walletKitServer == nil {
+		return nil, fmt.Errorf("batch channel open is only possible " +
+			"if the walletrpc sub-server is active")
+	}
+
+	batcher := funding.NewBatcher(&funding.BatchConfig{
+		RequestParser: func(req *lnrpc.OpenChannelRequest) (
+			*funding.InitFundingMsg, error) {
+
+			return r.parseOpenChannelReq(req, false)
+		},
+		ChannelOpener: r.server.OpenChannel,
+		ChannelAbandoner: func(chanPoint *wire.OutPoint) error {
+			_, bestHeight, err := r.server.cc.ChainIO.GetBestBlock()
+			if err != nil {
+				return err
+			}
+
+			return r.abandonChan(chanPoint, uint32(bestHeight))
+		},
+		FundingTxUpdater: func(chanPoint *wire.OutPoint,
+			fundingTx *wire.MsgTx) error {
+
+			channel, err := r.server.remoteChanDB.FetchChannel(
+				*chanPoint,
+			)
+			if err != nil {
+				return err
+			}
+
+			return channel.UpdateFundingTx(fundingTx)
+		},
+		WalletKitServer: walletKitServer,
+		Wallet:          r.server.cc.Wallet,
+		NetParams:       &r.server.cc.Wallet.Cfg.NetParams,
+		PeerTimeout:     funding.DefaultBatchPeerTimeout,
+		Quit:            r.quit,
+	})
+
+	pendingChannels, err := batcher.BatchFund(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("batch funding failed: %v", err)
+	}
+
+	return &lnrpc.BatchOpenChannelResponse{
+		PendingChannels: pendingChannels,
+	}, nil
+}
+
 // CloseChannel attempts to close an active channel identified by its channel
 // point. The actions of this method can additionally be augmented to attempt
 // a force close after a timeout period in the case of an inactive peer.
@@ -2093,7 +2190,8 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 	// If force closing a channel, the fee set in the commitment transaction
 	// is used.
 	if in.Force && (in.SatPerByte != 0 || in.SatPerVbyte != 0 ||
-		in.TargetConf != 0) {
+		in.TargetConf != 0 || in.MaxFeePerVbyte != 0 ||
+		in.MinFeePerVbyte != 0) {
 
 		return fmt.Errorf("force closing a channel uses a pre-defined fee")
 	}
@@ -2237,6 +2335,22 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		rpcsLog.Debugf("Target sat/kw for closing transaction: %v",
 			int64(feeRate))
 
+		// The optional fee range bounds the fees the channel closer
+		// will propose or accept during negotiation.
+		if in.MaxFeePerVbyte != 0 &&
+			in.MinFeePerVbyte > in.MaxFeePerVbyte {
+
+			return fmt.Errorf("min fee rate of %v sat/vbyte exceeds "+
+				"max fee rate of %v sat/vbyte",
+				in.MinFeePerVbyte, in.MaxFeePerVbyte)
+		}
+		maxFeeRate := chainfee.SatPerKVByte(
+			in.MaxFeePerVbyte * 1000,
+		).FeePerKWeight()
+		minFeeRate := chainfee.SatPerKVByte(
+			in.MinFeePerVbyte * 1000,
+		).FeePerKWeight()
+
 		// Before we attempt the cooperative channel closure, we'll
 		// examine the channel to ensure that it doesn't have a
 		// lingering HTLC.
@@ -2270,7 +2384,8 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		}
 
 		updateChan, errChan = r.server.htlcSwitch.CloseLink(
-			chanPoint, htlcswitch.CloseRegular, feeRate, deliveryScript,
+			chanPoint, htlcswitch.CloseRegular, feeRate, maxFeeRate,
+			minFeeRate, deliveryScript,
 		)
 	}
 out:
@@ -2432,40 +2547,45 @@ func (r *rpcServer) AbandonChannel(_ context.Context,
 		return nil, err
 	}
 
+	// Remove the channel from the graph, the contract court and the
+	// channel database.
+	err = r.abandonChan(chanPoint, uint32(bestHeight))
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.AbandonChannelResponse{}, nil
+}
+
+// abandonChan removes a channel from the database, graph and contract court.
+func (r *rpcServer) abandonChan(chanPoint *wire.OutPoint,
+	bestHeight uint32) error {
+
 	// Abandoning a channel is a three step process: remove from the open
 	// channel state, remove from the graph, remove from the contract
 	// court. Between any step it's possible that the users restarts the
 	// process all over again. As a result, each of the steps below are
 	// intended to be idempotent.
-	err = r.server.remoteChanDB.AbandonChannel(chanPoint, uint32(bestHeight))
+	err := r.server.remoteChanDB.AbandonChannel(chanPoint, bestHeight)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	err = abandonChanFromGraph(
 		r.server.localChanDB.ChannelGraph(), chanPoint,
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	err = r.server.chainArb.ResolveContract(*chanPoint)
 	if err != nil {
-		return nil, err
-	}
-
-	// If this channel was in the process of being closed, but didn't fully
-	// close, then it's possible that the nursery is hanging on to some
-	// state. To err on the side of caution, we'll now attempt to wipe any
-	// state for this channel from the nursery.
-	err = r.server.utxoNursery.cfg.Store.RemoveChannel(chanPoint)
-	if err != nil && err != ErrContractNotFound {
-		return nil, err
+		return err
 	}
 
 	// Finally, notify the backup listeners that the channel can be removed
 	// from any channel backups.
 	r.server.channelNotifier.NotifyClosedChannelEvent(*chanPoint)
 
-	return &lnrpc.AbandonChannelResponse{}, nil
+	return nil
 }
 
 // GetInfo returns general information concerning the lightning node including
@@ -3083,7 +3203,7 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 				pendingClose.ChanPoint)
 
 		// If the channel was force closed, then we'll need to query
-		// the utxoNursery for additional information.
+		// the channel arbitrator for additional information.
 		// TODO(halseth): distinguish remote and local case?
 		case channeldb.LocalForceClose, channeldb.RemoteForceClose:
 			forceClose := &lnrpc.PendingChannelsResponse_ForceClosedChannel{
@@ -3091,19 +3211,7 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 				ClosingTxid: closeTXID,
 			}
 
-			// Fetch reports from both nursery and resolvers. At the
-			// moment this is not an atomic snapshot. This is
-			// planned to be resolved when the nursery is removed
-			// and channel arbitrator will be the single source for
-			// these kind of reports.
-			err := r.nurseryPopulateForceCloseResp(
-				&chanPoint, currentHeight, forceClose,
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			err = r.arbitratorPopulateForceCloseResp(
+			err := r.arbitratorPopulateForceCloseResp(
 				&chanPoint, currentHeight, forceClose,
 			)
 			if err != nil {
@@ -3129,11 +3237,29 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 		return nil, err
 	}
 
+	// The anchors of the commitments of channels waiting to be closed may
+	// be swept to bump the fee of the commitments through CPFP. Fetch the
+	// pending sweeps to determine the effective fee rate of the
+	// commitments.
+	var pendingSweeps map[wire.OutPoint]*sweep.PendingInput
+	if len(waitingCloseChans) > 0 {
+		pendingSweeps, err = r.server.sweeper.PendingInputs()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	for _, waitingClose := range waitingCloseChans {
 		pub := waitingClose.IdentityPub.SerializeCompressed()
 		chanPoint := waitingClose.FundingOutpoint
 
-		var commitments lnrpc.PendingChannelsResponse_Commitments
+		var (
+			commitments lnrpc.PendingChannelsResponse_Commitments
+
+			// unconfirmedCommits holds all commitments that may
+			// be bumped through their anchors.
+			unconfirmedCommits []*channeldb.ChannelCommitment
+		)
 
 		// Report local commit. May not be present when DLP is active.
 		if waitingClose.LocalCommitment.CommitTx != nil {
@@ -3144,6 +3270,11 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 			commitments.LocalCommitFeeSat = uint64(
 				waitingClose.LocalCommitment.CommitFee,
 			)
+
+			unconfirmedCommits = append(
+				unconfirmedCommits,
+				&waitingClose.LocalCommitment,
+			)
 		}
 
 		// Report remote commit. May not be present when DLP is active.
@@ -3155,6 +3286,11 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 			commitments.RemoteCommitFeeSat = uint64(
 				waitingClose.RemoteCommitment.CommitFee,
 			)
+
+			unconfirmedCommits = append(
+				unconfirmedCommits,
+				&waitingClose.RemoteCommitment,
+			)
 		}
 
 		// Report the remote pending commit if any.
@@ -3177,6 +3313,11 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 			commitments.RemoteCommitFeeSat = uint64(
 				remoteCommitDiff.Commitment.CommitFee,
 			)
+
+			unconfirmedCommits = append(
+				unconfirmedCommits,
+				&remoteCommitDiff.Commitment,
+			)
 		}
 
 		channel := &lnrpc.PendingChannelsResponse_PendingChannel{
@@ -3197,6 +3338,17 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 			Commitments:  &commitments,
 		}
 
+		// Report the effective fee rate of the commitment that is
+		// bumped through its anchor. If none is, we report the fee rate
+		// of our local commitment.
+		feeRate, ok := packageFeeRate(unconfirmedCommits, pendingSweeps)
+		if !ok && waitingClose.LocalCommitment.CommitTx != nil {
+			feeRate = commitFeeRate(&waitingClose.LocalCommitment)
+		}
+		waitingCloseResp.PackageSatPerVbyte = uint64(
+			feeRate.FeePerKVByte() / 1000,
+		)
+
 		// A close tx has been broadcasted, all our balance will be in
 		// limbo until it confirms.
 		resp.WaitingCloseChannels = append(
@@ -3206,9 +3358,131 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 		resp.TotalLimboBalance += channel.LocalBalance
 	}
 
+	// Finally, we'll report the open channels with htlcs that reached
+	// their broadcast delta, but that aren't force closed because the
+	// htlcs are worth less than the minimum htlc value.
+	for chanPoint, htlcs := range r.server.chainArb.DustAtRisk() {
+		// The channel may have been closed since the arbitrator
+		// reported its htlcs, so we skip it rather than failing the
+		// whole request.
+		dbChannel, err := r.server.remoteChanDB.FetchChannel(chanPoint)
+		if err != nil {
+			rpcsLog.Warnf("unable to fetch channel %v with dust "+
+				"at risk: %v", chanPoint, err)
+			continue
+		}
+
+		pub := dbChannel.IdentityPub.SerializeCompressed()
+		localCommit := dbChannel.LocalCommitment
+		channel := &lnrpc.PendingChannelsResponse_PendingChannel{
+			RemoteNodePub:        hex.EncodeToString(pub),
+			ChannelPoint:         chanPoint.String(),
+			Capacity:             int64(dbChannel.Capacity),
+			LocalBalance:         int64(localCommit.LocalBalance.ToSatoshis()),
+			RemoteBalance:        int64(localCommit.RemoteBalance.ToSatoshis()),
+			LocalChanReserveSat:  int64(dbChannel.LocalChanCfg.ChanReserve),
+			RemoteChanReserveSat: int64(dbChannel.RemoteChanCfg.ChanReserve),
+			Initiator:            rpcInitiator(dbChannel.IsInitiator),
+			CommitmentType:       rpcCommitmentType(dbChannel.ChanType),
+		}
+
+		dustAtRiskResp := &lnrpc.PendingChannelsResponse_DustAtRiskChannel{
+			Channel: channel,
+		}
+		for _, htlc := range htlcs {
+			amt := int64(htlc.Amt.ToSatoshis())
+			blocksTilExpiry := int32(htlc.RefundTimeout) -
+				currentHeight
+
+			dustAtRiskResp.Htlcs = append(
+				dustAtRiskResp.Htlcs,
+				&lnrpc.PendingChannelsResponse_DustAtRiskHTLC{
+					Incoming:         htlc.Incoming,
+					Amount:           amt,
+					HashLock:         htlc.RHash[:],
+					ExpirationHeight: htlc.RefundTimeout,
+					BlocksTilExpiry:  blocksTilExpiry,
+				},
+			)
+			dustAtRiskResp.AmountAtRisk += amt
+		}
+
+		resp.DustAtRiskChannels = append(
+			resp.DustAtRiskChannels, dustAtRiskResp,
+		)
+	}
+
 	return resp, nil
 }
 
+// commitWeight returns the weight of the given commitment once it is signed.
+func commitWeight(commit *channeldb.ChannelCommitment) int64 {
+	utx := btcutil.NewTx(commit.CommitTx)
+	return blockchain.GetTransactionWeight(utx) +
+		input.WitnessCommitmentTxWeight
+}
+
+// commitFeeRate returns the fee rate of the given commitment on its own.
+func commitFeeRate(commit *channeldb.ChannelCommitment) chainfee.SatPerKWeight {
+	return chainfee.SatPerKWeight(
+		commit.CommitFee * 1000 / btcutil.Amount(commitWeight(commit)),
+	)
+}
+
+// packageFeeRate returns the effective fee rate of the commitment among the
+// given ones whose anchor is swept to bump its fee through CPFP. This is the
+// fee rate of the package made up of the commitment and the published sweep of
+// its anchor, unless the commitment pays a higher fee rate on its own. If the
+// anchors of multiple commitments are swept, the highest fee rate is returned.
+// False is returned if none of the anchors are swept.
+func packageFeeRate(commits []*channeldb.ChannelCommitment,
+	sweeps map[wire.OutPoint]*sweep.PendingInput) (chainfee.SatPerKWeight,
+	bool) {
+
+	var (
+		feeRate chainfee.SatPerKWeight
+		bumped  bool
+	)
+	for _, commit := range commits {
+		commitHash := commit.CommitTx.TxHash()
+
+		for op, inp := range sweeps {
+			if op.Hash != commitHash ||
+				inp.WitnessType != input.CommitmentAnchor {
+
+				continue
+			}
+
+			// Only sweeps that were published contribute to the
+			// fee of the package.
+			if inp.BroadcastAttempts == 0 {
+				continue
+			}
+
+			packageFee := commit.CommitFee + inp.LastSweepFee
+			packageWeight := commitWeight(commit) +
+				inp.LastSweepWeight
+			packageRate := chainfee.SatPerKWeight(
+				packageFee * 1000 /
+					btcutil.Amount(packageWeight),
+			)
+
+			// A commitment that pays a higher fee rate than the
+			// package is mined on its own.
+			if rate := commitFeeRate(commit); rate > packageRate {
+				packageRate = rate
+			}
+
+			if packageRate > feeRate {
+				feeRate = packageRate
+			}
+			bumped = true
+		}
+	}
+
+	return feeRate, bumped
+}
+
 // arbitratorPopulateForceCloseResp populates the pending channels response
 // message with channel resolution information from the contract resolvers.
 func (r *rpcServer) arbitratorPopulateForceCloseResp(chanPoint *wire.OutPoint,
@@ -3294,56 +3568,6 @@ func (r *rpcServer) arbitratorPopulateForceCloseResp(chanPoint *wire.OutPoint,
 	return nil
 }
 
-// nurseryPopulateForceCloseResp populates the pending channels response
-// message with contract resolution information from utxonursery.
-func (r *rpcServer) nurseryPopulateForceCloseResp(chanPoint *wire.OutPoint,
-	currentHeight int32,
-	forceClose *lnrpc.PendingChannelsResponse_ForceClosedChannel) error {
-
-	// Query for the maturity state for this force closed channel. If we
-	// didn't have any time-locked outputs, then the nursery may not know of
-	// the contract.
-	nurseryInfo, err := r.server.utxoNursery.NurseryReport(chanPoint)
-	if err == ErrContractNotFound {
-		return nil
-	}
-	if err != nil {
-		return fmt.Errorf("unable to obtain "+
-			"nursery report for ChannelPoint(%v): %v",
-			chanPoint, err)
-	}
-
-	// If the nursery knows of this channel, then we can populate
-	// information detailing exactly how much funds are time locked and also
-	// the height in which we can ultimately sweep the funds into the
-	// wallet.
-	forceClose.LimboBalance = int64(nurseryInfo.limboBalance)
-	forceClose.RecoveredBalance = int64(nurseryInfo.recoveredBalance)
-
-	for _, htlcReport := range nurseryInfo.htlcs {
-		// TODO(conner) set incoming flag appropriately after handling
-		// incoming incubation
-		htlc := &lnrpc.PendingHTLC{
-			Incoming:       false,
-			Amount:         int64(htlcReport.amount),
-			Outpoint:       htlcReport.outpoint.String(),
-			MaturityHeight: htlcReport.maturityHeight,
-			Stage:          htlcReport.stage,
-		}
-
-		if htlc.MaturityHeight != 0 {
-			htlc.BlocksTilMaturity =
-				int32(htlc.MaturityHeight) -
-					currentHeight
-		}
-
-		forceClose.PendingHtlcs = append(forceClose.PendingHtlcs,
-			htlc)
-	}
-
-	return nil
-}
-
 // ClosedChannels returns a list of all the channels have been closed.
 // This does not include channels that are still in the process of closing.
 func (r *rpcServer) ClosedChannels(ctx context.Context,
@@ -3353,7 +3577,7 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 	// Show all channels when no filter flags are set.
 	filterResults := in.Cooperative || in.LocalForce ||
 		in.RemoteForce || in.Breach || in.FundingCanceled ||
-		in.Abandoned
+		in.Abandoned || in.FundingDoubleSpent
 
 	resp := &lnrpc.ClosedChannelsResponse{}
 
@@ -3399,6 +3623,10 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 			if filterResults && !in.Abandoned {
 				continue
 			}
+		case channeldb.FundingDoubleSpent:
+			if filterResults && !in.FundingDoubleSpent {
+				continue
+			}
 		}
 
 		channel, err := r.createRPCClosedChannel(dbChannel)
@@ -3412,6 +3640,211 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 	return resp, nil
 }
 
+// closedOutputValues returns the values of all outputs that were
+// resolved for any closed channel, as recorded in their resolver reports.
+// Failures are only logged, as the values are merely used to split the fees
+// of batched sweeps.
+func (r *rpcServer) closedOutputValues() map[wire.OutPoint]btcutil.Amount {
+	values := make(map[wire.OutPoint]btcutil.Amount)
+
+	summaries, err := r.server.remoteChanDB.FetchClosedChannels(false)
+	if err != nil {
+		rpcsLog.Warnf("Unable to fetch closed channels: %v", err)
+		return values
+	}
+
+	for _, summary := range summaries {
+		reports, err := r.server.remoteChanDB.FetchChannelReports(
+			*r.cfg.ActiveNetParams.GenesisHash, &summary.ChanPoint,
+		)
+		if err != nil {
+			continue
+		}
+
+		for _, report := range reports {
+			values[report.OutPoint] = report.Amount
+		}
+	}
+
+	return values
+}
+
+// ChannelCloseReport returns a detailed account of how the funds of a closed
+// channel were resolved on chain, combining the channel's close summary, its
+// closing transaction, the resolver reports of the contract court and breach
+// arbiter, and the wallet's record of the sweep transactions.
+func (r *rpcServer) ChannelCloseReport(ctx context.Context,
+	in *lnrpc.ChannelCloseReportRequest) (*lnrpc.ChannelCloseReportResponse,
+	error) {
+
+	if in.ChannelPoint == nil {
+		return nil, fmt.Errorf("must specify channel point")
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(in.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	summary, err := r.server.remoteChanDB.FetchClosedChannel(chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcSummary, err := r.createRPCClosedChannel(summary)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := r.server.remoteChanDB.FetchChannelReports(
+		*r.cfg.ActiveNetParams.GenesisHash, chanPoint,
+	)
+	switch err {
+	// If the channel does not have its resolver outcomes stored, there's
+	// nothing to attribute to its outputs.
+	case channeldb.ErrNoChainHashBucket, channeldb.ErrNoChannelSummaries:
+	case nil:
+	default:
+		return nil, err
+	}
+
+	// All transactions that swept funds of the channel to our wallet were
+	// published after the channel was closed.
+	txns, err := r.server.cc.Wallet.ListTransactionDetails(
+		int32(summary.CloseHeight), -1, "",
+	)
+	if err != nil {
+		return nil, err
+	}
+	walletTxns := make(map[chainhash.Hash]*lnwallet.TransactionDetail)
+	for _, txn := range txns {
+		walletTxns[txn.Hash] = txn
+	}
+
+	// The closing transaction is only used to list all outputs, so we'll
+	// continue without it if it can't be retrieved.
+	closingTx, err := r.fetchClosingTx(summary, walletTxns)
+	if err != nil {
+		rpcsLog.Warnf("Unable to fetch closing tx %v of "+
+			"ChannelPoint(%v): %v", summary.ClosingTXID, chanPoint,
+			err)
+	}
+
+	// Sweep inputs that don't belong to the channel are either wallet
+	// inputs or outputs of other closed channels that were swept in the
+	// same transaction. We'll only load the reports of the other channels
+	// if we come across such an input.
+	var otherValues map[wire.OutPoint]btcutil.Amount
+	lookupValue := func(op wire.OutPoint) (btcutil.Amount, bool, bool) {
+		utxo, err := r.server.cc.Wallet.FetchInputInfo(&op)
+		if err == nil {
+			return utxo.Value, true, true
+		}
+
+		if otherValues == nil {
+			otherValues = r.closedOutputValues()
+		}
+		value, ok := otherValues[op]
+
+		return value, false, ok
+	}
+
+	report := newCloseReport(
+		summary, closingTx, reports, walletTxns, lookupValue,
+	)
+
+	resp := &lnrpc.ChannelCloseReportResponse{
+		Summary:           rpcSummary,
+		ClosingFeeSat:     int64(report.closingFee),
+		TotalRecoveredSat: int64(report.totalRecovered),
+		TotalSweepFeesSat: int64(report.totalSweepFees),
+	}
+
+	for _, output := range report.outputs {
+		rpcOutput := &lnrpc.CloseOutputReport{
+			OutputIndex: output.index,
+			AmountSat:   int64(output.amount),
+			PkScript:    hex.EncodeToString(output.pkScript),
+		}
+
+		for _, resolution := range output.resolutions {
+			rpcResolution, err := rpcChannelResolution(resolution)
+			if err != nil {
+				return nil, err
+			}
+
+			rpcOutput.Resolutions = append(
+				rpcOutput.Resolutions, rpcResolution,
+			)
+		}
+
+		resp.Outputs = append(resp.Outputs, rpcOutput)
+	}
+
+	for _, resolution := range report.unmatched {
+		rpcResolution, err := rpcChannelResolution(resolution)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.UnmatchedResolutions = append(
+			resp.UnmatchedResolutions, rpcResolution,
+		)
+	}
+
+	for _, sweep := range report.sweeps {
+		resp.Sweeps = append(resp.Sweeps, &lnrpc.SweepReport{
+			Txid:         sweep.txid.String(),
+			FeeSat:       int64(sweep.fee),
+			FeeKnown:     sweep.feeKnown,
+			RecoveredSat: int64(sweep.recovered),
+			BlockHeight:  sweep.blockHeight,
+			TimeStamp:    sweep.timestamp,
+		})
+	}
+
+	return resp, nil
+}
+
+// fetchClosingTx retrieves the closing transaction of a channel. We'll first
+// look for it in the wallet, which knows it if it paid to us, and otherwise in
+// the block it confirmed in.
+func (r *rpcServer) fetchClosingTx(summary *channeldb.ChannelCloseSummary,
+	walletTxns map[chainhash.Hash]*lnwallet.TransactionDetail) (*wire.MsgTx,
+	error) {
+
+	if detail, ok := walletTxns[summary.ClosingTXID]; ok {
+		var tx wire.MsgTx
+		err := tx.Deserialize(bytes.NewReader(detail.RawTx))
+		if err != nil {
+			return nil, err
+		}
+
+		return &tx, nil
+	}
+
+	blockHash, err := r.server.cc.ChainIO.GetBlockHash(
+		int64(summary.CloseHeight),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := r.server.cc.ChainIO.GetBlock(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range block.Transactions {
+		if tx.TxHash() == summary.ClosingTXID {
+			return tx, nil
+		}
+	}
+
+	return nil, fmt.Errorf("closing tx not found in block %v", blockHash)
+}
+
 // ListChannels returns a description of all the open channels that this node
 // is a participant in.
 func (r *rpcServer) ListChannels(ctx context.Context,
@@ -3589,6 +4022,7 @@ func createRPCOpenChannel(r *rpcServer, graph *channeldb.ChannelGraph,
 		StaticRemoteKey:       commitmentType == lnrpc.CommitmentType_STATIC_REMOTE_KEY,
 		CommitmentType:        commitmentType,
 		ThawHeight:            dbChannel.ThawHeight,
+		ZeroConf:              dbChannel.ChanType.IsZeroConf(),
 		LocalConstraints: createChannelConstraint(
 			&dbChannel.LocalChanCfg,
 		),
@@ -3741,7 +4175,6 @@ func (r *rpcServer) createRPCClosedChannel(
 	nodeID := hex.EncodeToString(nodePub.SerializeCompressed())
 
 	var (
-		closeType      lnrpc.ChannelCloseSummary_ClosureType
 		openInit       lnrpc.Initiator
 		closeInitiator lnrpc.Initiator
 		err            error
@@ -3756,20 +4189,9 @@ func (r *rpcServer) createRPCClosedChannel(
 		return nil, err
 	}
 
-	// Convert the close type to rpc type.
-	switch dbChannel.CloseType {
-	case channeldb.CooperativeClose:
-		closeType = lnrpc.ChannelCloseSummary_COOPERATIVE_CLOSE
-	case channeldb.LocalForceClose:
-		closeType = lnrpc.ChannelCloseSummary_LOCAL_FORCE_CLOSE
-	case channeldb.RemoteForceClose:
-		closeType = lnrpc.ChannelCloseSummary_REMOTE_FORCE_CLOSE
-	case channeldb.BreachClose:
-		closeType = lnrpc.ChannelCloseSummary_BREACH_CLOSE
-	case channeldb.FundingCanceled:
-		closeType = lnrpc.ChannelCloseSummary_FUNDING_CANCELED
-	case channeldb.Abandoned:
-		closeType = lnrpc.ChannelCloseSummary_ABANDONED
+	closeType, err := rpcCloseType(dbChannel.CloseType)
+	if err != nil {
+		return nil, err
 	}
 
 	channel := &lnrpc.ChannelCloseSummary{
@@ -3818,6 +4240,30 @@ func (r *rpcServer) createRPCClosedChannel(
 	return channel, nil
 }
 
+// rpcCloseType converts a channel close type to its rpc representation.
+func rpcCloseType(closeType channeldb.ClosureType) (
+	lnrpc.ChannelCloseSummary_ClosureType, error) {
+
+	switch closeType {
+	case channeldb.CooperativeClose:
+		return lnrpc.ChannelCloseSummary_COOPERATIVE_CLOSE, nil
+	case channeldb.LocalForceClose:
+		return lnrpc.ChannelCloseSummary_LOCAL_FORCE_CLOSE, nil
+	case channeldb.RemoteForceClose:
+		return lnrpc.ChannelCloseSummary_REMOTE_FORCE_CLOSE, nil
+	case channeldb.BreachClose:
+		return lnrpc.ChannelCloseSummary_BREACH_CLOSE, nil
+	case channeldb.FundingCanceled:
+		return lnrpc.ChannelCloseSummary_FUNDING_CANCELED, nil
+	case channeldb.Abandoned:
+		return lnrpc.ChannelCloseSummary_ABANDONED, nil
+	case channeldb.FundingDoubleSpent:
+		return lnrpc.ChannelCloseSummary_FUNDING_DOUBLE_SPENT, nil
+	default:
+		return 0, fmt.Errorf("unknown close type: %v", closeType)
+	}
+}
+
 func rpcChannelResolution(report *channeldb.ResolverReport) (*lnrpc.Resolution,
 	error) {
 
@@ -3834,6 +4280,10 @@ func rpcChannelResolution(report *channeldb.ResolverReport) (*lnrpc.Resolution,
 		res.SweepTxid = report.SpendTxID.String()
 	}
 
+	if !report.Timestamp.IsZero() {
+		res.TimeStamp = report.Timestamp.Unix()
+	}
+
 	switch report.ResolverType {
 	case channeldb.ResolverTypeAnchor:
 		res.ResolutionType = lnrpc.ResolutionType_ANCHOR
@@ -3847,6 +4297,9 @@ func rpcChannelResolution(report *channeldb.ResolverReport) (*lnrpc.Resolution,
 	case channeldb.ResolverTypeCommit:
 		res.ResolutionType = lnrpc.ResolutionType_COMMIT
 
+	case channeldb.ResolverTypeBreach:
+		res.ResolutionType = lnrpc.ResolutionType_BREACH
+
 	default:
 		return nil, fmt.Errorf("unknown resolver type: %v",
 			report.ResolverType)
@@ -4876,6 +5329,25 @@ func (r *rpcServer) LookupInvoice(ctx context.Context,
 	return rpcInvoice, nil
 }
 
+// DeleteInvoice deletes a settled or canceled invoice from the database. Open
+// and accepted invoices need to be canceled first.
+func (r *rpcServer) DeleteInvoice(ctx context.Context,
+	req *lnrpc.DeleteInvoiceRequest) (*lnrpc.DeleteInvoiceResponse, error) {
+
+	payHash, err := lntypes.MakeHash(req.RHash)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[DeleteInvoice] payment_hash=%v", payHash)
+
+	if err := r.server.invoices.DeleteInvoice(payHash); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.DeleteInvoiceResponse{}, nil
+}
+
 // ListInvoices returns a list of all the invoices currently stored within the
 // database. Any active debug invoices are ignored.
 func (r *rpcServer) ListInvoices(ctx context.Context,
@@ -5149,26 +5621,32 @@ func marshalDbEdge(edgeInfo *channeldb.ChannelEdgeInfo,
 	}
 
 	if c1 != nil {
+		inboundFee, _ := c1.InboundFee()
 		edge.Node1Policy = &lnrpc.RoutingPolicy{
-			TimeLockDelta:    uint32(c1.TimeLockDelta),
-			MinHtlc:          int64(c1.MinHTLC),
-			MaxHtlcMsat:      uint64(c1.MaxHTLC),
-			FeeBaseMsat:      int64(c1.FeeBaseMSat),
-			FeeRateMilliMsat: int64(c1.FeeProportionalMillionths),
-			Disabled:         c1.ChannelFlags&lnwire.ChanUpdateDisabled != 0,
-			LastUpdate:       uint32(c1.LastUpdate.Unix()),
+			TimeLockDelta:           uint32(c1.TimeLockDelta),
+			MinHtlc:                 int64(c1.MinHTLC),
+			MaxHtlcMsat:             uint64(c1.MaxHTLC),
+			FeeBaseMsat:             int64(c1.FeeBaseMSat),
+			FeeRateMilliMsat:        int64(c1.FeeProportionalMillionths),
+			Disabled:                c1.ChannelFlags&lnwire.ChanUpdateDisabled != 0,
+			LastUpdate:              uint32(c1.LastUpdate.Unix()),
+			InboundFeeBaseMsat:      inboundFee.Base,
+			InboundFeeRateMilliMsat: inboundFee.Rate,
 		}
 	}
 
 	if c2 != nil {
+		inboundFee, _ := c2.InboundFee()
 		edge.Node2Policy = &lnrpc.RoutingPolicy{
-			TimeLockDelta:    uint32(c2.TimeLockDelta),
-			MinHtlc:          int64(c2.MinHTLC),
-			MaxHtlcMsat:      uint64(c2.MaxHTLC),
-			FeeBaseMsat:      int64(c2.FeeBaseMSat),
-			FeeRateMilliMsat: int64(c2.FeeProportionalMillionths),
-			Disabled:         c2.ChannelFlags&lnwire.ChanUpdateDisabled != 0,
-			LastUpdate:       uint32(c2.LastUpdate.Unix()),
+			TimeLockDelta:           uint32(c2.TimeLockDelta),
+			MinHtlc:                 int64(c2.MinHTLC),
+			MaxHtlcMsat:             uint64(c2.MaxHTLC),
+			FeeBaseMsat:             int64(c2.FeeBaseMSat),
+			FeeRateMilliMsat:        int64(c2.FeeProportionalMillionths),
+			Disabled:                c2.ChannelFlags&lnwire.ChanUpdateDisabled != 0,
+			LastUpdate:              uint32(c2.LastUpdate.Unix()),
+			InboundFeeBaseMsat:      inboundFee.Base,
+			InboundFeeRateMilliMsat: inboundFee.Rate,
 		}
 	}
 
@@ -5686,6 +6164,29 @@ func (r *rpcServer) DeleteAllPayments(ctx context.Context,
 	return &lnrpc.DeleteAllPaymentsResponse{}, nil
 }
 
+// DeletePayment deletes a single outgoing payment from DB. In-flight payments
+// can't be deleted.
+func (r *rpcServer) DeletePayment(ctx context.Context,
+	req *lnrpc.DeletePaymentRequest) (*lnrpc.DeletePaymentResponse, error) {
+
+	paymentHash, err := lntypes.MakeHash(req.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[DeletePayment] payment_hash=%v, failed_htlcs_only=%v",
+		paymentHash, req.FailedHtlcsOnly)
+
+	err = r.server.remoteChanDB.DeletePayment(
+		paymentHash, req.FailedHtlcsOnly,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.DeletePaymentResponse{}, nil
+}
+
 // DebugLevel allows a caller to programmatically set the logging verbosity of
 // lnd. The logging can be targeted according to a coarse daemon-wide logging
 // level, or in a granular fashion to specify the logging for a target
@@ -5823,13 +6324,19 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 		feeRateFixedPoint := edgePolicy.FeeProportionalMillionths
 		feeRate := float64(feeRateFixedPoint) / float64(feeBase)
 
+		// The inbound fee is optional, so a zero fee is reported if
+		// none is set.
+		inboundFee, _ := edgePolicy.InboundFee()
+
 		// TODO(roasbeef): also add stats for revenue for each channel
 		feeReports = append(feeReports, &lnrpc.ChannelFeeReport{
-			ChanId:       chanInfo.ChannelID,
-			ChannelPoint: chanInfo.ChannelPoint.String(),
-			BaseFeeMsat:  int64(edgePolicy.FeeBaseMSat),
-			FeePerMil:    int64(feeRateFixedPoint),
-			FeeRate:      feeRate,
+			ChanId:                  chanInfo.ChannelID,
+			ChannelPoint:            chanInfo.ChannelPoint.String(),
+			BaseFeeMsat:             int64(edgePolicy.FeeBaseMSat),
+			FeePerMil:               int64(feeRateFixedPoint),
+			FeeRate:                 feeRate,
+			InboundFeeBaseMsat:      inboundFee.Base,
+			InboundFeeRateMilliMsat: inboundFee.Rate,
 		})
 
 		return nil
@@ -5989,6 +6496,14 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 		FeeRate: feeRateFixed,
 	}
 
+	// The inbound fee is only updated if it is specified in the request.
+	if req.InboundFee != nil {
+		feeSchema.InboundFee = &channeldb.InboundFee{
+			Base: req.InboundFee.FeeBaseMsat,
+			Rate: req.InboundFee.FeeRateMilliMsat,
+		}
+	}
+
 	maxHtlc := lnwire.MilliSatoshi(req.MaxHtlcMsat)
 	var minHtlc *lnwire.MilliSatoshi
 	if req.MinHtlcMsatSpecified {
@@ -6005,9 +6520,9 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 
 	rpcsLog.Debugf("[updatechanpolicy] updating channel policy base_fee=%v, "+
 		"rate_float=%v, rate_fixed=%v, time_lock_delta: %v, "+
-		"min_htlc=%v, max_htlc=%v, targets=%v",
+		"min_htlc=%v, max_htlc=%v, inbound_fee=%v, targets=%v",
 		req.BaseFeeMsat, req.FeeRate, feeRateFixed, req.TimeLockDelta,
-		minHtlc, maxHtlc,
+		minHtlc, maxHtlc, feeSchema.InboundFee,
 		spew.Sdump(targetChans))
 
 	// With the scope resolved, we'll now send this to the local channel
@@ -6114,6 +6629,185 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 	return resp, nil
 }
 
+// ForwardingHistoryStats returns aggregated statistics of the forwarding
+// history within the target time range. Volume and fees are totaled per
+// channel and per peer, optionally grouped into time buckets, and channels
+// that have been closed are annotated with their close data.
+func (r *rpcServer) ForwardingHistoryStats(ctx context.Context,
+	req *lnrpc.ForwardingHistoryStatsRequest) (
+	*lnrpc.ForwardingHistoryStatsResponse, error) {
+
+	rpcsLog.Debugf("[forwardinghistorystats]")
+
+	// Flush any pending events to disk first, so that the statistics are
+	// based on a complete snapshot.
+	if err := r.server.htlcSwitch.FlushForwardingEvents(); err != nil {
+		return nil, fmt.Errorf("unable to flush forwarding "+
+			"events: %v", err)
+	}
+
+	query := channeldb.ForwardingStatsQuery{
+		StartTime: time.Unix(int64(req.StartTime), 0),
+		EndTime:   time.Now(),
+	}
+	if req.EndTime != 0 {
+		query.EndTime = time.Unix(int64(req.EndTime), 0)
+	}
+
+	switch req.Interval {
+	case lnrpc.ForwardingHistoryStatsRequest_TOTAL:
+
+	case lnrpc.ForwardingHistoryStatsRequest_HOUR:
+		query.Interval = time.Hour
+
+	case lnrpc.ForwardingHistoryStatsRequest_DAY:
+		query.Interval = time.Hour * 24
+
+	case lnrpc.ForwardingHistoryStatsRequest_WEEK:
+		query.Interval = time.Hour * 24 * 7
+
+	default:
+		return nil, fmt.Errorf("unknown interval: %v", req.Interval)
+	}
+
+	buckets, err := r.server.remoteChanDB.ForwardingLog().Stats(query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query forwarding log: %v", err)
+	}
+
+	// To join the statistics with the peers and the close data of the
+	// channels, we'll index all channels that we know of.
+	peers := make(map[lnwire.ShortChannelID]string)
+	openChannels, err := r.server.remoteChanDB.FetchAllChannels()
+	if err != nil {
+		return nil, err
+	}
+	for _, channel := range openChannels {
+		peers[channel.ShortChanID()] = hex.EncodeToString(
+			channel.IdentityPub.SerializeCompressed(),
+		)
+	}
+
+	closedChannels, err := r.server.remoteChanDB.FetchClosedChannels(false)
+	if err != nil {
+		return nil, err
+	}
+	closed := make(map[lnwire.ShortChannelID]*channeldb.ChannelCloseSummary)
+	for _, channel := range closedChannels {
+		closed[channel.ShortChanID] = channel
+		peers[channel.ShortChanID] = hex.EncodeToString(
+			channel.RemotePub.SerializeCompressed(),
+		)
+	}
+
+	resp := &lnrpc.ForwardingHistoryStatsResponse{
+		Buckets: make([]*lnrpc.ForwardingStatsBucket, len(buckets)),
+	}
+	for i, bucket := range buckets {
+		resp.Buckets[i], err = marshallForwardingStatsBucket(
+			bucket, peers, closed,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// marshallForwardingStatsBucket converts a bucket of forwarding statistics to
+// its rpc representation, joined with the given peers and close summaries of
+// the channels.
+func marshallForwardingStatsBucket(bucket *channeldb.ForwardingStatsBucket,
+	peers map[lnwire.ShortChannelID]string,
+	closed map[lnwire.ShortChannelID]*channeldb.ChannelCloseSummary) (
+	*lnrpc.ForwardingStatsBucket, error) {
+
+	rpcBucket := &lnrpc.ForwardingStatsBucket{
+		StartTime:   uint64(bucket.StartTime.Unix()),
+		EndTime:     uint64(bucket.EndTime.Unix()),
+		NumForwards: bucket.NumForwards,
+		AmtInMsat:   uint64(bucket.AmtIn),
+		AmtOutMsat:  uint64(bucket.AmtOut),
+		FeeMsat:     uint64(bucket.AmtIn - bucket.AmtOut),
+	}
+
+	peerStats := make(map[string]*lnrpc.ForwardingStats)
+	for chanID, stats := range bucket.Channels {
+		rpcChannel := &lnrpc.ChannelForwardingStats{
+			ChanId:       chanID.ToUint64(),
+			RemotePubkey: peers[chanID],
+			Stats:        marshallForwardingStats(stats),
+		}
+
+		if summary, ok := closed[chanID]; ok {
+			closeType, err := rpcCloseType(summary.CloseType)
+			if err != nil {
+				return nil, err
+			}
+
+			rpcChannel.Closed = true
+			rpcChannel.CloseType = closeType
+			rpcChannel.ClosingTxHash = summary.ClosingTXID.String()
+			rpcChannel.CloseHeight = summary.CloseHeight
+		}
+
+		rpcBucket.Channels = append(rpcBucket.Channels, rpcChannel)
+
+		// Channels of which we don't know the peer can't be included
+		// in the peer totals.
+		if rpcChannel.RemotePubkey == "" {
+			continue
+		}
+
+		peer, ok := peerStats[rpcChannel.RemotePubkey]
+		if !ok {
+			peer = &lnrpc.ForwardingStats{}
+			peerStats[rpcChannel.RemotePubkey] = peer
+		}
+		peer.NumIncoming += stats.NumIncoming
+		peer.AmtInMsat += uint64(stats.AmtIn)
+		peer.FeeInMsat += uint64(stats.FeeIn)
+		peer.NumOutgoing += stats.NumOutgoing
+		peer.AmtOutMsat += uint64(stats.AmtOut)
+		peer.FeeOutMsat += uint64(stats.FeeOut)
+	}
+
+	for pubKey, stats := range peerStats {
+		rpcBucket.Peers = append(rpcBucket.Peers, &lnrpc.PeerForwardingStats{
+			RemotePubkey: pubKey,
+			Stats:        stats,
+		})
+	}
+
+	// Sort the channels and peers to obtain a deterministic response.
+	sort.Slice(rpcBucket.Channels, func(i, j int) bool {
+		return rpcBucket.Channels[i].ChanId <
+			rpcBucket.Channels[j].ChanId
+	})
+	sort.Slice(rpcBucket.Peers, func(i, j int) bool {
+		return rpcBucket.Peers[i].RemotePubkey <
+			rpcBucket.Peers[j].RemotePubkey
+	})
+
+	return rpcBucket, nil
+}
+
+// marshallForwardingStats converts forwarding totals to their rpc
+// representation.
+func marshallForwardingStats(
+	stats *channeldb.ForwardingStats) *lnrpc.ForwardingStats {
+
+	return &lnrpc.ForwardingStats{
+		NumIncoming: stats.NumIncoming,
+		AmtInMsat:   uint64(stats.AmtIn),
+		FeeInMsat:   uint64(stats.FeeIn),
+		NumOutgoing: stats.NumOutgoing,
+		AmtOutMsat:  uint64(stats.AmtOut),
+		FeeOutMsat:  uint64(stats.FeeOut),
+	}
+}
+
 // ExportChannelBackup attempts to return an encrypted static channel backup
 // for the target channel identified by it channel point. The backup is
 // encrypted with a key generated from the aezeed seed of the user. The
@@ -6742,7 +7436,7 @@ func (r *rpcServer) FundingStateStep(ctx context.Context,
 		}
 
 		err = r.server.cc.Wallet.PsbtFundingVerify(
-			pendingChanID, packet,
+			pendingChanID, packet, in.GetPsbtVerify().SkipFinalize,
 		)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,106 @@
+package lnd
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/sweep"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPackageFeeRate asserts that the effective fee rate of a commitment that
+// is bumped through its anchor is the fee rate of the package made up of the
+// commitment and the anchor sweep, and that commitments whose anchors aren't
+// swept are ignored.
+func TestPackageFeeRate(t *testing.T) {
+	t.Parallel()
+
+	newCommit := func(lockTime uint32,
+		fee btcutil.Amount) *channeldb.ChannelCommitment {
+
+		pkScript := make([]byte, 34)
+		return &channeldb.ChannelCommitment{
+			CommitTx: &wire.MsgTx{
+				TxIn: []*wire.TxIn{{}},
+				TxOut: []*wire.TxOut{
+					{Value: 330, PkScript: pkScript},
+					{Value: 1000, PkScript: pkScript},
+				},
+				LockTime: lockTime,
+			},
+			CommitFee: fee,
+		}
+	}
+
+	// The local commitment pays a high fee rate on its own, while the
+	// remote commitment pays a low one.
+	localCommit := newCommit(1, 10000)
+	remoteCommit := newCommit(2, 500)
+	commits := []*channeldb.ChannelCommitment{localCommit, remoteCommit}
+
+	anchor := func(commit *channeldb.ChannelCommitment) wire.OutPoint {
+		return wire.OutPoint{Hash: commit.CommitTx.TxHash()}
+	}
+
+	// Without any anchor sweeps, no commitment is bumped.
+	_, ok := packageFeeRate(commits, nil)
+	require.False(t, ok)
+
+	// An anchor sweep that wasn't published yet doesn't count either, and
+	// neither do sweeps of other outputs of the commitment.
+	sweeps := map[wire.OutPoint]*sweep.PendingInput{
+		anchor(remoteCommit): {
+			WitnessType: input.CommitmentAnchor,
+		},
+		{Hash: remoteCommit.CommitTx.TxHash(), Index: 1}: {
+			WitnessType:       input.CommitmentToRemoteConfirmed,
+			BroadcastAttempts: 1,
+			LastSweepFee:      100000,
+			LastSweepWeight:   500,
+		},
+		{Hash: chainhash.Hash{1}}: {
+			WitnessType:       input.CommitmentAnchor,
+			BroadcastAttempts: 1,
+			LastSweepFee:      100000,
+			LastSweepWeight:   500,
+		},
+	}
+	_, ok = packageFeeRate(commits, sweeps)
+	require.False(t, ok)
+
+	// Once the sweep of the remote anchor is published, the fee rate of
+	// the package made up of the remote commitment and the sweep is
+	// reported. The local commitment isn't bumped, so its own higher fee
+	// rate is ignored.
+	sweeps[anchor(remoteCommit)] = &sweep.PendingInput{
+		WitnessType:       input.CommitmentAnchor,
+		BroadcastAttempts: 1,
+		LastSweepFee:      4000,
+		LastSweepWeight:   700,
+	}
+	feeRate, ok := packageFeeRate(commits, sweeps)
+	require.True(t, ok)
+
+	packageWeight := commitWeight(remoteCommit) + 700
+	require.Equal(
+		t, chainfee.SatPerKWeight(4500*1000/packageWeight), feeRate,
+	)
+	require.Less(t, int64(feeRate), int64(commitFeeRate(localCommit)))
+
+	// If the bumped commitment pays a higher fee rate on its own than the
+	// package, it's mined on its own at that fee rate.
+	sweeps[anchor(localCommit)] = &sweep.PendingInput{
+		WitnessType:       input.CommitmentAnchor,
+		BroadcastAttempts: 1,
+		LastSweepFee:      200,
+		LastSweepWeight:   700,
+	}
+	feeRate, ok = packageFeeRate(commits, sweeps)
+	require.True(t, ok)
+	require.Equal(t, commitFeeRate(localCommit), feeRate)
+}
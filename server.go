@@ -23,6 +23,7 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/go-errors/errors"
 	sphinx "github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/autopilot"
@@ -58,6 +59,7 @@ import (
 	"github.com/lightningnetwork/lnd/peer"
 	"github.com/lightningnetwork/lnd/peernotifier"
 	"github.com/lightningnetwork/lnd/pool"
+	"github.com/lightningnetwork/lnd/pruner"
 	"github.com/lightningnetwork/lnd/queue"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/routing/localchans"
@@ -244,16 +246,20 @@ type server struct {
 
 	chanRouter *routing.ChannelRouter
 
+	// trampolineForwarder forwards payments on behalf of lightweight
+	// senders. It is nil if trampoline forwarding is disabled.
+	trampolineForwarder *routing.TrampolineForwarder
+
 	controlTower routing.ControlTower
 
 	authGossiper *discovery.AuthenticatedGossiper
 
 	localChanMgr *localchans.Manager
 
-	utxoNursery *utxoNursery
-
 	sweeper *sweep.UtxoSweeper
 
+	consolidator *sweep.Consolidator
+
 	chainArb *contractcourt.ChainArbitrator
 
 	sphinx *hop.OnionProcessor
@@ -292,6 +298,10 @@ type server struct {
 	// provide insights into their health and performance.
 	chanEventStore *chanfitness.ChannelEventStore
 
+	// pruner periodically deletes old payments and invoices. It is nil if
+	// pruning is disabled.
+	pruner *pruner.Pruner
+
 	hostAnn *netann.HostAnnouncer
 
 	// livelinessMonitor monitors that lnd has access to critical resources.
@@ -386,12 +396,18 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 	copy(serializedPubKey[:], nodeKeyECDH.PubKey().SerializeCompressed())
 
 	// Initialize the sphinx router, placing it's persistent replay log in
-	// the same directory as the channel graph database. We don't need to
-	// replicate this data, so we'll store it locally.
-	replayLog := htlcswitch.NewDecayedLog(
-		cfg.localDatabaseDir(), defaultSphinxDbName, cfg.DB.Bolt,
-		cc.ChainNotifier,
+	// the same backend as the channel database. Older versions stored the
+	// replay log in its own file next to the channel graph database, so
+	// we'll first copy any entries from there.
+	err = htlcswitch.MigrateDecayedLogFile(
+		cfg.localDatabaseDir(), defaultSphinxDbName,
+		cfg.DB.Bolt.DBTimeout, remoteChanDB,
 	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to migrate sphinx replay "+
+			"log: %v", err)
+	}
+	replayLog := htlcswitch.NewDecayedLog(remoteChanDB, cc.ChainNotifier)
 	sphinxRouter := sphinx.NewRouter(
 		nodeKeyECDH, cfg.ActiveNetParams.Params, replayLog,
 	)
@@ -419,6 +435,8 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		NoStaticRemoteKey: cfg.ProtocolOptions.NoStaticRemoteKey(),
 		NoAnchors:         cfg.ProtocolOptions.NoAnchorCommitments(),
 		NoWumbo:           !cfg.ProtocolOptions.Wumbo(),
+		NoTrampoline:      !cfg.Trampoline.Active,
+		NoZeroConf:        !cfg.ProtocolOptions.ZeroConf(),
 	})
 	if err != nil {
 		return nil, err
@@ -445,7 +463,10 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		chansToRestore: chansToRestore,
 
 		invoices: invoices.NewRegistry(
-			remoteChanDB, invoices.NewInvoiceExpiryWatcher(clock.NewDefaultClock()),
+			remoteChanDB, invoices.NewInvoiceExpiryWatcher(
+				clock.NewDefaultClock(), cfg.HoldExpiryDelta,
+				cc.ChainNotifier,
+			),
 			&registryConfig,
 		),
 
@@ -491,7 +512,12 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		return nil, err
 	}
 
-	s.htlcNotifier = htlcswitch.NewHtlcNotifier(time.Now)
+	// Htlc events are only persisted if the htlc event log isn't disabled.
+	var htlcEventLog htlcswitch.HtlcEventLog
+	if cfg.HtlcEventLogSize > 0 {
+		htlcEventLog = remoteChanDB.HtlcEventLog(cfg.HtlcEventLogSize)
+	}
+	s.htlcNotifier = htlcswitch.NewHtlcNotifier(time.Now, htlcEventLog)
 
 	s.htlcSwitch, err = htlcswitch.New(htlcswitch.Config{
 		DB: remoteChanDB,
@@ -526,7 +552,14 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 	if err != nil {
 		return nil, err
 	}
-	s.interceptableSwitch = htlcswitch.NewInterceptableSwitch(s.htlcSwitch)
+	s.interceptableSwitch = htlcswitch.NewInterceptableSwitch(
+		&htlcswitch.InterceptableSwitchConfig{
+			Switch:             s.htlcSwitch,
+			Notifier:           s.cc.ChainNotifier,
+			CltvRejectDelta:    lncfg.DefaultFinalCltvRejectDelta,
+			RequireInterceptor: cfg.RequireInterceptor,
+		},
+	)
 
 	chanStatusMgrCfg := &netann.ChanStatusConfig{
 		ChanStatusSampleInterval: cfg.ChanStatusSampleInterval,
@@ -792,6 +825,27 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		return nil, fmt.Errorf("can't create router: %v", err)
 	}
 
+	if cfg.Trampoline.Active {
+		s.trampolineForwarder = routing.NewTrampolineForwarder(
+			&routing.TrampolineForwarderConfig{
+				SendPayment: s.chanRouter.SendPaymentAsync,
+				Control:     s.controlTower,
+				NodeKeyECDH: s.identityECDH,
+				Clock:       clock.NewDefaultClock(),
+				MppTimeout:  invoices.DefaultHtlcHoldDuration,
+				BaseFee: lnwire.MilliSatoshi(
+					cfg.Trampoline.BaseFeeMsat,
+				),
+				FeeRate: lnwire.MilliSatoshi(
+					cfg.Trampoline.FeeRatePPM,
+				),
+				CltvDelta:         cfg.Trampoline.CltvDelta,
+				MaxParts:          cfg.Trampoline.MaxParts,
+				PayAttemptTimeout: cfg.Trampoline.PaymentTimeout,
+			},
+		)
+	}
+
 	chanSeries := discovery.NewChanSeries(s.localChanDB.ChannelGraph())
 	gossipMessageStore, err := discovery.NewMessageStore(s.remoteChanDB)
 	if err != nil {
@@ -840,18 +894,10 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		FetchChannel:              s.remoteChanDB.FetchChannel,
 	}
 
-	utxnStore, err := newNurseryStore(s.cfg.ActiveNetParams.GenesisHash, remoteChanDB)
-	if err != nil {
-		srvrLog.Errorf("unable to create nursery store: %v", err)
-		return nil, err
-	}
-
 	srvrLog.Tracef("Sweeper batch window duration: %v",
 		sweep.DefaultBatchWindowDuration)
 
-	sweeperStore, err := sweep.NewSweeperStore(
-		remoteChanDB, s.cfg.ActiveNetParams.GenesisHash,
-	)
+	sweeperStore, err := sweep.NewSweeperStore(remoteChanDB)
 	if err != nil {
 		srvrLog.Errorf("unable to create sweeper store: %v", err)
 		return nil, err
@@ -874,16 +920,64 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		FeeRateBucketSize:    sweep.DefaultFeeRateBucketSize,
 	})
 
-	s.utxoNursery = newUtxoNursery(&NurseryConfig{
-		ChainIO:             cc.ChainIO,
-		ConfDepth:           1,
-		FetchClosedChannels: remoteChanDB.FetchClosedChannels,
-		FetchClosedChannel:  remoteChanDB.FetchClosedChannel,
-		Notifier:            cc.ChainNotifier,
-		PublishTransaction:  cc.Wallet.PublishTransaction,
-		Store:               utxnStore,
-		SweepInput:          s.sweeper.SweepInput,
-	})
+	consolidationPolicy := sweep.ConsolidationPolicy{
+		MaxUtxoValue: btcutil.Amount(cfg.Consolidation.MaxUtxoValue),
+		MinUtxos:     int(cfg.Consolidation.MinUtxos),
+		Reserve:      btcutil.Amount(cfg.Consolidation.Reserve),
+	}
+	maxConsolidationFeeRate := chainfee.SatPerKVByte(
+		cfg.Consolidation.MaxFeeRate * 1000,
+	).FeePerKWeight()
+
+	consolidationCfg := &sweep.ConsolidatorConfig{
+		Policy:             consolidationPolicy,
+		AutoConsolidate:    cfg.Consolidation.Active,
+		MaxFeeRate:         maxConsolidationFeeRate,
+		ConfTarget:         cfg.Consolidation.ConfTarget,
+		NetParams:          s.cfg.ActiveNetParams.Params,
+		DustLimit:          lnwallet.DefaultDustLimit(),
+		Store:              sweeperStore,
+		FeeEstimator:       cc.FeeEstimator,
+		Notifier:           cc.ChainNotifier,
+		CoinSelectLocker:   cc.Wallet,
+		UtxoSource:         cc.Wallet,
+		OutpointLocker:     cc.Wallet.WalletController,
+		Signer:             cc.Wallet.Cfg.Signer,
+		CheckReservedValue: cc.Wallet.CheckReservedValueTx,
+		PublishTransaction: cc.Wallet.PublishTransaction,
+	}
+
+	// Consolidations pay either to a fixed cold storage address, or to
+	// fresh addresses derived from the xpub of a cold storage wallet.
+	switch {
+	case cfg.Consolidation.Addr != "":
+		addr, err := btcutil.DecodeAddress(
+			cfg.Consolidation.Addr, s.cfg.ActiveNetParams.Params,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid consolidation addr: %v",
+				err)
+		}
+		if !addr.IsForNet(s.cfg.ActiveNetParams.Params) {
+			return nil, fmt.Errorf("consolidation addr %v is not "+
+				"for the active network", addr)
+		}
+		consolidationCfg.DeliveryAddr = addr
+
+	case cfg.Consolidation.XPub != "":
+		xpub, err := hdkeychain.NewKeyFromString(cfg.Consolidation.XPub)
+		if err != nil {
+			return nil, fmt.Errorf("invalid consolidation xpub: %v",
+				err)
+		}
+		if !xpub.IsForNet(s.cfg.ActiveNetParams.Params) {
+			return nil, fmt.Errorf("consolidation xpub is not " +
+				"for the active network")
+		}
+		consolidationCfg.DeliveryXPub = xpub
+	}
+
+	s.consolidator = sweep.NewConsolidator(consolidationCfg)
 
 	// Construct a closure that wraps the htlcswitch's CloseLink method.
 	closeLink := func(chanPoint *wire.OutPoint,
@@ -894,17 +988,31 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		// Instruct the switch to close the channel.  Provide no close out
 		// delivery script or target fee per kw because user input is not
 		// available when the remote peer closes the channel.
-		s.htlcSwitch.CloseLink(chanPoint, closureType, 0, nil)
+		s.htlcSwitch.CloseLink(chanPoint, closureType, 0, 0, 0, nil)
 	}
 
 	// We will use the following channel to reliably hand off contract
 	// breach events from the ChannelArbitrator to the breachArbiter,
 	contractBreaches := make(chan *ContractBreachEvent, 1)
 
+	// Channels with a force close override get their own broadcast policy.
+	broadcastPolicies := make(
+		map[wire.OutPoint]contractcourt.BroadcastPolicy,
+	)
+	for chanPoint, policy := range cfg.ForceClose.Channels {
+		broadcastPolicies[chanPoint] = contractcourt.BroadcastPolicy{
+			IncomingBroadcastDelta: policy.IncomingBroadcastDelta,
+			OutgoingBroadcastDelta: policy.OutgoingBroadcastDelta,
+			MinHtlcValue:           policy.MinHtlcValue,
+		}
+	}
+
 	s.chainArb = contractcourt.NewChainArbitrator(contractcourt.ChainArbitratorConfig{
 		ChainHash:              *s.cfg.ActiveNetParams.GenesisHash,
-		IncomingBroadcastDelta: lncfg.DefaultIncomingBroadcastDelta,
-		OutgoingBroadcastDelta: lncfg.DefaultOutgoingBroadcastDelta,
+		IncomingBroadcastDelta: cfg.ForceClose.IncomingBroadcastDelta,
+		OutgoingBroadcastDelta: cfg.ForceClose.OutgoingBroadcastDelta,
+		MinBroadcastHtlcValue:  cfg.ForceClose.Policy().MinHtlcValue,
+		BroadcastPolicies:      broadcastPolicies,
 		NewSweepAddr:           newSweepPkScriptGen(cc.Wallet),
 		PublishTx:              cc.Wallet.PublishTransaction,
 		DeliverResolutionMsg: func(msgs ...contractcourt.ResolutionMsg) error {
@@ -916,27 +1024,6 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 			}
 			return nil
 		},
-		IncubateOutputs: func(chanPoint wire.OutPoint,
-			outHtlcRes *lnwallet.OutgoingHtlcResolution,
-			inHtlcRes *lnwallet.IncomingHtlcResolution,
-			broadcastHeight uint32) error {
-
-			var (
-				inRes  []lnwallet.IncomingHtlcResolution
-				outRes []lnwallet.OutgoingHtlcResolution
-			)
-			if inHtlcRes != nil {
-				inRes = append(inRes, *inHtlcRes)
-			}
-			if outHtlcRes != nil {
-				outRes = append(outRes, *outHtlcRes)
-			}
-
-			return s.utxoNursery.IncubateOutputs(
-				chanPoint, outRes, inRes,
-				broadcastHeight,
-			)
-		},
 		PreimageDB:   s.witnessBeacon,
 		Notifier:     cc.ChainNotifier,
 		Signer:       cc.Wallet.Cfg.Signer,
@@ -947,7 +1034,8 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 			s.htlcSwitch.RemoveLink(chanID)
 			return nil
 		},
-		IsOurAddress: cc.Wallet.IsOurAddress,
+		IsOurAddress:   cc.Wallet.IsOurAddress,
+		FetchInputInfo: cc.Wallet.FetchInputInfo,
 		ContractBreach: func(chanPoint wire.OutPoint,
 			breachRet *lnwallet.BreachRetribution) error {
 			event := &ContractBreachEvent{
@@ -980,6 +1068,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		OnionProcessor:                s.sphinx,
 		PaymentsExpirationGracePeriod: cfg.PaymentsExpirationGracePeriod,
 		IsForwardedHTLC:               s.htlcSwitch.IsForwardedHTLC,
+		IncomingHTLCExpiry:            s.htlcSwitch.IncomingHTLCExpiry,
 		Clock:                         clock.NewDefaultClock(),
 	}, remoteChanDB)
 
@@ -1163,10 +1252,21 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 			// the chain arb so it can react to on-chain events.
 			return s.chainArb.WatchNewChannel(channel)
 		},
+		SubscribeChainEvents: s.chainArb.SubscribeChannelEvents,
 		ReportShortChanID: func(chanPoint wire.OutPoint) error {
 			cid := lnwire.NewChanIDFromOutPoint(&chanPoint)
 			return s.htlcSwitch.UpdateShortChanID(cid)
 		},
+		FailUnconfirmedChannel: s.chainArb.FailUnconfirmedChannel,
+		DeleteAliasEdge: func(scid lnwire.ShortChannelID) error {
+			err := s.localChanDB.ChannelGraph().DeleteChannelEdges(
+				scid.ToUint64(),
+			)
+			if err == channeldb.ErrEdgeNotFound {
+				return nil
+			}
+			return err
+		},
 		RequiredRemoteChanReserve: func(chanAmt,
 			dustLimit btcutil.Amount) btcutil.Amount {
 
@@ -1254,6 +1354,17 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		FlapCountTicker: ticker.New(chanfitness.FlapCountFlushRate),
 	})
 
+	if cfg.Prune.Active() {
+		s.pruner = pruner.New(&pruner.Config{
+			PaymentAge:     cfg.Prune.PaymentAge,
+			InvoiceAge:     cfg.Prune.InvoiceAge,
+			DeletePayments: s.remoteChanDB.DeleteResolvedPayments,
+			DeleteInvoices: s.remoteChanDB.DeleteResolvedInvoices,
+			Ticker:         ticker.New(cfg.Prune.Interval),
+			Clock:          clock.NewDefaultClock(),
+		})
+	}
+
 	if cfg.WtClient.Active {
 		policy := wtpolicy.DefaultPolicy()
 
@@ -1529,11 +1640,15 @@ func (s *server) Start() error {
 			startErr = err
 			return
 		}
+		if err := s.interceptableSwitch.Start(); err != nil {
+			startErr = err
+			return
+		}
 		if err := s.sweeper.Start(); err != nil {
 			startErr = err
 			return
 		}
-		if err := s.utxoNursery.Start(); err != nil {
+		if err := s.consolidator.Start(); err != nil {
 			startErr = err
 			return
 		}
@@ -1571,6 +1686,13 @@ func (s *server) Start() error {
 			return
 		}
 
+		if s.pruner != nil {
+			if err := s.pruner.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
 		// Before we start the connMgr, we'll check to see if we have
 		// any backups to recover. We do this now as we want to ensure
 		// that have all the information we need to handle channel
@@ -1719,13 +1841,17 @@ func (s *server) Stop() error {
 		if err := s.cc.ChainNotifier.Stop(); err != nil {
 			srvrLog.Warnf("Unable to stop ChainNotifier: %v", err)
 		}
+		if s.trampolineForwarder != nil {
+			s.trampolineForwarder.Stop()
+		}
 		s.chanRouter.Stop()
+		s.interceptableSwitch.Stop()
 		s.htlcSwitch.Stop()
 		s.sphinx.Stop()
-		s.utxoNursery.Stop()
 		s.breachArbiter.Stop()
 		s.authGossiper.Stop()
 		s.chainArb.Stop()
+		s.consolidator.Stop()
 		s.sweeper.Stop()
 		s.channelNotifier.Stop()
 		s.peerNotifier.Stop()
@@ -1744,6 +1870,11 @@ func (s *server) Stop() error {
 		s.fundingMgr.Stop()
 		s.chanSubSwapper.Stop()
 		s.chanEventStore.Stop()
+		if s.pruner != nil {
+			if err := s.pruner.Stop(); err != nil {
+				srvrLog.Warnf("Unable to stop pruner: %v", err)
+			}
+		}
 
 		// Disconnect from each active peers to ensure that
 		// peerTerminationWatchers signal completion to each peer.
@@ -3149,12 +3280,21 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 		CoopCloseTargetConfs:    s.cfg.CoopCloseTargetConfs,
 		MaxAnchorsCommitFeeRate: chainfee.SatPerKVByte(
 			s.cfg.MaxCommitFeeRateAnchors * 1000).FeePerKWeight(),
+		MaxDustExposure: lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(s.cfg.MaxDustExposure),
+		),
 		Quit: s.quit,
 	}
 
 	copy(pCfg.PubKeyBytes[:], peerAddr.IdentityKey.SerializeCompressed())
 	copy(pCfg.ServerPubKey[:], s.identityECDH.PubKey().SerializeCompressed())
 
+	// Only hand the trampoline forwarder to the peer if forwarding is
+	// enabled, so that the links see a nil interface otherwise.
+	if s.trampolineForwarder != nil {
+		pCfg.TrampolineForwarder = s.trampolineForwarder
+	}
+
 	p := peer.NewBrontide(pCfg)
 
 	// TODO(roasbeef): update IP address for link-node
@@ -9,6 +9,7 @@ import (
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/chainreg"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lncfg"
@@ -95,6 +96,8 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 	localChanDB *channeldb.DB,
 	remoteChanDB *channeldb.DB,
 	sweeper *sweep.UtxoSweeper,
+	chainArb *contractcourt.ChainArbitrator,
+	consolidator *sweep.Consolidator,
 	tower *watchtower.Standalone,
 	towerClient wtclient.Client,
 	anchorTowerClient wtclient.Client,
@@ -172,6 +175,12 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 			subCfgValue.FieldByName("ChainParams").Set(
 				reflect.ValueOf(activeNetParams),
 			)
+			subCfgValue.FieldByName("ChainArb").Set(
+				reflect.ValueOf(chainArb),
+			)
+			subCfgValue.FieldByName("Consolidator").Set(
+				reflect.ValueOf(consolidator),
+			)
 
 		case *autopilotrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
@@ -0,0 +1,561 @@
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrNotEnoughUtxos is returned when there are fewer wallet utxos
+	// eligible for consolidation than the policy requires.
+	ErrNotEnoughUtxos = errors.New("not enough utxos to consolidate")
+
+	// ErrNoConsolidationAddr is returned when a consolidation is requested
+	// while neither a cold storage address nor an xpub is configured.
+	ErrNoConsolidationAddr = errors.New("no consolidation address or " +
+		"xpub configured")
+)
+
+// ConsolidationPolicy determines which wallet utxos are merged by a
+// consolidation.
+type ConsolidationPolicy struct {
+	// MaxUtxoValue is the largest value of a utxo that is consolidated.
+	MaxUtxoValue btcutil.Amount
+
+	// MinUtxos is the minimum number of eligible utxos for which a
+	// consolidation is crafted.
+	MinUtxos int
+
+	// Reserve is the wallet balance that is left untouched by a
+	// consolidation, so that it remains available to fee bump anchor
+	// channels.
+	Reserve btcutil.Amount
+}
+
+// ConsolidationPackage is a WalletSweepPackage that additionally carries the
+// wallet utxos that are spent by the consolidation.
+type ConsolidationPackage struct {
+	WalletSweepPackage
+
+	// Utxos are the wallet utxos that are consolidated.
+	Utxos []*lnwallet.Utxo
+}
+
+// selectConsolidationUtxos selects the utxos that are eligible for
+// consolidation under the given policy. Utxos are selected in ascending order
+// of value, while the largest ones are left out until the remaining wallet
+// balance satisfies the reserve.
+func selectConsolidationUtxos(utxos []*lnwallet.Utxo,
+	policy ConsolidationPolicy) []*lnwallet.Utxo {
+
+	var (
+		selected  []*lnwallet.Utxo
+		remaining btcutil.Amount
+	)
+	for _, utxo := range utxos {
+		// Utxos that are too large or that we can't sweep ourselves
+		// are never consolidated, but count towards the reserve.
+		_, err := walletWitnessType(utxo)
+		if err != nil || utxo.Value > policy.MaxUtxoValue {
+			remaining += utxo.Value
+			continue
+		}
+
+		selected = append(selected, utxo)
+	}
+
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].Value < selected[j].Value
+	})
+
+	for len(selected) > 0 && remaining < policy.Reserve {
+		largest := selected[len(selected)-1]
+		selected = selected[:len(selected)-1]
+
+		remaining += largest.Value
+	}
+
+	return selected
+}
+
+// CraftConsolidationTx attempts to craft a ConsolidationPackage which merges
+// the small confirmed utxos of the wallet into a single output paying to the
+// delivery address. Which utxos are consolidated is determined by the policy.
+// If fewer utxos than the policy requires are eligible, ErrNotEnoughUtxos is
+// returned. The consolidation transaction will be crafted with the target fee
+// rate, and will use the utxoSource and outpointLocker as sources for wallet
+// funds. If checkReservedValue is non-nil, it is called with the coin select
+// lock held to make sure that the consolidation leaves enough funds in the
+// wallet to fee bump our anchor channels.
+func CraftConsolidationTx(feeRate chainfee.SatPerKWeight,
+	dustLimit btcutil.Amount, blockHeight uint32,
+	policy ConsolidationPolicy, deliveryAddr btcutil.Address,
+	coinSelectLocker CoinSelectionLocker, utxoSource UtxoSource,
+	outpointLocker OutpointLocker, signer input.Signer,
+	checkReservedValue func(*wire.MsgTx) (btcutil.Amount, error)) (
+	*ConsolidationPackage, error) {
+
+	deliveryPkScript, err := txscript.PayToAddrScript(deliveryAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sweepTx  *wire.MsgTx
+		selected []*lnwallet.Utxo
+	)
+
+	// As with sweeping all coins, no coin selection may take place while
+	// we select the outputs to consolidate, check the reserved value and
+	// lock the outputs. Otherwise a concurrent coin selection could spend
+	// the funds that the reserved value check relied upon.
+	err = coinSelectLocker.WithCoinSelectLock(func() error {
+		utxos, err := utxoSource.ListUnspentWitnessFromDefaultAccount(
+			1, math.MaxInt32,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch wallet utxos: %v",
+				err)
+		}
+
+		sweepTx, selected, err = consolidationTx(
+			utxos, policy, feeRate, dustLimit, blockHeight,
+			deliveryPkScript, signer,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Make sure the consolidation doesn't take the wallet balance
+		// below what is required to fee bump our anchor channels. If
+		// it does, we retry once while reserving the required value.
+		if checkReservedValue != nil {
+			reserved, err := checkReservedValue(sweepTx)
+			if err == lnwallet.ErrReservedValueInvalidated &&
+				reserved > policy.Reserve {
+
+				log.Debugf("Reserved value %v not satisfied "+
+					"after consolidation, retrying with "+
+					"larger reserve", reserved)
+
+				policy.Reserve = reserved
+				sweepTx, selected, err = consolidationTx(
+					utxos, policy, feeRate, dustLimit,
+					blockHeight, deliveryPkScript, signer,
+				)
+				if err != nil {
+					return err
+				}
+
+				_, err = checkReservedValue(sweepTx)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, utxo := range selected {
+			outpointLocker.LockOutpoint(utxo.OutPoint)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll make a function closure that allows the caller to unlock all
+	// selected outputs if the consolidation isn't published.
+	unlockOutputs := func() {
+		for _, utxo := range selected {
+			outpointLocker.UnlockOutpoint(utxo.OutPoint)
+		}
+	}
+
+	return &ConsolidationPackage{
+		WalletSweepPackage: WalletSweepPackage{
+			SweepTx:            sweepTx,
+			CancelSweepAttempt: unlockOutputs,
+		},
+		Utxos: selected,
+	}, nil
+}
+
+// consolidationTx selects the utxos to consolidate under the given policy,
+// and crafts the transaction that merges them into a single output paying to
+// the delivery script.
+func consolidationTx(utxos []*lnwallet.Utxo, policy ConsolidationPolicy,
+	feeRate chainfee.SatPerKWeight, dustLimit btcutil.Amount,
+	blockHeight uint32, deliveryPkScript []byte,
+	signer input.Signer) (*wire.MsgTx, []*lnwallet.Utxo, error) {
+
+	selected := selectConsolidationUtxos(utxos, policy)
+	if len(selected) < policy.MinUtxos || len(selected) == 0 {
+		return nil, nil, ErrNotEnoughUtxos
+	}
+
+	inputsToSweep, err := walletInputs(selected)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sweepTx, err := createSweepTx(
+		inputsToSweep, nil, deliveryPkScript, blockHeight, feeRate,
+		dustLimit, signer,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sweepTx, selected, nil
+}
+
+// ConsolidatorConfig contains the dependencies and the policy of the
+// Consolidator.
+type ConsolidatorConfig struct {
+	// Policy determines which wallet utxos are consolidated.
+	Policy ConsolidationPolicy
+
+	// AutoConsolidate indicates whether consolidations are published
+	// automatically once the fee estimate drops to MaxFeeRate.
+	AutoConsolidate bool
+
+	// MaxFeeRate is the fee rate at or below which consolidations are
+	// published automatically.
+	MaxFeeRate chainfee.SatPerKWeight
+
+	// ConfTarget is the confirmation target used to estimate the fee rate
+	// of automatic consolidations.
+	ConfTarget uint32
+
+	// DeliveryAddr is the cold storage address that consolidations pay
+	// to. It takes precedence over DeliveryXPub.
+	DeliveryAddr btcutil.Address
+
+	// DeliveryXPub is the extended public key from whose external branch
+	// a fresh p2wkh address is derived for every consolidation.
+	DeliveryXPub *hdkeychain.ExtendedKey
+
+	// NetParams are the parameters of the backing chain, used to encode
+	// the addresses derived from DeliveryXPub.
+	NetParams *chaincfg.Params
+
+	// DustLimit is the dust limit of the consolidation output.
+	DustLimit btcutil.Amount
+
+	// Store persists the index of the next address to derive from
+	// DeliveryXPub.
+	Store SweeperStore
+
+	// FeeEstimator is used to decide whether to consolidate
+	// automatically.
+	FeeEstimator chainfee.Estimator
+
+	// Notifier is used to trigger automatic consolidations on new blocks.
+	Notifier chainntnfs.ChainNotifier
+
+	// CoinSelectLocker synchronizes consolidations with all other coin
+	// selection attempts.
+	CoinSelectLocker CoinSelectionLocker
+
+	// UtxoSource lists the wallet utxos to consolidate.
+	UtxoSource UtxoSource
+
+	// OutpointLocker locks the consolidated utxos.
+	OutpointLocker OutpointLocker
+
+	// Signer signs the consolidation transaction.
+	Signer input.Signer
+
+	// CheckReservedValue checks that the given transaction leaves enough
+	// funds in the wallet to fee bump our anchor channels. It returns the
+	// value that needs to be reserved. It is called with the coin select
+	// lock held.
+	CheckReservedValue func(*wire.MsgTx) (btcutil.Amount, error)
+
+	// PublishTransaction publishes the consolidation transaction.
+	PublishTransaction func(*wire.MsgTx, string) error
+}
+
+// Consolidation describes a crafted consolidation transaction.
+type Consolidation struct {
+	// Tx is the consolidation transaction.
+	Tx *wire.MsgTx
+
+	// Addr is the address that the consolidation pays to.
+	Addr btcutil.Address
+
+	// Utxos are the wallet utxos that are consolidated.
+	Utxos []*lnwallet.Utxo
+
+	// Amount is the value of the consolidated output.
+	Amount btcutil.Amount
+
+	// Fee is the fee paid by the consolidation transaction.
+	Fee btcutil.Amount
+
+	// FeeRate is the fee rate the transaction was crafted with.
+	FeeRate chainfee.SatPerKWeight
+
+	// Published indicates whether the transaction was published.
+	Published bool
+}
+
+// Consolidator merges small confirmed wallet utxos into a single output
+// paying to cold storage. Consolidations are published automatically when
+// the fee estimate drops below a threshold, or on request.
+type Consolidator struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *ConsolidatorConfig
+
+	// mu serializes consolidations, so that no two consolidations pay to
+	// the same address derived from the xpub.
+	mu sync.Mutex
+
+	// bestHeight is the height of the best known block.
+	bestHeight uint32 // To be used atomically.
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewConsolidator returns a new Consolidator instance.
+func NewConsolidator(cfg *ConsolidatorConfig) *Consolidator {
+	return &Consolidator{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the process of tracking blocks to consolidate automatically.
+func (c *Consolidator) Start() error {
+	if !atomic.CompareAndSwapUint32(&c.started, 0, 1) {
+		return nil
+	}
+
+	log.Tracef("Consolidator starting")
+
+	blockEpochs, err := c.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return fmt.Errorf("register block epoch ntfn: %v", err)
+	}
+
+	c.wg.Add(1)
+	go func() {
+		defer blockEpochs.Cancel()
+		defer c.wg.Done()
+
+		c.blockHandler(blockEpochs.Epochs)
+	}()
+
+	return nil
+}
+
+// Stop stops the consolidator.
+func (c *Consolidator) Stop() error {
+	if !atomic.CompareAndSwapUint32(&c.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Debugf("Consolidator shutting down")
+
+	close(c.quit)
+	c.wg.Wait()
+
+	log.Debugf("Consolidator shut down")
+
+	return nil
+}
+
+// blockHandler tracks the best height and attempts an automatic
+// consolidation on every new block.
+func (c *Consolidator) blockHandler(epochs <-chan *chainntnfs.BlockEpoch) {
+	for {
+		select {
+		case epoch, ok := <-epochs:
+			if !ok {
+				return
+			}
+
+			atomic.StoreUint32(&c.bestHeight, uint32(epoch.Height))
+
+			if c.cfg.AutoConsolidate {
+				c.autoConsolidate()
+			}
+
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// autoConsolidate publishes a consolidation if the fee estimate for the
+// configured confirmation target is at or below the threshold.
+func (c *Consolidator) autoConsolidate() {
+	feeRate, err := c.cfg.FeeEstimator.EstimateFeePerKW(c.cfg.ConfTarget)
+	if err != nil {
+		log.Errorf("Unable to estimate consolidation fee rate: %v", err)
+		return
+	}
+
+	if feeRate > c.cfg.MaxFeeRate {
+		log.Tracef("Fee rate %v above consolidation threshold %v",
+			feeRate, c.cfg.MaxFeeRate)
+		return
+	}
+
+	consolidation, err := c.Consolidate(feeRate, false)
+	switch {
+	case err == ErrNotEnoughUtxos:
+		log.Tracef("Not enough utxos to consolidate")
+
+	case err != nil:
+		log.Errorf("Unable to consolidate utxos: %v", err)
+
+	default:
+		log.Infof("Consolidated %v utxos into %v paying to %v "+
+			"(txid=%v)", len(consolidation.Utxos),
+			consolidation.Amount, consolidation.Addr,
+			consolidation.Tx.TxHash())
+	}
+}
+
+// FeeRate maps the fee preference of a consolidation to a fee rate. If no
+// preference is given, the fee rate is estimated for the configured
+// confirmation target.
+func (c *Consolidator) FeeRate(
+	feePref FeePreference) (chainfee.SatPerKWeight, error) {
+
+	if feePref.FeeRate == 0 && feePref.ConfTarget == 0 {
+		feePref.ConfTarget = c.cfg.ConfTarget
+	}
+
+	return DetermineFeePerKw(c.cfg.FeeEstimator, feePref)
+}
+
+// Consolidate crafts a consolidation at the given fee rate. If dryRun is
+// true, the consolidation is only previewed and the selected utxos are
+// released again. Otherwise the consolidation transaction is published.
+func (c *Consolidator) Consolidate(feeRate chainfee.SatPerKWeight,
+	dryRun bool) (*Consolidation, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	addr, index, err := c.deliveryAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	height := atomic.LoadUint32(&c.bestHeight)
+
+	pkg, err := CraftConsolidationTx(
+		feeRate, c.cfg.DustLimit, height, c.cfg.Policy, addr,
+		c.cfg.CoinSelectLocker, c.cfg.UtxoSource, c.cfg.OutpointLocker,
+		c.cfg.Signer, c.cfg.CheckReservedValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var totalInput btcutil.Amount
+	for _, utxo := range pkg.Utxos {
+		totalInput += utxo.Value
+	}
+	amount := btcutil.Amount(pkg.SweepTx.TxOut[0].Value)
+
+	consolidation := &Consolidation{
+		Tx:      pkg.SweepTx,
+		Addr:    addr,
+		Utxos:   pkg.Utxos,
+		Amount:  amount,
+		Fee:     totalInput - amount,
+		FeeRate: feeRate,
+	}
+
+	if dryRun {
+		pkg.CancelSweepAttempt()
+
+		return consolidation, nil
+	}
+
+	log.Debugf("Publishing consolidation of %v utxos paying %v to %v",
+		len(pkg.Utxos), amount, addr)
+
+	label := labels.MakeLabel(labels.LabelTypeConsolidation, nil)
+	if err := c.cfg.PublishTransaction(pkg.SweepTx, label); err != nil {
+		pkg.CancelSweepAttempt()
+
+		return nil, err
+	}
+	consolidation.Published = true
+
+	// Once published, the address derived from the xpub is used and the
+	// next consolidation derives a fresh one.
+	if c.cfg.DeliveryAddr == nil {
+		err := c.cfg.Store.PutConsolidationIndex(index + 1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return consolidation, nil
+}
+
+// deliveryAddr returns the address the next consolidation pays to. If the
+// address is derived from the xpub, its index is returned as well.
+func (c *Consolidator) deliveryAddr() (btcutil.Address, uint32, error) {
+	if c.cfg.DeliveryAddr != nil {
+		return c.cfg.DeliveryAddr, 0, nil
+	}
+
+	if c.cfg.DeliveryXPub == nil {
+		return nil, 0, ErrNoConsolidationAddr
+	}
+
+	index, err := c.cfg.Store.FetchConsolidationIndex()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Derive the address from the external branch of the xpub, as is
+	// done for receive addresses by wallets following BIP 44.
+	external, err := c.cfg.DeliveryXPub.Derive(0)
+	if err != nil {
+		return nil, 0, err
+	}
+	child, err := external.Derive(index)
+	if err != nil {
+		return nil, 0, err
+	}
+	pubKey, err := child.ECPubKey()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(pubKey.SerializeCompressed()), c.cfg.NetParams,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return addr, index, nil
+}
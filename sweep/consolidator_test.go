@@ -0,0 +1,251 @@
+package sweep
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/lightningnetwork/lnd/lntest/mock"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// testXPub is the master key of the first BIP 32 test vector.
+const testXPub = "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY" +
+	"2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+
+// makeConsolidationUtxos returns a set of wallet utxos of which the first three
+// are small enough to be consolidated.
+func makeConsolidationUtxos() []*lnwallet.Utxo {
+	makeUtxo := func(index uint32, addrType lnwallet.AddressType,
+		value btcutil.Amount) *lnwallet.Utxo {
+
+		return &lnwallet.Utxo{
+			AddressType: addrType,
+			PkScript:    testUtxos[0].PkScript,
+			Value:       value,
+			OutPoint: wire.OutPoint{
+				Index: index,
+			},
+		}
+	}
+
+	return []*lnwallet.Utxo{
+		makeUtxo(1, lnwallet.WitnessPubKey, 3000),
+		makeUtxo(2, lnwallet.WitnessPubKey, 1000),
+		makeUtxo(3, lnwallet.NestedWitnessPubKey, 2000),
+		makeUtxo(4, lnwallet.WitnessPubKey, 500000),
+		makeUtxo(5, lnwallet.UnknownAddressType, 4000),
+	}
+}
+
+// TestCraftConsolidationTx asserts that only small utxos are consolidated,
+// that the reserve is left in the wallet and that no consolidation is crafted
+// for too few utxos.
+func TestCraftConsolidationTx(t *testing.T) {
+	t.Parallel()
+
+	utxos := makeConsolidationUtxos()
+
+	tests := []struct {
+		name          string
+		policy        ConsolidationPolicy
+		expectedUtxos []*lnwallet.Utxo
+		expectedValue int64
+	}{
+		{
+			name: "consolidate small utxos",
+			policy: ConsolidationPolicy{
+				MaxUtxoValue: 10000,
+				MinUtxos:     2,
+			},
+			expectedUtxos: utxos[:3],
+			expectedValue: 6000,
+		},
+		{
+			name: "leave reserve",
+			policy: ConsolidationPolicy{
+				MaxUtxoValue: 10000,
+				MinUtxos:     2,
+				Reserve:      506000,
+			},
+			expectedUtxos: utxos[1:3],
+			expectedValue: 3000,
+		},
+		{
+			name: "not enough utxos",
+			policy: ConsolidationPolicy{
+				MaxUtxoValue: 10000,
+				MinUtxos:     3,
+				Reserve:      506000,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			utxoLocker := newMockOutpointLocker()
+
+			pkg, err := CraftConsolidationTx(
+				0, 100, 10, test.policy, deliveryAddr,
+				&mockCoinSelectionLocker{},
+				newMockUtxoSource(utxos), utxoLocker,
+				&mock.DummySigner{}, nil,
+			)
+			if test.expectedUtxos == nil {
+				if err != ErrNotEnoughUtxos {
+					t.Fatalf("expected ErrNotEnoughUtxos, "+
+						"got %v", err)
+				}
+				if len(utxoLocker.lockedOutpoints) != 0 {
+					t.Fatalf("expected no locked utxos")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("unable to craft consolidation: %v",
+					err)
+			}
+
+			assertUtxosLocked(t, utxoLocker, test.expectedUtxos)
+			if len(utxoLocker.lockedOutpoints) !=
+				len(test.expectedUtxos) {
+
+				t.Fatalf("expected %v locked utxos, got %v",
+					len(test.expectedUtxos),
+					len(utxoLocker.lockedOutpoints))
+			}
+
+			tx := pkg.SweepTx
+			if len(tx.TxIn) != len(test.expectedUtxos) {
+				t.Fatalf("expected %v inputs, got %v",
+					len(test.expectedUtxos), len(tx.TxIn))
+			}
+			if len(tx.TxOut) != 1 {
+				t.Fatalf("expected 1 output, got %v",
+					len(tx.TxOut))
+			}
+			if tx.TxOut[0].Value != test.expectedValue {
+				t.Fatalf("expected output value %v, got %v",
+					test.expectedValue, tx.TxOut[0].Value)
+			}
+
+			pkg.CancelSweepAttempt()
+			assertUtxosUnlocked(t, utxoLocker, test.expectedUtxos)
+		})
+	}
+}
+
+// TestConsolidator asserts that the consolidator only advances the xpub
+// derivation index for published consolidations, and that it retries with a
+// larger reserve if the anchor channel reserve would be violated.
+func TestConsolidator(t *testing.T) {
+	t.Parallel()
+
+	xpub, err := hdkeychain.NewKeyFromString(testXPub)
+	if err != nil {
+		t.Fatalf("unable to parse xpub: %v", err)
+	}
+
+	utxos := makeConsolidationUtxos()
+	store := NewMockSweeperStore()
+
+	var (
+		published    []*wire.MsgTx
+		coinSelector = &mockCoinSelectionLocker{}
+	)
+	consolidator := NewConsolidator(&ConsolidatorConfig{
+		Policy: ConsolidationPolicy{
+			MaxUtxoValue: 10000,
+			MinUtxos:     2,
+		},
+		DeliveryXPub:     xpub,
+		NetParams:        &chaincfg.MainNetParams,
+		DustLimit:        100,
+		Store:            store,
+		FeeEstimator:     newMockFeeEstimator(0, 0),
+		CoinSelectLocker: coinSelector,
+		UtxoSource:       newMockUtxoSource(utxos),
+		OutpointLocker:   newMockOutpointLocker(),
+		Signer:           &mock.DummySigner{},
+		CheckReservedValue: func(tx *wire.MsgTx) (btcutil.Amount,
+			error) {
+
+			// The reserved value must be checked while no other
+			// coin selection can take place.
+			if !coinSelector.held {
+				t.Fatalf("reserved value checked without " +
+					"coin select lock")
+			}
+
+			// Consolidating all small utxos violates the anchor
+			// channel reserve.
+			const reserved = 506000
+			if len(tx.TxIn) == 3 {
+				return reserved,
+					lnwallet.ErrReservedValueInvalidated
+			}
+
+			return reserved, nil
+		},
+		PublishTransaction: func(tx *wire.MsgTx, _ string) error {
+			published = append(published, tx)
+			return nil
+		},
+	})
+
+	// A dry run shouldn't publish the consolidation nor advance the
+	// derivation index.
+	preview, err := consolidator.Consolidate(0, true)
+	if err != nil {
+		t.Fatalf("unable to preview consolidation: %v", err)
+	}
+	if preview.Published || len(published) != 0 {
+		t.Fatalf("expected dry run not to be published")
+	}
+	if len(preview.Utxos) != 2 || preview.Amount != 3000 {
+		t.Fatalf("expected reserve to be left, got %v utxos of %v",
+			len(preview.Utxos), preview.Amount)
+	}
+	index, err := store.FetchConsolidationIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index != 0 {
+		t.Fatalf("expected index 0 after dry run, got %v", index)
+	}
+
+	// Publishing the consolidation pays to the same address as the
+	// preview and advances the index.
+	consolidation, err := consolidator.Consolidate(0, false)
+	if err != nil {
+		t.Fatalf("unable to consolidate: %v", err)
+	}
+	if !consolidation.Published || len(published) != 1 {
+		t.Fatalf("expected consolidation to be published")
+	}
+	if consolidation.Addr.String() != preview.Addr.String() {
+		t.Fatalf("expected address %v, got %v", preview.Addr,
+			consolidation.Addr)
+	}
+	index, err = store.FetchConsolidationIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index != 1 {
+		t.Fatalf("expected index 1 after publish, got %v", index)
+	}
+
+	// The next consolidation pays to a fresh address.
+	next, err := consolidator.Consolidate(0, true)
+	if err != nil {
+		t.Fatalf("unable to preview consolidation: %v", err)
+	}
+	if next.Addr.String() == consolidation.Addr.String() {
+		t.Fatalf("expected fresh address, got %v", next.Addr)
+	}
+}
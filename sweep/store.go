@@ -5,10 +5,15 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 var (
@@ -27,22 +32,97 @@ var (
 	// maps: txHash -> empty slice
 	txHashesBucketKey = []byte("sweeper-tx-hashes")
 
-	// utxnChainPrefix is the bucket prefix for nursery buckets.
-	utxnChainPrefix = []byte("utxn")
+	// pendingInputsBucketKey is the key that points to a bucket containing
+	// the state of the inputs that the sweeper is attempting to sweep.
+	//
+	// maps: outpoint -> serialized_stored_input
+	pendingInputsBucketKey = []byte("sweeper-pending-inputs")
 
-	// utxnHeightIndexKey is the sub bucket where the nursery stores the
-	// height index.
-	utxnHeightIndexKey = []byte("height-index")
+	// consolidationBucketKey is the key that points to a bucket containing
+	// the state of the utxo consolidator.
+	//
+	// maps: consolidationIndexKey -> index
+	consolidationBucketKey = []byte("sweeper-consolidation")
 
-	// utxnFinalizedKndrTxnKey is a static key that can be used to locate
-	// the nursery finalized kindergarten sweep txn.
-	utxnFinalizedKndrTxnKey = []byte("finalized-kndr-txn")
+	// consolidationIndexKey is the fixed key under which the index of the
+	// next address to derive from the consolidation xpub is stored.
+	consolidationIndexKey = []byte("consolidation-index")
 
 	byteOrder = binary.BigEndian
 
 	errNoTxHashesBucket = errors.New("tx hashes bucket does not exist")
+
+	errNoPendingInputsBucket = errors.New("pending inputs bucket does " +
+		"not exist")
+
+	errNoConsolidationBucket = errors.New("consolidation bucket does " +
+		"not exist")
 )
 
+// StoredInput is the persisted state of an input that the sweeper is
+// attempting to sweep. It allows the sweeper to restore its set of pending
+// inputs, including their sweep parameters and attempt history, on restart.
+type StoredInput struct {
+	// OutPoint is the outpoint of the input.
+	OutPoint wire.OutPoint
+
+	// WitnessType is the witness type of the input.
+	WitnessType input.StandardWitnessType
+
+	// Output is the output that is spent by the input.
+	Output wire.TxOut
+
+	// HashType is the sighash type that the input is signed with.
+	HashType txscript.SigHashType
+
+	// SignDesc is the full sign descriptor of the input. It is only set
+	// for inputs that the sweeper can rebuild and sweep on its own after
+	// a restart, without waiting for their owner to offer them again.
+	SignDesc *input.SignDescriptor
+
+	// CsvDelay is the relative time lock of the input. It is only
+	// meaningful if SignDesc is set.
+	CsvDelay uint32
+
+	// CltvExpiry is the absolute time lock of the input. It is only
+	// meaningful if SignDesc is set.
+	CltvExpiry uint32
+
+	// HeightHint is the height from which to look for a spend of the
+	// input.
+	HeightHint uint32
+
+	// Params are the sweep parameters of the input.
+	Params Params
+
+	// ParamsUpdated is true if the fee preference and force flag of the
+	// input were updated through UpdateParams.
+	ParamsUpdated bool
+
+	// StartHeight is the height at which the input was first offered to
+	// the sweeper.
+	StartHeight int32
+
+	// MinPublishHeight is the minimum height at which the input may be
+	// (re)published.
+	MinPublishHeight int32
+
+	// PublishAttempts is the number of attempts that have been made to
+	// sweep the input.
+	PublishAttempts int
+
+	// LastFeeRate is the most recent fee rate used for the input.
+	LastFeeRate chainfee.SatPerKWeight
+
+	// LastSweepFee is the fee of the most recent transaction that was
+	// broadcast to sweep the input.
+	LastSweepFee btcutil.Amount
+
+	// LastSweepWeight is the weight of the most recent transaction that
+	// was broadcast to sweep the input.
+	LastSweepWeight int64
+}
+
 // SweeperStore stores published txes.
 type SweeperStore interface {
 	// IsOurTx determines whether a tx is published by us, based on its
@@ -58,6 +138,26 @@ type SweeperStore interface {
 
 	// ListSweeps lists all the sweeps we have successfully published.
 	ListSweeps() ([]chainhash.Hash, error)
+
+	// PutPendingInput adds or replaces the stored state of an input that
+	// the sweeper is attempting to sweep.
+	PutPendingInput(*StoredInput) error
+
+	// RemovePendingInput removes the stored state of an input that the
+	// sweeper no longer attempts to sweep.
+	RemovePendingInput(wire.OutPoint) error
+
+	// FetchPendingInputs returns the stored state of all inputs that the
+	// sweeper is attempting to sweep.
+	FetchPendingInputs() ([]*StoredInput, error)
+
+	// FetchConsolidationIndex returns the index of the next address to
+	// derive from the consolidation xpub.
+	FetchConsolidationIndex() (uint32, error)
+
+	// PutConsolidationIndex stores the index of the next address to derive
+	// from the consolidation xpub.
+	PutConsolidationIndex(uint32) error
 }
 
 type sweeperStore struct {
@@ -65,33 +165,20 @@ type sweeperStore struct {
 }
 
 // NewSweeperStore returns a new store instance.
-func NewSweeperStore(db kvdb.Backend, chainHash *chainhash.Hash) (
-	SweeperStore, error) {
-
+func NewSweeperStore(db kvdb.Backend) (SweeperStore, error) {
 	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
-		_, err := tx.CreateTopLevelBucket(
-			lastTxBucketKey,
-		)
-		if err != nil {
-			return err
+		topLevelBuckets := [][]byte{
+			lastTxBucketKey, txHashesBucketKey,
+			pendingInputsBucketKey, consolidationBucketKey,
 		}
-
-		if tx.ReadWriteBucket(txHashesBucketKey) != nil {
-			return nil
-		}
-
-		txHashesBucket, err := tx.CreateTopLevelBucket(
-			txHashesBucketKey,
-		)
-		if err != nil {
-			return err
+		for _, bucketKey := range topLevelBuckets {
+			_, err := tx.CreateTopLevelBucket(bucketKey)
+			if err != nil {
+				return err
+			}
 		}
 
-		// Use non-existence of tx hashes bucket as a signal to migrate
-		// nursery finalized txes.
-		err = migrateTxHashes(tx, txHashesBucket, chainHash)
-
-		return err
+		return nil
 	}, func() {})
 	if err != nil {
 		return nil, err
@@ -102,72 +189,6 @@ func NewSweeperStore(db kvdb.Backend, chainHash *chainhash.Hash) (
 	}, nil
 }
 
-// migrateTxHashes migrates nursery finalized txes to the tx hashes bucket. This
-// is not implemented as a database migration, to keep the downgrade path open.
-func migrateTxHashes(tx kvdb.RwTx, txHashesBucket kvdb.RwBucket,
-	chainHash *chainhash.Hash) error {
-
-	log.Infof("Migrating UTXO nursery finalized TXIDs")
-
-	// Compose chain bucket key.
-	var b bytes.Buffer
-	if _, err := b.Write(utxnChainPrefix); err != nil {
-		return err
-	}
-
-	if _, err := b.Write(chainHash[:]); err != nil {
-		return err
-	}
-
-	// Get chain bucket if exists.
-	chainBucket := tx.ReadWriteBucket(b.Bytes())
-	if chainBucket == nil {
-		return nil
-	}
-
-	// Retrieve the existing height index.
-	hghtIndex := chainBucket.NestedReadWriteBucket(utxnHeightIndexKey)
-	if hghtIndex == nil {
-		return nil
-	}
-
-	// Retrieve all heights.
-	err := hghtIndex.ForEach(func(k, v []byte) error {
-		heightBucket := hghtIndex.NestedReadWriteBucket(k)
-		if heightBucket == nil {
-			return nil
-		}
-
-		// Get finalized tx for height.
-		txBytes := heightBucket.Get(utxnFinalizedKndrTxnKey)
-		if txBytes == nil {
-			return nil
-		}
-
-		// Deserialize and skip tx if it cannot be deserialized.
-		tx := &wire.MsgTx{}
-		err := tx.Deserialize(bytes.NewReader(txBytes))
-		if err != nil {
-			log.Warnf("Cannot deserialize utxn tx")
-			return nil
-		}
-
-		// Calculate hash.
-		hash := tx.TxHash()
-
-		// Insert utxn tx hash in hashes bucket.
-		log.Debugf("Inserting nursery tx %v in hash list "+
-			"(height=%v)", hash, byteOrder.Uint32(k))
-
-		return txHashesBucket.Put(hash[:], []byte{})
-	})
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // NotifyPublishTx signals that we are about to publish a tx.
 func (s *sweeperStore) NotifyPublishTx(sweepTx *wire.MsgTx) error {
 	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
@@ -282,5 +303,286 @@ func (s *sweeperStore) ListSweeps() ([]chainhash.Hash, error) {
 	return sweepTxns, nil
 }
 
+// PutPendingInput adds or replaces the stored state of an input that the
+// sweeper is attempting to sweep.
+func (s *sweeperStore) PutPendingInput(storedInput *StoredInput) error {
+	var key bytes.Buffer
+	if err := writeOutPoint(&key, &storedInput.OutPoint); err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	if err := serializeStoredInput(&b, storedInput); err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		inputsBucket := tx.ReadWriteBucket(pendingInputsBucketKey)
+		if inputsBucket == nil {
+			return errNoPendingInputsBucket
+		}
+
+		return inputsBucket.Put(key.Bytes(), b.Bytes())
+	}, func() {})
+}
+
+// RemovePendingInput removes the stored state of an input that the sweeper no
+// longer attempts to sweep.
+func (s *sweeperStore) RemovePendingInput(outpoint wire.OutPoint) error {
+	var key bytes.Buffer
+	if err := writeOutPoint(&key, &outpoint); err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		inputsBucket := tx.ReadWriteBucket(pendingInputsBucketKey)
+		if inputsBucket == nil {
+			return errNoPendingInputsBucket
+		}
+
+		return inputsBucket.Delete(key.Bytes())
+	}, func() {})
+}
+
+// FetchPendingInputs returns the stored state of all inputs that the sweeper
+// is attempting to sweep.
+func (s *sweeperStore) FetchPendingInputs() ([]*StoredInput, error) {
+	var storedInputs []*StoredInput
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		inputsBucket := tx.ReadBucket(pendingInputsBucketKey)
+		if inputsBucket == nil {
+			return errNoPendingInputsBucket
+		}
+
+		return inputsBucket.ForEach(func(k, v []byte) error {
+			storedInput := &StoredInput{}
+			err := readOutPoint(
+				bytes.NewReader(k), &storedInput.OutPoint,
+			)
+			if err != nil {
+				return err
+			}
+
+			err = deserializeStoredInput(
+				bytes.NewReader(v), storedInput,
+			)
+			if err != nil {
+				return err
+			}
+
+			storedInputs = append(storedInputs, storedInput)
+
+			return nil
+		})
+	}, func() {
+		storedInputs = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return storedInputs, nil
+}
+
+// FetchConsolidationIndex returns the index of the next address to derive from
+// the consolidation xpub.
+func (s *sweeperStore) FetchConsolidationIndex() (uint32, error) {
+	var index uint32
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		consolidationBucket := tx.ReadBucket(consolidationBucketKey)
+		if consolidationBucket == nil {
+			return errNoConsolidationBucket
+		}
+
+		indexBytes := consolidationBucket.Get(consolidationIndexKey)
+		if indexBytes == nil {
+			return nil
+		}
+
+		index = byteOrder.Uint32(indexBytes)
+
+		return nil
+	}, func() {
+		index = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return index, nil
+}
+
+// PutConsolidationIndex stores the index of the next address to derive from
+// the consolidation xpub.
+func (s *sweeperStore) PutConsolidationIndex(index uint32) error {
+	var indexBytes [4]byte
+	byteOrder.PutUint32(indexBytes[:], index)
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		consolidationBucket := tx.ReadWriteBucket(
+			consolidationBucketKey,
+		)
+		if consolidationBucket == nil {
+			return errNoConsolidationBucket
+		}
+
+		return consolidationBucket.Put(
+			consolidationIndexKey, indexBytes[:],
+		)
+	}, func() {})
+}
+
+// writeOutPoint serializes an outpoint to be used as a key.
+func writeOutPoint(w io.Writer, o *wire.OutPoint) error {
+	if _, err := w.Write(o.Hash[:]); err != nil {
+		return err
+	}
+
+	return binary.Write(w, byteOrder, o.Index)
+}
+
+// readOutPoint deserializes an outpoint that is used as a key.
+func readOutPoint(r io.Reader, o *wire.OutPoint) error {
+	if _, err := io.ReadFull(r, o.Hash[:]); err != nil {
+		return err
+	}
+
+	return binary.Read(r, byteOrder, &o.Index)
+}
+
+// serializeStoredInput serializes the stored state of an input, except for its
+// outpoint which is used as the key.
+func serializeStoredInput(w io.Writer, storedInput *StoredInput) error {
+	err := binary.Write(w, byteOrder, uint16(storedInput.WitnessType))
+	if err != nil {
+		return err
+	}
+
+	err = binary.Write(w, byteOrder, storedInput.Output.Value)
+	if err != nil {
+		return err
+	}
+	err = wire.WriteVarBytes(w, 0, storedInput.Output.PkScript)
+	if err != nil {
+		return err
+	}
+
+	params := storedInput.Params
+
+	var (
+		hasGroup bool
+		group    uint64
+	)
+	if params.ExclusiveGroup != nil {
+		hasGroup = true
+		group = *params.ExclusiveGroup
+	}
+
+	elements := []interface{}{
+		storedInput.HeightHint,
+		params.Fee.ConfTarget, int64(params.Fee.FeeRate), params.Force,
+		hasGroup, group, params.DeadlineHeight, int64(params.Budget),
+		storedInput.ParamsUpdated, storedInput.StartHeight,
+		storedInput.MinPublishHeight,
+		uint32(storedInput.PublishAttempts),
+		int64(storedInput.LastFeeRate),
+		int64(storedInput.LastSweepFee), storedInput.LastSweepWeight,
+		uint32(storedInput.HashType), storedInput.SignDesc != nil,
+	}
+	for _, element := range elements {
+		if err := binary.Write(w, byteOrder, element); err != nil {
+			return err
+		}
+	}
+
+	if storedInput.SignDesc == nil {
+		return nil
+	}
+
+	err = input.WriteSignDescriptor(w, storedInput.SignDesc)
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(
+		w, byteOrder, []uint32{
+			storedInput.CsvDelay, storedInput.CltvExpiry,
+		},
+	)
+}
+
+// deserializeStoredInput deserializes the stored state of an input, except for
+// its outpoint which is used as the key.
+func deserializeStoredInput(r io.Reader, storedInput *StoredInput) error {
+	var witnessType uint16
+	if err := binary.Read(r, byteOrder, &witnessType); err != nil {
+		return err
+	}
+	storedInput.WitnessType = input.StandardWitnessType(witnessType)
+
+	err := binary.Read(r, byteOrder, &storedInput.Output.Value)
+	if err != nil {
+		return err
+	}
+	storedInput.Output.PkScript, err = wire.ReadVarBytes(
+		r, 0, 10000, "pkScript",
+	)
+	if err != nil {
+		return err
+	}
+
+	var (
+		feeRate, budget, lastFeeRate int64
+		lastSweepFee                 int64
+		hasGroup, hasSignDesc        bool
+		group                        uint64
+		publishAttempts, hashType    uint32
+		params                       = &storedInput.Params
+	)
+	elements := []interface{}{
+		&storedInput.HeightHint,
+		&params.Fee.ConfTarget, &feeRate, &params.Force,
+		&hasGroup, &group, &params.DeadlineHeight, &budget,
+		&storedInput.ParamsUpdated, &storedInput.StartHeight,
+		&storedInput.MinPublishHeight, &publishAttempts, &lastFeeRate,
+		&lastSweepFee, &storedInput.LastSweepWeight, &hashType,
+		&hasSignDesc,
+	}
+	for _, element := range elements {
+		if err := binary.Read(r, byteOrder, element); err != nil {
+			return err
+		}
+	}
+
+	params.Fee.FeeRate = chainfee.SatPerKWeight(feeRate)
+	params.Budget = btcutil.Amount(budget)
+	if hasGroup {
+		params.ExclusiveGroup = &group
+	}
+	storedInput.PublishAttempts = int(publishAttempts)
+	storedInput.LastFeeRate = chainfee.SatPerKWeight(lastFeeRate)
+	storedInput.LastSweepFee = btcutil.Amount(lastSweepFee)
+	storedInput.HashType = txscript.SigHashType(hashType)
+
+	if !hasSignDesc {
+		return nil
+	}
+
+	storedInput.SignDesc = &input.SignDescriptor{}
+	err = input.ReadSignDescriptor(r, storedInput.SignDesc)
+	if err != nil {
+		return err
+	}
+
+	err = binary.Read(r, byteOrder, &storedInput.CsvDelay)
+	if err != nil {
+		return err
+	}
+
+	return binary.Read(r, byteOrder, &storedInput.CltvExpiry)
+}
+
 // Compile-time constraint to ensure sweeperStore implements SweeperStore.
 var _ SweeperStore = (*sweeperStore)(nil)
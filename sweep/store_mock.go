@@ -1,6 +1,8 @@
 package sweep
 
 import (
+	"sync"
+
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 )
@@ -8,14 +10,18 @@ import (
 // MockSweeperStore is a mock implementation of sweeper store. This type is
 // exported, because it is currently used in nursery tests too.
 type MockSweeperStore struct {
-	lastTx  *wire.MsgTx
-	ourTxes map[chainhash.Hash]struct{}
+	lastTx        *wire.MsgTx
+	ourTxes       map[chainhash.Hash]struct{}
+	pendingInputs map[wire.OutPoint]StoredInput
+	consolIndex   uint32
+	mtx           sync.Mutex
 }
 
 // NewMockSweeperStore returns a new instance.
 func NewMockSweeperStore() *MockSweeperStore {
 	return &MockSweeperStore{
-		ourTxes: make(map[chainhash.Hash]struct{}),
+		ourTxes:       make(map[chainhash.Hash]struct{}),
+		pendingInputs: make(map[wire.OutPoint]StoredInput),
 	}
 }
 
@@ -51,5 +57,62 @@ func (s *MockSweeperStore) ListSweeps() ([]chainhash.Hash, error) {
 	return txns, nil
 }
 
+// PutPendingInput adds or replaces the stored state of an input that the
+// sweeper is attempting to sweep.
+func (s *MockSweeperStore) PutPendingInput(storedInput *StoredInput) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.pendingInputs[storedInput.OutPoint] = *storedInput
+
+	return nil
+}
+
+// RemovePendingInput removes the stored state of an input that the sweeper no
+// longer attempts to sweep.
+func (s *MockSweeperStore) RemovePendingInput(outpoint wire.OutPoint) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	delete(s.pendingInputs, outpoint)
+
+	return nil
+}
+
+// FetchPendingInputs returns the stored state of all inputs that the sweeper
+// is attempting to sweep.
+func (s *MockSweeperStore) FetchPendingInputs() ([]*StoredInput, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	storedInputs := make([]*StoredInput, 0, len(s.pendingInputs))
+	for _, storedInput := range s.pendingInputs {
+		storedInput := storedInput
+		storedInputs = append(storedInputs, &storedInput)
+	}
+
+	return storedInputs, nil
+}
+
+// FetchConsolidationIndex returns the index of the next address to derive from
+// the consolidation xpub.
+func (s *MockSweeperStore) FetchConsolidationIndex() (uint32, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.consolIndex, nil
+}
+
+// PutConsolidationIndex stores the index of the next address to derive from
+// the consolidation xpub.
+func (s *MockSweeperStore) PutConsolidationIndex(index uint32) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.consolIndex = index
+
+	return nil
+}
+
 // Compile-time constraint to ensure MockSweeperStore implements SweeperStore.
 var _ SweeperStore = (*MockSweeperStore)(nil)
@@ -1,11 +1,18 @@
 package sweep
 
 import (
+	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 // TestStore asserts that the store persists the presented data to disk and is
@@ -25,8 +32,7 @@ func TestStore(t *testing.T) {
 		}
 
 		testStore(t, func() (SweeperStore, error) {
-			var chain chainhash.Hash
-			return NewSweeperStore(cdb, &chain)
+			return NewSweeperStore(cdb)
 		})
 	})
 	t.Run("mock", func(t *testing.T) {
@@ -147,4 +153,139 @@ func testStore(t *testing.T, createStore func() (SweeperStore, error)) {
 			t.Fatalf("unexpected tx: %v", tx)
 		}
 	}
+
+	// Store the state of two pending inputs and remove one of them again.
+	exclusiveGroup := uint64(7)
+	storedInput1 := &StoredInput{
+		OutPoint:    wire.OutPoint{Index: 1},
+		WitnessType: input.CommitmentTimeLock,
+		Output: wire.TxOut{
+			Value:    10000,
+			PkScript: []byte{0, 1, 2},
+		},
+		HashType:   txscript.SigHashAll,
+		HeightHint: 100,
+		Params: Params{
+			Fee: FeePreference{
+				FeeRate: chainfee.FeePerKwFloor,
+			},
+			Force:          true,
+			ExclusiveGroup: &exclusiveGroup,
+			DeadlineHeight: 144,
+			Budget:         1000,
+		},
+		ParamsUpdated:    true,
+		StartHeight:      101,
+		MinPublishHeight: 103,
+		PublishAttempts:  2,
+		LastFeeRate:      chainfee.FeePerKwFloor * 2,
+		LastSweepFee:     500,
+		LastSweepWeight:  800,
+	}
+	storedInput2 := &StoredInput{
+		OutPoint:    wire.OutPoint{Index: 2},
+		WitnessType: input.CommitmentAnchor,
+		Output: wire.TxOut{
+			Value: 330,
+		},
+		Params: Params{
+			Fee: FeePreference{
+				ConfTarget: 6,
+			},
+		},
+	}
+
+	// A wallet input is stored with its full sign descriptor.
+	walletOutput := &wire.TxOut{
+		Value:    20000,
+		PkScript: []byte{0, 20, 3, 4, 5},
+	}
+	storedInput3 := &StoredInput{
+		OutPoint:    wire.OutPoint{Index: 3},
+		WitnessType: input.WitnessKeyHash,
+		Output:      *walletOutput,
+		HashType:    txscript.SigHashAll,
+		SignDesc: &input.SignDescriptor{
+			KeyDesc: keychain.KeyDescriptor{
+				KeyLocator: keychain.KeyLocator{
+					Family: keychain.KeyFamilyMultiSig,
+					Index:  5,
+				},
+			},
+			WitnessScript: []byte{6, 7},
+			Output:        walletOutput,
+			HashType:      txscript.SigHashAll,
+		},
+		CsvDelay:   2,
+		CltvExpiry: 500,
+		HeightHint: 110,
+		Params: Params{
+			Fee: FeePreference{
+				ConfTarget: 6,
+			},
+		},
+	}
+
+	storedInputs := []*StoredInput{storedInput1, storedInput2, storedInput3}
+	for _, storedInput := range storedInputs {
+		if err := store.PutPendingInput(storedInput); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = store.RemovePendingInput(storedInput2.OutPoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Recreate the sweeper store and assert that only the first and third
+	// input are restored, with all of their state.
+	store, err = createStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	storedInputs, err = store.FetchPendingInputs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(storedInputs) != 2 {
+		t.Fatalf("expected 2 stored inputs, got %v", len(storedInputs))
+	}
+	sort.Slice(storedInputs, func(i, j int) bool {
+		return storedInputs[i].OutPoint.Index <
+			storedInputs[j].OutPoint.Index
+	})
+	for i, expected := range []*StoredInput{storedInput1, storedInput3} {
+		if !reflect.DeepEqual(storedInputs[i], expected) {
+			t.Fatalf("expected stored input %v, got %v",
+				spew.Sdump(expected),
+				spew.Sdump(storedInputs[i]))
+		}
+	}
+
+	// Initially the consolidation index is expected to be zero. Once it is
+	// stored, it is expected to be retrieved after a restart.
+	index, err := store.FetchConsolidationIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index != 0 {
+		t.Fatalf("expected consolidation index 0, got %v", index)
+	}
+	if err := store.PutConsolidationIndex(7); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err = createStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	index, err = store.FetchConsolidationIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index != 7 {
+		t.Fatalf("expected consolidation index 7, got %v", index)
+	}
 }
@@ -9,6 +9,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
@@ -36,6 +37,13 @@ const (
 	//   #1: min = 1 sat/vbyte, max = 10 sat/vbyte
 	//   #2: min = 11 sat/vbyte, max = 20 sat/vbyte...
 	DefaultFeeRateBucketSize = 10
+
+	// restoredInputTimeout is the number of blocks after start up within
+	// which an input that was restored from the store must be offered
+	// again by its owner. Restored inputs that aren't offered again in
+	// time are forgotten. Wallet inputs are swept without being offered
+	// again, so they never expire.
+	restoredInputTimeout = 6
 )
 
 var (
@@ -61,6 +69,11 @@ var (
 	// it is/has already been stopped.
 	ErrSweeperShuttingDown = errors.New("utxo sweeper shutting down")
 
+	// errRestoredInputTimeout is the result of a restored input that wasn't
+	// offered again within the restored input timeout.
+	errRestoredInputTimeout = errors.New("restored input not offered " +
+		"again")
+
 	// DefaultMaxSweepAttempts specifies the default maximum number of times
 	// an input is included in a publish attempt before giving up and
 	// returning an error to the caller.
@@ -81,6 +94,23 @@ type Params struct {
 	// ExclusiveGroup is an identifier that, if set, prevents other inputs
 	// with the same identifier from being batched together.
 	ExclusiveGroup *uint64
+
+	// DeadlineHeight is the block height by which the input must be
+	// confirmed. If set, the fee rate of the input is raised from the
+	// fee preference towards the maximum fee rate allowed by the budget
+	// as the deadline approaches, and the input is republished every
+	// block. Zero means the input has no deadline.
+	DeadlineHeight int32
+
+	// Budget is the maximum fee the input may pay for being swept. It
+	// caps the fee rate at which the input is swept, regardless of the
+	// fee preference and deadline. Zero means the fee is only limited by
+	// the maximum fee rate of the sweeper.
+	//
+	// NOTE: A budget that doesn't even allow sweeping at the relay fee
+	// rate is exceeded, as the input could never be swept otherwise. The
+	// input is then swept at the relay fee rate.
+	Budget btcutil.Amount
 }
 
 // ParamsUpdate contains a new set of parameters to update a pending sweep with.
@@ -97,8 +127,9 @@ type ParamsUpdate struct {
 
 // String returns a human readable interpretation of the sweep parameters.
 func (p Params) String() string {
-	return fmt.Sprintf("fee=%v, force=%v, exclusive_group=%v",
-		p.Fee, p.Force, p.ExclusiveGroup)
+	return fmt.Sprintf("fee=%v, force=%v, exclusive_group=%v, "+
+		"deadline_height=%v, budget=%v", p.Fee, p.Force,
+		p.ExclusiveGroup, p.DeadlineHeight, p.Budget)
 }
 
 // pendingInput is created when an input reaches the main loop for the first
@@ -119,6 +150,11 @@ type pendingInput struct {
 	// input may be (re)published.
 	minPublishHeight int32
 
+	// startHeight is the block height at which the input was first
+	// offered to the sweeper. It is where the fee rate of inputs with a
+	// deadline starts to be raised.
+	startHeight int32
+
 	// publishAttempts records the number of attempts that have already been
 	// made to sweep this tx.
 	publishAttempts int
@@ -129,6 +165,37 @@ type pendingInput struct {
 	// lastFeeRate is the most recent fee rate used for this input within a
 	// transaction broadcast to the network.
 	lastFeeRate chainfee.SatPerKWeight
+
+	// lastSweepFee is the fee of the most recent transaction broadcast to
+	// sweep this input.
+	lastSweepFee btcutil.Amount
+
+	// lastSweepWeight is the weight of the most recent transaction
+	// broadcast to sweep this input.
+	lastSweepWeight int64
+
+	// paramsUpdated is true if the fee preference and force flag of the
+	// input were updated through UpdateParams since it was last offered.
+	paramsUpdated bool
+
+	// restored is true if the input was restored from the store on start
+	// up and hasn't been offered again by its owner yet. Restored inputs
+	// aren't swept, as only their owner holds everything that is needed
+	// to sign for them.
+	restored bool
+
+	// restoreExpiry is the height at which a restored input is forgotten
+	// if it hasn't been offered again by then.
+	restoreExpiry int32
+
+	// budgetExceeded is true if the budget of the input is too low to
+	// sweep it at the relay fee rate, and it was reported as such.
+	budgetExceeded bool
+
+	// selfContained is true if the input was restored from its full sign
+	// descriptor. Such inputs have no owner that offers them again after
+	// a restart, so their sign descriptor must be stored along with them.
+	selfContained bool
 }
 
 // parameters returns the sweep parameters for this input.
@@ -173,6 +240,16 @@ type PendingInput struct {
 	// swept within a transaction broadcast to the network.
 	LastFeeRate chainfee.SatPerKWeight
 
+	// LastSweepFee is the fee of the most recent transaction broadcast to
+	// sweep the input. Along with LastSweepWeight, it allows the effective
+	// fee rate of an unconfirmed parent that is bumped through CPFP to be
+	// determined.
+	LastSweepFee btcutil.Amount
+
+	// LastSweepWeight is the weight of the most recent transaction
+	// broadcast to sweep the input.
+	LastSweepWeight int64
+
 	// BroadcastAttempts is the number of attempts we've made to sweept the
 	// input.
 	BroadcastAttempts int
@@ -367,6 +444,13 @@ func (s *UtxoSweeper) Start() error {
 	// not change from here on.
 	s.relayFeeRate = s.cfg.FeeEstimator.RelayFeePerKW()
 
+	// Restore the inputs that we were attempting to sweep before the
+	// restart, so that they are reported as pending and keep their sweep
+	// parameters until their owners offer them again.
+	if err := s.restorePendingInputs(); err != nil {
+		return fmt.Errorf("restore pending inputs: %v", err)
+	}
+
 	// We need to register for block epochs and retry sweeping every block.
 	// We should get a notification with the current best block immediately
 	// if we don't provide any epoch. We'll wait for that in the collector.
@@ -522,6 +606,21 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 		return
 	}
 
+	// Now that we know the current height, we can determine when the
+	// restored inputs expire if they aren't offered again.
+	for _, pendInput := range s.pendingInputs {
+		if pendInput.restored {
+			pendInput.restoreExpiry = bestHeight +
+				restoredInputTimeout
+		}
+	}
+
+	// The inputs that were rebuilt from the store don't need to be
+	// offered again, so we can schedule a sweep for them right away.
+	if err := s.scheduleSweep(bestHeight); err != nil {
+		log.Errorf("schedule sweep: %v", err)
+	}
+
 	for {
 		select {
 		// A new inputs is offered to the sweeper. We check to see if we
@@ -536,15 +635,45 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 
 				// Update input details and sweep parameters.
 				// The re-offered input details may contain a
-				// change to the unconfirmed parent tx info.
-				pendInput.params = input.params
+				// change to the unconfirmed parent tx info. If
+				// the fee of a restored input was updated
+				// before the restart, the update takes
+				// precedence over the fee it's offered with.
+				params := input.params
+				keepUpdate := pendInput.restored &&
+					pendInput.paramsUpdated
+				if keepUpdate {
+					params.Fee = pendInput.params.Fee
+					params.Force = pendInput.params.Force
+				} else {
+					pendInput.paramsUpdated = false
+				}
+
+				wasRestored := pendInput.restored
+				pendInput.params = params
+				pendInput.budgetExceeded = false
 				pendInput.Input = input.input
+				pendInput.restored = false
 
 				// Add additional result channel to signal
 				// spend of this input.
 				pendInput.listeners = append(
 					pendInput.listeners, input.resultChan,
 				)
+
+				s.persistInput(pendInput)
+
+				// A restored input can be swept now that its
+				// owner offered it again.
+				if !wasRestored {
+					continue
+				}
+
+				err := s.scheduleSweep(bestHeight)
+				if err != nil {
+					log.Errorf("schedule sweep: %v", err)
+				}
+
 				continue
 			}
 
@@ -556,9 +685,11 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 				listeners:        []chan Result{input.resultChan},
 				Input:            input.input,
 				minPublishHeight: bestHeight,
+				startHeight:      bestHeight,
 				params:           input.params,
 			}
 			s.pendingInputs[outpoint] = pendInput
+			s.persistInput(pendInput)
 
 			// Start watching for spend of this input, either by us
 			// or the remote party.
@@ -676,7 +807,7 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 			// this to ensure any inputs which have had their fee
 			// rate bumped are broadcast first in order enforce the
 			// RBF policy.
-			inputClusters := s.createInputClusters()
+			inputClusters := s.createInputClusters(bestHeight)
 			sort.Slice(inputClusters, func(i, j int) bool {
 				return inputClusters[i].sweepFeeRate >
 					inputClusters[j].sweepFeeRate
@@ -701,6 +832,8 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 			log.Debugf("New block: height=%v, sha=%v",
 				epoch.Height, epoch.Hash)
 
+			s.removeExpiredRestoredInputs(bestHeight)
+
 			if err := s.scheduleSweep(bestHeight); err != nil {
 				log.Errorf("schedule sweep: %v", err)
 			}
@@ -711,6 +844,161 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 	}
 }
 
+// restorePendingInputs adds the inputs that are in the store to the set of
+// pending inputs and registers for their spends. Wallet inputs, which nobody
+// offers again after a restart, are rebuilt from the store and swept directly.
+// All other restored inputs are only swept once their owners offer them again.
+func (s *UtxoSweeper) restorePendingInputs() error {
+	storedInputs, err := s.cfg.Store.FetchPendingInputs()
+	if err != nil {
+		return err
+	}
+
+	for _, storedInput := range storedInputs {
+		output := storedInput.Output
+
+		// Inputs that were stored with their full sign descriptor can
+		// be swept directly. The others are parked until their owners
+		// offer them again.
+		var (
+			restoredInput input.Input
+			restored      bool
+			selfContained = storedInput.SignDesc != nil
+		)
+		if selfContained {
+			restoredInput = input.NewCsvInputWithCltv(
+				&storedInput.OutPoint, storedInput.WitnessType,
+				storedInput.SignDesc, storedInput.HeightHint,
+				storedInput.CsvDelay, storedInput.CltvExpiry,
+			)
+		} else {
+			baseInput := input.MakeBaseInput(
+				&storedInput.OutPoint, storedInput.WitnessType,
+				&input.SignDescriptor{
+					Output:   &output,
+					HashType: storedInput.HashType,
+				},
+				storedInput.HeightHint, nil,
+			)
+			restoredInput = &baseInput
+			restored = true
+		}
+
+		pendInput := &pendingInput{
+			Input:            restoredInput,
+			minPublishHeight: storedInput.MinPublishHeight,
+			startHeight:      storedInput.StartHeight,
+			publishAttempts:  storedInput.PublishAttempts,
+			params:           storedInput.Params,
+			lastFeeRate:      storedInput.LastFeeRate,
+			lastSweepFee:     storedInput.LastSweepFee,
+			lastSweepWeight:  storedInput.LastSweepWeight,
+			paramsUpdated:    storedInput.ParamsUpdated,
+			restored:         restored,
+			selfContained:    selfContained,
+		}
+
+		cancel, err := s.waitForSpend(
+			storedInput.OutPoint, output.PkScript,
+			storedInput.HeightHint,
+		)
+		if err != nil {
+			return err
+		}
+		pendInput.ntfnRegCancel = cancel
+
+		s.pendingInputs[storedInput.OutPoint] = pendInput
+
+		log.Debugf("Restored pending input %v: attempts=%v, "+
+			"params=(%v), parked=%v", storedInput.OutPoint,
+			storedInput.PublishAttempts, storedInput.Params,
+			restored)
+	}
+
+	return nil
+}
+
+// removeExpiredRestoredInputs forgets the restored inputs that weren't offered
+// again by their owners before their restore expiry.
+func (s *UtxoSweeper) removeExpiredRestoredInputs(currentHeight int32) {
+	for outpoint, pendInput := range s.pendingInputs {
+		outpoint := outpoint
+
+		if !pendInput.restored ||
+			pendInput.restoreExpiry > currentHeight {
+
+			continue
+		}
+
+		s.signalAndRemove(&outpoint, Result{
+			Err: errRestoredInputTimeout,
+		})
+	}
+}
+
+// persistInput stores the state of the pending input, so that it can be
+// restored after a restart. Inputs with a witness type that can't be stored
+// are skipped.
+func (s *UtxoSweeper) persistInput(pendInput *pendingInput) {
+	outpoint := *pendInput.OutPoint()
+
+	witnessType, ok := pendInput.WitnessType().(input.StandardWitnessType)
+	if !ok {
+		log.Debugf("Not storing input %v with witness type %v",
+			outpoint, pendInput.WitnessType())
+		return
+	}
+
+	storedInput := &StoredInput{
+		OutPoint:         outpoint,
+		WitnessType:      witnessType,
+		Output:           *pendInput.SignDesc().Output,
+		HashType:         pendInput.SignDesc().HashType,
+		HeightHint:       pendInput.HeightHint(),
+		Params:           pendInput.params,
+		ParamsUpdated:    pendInput.paramsUpdated,
+		StartHeight:      pendInput.startHeight,
+		MinPublishHeight: pendInput.minPublishHeight,
+		PublishAttempts:  pendInput.publishAttempts,
+		LastFeeRate:      pendInput.lastFeeRate,
+		LastSweepFee:     pendInput.lastSweepFee,
+		LastSweepWeight:  pendInput.lastSweepWeight,
+	}
+
+	// Wallet inputs, such as the ones used to CPFP a transaction through
+	// BumpFee, and inputs that were restored from their full sign
+	// descriptor, such as migrated nursery outputs, have no owner that
+	// offers them again after a restart. We store everything that's needed
+	// to sweep them on our own.
+	if pendInput.selfContained || isWalletInput(pendInput.Input) {
+		storedInput.SignDesc = pendInput.SignDesc()
+		storedInput.CsvDelay = pendInput.BlocksToMaturity()
+		storedInput.CltvExpiry, _ = pendInput.RequiredLockTime()
+	}
+
+	err := s.cfg.Store.PutPendingInput(storedInput)
+	if err != nil {
+		log.Errorf("Unable to store pending input %v: %v", outpoint,
+			err)
+	}
+}
+
+// isWalletInput returns true if the input is a plain wallet output that can be
+// rebuilt from its sign descriptor alone.
+func isWalletInput(inp input.Input) bool {
+	if _, ok := inp.(*input.BaseInput); !ok {
+		return false
+	}
+
+	switch inp.WitnessType() {
+	case input.WitnessKeyHash, input.NestedWitnessKeyHash:
+		return true
+
+	default:
+		return false
+	}
+}
+
 // removeExclusiveGroup removes all inputs in the given exclusive group. This
 // function is called when one of the exclusive group inputs has been spent. The
 // other inputs won't ever be spendable and can be removed. This also prevents
@@ -783,21 +1071,170 @@ func (s *UtxoSweeper) bucketForFeeRate(
 // inputs known by the UtxoSweeper. It clusters inputs by
 // 1) Required tx locktime
 // 2) Similar fee rates
-func (s *UtxoSweeper) createInputClusters() []inputCluster {
-	inputs := s.pendingInputs
+func (s *UtxoSweeper) createInputClusters(
+	currentHeight int32) []inputCluster {
+
+	// Restored inputs can't be swept until their owners offer them again,
+	// so we leave them out.
+	inputs := make(pendingInputs, len(s.pendingInputs))
+	for op, input := range s.pendingInputs {
+		if input.restored {
+			continue
+		}
+
+		inputs[op] = input
+	}
 
 	// We start by getting the inputs clusters by locktime. Since the
 	// inputs commit to the locktime, they can only be clustered together
 	// if the locktime is equal.
-	lockTimeClusters, nonLockTimeInputs := s.clusterByLockTime(inputs)
+	lockTimeClusters, nonLockTimeInputs := s.clusterByLockTime(
+		inputs, currentHeight,
+	)
 
 	// Cluster the the remaining inputs by sweep fee rate.
-	feeClusters := s.clusterBySweepFeeRate(nonLockTimeInputs)
+	feeClusters := s.clusterBySweepFeeRate(
+		nonLockTimeInputs, currentHeight,
+	)
 
 	// Since the inputs that we clustered by fee rate don't commit to a
 	// specific locktime, we can try to merge a locktime cluster with a fee
 	// cluster.
-	return zipClusters(lockTimeClusters, feeClusters)
+	clusters := zipClusters(lockTimeClusters, feeClusters)
+
+	// Averaging and merging may have raised the fee rate of a cluster
+	// above what the budget of one of its inputs allows. Lower the fee
+	// rate of those clusters, so no input pays more than its budget.
+	for i := range clusters {
+		for _, input := range clusters[i].inputs {
+			maxFeeRate, err := s.maxFeeRateForInput(input)
+			if err != nil {
+				continue
+			}
+
+			if clusters[i].sweepFeeRate > maxFeeRate {
+				clusters[i].sweepFeeRate = maxFeeRate
+			}
+		}
+	}
+
+	return clusters
+}
+
+// maxFeeRateForInput returns the maximum fee rate at which the given input may
+// be swept. This is the maximum fee rate of the sweeper, lowered to the fee
+// rate at which sweeping the input on its own would spend its entire budget.
+// If the budget doesn't even allow sweeping at the relay fee rate, the input
+// is swept at the relay fee rate regardless, as it would never be swept
+// otherwise. This exceeds the budget, so we warn about it.
+func (s *UtxoSweeper) maxFeeRateForInput(
+	input *pendingInput) (chainfee.SatPerKWeight, error) {
+
+	maxFeeRate := s.cfg.MaxFeeRate
+	if input.params.Budget == 0 {
+		return maxFeeRate, nil
+	}
+
+	budgetFeeRate, err := budgetFeeRate(input, input.params.Budget)
+	if err != nil {
+		return 0, err
+	}
+
+	if budgetFeeRate < s.relayFeeRate {
+		if !input.budgetExceeded {
+			log.Warnf("Budget %v of input %v too low to sweep at "+
+				"relay fee rate %v, exceeding budget to sweep "+
+				"at relay fee rate", input.params.Budget,
+				input.OutPoint(), s.relayFeeRate)
+
+			input.budgetExceeded = true
+		}
+
+		budgetFeeRate = s.relayFeeRate
+	}
+
+	if budgetFeeRate < maxFeeRate {
+		maxFeeRate = budgetFeeRate
+	}
+
+	return maxFeeRate, nil
+}
+
+// feeRateForInput returns the fee rate at which the given input should be swept
+// at the current height. The fee rate of inputs with a deadline is raised
+// towards their maximum fee rate as the deadline approaches. An error is
+// returned if the fee preference is invalid.
+func (s *UtxoSweeper) feeRateForInput(input *pendingInput,
+	currentHeight int32) (chainfee.SatPerKWeight, error) {
+
+	feeRate, err := s.feeRateForPreference(input.params.Fee)
+	if err != nil {
+		return 0, err
+	}
+
+	maxFeeRate, err := s.maxFeeRateForInput(input)
+	if err != nil {
+		return 0, err
+	}
+
+	if input.params.DeadlineHeight != 0 {
+		feeRate = DeadlineFeeRate(
+			feeRate, maxFeeRate, input.startHeight,
+			input.params.DeadlineHeight, currentHeight,
+		)
+	}
+
+	if feeRate > maxFeeRate {
+		feeRate = maxFeeRate
+	}
+
+	return feeRate, nil
+}
+
+// DeadlineFeeRate raises the given fee rate linearly towards the maximum fee
+// rate, starting at the start height, so that the maximum fee rate is reached
+// at the deadline height.
+func DeadlineFeeRate(feeRate, maxFeeRate chainfee.SatPerKWeight, startHeight,
+	deadlineHeight, currentHeight int32) chainfee.SatPerKWeight {
+
+	switch {
+	case feeRate >= maxFeeRate:
+		return maxFeeRate
+
+	case currentHeight >= deadlineHeight:
+		return maxFeeRate
+
+	case currentHeight <= startHeight:
+		return feeRate
+	}
+
+	elapsed := chainfee.SatPerKWeight(currentHeight - startHeight)
+	total := chainfee.SatPerKWeight(deadlineHeight - startHeight)
+
+	return feeRate + (maxFeeRate-feeRate)*elapsed/total
+}
+
+// budgetFeeRate returns the fee rate at which a transaction that only sweeps
+// the given input would pay exactly the given budget. As the input shares the
+// fixed part of the transaction weight with the other inputs when it is
+// batched, it won't pay more than its budget at this fee rate.
+func budgetFeeRate(inp input.Input,
+	budget btcutil.Amount) (chainfee.SatPerKWeight, error) {
+
+	var estimator input.TxWeightEstimator
+	err := inp.WitnessType().AddWeightEstimation(&estimator)
+	if err != nil {
+		return 0, err
+	}
+
+	if txOut := inp.RequiredTxOut(); txOut != nil {
+		estimator.AddTxOutput(txOut)
+	}
+	estimator.AddP2WKHOutput()
+
+	weight := btcutil.Amount(estimator.Weight())
+
+	return chainfee.SatPerKWeight(budget * 1000 / weight), nil
 }
 
 // clusterByLockTime takes the given set of pending inputs and clusters those
@@ -805,8 +1242,8 @@ func (s *UtxoSweeper) createInputClusters() []inputCluster {
 // is determined by calculating the average fee rate of all inputs within that
 // cluster. In addition to the created clusters, inputs that did not specify a
 // required lock time are returned.
-func (s *UtxoSweeper) clusterByLockTime(inputs pendingInputs) ([]inputCluster,
-	pendingInputs) {
+func (s *UtxoSweeper) clusterByLockTime(inputs pendingInputs,
+	currentHeight int32) ([]inputCluster, pendingInputs) {
 
 	locktimes := make(map[uint32]pendingInputs)
 	inputFeeRates := make(map[wire.OutPoint]chainfee.SatPerKWeight)
@@ -820,6 +1257,15 @@ func (s *UtxoSweeper) clusterByLockTime(inputs pendingInputs) ([]inputCluster,
 			continue
 		}
 
+		// A transaction committing to a locktime beyond the current
+		// height can't be confirmed in the next block, so we'll leave
+		// the input pending until its locktime has been reached.
+		if lt > uint32(currentHeight) {
+			log.Debugf("Skipping input %v with locktime %v at "+
+				"height %v", op, lt, currentHeight)
+			continue
+		}
+
 		// Check if we already have inputs with this locktime.
 		p, ok := locktimes[lt]
 		if !ok {
@@ -830,7 +1276,7 @@ func (s *UtxoSweeper) clusterByLockTime(inputs pendingInputs) ([]inputCluster,
 		locktimes[lt] = p
 
 		// We also get the preferred fee rate for this input.
-		feeRate, err := s.feeRateForPreference(input.params.Fee)
+		feeRate, err := s.feeRateForInput(input, currentHeight)
 		if err != nil {
 			log.Warnf("Skipping input %v: %v", op, err)
 			continue
@@ -866,14 +1312,16 @@ func (s *UtxoSweeper) clusterByLockTime(inputs pendingInputs) ([]inputCluster,
 // and clusters those together with similar fee rates. Each cluster contains a
 // sweep fee rate, which is determined by calculating the average fee rate of
 // all inputs within that cluster.
-func (s *UtxoSweeper) clusterBySweepFeeRate(inputs pendingInputs) []inputCluster {
+func (s *UtxoSweeper) clusterBySweepFeeRate(inputs pendingInputs,
+	currentHeight int32) []inputCluster {
+
 	bucketInputs := make(map[int]*bucketList)
 	inputFeeRates := make(map[wire.OutPoint]chainfee.SatPerKWeight)
 
 	// First, we'll group together all inputs with similar fee rates. This
 	// is done by determining the fee rate bucket they should belong in.
 	for op, input := range inputs {
-		feeRate, err := s.feeRateForPreference(input.params.Fee)
+		feeRate, err := s.feeRateForInput(input, currentHeight)
 		if err != nil {
 			log.Warnf("Skipping input %v: %v", op, err)
 			continue
@@ -1041,7 +1489,7 @@ func (s *UtxoSweeper) scheduleSweep(currentHeight int32) error {
 
 	// We'll only start our timer once we have inputs we're able to sweep.
 	startTimer := false
-	for _, cluster := range s.createInputClusters() {
+	for _, cluster := range s.createInputClusters(currentHeight) {
 		// Examine pending inputs and try to construct lists of inputs.
 		// We don't need to obtain the coin selection lock, because we
 		// just need an indication as to whether we can sweep. More
@@ -1108,6 +1556,12 @@ func (s *UtxoSweeper) signalAndRemove(outpoint *wire.OutPoint, result Result) {
 
 	// Inputs are no longer pending after result has been sent.
 	delete(s.pendingInputs, *outpoint)
+
+	err := s.cfg.Store.RemovePendingInput(*outpoint)
+	if err != nil {
+		log.Errorf("Unable to remove stored input %v: %v", outpoint,
+			err)
+	}
 }
 
 // getInputLists goes through the given inputs and constructs multiple distinct
@@ -1232,6 +1686,17 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 		s.currentOutputScript = nil
 	}
 
+	// Determine the fee and weight of the sweep tx, so that the effective
+	// fee rate of the unconfirmed parents that it bumps can be reported.
+	var sweepFee btcutil.Amount
+	for _, inp := range inputs {
+		sweepFee += btcutil.Amount(inp.SignDesc().Output.Value)
+	}
+	for _, out := range tx.TxOut {
+		sweepFee -= btcutil.Amount(out.Value)
+	}
+	sweepWeight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+
 	// Reschedule sweep.
 	for _, input := range tx.TxIn {
 		pi, ok := s.pendingInputs[input.PreviousOutPoint]
@@ -1246,14 +1711,21 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 
 		// Record another publish attempt.
 		pi.publishAttempts++
+		pi.lastSweepFee = sweepFee
+		pi.lastSweepWeight = sweepWeight
 
 		// We don't care what the result of the publish call was. Even
 		// if it is published successfully, it can still be that it
 		// needs to be retried. Call NextAttemptDeltaFunc to calculate
-		// when to resweep this input.
+		// when to resweep this input. Inputs with a deadline are
+		// retried at the next block, so their raised fee rate is
+		// applied without delay.
 		nextAttemptDelta := s.cfg.NextAttemptDeltaFunc(
 			pi.publishAttempts,
 		)
+		if pi.params.DeadlineHeight != 0 {
+			nextAttemptDelta = 1
+		}
 
 		pi.minPublishHeight = currentHeight + nextAttemptDelta
 
@@ -1262,12 +1734,20 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 			pi.publishAttempts, pi.minPublishHeight,
 			nextAttemptDelta)
 
-		if pi.publishAttempts >= s.cfg.MaxSweepAttempts {
+		// Inputs with a deadline are never given up on, as they are
+		// retried every block by design.
+		if pi.publishAttempts >= s.cfg.MaxSweepAttempts &&
+			pi.params.DeadlineHeight == 0 {
+
 			// Signal result channels sweep result.
 			s.signalAndRemove(&input.PreviousOutPoint, Result{
 				Err: ErrTooManyAttempts,
 			})
+
+			continue
 		}
+
+		s.persistInput(pi)
 	}
 
 	return nil
@@ -1355,6 +1835,8 @@ func (s *UtxoSweeper) handlePendingSweepsReq(
 				pendingInput.SignDesc().Output.Value,
 			),
 			LastFeeRate:         pendingInput.lastFeeRate,
+			LastSweepFee:        pendingInput.lastSweepFee,
+			LastSweepWeight:     pendingInput.lastSweepWeight,
 			BroadcastAttempts:   pendingInput.publishAttempts,
 			NextBroadcastHeight: uint32(pendingInput.minPublishHeight),
 			Params:              pendingInput.params,
@@ -1434,6 +1916,7 @@ func (s *UtxoSweeper) handleUpdateReq(req *updateReq, bestHeight int32) (
 		pendingInput.params, newParams)
 
 	pendingInput.params = newParams
+	pendingInput.paramsUpdated = true
 
 	// We'll reset the input's publish height to the current so that a new
 	// transaction can be created that replaces the transaction currently
@@ -1447,6 +1930,9 @@ func (s *UtxoSweeper) handleUpdateReq(req *updateReq, bestHeight int32) (
 		pendingInput.minPublishHeight = bestHeight
 	}
 
+	// Store the new parameters, so that they survive a restart.
+	s.persistInput(pendingInput)
+
 	if err := s.scheduleSweep(bestHeight); err != nil {
 		log.Errorf("Unable to schedule sweep: %v", err)
 	}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
@@ -884,8 +885,10 @@ func TestRestartRemoteSpend(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	// Expect sweeper to construct a new tx, because input 1 was spend
-	// remotely.
+	// The restored input keeps its attempt history, so it is only retried
+	// at the next block. Expect sweeper to construct a new tx then,
+	// because input 1 was spend remotely.
+	ctx.notifier.NotifyEpoch(101)
 	ctx.tick()
 
 	ctx.receiveTx()
@@ -918,21 +921,206 @@ func TestRestartConfirmed(t *testing.T) {
 	// Expect last tx to be republished.
 	ctx.receiveTx()
 
-	// Mine the sweep tx.
-	ctx.backend.mine()
-
 	// Simulate other subsystem (e.g. contract resolver) re-offering input 0.
+	// As the restored input keeps its attempt history, it isn't retried
+	// before the next block.
 	spendChan, err := ctx.sweeper.SweepInput(input, defaultFeePref)
 	if err != nil {
 		t.Fatal(err)
 	}
+	ctx.assertNoTick()
+
+	// Mine the sweep tx.
+	ctx.backend.mine()
 
 	// Here we expect again a successful sweep.
 	ctx.expectResult(spendChan, nil)
 
-	// Timer started but not needed because spend ntfn was sent.
+	ctx.finish(1)
+}
+
+// TestRestartRestoresInputs asserts that the sweeper restores its pending
+// inputs on restart, and that a fee update survives the restart when the input
+// is offered again.
+func TestRestartRestoresInputs(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	lowFeePref := FeePreference{ConfTarget: 144}
+	lowFeeRate := chainfee.FeePerKwFloor
+	ctx.estimator.blocksToFee[lowFeePref.ConfTarget] = lowFeeRate
+
+	highFeePref := FeePreference{ConfTarget: 6}
+	highFeeRate := lowFeeRate * 10
+	ctx.estimator.blocksToFee[highFeePref.ConfTarget] = highFeeRate
+
+	// Offer two inputs and bump the fee of the first one.
+	input1 := spendableInputs[0]
+	_, err := ctx.sweeper.SweepInput(input1, Params{Fee: lowFeePref})
+	if err != nil {
+		t.Fatal(err)
+	}
+	input2 := spendableInputs[1]
+	_, err = ctx.sweeper.SweepInput(input2, Params{Fee: lowFeePref})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ctx.sweeper.UpdateParams(
+		*input1.OutPoint(), ParamsUpdate{Fee: highFeePref},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two sweep txes are published, one for each fee rate. We'll evict
+	// them from the mempool, so that the inputs remain unspent.
+	ctx.tick()
+	highFeeTx := ctx.receiveTx()
+	lowFeeTx := ctx.receiveTx()
+	ctx.backend.deleteUnconfirmed(highFeeTx.TxHash())
+	ctx.backend.deleteUnconfirmed(lowFeeTx.TxHash())
+
+	// After a restart, both inputs should be pending immediately. The
+	// restored inputs keep their attempt history and updated parameters.
+	ctx.restartSweeper()
+	republishedTx := ctx.receiveTx()
+	ctx.backend.deleteUnconfirmed(republishedTx.TxHash())
+	ctx.assertPendingInputs(input1, input2)
+
+	pendingInputs, err := ctx.sweeper.PendingInputs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pendingInput1 := pendingInputs[*input1.OutPoint()]
+	if pendingInput1.Params.Fee != highFeePref {
+		t.Fatalf("expected fee preference %v, got %v", highFeePref,
+			pendingInput1.Params.Fee)
+	}
+	if pendingInput1.BroadcastAttempts != 1 {
+		t.Fatalf("expected 1 broadcast attempt, got %v",
+			pendingInput1.BroadcastAttempts)
+	}
+
+	// The restored inputs aren't swept before they are offered again.
+	ctx.notifier.NotifyEpoch(101)
+	ctx.assertNoTick()
+
+	// Offer the first input again with its original fee preference. The
+	// fee update should take precedence.
+	resultChan1, err := ctx.sweeper.SweepInput(
+		input1, Params{Fee: lowFeePref},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+	assertTxFeeRate(t, &sweepTx, highFeeRate, input1)
+
+	ctx.backend.mine()
+	ctx.expectResult(resultChan1, nil)
+
+	// The second input isn't offered again, so it should be forgotten
+	// once the restored input timeout passes.
+	ctx.notifier.NotifyEpoch(100 + restoredInputTimeout)
+	ctx.assertNoTick()
+	ctx.assertPendingInputs()
+
+	ctx.finish(1)
+}
+
+// TestRestartSweepsWalletInputs asserts that wallet inputs, which aren't
+// offered again after a restart, are rebuilt from the store and swept without
+// being offered again.
+func TestRestartSweepsWalletInputs(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	walletInput := createTestInput(50000, input.WitnessKeyHash)
+	_, err := ctx.sweeper.SweepInput(&walletInput, defaultFeePref)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+	ctx.backend.deleteUnconfirmed(sweepTx.TxHash())
+
+	// After a restart, the wallet input is swept again once its backoff
+	// has passed, without waiting for it to be offered again.
+	ctx.restartSweeper()
+	republishedTx := ctx.receiveTx()
+	ctx.backend.deleteUnconfirmed(republishedTx.TxHash())
+	ctx.assertPendingInputs(&walletInput)
+
+	ctx.notifier.NotifyEpoch(101)
+	ctx.tick()
+	sweepTx = ctx.receiveTx()
+	if len(sweepTx.TxIn) != 1 ||
+		sweepTx.TxIn[0].PreviousOutPoint != *walletInput.OutPoint() {
+
+		t.Fatalf("expected wallet input to be swept")
+	}
+
+	ctx.backend.mine()
+	ctx.finish(1)
+}
+
+// TestRestartSweepsSelfContainedInputs asserts that inputs that were stored
+// with their full sign descriptor, such as migrated nursery outputs, remain
+// sweepable across multiple restarts even though nobody offers them again.
+func TestRestartSweepsSelfContainedInputs(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	// Store a time locked commitment output along with everything that
+	// is needed to sweep it, like the nursery migration does.
+	testInput := createTestInput(50000, input.CommitmentTimeLock)
+	storedInput := &StoredInput{
+		OutPoint:    *testInput.OutPoint(),
+		WitnessType: input.CommitmentTimeLock,
+		Output:      *testInput.SignDesc().Output,
+		SignDesc:    testInput.SignDesc(),
+		CsvDelay:    10,
+		Params:      defaultFeePref,
+	}
+	if err := ctx.store.PutPendingInput(storedInput); err != nil {
+		t.Fatal(err)
+	}
+
+	// After a restart, the input is restored and swept right away.
+	ctx.restartSweeper()
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+	ctx.backend.deleteUnconfirmed(sweepTx.TxHash())
+
+	// Sweeping the input persists it again. It should still be stored
+	// with its sign descriptor, so that it can be swept after the next
+	// restart.
+	storedInputs, err := ctx.store.FetchPendingInputs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(storedInputs) != 1 || storedInputs[0].SignDesc == nil {
+		t.Fatalf("expected input to be stored with sign descriptor")
+	}
+
+	ctx.restartSweeper()
+	republishedTx := ctx.receiveTx()
+	ctx.backend.deleteUnconfirmed(republishedTx.TxHash())
+	ctx.assertPendingInputs(&testInput)
+
+	// The input isn't parked, so it's swept again once its backoff has
+	// passed, even beyond the timeout of parked inputs.
+	ctx.notifier.NotifyEpoch(100 + restoredInputTimeout)
 	ctx.tick()
+	sweepTx = ctx.receiveTx()
+	if len(sweepTx.TxIn) != 1 ||
+		sweepTx.TxIn[0].PreviousOutPoint != *testInput.OutPoint() {
+
+		t.Fatalf("expected restored input to be swept")
+	}
 
+	ctx.backend.mine()
 	ctx.finish(1)
 }
 
@@ -1047,6 +1235,146 @@ func TestGiveUp(t *testing.T) {
 	ctx.finish(1)
 }
 
+// TestDeadlineFeeRate asserts that the fee rate of inputs with a deadline is
+// raised linearly from the preferred fee rate to the maximum fee rate.
+func TestDeadlineFeeRate(t *testing.T) {
+	t.Parallel()
+
+	const (
+		feeRate    = chainfee.SatPerKWeight(1000)
+		maxFeeRate = chainfee.SatPerKWeight(5000)
+	)
+
+	tests := []struct {
+		name            string
+		feeRate         chainfee.SatPerKWeight
+		currentHeight   int32
+		expectedFeeRate chainfee.SatPerKWeight
+	}{
+		{
+			name:            "start",
+			feeRate:         feeRate,
+			currentHeight:   100,
+			expectedFeeRate: feeRate,
+		},
+		{
+			name:            "halfway",
+			feeRate:         feeRate,
+			currentHeight:   105,
+			expectedFeeRate: 3000,
+		},
+		{
+			name:            "deadline",
+			feeRate:         feeRate,
+			currentHeight:   110,
+			expectedFeeRate: maxFeeRate,
+		},
+		{
+			name:            "past deadline",
+			feeRate:         feeRate,
+			currentHeight:   120,
+			expectedFeeRate: maxFeeRate,
+		},
+		{
+			name:            "preference above max",
+			feeRate:         2 * maxFeeRate,
+			currentHeight:   100,
+			expectedFeeRate: maxFeeRate,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			feeRate := DeadlineFeeRate(
+				test.feeRate, maxFeeRate, 100, 110,
+				test.currentHeight,
+			)
+			require.Equal(t, test.expectedFeeRate, feeRate)
+		})
+	}
+}
+
+// TestDeadlineSweep asserts that an input with a deadline is republished every
+// block with a rising fee rate, never exceeds its budget and isn't given up on
+// after the maximum number of attempts.
+func TestDeadlineSweep(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	inp := createTestInput(1_000_000, input.CommitmentTimeLock)
+
+	const (
+		feeRate = chainfee.SatPerKWeight(2500)
+		budget  = btcutil.Amount(5000)
+	)
+	maxFeeRate, err := budgetFeeRate(&inp, budget)
+	require.NoError(t, err)
+	require.Greater(t, int64(maxFeeRate), int64(feeRate))
+
+	resultChan, err := ctx.sweeper.SweepInput(&inp, Params{
+		Fee:            FeePreference{FeeRate: feeRate},
+		DeadlineHeight: 110,
+		Budget:         budget,
+	})
+	require.NoError(t, err)
+
+	// The first sweep is published at height 100 with the preferred fee
+	// rate.
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+	assertTxFeeRate(t, &sweepTx, feeRate, &inp)
+
+	// Halfway to the deadline, the fee rate is raised halfway to the
+	// maximum fee rate.
+	ctx.notifier.NotifyEpoch(105)
+	ctx.tick()
+	sweepTx = ctx.receiveTx()
+	assertTxFeeRate(t, &sweepTx, feeRate+(maxFeeRate-feeRate)/2, &inp)
+
+	// From the deadline on, the input is swept at the maximum fee rate
+	// allowed by the budget, and is retried every block beyond the
+	// maximum number of attempts.
+	for i := 0; i < testMaxSweepAttempts; i++ {
+		height := int32(110 + i)
+
+		ctx.notifier.NotifyEpoch(height)
+		ctx.tick()
+		sweepTx = ctx.receiveTx()
+		assertTxFeeRate(t, &sweepTx, maxFeeRate, &inp)
+
+		fee := inp.SignDesc().Output.Value - sweepTx.TxOut[0].Value
+		require.LessOrEqual(t, fee, int64(budget))
+	}
+
+	ctx.backend.mine()
+	ctx.expectResult(resultChan, nil)
+
+	ctx.finish(1)
+}
+
+// TestBudgetTooLow asserts that an input whose budget doesn't even allow
+// sweeping at the relay fee rate is swept at the relay fee rate.
+func TestBudgetTooLow(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	inp := createTestInput(1_000_000, input.CommitmentTimeLock)
+	resultChan, err := ctx.sweeper.SweepInput(&inp, Params{
+		Fee:    FeePreference{FeeRate: 2 * chainfee.FeePerKwFloor},
+		Budget: 1,
+	})
+	require.NoError(t, err)
+
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+	assertTxFeeRate(t, &sweepTx, ctx.sweeper.relayFeeRate, &inp)
+
+	ctx.backend.mine()
+	ctx.expectResult(resultChan, nil)
+
+	ctx.finish(1)
+}
+
 // TestDifferentFeePreferences ensures that the sweeper can have different
 // transactions for different fee preferences. These transactions should be
 // broadcast from highest to lowest fee rate.
@@ -1374,6 +1702,17 @@ func TestCpfp(t *testing.T) {
 	// therefore: 1_000_000 + 330 - 4395 = 995 935.
 	require.Equal(t, int64(995_935), tx.TxOut[0].Value)
 
+	// The fee and weight of the sweep tx are reported, so that the
+	// effective fee rate of the package can be determined.
+	pendingInputs, err := ctx.sweeper.PendingInputs()
+	require.NoError(t, err)
+	pendingInput := pendingInputs[*input.OutPoint()]
+	require.Equal(t, btcutil.Amount(4395), pendingInput.LastSweepFee)
+	require.Equal(
+		t, blockchain.GetTransactionWeight(btcutil.NewTx(&tx)),
+		pendingInput.LastSweepWeight,
+	)
+
 	// Mine the tx and assert that the result is passed back.
 	ctx.backend.mine()
 	ctx.expectResult(result, nil)
@@ -1783,6 +2122,38 @@ func TestLockTimes(t *testing.T) {
 	}
 }
 
+// TestImmatureLockTime asserts that an input committing to a locktime that
+// hasn't been reached yet is only swept once the chain reaches that height.
+func TestImmatureLockTime(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	lt := uint32(mockChainHeight + 1)
+	inp := &testInput{
+		BaseInput: spendableInputs[0],
+		locktime:  &lt,
+	}
+
+	resultChan, err := ctx.sweeper.SweepInput(inp, defaultFeePref)
+	require.NoError(t, err)
+
+	// The input can't be swept at the current height, so the sweeper
+	// shouldn't even start its batch timer.
+	ctx.assertNoTick()
+
+	// Once the locktime is reached, the input should be swept with a
+	// transaction committing to its locktime.
+	ctx.notifier.NotifyEpoch(mockChainHeight + 1)
+	ctx.tick()
+
+	sweepTx := ctx.receiveTx()
+	require.Equal(t, lt, sweepTx.LockTime)
+
+	ctx.backend.mine()
+	ctx.expectResult(resultChan, nil)
+
+	ctx.finish(1)
+}
+
 // TestRequiredTxOuts checks that inputs having a required TxOut gets swept with
 // sweep transactions paying into these outputs.
 func TestRequiredTxOuts(t *testing.T) {
@@ -223,54 +223,11 @@ func CraftSweepAllTx(feeRate chainfee.SatPerKWeight, dustLimit btcutil.Amount,
 	// Now that we've locked all the potential outputs to sweep, we'll
 	// assemble an input for each of them, so we can hand it off to the
 	// sweeper to generate and sign a transaction for us.
-	var inputsToSweep []input.Input
-	for _, output := range allOutputs {
-		// As we'll be signing for outputs under control of the wallet,
-		// we only need to populate the output value and output script.
-		// The rest of the items will be populated internally within
-		// the sweeper via the witness generation function.
-		signDesc := &input.SignDescriptor{
-			Output: &wire.TxOut{
-				PkScript: output.PkScript,
-				Value:    int64(output.Value),
-			},
-			HashType: txscript.SigHashAll,
-		}
-
-		pkScript := output.PkScript
-
-		// Based on the output type, we'll map it to the proper witness
-		// type so we can generate the set of input scripts needed to
-		// sweep the output.
-		var witnessType input.WitnessType
-		switch output.AddressType {
-
-		// If this is a p2wkh output, then we'll assume it's a witness
-		// key hash witness type.
-		case lnwallet.WitnessPubKey:
-			witnessType = input.WitnessKeyHash
-
-		// If this is a p2sh output, then as since it's under control
-		// of the wallet, we'll assume it's a nested p2sh output.
-		case lnwallet.NestedWitnessPubKey:
-			witnessType = input.NestedWitnessKeyHash
-
-		// All other output types we count as unknown and will fail to
-		// sweep.
-		default:
-			unlockOutputs()
-
-			return nil, fmt.Errorf("unable to sweep coins, "+
-				"unknown script: %x", pkScript[:])
-		}
+	inputsToSweep, err := walletInputs(allOutputs)
+	if err != nil {
+		unlockOutputs()
 
-		// Now that we've constructed the items required, we'll make an
-		// input which can be passed to the sweeper for ultimate
-		// sweeping.
-		input := input.MakeBaseInput(
-			&output.OutPoint, witnessType, signDesc, 0, nil,
-		)
-		inputsToSweep = append(inputsToSweep, &input)
+		return nil, err
 	}
 
 	// Create a list of TxOuts from the given delivery addresses.
@@ -315,3 +272,59 @@ func CraftSweepAllTx(feeRate chainfee.SatPerKWeight, dustLimit btcutil.Amount,
 		CancelSweepAttempt: unlockOutputs,
 	}, nil
 }
+
+// walletInputs assembles an input for each of the given wallet utxos, so they
+// can be handed off to the sweeper to generate and sign a transaction.
+func walletInputs(utxos []*lnwallet.Utxo) ([]input.Input, error) {
+	var inputs []input.Input
+	for _, output := range utxos {
+		// As we'll be signing for outputs under control of the wallet,
+		// we only need to populate the output value and output script.
+		// The rest of the items will be populated internally within
+		// the sweeper via the witness generation function.
+		signDesc := &input.SignDescriptor{
+			Output: &wire.TxOut{
+				PkScript: output.PkScript,
+				Value:    int64(output.Value),
+			},
+			HashType: txscript.SigHashAll,
+		}
+
+		witnessType, err := walletWitnessType(output)
+		if err != nil {
+			return nil, err
+		}
+
+		// Now that we've constructed the items required, we'll make an
+		// input which can be passed to the sweeper for ultimate
+		// sweeping.
+		input := input.MakeBaseInput(
+			&output.OutPoint, witnessType, signDesc, 0, nil,
+		)
+		inputs = append(inputs, &input)
+	}
+
+	return inputs, nil
+}
+
+// walletWitnessType maps the address type of a wallet utxo to the witness type
+// needed to sweep it.
+func walletWitnessType(output *lnwallet.Utxo) (input.WitnessType, error) {
+	switch output.AddressType {
+
+	// If this is a p2wkh output, then we'll assume it's a witness key hash
+	// witness type.
+	case lnwallet.WitnessPubKey:
+		return input.WitnessKeyHash, nil
+
+	// If this is a p2sh output, then as since it's under control of the
+	// wallet, we'll assume it's a nested p2sh output.
+	case lnwallet.NestedWitnessPubKey:
+		return input.NestedWitnessKeyHash, nil
+
+	// All other output types we count as unknown and will fail to sweep.
+	default:
+		return nil, fmt.Errorf("unable to sweep coins, unknown "+
+			"script: %x", output.PkScript[:])
+	}
+}
@@ -127,10 +127,16 @@ func (m *mockUtxoSource) ListUnspentWitnessFromDefaultAccount(minConfs int32,
 
 type mockCoinSelectionLocker struct {
 	fail bool
+
+	// held is true while the coin select lock is held.
+	held bool
 }
 
 func (m *mockCoinSelectionLocker) WithCoinSelectLock(f func() error) error {
-	if err := f(); err != nil {
+	m.held = true
+	err := f()
+	m.held = false
+	if err != nil {
 		return err
 	}
 